@@ -27,6 +27,8 @@ type ApiContainerServiceClient interface {
 	RunStarlarkScript(ctx context.Context, in *RunStarlarkScriptArgs, opts ...grpc.CallOption) (ApiContainerService_RunStarlarkScriptClient, error)
 	// Executes a Starlark script on the user's behalf
 	RunStarlarkPackage(ctx context.Context, in *RunStarlarkPackageArgs, opts ...grpc.CallOption) (ApiContainerService_RunStarlarkPackageClient, error)
+	// Runs the test functions of the Starlark test files of a package against a test plan, without touching the enclave
+	RunStarlarkPackageTests(ctx context.Context, in *RunStarlarkPackageTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageTestsResponse, error)
 	// Start services by creating containers for them
 	StartServices(ctx context.Context, in *StartServicesArgs, opts ...grpc.CallOption) (*StartServicesResponse, error)
 	// Returns the IDs of the current services in the enclave
@@ -37,6 +39,11 @@ type ApiContainerServiceClient interface {
 	RemoveService(ctx context.Context, in *RemoveServiceArgs, opts ...grpc.CallOption) (*RemoveServiceResponse, error)
 	// Instructs the API container to repartition the enclave
 	Repartition(ctx context.Context, in *RepartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Cancels the connection changes scheduled under the given name which are not applied yet
+	CancelConnectionChanges(ctx context.Context, in *CancelConnectionChangesArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams the events of the connection changes scheduled under the given name, starting with the past ones. The
+	//  stream ends once all the changes got applied or cancelled
+	WatchConnectionChanges(ctx context.Context, in *WatchConnectionChangesArgs, opts ...grpc.CallOption) (ApiContainerService_WatchConnectionChangesClient, error)
 	// Executes the given command inside a running container
 	ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error)
 	// Pauses all processes running in the service container
@@ -58,6 +65,12 @@ type ApiContainerServiceClient interface {
 	StoreFilesArtifactFromService(ctx context.Context, in *StoreFilesArtifactFromServiceArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromServiceResponse, error)
 	// Renders the templates and their data to a files artifact in the Kurtosis File System
 	RenderTemplatesToFilesArtifact(ctx context.Context, in *RenderTemplatesToFilesArtifactArgs, opts ...grpc.CallOption) (*RenderTemplatesToFilesArtifactResponse, error)
+	// Captures the traffic of a service from its networking sidecar and streams the capture in pcap format, in chunks
+	CaptureServiceTraffic(ctx context.Context, in *CaptureServiceTrafficArgs, opts ...grpc.CallOption) (ApiContainerService_CaptureServiceTrafficClient, error)
+	// Captures the traffic of a service from its networking sidecar and stores the capture as a files artifact
+	StoreServiceTrafficCapture(ctx context.Context, in *StoreServiceTrafficCaptureArgs, opts ...grpc.CallOption) (*StoreServiceTrafficCaptureResponse, error)
+	// Returns the counters of the traffic services sent to each other, as seen by the traffic control of their networking sidecars
+	GetTrafficStatistics(ctx context.Context, in *GetTrafficStatisticsArgs, opts ...grpc.CallOption) (*GetTrafficStatisticsResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -132,6 +145,15 @@ func (x *apiContainerServiceRunStarlarkPackageClient) Recv() (*StarlarkRunRespon
 	return m, nil
 }
 
+func (c *apiContainerServiceClient) RunStarlarkPackageTests(ctx context.Context, in *RunStarlarkPackageTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageTestsResponse, error) {
+	out := new(RunStarlarkPackageTestsResponse)
+	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/RunStarlarkPackageTests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) StartServices(ctx context.Context, in *StartServicesArgs, opts ...grpc.CallOption) (*StartServicesResponse, error) {
 	out := new(StartServicesResponse)
 	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/StartServices", in, out, opts...)
@@ -177,6 +199,47 @@ func (c *apiContainerServiceClient) Repartition(ctx context.Context, in *Reparti
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) CancelConnectionChanges(ctx context.Context, in *CancelConnectionChangesArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/CancelConnectionChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) WatchConnectionChanges(ctx context.Context, in *WatchConnectionChangesArgs, opts ...grpc.CallOption) (ApiContainerService_WatchConnectionChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[2], "/api_container_api.ApiContainerService/WatchConnectionChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceWatchConnectionChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_WatchConnectionChangesClient interface {
+	Recv() (*ConnectionChangeEvent, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceWatchConnectionChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceWatchConnectionChangesClient) Recv() (*ConnectionChangeEvent, error) {
+	m := new(ConnectionChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
 	out := new(ExecCommandResponse)
 	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/ExecCommand", in, out, opts...)
@@ -267,6 +330,56 @@ func (c *apiContainerServiceClient) RenderTemplatesToFilesArtifact(ctx context.C
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) CaptureServiceTraffic(ctx context.Context, in *CaptureServiceTrafficArgs, opts ...grpc.CallOption) (ApiContainerService_CaptureServiceTrafficClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], "/api_container_api.ApiContainerService/CaptureServiceTraffic", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceCaptureServiceTrafficClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_CaptureServiceTrafficClient interface {
+	Recv() (*ServiceTrafficCaptureChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceCaptureServiceTrafficClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceCaptureServiceTrafficClient) Recv() (*ServiceTrafficCaptureChunk, error) {
+	m := new(ServiceTrafficCaptureChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) StoreServiceTrafficCapture(ctx context.Context, in *StoreServiceTrafficCaptureArgs, opts ...grpc.CallOption) (*StoreServiceTrafficCaptureResponse, error) {
+	out := new(StoreServiceTrafficCaptureResponse)
+	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/StoreServiceTrafficCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetTrafficStatistics(ctx context.Context, in *GetTrafficStatisticsArgs, opts ...grpc.CallOption) (*GetTrafficStatisticsResponse, error) {
+	out := new(GetTrafficStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/api_container_api.ApiContainerService/GetTrafficStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -275,6 +388,8 @@ type ApiContainerServiceServer interface {
 	RunStarlarkScript(*RunStarlarkScriptArgs, ApiContainerService_RunStarlarkScriptServer) error
 	// Executes a Starlark script on the user's behalf
 	RunStarlarkPackage(*RunStarlarkPackageArgs, ApiContainerService_RunStarlarkPackageServer) error
+	// Runs the test functions of the Starlark test files of a package against a test plan, without touching the enclave
+	RunStarlarkPackageTests(context.Context, *RunStarlarkPackageTestsArgs) (*RunStarlarkPackageTestsResponse, error)
 	// Start services by creating containers for them
 	StartServices(context.Context, *StartServicesArgs) (*StartServicesResponse, error)
 	// Returns the IDs of the current services in the enclave
@@ -285,6 +400,11 @@ type ApiContainerServiceServer interface {
 	RemoveService(context.Context, *RemoveServiceArgs) (*RemoveServiceResponse, error)
 	// Instructs the API container to repartition the enclave
 	Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error)
+	// Cancels the connection changes scheduled under the given name which are not applied yet
+	CancelConnectionChanges(context.Context, *CancelConnectionChangesArgs) (*emptypb.Empty, error)
+	// Streams the events of the connection changes scheduled under the given name, starting with the past ones. The
+	//  stream ends once all the changes got applied or cancelled
+	WatchConnectionChanges(*WatchConnectionChangesArgs, ApiContainerService_WatchConnectionChangesServer) error
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error)
 	// Pauses all processes running in the service container
@@ -306,6 +426,12 @@ type ApiContainerServiceServer interface {
 	StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error)
 	// Renders the templates and their data to a files artifact in the Kurtosis File System
 	RenderTemplatesToFilesArtifact(context.Context, *RenderTemplatesToFilesArtifactArgs) (*RenderTemplatesToFilesArtifactResponse, error)
+	// Captures the traffic of a service from its networking sidecar and streams the capture in pcap format, in chunks
+	CaptureServiceTraffic(*CaptureServiceTrafficArgs, ApiContainerService_CaptureServiceTrafficServer) error
+	// Captures the traffic of a service from its networking sidecar and stores the capture as a files artifact
+	StoreServiceTrafficCapture(context.Context, *StoreServiceTrafficCaptureArgs) (*StoreServiceTrafficCaptureResponse, error)
+	// Returns the counters of the traffic services sent to each other, as seen by the traffic control of their networking sidecars
+	GetTrafficStatistics(context.Context, *GetTrafficStatisticsArgs) (*GetTrafficStatisticsResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -318,6 +444,9 @@ func (UnimplementedApiContainerServiceServer) RunStarlarkScript(*RunStarlarkScri
 func (UnimplementedApiContainerServiceServer) RunStarlarkPackage(*RunStarlarkPackageArgs, ApiContainerService_RunStarlarkPackageServer) error {
 	return status.Errorf(codes.Unimplemented, "method RunStarlarkPackage not implemented")
 }
+func (UnimplementedApiContainerServiceServer) RunStarlarkPackageTests(context.Context, *RunStarlarkPackageTestsArgs) (*RunStarlarkPackageTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunStarlarkPackageTests not implemented")
+}
 func (UnimplementedApiContainerServiceServer) StartServices(context.Context, *StartServicesArgs) (*StartServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartServices not implemented")
 }
@@ -333,6 +462,12 @@ func (UnimplementedApiContainerServiceServer) RemoveService(context.Context, *Re
 func (UnimplementedApiContainerServiceServer) Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Repartition not implemented")
 }
+func (UnimplementedApiContainerServiceServer) CancelConnectionChanges(context.Context, *CancelConnectionChangesArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConnectionChanges not implemented")
+}
+func (UnimplementedApiContainerServiceServer) WatchConnectionChanges(*WatchConnectionChangesArgs, ApiContainerService_WatchConnectionChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectionChanges not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
 }
@@ -363,6 +498,15 @@ func (UnimplementedApiContainerServiceServer) StoreFilesArtifactFromService(cont
 func (UnimplementedApiContainerServiceServer) RenderTemplatesToFilesArtifact(context.Context, *RenderTemplatesToFilesArtifactArgs) (*RenderTemplatesToFilesArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplatesToFilesArtifact not implemented")
 }
+func (UnimplementedApiContainerServiceServer) CaptureServiceTraffic(*CaptureServiceTrafficArgs, ApiContainerService_CaptureServiceTrafficServer) error {
+	return status.Errorf(codes.Unimplemented, "method CaptureServiceTraffic not implemented")
+}
+func (UnimplementedApiContainerServiceServer) StoreServiceTrafficCapture(context.Context, *StoreServiceTrafficCaptureArgs) (*StoreServiceTrafficCaptureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreServiceTrafficCapture not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetTrafficStatistics(context.Context, *GetTrafficStatisticsArgs) (*GetTrafficStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrafficStatistics not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -417,6 +561,24 @@ func (x *apiContainerServiceRunStarlarkPackageServer) Send(m *StarlarkRunRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApiContainerService_RunStarlarkPackageTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunStarlarkPackageTestsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api_container_api.ApiContainerService/RunStarlarkPackageTests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageTests(ctx, req.(*RunStarlarkPackageTestsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_StartServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartServicesArgs)
 	if err := dec(in); err != nil {
@@ -507,6 +669,45 @@ func _ApiContainerService_Repartition_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_CancelConnectionChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConnectionChangesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).CancelConnectionChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api_container_api.ApiContainerService/CancelConnectionChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).CancelConnectionChanges(ctx, req.(*CancelConnectionChangesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_WatchConnectionChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConnectionChangesArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).WatchConnectionChanges(m, &apiContainerServiceWatchConnectionChangesServer{stream})
+}
+
+type ApiContainerService_WatchConnectionChangesServer interface {
+	Send(*ConnectionChangeEvent) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceWatchConnectionChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceWatchConnectionChangesServer) Send(m *ConnectionChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecCommandArgs)
 	if err := dec(in); err != nil {
@@ -687,6 +888,63 @@ func _ApiContainerService_RenderTemplatesToFilesArtifact_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_CaptureServiceTraffic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CaptureServiceTrafficArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).CaptureServiceTraffic(m, &apiContainerServiceCaptureServiceTrafficServer{stream})
+}
+
+type ApiContainerService_CaptureServiceTrafficServer interface {
+	Send(*ServiceTrafficCaptureChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceCaptureServiceTrafficServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceCaptureServiceTrafficServer) Send(m *ServiceTrafficCaptureChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_StoreServiceTrafficCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreServiceTrafficCaptureArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).StoreServiceTrafficCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api_container_api.ApiContainerService/StoreServiceTrafficCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).StoreServiceTrafficCapture(ctx, req.(*StoreServiceTrafficCaptureArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetTrafficStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrafficStatisticsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetTrafficStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api_container_api.ApiContainerService/GetTrafficStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetTrafficStatistics(ctx, req.(*GetTrafficStatisticsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -694,6 +952,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api_container_api.ApiContainerService",
 	HandlerType: (*ApiContainerServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunStarlarkPackageTests",
+			Handler:    _ApiContainerService_RunStarlarkPackageTests_Handler,
+		},
 		{
 			MethodName: "StartServices",
 			Handler:    _ApiContainerService_StartServices_Handler,
@@ -714,6 +976,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Repartition",
 			Handler:    _ApiContainerService_Repartition_Handler,
 		},
+		{
+			MethodName: "CancelConnectionChanges",
+			Handler:    _ApiContainerService_CancelConnectionChanges_Handler,
+		},
 		{
 			MethodName: "ExecCommand",
 			Handler:    _ApiContainerService_ExecCommand_Handler,
@@ -754,6 +1020,14 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenderTemplatesToFilesArtifact",
 			Handler:    _ApiContainerService_RenderTemplatesToFilesArtifact_Handler,
 		},
+		{
+			MethodName: "StoreServiceTrafficCapture",
+			Handler:    _ApiContainerService_StoreServiceTrafficCapture_Handler,
+		},
+		{
+			MethodName: "GetTrafficStatistics",
+			Handler:    _ApiContainerService_GetTrafficStatistics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -766,6 +1040,16 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_RunStarlarkPackage_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchConnectionChanges",
+			Handler:       _ApiContainerService_WatchConnectionChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CaptureServiceTraffic",
+			Handler:       _ApiContainerService_CaptureServiceTraffic_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -2,6 +2,7 @@ package binding_constructors
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // The generated bindings don't come with constructors (leaving it up to the user to initialize all the fields), so we
@@ -32,7 +33,8 @@ func NewServiceConfig(
 	cpuAllocationMillicpus uint64,
 	memoryAllocationMegabytes uint64,
 	privateIPAddrPlaceholder string,
-	subnetwork string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	subnetwork string,
+	hostnames []string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
 	return &kurtosis_core_rpc_api_bindings.ServiceConfig{
 		ContainerImageName:        containerImageName,
 		PrivatePorts:              privatePorts,
@@ -45,6 +47,7 @@ func NewServiceConfig(
 		MemoryAllocationMegabytes: memoryAllocationMegabytes,
 		PrivateIpAddrPlaceholder:  privateIPAddrPlaceholder,
 		Subnetwork:                &subnetwork,
+		Hostnames:                 hostnames,
 	}
 }
 
@@ -70,27 +73,74 @@ func NewRunStarlarkScriptArgs(serializedString string, serializedParams string,
 	}
 }
 
-func NewRunStarlarkPackageArgs(packageId string, compressedPackage []byte, serializedParams string, dryRun bool, parallelism int32) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
+func NewRunStarlarkPackageArgs(packageId string, compressedPackage []byte, serializedParams string, dryRun bool, parallelism int32, resume bool, apply bool, removeUndeclaredServices bool) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
-		PackageId:              packageId,
-		StarlarkPackageContent: &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs_Local{Local: compressedPackage},
-		SerializedParams:       serializedParams,
-		DryRun:                 &dryRun,
-		Parallelism:            parallelismCopy,
+		PackageId:                packageId,
+		StarlarkPackageContent:   &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs_Local{Local: compressedPackage},
+		SerializedParams:         serializedParams,
+		DryRun:                   &dryRun,
+		Parallelism:              parallelismCopy,
+		Resume:                   &resume,
+		Apply:                    &apply,
+		RemoveUndeclaredServices: &removeUndeclaredServices,
 	}
 }
 
-func NewRunStarlarkRemotePackageArgs(packageId string, serializedParams string, dryRun bool, parallelism int32) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
+func NewRunStarlarkRemotePackageArgs(packageId string, serializedParams string, dryRun bool, parallelism int32, resume bool, apply bool, removeUndeclaredServices bool) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
+		PackageId:                packageId,
+		StarlarkPackageContent:   &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs_Remote{Remote: true},
+		SerializedParams:         serializedParams,
+		DryRun:                   &dryRun,
+		Parallelism:              parallelismCopy,
+		Resume:                   &resume,
+		Apply:                    &apply,
+		RemoveUndeclaredServices: &removeUndeclaredServices,
+	}
+}
+
+// ==============================================================================================
+//
+//	Starlark Package Tests
+//
+// ==============================================================================================
+func NewRunStarlarkPackageTestsArgs(packageId string, compressedPackage []byte) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs {
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs{
+		PackageId:              packageId,
+		StarlarkPackageContent: &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs_Local{Local: compressedPackage},
+	}
+}
+
+func NewRunStarlarkRemotePackageTestsArgs(packageId string) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs {
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs{
 		PackageId:              packageId,
-		StarlarkPackageContent: &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs_Remote{Remote: true},
-		SerializedParams:       serializedParams,
-		DryRun:                 &dryRun,
-		Parallelism:            parallelismCopy,
+		StarlarkPackageContent: &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs_Remote{Remote: true},
+	}
+}
+
+func NewRunStarlarkPackageTestsResponse(testResults []*kurtosis_core_rpc_api_bindings.StarlarkTestResult) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse {
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse{
+		TestResults: testResults,
+	}
+}
+
+func NewSuccessfulStarlarkTestResult(testFilepath string, testFunctionName string) *kurtosis_core_rpc_api_bindings.StarlarkTestResult {
+	return &kurtosis_core_rpc_api_bindings.StarlarkTestResult{
+		TestFilepath:     testFilepath,
+		TestFunctionName: testFunctionName,
+		Failure:          nil,
+	}
+}
+
+func NewFailedStarlarkTestResult(testFilepath string, testFunctionName string, failure *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.StarlarkTestResult {
+	return &kurtosis_core_rpc_api_bindings.StarlarkTestResult{
+		TestFilepath:     testFilepath,
+		TestFunctionName: testFunctionName,
+		Failure:          failure,
 	}
 }
 
@@ -199,6 +249,25 @@ func NewStarlarkRunResponseLineFromRunSuccessEvent(serializedOutputObject string
 	}
 }
 
+func NewStarlarkRunResponseLineFromApplyPlan(changes []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ApplyPlan{
+			ApplyPlan: &kurtosis_core_rpc_api_bindings.StarlarkApplyPlan{
+				Changes: changes,
+			},
+		},
+	}
+}
+
+func NewStarlarkApplyPlanChange(action kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_Action, resourceType kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ResourceType, resourceName string) *kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange {
+	return &kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		Action:            action,
+		ResourceType:      resourceType,
+		ResourceName:      resourceName,
+		InstructionNumber: nil,
+	}
+}
+
 func NewStarlarkInstruction(position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition, name string, executableInstruction string, arguments []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
 	return &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
 		InstructionName:       name,
@@ -290,18 +359,22 @@ func NewServiceInfo(
 	name string,
 	shortenedUuid string,
 	privateIpAddr string,
+	privateIpv6Addr string,
 	privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	maybePublicIpAddr string,
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
+	hostnames []string,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
 		ServiceUuid:       uuid,
 		Name:              name,
 		ShortenedUuid:     shortenedUuid,
 		PrivateIpAddr:     privateIpAddr,
+		PrivateIpv6Addr:   privateIpv6Addr,
 		PrivatePorts:      privatePorts,
 		MaybePublicIpAddr: maybePublicIpAddr,
 		MaybePublicPorts:  maybePublicPorts,
+		Hostnames:         hostnames,
 	}
 }
 
@@ -317,6 +390,38 @@ func NewRemoveServiceResponse(serviceUuid string) *kurtosis_core_rpc_api_binding
 	}
 }
 
+// ==============================================================================================
+//
+//	Scheduled Connection Changes
+//
+// ==============================================================================================
+
+func NewCancelConnectionChangesArgs(name string) *kurtosis_core_rpc_api_bindings.CancelConnectionChangesArgs {
+	return &kurtosis_core_rpc_api_bindings.CancelConnectionChangesArgs{
+		Name: name,
+	}
+}
+
+func NewWatchConnectionChangesArgs(name string) *kurtosis_core_rpc_api_bindings.WatchConnectionChangesArgs {
+	return &kurtosis_core_rpc_api_bindings.WatchConnectionChangesArgs{
+		Name: name,
+	}
+}
+
+func NewConnectionChangeEvent(
+	eventType kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_EventType,
+	stepIndex uint32,
+	timestamp *timestamppb.Timestamp,
+	errorMessage string,
+) *kurtosis_core_rpc_api_bindings.ConnectionChangeEvent {
+	return &kurtosis_core_rpc_api_bindings.ConnectionChangeEvent{
+		EventType:    eventType,
+		StepIndex:    stepIndex,
+		Timestamp:    timestamp,
+		ErrorMessage: errorMessage,
+	}
+}
+
 // ==============================================================================================
 //
 //	Exec Command
@@ -387,3 +492,73 @@ func NewRenderTemplatesToFilesArtifactResponse(filesArtifactUuid string) *kurtos
 		Uuid: filesArtifactUuid,
 	}
 }
+
+// ==============================================================================================
+//
+//	Capture Service Traffic
+//
+// ==============================================================================================
+
+func NewCaptureServiceTrafficArgs(serviceIdentifier string, durationSeconds uint32, filter string) *kurtosis_core_rpc_api_bindings.CaptureServiceTrafficArgs {
+	return &kurtosis_core_rpc_api_bindings.CaptureServiceTrafficArgs{
+		ServiceIdentifier: serviceIdentifier,
+		DurationSeconds:   durationSeconds,
+		Filter:            &filter,
+	}
+}
+
+func NewServiceTrafficCaptureChunk(data []byte) *kurtosis_core_rpc_api_bindings.ServiceTrafficCaptureChunk {
+	return &kurtosis_core_rpc_api_bindings.ServiceTrafficCaptureChunk{
+		Data: data,
+	}
+}
+
+func NewStoreServiceTrafficCaptureArgs(serviceIdentifier string, durationSeconds uint32, filter string, name string) *kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureArgs {
+	return &kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureArgs{
+		ServiceIdentifier: serviceIdentifier,
+		DurationSeconds:   durationSeconds,
+		Filter:            &filter,
+		Name:              name,
+	}
+}
+
+func NewStoreServiceTrafficCaptureResponse(filesArtifactUuid string, filesArtifactName string) *kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureResponse {
+	return &kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureResponse{
+		Uuid: filesArtifactUuid,
+		Name: filesArtifactName,
+	}
+}
+
+// ==============================================================================================
+//
+//	Get Traffic Statistics
+//
+// ==============================================================================================
+
+func NewGetTrafficStatisticsArgs(serviceIdentifier string) *kurtosis_core_rpc_api_bindings.GetTrafficStatisticsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetTrafficStatisticsArgs{
+		ServiceIdentifier: &serviceIdentifier,
+	}
+}
+
+func NewLinkTrafficStatistics(
+	sourceServiceName string,
+	destinationServiceName string,
+	sentBytes uint64,
+	sentPackets uint64,
+	droppedPackets uint64,
+) *kurtosis_core_rpc_api_bindings.LinkTrafficStatistics {
+	return &kurtosis_core_rpc_api_bindings.LinkTrafficStatistics{
+		SourceServiceName:      sourceServiceName,
+		DestinationServiceName: destinationServiceName,
+		SentBytes:              sentBytes,
+		SentPackets:            sentPackets,
+		DroppedPackets:         droppedPackets,
+	}
+}
+
+func NewGetTrafficStatisticsResponse(linkTrafficStatistics []*kurtosis_core_rpc_api_bindings.LinkTrafficStatistics) *kurtosis_core_rpc_api_bindings.GetTrafficStatisticsResponse {
+	return &kurtosis_core_rpc_api_bindings.GetTrafficStatisticsResponse{
+		LinkTrafficStatistics: linkTrafficStatistics,
+	}
+}
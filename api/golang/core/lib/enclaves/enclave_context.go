@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"io"
 	"path"
+	"time"
 )
 
 type EnclaveUUID string
@@ -101,10 +102,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScriptBlocking(ctx context.Context,
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkpackagestring-packagerootpath-string-serializedparams-boolean-dryrun---streamstarlarkrunresponseline-responselines-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packageRootPath string, serializedParams string, runOptions *StarlarkRunOptions) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, serializedParams, dryRun, parallelism)
+	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, serializedParams, runOptions)
 	if err != nil {
 		cancelCtxFunc() // manually call the cancel function as something went wrong
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package for execution '%v'", packageRootPath)
@@ -121,8 +122,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packag
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkpackageblockingstring-packagerootpath-string-serializedparams-boolean-dryrun---starlarkrunresult-runresult-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, serializedParams, dryRun, parallelism)
+func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context, packageRootPath string, serializedParams string, runOptions *StarlarkRunOptions) (*StarlarkRunResult, error) {
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, serializedParams, runOptions)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running Starlark package")
 	}
@@ -130,10 +131,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkremotepackagestring-packageid-string-serializedparams-boolean-dryrun---streamstarlarkrunresponseline-responselines-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context, packageId string, serializedParams string, runOptions *StarlarkRunOptions) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, serializedParams, dryRun, parallelism)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, serializedParams, runOptions.GetDryRun(), runOptions.GetParallelism(), runOptions.GetResume(), runOptions.GetApply(), runOptions.GetRemoveUndeclaredServices())
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -146,14 +147,39 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context,
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkremotepackageblockingstring-packageid-string-serializedparams-boolean-dryrun---starlarkrunresult-runresult-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageBlocking(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism)
+func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageBlocking(ctx context.Context, packageId string, serializedParams string, runOptions *StarlarkRunOptions) (*StarlarkRunResult, error) {
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, runOptions)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running remote Starlark package")
 	}
 	return ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLineChan), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#runstarlarkpackageteststring-packagerootpath---starlarktestresult-testresults-error-error
+func (enclaveCtx *EnclaveContext) RunStarlarkPackageTests(ctx context.Context, packageRootPath string) ([]*kurtosis_core_rpc_api_bindings.StarlarkTestResult, error) {
+	kurtosisYaml, compressedPackage, err := compressPackage(packageRootPath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error preparing package '%v' for upload", packageRootPath)
+	}
+	logrus.Infof("Uploading and testing package '%v'", kurtosisYaml.PackageName)
+	args := binding_constructors.NewRunStarlarkPackageTestsArgs(kurtosisYaml.PackageName, compressedPackage)
+	response, err := enclaveCtx.client.RunStarlarkPackageTests(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of Starlark package '%v'", packageRootPath)
+	}
+	return response.GetTestResults(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#runstarlarkremotepackageteststring-packageid---starlarktestresult-testresults-error-error
+func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageTests(ctx context.Context, packageId string) ([]*kurtosis_core_rpc_api_bindings.StarlarkTestResult, error) {
+	args := binding_constructors.NewRunStarlarkRemotePackageTestsArgs(packageId)
+	response, err := enclaveCtx.client.RunStarlarkPackageTests(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of Starlark package '%v'", packageId)
+	}
+	return response.GetTestResults(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#getservicecontextstring-serviceidentifier---servicecontext-servicecontext
 func (enclaveCtx *EnclaveContext) GetServiceContext(serviceIdentifier string) (*services.ServiceContext, error) {
 	serviceIdentifierMapForArgs := map[string]bool{serviceIdentifier: true}
@@ -189,6 +215,7 @@ func (enclaveCtx *EnclaveContext) GetServiceContext(serviceIdentifier string) (*
 		services.ServiceName(serviceIdentifier),
 		services.ServiceUUID(serviceInfo.ServiceUuid),
 		serviceInfo.GetPrivateIpAddr(),
+		serviceInfo.GetPrivateIpv6Addr(),
 		serviceCtxPrivatePorts,
 		serviceInfo.GetMaybePublicIpAddr(),
 		serviceCtxPublicPorts,
@@ -260,6 +287,73 @@ func (enclaveCtx *EnclaveContext) GetExistingAndHistoricalServiceIdentifiers(ctx
 	return services.NewServiceIdentifiers(enclaveCtx.enclaveName, response.AllIdentifiers), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#cancelconnectionchangesstring-name
+func (enclaveCtx *EnclaveContext) CancelConnectionChanges(ctx context.Context, name string) error {
+	args := binding_constructors.NewCancelConnectionChangesArgs(name)
+	if _, err := enclaveCtx.client.CancelConnectionChanges(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred cancelling connection changes '%v'", name)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#watchconnectionchangesstring-name---streamconnectionchangeevent-events-error-error
+func (enclaveCtx *EnclaveContext) WatchConnectionChanges(ctx context.Context, name string) (chan *kurtosis_core_rpc_api_bindings.ConnectionChangeEvent, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	args := binding_constructors.NewWatchConnectionChangesArgs(name)
+	connectionChangeEventChan := make(chan *kurtosis_core_rpc_api_bindings.ConnectionChangeEvent)
+
+	stream, err := enclaveCtx.client.WatchConnectionChanges(ctxWithCancel, args)
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, stacktrace.Propagate(err, "An error occurred watching connection changes '%v'", name)
+	}
+
+	go runReceiveConnectionChangeEventRoutine(cancelCtxFunc, stream, connectionChangeEventChan)
+	return connectionChangeEventChan, cancelCtxFunc, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#captureservicetrafficstring-serviceidentifier-duration-duration-string-filter-writer-output
+func (enclaveCtx *EnclaveContext) CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error {
+	args := binding_constructors.NewCaptureServiceTrafficArgs(serviceIdentifier, uint32(duration.Seconds()), filter)
+	stream, err := enclaveCtx.client.CaptureServiceTraffic(ctx, args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred capturing the traffic of service '%v'", serviceIdentifier)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the traffic capture of service '%v'", serviceIdentifier)
+		}
+		if _, err = output.Write(chunk.GetData()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the traffic capture of service '%v' to the output", serviceIdentifier)
+		}
+	}
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#storeservicetrafficcapturestring-serviceidentifier-duration-duration-string-filter-string-artifactname---filesartifactuuid-filesartifactuuid-fileartifactname-fileartifactname
+func (enclaveCtx *EnclaveContext) StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (services.FilesArtifactUUID, services.FileArtifactName, error) {
+	args := binding_constructors.NewStoreServiceTrafficCaptureArgs(serviceIdentifier, uint32(duration.Seconds()), filter, artifactName)
+	response, err := enclaveCtx.client.StoreServiceTrafficCapture(ctx, args)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred storing the traffic capture of service '%v'", serviceIdentifier)
+	}
+	return services.FilesArtifactUUID(response.GetUuid()), services.FileArtifactName(response.GetName()), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#gettrafficstatisticsstring-serviceidentifier---linktrafficstatistics-linktrafficstatistics
+func (enclaveCtx *EnclaveContext) GetTrafficStatistics(ctx context.Context, serviceIdentifier string) ([]*kurtosis_core_rpc_api_bindings.LinkTrafficStatistics, error) {
+	args := binding_constructors.NewGetTrafficStatisticsArgs(serviceIdentifier)
+	response, err := enclaveCtx.client.GetTrafficStatistics(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the traffic statistics for service identifier '%v'", serviceIdentifier)
+	}
+	return response.GetLinkTrafficStatistics(), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -318,19 +412,48 @@ func runReceiveStarlarkResponseLineRoutine(cancelCtxFunc context.CancelFunc, str
 	}
 }
 
-func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
+func runReceiveConnectionChangeEventRoutine(cancelCtxFunc context.CancelFunc, stream grpc.ClientStream, connectionChangeEventChan chan *kurtosis_core_rpc_api_bindings.ConnectionChangeEvent) {
+	defer func() {
+		close(connectionChangeEventChan)
+		cancelCtxFunc()
+	}()
+	for {
+		event := new(kurtosis_core_rpc_api_bindings.ConnectionChangeEvent)
+		err := stream.RecvMsg(event)
+		if err == io.EOF {
+			logrus.Debugf("Successfully reached the end of the connection change event stream. Closing.")
+			return
+		}
+		if err != nil {
+			logrus.Errorf("Unexpected error happened reading the stream. Client might have cancelled the stream\n%v", err.Error())
+			return
+		}
+		connectionChangeEventChan <- event
+	}
+}
+
+func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(packageRootPath string, serializedParams string, runOptions *StarlarkRunOptions) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
+	kurtosisYaml, compressedModule, err := compressPackage(packageRootPath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "There was an error preparing package '%v' for upload", packageRootPath)
+	}
+	logrus.Infof("Uploading and executing package '%v'", kurtosisYaml.PackageName)
+	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, compressedModule, serializedParams, runOptions.GetDryRun(), runOptions.GetParallelism(), runOptions.GetResume(), runOptions.GetApply(), runOptions.GetRemoveUndeclaredServices()), nil
+}
+
+// compressPackage returns the kurtosis.yml of the package along with its compressed content, ready to be uploaded
+func compressPackage(packageRootPath string) (*KurtosisYaml, []byte, error) {
 	kurtosisYamlFilepath := path.Join(packageRootPath, kurtosisYamlFilename)
 
 	kurtosisYaml, err := parseKurtosisYaml(kurtosisYamlFilepath)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "There was an error parsing the '%v' at '%v'", kurtosisYamlFilename, packageRootPath)
+		return nil, nil, stacktrace.Propagate(err, "There was an error parsing the '%v' at '%v'", kurtosisYamlFilename, packageRootPath)
 	}
 
 	logrus.Infof("Compressing package '%v' at '%v' for upload", kurtosisYaml.PackageName, packageRootPath)
 	compressedModule, err := shared_utils.CompressPath(packageRootPath, ensureCompressedFileIsLesserThanGRPCLimit)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "There was an error compressing module '%v' before upload", packageRootPath)
+		return nil, nil, stacktrace.Propagate(err, "There was an error compressing module '%v' before upload", packageRootPath)
 	}
-	logrus.Infof("Uploading and executing package '%v'", kurtosisYaml.PackageName)
-	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, compressedModule, serializedParams, dryRun, parallelism), nil
+	return kurtosisYaml, compressedModule, nil
 }
@@ -0,0 +1,92 @@
+package enclaves
+
+const (
+	defaultStarlarkRunParallelism = 4
+)
+
+// StarlarkRunOptions are the options of a Starlark package run, built with a StarlarkRunOptionsBuilder
+type StarlarkRunOptions struct {
+	dryRun                   bool
+	parallelism              int32
+	resume                   bool
+	apply                    bool
+	removeUndeclaredServices bool
+}
+
+func (options *StarlarkRunOptions) GetDryRun() bool {
+	return options.dryRun
+}
+
+func (options *StarlarkRunOptions) GetParallelism() int32 {
+	return options.parallelism
+}
+
+func (options *StarlarkRunOptions) GetResume() bool {
+	return options.resume
+}
+
+func (options *StarlarkRunOptions) GetApply() bool {
+	return options.apply
+}
+
+func (options *StarlarkRunOptions) GetRemoveUndeclaredServices() bool {
+	return options.removeUndeclaredServices
+}
+
+type StarlarkRunOptionsBuilder struct {
+	dryRun                   bool
+	parallelism              int32
+	resume                   bool
+	apply                    bool
+	removeUndeclaredServices bool
+}
+
+// NewStarlarkRunOptionsBuilder returns a builder of the options of a regular run, which executes every instruction of
+// the package with the default parallelism
+func NewStarlarkRunOptionsBuilder() *StarlarkRunOptionsBuilder {
+	return &StarlarkRunOptionsBuilder{
+		dryRun:                   false,
+		parallelism:              defaultStarlarkRunParallelism,
+		resume:                   false,
+		apply:                    false,
+		removeUndeclaredServices: false,
+	}
+}
+
+func (builder *StarlarkRunOptionsBuilder) WithDryRun(dryRun bool) *StarlarkRunOptionsBuilder {
+	builder.dryRun = dryRun
+	return builder
+}
+
+func (builder *StarlarkRunOptionsBuilder) WithParallelism(parallelism int32) *StarlarkRunOptionsBuilder {
+	builder.parallelism = parallelism
+	return builder
+}
+
+// WithResume skips the instructions the last run of the package already applied to the enclave
+func (builder *StarlarkRunOptionsBuilder) WithResume(resume bool) *StarlarkRunOptionsBuilder {
+	builder.resume = resume
+	return builder
+}
+
+// WithApply only executes the instructions bringing changes to the current state of the enclave
+func (builder *StarlarkRunOptionsBuilder) WithApply(apply bool) *StarlarkRunOptionsBuilder {
+	builder.apply = apply
+	return builder
+}
+
+// WithRemoveUndeclaredServices also removes the services the applied package doesn't declare anymore
+func (builder *StarlarkRunOptionsBuilder) WithRemoveUndeclaredServices(removeUndeclaredServices bool) *StarlarkRunOptionsBuilder {
+	builder.removeUndeclaredServices = removeUndeclaredServices
+	return builder
+}
+
+func (builder *StarlarkRunOptionsBuilder) Build() *StarlarkRunOptions {
+	return &StarlarkRunOptions{
+		dryRun:                   builder.dryRun,
+		parallelism:              builder.parallelism,
+		resume:                   builder.resume,
+		apply:                    builder.apply,
+		removeUndeclaredServices: builder.removeUndeclaredServices,
+	}
+}
@@ -27,6 +27,7 @@ type ServiceConfigBuilder struct {
 	memoryAllocationMegabytes  uint64
 	privateIPAddrPlaceholder   string
 	subnetwork                 string
+	hostnames                  []string
 }
 
 func NewServiceConfigBuilder(containerImageName string) *ServiceConfigBuilder {
@@ -42,6 +43,7 @@ func NewServiceConfigBuilder(containerImageName string) *ServiceConfigBuilder {
 		memoryAllocationMegabytes:  0,
 		privateIPAddrPlaceholder:   defaultPrivateIPAddrPlaceholder,
 		subnetwork:                 defaultSubnetwork,
+		hostnames:                  nil,
 	}
 }
 
@@ -60,6 +62,7 @@ func NewServiceConfigBuilderFromServiceConfig(serviceConfig *kurtosis_core_rpc_a
 		memoryAllocationMegabytes:  serviceConfig.MemoryAllocationMegabytes,
 		privateIPAddrPlaceholder:   serviceConfig.PrivateIpAddrPlaceholder,
 		subnetwork:                 *serviceConfig.Subnetwork,
+		hostnames:                  copySlice(serviceConfig.Hostnames),
 	}
 }
 
@@ -108,6 +111,12 @@ func (builder *ServiceConfigBuilder) WithSubnetwork(subnetwork string) *ServiceC
 	return builder
 }
 
+// WithHostnames sets the additional hostnames the service can be reached at inside the enclave, on top of its name
+func (builder *ServiceConfigBuilder) WithHostnames(hostnames []string) *ServiceConfigBuilder {
+	builder.hostnames = copySlice(hostnames)
+	return builder
+}
+
 func (builder *ServiceConfigBuilder) WithCpuAllocationMillicpus(cpuAllocationMillicpus uint64) *ServiceConfigBuilder {
 	builder.cpuAllocationMillicpus = cpuAllocationMillicpus
 	return builder
@@ -131,6 +140,7 @@ func (builder *ServiceConfigBuilder) Build() *kurtosis_core_rpc_api_bindings.Ser
 		builder.memoryAllocationMegabytes,
 		builder.privateIPAddrPlaceholder,
 		builder.subnetwork,
+		builder.hostnames,
 	)
 }
 
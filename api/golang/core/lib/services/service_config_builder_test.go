@@ -38,6 +38,8 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 		"<IP_ADDRESS>",
 	).WithSubnetwork(
 		"subnetwork_1",
+	).WithHostnames(
+		[]string{"database"},
 	).Build()
 
 	newServiceConfigBuilder := NewServiceConfigBuilderFromServiceConfig(initialServiceConfig)
@@ -57,6 +59,7 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	newServiceConfig.MemoryAllocationMegabytes = 512
 	newSubnetwork := "new-subnetwork"
 	newServiceConfig.Subnetwork = &newSubnetwork
+	newServiceConfig.Hostnames[0] = "new-database"
 
 	// test that initial value has not changed
 	require.Equal(t, "test-image", initialServiceConfig.ContainerImageName)
@@ -69,6 +72,7 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	require.Equal(t, uint64(1000), initialServiceConfig.CpuAllocationMillicpus)
 	require.Equal(t, uint64(1024), initialServiceConfig.MemoryAllocationMegabytes)
 	require.Equal(t, "subnetwork_1", *initialServiceConfig.Subnetwork)
+	require.Equal(t, []string{"database"}, initialServiceConfig.Hostnames)
 
 	// test that new value are as expected
 	require.Equal(t, "new-test-image", newServiceConfig.ContainerImageName)
@@ -81,4 +85,5 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	require.Equal(t, uint64(500), newServiceConfig.CpuAllocationMillicpus)
 	require.Equal(t, uint64(512), newServiceConfig.MemoryAllocationMegabytes)
 	require.Equal(t, "new-subnetwork", *newServiceConfig.Subnetwork)
+	require.Equal(t, []string{"new-database"}, newServiceConfig.Hostnames)
 }
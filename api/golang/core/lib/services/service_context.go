@@ -31,8 +31,9 @@ type ServiceContext struct {
 	serviceUuid ServiceUUID
 
 	// Network location inside the enclave
-	privateIpAddr string
-	privatePorts  map[string]*PortSpec
+	privateIpAddr   string
+	privateIpv6Addr string // Empty if the enclave isn't an IPv6 enclave
+	privatePorts    map[string]*PortSpec
 
 	// Network location outside the enclave
 	publicIpAddr string
@@ -44,18 +45,20 @@ func NewServiceContext(
 	serviceName ServiceName,
 	serviceUuid ServiceUUID,
 	privateIpAddr string,
+	privateIpv6Addr string,
 	privatePorts map[string]*PortSpec,
 	publicIpAddr string,
 	publicPorts map[string]*PortSpec,
 ) *ServiceContext {
 	return &ServiceContext{
-		client:        client,
-		serviceName:   serviceName,
-		serviceUuid:   serviceUuid,
-		privateIpAddr: privateIpAddr,
-		privatePorts:  privatePorts,
-		publicIpAddr:  publicIpAddr,
-		publicPorts:   publicPorts,
+		client:          client,
+		serviceName:     serviceName,
+		serviceUuid:     serviceUuid,
+		privateIpAddr:   privateIpAddr,
+		privateIpv6Addr: privateIpv6Addr,
+		privatePorts:    privatePorts,
+		publicIpAddr:    publicIpAddr,
+		publicPorts:     publicPorts,
 	}
 }
 
@@ -74,6 +77,11 @@ func (service *ServiceContext) GetPrivateIPAddress() string {
 	return service.privateIpAddr
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#getprivateipv6address---string
+func (service *ServiceContext) GetPrivateIPv6Address() string {
+	return service.privateIpv6Addr
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#getprivateports---mapportid-portspec
 func (service *ServiceContext) GetPrivatePorts() map[string]*PortSpec {
 	return service.privatePorts
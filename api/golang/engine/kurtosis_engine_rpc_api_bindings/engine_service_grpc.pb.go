@@ -36,12 +36,27 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts again all containers of a stopped enclave
+	StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Takes a snapshot of an enclave, streamed back as the chunks of a gzipped TAR archive
+	SnapshotEnclave(ctx context.Context, in *SnapshotEnclaveArgs, opts ...grpc.CallOption) (EngineService_SnapshotEnclaveClient, error)
+	// Creates a new enclave reproducing the state of an enclave snapshot, streamed as the chunks of the snapshot archive
+	RestoreEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_RestoreEnclaveClient, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// ==============================================================================================
+	//                                   Port Forwards
+	// ==============================================================================================
+	// Forwards a local port of the machine running the engine to a private port of a service
+	CreatePortForward(ctx context.Context, in *CreatePortForwardArgs, opts ...grpc.CallOption) (*CreatePortForwardResponse, error)
+	// Returns information about the existing port forwards
+	GetPortForwards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPortForwardsResponse, error)
+	// Stops forwarding a local port
+	DestroyPortForward(ctx context.Context, in *DestroyPortForwardArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type engineServiceClient struct {
@@ -97,6 +112,81 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/engine_api.EngineService/StartEnclave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) SnapshotEnclave(ctx context.Context, in *SnapshotEnclaveArgs, opts ...grpc.CallOption) (EngineService_SnapshotEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], "/engine_api.EngineService/SnapshotEnclave", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceSnapshotEnclaveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_SnapshotEnclaveClient interface {
+	Recv() (*EnclaveSnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type engineServiceSnapshotEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceSnapshotEnclaveClient) Recv() (*EnclaveSnapshotChunk, error) {
+	m := new(EnclaveSnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) RestoreEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_RestoreEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], "/engine_api.EngineService/RestoreEnclave", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceRestoreEnclaveClient{stream}
+	return x, nil
+}
+
+type EngineService_RestoreEnclaveClient interface {
+	Send(*RestoreEnclaveArgs) error
+	CloseAndRecv() (*RestoreEnclaveResponse, error)
+	grpc.ClientStream
+}
+
+type engineServiceRestoreEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceRestoreEnclaveClient) Send(m *RestoreEnclaveArgs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *engineServiceRestoreEnclaveClient) CloseAndRecv() (*RestoreEnclaveResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreEnclaveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/engine_api.EngineService/DestroyEnclave", in, out, opts...)
@@ -116,7 +206,7 @@ func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...
 }
 
 func (c *engineServiceClient) GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], "/engine_api.EngineService/GetServiceLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[2], "/engine_api.EngineService/GetServiceLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +237,33 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) CreatePortForward(ctx context.Context, in *CreatePortForwardArgs, opts ...grpc.CallOption) (*CreatePortForwardResponse, error) {
+	out := new(CreatePortForwardResponse)
+	err := c.cc.Invoke(ctx, "/engine_api.EngineService/CreatePortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetPortForwards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPortForwardsResponse, error) {
+	out := new(GetPortForwardsResponse)
+	err := c.cc.Invoke(ctx, "/engine_api.EngineService/GetPortForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DestroyPortForward(ctx context.Context, in *DestroyPortForwardArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/engine_api.EngineService/DestroyPortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -164,12 +281,27 @@ type EngineServiceServer interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Starts again all containers of a stopped enclave
+	StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error)
+	// Takes a snapshot of an enclave, streamed back as the chunks of a gzipped TAR archive
+	SnapshotEnclave(*SnapshotEnclaveArgs, EngineService_SnapshotEnclaveServer) error
+	// Creates a new enclave reproducing the state of an enclave snapshot, streamed as the chunks of the snapshot archive
+	RestoreEnclave(EngineService_RestoreEnclaveServer) error
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// ==============================================================================================
+	//                                   Port Forwards
+	// ==============================================================================================
+	// Forwards a local port of the machine running the engine to a private port of a service
+	CreatePortForward(context.Context, *CreatePortForwardArgs) (*CreatePortForwardResponse, error)
+	// Returns information about the existing port forwards
+	GetPortForwards(context.Context, *emptypb.Empty) (*GetPortForwardsResponse, error)
+	// Stops forwarding a local port
+	DestroyPortForward(context.Context, *DestroyPortForwardArgs) (*emptypb.Empty, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -191,6 +323,15 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) SnapshotEnclave(*SnapshotEnclaveArgs, EngineService_SnapshotEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method SnapshotEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) RestoreEnclave(EngineService_RestoreEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
@@ -200,6 +341,15 @@ func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*Cle
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) CreatePortForward(context.Context, *CreatePortForwardArgs) (*CreatePortForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePortForward not implemented")
+}
+func (UnimplementedEngineServiceServer) GetPortForwards(context.Context, *emptypb.Empty) (*GetPortForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortForwards not implemented")
+}
+func (UnimplementedEngineServiceServer) DestroyPortForward(context.Context, *DestroyPortForwardArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyPortForward not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -302,6 +452,71 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StartEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).StartEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/engine_api.EngineService/StartEnclave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).StartEnclave(ctx, req.(*StartEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_SnapshotEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SnapshotEnclaveArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).SnapshotEnclave(m, &engineServiceSnapshotEnclaveServer{stream})
+}
+
+type EngineService_SnapshotEnclaveServer interface {
+	Send(*EnclaveSnapshotChunk) error
+	grpc.ServerStream
+}
+
+type engineServiceSnapshotEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceSnapshotEnclaveServer) Send(m *EnclaveSnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_RestoreEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EngineServiceServer).RestoreEnclave(&engineServiceRestoreEnclaveServer{stream})
+}
+
+type EngineService_RestoreEnclaveServer interface {
+	SendAndClose(*RestoreEnclaveResponse) error
+	Recv() (*RestoreEnclaveArgs, error)
+	grpc.ServerStream
+}
+
+type engineServiceRestoreEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceRestoreEnclaveServer) SendAndClose(m *RestoreEnclaveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *engineServiceRestoreEnclaveServer) Recv() (*RestoreEnclaveArgs, error) {
+	m := new(RestoreEnclaveArgs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -359,6 +574,60 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_CreatePortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePortForwardArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CreatePortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/engine_api.EngineService/CreatePortForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CreatePortForward(ctx, req.(*CreatePortForwardArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetPortForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetPortForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/engine_api.EngineService/GetPortForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetPortForwards(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DestroyPortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyPortForwardArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DestroyPortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/engine_api.EngineService/DestroyPortForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DestroyPortForward(ctx, req.(*DestroyPortForwardArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -386,6 +655,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEnclave",
 			Handler:    _EngineService_StopEnclave_Handler,
 		},
+		{
+			MethodName: "StartEnclave",
+			Handler:    _EngineService_StartEnclave_Handler,
+		},
 		{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
@@ -394,8 +667,30 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "CreatePortForward",
+			Handler:    _EngineService_CreatePortForward_Handler,
+		},
+		{
+			MethodName: "GetPortForwards",
+			Handler:    _EngineService_GetPortForwards_Handler,
+		},
+		{
+			MethodName: "DestroyPortForward",
+			Handler:    _EngineService_DestroyPortForward_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SnapshotEnclave",
+			Handler:       _EngineService_SnapshotEnclave_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreEnclave",
+			Handler:       _EngineService_RestoreEnclave_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetServiceLogs",
 			Handler:       _EngineService_GetServiceLogs_Handler,
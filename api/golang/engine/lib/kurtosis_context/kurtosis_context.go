@@ -34,10 +34,19 @@ const (
 	grpcStreamCancelContextErrorMessage = "rpc error: code = Canceled desc = context canceled"
 
 	validUuidMatchesAllowed = 1
+
+	// Enclave snapshots are streamed in chunks below the default max gRPC message size
+	snapshotChunkSizeBytes = 3 * 1024 * 1024
+
+	isIpv6DisabledByDefault = false
+	isIpv6Enabled           = true
 )
 
 var apiContainerLogLevel = logrus.DebugLevel
 
+// The packages of the enclaves created without Git credentials can only be fetched from public repositories
+var noGitCredentials = map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials{}
+
 // Docs available at https://docs.kurtosis.com/sdk#kurtosiscontext
 type KurtosisContext struct {
 	client kurtosis_engine_rpc_api_bindings.EngineServiceClient
@@ -77,24 +86,37 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(
 	enclaveName string,
 	isPartitioningEnabled bool,
 ) (*enclaves.EnclaveContext, error) {
-
-	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
-		EnclaveName:            enclaveName,
-		ApiContainerVersionTag: defaultApiContainerVersionTag,
-		ApiContainerLogLevel:   apiContainerLogLevel.String(),
-		IsPartitioningEnabled:  isPartitioningEnabled,
+	enclaveContext, err := kurtosisCtx.createEnclave(ctx, enclaveName, isPartitioningEnabled, isIpv6DisabledByDefault, noGitCredentials)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
 	}
+	return enclaveContext, nil
+}
 
-	response, err := kurtosisCtx.client.CreateEnclave(ctx, createEnclaveArgs)
+// Docs available at https://docs.kurtosis.com/sdk#createipv6enclavestring-enclavename-boolean-ispartitioningenabled---enclavecontextenclavecontext-enclavecontext
+func (kurtosisCtx *KurtosisContext) CreateIpv6Enclave(
+	ctx context.Context,
+	enclaveName string,
+	isPartitioningEnabled bool,
+) (*enclaves.EnclaveContext, error) {
+	enclaveContext, err := kurtosisCtx.createEnclave(ctx, enclaveName, isPartitioningEnabled, isIpv6Enabled, noGitCredentials)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+		return nil, stacktrace.Propagate(err, "An error occurred creating IPv6 enclave '%v'", enclaveName)
 	}
+	return enclaveContext, nil
+}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(response.EnclaveInfo)
+// Docs available at https://docs.kurtosis.com/sdk#createenclavewithgitcredentialsstring-enclavename-boolean-ispartitioningenabled-mapstring-gitcredentials-gitcredentialspergithost---enclavecontextenclavecontext-enclavecontext
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithGitCredentials(
+	ctx context.Context,
+	enclaveName string,
+	isPartitioningEnabled bool,
+	gitCredentialsPerGitHost map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials,
+) (*enclaves.EnclaveContext, error) {
+	enclaveContext, err := kurtosisCtx.createEnclave(ctx, enclaveName, isPartitioningEnabled, isIpv6DisabledByDefault, gitCredentialsPerGitHost)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' with Git credentials", enclaveName)
 	}
-
 	return enclaveContext, nil
 }
 
@@ -186,6 +208,99 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 	return nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#startenclavestring-enclaveidentifier
+func (kurtosisCtx *KurtosisContext) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	startEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	if _, err := kurtosisCtx.client.StartEnclave(ctx, startEnclaveArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#snapshotenclavestring-enclaveidentifier-writer-output
+func (kurtosisCtx *KurtosisContext) SnapshotEnclave(ctx context.Context, enclaveIdentifier string, output io.Writer) error {
+	snapshotEnclaveArgs := &kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	stream, err := kurtosisCtx.client.SnapshotEnclave(ctx, snapshotEnclaveArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred taking a snapshot of enclave with identifier '%v'", enclaveIdentifier)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the snapshot of enclave with identifier '%v'", enclaveIdentifier)
+		}
+		if _, err = output.Write(chunk.GetContent()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the snapshot of enclave with identifier '%v'", enclaveIdentifier)
+		}
+	}
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#restoreenclavestring-enclavename-reader-snapshot---enclavecontextenclavecontext-enclavecontext
+func (kurtosisCtx *KurtosisContext) RestoreEnclave(
+	ctx context.Context,
+	enclaveName string,
+	snapshot io.Reader,
+) (*enclaves.EnclaveContext, error) {
+	stream, err := kurtosisCtx.client.RestoreEnclave(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the stream to restore the enclave snapshot")
+	}
+
+	// The enclave settings are sent in the first message, and the snapshot in the following ones
+	restoreEnclaveArgs := &kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs{
+		EnclaveName:            enclaveName,
+		ApiContainerVersionTag: defaultApiContainerVersionTag,
+		ApiContainerLogLevel:   apiContainerLogLevel.String(),
+		SnapshotChunk:          nil,
+	}
+	if err = stream.Send(restoreEnclaveArgs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the settings of the enclave to restore")
+	}
+	chunk := make([]byte, snapshotChunkSizeBytes)
+	for {
+		readBytes, readErr := io.ReadFull(snapshot, chunk)
+		if readBytes > 0 {
+			snapshotChunkArgs := &kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs{
+				EnclaveName:            "",
+				ApiContainerVersionTag: "",
+				ApiContainerLogLevel:   "",
+				SnapshotChunk:          chunk[:readBytes],
+			}
+			if err = stream.Send(snapshotChunkArgs); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred sending the enclave snapshot")
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, stacktrace.Propagate(readErr, "An error occurred reading the enclave snapshot")
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot")
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the restored enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
@@ -277,11 +392,85 @@ func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(c
 	return newEnclaveIdentifiers(historicalEnclaveIdentifiers.AllIdentifiers), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#createportforwardstring-enclaveidentifier-string-serviceidentifier-string-portid-uint32-localportnumber---portforwardinfo-portforwardinfo
+func (kurtosisCtx *KurtosisContext) CreatePortForward(
+	ctx context.Context,
+	enclaveIdentifier string,
+	serviceIdentifier string,
+	portId string,
+	localPortNumber uint32,
+) (*kurtosis_engine_rpc_api_bindings.PortForwardInfo, error) {
+	createPortForwardArgs := &kurtosis_engine_rpc_api_bindings.CreatePortForwardArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ServiceIdentifier: serviceIdentifier,
+		PortId:            portId,
+		LocalPortNumber:   localPortNumber,
+	}
+	response, err := kurtosisCtx.client.CreatePortForward(ctx, createPortForwardArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v' in enclave '%v'", portId, serviceIdentifier, enclaveIdentifier)
+	}
+
+	return response.GetPortForwardInfo(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#getportforwards---mapuint32-portforwardinfo-portforwardinfos
+func (kurtosisCtx *KurtosisContext) GetPortForwards(ctx context.Context) (map[uint32]*kurtosis_engine_rpc_api_bindings.PortForwardInfo, error) {
+	response, err := kurtosisCtx.client.GetPortForwards(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the port forwards")
+	}
+
+	return response.GetPortForwardInfo(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#destroyportforwarduint32-localportnumber
+func (kurtosisCtx *KurtosisContext) DestroyPortForward(ctx context.Context, localPortNumber uint32) error {
+	destroyPortForwardArgs := &kurtosis_engine_rpc_api_bindings.DestroyPortForwardArgs{
+		LocalPortNumber: localPortNumber,
+	}
+
+	if _, err := kurtosisCtx.client.DestroyPortForward(ctx, destroyPortForwardArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the port forward of local port '%v'", localPortNumber)
+	}
+
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+func (kurtosisCtx *KurtosisContext) createEnclave(
+	ctx context.Context,
+	enclaveName string,
+	isPartitioningEnabled bool,
+	isIpv6Enabled bool,
+	gitCredentialsPerGitHost map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials,
+) (*enclaves.EnclaveContext, error) {
+	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
+		EnclaveName:              enclaveName,
+		ApiContainerVersionTag:   defaultApiContainerVersionTag,
+		ApiContainerLogLevel:     apiContainerLogLevel.String(),
+		IsPartitioningEnabled:    isPartitioningEnabled,
+		IsIpv6Enabled:            isIpv6Enabled,
+		GitCredentialsPerGitHost: gitCredentialsPerGitHost,
+	}
+
+	response, err := kurtosisCtx.client.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
 func runReceiveStreamLogsFromTheServerRoutine(
 	cancelCtxFunc context.CancelFunc,
 	enclaveIdentifier string,
@@ -10,11 +10,14 @@ import * as grpc from "@grpc/grpc-js";
 interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
   runStarlarkScript: grpc.MethodDefinition<api_container_service_pb.RunStarlarkScriptArgs, api_container_service_pb.StarlarkRunResponseLine>;
   runStarlarkPackage: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  runStarlarkPackageTests: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageTestsArgs, api_container_service_pb.RunStarlarkPackageTestsResponse>;
   startServices: grpc.MethodDefinition<api_container_service_pb.StartServicesArgs, api_container_service_pb.StartServicesResponse>;
   getServices: grpc.MethodDefinition<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
   removeService: grpc.MethodDefinition<api_container_service_pb.RemoveServiceArgs, api_container_service_pb.RemoveServiceResponse>;
   repartition: grpc.MethodDefinition<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  cancelConnectionChanges: grpc.MethodDefinition<api_container_service_pb.CancelConnectionChangesArgs, google_protobuf_empty_pb.Empty>;
+  watchConnectionChanges: grpc.MethodDefinition<api_container_service_pb.WatchConnectionChangesArgs, api_container_service_pb.ConnectionChangeEvent>;
   execCommand: grpc.MethodDefinition<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   pauseService: grpc.MethodDefinition<api_container_service_pb.PauseServiceArgs, google_protobuf_empty_pb.Empty>;
   unpauseService: grpc.MethodDefinition<api_container_service_pb.UnpauseServiceArgs, google_protobuf_empty_pb.Empty>;
@@ -25,6 +28,9 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   storeWebFilesArtifact: grpc.MethodDefinition<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.MethodDefinition<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   renderTemplatesToFilesArtifact: grpc.MethodDefinition<api_container_service_pb.RenderTemplatesToFilesArtifactArgs, api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
+  captureServiceTraffic: grpc.MethodDefinition<api_container_service_pb.CaptureServiceTrafficArgs, api_container_service_pb.ServiceTrafficCaptureChunk>;
+  storeServiceTrafficCapture: grpc.MethodDefinition<api_container_service_pb.StoreServiceTrafficCaptureArgs, api_container_service_pb.StoreServiceTrafficCaptureResponse>;
+  getTrafficStatistics: grpc.MethodDefinition<api_container_service_pb.GetTrafficStatisticsArgs, api_container_service_pb.GetTrafficStatisticsResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -32,11 +38,14 @@ export const ApiContainerServiceService: IApiContainerServiceService;
 export interface IApiContainerServiceServer extends grpc.UntypedServiceImplementation {
   runStarlarkScript: grpc.handleServerStreamingCall<api_container_service_pb.RunStarlarkScriptArgs, api_container_service_pb.StarlarkRunResponseLine>;
   runStarlarkPackage: grpc.handleServerStreamingCall<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  runStarlarkPackageTests: grpc.handleUnaryCall<api_container_service_pb.RunStarlarkPackageTestsArgs, api_container_service_pb.RunStarlarkPackageTestsResponse>;
   startServices: grpc.handleUnaryCall<api_container_service_pb.StartServicesArgs, api_container_service_pb.StartServicesResponse>;
   getServices: grpc.handleUnaryCall<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
   removeService: grpc.handleUnaryCall<api_container_service_pb.RemoveServiceArgs, api_container_service_pb.RemoveServiceResponse>;
   repartition: grpc.handleUnaryCall<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  cancelConnectionChanges: grpc.handleUnaryCall<api_container_service_pb.CancelConnectionChangesArgs, google_protobuf_empty_pb.Empty>;
+  watchConnectionChanges: grpc.handleServerStreamingCall<api_container_service_pb.WatchConnectionChangesArgs, api_container_service_pb.ConnectionChangeEvent>;
   execCommand: grpc.handleUnaryCall<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   pauseService: grpc.handleUnaryCall<api_container_service_pb.PauseServiceArgs, google_protobuf_empty_pb.Empty>;
   unpauseService: grpc.handleUnaryCall<api_container_service_pb.UnpauseServiceArgs, google_protobuf_empty_pb.Empty>;
@@ -47,6 +56,9 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   storeWebFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.handleUnaryCall<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   renderTemplatesToFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.RenderTemplatesToFilesArtifactArgs, api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
+  captureServiceTraffic: grpc.handleServerStreamingCall<api_container_service_pb.CaptureServiceTrafficArgs, api_container_service_pb.ServiceTrafficCaptureChunk>;
+  storeServiceTrafficCapture: grpc.handleUnaryCall<api_container_service_pb.StoreServiceTrafficCaptureArgs, api_container_service_pb.StoreServiceTrafficCaptureResponse>;
+  getTrafficStatistics: grpc.handleUnaryCall<api_container_service_pb.GetTrafficStatisticsArgs, api_container_service_pb.GetTrafficStatisticsResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -55,6 +67,9 @@ export class ApiContainerServiceClient extends grpc.Client {
   runStarlarkScript(argument: api_container_service_pb.RunStarlarkScriptArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
   runStarlarkPackage(argument: api_container_service_pb.RunStarlarkPackageArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
   runStarlarkPackage(argument: api_container_service_pb.RunStarlarkPackageArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
   startServices(argument: api_container_service_pb.StartServicesArgs, callback: grpc.requestCallback<api_container_service_pb.StartServicesResponse>): grpc.ClientUnaryCall;
   startServices(argument: api_container_service_pb.StartServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StartServicesResponse>): grpc.ClientUnaryCall;
   startServices(argument: api_container_service_pb.StartServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StartServicesResponse>): grpc.ClientUnaryCall;
@@ -70,6 +85,11 @@ export class ApiContainerServiceClient extends grpc.Client {
   repartition(argument: api_container_service_pb.RepartitionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   repartition(argument: api_container_service_pb.RepartitionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   repartition(argument: api_container_service_pb.RepartitionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cancelConnectionChanges(argument: api_container_service_pb.CancelConnectionChangesArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cancelConnectionChanges(argument: api_container_service_pb.CancelConnectionChangesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cancelConnectionChanges(argument: api_container_service_pb.CancelConnectionChangesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  watchConnectionChanges(argument: api_container_service_pb.WatchConnectionChangesArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ConnectionChangeEvent>;
+  watchConnectionChanges(argument: api_container_service_pb.WatchConnectionChangesArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ConnectionChangeEvent>;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
@@ -100,4 +120,12 @@ export class ApiContainerServiceClient extends grpc.Client {
   renderTemplatesToFilesArtifact(argument: api_container_service_pb.RenderTemplatesToFilesArtifactArgs, callback: grpc.requestCallback<api_container_service_pb.RenderTemplatesToFilesArtifactResponse>): grpc.ClientUnaryCall;
   renderTemplatesToFilesArtifact(argument: api_container_service_pb.RenderTemplatesToFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RenderTemplatesToFilesArtifactResponse>): grpc.ClientUnaryCall;
   renderTemplatesToFilesArtifact(argument: api_container_service_pb.RenderTemplatesToFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RenderTemplatesToFilesArtifactResponse>): grpc.ClientUnaryCall;
+  captureServiceTraffic(argument: api_container_service_pb.CaptureServiceTrafficArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceTrafficCaptureChunk>;
+  captureServiceTraffic(argument: api_container_service_pb.CaptureServiceTrafficArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceTrafficCaptureChunk>;
+  storeServiceTrafficCapture(argument: api_container_service_pb.StoreServiceTrafficCaptureArgs, callback: grpc.requestCallback<api_container_service_pb.StoreServiceTrafficCaptureResponse>): grpc.ClientUnaryCall;
+  storeServiceTrafficCapture(argument: api_container_service_pb.StoreServiceTrafficCaptureArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreServiceTrafficCaptureResponse>): grpc.ClientUnaryCall;
+  storeServiceTrafficCapture(argument: api_container_service_pb.StoreServiceTrafficCaptureArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreServiceTrafficCaptureResponse>): grpc.ClientUnaryCall;
+  getTrafficStatistics(argument: api_container_service_pb.GetTrafficStatisticsArgs, callback: grpc.requestCallback<api_container_service_pb.GetTrafficStatisticsResponse>): grpc.ClientUnaryCall;
+  getTrafficStatistics(argument: api_container_service_pb.GetTrafficStatisticsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetTrafficStatisticsResponse>): grpc.ClientUnaryCall;
+  getTrafficStatistics(argument: api_container_service_pb.GetTrafficStatisticsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetTrafficStatisticsResponse>): grpc.ClientUnaryCall;
 }
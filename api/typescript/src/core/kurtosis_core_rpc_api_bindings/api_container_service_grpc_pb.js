@@ -4,6 +4,40 @@
 var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_api_container_api_CancelConnectionChangesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CancelConnectionChangesArgs)) {
+    throw new Error('Expected argument of type api_container_api.CancelConnectionChangesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CancelConnectionChangesArgs(buffer_arg) {
+  return api_container_service_pb.CancelConnectionChangesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_CaptureServiceTrafficArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CaptureServiceTrafficArgs)) {
+    throw new Error('Expected argument of type api_container_api.CaptureServiceTrafficArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CaptureServiceTrafficArgs(buffer_arg) {
+  return api_container_service_pb.CaptureServiceTrafficArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ConnectionChangeEvent(arg) {
+  if (!(arg instanceof api_container_service_pb.ConnectionChangeEvent)) {
+    throw new Error('Expected argument of type api_container_api.ConnectionChangeEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ConnectionChangeEvent(buffer_arg) {
+  return api_container_service_pb.ConnectionChangeEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
 
 function serialize_api_container_api_DownloadFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.DownloadFilesArtifactArgs)) {
@@ -82,6 +116,28 @@ function deserialize_api_container_api_GetServicesResponse(buffer_arg) {
   return api_container_service_pb.GetServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetTrafficStatisticsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetTrafficStatisticsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetTrafficStatisticsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetTrafficStatisticsArgs(buffer_arg) {
+  return api_container_service_pb.GetTrafficStatisticsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetTrafficStatisticsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetTrafficStatisticsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetTrafficStatisticsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetTrafficStatisticsResponse(buffer_arg) {
+  return api_container_service_pb.GetTrafficStatisticsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_PauseServiceArgs(arg) {
   if (!(arg instanceof api_container_service_pb.PauseServiceArgs)) {
     throw new Error('Expected argument of type api_container_api.PauseServiceArgs');
@@ -159,6 +215,28 @@ function deserialize_api_container_api_RunStarlarkPackageArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkPackageArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RunStarlarkPackageTestsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageTestsArgs)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageTestsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageTestsArgs(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageTestsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RunStarlarkPackageTestsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageTestsResponse)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageTestsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageTestsResponse(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageTestsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkScriptArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkScriptArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkScriptArgs');
@@ -170,6 +248,17 @@ function deserialize_api_container_api_RunStarlarkScriptArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkScriptArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ServiceTrafficCaptureChunk(arg) {
+  if (!(arg instanceof api_container_service_pb.ServiceTrafficCaptureChunk)) {
+    throw new Error('Expected argument of type api_container_api.ServiceTrafficCaptureChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ServiceTrafficCaptureChunk(buffer_arg) {
+  return api_container_service_pb.ServiceTrafficCaptureChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StarlarkRunResponseLine(arg) {
   if (!(arg instanceof api_container_service_pb.StarlarkRunResponseLine)) {
     throw new Error('Expected argument of type api_container_api.StarlarkRunResponseLine');
@@ -225,6 +314,28 @@ function deserialize_api_container_api_StoreFilesArtifactFromServiceResponse(buf
   return api_container_service_pb.StoreFilesArtifactFromServiceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_StoreServiceTrafficCaptureArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.StoreServiceTrafficCaptureArgs)) {
+    throw new Error('Expected argument of type api_container_api.StoreServiceTrafficCaptureArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_StoreServiceTrafficCaptureArgs(buffer_arg) {
+  return api_container_service_pb.StoreServiceTrafficCaptureArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_StoreServiceTrafficCaptureResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.StoreServiceTrafficCaptureResponse)) {
+    throw new Error('Expected argument of type api_container_api.StoreServiceTrafficCaptureResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_StoreServiceTrafficCaptureResponse(buffer_arg) {
+  return api_container_service_pb.StoreServiceTrafficCaptureResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StoreWebFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.StoreWebFilesArtifactArgs)) {
     throw new Error('Expected argument of type api_container_api.StoreWebFilesArtifactArgs');
@@ -302,6 +413,17 @@ function deserialize_api_container_api_WaitForHttpPostEndpointAvailabilityArgs(b
   return api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_WatchConnectionChangesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.WatchConnectionChangesArgs)) {
+    throw new Error('Expected argument of type api_container_api.WatchConnectionChangesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_WatchConnectionChangesArgs(buffer_arg) {
+  return api_container_service_pb.WatchConnectionChangesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -339,6 +461,18 @@ runStarlarkPackage: {
     responseSerialize: serialize_api_container_api_StarlarkRunResponseLine,
     responseDeserialize: deserialize_api_container_api_StarlarkRunResponseLine,
   },
+  // Runs the test functions of the Starlark test files of a package against a test plan, without touching the enclave
+runStarlarkPackageTests: {
+    path: '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    responseType: api_container_service_pb.RunStarlarkPackageTestsResponse,
+    requestSerialize: serialize_api_container_api_RunStarlarkPackageTestsArgs,
+    requestDeserialize: deserialize_api_container_api_RunStarlarkPackageTestsArgs,
+    responseSerialize: serialize_api_container_api_RunStarlarkPackageTestsResponse,
+    responseDeserialize: deserialize_api_container_api_RunStarlarkPackageTestsResponse,
+  },
   // Start services by creating containers for them
 startServices: {
     path: '/api_container_api.ApiContainerService/StartServices',
@@ -399,6 +533,31 @@ repartition: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Cancels the connection changes scheduled under the given name which are not applied yet
+cancelConnectionChanges: {
+    path: '/api_container_api.ApiContainerService/CancelConnectionChanges',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.CancelConnectionChangesArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_CancelConnectionChangesArgs,
+    requestDeserialize: deserialize_api_container_api_CancelConnectionChangesArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Streams the events of the connection changes scheduled under the given name, starting with the past ones. The
+//  stream ends once all the changes got applied or cancelled
+watchConnectionChanges: {
+    path: '/api_container_api.ApiContainerService/WatchConnectionChanges',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.WatchConnectionChangesArgs,
+    responseType: api_container_service_pb.ConnectionChangeEvent,
+    requestSerialize: serialize_api_container_api_WatchConnectionChangesArgs,
+    requestDeserialize: deserialize_api_container_api_WatchConnectionChangesArgs,
+    responseSerialize: serialize_api_container_api_ConnectionChangeEvent,
+    responseDeserialize: deserialize_api_container_api_ConnectionChangeEvent,
+  },
   // Executes the given command inside a running container
 execCommand: {
     path: '/api_container_api.ApiContainerService/ExecCommand',
@@ -520,6 +679,42 @@ renderTemplatesToFilesArtifact: {
     responseSerialize: serialize_api_container_api_RenderTemplatesToFilesArtifactResponse,
     responseDeserialize: deserialize_api_container_api_RenderTemplatesToFilesArtifactResponse,
   },
+  // Captures the traffic of a service from its networking sidecar and streams the capture in pcap format, in chunks
+captureServiceTraffic: {
+    path: '/api_container_api.ApiContainerService/CaptureServiceTraffic',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.CaptureServiceTrafficArgs,
+    responseType: api_container_service_pb.ServiceTrafficCaptureChunk,
+    requestSerialize: serialize_api_container_api_CaptureServiceTrafficArgs,
+    requestDeserialize: deserialize_api_container_api_CaptureServiceTrafficArgs,
+    responseSerialize: serialize_api_container_api_ServiceTrafficCaptureChunk,
+    responseDeserialize: deserialize_api_container_api_ServiceTrafficCaptureChunk,
+  },
+  // Captures the traffic of a service from its networking sidecar and stores the capture as a files artifact
+storeServiceTrafficCapture: {
+    path: '/api_container_api.ApiContainerService/StoreServiceTrafficCapture',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.StoreServiceTrafficCaptureArgs,
+    responseType: api_container_service_pb.StoreServiceTrafficCaptureResponse,
+    requestSerialize: serialize_api_container_api_StoreServiceTrafficCaptureArgs,
+    requestDeserialize: deserialize_api_container_api_StoreServiceTrafficCaptureArgs,
+    responseSerialize: serialize_api_container_api_StoreServiceTrafficCaptureResponse,
+    responseDeserialize: deserialize_api_container_api_StoreServiceTrafficCaptureResponse,
+  },
+  // Returns the counters of the traffic services sent to each other, as seen by the traffic control of their networking sidecars
+getTrafficStatistics: {
+    path: '/api_container_api.ApiContainerService/GetTrafficStatistics',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetTrafficStatisticsArgs,
+    responseType: api_container_service_pb.GetTrafficStatisticsResponse,
+    requestSerialize: serialize_api_container_api_GetTrafficStatisticsArgs,
+    requestDeserialize: deserialize_api_container_api_GetTrafficStatisticsArgs,
+    responseSerialize: serialize_api_container_api_GetTrafficStatisticsResponse,
+    responseDeserialize: deserialize_api_container_api_GetTrafficStatisticsResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
@@ -19,6 +19,13 @@ export class ApiContainerServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
 
+  runStarlarkPackageTests(
+    request: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RunStarlarkPackageTestsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RunStarlarkPackageTestsResponse>;
+
   startServices(
     request: api_container_service_pb.StartServicesArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -54,6 +61,18 @@ export class ApiContainerServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  cancelConnectionChanges(
+    request: api_container_service_pb.CancelConnectionChangesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  watchConnectionChanges(
+    request: api_container_service_pb.WatchConnectionChangesArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ConnectionChangeEvent>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -124,6 +143,25 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.RenderTemplatesToFilesArtifactResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
 
+  captureServiceTraffic(
+    request: api_container_service_pb.CaptureServiceTrafficArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceTrafficCaptureChunk>;
+
+  storeServiceTrafficCapture(
+    request: api_container_service_pb.StoreServiceTrafficCaptureArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.StoreServiceTrafficCaptureResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StoreServiceTrafficCaptureResponse>;
+
+  getTrafficStatistics(
+    request: api_container_service_pb.GetTrafficStatisticsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetTrafficStatisticsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetTrafficStatisticsResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -141,6 +179,11 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
 
+  runStarlarkPackageTests(
+    request: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RunStarlarkPackageTestsResponse>;
+
   startServices(
     request: api_container_service_pb.StartServicesArgs,
     metadata?: grpcWeb.Metadata
@@ -166,6 +209,16 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  cancelConnectionChanges(
+    request: api_container_service_pb.CancelConnectionChangesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  watchConnectionChanges(
+    request: api_container_service_pb.WatchConnectionChangesArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ConnectionChangeEvent>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata?: grpcWeb.Metadata
@@ -216,5 +269,20 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
 
+  captureServiceTraffic(
+    request: api_container_service_pb.CaptureServiceTrafficArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceTrafficCaptureChunk>;
+
+  storeServiceTrafficCapture(
+    request: api_container_service_pb.StoreServiceTrafficCaptureArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.StoreServiceTrafficCaptureResponse>;
+
+  getTrafficStatistics(
+    request: api_container_service_pb.GetTrafficStatisticsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetTrafficStatisticsResponse>;
+
 }
 
@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -188,6 +190,67 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.runStarlarkPa
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RunStarlarkPackageTestsArgs,
+ *   !proto.api_container_api.RunStarlarkPackageTestsResponse>}
+ */
+const methodDescriptor_ApiContainerService_RunStarlarkPackageTests = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RunStarlarkPackageTestsArgs,
+  proto.api_container_api.RunStarlarkPackageTestsResponse,
+  /**
+   * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RunStarlarkPackageTestsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RunStarlarkPackageTestsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.runStarlarkPackageTests =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageTests,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.RunStarlarkPackageTestsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.runStarlarkPackageTests =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageTests);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -493,6 +556,123 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.repartition =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CancelConnectionChangesArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_CancelConnectionChanges = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CancelConnectionChanges',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.CancelConnectionChangesArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.CancelConnectionChangesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CancelConnectionChangesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.cancelConnectionChanges =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CancelConnectionChanges',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CancelConnectionChanges,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CancelConnectionChangesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.cancelConnectionChanges =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CancelConnectionChanges',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CancelConnectionChanges);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.WatchConnectionChangesArgs,
+ *   !proto.api_container_api.ConnectionChangeEvent>}
+ */
+const methodDescriptor_ApiContainerService_WatchConnectionChanges = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WatchConnectionChanges',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.WatchConnectionChangesArgs,
+  proto.api_container_api.ConnectionChangeEvent,
+  /**
+   * @param {!proto.api_container_api.WatchConnectionChangesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ConnectionChangeEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.WatchConnectionChangesArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ConnectionChangeEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.watchConnectionChanges =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchConnectionChanges',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchConnectionChanges);
+};
+
+
+/**
+ * @param {!proto.api_container_api.WatchConnectionChangesArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ConnectionChangeEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.watchConnectionChanges =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchConnectionChanges',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchConnectionChanges);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1103,5 +1283,183 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.renderTemplat
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CaptureServiceTrafficArgs,
+ *   !proto.api_container_api.ServiceTrafficCaptureChunk>}
+ */
+const methodDescriptor_ApiContainerService_CaptureServiceTraffic = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CaptureServiceTraffic',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.CaptureServiceTrafficArgs,
+  proto.api_container_api.ServiceTrafficCaptureChunk,
+  /**
+   * @param {!proto.api_container_api.CaptureServiceTrafficArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ServiceTrafficCaptureChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CaptureServiceTrafficArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceTrafficCaptureChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.captureServiceTraffic =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/CaptureServiceTraffic',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CaptureServiceTraffic);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CaptureServiceTrafficArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceTrafficCaptureChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.captureServiceTraffic =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/CaptureServiceTraffic',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CaptureServiceTraffic);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.StoreServiceTrafficCaptureArgs,
+ *   !proto.api_container_api.StoreServiceTrafficCaptureResponse>}
+ */
+const methodDescriptor_ApiContainerService_StoreServiceTrafficCapture = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StoreServiceTrafficCapture',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.StoreServiceTrafficCaptureArgs,
+  proto.api_container_api.StoreServiceTrafficCaptureResponse,
+  /**
+   * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StoreServiceTrafficCaptureResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreServiceTrafficCaptureResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StoreServiceTrafficCaptureResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.storeServiceTrafficCapture =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreServiceTrafficCapture',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreServiceTrafficCapture,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.StoreServiceTrafficCaptureResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeServiceTrafficCapture =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreServiceTrafficCapture',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreServiceTrafficCapture);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetTrafficStatisticsArgs,
+ *   !proto.api_container_api.GetTrafficStatisticsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetTrafficStatistics = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetTrafficStatistics',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetTrafficStatisticsArgs,
+  proto.api_container_api.GetTrafficStatisticsResponse,
+  /**
+   * @param {!proto.api_container_api.GetTrafficStatisticsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetTrafficStatisticsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetTrafficStatisticsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetTrafficStatisticsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetTrafficStatisticsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getTrafficStatistics =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetTrafficStatistics',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetTrafficStatistics,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetTrafficStatisticsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetTrafficStatisticsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getTrafficStatistics =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetTrafficStatistics',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetTrafficStatistics);
+};
+
+
 module.exports = proto.api_container_api;
 
@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
 
 export class Port extends jspb.Message {
@@ -57,6 +58,14 @@ export class ServiceInfo extends jspb.Message {
   getShortenedUuid(): string;
   setShortenedUuid(value: string): ServiceInfo;
 
+  getHostnamesList(): Array<string>;
+  setHostnamesList(value: Array<string>): ServiceInfo;
+  clearHostnamesList(): ServiceInfo;
+  addHostnames(value: string, index?: number): ServiceInfo;
+
+  getPrivateIpv6Addr(): string;
+  setPrivateIpv6Addr(value: string): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -74,6 +83,8 @@ export namespace ServiceInfo {
     maybePublicPortsMap: Array<[string, Port.AsObject]>,
     name: string,
     shortenedUuid: string,
+    hostnamesList: Array<string>,
+    privateIpv6Addr: string,
   }
 }
 
@@ -117,6 +128,11 @@ export class ServiceConfig extends jspb.Message {
   hasSubnetwork(): boolean;
   clearSubnetwork(): ServiceConfig;
 
+  getHostnamesList(): Array<string>;
+  setHostnamesList(value: Array<string>): ServiceConfig;
+  clearHostnamesList(): ServiceConfig;
+  addHostnames(value: string, index?: number): ServiceConfig;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceConfig.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceConfig): ServiceConfig.AsObject;
@@ -138,6 +154,7 @@ export namespace ServiceConfig {
     memoryAllocationMegabytes: number,
     privateIpAddrPlaceholder: string,
     subnetwork?: string,
+    hostnamesList: Array<string>,
   }
 
   export enum SubnetworkCase { 
@@ -152,6 +169,40 @@ export class UpdateServiceConfig extends jspb.Message {
   hasSubnetwork(): boolean;
   clearSubnetwork(): UpdateServiceConfig;
 
+  getContainerImageName(): string;
+  setContainerImageName(value: string): UpdateServiceConfig;
+  hasContainerImageName(): boolean;
+  clearContainerImageName(): UpdateServiceConfig;
+
+  getPrivatePortsMap(): jspb.Map<string, Port>;
+  clearPrivatePortsMap(): UpdateServiceConfig;
+
+  getEntrypointArgsList(): Array<string>;
+  setEntrypointArgsList(value: Array<string>): UpdateServiceConfig;
+  clearEntrypointArgsList(): UpdateServiceConfig;
+  addEntrypointArgs(value: string, index?: number): UpdateServiceConfig;
+
+  getCmdArgsList(): Array<string>;
+  setCmdArgsList(value: Array<string>): UpdateServiceConfig;
+  clearCmdArgsList(): UpdateServiceConfig;
+  addCmdArgs(value: string, index?: number): UpdateServiceConfig;
+
+  getEnvVarsMap(): jspb.Map<string, string>;
+  clearEnvVarsMap(): UpdateServiceConfig;
+
+  getFilesArtifactMountpointsMap(): jspb.Map<string, string>;
+  clearFilesArtifactMountpointsMap(): UpdateServiceConfig;
+
+  getCpuAllocationMillicpus(): number;
+  setCpuAllocationMillicpus(value: number): UpdateServiceConfig;
+  hasCpuAllocationMillicpus(): boolean;
+  clearCpuAllocationMillicpus(): UpdateServiceConfig;
+
+  getMemoryAllocationMegabytes(): number;
+  setMemoryAllocationMegabytes(value: number): UpdateServiceConfig;
+  hasMemoryAllocationMegabytes(): boolean;
+  clearMemoryAllocationMegabytes(): UpdateServiceConfig;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UpdateServiceConfig.AsObject;
   static toObject(includeInstance: boolean, msg: UpdateServiceConfig): UpdateServiceConfig.AsObject;
@@ -163,12 +214,35 @@ export class UpdateServiceConfig extends jspb.Message {
 export namespace UpdateServiceConfig {
   export type AsObject = {
     subnetwork?: string,
+    containerImageName?: string,
+    privatePortsMap: Array<[string, Port.AsObject]>,
+    entrypointArgsList: Array<string>,
+    cmdArgsList: Array<string>,
+    envVarsMap: Array<[string, string]>,
+    filesArtifactMountpointsMap: Array<[string, string]>,
+    cpuAllocationMillicpus?: number,
+    memoryAllocationMegabytes?: number,
   }
 
   export enum SubnetworkCase { 
     _SUBNETWORK_NOT_SET = 0,
     SUBNETWORK = 1,
   }
+
+  export enum ContainerImageNameCase { 
+    _CONTAINER_IMAGE_NAME_NOT_SET = 0,
+    CONTAINER_IMAGE_NAME = 2,
+  }
+
+  export enum CpuAllocationMillicpusCase { 
+    _CPU_ALLOCATION_MILLICPUS_NOT_SET = 0,
+    CPU_ALLOCATION_MILLICPUS = 8,
+  }
+
+  export enum MemoryAllocationMegabytesCase { 
+    _MEMORY_ALLOCATION_MEGABYTES_NOT_SET = 0,
+    MEMORY_ALLOCATION_MEGABYTES = 9,
+  }
 }
 
 export class RunStarlarkScriptArgs extends jspb.Message {
@@ -240,6 +314,21 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   hasParallelism(): boolean;
   clearParallelism(): RunStarlarkPackageArgs;
 
+  getResume(): boolean;
+  setResume(value: boolean): RunStarlarkPackageArgs;
+  hasResume(): boolean;
+  clearResume(): RunStarlarkPackageArgs;
+
+  getApply(): boolean;
+  setApply(value: boolean): RunStarlarkPackageArgs;
+  hasApply(): boolean;
+  clearApply(): RunStarlarkPackageArgs;
+
+  getRemoveUndeclaredServices(): boolean;
+  setRemoveUndeclaredServices(value: boolean): RunStarlarkPackageArgs;
+  hasRemoveUndeclaredServices(): boolean;
+  clearRemoveUndeclaredServices(): RunStarlarkPackageArgs;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
   serializeBinary(): Uint8Array;
@@ -258,6 +347,9 @@ export namespace RunStarlarkPackageArgs {
     serializedParams: string,
     dryRun?: boolean,
     parallelism?: number,
+    resume?: boolean,
+    apply?: boolean,
+    removeUndeclaredServices?: boolean,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -275,6 +367,110 @@ export namespace RunStarlarkPackageArgs {
     _PARALLELISM_NOT_SET = 0,
     PARALLELISM = 7,
   }
+
+  export enum ResumeCase { 
+    _RESUME_NOT_SET = 0,
+    RESUME = 8,
+  }
+
+  export enum ApplyCase { 
+    _APPLY_NOT_SET = 0,
+    APPLY = 9,
+  }
+
+  export enum RemoveUndeclaredServicesCase { 
+    _REMOVE_UNDECLARED_SERVICES_NOT_SET = 0,
+    REMOVE_UNDECLARED_SERVICES = 10,
+  }
+}
+
+export class RunStarlarkPackageTestsArgs extends jspb.Message {
+  getPackageId(): string;
+  setPackageId(value: string): RunStarlarkPackageTestsArgs;
+
+  getLocal(): Uint8Array | string;
+  getLocal_asU8(): Uint8Array;
+  getLocal_asB64(): string;
+  setLocal(value: Uint8Array | string): RunStarlarkPackageTestsArgs;
+
+  getRemote(): boolean;
+  setRemote(value: boolean): RunStarlarkPackageTestsArgs;
+
+  getStarlarkPackageContentCase(): RunStarlarkPackageTestsArgs.StarlarkPackageContentCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageTestsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageTestsArgs): RunStarlarkPackageTestsArgs.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageTestsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageTestsArgs;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageTestsArgs, reader: jspb.BinaryReader): RunStarlarkPackageTestsArgs;
+}
+
+export namespace RunStarlarkPackageTestsArgs {
+  export type AsObject = {
+    packageId: string,
+    local: Uint8Array | string,
+    remote: boolean,
+  }
+
+  export enum StarlarkPackageContentCase { 
+    STARLARK_PACKAGE_CONTENT_NOT_SET = 0,
+    LOCAL = 2,
+    REMOTE = 3,
+  }
+}
+
+export class RunStarlarkPackageTestsResponse extends jspb.Message {
+  getTestResultsList(): Array<StarlarkTestResult>;
+  setTestResultsList(value: Array<StarlarkTestResult>): RunStarlarkPackageTestsResponse;
+  clearTestResultsList(): RunStarlarkPackageTestsResponse;
+  addTestResults(value?: StarlarkTestResult, index?: number): StarlarkTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageTestsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageTestsResponse): RunStarlarkPackageTestsResponse.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageTestsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageTestsResponse;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageTestsResponse, reader: jspb.BinaryReader): RunStarlarkPackageTestsResponse;
+}
+
+export namespace RunStarlarkPackageTestsResponse {
+  export type AsObject = {
+    testResultsList: Array<StarlarkTestResult.AsObject>,
+  }
+}
+
+export class StarlarkTestResult extends jspb.Message {
+  getTestFilepath(): string;
+  setTestFilepath(value: string): StarlarkTestResult;
+
+  getTestFunctionName(): string;
+  setTestFunctionName(value: string): StarlarkTestResult;
+
+  getFailure(): StarlarkInterpretationError | undefined;
+  setFailure(value?: StarlarkInterpretationError): StarlarkTestResult;
+  hasFailure(): boolean;
+  clearFailure(): StarlarkTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkTestResult.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkTestResult): StarlarkTestResult.AsObject;
+  static serializeBinaryToWriter(message: StarlarkTestResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkTestResult;
+  static deserializeBinaryFromReader(message: StarlarkTestResult, reader: jspb.BinaryReader): StarlarkTestResult;
+}
+
+export namespace StarlarkTestResult {
+  export type AsObject = {
+    testFilepath: string,
+    testFunctionName: string,
+    failure?: StarlarkInterpretationError.AsObject,
+  }
+
+  export enum FailureCase { 
+    _FAILURE_NOT_SET = 0,
+    FAILURE = 3,
+  }
 }
 
 export class StarlarkRunResponseLine extends jspb.Message {
@@ -303,6 +499,11 @@ export class StarlarkRunResponseLine extends jspb.Message {
   hasRunFinishedEvent(): boolean;
   clearRunFinishedEvent(): StarlarkRunResponseLine;
 
+  getApplyPlan(): StarlarkApplyPlan | undefined;
+  setApplyPlan(value?: StarlarkApplyPlan): StarlarkRunResponseLine;
+  hasApplyPlan(): boolean;
+  clearApplyPlan(): StarlarkRunResponseLine;
+
   getRunResponseLineCase(): StarlarkRunResponseLine.RunResponseLineCase;
 
   serializeBinary(): Uint8Array;
@@ -320,6 +521,7 @@ export namespace StarlarkRunResponseLine {
     progressInfo?: StarlarkRunProgress.AsObject,
     instructionResult?: StarlarkInstructionResult.AsObject,
     runFinishedEvent?: StarlarkRunFinishedEvent.AsObject,
+    applyPlan?: StarlarkApplyPlan.AsObject,
   }
 
   export enum RunResponseLineCase { 
@@ -329,6 +531,7 @@ export namespace StarlarkRunResponseLine {
     PROGRESS_INFO = 3,
     INSTRUCTION_RESULT = 4,
     RUN_FINISHED_EVENT = 5,
+    APPLY_PLAN = 6,
   }
 }
 
@@ -595,6 +798,78 @@ export namespace StarlarkRunFinishedEvent {
   }
 }
 
+export class StarlarkApplyPlan extends jspb.Message {
+  getChangesList(): Array<StarlarkApplyPlanChange>;
+  setChangesList(value: Array<StarlarkApplyPlanChange>): StarlarkApplyPlan;
+  clearChangesList(): StarlarkApplyPlan;
+  addChanges(value?: StarlarkApplyPlanChange, index?: number): StarlarkApplyPlanChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkApplyPlan.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkApplyPlan): StarlarkApplyPlan.AsObject;
+  static serializeBinaryToWriter(message: StarlarkApplyPlan, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkApplyPlan;
+  static deserializeBinaryFromReader(message: StarlarkApplyPlan, reader: jspb.BinaryReader): StarlarkApplyPlan;
+}
+
+export namespace StarlarkApplyPlan {
+  export type AsObject = {
+    changesList: Array<StarlarkApplyPlanChange.AsObject>,
+  }
+}
+
+export class StarlarkApplyPlanChange extends jspb.Message {
+  getAction(): StarlarkApplyPlanChange.Action;
+  setAction(value: StarlarkApplyPlanChange.Action): StarlarkApplyPlanChange;
+
+  getResourceType(): StarlarkApplyPlanChange.ResourceType;
+  setResourceType(value: StarlarkApplyPlanChange.ResourceType): StarlarkApplyPlanChange;
+
+  getResourceName(): string;
+  setResourceName(value: string): StarlarkApplyPlanChange;
+
+  getInstructionNumber(): number;
+  setInstructionNumber(value: number): StarlarkApplyPlanChange;
+  hasInstructionNumber(): boolean;
+  clearInstructionNumber(): StarlarkApplyPlanChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkApplyPlanChange.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkApplyPlanChange): StarlarkApplyPlanChange.AsObject;
+  static serializeBinaryToWriter(message: StarlarkApplyPlanChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkApplyPlanChange;
+  static deserializeBinaryFromReader(message: StarlarkApplyPlanChange, reader: jspb.BinaryReader): StarlarkApplyPlanChange;
+}
+
+export namespace StarlarkApplyPlanChange {
+  export type AsObject = {
+    action: StarlarkApplyPlanChange.Action,
+    resourceType: StarlarkApplyPlanChange.ResourceType,
+    resourceName: string,
+    instructionNumber?: number,
+  }
+
+  export enum Action { 
+    ADD = 0,
+    UPDATE = 1,
+    REMOVE = 2,
+    UNCHANGED = 3,
+    EXECUTE = 4,
+  }
+
+  export enum ResourceType { 
+    SERVICE = 0,
+    FILES_ARTIFACT = 1,
+    SUBNETWORK_CONNECTION = 2,
+    INSTRUCTION = 3,
+  }
+
+  export enum InstructionNumberCase { 
+    _INSTRUCTION_NUMBER_NOT_SET = 0,
+    INSTRUCTION_NUMBER = 4,
+  }
+}
+
 export class StartServicesArgs extends jspb.Message {
   getServiceNamesToConfigsMap(): jspb.Map<string, ServiceConfig>;
   clearServiceNamesToConfigsMap(): StartServicesArgs;
@@ -835,6 +1110,81 @@ export namespace PartitionConnectionInfo {
   }
 }
 
+export class CancelConnectionChangesArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): CancelConnectionChangesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelConnectionChangesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelConnectionChangesArgs): CancelConnectionChangesArgs.AsObject;
+  static serializeBinaryToWriter(message: CancelConnectionChangesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelConnectionChangesArgs;
+  static deserializeBinaryFromReader(message: CancelConnectionChangesArgs, reader: jspb.BinaryReader): CancelConnectionChangesArgs;
+}
+
+export namespace CancelConnectionChangesArgs {
+  export type AsObject = {
+    name: string,
+  }
+}
+
+export class WatchConnectionChangesArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): WatchConnectionChangesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WatchConnectionChangesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: WatchConnectionChangesArgs): WatchConnectionChangesArgs.AsObject;
+  static serializeBinaryToWriter(message: WatchConnectionChangesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WatchConnectionChangesArgs;
+  static deserializeBinaryFromReader(message: WatchConnectionChangesArgs, reader: jspb.BinaryReader): WatchConnectionChangesArgs;
+}
+
+export namespace WatchConnectionChangesArgs {
+  export type AsObject = {
+    name: string,
+  }
+}
+
+export class ConnectionChangeEvent extends jspb.Message {
+  getEventType(): ConnectionChangeEvent.EventType;
+  setEventType(value: ConnectionChangeEvent.EventType): ConnectionChangeEvent;
+
+  getStepIndex(): number;
+  setStepIndex(value: number): ConnectionChangeEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): ConnectionChangeEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): ConnectionChangeEvent;
+
+  getErrorMessage(): string;
+  setErrorMessage(value: string): ConnectionChangeEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConnectionChangeEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: ConnectionChangeEvent): ConnectionChangeEvent.AsObject;
+  static serializeBinaryToWriter(message: ConnectionChangeEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConnectionChangeEvent;
+  static deserializeBinaryFromReader(message: ConnectionChangeEvent, reader: jspb.BinaryReader): ConnectionChangeEvent;
+}
+
+export namespace ConnectionChangeEvent {
+  export type AsObject = {
+    eventType: ConnectionChangeEvent.EventType,
+    stepIndex: number,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    errorMessage: string,
+  }
+
+  export enum EventType { 
+    STEP_APPLIED = 0,
+    STEP_FAILED = 1,
+    COMPLETED = 2,
+    CANCELLED = 3,
+  }
+}
+
 export class ExecCommandArgs extends jspb.Message {
   getServiceIdentifier(): string;
   setServiceIdentifier(value: string): ExecCommandArgs;
@@ -1236,3 +1586,194 @@ export namespace RenderTemplatesToFilesArtifactResponse {
   }
 }
 
+export class CaptureServiceTrafficArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CaptureServiceTrafficArgs;
+
+  getDurationSeconds(): number;
+  setDurationSeconds(value: number): CaptureServiceTrafficArgs;
+
+  getFilter(): string;
+  setFilter(value: string): CaptureServiceTrafficArgs;
+  hasFilter(): boolean;
+  clearFilter(): CaptureServiceTrafficArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CaptureServiceTrafficArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CaptureServiceTrafficArgs): CaptureServiceTrafficArgs.AsObject;
+  static serializeBinaryToWriter(message: CaptureServiceTrafficArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CaptureServiceTrafficArgs;
+  static deserializeBinaryFromReader(message: CaptureServiceTrafficArgs, reader: jspb.BinaryReader): CaptureServiceTrafficArgs;
+}
+
+export namespace CaptureServiceTrafficArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    durationSeconds: number,
+    filter?: string,
+  }
+
+  export enum FilterCase { 
+    _FILTER_NOT_SET = 0,
+    FILTER = 3,
+  }
+}
+
+export class ServiceTrafficCaptureChunk extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): ServiceTrafficCaptureChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceTrafficCaptureChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceTrafficCaptureChunk): ServiceTrafficCaptureChunk.AsObject;
+  static serializeBinaryToWriter(message: ServiceTrafficCaptureChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceTrafficCaptureChunk;
+  static deserializeBinaryFromReader(message: ServiceTrafficCaptureChunk, reader: jspb.BinaryReader): ServiceTrafficCaptureChunk;
+}
+
+export namespace ServiceTrafficCaptureChunk {
+  export type AsObject = {
+    data: Uint8Array | string,
+  }
+}
+
+export class StoreServiceTrafficCaptureArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): StoreServiceTrafficCaptureArgs;
+
+  getDurationSeconds(): number;
+  setDurationSeconds(value: number): StoreServiceTrafficCaptureArgs;
+
+  getFilter(): string;
+  setFilter(value: string): StoreServiceTrafficCaptureArgs;
+  hasFilter(): boolean;
+  clearFilter(): StoreServiceTrafficCaptureArgs;
+
+  getName(): string;
+  setName(value: string): StoreServiceTrafficCaptureArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StoreServiceTrafficCaptureArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StoreServiceTrafficCaptureArgs): StoreServiceTrafficCaptureArgs.AsObject;
+  static serializeBinaryToWriter(message: StoreServiceTrafficCaptureArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StoreServiceTrafficCaptureArgs;
+  static deserializeBinaryFromReader(message: StoreServiceTrafficCaptureArgs, reader: jspb.BinaryReader): StoreServiceTrafficCaptureArgs;
+}
+
+export namespace StoreServiceTrafficCaptureArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    durationSeconds: number,
+    filter?: string,
+    name: string,
+  }
+
+  export enum FilterCase { 
+    _FILTER_NOT_SET = 0,
+    FILTER = 3,
+  }
+}
+
+export class StoreServiceTrafficCaptureResponse extends jspb.Message {
+  getUuid(): string;
+  setUuid(value: string): StoreServiceTrafficCaptureResponse;
+
+  getName(): string;
+  setName(value: string): StoreServiceTrafficCaptureResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StoreServiceTrafficCaptureResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StoreServiceTrafficCaptureResponse): StoreServiceTrafficCaptureResponse.AsObject;
+  static serializeBinaryToWriter(message: StoreServiceTrafficCaptureResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StoreServiceTrafficCaptureResponse;
+  static deserializeBinaryFromReader(message: StoreServiceTrafficCaptureResponse, reader: jspb.BinaryReader): StoreServiceTrafficCaptureResponse;
+}
+
+export namespace StoreServiceTrafficCaptureResponse {
+  export type AsObject = {
+    uuid: string,
+    name: string,
+  }
+}
+
+export class GetTrafficStatisticsArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): GetTrafficStatisticsArgs;
+  hasServiceIdentifier(): boolean;
+  clearServiceIdentifier(): GetTrafficStatisticsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTrafficStatisticsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTrafficStatisticsArgs): GetTrafficStatisticsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetTrafficStatisticsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTrafficStatisticsArgs;
+  static deserializeBinaryFromReader(message: GetTrafficStatisticsArgs, reader: jspb.BinaryReader): GetTrafficStatisticsArgs;
+}
+
+export namespace GetTrafficStatisticsArgs {
+  export type AsObject = {
+    serviceIdentifier?: string,
+  }
+
+  export enum ServiceIdentifierCase { 
+    _SERVICE_IDENTIFIER_NOT_SET = 0,
+    SERVICE_IDENTIFIER = 1,
+  }
+}
+
+export class LinkTrafficStatistics extends jspb.Message {
+  getSourceServiceName(): string;
+  setSourceServiceName(value: string): LinkTrafficStatistics;
+
+  getDestinationServiceName(): string;
+  setDestinationServiceName(value: string): LinkTrafficStatistics;
+
+  getSentBytes(): number;
+  setSentBytes(value: number): LinkTrafficStatistics;
+
+  getSentPackets(): number;
+  setSentPackets(value: number): LinkTrafficStatistics;
+
+  getDroppedPackets(): number;
+  setDroppedPackets(value: number): LinkTrafficStatistics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LinkTrafficStatistics.AsObject;
+  static toObject(includeInstance: boolean, msg: LinkTrafficStatistics): LinkTrafficStatistics.AsObject;
+  static serializeBinaryToWriter(message: LinkTrafficStatistics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LinkTrafficStatistics;
+  static deserializeBinaryFromReader(message: LinkTrafficStatistics, reader: jspb.BinaryReader): LinkTrafficStatistics;
+}
+
+export namespace LinkTrafficStatistics {
+  export type AsObject = {
+    sourceServiceName: string,
+    destinationServiceName: string,
+    sentBytes: number,
+    sentPackets: number,
+    droppedPackets: number,
+  }
+}
+
+export class GetTrafficStatisticsResponse extends jspb.Message {
+  getLinkTrafficStatisticsList(): Array<LinkTrafficStatistics>;
+  setLinkTrafficStatisticsList(value: Array<LinkTrafficStatistics>): GetTrafficStatisticsResponse;
+  clearLinkTrafficStatisticsList(): GetTrafficStatisticsResponse;
+  addLinkTrafficStatistics(value?: LinkTrafficStatistics, index?: number): LinkTrafficStatistics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTrafficStatisticsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTrafficStatisticsResponse): GetTrafficStatisticsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetTrafficStatisticsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTrafficStatisticsResponse;
+  static deserializeBinaryFromReader(message: GetTrafficStatisticsResponse, reader: jspb.BinaryReader): GetTrafficStatisticsResponse;
+}
+
+export namespace GetTrafficStatisticsResponse {
+  export type AsObject = {
+    linkTrafficStatisticsList: Array<LinkTrafficStatistics.AsObject>,
+  }
+}
+
@@ -17,6 +17,12 @@ var global = Function('return this')();
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.api_container_api.CancelConnectionChangesArgs', null, global);
+goog.exportSymbol('proto.api_container_api.CaptureServiceTrafficArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ConnectionChangeEvent', null, global);
+goog.exportSymbol('proto.api_container_api.ConnectionChangeEvent.EventType', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
@@ -24,6 +30,9 @@ goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetTrafficStatisticsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetTrafficStatisticsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.LinkTrafficStatistics', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionConnectionInfo', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionConnections', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionServices', null, global);
@@ -38,10 +47,18 @@ goog.exportSymbol('proto.api_container_api.RenderTemplatesToFilesArtifactRespons
 goog.exportSymbol('proto.api_container_api.RepartitionArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
+goog.exportSymbol('proto.api_container_api.RunStarlarkPackageTestsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RunStarlarkPackageTestsArgs.StarlarkPackageContentCase', null, global);
+goog.exportSymbol('proto.api_container_api.RunStarlarkPackageTestsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceConfig', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceTrafficCaptureChunk', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkApplyPlan', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkApplyPlanChange', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkApplyPlanChange.Action', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkApplyPlanChange.ResourceType', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
@@ -54,11 +71,14 @@ goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, glob
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkTestResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkValidationError', null, global);
 goog.exportSymbol('proto.api_container_api.StartServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StartServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceResponse', null, global);
+goog.exportSymbol('proto.api_container_api.StoreServiceTrafficCaptureArgs', null, global);
+goog.exportSymbol('proto.api_container_api.StoreServiceTrafficCaptureResponse', null, global);
 goog.exportSymbol('proto.api_container_api.StoreWebFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StoreWebFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.UnpauseServiceArgs', null, global);
@@ -67,6 +87,7 @@ goog.exportSymbol('proto.api_container_api.UploadFilesArtifactArgs', null, globa
 goog.exportSymbol('proto.api_container_api.UploadFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs', null, global);
+goog.exportSymbol('proto.api_container_api.WatchConnectionChangesArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -99,7 +120,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.ServiceInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ServiceInfo.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.ServiceInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -141,7 +162,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.UpdateServiceConfig = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.UpdateServiceConfig.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.UpdateServiceConfig, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -193,6 +214,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.RunStarlarkPackageArgs.displayName = 'proto.api_container_api.RunStarlarkPackageArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_);
+};
+goog.inherits(proto.api_container_api.RunStarlarkPackageTestsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RunStarlarkPackageTestsArgs.displayName = 'proto.api_container_api.RunStarlarkPackageTestsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RunStarlarkPackageTestsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RunStarlarkPackageTestsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RunStarlarkPackageTestsResponse.displayName = 'proto.api_container_api.RunStarlarkPackageTestsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkTestResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkTestResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkTestResult.displayName = 'proto.api_container_api.StarlarkTestResult';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -424,6 +508,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkRunFinishedEvent.displayName = 'proto.api_container_api.StarlarkRunFinishedEvent';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkApplyPlan = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkApplyPlan.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkApplyPlan, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkApplyPlan.displayName = 'proto.api_container_api.StarlarkApplyPlan';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkApplyPlanChange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkApplyPlanChange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkApplyPlanChange.displayName = 'proto.api_container_api.StarlarkApplyPlanChange';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -676,6 +802,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.PartitionConnectionInfo.displayName = 'proto.api_container_api.PartitionConnectionInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CancelConnectionChangesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CancelConnectionChangesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CancelConnectionChangesArgs.displayName = 'proto.api_container_api.CancelConnectionChangesArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.WatchConnectionChangesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.WatchConnectionChangesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WatchConnectionChangesArgs.displayName = 'proto.api_container_api.WatchConnectionChangesArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ConnectionChangeEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ConnectionChangeEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ConnectionChangeEvent.displayName = 'proto.api_container_api.ConnectionChangeEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1033,79 +1222,226 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.RenderTemplatesToFilesArtifactResponse.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.CaptureServiceTrafficArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.CaptureServiceTrafficArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CaptureServiceTrafficArgs.displayName = 'proto.api_container_api.CaptureServiceTrafficArgs';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.Port} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.ServiceTrafficCaptureChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.api_container_api.ServiceTrafficCaptureChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceTrafficCaptureChunk.displayName = 'proto.api_container_api.ServiceTrafficCaptureChunk';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.Port;
-  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.StoreServiceTrafficCaptureArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.StoreServiceTrafficCaptureArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreServiceTrafficCaptureArgs.displayName = 'proto.api_container_api.StoreServiceTrafficCaptureArgs';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
+proto.api_container_api.StoreServiceTrafficCaptureResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreServiceTrafficCaptureResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreServiceTrafficCaptureResponse.displayName = 'proto.api_container_api.StoreServiceTrafficCaptureResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetTrafficStatisticsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetTrafficStatisticsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetTrafficStatisticsArgs.displayName = 'proto.api_container_api.GetTrafficStatisticsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.LinkTrafficStatistics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.LinkTrafficStatistics, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.LinkTrafficStatistics.displayName = 'proto.api_container_api.LinkTrafficStatistics';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetTrafficStatisticsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetTrafficStatisticsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetTrafficStatisticsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetTrafficStatisticsResponse.displayName = 'proto.api_container_api.GetTrafficStatisticsResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.Port} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Port;
+  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
       msg.setNumber(value);
       break;
     case 2:
@@ -1233,6 +1569,13 @@ proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(va
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ServiceInfo.repeatedFields_ = [8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1270,7 +1613,9 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
     maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
     maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
     name: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, "")
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    hostnamesList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    privateIpv6Addr: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -1339,6 +1684,14 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setShortenedUuid(value);
       break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addHostnames(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpv6Addr(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1411,6 +1764,20 @@ proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getHostnamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getPrivateIpv6Addr();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -1548,13 +1915,68 @@ proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value)
 };
 
 
+/**
+ * repeated string hostnames = 8;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getHostnamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setHostnamesList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.addHostnames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearHostnamesList = function() {
+  return this.setHostnamesList([]);
+};
+
+
+/**
+ * optional string private_ipv6_addr = 9;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivateIpv6Addr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setPrivateIpv6Addr = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.ServiceConfig.repeatedFields_ = [4,5];
+proto.api_container_api.ServiceConfig.repeatedFields_ = [4,5,12];
 
 
 
@@ -1597,7 +2019,8 @@ proto.api_container_api.ServiceConfig.toObject = function(includeInstance, msg)
     cpuAllocationMillicpus: jspb.Message.getFieldWithDefault(msg, 8, 0),
     memoryAllocationMegabytes: jspb.Message.getFieldWithDefault(msg, 9, 0),
     privateIpAddrPlaceholder: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    subnetwork: jspb.Message.getFieldWithDefault(msg, 11, "")
+    subnetwork: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    hostnamesList: (f = jspb.Message.getRepeatedField(msg, 12)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1686,6 +2109,10 @@ proto.api_container_api.ServiceConfig.deserializeBinaryFromReader = function(msg
       var value = /** @type {string} */ (reader.readString());
       msg.setSubnetwork(value);
       break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addHostnames(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1780,6 +2207,13 @@ proto.api_container_api.ServiceConfig.serializeBinaryToWriter = function(message
       f
     );
   }
+  f = message.getHostnamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      12,
+      f
+    );
+  }
 };
 
 
@@ -2053,6 +2487,50 @@ proto.api_container_api.ServiceConfig.prototype.hasSubnetwork = function() {
 };
 
 
+/**
+ * repeated string hostnames = 12;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getHostnamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 12));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setHostnamesList = function(value) {
+  return jspb.Message.setField(this, 12, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.addHostnames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 12, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearHostnamesList = function() {
+  return this.setHostnamesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.UpdateServiceConfig.repeatedFields_ = [4,5];
 
 
 
@@ -2085,7 +2563,15 @@ proto.api_container_api.UpdateServiceConfig.prototype.toObject = function(opt_in
  */
 proto.api_container_api.UpdateServiceConfig.toObject = function(includeInstance, msg) {
   var f, obj = {
-    subnetwork: jspb.Message.getFieldWithDefault(msg, 1, "")
+    subnetwork: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    containerImageName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    entrypointArgsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    cmdArgsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : [],
+    filesArtifactMountpointsMap: (f = msg.getFilesArtifactMountpointsMap()) ? f.toObject(includeInstance, undefined) : [],
+    cpuAllocationMillicpus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    memoryAllocationMegabytes: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -2126,14 +2612,52 @@ proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader = functi
       var value = /** @type {string} */ (reader.readString());
       msg.setSubnetwork(value);
       break;
-    default:
-      reader.skipField();
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerImageName(value);
       break;
-    }
-  }
-  return msg;
-};
-
+    case 3:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEntrypointArgs(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCmdArgs(value);
+      break;
+    case 6:
+      var value = msg.getEnvVarsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
+      var value = msg.getFilesArtifactMountpointsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuAllocationMillicpus(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryAllocationMegabytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
 
 /**
  * Serializes the message to binary data (in protobuf wire format).
@@ -2162,6 +2686,53 @@ proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter = function(m
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getEntrypointArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getCmdArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getEnvVarsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getFilesArtifactMountpointsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(7, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeUint64(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -2201,250 +2772,206 @@ proto.api_container_api.UpdateServiceConfig.prototype.hasSubnetwork = function()
 };
 
 
+/**
+ * optional string container_image_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getContainerImageName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UpdateServiceConfig.prototype.setContainerImageName = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.UpdateServiceConfig.prototype.clearContainerImageName = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
-  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.UpdateServiceConfig.prototype.hasContainerImageName = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * map<string, Port> private_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.UpdateServiceConfig.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
+proto.api_container_api.UpdateServiceConfig.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string entrypoint_args = 4;
+ * @return {!Array<string>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSerializedScript();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
+proto.api_container_api.UpdateServiceConfig.prototype.getEntrypointArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * optional string serialized_script = 1;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.UpdateServiceConfig.prototype.setEntrypointArgsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.UpdateServiceConfig.prototype.addEntrypointArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * optional string serialized_params = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.UpdateServiceConfig.prototype.clearEntrypointArgsList = function() {
+  return this.setEntrypointArgsList([]);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * repeated string cmd_args = 5;
+ * @return {!Array<string>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.UpdateServiceConfig.prototype.getCmdArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
- * optional bool dry_run = 3;
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.UpdateServiceConfig.prototype.setCmdArgsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.UpdateServiceConfig.prototype.addCmdArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.UpdateServiceConfig.prototype.clearCmdArgsList = function() {
+  return this.setCmdArgsList([]);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * map<string, string> env_vars = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.UpdateServiceConfig.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * optional int32 parallelism = 4;
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearEnvVarsMap = function() {
+  this.getEnvVarsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> files_artifact_mountpoints = 7;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getFilesArtifactMountpointsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearFilesArtifactMountpointsMap = function() {
+  this.getFilesArtifactMountpointsMap().clear();
+  return this;};
+
+
+/**
+ * optional uint64 cpu_allocation_millicpus = 8;
  * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.UpdateServiceConfig.prototype.getCpuAllocationMillicpus = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.UpdateServiceConfig.prototype.setCpuAllocationMillicpus = function(value) {
+  return jspb.Message.setField(this, 8, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.UpdateServiceConfig.prototype.clearCpuAllocationMillicpus = function() {
+  return jspb.Message.setField(this, 8, undefined);
 };
 
 
@@ -2452,40 +2979,50 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = funct
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.UpdateServiceConfig.prototype.hasCpuAllocationMillicpus = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
+/**
+ * optional uint64 memory_allocation_megabytes = 9;
+ * @return {number}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getMemoryAllocationMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * @param {number} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
+proto.api_container_api.UpdateServiceConfig.prototype.setMemoryAllocationMegabytes = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
 
 /**
- * @enum {number}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
-  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
-  LOCAL: 3,
-  REMOTE: 4
+proto.api_container_api.UpdateServiceConfig.prototype.clearMemoryAllocationMegabytes = function() {
+  return jspb.Message.setField(this, 9, undefined);
 };
 
+
 /**
- * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
-  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
+proto.api_container_api.UpdateServiceConfig.prototype.hasMemoryAllocationMegabytes = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2499,8 +3036,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2509,18 +3046,16 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    local: msg.getLocal_asB64(),
-    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -2534,23 +3069,23 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
-  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
+  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2559,25 +3094,17 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLocal(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRemote(value);
+      msg.setSerializedScript(value);
       break;
-    case 5:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setSerializedParams(value);
       break;
-    case 6:
+    case 3:
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setDryRun(value);
       break;
-    case 7:
+    case 4:
       var value = /** @type {number} */ (reader.readInt32());
       msg.setParallelism(value);
       break;
@@ -2594,9 +3121,9 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2604,51 +3131,37 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
+  f = message.getSerializedScript();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBytes(
-      3,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
   f = message.getSerializedParams();
   if (f.length > 0) {
     writer.writeString(
-      5,
+      2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
   if (f != null) {
     writer.writeBool(
-      6,
+      3,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
   if (f != null) {
     writer.writeInt32(
-      7,
+      4,
       f
     );
   }
@@ -2656,71 +3169,65 @@ proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = functio
 
 
 /**
- * optional string package_id = 1;
+ * optional string serialized_script = 1;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bytes local = 3;
+ * optional string serialized_params = 2;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes local = 3;
- * This is a type-conversion wrapper around `getLocal()`
- * @return {string}
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLocal()));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bytes local = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLocal()`
- * @return {!Uint8Array}
+ * optional bool dry_run = 3;
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLocal()));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
@@ -2728,125 +3235,35 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional bool remote = 4;
- * @return {boolean}
+ * optional int32 parallelism = 4;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional string serialized_params = 5;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
-
-/**
- * optional bool dry_run = 6;
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 6, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 6, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 6) != null;
-};
-
-
-/**
- * optional int32 parallelism = 7;
- * @return {number}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
@@ -2854,8 +3271,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = func
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -2868,25 +3285,22 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = functi
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5]];
+proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
 
 /**
  * @enum {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5
+proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 3,
+  REMOTE: 4
 };
 
 /**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
 };
 
 
@@ -2904,8 +3318,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2914,17 +3328,21 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f)
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    resume: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    apply: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    removeUndeclaredServices: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -2938,23 +3356,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
+  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2962,29 +3380,40 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
-      break;
-    case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
       break;
     case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
       break;
     case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setResume(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApply(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemoveUndeclaredServices(value);
       break;
     default:
       reader.skipField();
@@ -2999,9 +3428,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3009,80 +3438,144 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getError();
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
   if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    writer.writeBytes(
+      3,
+      f
     );
   }
-  f = message.getProgressInfo();
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
   if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    writer.writeBool(
+      4,
+      f
     );
   }
-  f = message.getInstructionResult();
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
   if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    writer.writeBool(
+      6,
+      f
     );
   }
-  f = message.getRunFinishedEvent();
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
   if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    writer.writeInt32(
+      7,
+      f
     );
   }
-};
-
-
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+};
+
+
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
+ * optional string package_id = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional bytes local = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
 };
 
 
@@ -3090,36 +3583,35 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = fun
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * optional bool remote = 4;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
 };
 
 
@@ -3127,36 +3619,53 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * optional string serialized_params = 5;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional bool dry_run = 6;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
@@ -3164,36 +3673,35 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = fu
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
+ * optional int32 parallelism = 7;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
@@ -3201,55 +3709,2887 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ * optional bool resume = 8;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getResume = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setResume = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearResume = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasResume = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional bool apply = 9;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getApply = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setApply = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearApply = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasApply = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional bool remove_undeclared_services = 10;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemoveUndeclaredServices = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemoveUndeclaredServices = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemoveUndeclaredServices = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemoveUndeclaredServices = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_ = [[2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 2,
+  REMOTE: 3
+};
+
+/**
+ * @return {proto.api_container_api.RunStarlarkPackageTestsArgs.StarlarkPackageContentCase}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageTestsArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageTestsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageTestsArgs;
+  return proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageTestsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes local = 2;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes local = 2;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 2, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool remote = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageTestsArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageTestsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testResultsList: jspb.Message.toObjectList(msg.getTestResultsList(),
+    proto.api_container_api.StarlarkTestResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageTestsResponse;
+  return proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkTestResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader);
+      msg.addTestResults(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageTestsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated StarlarkTestResult test_results = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkTestResult>}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.getTestResultsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkTestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkTestResult, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkTestResult>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse} returns this
+*/
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.setTestResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkTestResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkTestResult}
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.addTestResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkTestResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.clearTestResultsList = function() {
+  return this.setTestResultsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkTestResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkTestResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkTestResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testFilepath: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    testFunctionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    failure: (f = msg.getFailure()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkTestResult}
+ */
+proto.api_container_api.StarlarkTestResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkTestResult;
+  return proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkTestResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkTestResult}
+ */
+proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestFilepath(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestFunctionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setFailure(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkTestResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestFilepath();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTestFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFailure();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string test_filepath = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.getTestFilepath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
+ */
+proto.api_container_api.StarlarkTestResult.prototype.setTestFilepath = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string test_function_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.getTestFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
+ */
+proto.api_container_api.StarlarkTestResult.prototype.setTestFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional StarlarkInterpretationError failure = 3;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.getFailure = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
+*/
+proto.api_container_api.StarlarkTestResult.prototype.setFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
+ */
+proto.api_container_api.StarlarkTestResult.prototype.clearFailure = function() {
+  return this.setFailure(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkTestResult.prototype.hasFailure = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  APPLY_PLAN: 6
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    applyPlan: (f = msg.getApplyPlan()) && proto.api_container_api.StarlarkApplyPlan.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.StarlarkApplyPlan;
+      reader.readMessage(value,proto.api_container_api.StarlarkApplyPlan.deserializeBinaryFromReader);
+      msg.setApplyPlan(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getApplyPlan();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkApplyPlan.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional StarlarkApplyPlan apply_plan = 6;
+ * @return {?proto.api_container_api.StarlarkApplyPlan}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getApplyPlan = function() {
+  return /** @type{?proto.api_container_api.StarlarkApplyPlan} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkApplyPlan, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkApplyPlan|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setApplyPlan = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearApplyPlan = function() {
+  return this.setApplyPlan(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasApplyPlan = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
+};
+
+
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedArgValue();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIsRepresentative();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_arg_value = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string arg_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string filename = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ */
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ */
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
+};
+
+
+/**
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint32 current_step_number = 3;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -3266,8 +6606,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -3276,17 +6616,14 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, "")
+    isrunsuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3300,23 +6637,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3324,22 +6661,12 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsrunsuccessful(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
+      msg.setSerializedOutput(value);
       break;
     default:
       reader.skipField();
@@ -3354,9 +6681,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3364,39 +6691,23 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getIsrunsuccessful();
+  if (f) {
+    writer.writeBool(
       1,
-      f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
       f
     );
   }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutableInstruction();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
-      4,
+      2,
       f
     );
   }
@@ -3404,116 +6715,66 @@ proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(m
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
- */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional bool isRunSuccessful = 1;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsrunsuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsrunsuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * optional string instruction_name = 2;
+ * optional string serialized_output = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
- */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * optional string executable_instruction = 4;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
+proto.api_container_api.StarlarkApplyPlan.repeatedFields_ = [1];
 
 
 
@@ -3530,8 +6791,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkApplyPlan.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkApplyPlan.toObject(opt_includeInstance, this);
 };
 
 
@@ -3540,13 +6801,14 @@ proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkApplyPlan} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkApplyPlan.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+    changesList: jspb.Message.toObjectList(msg.getChangesList(),
+    proto.api_container_api.StarlarkApplyPlanChange.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3560,23 +6822,23 @@ proto.api_container_api.StarlarkInstructionResult.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.StarlarkApplyPlan}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkApplyPlan.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkApplyPlan;
+  return proto.api_container_api.StarlarkApplyPlan.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkApplyPlan} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.StarlarkApplyPlan}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkApplyPlan.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3584,8 +6846,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
+      var value = new proto.api_container_api.StarlarkApplyPlanChange;
+      reader.readMessage(value,proto.api_container_api.StarlarkApplyPlanChange.deserializeBinaryFromReader);
+      msg.addChanges(value);
       break;
     default:
       reader.skipField();
@@ -3600,9 +6863,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkApplyPlan.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkApplyPlan.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3610,37 +6873,58 @@ proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!proto.api_container_api.StarlarkApplyPlan} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkApplyPlan.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedInstructionResult();
+  f = message.getChangesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.StarlarkApplyPlanChange.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string serialized_instruction_result = 1;
- * @return {string}
+ * repeated StarlarkApplyPlanChange changes = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkApplyPlanChange>}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkApplyPlan.prototype.getChangesList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkApplyPlanChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkApplyPlanChange, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * @param {!Array<!proto.api_container_api.StarlarkApplyPlanChange>} value
+ * @return {!proto.api_container_api.StarlarkApplyPlan} returns this
+*/
+proto.api_container_api.StarlarkApplyPlan.prototype.setChangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkApplyPlan.prototype.addChanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkApplyPlanChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkApplyPlan} returns this
+ */
+proto.api_container_api.StarlarkApplyPlan.prototype.clearChangesList = function() {
+  return this.setChangesList([]);
 };
 
 
@@ -3660,8 +6944,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkApplyPlanChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkApplyPlanChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -3670,15 +6954,16 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkApplyPlanChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    action: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    resourceType: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    resourceName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    instructionNumber: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -3692,23 +6977,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkApplyPlanChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkApplyPlanChange;
+  return proto.api_container_api.StarlarkApplyPlanChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkApplyPlanChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3716,16 +7001,20 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
+      var value = /** @type {!proto.api_container_api.StarlarkApplyPlanChange.Action} */ (reader.readEnum());
+      msg.setAction(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
+      var value = /** @type {!proto.api_container_api.StarlarkApplyPlanChange.ResourceType} */ (reader.readEnum());
+      msg.setResourceType(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResourceName(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInstructionNumber(value);
       break;
     default:
       reader.skipField();
@@ -3740,9 +7029,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkApplyPlanChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkApplyPlanChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3750,105 +7039,151 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkApplyPlanChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedArgValue();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
+  f = message.getResourceType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getIsRepresentative();
-  if (f) {
-    writer.writeBool(
+  f = message.getResourceName();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string serialized_arg_value = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkApplyPlanChange.Action = {
+  ADD: 0,
+  UPDATE: 1,
+  REMOVE: 2,
+  UNCHANGED: 3,
+  EXECUTE: 4
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkApplyPlanChange.ResourceType = {
+  SERVICE: 0,
+  FILES_ARTIFACT: 1,
+  SUBNETWORK_CONNECTION: 2,
+  INSTRUCTION: 3
+};
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * optional Action action = 1;
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange.Action}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkApplyPlanChange.prototype.getAction = function() {
+  return /** @type {!proto.api_container_api.StarlarkApplyPlanChange.Action} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional string arg_name = 2;
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange.Action} value
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange} returns this
+ */
+proto.api_container_api.StarlarkApplyPlanChange.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional ResourceType resource_type = 2;
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange.ResourceType}
+ */
+proto.api_container_api.StarlarkApplyPlanChange.prototype.getResourceType = function() {
+  return /** @type {!proto.api_container_api.StarlarkApplyPlanChange.ResourceType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkApplyPlanChange.ResourceType} value
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange} returns this
+ */
+proto.api_container_api.StarlarkApplyPlanChange.prototype.setResourceType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string resource_name = 3;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StarlarkApplyPlanChange.prototype.getResourceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.StarlarkApplyPlanChange.prototype.setResourceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * optional uint32 instruction_number = 4;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.StarlarkApplyPlanChange.prototype.getInstructionNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StarlarkApplyPlanChange.prototype.setInstructionNumber = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional bool is_representative = 3;
- * @return {boolean}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkApplyPlanChange} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.StarlarkApplyPlanChange.prototype.clearInstructionNumber = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.StarlarkApplyPlanChange.prototype.hasInstructionNumber = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -3868,8 +7203,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StartServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3878,15 +7213,13 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StartServicesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    serviceNamesToConfigsMap: (f = msg.getServiceNamesToConfigsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceConfig.toObject) : []
   };
 
   if (includeInstance) {
@@ -3900,23 +7233,23 @@ proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.StartServicesArgs}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+proto.api_container_api.StartServicesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StartServicesArgs;
+  return proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StartServicesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.StartServicesArgs}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3924,16 +7257,10 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
+      var value = msg.getServiceNamesToConfigsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceConfig.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceConfig());
+         });
       break;
     default:
       reader.skipField();
@@ -3948,9 +7275,9 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+proto.api_container_api.StartServicesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StartServicesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3958,117 +7285,41 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!proto.api_container_api.StartServicesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StartServicesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getLine();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
+  f = message.getServiceNamesToConfigsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceConfig.serializeBinaryToWriter);
   }
 };
 
 
 /**
- * optional string filename = 1;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional int32 line = 2;
- * @return {number}
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional int32 column = 3;
- * @return {number}
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
-
-/**
- * @enum {number}
- */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
+ * map<string, ServiceConfig> service_names_to_configs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceConfig>}
+ */
+proto.api_container_api.StartServicesArgs.prototype.getServiceNamesToConfigsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceConfig>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceConfig));
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
-};
+proto.api_container_api.StartServicesArgs.prototype.clearServiceNamesToConfigsMap = function() {
+  this.getServiceNamesToConfigsMap().clear();
+  return this;};
+
+
 
 
 
@@ -4085,8 +7336,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StartServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4095,15 +7346,14 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StartServicesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StartServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    successfulServiceNameToServiceInfoMap: (f = msg.getSuccessfulServiceNameToServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : [],
+    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -4117,23 +7367,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StartServicesResponse}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.StartServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StartServicesResponse;
+  return proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StartServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StartServicesResponse}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4141,19 +7391,16 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
+      var value = msg.getSuccessfulServiceNameToServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = msg.getFailedServiceNameToErrorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -4168,9 +7415,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.StartServicesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StartServicesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4178,148 +7425,198 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.StartServicesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StartServicesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
-    );
+  f = message.getSuccessfulServiceNameToServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
   }
-  f = message.getExecutionError();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
-    );
+  f = message.getFailedServiceNameToErrorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
+ * map<string, ServiceInfo> successful_service_name_to_service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
  */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+proto.api_container_api.StartServicesResponse.prototype.getSuccessfulServiceNameToServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
-};
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesResponse} returns this
+ */
+proto.api_container_api.StartServicesResponse.prototype.clearSuccessfulServiceNameToServiceInfoMap = function() {
+  this.getSuccessfulServiceNameToServiceInfoMap().clear();
+  return this;};
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * map<string, string> failed_service_name_to_error = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
+proto.api_container_api.StartServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesResponse} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
+proto.api_container_api.StartServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
+  this.getFailedServiceNameToErrorMap().clear();
+  return this;};
+
+
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
 
 
 
@@ -4338,8 +7635,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4348,13 +7645,13 @@ proto.api_container_api.StarlarkInterpretationError.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
   };
 
   if (includeInstance) {
@@ -4368,23 +7665,23 @@ proto.api_container_api.StarlarkInterpretationError.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4392,8 +7689,10 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
       break;
     default:
       reader.skipField();
@@ -4408,9 +7707,9 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4418,38 +7717,39 @@ proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!proto.api_container_api.GetServicesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
   }
 };
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
 
 
 
@@ -4468,8 +7768,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
 };
 
 
@@ -4478,13 +7778,15 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4498,23 +7800,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4523,7 +7825,15 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
       break;
     default:
       reader.skipField();
@@ -4538,9 +7848,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4548,40 +7858,97 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getServiceUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string service_uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
 
 
 
@@ -4598,8 +7965,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4608,13 +7975,14 @@ proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4628,23 +7996,23 @@ proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4652,8 +8020,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
       break;
     default:
       reader.skipField();
@@ -4668,9 +8037,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4678,47 +8047,61 @@ proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getAllidentifiersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
- */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+*/
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
  */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
 
 
 
@@ -4735,8 +8118,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4745,15 +8128,13 @@ proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RemoveServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4767,23 +8148,23 @@ proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.RemoveServiceArgs}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+proto.api_container_api.RemoveServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemoveServiceArgs;
+  return proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemoveServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.RemoveServiceArgs}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4792,15 +8173,7 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -4815,9 +8188,9 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4825,106 +8198,37 @@ proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!proto.api_container_api.RemoveServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCurrentStepInfoList();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.api_container_api.RemoveServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
-};
-
-
-/**
- * optional uint32 total_steps = 2;
- * @return {number}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 current_step_number = 3;
- * @return {number}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @return {!proto.api_container_api.RemoveServiceArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.RemoveServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4944,8 +8248,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4954,14 +8258,13 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RemoveServiceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isrunsuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4975,23 +8278,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.RemoveServiceResponse}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.RemoveServiceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemoveServiceResponse;
+  return proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemoveServiceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.RemoveServiceResponse}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4999,12 +8302,8 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsrunsuccessful(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setServiceUuid(value);
       break;
     default:
       reader.skipField();
@@ -5018,91 +8317,48 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
 /**
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getIsrunsuccessful();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional bool isRunSuccessful = 1;
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsrunsuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsrunsuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string serialized_output = 2;
- * @return {string}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+ */
+proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveServiceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * optional string service_uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.RemoveServiceResponse.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.RemoveServiceResponse} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.RemoveServiceResponse.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5122,8 +8378,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StartServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RepartitionArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5132,13 +8388,15 @@ proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StartServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RepartitionArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceNamesToConfigsMap: (f = msg.getServiceNamesToConfigsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceConfig.toObject) : []
+    partitionServicesMap: (f = msg.getPartitionServicesMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionServices.toObject) : [],
+    partitionConnectionsMap: (f = msg.getPartitionConnectionsMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnections.toObject) : [],
+    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5152,23 +8410,23 @@ proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StartServicesArgs}
+ * @return {!proto.api_container_api.RepartitionArgs}
  */
-proto.api_container_api.StartServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RepartitionArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StartServicesArgs;
-  return proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RepartitionArgs;
+  return proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StartServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RepartitionArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StartServicesArgs}
+ * @return {!proto.api_container_api.RepartitionArgs}
  */
-proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5176,11 +8434,22 @@ proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceNamesToConfigsMap();
+      var value = msg.getPartitionServicesMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceConfig.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceConfig());
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionServices.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionServices());
+         });
+      break;
+    case 2:
+      var value = msg.getPartitionConnectionsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnections.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnections());
          });
       break;
+    case 3:
+      var value = new proto.api_container_api.PartitionConnectionInfo;
+      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
+      msg.setDefaultConnection(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5194,9 +8463,9 @@ proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StartServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RepartitionArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5204,41 +8473,112 @@ proto.api_container_api.StartServicesArgs.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StartServicesArgs} message
+ * @param {!proto.api_container_api.RepartitionArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RepartitionArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceNamesToConfigsMap(true);
+  f = message.getPartitionServicesMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceConfig.serializeBinaryToWriter);
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionServices.serializeBinaryToWriter);
+  }
+  f = message.getPartitionConnectionsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnections.serializeBinaryToWriter);
+  }
+  f = message.getDefaultConnection();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
+    );
   }
 };
 
 
 /**
- * map<string, ServiceConfig> service_names_to_configs = 1;
+ * map<string, PartitionServices> partition_services = 1;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceConfig>}
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionServices>}
  */
-proto.api_container_api.StartServicesArgs.prototype.getServiceNamesToConfigsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceConfig>} */ (
+proto.api_container_api.RepartitionArgs.prototype.getPartitionServicesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionServices>} */ (
       jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceConfig));
+      proto.api_container_api.PartitionServices));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesArgs} returns this
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
  */
-proto.api_container_api.StartServicesArgs.prototype.clearServiceNamesToConfigsMap = function() {
-  this.getServiceNamesToConfigsMap().clear();
+proto.api_container_api.RepartitionArgs.prototype.clearPartitionServicesMap = function() {
+  this.getPartitionServicesMap().clear();
+  return this;};
+
+
+/**
+ * map<string, PartitionConnections> partition_connections = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnections>}
+ */
+proto.api_container_api.RepartitionArgs.prototype.getPartitionConnectionsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnections>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      proto.api_container_api.PartitionConnections));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+ */
+proto.api_container_api.RepartitionArgs.prototype.clearPartitionConnectionsMap = function() {
+  this.getPartitionConnectionsMap().clear();
   return this;};
 
 
+/**
+ * optional PartitionConnectionInfo default_connection = 3;
+ * @return {?proto.api_container_api.PartitionConnectionInfo}
+ */
+proto.api_container_api.RepartitionArgs.prototype.getDefaultConnection = function() {
+  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+*/
+proto.api_container_api.RepartitionArgs.prototype.setDefaultConnection = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+ */
+proto.api_container_api.RepartitionArgs.prototype.clearDefaultConnection = function() {
+  return this.setDefaultConnection(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RepartitionArgs.prototype.hasDefaultConnection = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -5255,8 +8595,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StartServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionServices.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionServices.toObject(opt_includeInstance, this);
 };
 
 
@@ -5265,14 +8605,13 @@ proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StartServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionServices} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionServices.toObject = function(includeInstance, msg) {
   var f, obj = {
-    successfulServiceNameToServiceInfoMap: (f = msg.getSuccessfulServiceNameToServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : [],
-    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
+    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -5286,23 +8625,23 @@ proto.api_container_api.StartServicesResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StartServicesResponse}
+ * @return {!proto.api_container_api.PartitionServices}
  */
-proto.api_container_api.StartServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionServices.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StartServicesResponse;
-  return proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionServices;
+  return proto.api_container_api.PartitionServices.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StartServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionServices} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StartServicesResponse}
+ * @return {!proto.api_container_api.PartitionServices}
  */
-proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5310,15 +8649,9 @@ proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getSuccessfulServiceNameToServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
-      break;
-    case 2:
-      var value = msg.getFailedServiceNameToErrorMap();
+      var value = msg.getServiceNameSetMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
          });
       break;
     default:
@@ -5334,9 +8667,9 @@ proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionServices.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StartServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionServices.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5344,64 +8677,38 @@ proto.api_container_api.StartServicesResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StartServicesResponse} message
+ * @param {!proto.api_container_api.PartitionServices} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PartitionServices.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccessfulServiceNameToServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
-  }
-  f = message.getFailedServiceNameToErrorMap(true);
+  f = message.getServiceNameSetMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
 };
 
 
 /**
- * map<string, ServiceInfo> successful_service_name_to_service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
- */
-proto.api_container_api.StartServicesResponse.prototype.getSuccessfulServiceNameToServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesResponse} returns this
- */
-proto.api_container_api.StartServicesResponse.prototype.clearSuccessfulServiceNameToServiceInfoMap = function() {
-  this.getSuccessfulServiceNameToServiceInfoMap().clear();
-  return this;};
-
-
-/**
- * map<string, string> failed_service_name_to_error = 2;
+ * map<string, bool> service_name_set = 1;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.StartServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+proto.api_container_api.PartitionServices.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
       null));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesResponse} returns this
+ * @return {!proto.api_container_api.PartitionServices} returns this
  */
-proto.api_container_api.StartServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
-  this.getFailedServiceNameToErrorMap().clear();
+proto.api_container_api.PartitionServices.prototype.clearServiceNameSetMap = function() {
+  this.getServiceNameSetMap().clear();
   return this;};
 
 
@@ -5421,8 +8728,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnections.toObject(opt_includeInstance, this);
 };
 
 
@@ -5431,13 +8738,13 @@ proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionConnections} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionConnections.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+    connectionInfoMap: (f = msg.getConnectionInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnectionInfo.toObject) : []
   };
 
   if (includeInstance) {
@@ -5451,23 +8758,23 @@ proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.PartitionConnections}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionConnections.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionConnections;
+  return proto.api_container_api.PartitionConnections.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionConnections} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.PartitionConnections}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5475,9 +8782,9 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceIdentifiersMap();
+      var value = msg.getConnectionInfoMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnectionInfo());
          });
       break;
     default:
@@ -5493,9 +8800,9 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionConnections.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionConnections.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5503,38 +8810,38 @@ proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!proto.api_container_api.PartitionConnections} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PartitionConnections.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
+  f = message.getConnectionInfoMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter);
   }
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
+ * map<string, PartitionConnectionInfo> connection_info = 1;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>}
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
+proto.api_container_api.PartitionConnections.prototype.getConnectionInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>} */ (
       jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+      proto.api_container_api.PartitionConnectionInfo));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * @return {!proto.api_container_api.PartitionConnections} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
+proto.api_container_api.PartitionConnections.prototype.clearConnectionInfoMap = function() {
+  this.getConnectionInfoMap().clear();
   return this;};
 
 
@@ -5554,8 +8861,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnectionInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -5564,13 +8871,13 @@ proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
   };
 
   if (includeInstance) {
@@ -5584,23 +8891,23 @@ proto.api_container_api.GetServicesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionConnectionInfo;
+  return proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5608,10 +8915,8 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketLossPercentage(value);
       break;
     default:
       reader.skipField();
@@ -5626,9 +8931,9 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5636,39 +8941,38 @@ proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!proto.api_container_api.PartitionConnectionInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * optional float packet_loss_percentage = 1;
+ * @return {number}
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
 
 
 
@@ -5687,8 +8991,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.CancelConnectionChangesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CancelConnectionChangesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5697,15 +9001,13 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.CancelConnectionChangesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.CancelConnectionChangesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5719,23 +9021,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.CancelConnectionChangesArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.CancelConnectionChangesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.CancelConnectionChangesArgs;
+  return proto.api_container_api.CancelConnectionChangesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.CancelConnectionChangesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.CancelConnectionChangesArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.CancelConnectionChangesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5743,17 +9045,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -5767,9 +9061,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.CancelConnectionChangesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.CancelConnectionChangesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5777,30 +9071,16 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.CancelConnectionChangesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.CancelConnectionChangesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
   f = message.getName();
   if (f.length > 0) {
     writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
+      1,
       f
     );
   }
@@ -5808,67 +9088,154 @@ proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(me
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+proto.api_container_api.CancelConnectionChangesArgs.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.CancelConnectionChangesArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+proto.api_container_api.CancelConnectionChangesArgs.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string name = 2;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.WatchConnectionChangesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WatchConnectionChangesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.WatchConnectionChangesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WatchConnectionChangesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.WatchConnectionChangesArgs}
+ */
+proto.api_container_api.WatchConnectionChangesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.WatchConnectionChangesArgs;
+  return proto.api_container_api.WatchConnectionChangesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.WatchConnectionChangesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.WatchConnectionChangesArgs}
+ */
+proto.api_container_api.WatchConnectionChangesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WatchConnectionChangesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.WatchConnectionChangesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.WatchConnectionChangesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.WatchConnectionChangesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string shortened_uuid = 3;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.WatchConnectionChangesArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.WatchConnectionChangesArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.WatchConnectionChangesArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5884,8 +9251,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ConnectionChangeEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectionChangeEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -5894,14 +9261,16 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ConnectionChangeEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ConnectionChangeEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    eventType: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    stepIndex: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5915,23 +9284,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.ConnectionChangeEvent}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ConnectionChangeEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ConnectionChangeEvent;
+  return proto.api_container_api.ConnectionChangeEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ConnectionChangeEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.ConnectionChangeEvent}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ConnectionChangeEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5939,9 +9308,21 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = /** @type {!proto.api_container_api.ConnectionChangeEvent.EventType} */ (reader.readEnum());
+      msg.setEventType(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setStepIndex(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
       break;
     default:
       reader.skipField();
@@ -5956,9 +9337,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ConnectionChangeEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ConnectionChangeEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5966,62 +9347,153 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.ConnectionChangeEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ConnectionChangeEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getEventType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
+      f
+    );
+  }
+  f = message.getStepIndex();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      3,
       f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ * @enum {number}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+proto.api_container_api.ConnectionChangeEvent.EventType = {
+  STEP_APPLIED: 0,
+  STEP_FAILED: 1,
+  COMPLETED: 2,
+  CANCELLED: 3
+};
+
+/**
+ * optional EventType event_type = 1;
+ * @return {!proto.api_container_api.ConnectionChangeEvent.EventType}
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.getEventType = function() {
+  return /** @type {!proto.api_container_api.ConnectionChangeEvent.EventType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @param {!proto.api_container_api.ConnectionChangeEvent.EventType} value
+ * @return {!proto.api_container_api.ConnectionChangeEvent} returns this
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.setEventType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 step_index = 2;
+ * @return {number}
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.getStepIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ConnectionChangeEvent} returns this
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.setStepIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ConnectionChangeEvent} returns this
 */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.api_container_api.ConnectionChangeEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ConnectionChangeEvent} returns this
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+proto.api_container_api.ConnectionChangeEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.api_container_api.ConnectionChangeEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string error_message = 4;
+ * @return {string}
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ConnectionChangeEvent} returns this
+ */
+proto.api_container_api.ConnectionChangeEvent.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6037,8 +9509,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RemoveServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6047,13 +9519,14 @@ proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RemoveServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6067,23 +9540,23 @@ proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RemoveServiceArgs}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.RemoveServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RemoveServiceArgs;
-  return proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RemoveServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RemoveServiceArgs}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6094,6 +9567,10 @@ proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function
       var value = /** @type {string} */ (reader.readString());
       msg.setServiceIdentifier(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6107,9 +9584,9 @@ proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6117,11 +9594,11 @@ proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RemoveServiceArgs} message
+ * @param {!proto.api_container_api.ExecCommandArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getServiceIdentifier();
   if (f.length > 0) {
@@ -6130,6 +9607,13 @@ proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(mes
       f
     );
   }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -6137,20 +9621,57 @@ proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(mes
  * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RemoveServiceArgs} returns this
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.RemoveServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
+};
+
+
 
 
 
@@ -6167,8 +9688,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RemoveServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PauseServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6177,13 +9698,13 @@ proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RemoveServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PauseServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6197,23 +9718,23 @@ proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RemoveServiceResponse}
+ * @return {!proto.api_container_api.PauseServiceArgs}
  */
-proto.api_container_api.RemoveServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.PauseServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RemoveServiceResponse;
-  return proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PauseServiceArgs;
+  return proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RemoveServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PauseServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RemoveServiceResponse}
+ * @return {!proto.api_container_api.PauseServiceArgs}
  */
-proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6222,7 +9743,7 @@ proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -6237,9 +9758,9 @@ proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6247,13 +9768,13 @@ proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RemoveServiceResponse} message
+ * @param {!proto.api_container_api.PauseServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -6264,19 +9785,19 @@ proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.getServiceUuid = function() {
+proto.api_container_api.PauseServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RemoveServiceResponse} returns this
+ * @return {!proto.api_container_api.PauseServiceArgs} returns this
  */
-proto.api_container_api.RemoveServiceResponse.prototype.setServiceUuid = function(value) {
+proto.api_container_api.PauseServiceArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -6297,8 +9818,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RepartitionArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UnpauseServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6307,15 +9828,13 @@ proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RepartitionArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UnpauseServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    partitionServicesMap: (f = msg.getPartitionServicesMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionServices.toObject) : [],
-    partitionConnectionsMap: (f = msg.getPartitionConnectionsMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnections.toObject) : [],
-    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6329,23 +9848,23 @@ proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RepartitionArgs}
+ * @return {!proto.api_container_api.UnpauseServiceArgs}
  */
-proto.api_container_api.RepartitionArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.UnpauseServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RepartitionArgs;
-  return proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UnpauseServiceArgs;
+  return proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RepartitionArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UnpauseServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RepartitionArgs}
+ * @return {!proto.api_container_api.UnpauseServiceArgs}
  */
-proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6353,21 +9872,8 @@ proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getPartitionServicesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionServices.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionServices());
-         });
-      break;
-    case 2:
-      var value = msg.getPartitionConnectionsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnections.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnections());
-         });
-      break;
-    case 3:
-      var value = new proto.api_container_api.PartitionConnectionInfo;
-      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
-      msg.setDefaultConnection(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -6382,9 +9888,9 @@ proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
+proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RepartitionArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6392,109 +9898,37 @@ proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RepartitionArgs} message
+ * @param {!proto.api_container_api.UnpauseServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RepartitionArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPartitionServicesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionServices.serializeBinaryToWriter);
-  }
-  f = message.getPartitionConnectionsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnections.serializeBinaryToWriter);
-  }
-  f = message.getDefaultConnection();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * map<string, PartitionServices> partition_services = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionServices>}
- */
-proto.api_container_api.RepartitionArgs.prototype.getPartitionServicesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionServices>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.PartitionServices));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
- */
-proto.api_container_api.RepartitionArgs.prototype.clearPartitionServicesMap = function() {
-  this.getPartitionServicesMap().clear();
-  return this;};
-
-
-/**
- * map<string, PartitionConnections> partition_connections = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnections>}
- */
-proto.api_container_api.RepartitionArgs.prototype.getPartitionConnectionsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnections>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      proto.api_container_api.PartitionConnections));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
- */
-proto.api_container_api.RepartitionArgs.prototype.clearPartitionConnectionsMap = function() {
-  this.getPartitionConnectionsMap().clear();
-  return this;};
-
-
-/**
- * optional PartitionConnectionInfo default_connection = 3;
- * @return {?proto.api_container_api.PartitionConnectionInfo}
- */
-proto.api_container_api.RepartitionArgs.prototype.getDefaultConnection = function() {
-  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
-};
-
-
-/**
- * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
- * @return {!proto.api_container_api.RepartitionArgs} returns this
-*/
-proto.api_container_api.RepartitionArgs.prototype.setDefaultConnection = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.RepartitionArgs.prototype.clearDefaultConnection = function() {
-  return this.setDefaultConnection(undefined);
+proto.api_container_api.UnpauseServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.UnpauseServiceArgs} returns this
  */
-proto.api_container_api.RepartitionArgs.prototype.hasDefaultConnection = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.UnpauseServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -6514,8 +9948,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PartitionServices.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionServices.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6524,13 +9958,14 @@ proto.api_container_api.PartitionServices.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionServices} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionServices.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6544,23 +9979,23 @@ proto.api_container_api.PartitionServices.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionServices}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.PartitionServices.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionServices;
-  return proto.api_container_api.PartitionServices.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionServices} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionServices}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6568,10 +10003,12 @@ proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceNameSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogOutput(value);
       break;
     default:
       reader.skipField();
@@ -6586,9 +10023,9 @@ proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PartitionServices.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionServices.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6596,39 +10033,63 @@ proto.api_container_api.PartitionServices.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionServices} message
+ * @param {!proto.api_container_api.ExecCommandResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionServices.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceNameSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getLogOutput();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 exit_code = 1;
+ * @return {number}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ */
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * map<string, bool> service_name_set = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional string log_output = 2;
+ * @return {string}
  */
-proto.api_container_api.PartitionServices.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.PartitionServices} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.PartitionServices.prototype.clearServiceNameSetMap = function() {
-  this.getServiceNameSetMap().clear();
-  return this;};
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
 
 
@@ -6647,8 +10108,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionConnections.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6657,13 +10118,19 @@ proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionConnections} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnections.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connectionInfoMap: (f = msg.getConnectionInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnectionInfo.toObject) : []
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -6677,23 +10144,23 @@ proto.api_container_api.PartitionConnections.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionConnections}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.PartitionConnections.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionConnections;
-  return proto.api_container_api.PartitionConnections.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionConnections} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionConnections}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6701,10 +10168,32 @@ proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = funct
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getConnectionInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnectionInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -6719,9 +10208,9 @@ proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PartitionConnections.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionConnections.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6729,178 +10218,190 @@ proto.api_container_api.PartitionConnections.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionConnections} message
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnections.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnectionInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter);
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, PartitionConnectionInfo> connection_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.PartitionConnections.prototype.getConnectionInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.PartitionConnectionInfo));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.PartitionConnections} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.PartitionConnections.prototype.clearConnectionInfoMap = function() {
-  this.getConnectionInfoMap().clear();
-  return this;};
-
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionConnectionInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionConnectionInfo} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string path = 3;
+ * @return {string}
  */
-proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionConnectionInfo}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.PartitionConnectionInfo.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionConnectionInfo;
-  return proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionConnectionInfo} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionConnectionInfo}
+ * optional uint32 initial_delay_milliseconds = 4;
+ * @return {number}
  */
-proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setPacketLossPercentage(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionConnectionInfo} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint32 retries = 5;
+ * @return {number}
  */
-proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPacketLossPercentage();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      1,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * optional float packet_loss_percentage = 1;
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 retries_delay_milliseconds = 6;
  * @return {number}
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.getPacketLossPercentage = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentage = function(value) {
-  return jspb.Message.setProto3FloatField(this, 1, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
+/**
+ * optional string body_text = 7;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
 
 
 
@@ -6917,8 +10418,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6927,14 +10428,20 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -6948,23 +10455,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6976,8 +10483,32 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
       msg.setServiceIdentifier(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -6992,9 +10523,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7002,11 +10533,11 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getServiceIdentifier();
   if (f.length > 0) {
@@ -7015,68 +10546,199 @@ proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(messa
       f
     );
   }
-  f = message.getCommandArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRequestBody();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string path = 3;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string request_body = 4;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * optional string service_identifier = 1;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * optional uint32 retries = 6;
+ * @return {number}
  */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * repeated string command_args = 2;
- * @return {!Array<string>}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * optional uint32 retries_delay_milliseconds = 7;
+ * @return {number}
  */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * optional string body_text = 8;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
@@ -7096,8 +10758,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PauseServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7106,13 +10768,14 @@ proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PauseServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    data: msg.getData_asB64(),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7126,23 +10789,23 @@ proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PauseServiceArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs}
  */
-proto.api_container_api.PauseServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PauseServiceArgs;
-  return proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactArgs;
+  return proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PauseServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PauseServiceArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs}
  */
-proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7150,8 +10813,12 @@ proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -7166,9 +10833,9 @@ proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7176,37 +10843,86 @@ proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PauseServiceArgs} message
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.PauseServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
+/**
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
+ */
+proto.api_container_api.UploadFilesArtifactArgs.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
 /**
  * @param {string} value
- * @return {!proto.api_container_api.PauseServiceArgs} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
  */
-proto.api_container_api.PauseServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -7226,8 +10942,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UnpauseServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7236,13 +10952,14 @@ proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UnpauseServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7256,23 +10973,23 @@ proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UnpauseServiceArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.UnpauseServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UnpauseServiceArgs;
-  return proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UnpauseServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UnpauseServiceArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7281,7 +10998,11 @@ proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -7296,9 +11017,9 @@ proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7306,40 +11027,65 @@ proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UnpauseServiceArgs} message
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UnpauseServiceArgs} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ */
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -7356,8 +11102,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7366,14 +11112,13 @@ proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7387,23 +11132,23 @@ proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7411,12 +11156,8 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -7431,9 +11172,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7441,23 +11182,16 @@ proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getLogOutput();
+  f = message.getIdentifier();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -7465,38 +11199,20 @@ proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(m
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
- */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
- */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional string log_output = 2;
+ * optional string identifier = 1;
  * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -7516,8 +11232,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7526,19 +11242,13 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -7552,23 +11262,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactResponse;
+  return proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7576,32 +11286,8 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -7616,9 +11302,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7626,187 +11312,221 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      4,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getBodyText();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string path = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * optional string url = 1;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string body_text = 7;
+ * optional string name = 2;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -7826,8 +11546,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7836,20 +11556,13 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7863,23 +11576,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7888,35 +11601,7 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -7931,9 +11616,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7941,212 +11626,227 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getRequestBody();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = message.getBodyText();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional uint32 port = 2;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
 
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string path = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string request_body = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcePath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 5;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint32 retries = 6;
- * @return {number}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
+ * optional string source_path = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string body_text = 8;
+ * optional string name = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -8166,8 +11866,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8176,14 +11876,13 @@ proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8197,23 +11896,23 @@ proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.UploadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactArgs;
-  return proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8221,12 +11920,8 @@ proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -8241,9 +11936,9 @@ proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8251,23 +11946,16 @@ proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
-      1,
-      f
-    );
-  }
-  f = message.getName();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -8275,62 +11963,20 @@ proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = functi
 
 
 /**
- * optional bytes data = 1;
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
-};
-
-
-/**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
-};
-
-
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
-};
-
-
-/**
- * optional string name = 2;
+ * optional string uuid = 1;
  * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8350,8 +11996,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8360,13 +12006,13 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    templatesAndDataByDestinationRelFilepathMap: (f = msg.getTemplatesAndDataByDestinationRelFilepathMap()) ? f.toObject(includeInstance, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject) : [],
     name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
@@ -8381,23 +12027,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8405,8 +12051,10 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = msg.getTemplatesAndDataByDestinationRelFilepathMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader, "", new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData());
+         });
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
@@ -8425,9 +12073,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8435,18 +12083,15 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
+  f = message.getTemplatesAndDataByDestinationRelFilepathMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter);
   }
   f = message.getName();
   if (f.length > 0) {
@@ -8458,42 +12103,6 @@ proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = fu
 };
 
 
-/**
- * optional string uuid = 1;
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string name = 2;
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
 
 
 
@@ -8510,8 +12119,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject(opt_includeInstance, this);
 };
 
 
@@ -8520,13 +12129,14 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject = function(includeInstance, msg) {
   var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    template: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    dataAsJson: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8540,23 +12150,23 @@ proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8565,7 +12175,11 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
+      msg.setTemplate(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDataAsJson(value);
       break;
     default:
       reader.skipField();
@@ -8580,9 +12194,9 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8590,40 +12204,105 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIdentifier();
+  f = message.getTemplate();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getDataAsJson();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string identifier = 1;
+ * optional string template = 1;
  * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getTemplate = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setTemplate = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string data_as_json = 2;
+ * @return {string}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getDataAsJson = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setDataAsJson = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, TemplateAndData> templates_and_data_by_destination_rel_filepath = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getTemplatesAndDataByDestinationRelFilepathMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.clearTemplatesAndDataByDestinationRelFilepathMap = function() {
+  this.getTemplatesAndDataByDestinationRelFilepathMap().clear();
+  return this;};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -8640,8 +12319,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8650,13 +12329,13 @@ proto.api_container_api.DownloadFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64()
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8670,23 +12349,23 @@ proto.api_container_api.DownloadFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactResponse;
-  return proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactResponse;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8694,8 +12373,8 @@ proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -8710,9 +12389,9 @@ proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8720,61 +12399,37 @@ proto.api_container_api.DownloadFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getUuid();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
-  }
-};
-
-
-/**
- * optional bytes data = 1;
- * @return {string}
- */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+  }
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
-};
-
-
-/**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
- */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8794,8 +12449,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CaptureServiceTrafficArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8804,14 +12459,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.CaptureServiceTrafficArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.CaptureServiceTrafficArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    durationSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    filter: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8825,23 +12481,23 @@ proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.CaptureServiceTrafficArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.CaptureServiceTrafficArgs;
+  return proto.api_container_api.CaptureServiceTrafficArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.CaptureServiceTrafficArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.CaptureServiceTrafficArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8850,11 +12506,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setServiceIdentifier(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setDurationSeconds(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setFilter(value);
       break;
     default:
       reader.skipField();
@@ -8869,9 +12529,9 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.CaptureServiceTrafficArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8879,62 +12539,105 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!proto.api_container_api.CaptureServiceTrafficArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.CaptureServiceTrafficArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDurationSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional uint32 duration_seconds = 2;
+ * @return {number}
+ */
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.getDurationSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs} returns this
+ */
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.setDurationSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string filter = 3;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.getFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.setFilter = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.CaptureServiceTrafficArgs} returns this
+ */
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.clearFilter = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.CaptureServiceTrafficArgs.prototype.hasFilter = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -8954,8 +12657,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceTrafficCaptureChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -8964,13 +12667,13 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceTrafficCaptureChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceTrafficCaptureChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -8984,23 +12687,23 @@ proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.ServiceTrafficCaptureChunk}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceTrafficCaptureChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceTrafficCaptureChunk;
+  return proto.api_container_api.ServiceTrafficCaptureChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceTrafficCaptureChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.ServiceTrafficCaptureChunk}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceTrafficCaptureChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9008,8 +12711,8 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -9024,9 +12727,9 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceTrafficCaptureChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9034,15 +12737,15 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!proto.api_container_api.ServiceTrafficCaptureChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceTrafficCaptureChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
@@ -9051,20 +12754,44 @@ proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter =
 
 
 /**
- * optional string uuid = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.ServiceTrafficCaptureChunk} returns this
+ */
+proto.api_container_api.ServiceTrafficCaptureChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
@@ -9084,8 +12811,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreServiceTrafficCaptureArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9094,15 +12821,16 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+    durationSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    filter: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -9116,23 +12844,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreServiceTrafficCaptureArgs;
+  return proto.api_container_api.StoreServiceTrafficCaptureArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9144,10 +12872,14 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
       msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setDurationSeconds(value);
       break;
     case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilter(value);
+      break;
+    case 4:
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
@@ -9164,9 +12896,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreServiceTrafficCaptureArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9174,11 +12906,11 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getServiceIdentifier();
   if (f.length > 0) {
@@ -9187,17 +12919,24 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWrite
       f
     );
   }
-  f = message.getSourcePath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDurationSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
   f = message.getName();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      4,
       f
     );
   }
@@ -9208,53 +12947,89 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWrite
  * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string source_path = 2;
+ * optional uint32 duration_seconds = 2;
+ * @return {number}
+ */
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.getDurationSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs} returns this
+ */
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.setDurationSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string filter = 3;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.getFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.setFilter = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional string name = 3;
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs} returns this
+ */
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.clearFilter = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.hasFilter = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string name = 4;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StoreServiceTrafficCaptureArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -9274,8 +13049,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreServiceTrafficCaptureResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9284,13 +13059,14 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9304,23 +13080,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureResponse}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreServiceTrafficCaptureResponse;
+  return proto.api_container_api.StoreServiceTrafficCaptureResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureResponse}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9331,6 +13107,10 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
       var value = /** @type {string} */ (reader.readString());
       msg.setUuid(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -9344,9 +13124,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreServiceTrafficCaptureResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9354,11 +13134,11 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.StoreServiceTrafficCaptureResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUuid();
   if (f.length > 0) {
@@ -9367,6 +13147,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToW
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -9374,20 +13161,38 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToW
  * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureResponse} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreServiceTrafficCaptureResponse} returns this
+ */
+proto.api_container_api.StoreServiceTrafficCaptureResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -9404,8 +13209,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetTrafficStatisticsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9414,14 +13219,13 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetTrafficStatisticsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetTrafficStatisticsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    templatesAndDataByDestinationRelFilepathMap: (f = msg.getTemplatesAndDataByDestinationRelFilepathMap()) ? f.toObject(includeInstance, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject) : [],
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9435,23 +13239,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetTrafficStatisticsArgs}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetTrafficStatisticsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetTrafficStatisticsArgs;
+  return proto.api_container_api.GetTrafficStatisticsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetTrafficStatisticsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetTrafficStatisticsArgs}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetTrafficStatisticsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9459,14 +13263,8 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFrom
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getTemplatesAndDataByDestinationRelFilepathMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader, "", new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData());
-         });
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -9481,9 +13279,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetTrafficStatisticsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9491,26 +13289,58 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} message
+ * @param {!proto.api_container_api.GetTrafficStatisticsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetTrafficStatisticsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTemplatesAndDataByDestinationRelFilepathMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter);
-  }
-  f = message.getName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
 };
 
 
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetTrafficStatisticsArgs} returns this
+ */
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetTrafficStatisticsArgs} returns this
+ */
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.clearServiceIdentifier = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetTrafficStatisticsArgs.prototype.hasServiceIdentifier = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
 
 
 
@@ -9527,8 +13357,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject(opt_includeInstance, this);
+proto.api_container_api.LinkTrafficStatistics.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.LinkTrafficStatistics.toObject(opt_includeInstance, this);
 };
 
 
@@ -9537,14 +13367,17 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The msg instance to transform.
+ * @param {!proto.api_container_api.LinkTrafficStatistics} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject = function(includeInstance, msg) {
+proto.api_container_api.LinkTrafficStatistics.toObject = function(includeInstance, msg) {
   var f, obj = {
-    template: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    dataAsJson: jspb.Message.getFieldWithDefault(msg, 2, "")
+    sourceServiceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    destinationServiceName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sentBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    sentPackets: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    droppedPackets: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -9558,23 +13391,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
+ * @return {!proto.api_container_api.LinkTrafficStatistics}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinary = function(bytes) {
+proto.api_container_api.LinkTrafficStatistics.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.LinkTrafficStatistics;
+  return proto.api_container_api.LinkTrafficStatistics.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.LinkTrafficStatistics} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
+ * @return {!proto.api_container_api.LinkTrafficStatistics}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.LinkTrafficStatistics.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9583,11 +13416,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deser
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTemplate(value);
+      msg.setSourceServiceName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDataAsJson(value);
+      msg.setDestinationServiceName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSentBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSentPackets(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDroppedPackets(value);
       break;
     default:
       reader.skipField();
@@ -9602,9 +13447,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.serializeBinary = function() {
+proto.api_container_api.LinkTrafficStatistics.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.LinkTrafficStatistics.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9612,105 +13457,147 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} message
+ * @param {!proto.api_container_api.LinkTrafficStatistics} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.LinkTrafficStatistics.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTemplate();
+  f = message.getSourceServiceName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDataAsJson();
+  f = message.getDestinationServiceName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getSentBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getSentPackets();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getDroppedPackets();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string template = 1;
+ * optional string source_service_name = 1;
  * @return {string}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getTemplate = function() {
+proto.api_container_api.LinkTrafficStatistics.prototype.getSourceServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
+ * @return {!proto.api_container_api.LinkTrafficStatistics} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setTemplate = function(value) {
+proto.api_container_api.LinkTrafficStatistics.prototype.setSourceServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string data_as_json = 2;
+ * optional string destination_service_name = 2;
  * @return {string}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getDataAsJson = function() {
+proto.api_container_api.LinkTrafficStatistics.prototype.getDestinationServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
+ * @return {!proto.api_container_api.LinkTrafficStatistics} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setDataAsJson = function(value) {
+proto.api_container_api.LinkTrafficStatistics.prototype.setDestinationServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * map<string, TemplateAndData> templates_and_data_by_destination_rel_filepath = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>}
+ * optional uint64 sent_bytes = 3;
+ * @return {number}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getTemplatesAndDataByDestinationRelFilepathMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData));
+proto.api_container_api.LinkTrafficStatistics.prototype.getSentBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.LinkTrafficStatistics} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.clearTemplatesAndDataByDestinationRelFilepathMap = function() {
-  this.getTemplatesAndDataByDestinationRelFilepathMap().clear();
-  return this;};
+proto.api_container_api.LinkTrafficStatistics.prototype.setSentBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional uint64 sent_packets = 4;
+ * @return {number}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.LinkTrafficStatistics.prototype.getSentPackets = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.LinkTrafficStatistics} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.LinkTrafficStatistics.prototype.setSentPackets = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
+/**
+ * optional uint64 dropped_packets = 5;
+ * @return {number}
+ */
+proto.api_container_api.LinkTrafficStatistics.prototype.getDroppedPackets = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.LinkTrafficStatistics} returns this
+ */
+proto.api_container_api.LinkTrafficStatistics.prototype.setDroppedPackets = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetTrafficStatisticsResponse.repeatedFields_ = [1];
 
 
 
@@ -9727,8 +13614,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetTrafficStatisticsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9737,13 +13624,14 @@ proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetTrafficStatisticsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetTrafficStatisticsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    linkTrafficStatisticsList: jspb.Message.toObjectList(msg.getLinkTrafficStatisticsList(),
+    proto.api_container_api.LinkTrafficStatistics.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9757,23 +13645,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetTrafficStatisticsResponse}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetTrafficStatisticsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactResponse;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetTrafficStatisticsResponse;
+  return proto.api_container_api.GetTrafficStatisticsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetTrafficStatisticsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetTrafficStatisticsResponse}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetTrafficStatisticsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9781,8 +13669,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = new proto.api_container_api.LinkTrafficStatistics;
+      reader.readMessage(value,proto.api_container_api.LinkTrafficStatistics.deserializeBinaryFromReader);
+      msg.addLinkTrafficStatistics(value);
       break;
     default:
       reader.skipField();
@@ -9797,9 +13686,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetTrafficStatisticsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9807,37 +13696,58 @@ proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} message
+ * @param {!proto.api_container_api.GetTrafficStatisticsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetTrafficStatisticsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getLinkTrafficStatisticsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.LinkTrafficStatistics.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * repeated LinkTrafficStatistics link_traffic_statistics = 1;
+ * @return {!Array<!proto.api_container_api.LinkTrafficStatistics>}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.getLinkTrafficStatisticsList = function() {
+  return /** @type{!Array<!proto.api_container_api.LinkTrafficStatistics>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.LinkTrafficStatistics, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} returns this
+ * @param {!Array<!proto.api_container_api.LinkTrafficStatistics>} value
+ * @return {!proto.api_container_api.GetTrafficStatisticsResponse} returns this
+*/
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.setLinkTrafficStatisticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.LinkTrafficStatistics=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.LinkTrafficStatistics}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.addLinkTrafficStatistics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.LinkTrafficStatistics, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetTrafficStatisticsResponse} returns this
+ */
+proto.api_container_api.GetTrafficStatisticsResponse.prototype.clearLinkTrafficStatisticsList = function() {
+  return this.setLinkTrafficStatisticsList([]);
 };
 
 
@@ -13,9 +13,15 @@ interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServi
   getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.MethodDefinition<engine_service_pb.StartEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  snapshotEnclave: grpc.MethodDefinition<engine_service_pb.SnapshotEnclaveArgs, engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave: grpc.MethodDefinition<engine_service_pb.RestoreEnclaveArgs, engine_service_pb.RestoreEnclaveResponse>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  createPortForward: grpc.MethodDefinition<engine_service_pb.CreatePortForwardArgs, engine_service_pb.CreatePortForwardResponse>;
+  getPortForwards: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetPortForwardsResponse>;
+  destroyPortForward: grpc.MethodDefinition<engine_service_pb.DestroyPortForwardArgs, google_protobuf_empty_pb.Empty>;
 }
 
 export const EngineServiceService: IEngineServiceService;
@@ -26,9 +32,15 @@ export interface IEngineServiceServer extends grpc.UntypedServiceImplementation
   getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.handleUnaryCall<engine_service_pb.StartEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  snapshotEnclave: grpc.handleServerStreamingCall<engine_service_pb.SnapshotEnclaveArgs, engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave: grpc.handleClientStreamingCall<engine_service_pb.RestoreEnclaveArgs, engine_service_pb.RestoreEnclaveResponse>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  createPortForward: grpc.handleUnaryCall<engine_service_pb.CreatePortForwardArgs, engine_service_pb.CreatePortForwardResponse>;
+  getPortForwards: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetPortForwardsResponse>;
+  destroyPortForward: grpc.handleUnaryCall<engine_service_pb.DestroyPortForwardArgs, google_protobuf_empty_pb.Empty>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -48,6 +60,14 @@ export class EngineServiceClient extends grpc.Client {
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  snapshotEnclave(argument: engine_service_pb.SnapshotEnclaveArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+  snapshotEnclave(argument: engine_service_pb.SnapshotEnclaveArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave(callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
+  restoreEnclave(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
+  restoreEnclave(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
@@ -56,4 +76,13 @@ export class EngineServiceClient extends grpc.Client {
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  createPortForward(argument: engine_service_pb.CreatePortForwardArgs, callback: grpc.requestCallback<engine_service_pb.CreatePortForwardResponse>): grpc.ClientUnaryCall;
+  createPortForward(argument: engine_service_pb.CreatePortForwardArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreatePortForwardResponse>): grpc.ClientUnaryCall;
+  createPortForward(argument: engine_service_pb.CreatePortForwardArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreatePortForwardResponse>): grpc.ClientUnaryCall;
+  getPortForwards(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetPortForwardsResponse>): grpc.ClientUnaryCall;
+  getPortForwards(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetPortForwardsResponse>): grpc.ClientUnaryCall;
+  getPortForwards(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetPortForwardsResponse>): grpc.ClientUnaryCall;
+  destroyPortForward(argument: engine_service_pb.DestroyPortForwardArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  destroyPortForward(argument: engine_service_pb.DestroyPortForwardArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  destroyPortForward(argument: engine_service_pb.DestroyPortForwardArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
 }
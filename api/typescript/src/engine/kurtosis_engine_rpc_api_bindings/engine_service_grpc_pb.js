@@ -50,6 +50,28 @@ function deserialize_engine_api_CreateEnclaveResponse(buffer_arg) {
   return engine_service_pb.CreateEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_CreatePortForwardArgs(arg) {
+  if (!(arg instanceof engine_service_pb.CreatePortForwardArgs)) {
+    throw new Error('Expected argument of type engine_api.CreatePortForwardArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CreatePortForwardArgs(buffer_arg) {
+  return engine_service_pb.CreatePortForwardArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_CreatePortForwardResponse(arg) {
+  if (!(arg instanceof engine_service_pb.CreatePortForwardResponse)) {
+    throw new Error('Expected argument of type engine_api.CreatePortForwardResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CreatePortForwardResponse(buffer_arg) {
+  return engine_service_pb.CreatePortForwardResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_DestroyEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.DestroyEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.DestroyEnclaveArgs');
@@ -61,6 +83,28 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_DestroyPortForwardArgs(arg) {
+  if (!(arg instanceof engine_service_pb.DestroyPortForwardArgs)) {
+    throw new Error('Expected argument of type engine_api.DestroyPortForwardArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_DestroyPortForwardArgs(buffer_arg) {
+  return engine_service_pb.DestroyPortForwardArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_EnclaveSnapshotChunk(arg) {
+  if (!(arg instanceof engine_service_pb.EnclaveSnapshotChunk)) {
+    throw new Error('Expected argument of type engine_api.EnclaveSnapshotChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EnclaveSnapshotChunk(buffer_arg) {
+  return engine_service_pb.EnclaveSnapshotChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -94,6 +138,17 @@ function deserialize_engine_api_GetExistingAndHistoricalEnclaveIdentifiersRespon
   return engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetPortForwardsResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetPortForwardsResponse)) {
+    throw new Error('Expected argument of type engine_api.GetPortForwardsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetPortForwardsResponse(buffer_arg) {
+  return engine_service_pb.GetPortForwardsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetServiceLogsArgs(arg) {
   if (!(arg instanceof engine_service_pb.GetServiceLogsArgs)) {
     throw new Error('Expected argument of type engine_api.GetServiceLogsArgs');
@@ -116,6 +171,50 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_RestoreEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.RestoreEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.RestoreEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RestoreEnclaveArgs(buffer_arg) {
+  return engine_service_pb.RestoreEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_RestoreEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.RestoreEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.RestoreEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RestoreEnclaveResponse(buffer_arg) {
+  return engine_service_pb.RestoreEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_SnapshotEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.SnapshotEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.SnapshotEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_SnapshotEnclaveArgs(buffer_arg) {
+  return engine_service_pb.SnapshotEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_StartEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.StartEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.StartEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StartEnclaveArgs(buffer_arg) {
+  return engine_service_pb.StartEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -203,6 +302,42 @@ stopEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Starts again all containers of a stopped enclave
+startEnclave: {
+    path: '/engine_api.EngineService/StartEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.StartEnclaveArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_StartEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_StartEnclaveArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Takes a snapshot of an enclave, streamed back as the chunks of a gzipped TAR archive
+snapshotEnclave: {
+    path: '/engine_api.EngineService/SnapshotEnclave',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.SnapshotEnclaveArgs,
+    responseType: engine_service_pb.EnclaveSnapshotChunk,
+    requestSerialize: serialize_engine_api_SnapshotEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_SnapshotEnclaveArgs,
+    responseSerialize: serialize_engine_api_EnclaveSnapshotChunk,
+    responseDeserialize: deserialize_engine_api_EnclaveSnapshotChunk,
+  },
+  // Creates a new enclave reproducing the state of an enclave snapshot, streamed as the chunks of the snapshot archive
+restoreEnclave: {
+    path: '/engine_api.EngineService/RestoreEnclave',
+    requestStream: true,
+    responseStream: false,
+    requestType: engine_service_pb.RestoreEnclaveArgs,
+    responseType: engine_service_pb.RestoreEnclaveResponse,
+    requestSerialize: serialize_engine_api_RestoreEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_RestoreEnclaveArgs,
+    responseSerialize: serialize_engine_api_RestoreEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_RestoreEnclaveResponse,
+  },
   // Destroys an enclave, removing all artifacts associated with it
 destroyEnclave: {
     path: '/engine_api.EngineService/DestroyEnclave',
@@ -239,6 +374,45 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // ==============================================================================================
+//                                   Port Forwards
+// ==============================================================================================
+// Forwards a local port of the machine running the engine to a private port of a service
+createPortForward: {
+    path: '/engine_api.EngineService/CreatePortForward',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.CreatePortForwardArgs,
+    responseType: engine_service_pb.CreatePortForwardResponse,
+    requestSerialize: serialize_engine_api_CreatePortForwardArgs,
+    requestDeserialize: deserialize_engine_api_CreatePortForwardArgs,
+    responseSerialize: serialize_engine_api_CreatePortForwardResponse,
+    responseDeserialize: deserialize_engine_api_CreatePortForwardResponse,
+  },
+  // Returns information about the existing port forwards
+getPortForwards: {
+    path: '/engine_api.EngineService/GetPortForwards',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetPortForwardsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetPortForwardsResponse,
+    responseDeserialize: deserialize_engine_api_GetPortForwardsResponse,
+  },
+  // Stops forwarding a local port
+destroyPortForward: {
+    path: '/engine_api.EngineService/DestroyPortForward',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.DestroyPortForwardArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_DestroyPortForwardArgs,
+    requestDeserialize: deserialize_engine_api_DestroyPortForwardArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -44,6 +44,18 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  snapshotEnclave(
+    request: engine_service_pb.SnapshotEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +75,27 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  createPortForward(
+    request: engine_service_pb.CreatePortForwardArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.CreatePortForwardResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.CreatePortForwardResponse>;
+
+  getPortForwards(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetPortForwardsResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetPortForwardsResponse>;
+
+  destroyPortForward(
+    request: engine_service_pb.DestroyPortForwardArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -95,6 +128,16 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  snapshotEnclave(
+    request: engine_service_pb.SnapshotEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +153,20 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  createPortForward(
+    request: engine_service_pb.CreatePortForwardArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.CreatePortForwardResponse>;
+
+  getPortForwards(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetPortForwardsResponse>;
+
+  destroyPortForward(
+    request: engine_service_pb.DestroyPortForwardArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
 }
 
@@ -383,6 +383,123 @@ proto.engine_api.EngineServicePromiseClient.prototype.stopEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StartEnclaveArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_StartEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StartEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.StartEnclaveArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.StartEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.startEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.startEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.SnapshotEnclaveArgs,
+ *   !proto.engine_api.EnclaveSnapshotChunk>}
+ */
+const methodDescriptor_EngineService_SnapshotEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/SnapshotEnclave',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.SnapshotEnclaveArgs,
+  proto.engine_api.EnclaveSnapshotChunk,
+  /**
+   * @param {!proto.engine_api.SnapshotEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveSnapshotChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveSnapshotChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.snapshotEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/SnapshotEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SnapshotEnclave);
+};
+
+
+/**
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveSnapshotChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.snapshotEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/SnapshotEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SnapshotEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +678,188 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.CreatePortForwardArgs,
+ *   !proto.engine_api.CreatePortForwardResponse>}
+ */
+const methodDescriptor_EngineService_CreatePortForward = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/CreatePortForward',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.CreatePortForwardArgs,
+  proto.engine_api.CreatePortForwardResponse,
+  /**
+   * @param {!proto.engine_api.CreatePortForwardArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.CreatePortForwardResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.CreatePortForwardArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.CreatePortForwardResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.CreatePortForwardResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.createPortForward =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/CreatePortForward',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CreatePortForward,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.CreatePortForwardArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.CreatePortForwardResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.createPortForward =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/CreatePortForward',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CreatePortForward);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetPortForwardsResponse>}
+ */
+const methodDescriptor_EngineService_GetPortForwards = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetPortForwards',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetPortForwardsResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetPortForwardsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetPortForwardsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetPortForwardsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getPortForwards =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetPortForwards',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetPortForwards,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetPortForwardsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getPortForwards =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetPortForwards',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetPortForwards);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.DestroyPortForwardArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_DestroyPortForward = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/DestroyPortForward',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.DestroyPortForwardArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.DestroyPortForwardArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.DestroyPortForwardArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.destroyPortForward =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/DestroyPortForward',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_DestroyPortForward,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.DestroyPortForwardArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.destroyPortForward =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/DestroyPortForward',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_DestroyPortForward);
+};
+
+
 module.exports = proto.engine_api;
 
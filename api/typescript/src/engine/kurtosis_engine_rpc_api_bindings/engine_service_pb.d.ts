@@ -35,6 +35,12 @@ export class CreateEnclaveArgs extends jspb.Message {
   getIsPartitioningEnabled(): boolean;
   setIsPartitioningEnabled(value: boolean): CreateEnclaveArgs;
 
+  getIsIpv6Enabled(): boolean;
+  setIsIpv6Enabled(value: boolean): CreateEnclaveArgs;
+
+  getGitCredentialsPerGitHostMap(): jspb.Map<string, GitCredentials>;
+  clearGitCredentialsPerGitHostMap(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -49,6 +55,38 @@ export namespace CreateEnclaveArgs {
     apiContainerVersionTag: string,
     apiContainerLogLevel: string,
     isPartitioningEnabled: boolean,
+    isIpv6Enabled: boolean,
+    gitCredentialsPerGitHostMap: Array<[string, GitCredentials.AsObject]>,
+  }
+}
+
+export class GitCredentials extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): GitCredentials;
+
+  getToken(): string;
+  setToken(value: string): GitCredentials;
+
+  getSshPrivateKey(): string;
+  setSshPrivateKey(value: string): GitCredentials;
+
+  getSshKnownHosts(): string;
+  setSshKnownHosts(value: string): GitCredentials;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GitCredentials.AsObject;
+  static toObject(includeInstance: boolean, msg: GitCredentials): GitCredentials.AsObject;
+  static serializeBinaryToWriter(message: GitCredentials, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GitCredentials;
+  static deserializeBinaryFromReader(message: GitCredentials, reader: jspb.BinaryReader): GitCredentials;
+}
+
+export namespace GitCredentials {
+  export type AsObject = {
+    username: string,
+    token: string,
+    sshPrivateKey: string,
+    sshKnownHosts: string,
   }
 }
 
@@ -262,6 +300,114 @@ export namespace StopEnclaveArgs {
   }
 }
 
+export class StartEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): StartEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StartEnclaveArgs): StartEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: StartEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartEnclaveArgs;
+  static deserializeBinaryFromReader(message: StartEnclaveArgs, reader: jspb.BinaryReader): StartEnclaveArgs;
+}
+
+export namespace StartEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class SnapshotEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): SnapshotEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SnapshotEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SnapshotEnclaveArgs): SnapshotEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: SnapshotEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SnapshotEnclaveArgs;
+  static deserializeBinaryFromReader(message: SnapshotEnclaveArgs, reader: jspb.BinaryReader): SnapshotEnclaveArgs;
+}
+
+export namespace SnapshotEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class EnclaveSnapshotChunk extends jspb.Message {
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): EnclaveSnapshotChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveSnapshotChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveSnapshotChunk): EnclaveSnapshotChunk.AsObject;
+  static serializeBinaryToWriter(message: EnclaveSnapshotChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveSnapshotChunk;
+  static deserializeBinaryFromReader(message: EnclaveSnapshotChunk, reader: jspb.BinaryReader): EnclaveSnapshotChunk;
+}
+
+export namespace EnclaveSnapshotChunk {
+  export type AsObject = {
+    content: Uint8Array | string,
+  }
+}
+
+export class RestoreEnclaveArgs extends jspb.Message {
+  getEnclaveName(): string;
+  setEnclaveName(value: string): RestoreEnclaveArgs;
+
+  getApiContainerVersionTag(): string;
+  setApiContainerVersionTag(value: string): RestoreEnclaveArgs;
+
+  getApiContainerLogLevel(): string;
+  setApiContainerLogLevel(value: string): RestoreEnclaveArgs;
+
+  getSnapshotChunk(): Uint8Array | string;
+  getSnapshotChunk_asU8(): Uint8Array;
+  getSnapshotChunk_asB64(): string;
+  setSnapshotChunk(value: Uint8Array | string): RestoreEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveArgs): RestoreEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveArgs;
+  static deserializeBinaryFromReader(message: RestoreEnclaveArgs, reader: jspb.BinaryReader): RestoreEnclaveArgs;
+}
+
+export namespace RestoreEnclaveArgs {
+  export type AsObject = {
+    enclaveName: string,
+    apiContainerVersionTag: string,
+    apiContainerLogLevel: string,
+    snapshotChunk: Uint8Array | string,
+  }
+}
+
+export class RestoreEnclaveResponse extends jspb.Message {
+  getEnclaveInfo(): EnclaveInfo | undefined;
+  setEnclaveInfo(value?: EnclaveInfo): RestoreEnclaveResponse;
+  hasEnclaveInfo(): boolean;
+  clearEnclaveInfo(): RestoreEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveResponse): RestoreEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveResponse;
+  static deserializeBinaryFromReader(message: RestoreEnclaveResponse, reader: jspb.BinaryReader): RestoreEnclaveResponse;
+}
+
+export namespace RestoreEnclaveResponse {
+  export type AsObject = {
+    enclaveInfo?: EnclaveInfo.AsObject,
+  }
+}
+
 export class DestroyEnclaveArgs extends jspb.Message {
   getEnclaveIdentifier(): string;
   setEnclaveIdentifier(value: string): DestroyEnclaveArgs;
@@ -436,6 +582,134 @@ export namespace LogLineFilter {
   }
 }
 
+export class PortForwardInfo extends jspb.Message {
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): PortForwardInfo;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): PortForwardInfo;
+
+  getServiceUuid(): string;
+  setServiceUuid(value: string): PortForwardInfo;
+
+  getServiceName(): string;
+  setServiceName(value: string): PortForwardInfo;
+
+  getPortId(): string;
+  setPortId(value: string): PortForwardInfo;
+
+  getLocalPortNumber(): number;
+  setLocalPortNumber(value: number): PortForwardInfo;
+
+  getIsRunning(): boolean;
+  setIsRunning(value: boolean): PortForwardInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PortForwardInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: PortForwardInfo): PortForwardInfo.AsObject;
+  static serializeBinaryToWriter(message: PortForwardInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PortForwardInfo;
+  static deserializeBinaryFromReader(message: PortForwardInfo, reader: jspb.BinaryReader): PortForwardInfo;
+}
+
+export namespace PortForwardInfo {
+  export type AsObject = {
+    enclaveUuid: string,
+    enclaveName: string,
+    serviceUuid: string,
+    serviceName: string,
+    portId: string,
+    localPortNumber: number,
+    isRunning: boolean,
+  }
+}
+
+export class CreatePortForwardArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): CreatePortForwardArgs;
+
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CreatePortForwardArgs;
+
+  getPortId(): string;
+  setPortId(value: string): CreatePortForwardArgs;
+
+  getLocalPortNumber(): number;
+  setLocalPortNumber(value: number): CreatePortForwardArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreatePortForwardArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CreatePortForwardArgs): CreatePortForwardArgs.AsObject;
+  static serializeBinaryToWriter(message: CreatePortForwardArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreatePortForwardArgs;
+  static deserializeBinaryFromReader(message: CreatePortForwardArgs, reader: jspb.BinaryReader): CreatePortForwardArgs;
+}
+
+export namespace CreatePortForwardArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    serviceIdentifier: string,
+    portId: string,
+    localPortNumber: number,
+  }
+}
+
+export class CreatePortForwardResponse extends jspb.Message {
+  getPortForwardInfo(): PortForwardInfo | undefined;
+  setPortForwardInfo(value?: PortForwardInfo): CreatePortForwardResponse;
+  hasPortForwardInfo(): boolean;
+  clearPortForwardInfo(): CreatePortForwardResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreatePortForwardResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreatePortForwardResponse): CreatePortForwardResponse.AsObject;
+  static serializeBinaryToWriter(message: CreatePortForwardResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreatePortForwardResponse;
+  static deserializeBinaryFromReader(message: CreatePortForwardResponse, reader: jspb.BinaryReader): CreatePortForwardResponse;
+}
+
+export namespace CreatePortForwardResponse {
+  export type AsObject = {
+    portForwardInfo?: PortForwardInfo.AsObject,
+  }
+}
+
+export class GetPortForwardsResponse extends jspb.Message {
+  getPortForwardInfoMap(): jspb.Map<number, PortForwardInfo>;
+  clearPortForwardInfoMap(): GetPortForwardsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPortForwardsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPortForwardsResponse): GetPortForwardsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetPortForwardsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPortForwardsResponse;
+  static deserializeBinaryFromReader(message: GetPortForwardsResponse, reader: jspb.BinaryReader): GetPortForwardsResponse;
+}
+
+export namespace GetPortForwardsResponse {
+  export type AsObject = {
+    portForwardInfoMap: Array<[number, PortForwardInfo.AsObject]>,
+  }
+}
+
+export class DestroyPortForwardArgs extends jspb.Message {
+  getLocalPortNumber(): number;
+  setLocalPortNumber(value: number): DestroyPortForwardArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DestroyPortForwardArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: DestroyPortForwardArgs): DestroyPortForwardArgs.AsObject;
+  static serializeBinaryToWriter(message: DestroyPortForwardArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DestroyPortForwardArgs;
+  static deserializeBinaryFromReader(message: DestroyPortForwardArgs, reader: jspb.BinaryReader): DestroyPortForwardArgs;
+}
+
+export namespace DestroyPortForwardArgs {
+  export type AsObject = {
+    localPortNumber: number,
+  }
+}
+
 export enum EnclaveContainersStatus { 
   ENCLAVECONTAINERSSTATUS_EMPTY = 0,
   ENCLAVECONTAINERSSTATUS_RUNNING = 1,
@@ -23,7 +23,10 @@ goog.exportSymbol('proto.engine_api.CleanArgs', null, global);
 goog.exportSymbol('proto.engine_api.CleanResponse', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.CreatePortForwardArgs', null, global);
+goog.exportSymbol('proto.engine_api.CreatePortForwardResponse', null, global);
 goog.exportSymbol('proto.engine_api.DestroyEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.DestroyPortForwardArgs', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerHostMachineInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
@@ -31,14 +34,22 @@ goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveSnapshotChunk', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetPortForwardsResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
+goog.exportSymbol('proto.engine_api.GitCredentials', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.PortForwardInfo', null, global);
+goog.exportSymbol('proto.engine_api.RestoreEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.RestoreEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.SnapshotEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.StartEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -82,6 +93,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.CreateEnclaveArgs.displayName = 'proto.engine_api.CreateEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GitCredentials = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GitCredentials, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GitCredentials.displayName = 'proto.engine_api.GitCredentials';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -250,6 +282,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.StopEnclaveArgs.displayName = 'proto.engine_api.StopEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StartEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.StartEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StartEnclaveArgs.displayName = 'proto.engine_api.StartEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.SnapshotEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.SnapshotEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.SnapshotEnclaveArgs.displayName = 'proto.engine_api.SnapshotEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveSnapshotChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveSnapshotChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveSnapshotChunk.displayName = 'proto.engine_api.EnclaveSnapshotChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RestoreEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RestoreEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RestoreEnclaveArgs.displayName = 'proto.engine_api.RestoreEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RestoreEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RestoreEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RestoreEnclaveResponse.displayName = 'proto.engine_api.RestoreEnclaveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -418,6 +555,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.PortForwardInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.PortForwardInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.PortForwardInfo.displayName = 'proto.engine_api.PortForwardInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CreatePortForwardArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.CreatePortForwardArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CreatePortForwardArgs.displayName = 'proto.engine_api.CreatePortForwardArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CreatePortForwardResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.CreatePortForwardResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CreatePortForwardResponse.displayName = 'proto.engine_api.CreatePortForwardResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetPortForwardsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetPortForwardsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetPortForwardsResponse.displayName = 'proto.engine_api.GetPortForwardsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.DestroyPortForwardArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.DestroyPortForwardArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.DestroyPortForwardArgs.displayName = 'proto.engine_api.DestroyPortForwardArgs';
+}
 
 
 
@@ -583,7 +825,9 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
     enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
     apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
     apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    isPartitioningEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    isPartitioningEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    isIpv6Enabled: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    gitCredentialsPerGitHostMap: (f = msg.getGitCredentialsPerGitHostMap()) ? f.toObject(includeInstance, proto.engine_api.GitCredentials.toObject) : []
   };
 
   if (includeInstance) {
@@ -636,6 +880,16 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setIsPartitioningEnabled(value);
       break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsIpv6Enabled(value);
+      break;
+    case 6:
+      var value = msg.getGitCredentialsPerGitHostMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.GitCredentials.deserializeBinaryFromReader, "", new proto.engine_api.GitCredentials());
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -693,6 +947,17 @@ proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getIsIpv6Enabled();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getGitCredentialsPerGitHostMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.GitCredentials.serializeBinaryToWriter);
+  }
 };
 
 
@@ -768,6 +1033,46 @@ proto.engine_api.CreateEnclaveArgs.prototype.setIsPartitioningEnabled = function
 };
 
 
+/**
+ * optional bool is_ipv6_enabled = 5;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getIsIpv6Enabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIsIpv6Enabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * map<string, GitCredentials> git_credentials_per_git_host = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.GitCredentials>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getGitCredentialsPerGitHostMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.GitCredentials>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      proto.engine_api.GitCredentials));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearGitCredentialsPerGitHostMap = function() {
+  this.getGitCredentialsPerGitHostMap().clear();
+  return this;};
+
+
 
 
 
@@ -784,8 +1089,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GitCredentials.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GitCredentials.toObject(opt_includeInstance, this);
 };
 
 
@@ -794,13 +1099,16 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GitCredentials} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GitCredentials.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sshPrivateKey: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    sshKnownHosts: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -814,23 +1122,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.GitCredentials}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GitCredentials.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GitCredentials;
+  return proto.engine_api.GitCredentials.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GitCredentials} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.GitCredentials}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GitCredentials.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -838,9 +1146,226 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSshPrivateKey(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSshKnownHosts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GitCredentials.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GitCredentials.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GitCredentials} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GitCredentials.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSshPrivateKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSshKnownHosts();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.engine_api.GitCredentials.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GitCredentials} returns this
+ */
+proto.engine_api.GitCredentials.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string token = 2;
+ * @return {string}
+ */
+proto.engine_api.GitCredentials.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GitCredentials} returns this
+ */
+proto.engine_api.GitCredentials.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string ssh_private_key = 3;
+ * @return {string}
+ */
+proto.engine_api.GitCredentials.prototype.getSshPrivateKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GitCredentials} returns this
+ */
+proto.engine_api.GitCredentials.prototype.setSshPrivateKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string ssh_known_hosts = 4;
+ * @return {string}
+ */
+proto.engine_api.GitCredentials.prototype.getSshKnownHosts = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GitCredentials} returns this
+ */
+proto.engine_api.GitCredentials.prototype.setSshKnownHosts = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
       break;
     default:
       reader.skipField();
@@ -2361,8 +2886,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.StartEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StartEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2371,11 +2896,11 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.StartEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -2391,23 +2916,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.StartEnclaveArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.StartEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.StartEnclaveArgs;
+  return proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.StartEnclaveArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2431,9 +2956,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.StartEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2441,11 +2966,11 @@ proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.StartEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -2461,16 +2986,16 @@ proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message,
  * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.StartEnclaveArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * @return {!proto.engine_api.StartEnclaveArgs} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.StartEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -2491,8 +3016,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.SnapshotEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.SnapshotEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2501,13 +3026,13 @@ proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.SnapshotEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2521,23 +3046,23 @@ proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.SnapshotEnclaveArgs}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+proto.engine_api.SnapshotEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.SnapshotEnclaveArgs;
+  return proto.engine_api.SnapshotEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.SnapshotEnclaveArgs}
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.SnapshotEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2545,8 +3070,8 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -2561,9 +3086,9 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+proto.engine_api.SnapshotEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.SnapshotEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2571,15 +3096,15 @@ proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.SnapshotEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getShouldCleanAll();
-  if (f) {
-    writer.writeBool(
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -2588,20 +3113,20 @@ proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * optional string enclave_identifier = 1;
+ * @return {string}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.SnapshotEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.engine_api.SnapshotEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2621,8 +3146,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveSnapshotChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveSnapshotChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -2631,14 +3156,13 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveSnapshotChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    content: msg.getContent_asB64()
   };
 
   if (includeInstance) {
@@ -2652,23 +3176,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.EnclaveSnapshotChunk}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveSnapshotChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveSnapshotChunk;
+  return proto.engine_api.EnclaveSnapshotChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.EnclaveSnapshotChunk}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveSnapshotChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2676,12 +3200,8 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
       break;
     default:
       reader.skipField();
@@ -2696,9 +3216,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveSnapshotChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveSnapshotChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2706,73 +3226,65 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveSnapshotChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getContent_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional bytes content = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+proto.engine_api.EnclaveSnapshotChunk.prototype.getContent = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.EnclaveSnapshotChunk.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
 };
 
 
 /**
- * optional string uuid = 2;
- * @return {string}
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.EnclaveSnapshotChunk.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.EnclaveSnapshotChunk} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.EnclaveSnapshotChunk.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2788,8 +3300,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+proto.engine_api.RestoreEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RestoreEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2798,14 +3310,16 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.RestoreEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.RestoreEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    snapshotChunk: msg.getSnapshotChunk_asB64()
   };
 
   if (includeInstance) {
@@ -2819,23 +3333,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.RestoreEnclaveArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.RestoreEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RestoreEnclaveArgs;
+  return proto.engine_api.RestoreEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RestoreEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.RestoreEnclaveArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RestoreEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2843,9 +3357,20 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerVersionTag(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerLogLevel(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setSnapshotChunk(value);
       break;
     default:
       reader.skipField();
@@ -2860,9 +3385,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.RestoreEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RestoreEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2870,68 +3395,139 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.RestoreEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RestoreEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
+  f = message.getEnclaveName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getApiContainerVersionTag();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApiContainerLogLevel();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSnapshotChunk_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * optional string enclave_name = 1;
+ * @return {string}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.RestoreEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
-*/
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * optional string api_container_version_tag = 2;
+ * @return {string}
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.RestoreEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.RestoreEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string api_container_log_level = 3;
+ * @return {string}
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional bytes snapshot_chunk = 5;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+proto.engine_api.RestoreEnclaveArgs.prototype.getSnapshotChunk = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes snapshot_chunk = 5;
+ * This is a type-conversion wrapper around `getSnapshotChunk()`
+ * @return {string}
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.getSnapshotChunk_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getSnapshotChunk()));
+};
+
+
+/**
+ * optional bytes snapshot_chunk = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getSnapshotChunk()`
+ * @return {!Uint8Array}
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.getSnapshotChunk_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getSnapshotChunk()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.setSnapshotChunk = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
 
 
 
@@ -2948,8 +3544,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.RestoreEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RestoreEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2958,17 +3554,13 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.RestoreEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.RestoreEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance)
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2982,23 +3574,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.RestoreEnclaveResponse}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.RestoreEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RestoreEnclaveResponse;
+  return proto.engine_api.RestoreEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RestoreEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.RestoreEnclaveResponse}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RestoreEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3006,23 +3598,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
-      break;
-    case 2:
-      var value = msg.getServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
-      break;
-    case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
       break;
     default:
       reader.skipField();
@@ -3037,9 +3615,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.RestoreEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RestoreEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3047,134 +3625,1902 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.RestoreEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RestoreEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
-  f = message.getFollowLogs();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getConjunctiveFiltersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
       f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.RestoreEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.RestoreEnclaveResponse} returns this
+*/
+proto.engine_api.RestoreEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.RestoreEnclaveResponse} returns this
+ */
+proto.engine_api.RestoreEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.RestoreEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getShouldCleanAll();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string uuid = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedEnclaveNameAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ */
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
+ */
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
+      break;
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getFollowLogs();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
 proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, bool> service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
+      break;
+    case 2:
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  }
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
+
+
+/**
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.LogLine.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLineList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string line = 1;
+ * @return {!Array<string>}
+ */
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPattern(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getTextPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
+ */
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string text_pattern = 2;
+ * @return {string}
+ */
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.PortForwardInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.PortForwardInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.PortForwardInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PortForwardInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    serviceName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    portId: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    localPortNumber: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    isRunning: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.PortForwardInfo}
+ */
+proto.engine_api.PortForwardInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.PortForwardInfo;
+  return proto.engine_api.PortForwardInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.PortForwardInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.PortForwardInfo}
+ */
+proto.engine_api.PortForwardInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPortId(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLocalPortNumber(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRunning(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.PortForwardInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.PortForwardInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.PortForwardInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PortForwardInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getEnclaveName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getServiceName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getPortId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLocalPortNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getIsRunning();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.PortForwardInfo.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string enclave_name = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.PortForwardInfo.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string service_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.PortForwardInfo.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string service_name = 4;
+ * @return {string}
+ */
+proto.engine_api.PortForwardInfo.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string port_id = 5;
+ * @return {string}
+ */
+proto.engine_api.PortForwardInfo.prototype.getPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setPortId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 local_port_number = 6;
+ * @return {number}
+ */
+proto.engine_api.PortForwardInfo.prototype.getLocalPortNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setLocalPortNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional bool is_running = 7;
+ * @return {boolean}
+ */
+proto.engine_api.PortForwardInfo.prototype.getIsRunning = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.PortForwardInfo} returns this
+ */
+proto.engine_api.PortForwardInfo.prototype.setIsRunning = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreatePortForwardArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreatePortForwardArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreatePortForwardArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreatePortForwardArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    portId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    localPortNumber: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreatePortForwardArgs}
+ */
+proto.engine_api.CreatePortForwardArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreatePortForwardArgs;
+  return proto.engine_api.CreatePortForwardArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreatePortForwardArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreatePortForwardArgs}
+ */
+proto.engine_api.CreatePortForwardArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPortId(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLocalPortNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreatePortForwardArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreatePortForwardArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreatePortForwardArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreatePortForwardArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPortId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getLocalPortNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.CreatePortForwardArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CreatePortForwardArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
-  return this;};
+proto.engine_api.CreatePortForwardArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * optional bool follow_logs = 3;
- * @return {boolean}
+ * optional string service_identifier = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.engine_api.CreatePortForwardArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CreatePortForwardArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.engine_api.CreatePortForwardArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
+ * optional string port_id = 3;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
-  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+proto.engine_api.CreatePortForwardArgs.prototype.getPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.engine_api.CreatePortForwardArgs} returns this
+ */
+proto.engine_api.CreatePortForwardArgs.prototype.setPortId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * optional uint32 local_port_number = 4;
+ * @return {number}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.CreatePortForwardArgs.prototype.getLocalPortNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.CreatePortForwardArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.CreatePortForwardArgs.prototype.setLocalPortNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -3194,8 +5540,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CreatePortForwardResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreatePortForwardResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3204,14 +5550,13 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreatePortForwardResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.CreatePortForwardResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    portForwardInfo: (f = msg.getPortForwardInfo()) && proto.engine_api.PortForwardInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3225,23 +5570,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.CreatePortForwardResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.CreatePortForwardResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreatePortForwardResponse;
+  return proto.engine_api.CreatePortForwardResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreatePortForwardResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.CreatePortForwardResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreatePortForwardResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3249,16 +5594,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
-         });
-      break;
-    case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = new proto.engine_api.PortForwardInfo;
+      reader.readMessage(value,proto.engine_api.PortForwardInfo.deserializeBinaryFromReader);
+      msg.setPortForwardInfo(value);
       break;
     default:
       reader.skipField();
@@ -3273,9 +5611,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.CreatePortForwardResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreatePortForwardResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3283,74 +5621,60 @@ proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!proto.engine_api.CreatePortForwardResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreatePortForwardResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
-  }
-  f = message.getNotFoundServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getPortForwardInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.PortForwardInfo.serializeBinaryToWriter
+    );
   }
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ * optional PortForwardInfo port_forward_info = 1;
+ * @return {?proto.engine_api.PortForwardInfo}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+proto.engine_api.CreatePortForwardResponse.prototype.getPortForwardInfo = function() {
+  return /** @type{?proto.engine_api.PortForwardInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.PortForwardInfo, 1));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
- */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
-  return this;};
+ * @param {?proto.engine_api.PortForwardInfo|undefined} value
+ * @return {!proto.engine_api.CreatePortForwardResponse} returns this
+*/
+proto.engine_api.CreatePortForwardResponse.prototype.setPortForwardInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreatePortForwardResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.CreatePortForwardResponse.prototype.clearPortForwardInfo = function() {
+  return this.setPortForwardInfo(undefined);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
-
+proto.engine_api.CreatePortForwardResponse.prototype.hasPortForwardInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.LogLine.repeatedFields_ = [1];
 
 
 
@@ -3367,8 +5691,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+proto.engine_api.GetPortForwardsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetPortForwardsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3377,13 +5701,13 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetPortForwardsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+proto.engine_api.GetPortForwardsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    portForwardInfoMap: (f = msg.getPortForwardInfoMap()) ? f.toObject(includeInstance, proto.engine_api.PortForwardInfo.toObject) : []
   };
 
   if (includeInstance) {
@@ -3397,23 +5721,23 @@ proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.GetPortForwardsResponse}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+proto.engine_api.GetPortForwardsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetPortForwardsResponse;
+  return proto.engine_api.GetPortForwardsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetPortForwardsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.GetPortForwardsResponse}
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetPortForwardsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3421,8 +5745,10 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
+      var value = msg.getPortForwardInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readUint32, jspb.BinaryReader.prototype.readMessage, proto.engine_api.PortForwardInfo.deserializeBinaryFromReader, 0, new proto.engine_api.PortForwardInfo());
+         });
       break;
     default:
       reader.skipField();
@@ -3437,9 +5763,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
+proto.engine_api.GetPortForwardsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetPortForwardsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3447,57 +5773,39 @@ proto.engine_api.LogLine.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
+ * @param {!proto.engine_api.GetPortForwardsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetPortForwardsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLineList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
+  f = message.getPortForwardInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeUint32, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.PortForwardInfo.serializeBinaryToWriter);
   }
 };
 
 
 /**
- * repeated string line = 1;
- * @return {!Array<string>}
- */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
+ * map<uint32, PortForwardInfo> port_forward_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,!proto.engine_api.PortForwardInfo>}
  */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.GetPortForwardsResponse.prototype.getPortForwardInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,!proto.engine_api.PortForwardInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.PortForwardInfo));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetPortForwardsResponse} returns this
  */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
-};
+proto.engine_api.GetPortForwardsResponse.prototype.clearPortForwardInfoMap = function() {
+  this.getPortForwardInfoMap().clear();
+  return this;};
 
 
 
@@ -3516,8 +5824,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.DestroyPortForwardArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyPortForwardArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3526,14 +5834,13 @@ proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @param {!proto.engine_api.DestroyPortForwardArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+proto.engine_api.DestroyPortForwardArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+    localPortNumber: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -3547,23 +5854,23 @@ proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.DestroyPortForwardArgs}
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+proto.engine_api.DestroyPortForwardArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.DestroyPortForwardArgs;
+  return proto.engine_api.DestroyPortForwardArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!proto.engine_api.DestroyPortForwardArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.DestroyPortForwardArgs}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.DestroyPortForwardArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3571,12 +5878,8 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLocalPortNumber(value);
       break;
     default:
       reader.skipField();
@@ -3591,9 +5894,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+proto.engine_api.DestroyPortForwardArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  proto.engine_api.DestroyPortForwardArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3601,62 +5904,37 @@ proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!proto.engine_api.DestroyPortForwardArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.DestroyPortForwardArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getLocalPortNumber();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getTextPattern();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
- */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
- */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional string text_pattern = 2;
- * @return {string}
+ * optional uint32 local_port_number = 1;
+ * @return {number}
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.DestroyPortForwardArgs.prototype.getLocalPortNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.DestroyPortForwardArgs} returns this
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.DestroyPortForwardArgs.prototype.setLocalPortNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
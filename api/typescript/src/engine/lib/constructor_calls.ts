@@ -6,6 +6,7 @@ import {
     LogLineFilter,
     LogLineOperator,
     StopEnclaveArgs,
+    StartEnclaveArgs,
 } from "../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import * as jspb from "google-protobuf";
 import {ServiceUUID} from "../../core/lib/services/service";
@@ -36,6 +37,12 @@ export function newStopEnclaveArgs(enclaveIdentifier:string): DestroyEnclaveArgs
     return result;
 }
 
+export function newStartEnclaveArgs(enclaveIdentifier:string): StartEnclaveArgs {
+    const result: StartEnclaveArgs = new StartEnclaveArgs();
+    result.setEnclaveIdentifier(enclaveIdentifier);
+    return result;
+}
+
 export function newDestroyEnclaveArgs(enclaveIdentifier:string): DestroyEnclaveArgs {
     const result: DestroyEnclaveArgs = new DestroyEnclaveArgs();
     result.setEnclaveIdentifier(enclaveIdentifier);
@@ -8,6 +8,7 @@ import {
     GetEnclavesResponse,
     GetEngineInfoResponse,
     StopEnclaveArgs,
+    StartEnclaveArgs,
     GetServiceLogsArgs, GetExistingAndHistoricalEnclaveIdentifiersResponse,
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import {Readable} from "stream";
@@ -17,6 +18,7 @@ export interface GenericEngineClient {
     createEnclaveResponse(args: CreateEnclaveArgs): Promise<Result<CreateEnclaveResponse, Error>>
     getEnclavesResponse(): Promise<Result<GetEnclavesResponse, Error>>
     stopEnclave(stopEnclaveArgs: StopEnclaveArgs): Promise<Result<null, Error>>
+    startEnclave(startEnclaveArgs: StartEnclaveArgs): Promise<Result<null, Error>>
     destroyEnclave(destroyEnclaveArgs: DestroyEnclaveArgs): Promise<Result<null, Error>>
     clean(cleanArgs: CleanArgs): Promise<Result<CleanResponse, Error>>
     getServiceLogs(getServiceLogsArgs: GetServiceLogsArgs): Promise<Result<Readable, Error>>
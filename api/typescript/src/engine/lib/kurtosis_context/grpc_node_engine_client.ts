@@ -22,7 +22,8 @@ import type {
     GetEngineInfoResponse,
     GetServiceLogsArgs,
     GetServiceLogsResponse,
-    StopEnclaveArgs
+    StopEnclaveArgs,
+    StartEnclaveArgs
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import type {ClientReadableStream, ServiceError} from "@grpc/grpc-js";
 import {ServiceLogsStreamContent} from "./service_logs_stream_content";
@@ -118,6 +119,25 @@ export class GrpcNodeEngineClient implements GenericEngineClient {
         return ok(null);
     }
 
+    public async startEnclave(startEnclaveArgs: StartEnclaveArgs): Promise<Result<null, Error>> {
+        const startEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.startEnclave(startEnclaveArgs, (error: ServiceError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
+                if (error === null) {
+                    resolve(ok(null));
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+
+        const startEnclaveResult: Result<null, Error> = await startEnclavePromise;
+        if (startEnclaveResult.isErr()) {
+            return err(startEnclaveResult.error);
+        }
+
+        return ok(null);
+    }
+
     public async destroyEnclave(destroyEnclaveArgs: DestroyEnclaveArgs): Promise<Result<null, Error>> {
         const destroyEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
             this.client.destroyEnclave(destroyEnclaveArgs, {}, (error: ServiceError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
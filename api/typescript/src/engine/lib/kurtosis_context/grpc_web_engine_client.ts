@@ -12,6 +12,7 @@ import type {
     GetEnclavesResponse,
     GetEngineInfoResponse,
     StopEnclaveArgs,
+    StartEnclaveArgs,
     GetServiceLogsArgs,
     GetServiceLogsResponse
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
@@ -114,6 +115,25 @@ export class GrpcWebEngineClient implements GenericEngineClient {
         return ok(null);
     }
 
+    public async startEnclave(startEnclaveArgs: StartEnclaveArgs): Promise<Result<null, Error>> {
+        const startEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.startEnclave(startEnclaveArgs, {}, (error: grpc_web.RpcError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
+                if (error === null) {
+                    resolve(ok(null));
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+
+        const startEnclaveResult: Result<null, Error> = await startEnclavePromise;
+        if (startEnclaveResult.isErr()) {
+            return err(startEnclaveResult.error);
+        }
+
+        return ok(null);
+    }
+
     public async destroyEnclave(destroyEnclaveArgs: DestroyEnclaveArgs): Promise<Result<null, Error>> {
         const destroyEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
             this.client.destroyEnclave(destroyEnclaveArgs, {}, (error: grpc_web.RpcError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
@@ -21,6 +21,7 @@ import {
     GetEnclavesResponse,
     GetEngineInfoResponse,
     StopEnclaveArgs,
+    StartEnclaveArgs,
     GetServiceLogsArgs, EnclaveNameAndUuid,
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import {
@@ -28,7 +29,8 @@ import {
     newCreateEnclaveArgs,
     newDestroyEnclaveArgs,
     newGetServiceLogsArgs,
-    newStopEnclaveArgs
+    newStopEnclaveArgs,
+    newStartEnclaveArgs
 } from "../constructor_calls";
 import {Readable} from "stream";
 import {LogLineFilter} from "./log_line_filter";
@@ -217,6 +219,17 @@ export class KurtosisContext {
         return ok(null)
     }
 
+    // Docs available at https://docs.kurtosis.com/sdk/#startenclavestring-enclaveidentifier
+    public async startEnclave(enclaveIdentifier: string): Promise<Result<null, Error>>{
+        const startEnclaveArgs: StartEnclaveArgs = newStartEnclaveArgs(enclaveIdentifier)
+        const startEnclaveResult = await this.client.startEnclave(startEnclaveArgs)
+        if(startEnclaveResult.isErr()){
+            return err(startEnclaveResult.error)
+        }
+
+        return ok(null)
+    }
+
     // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
     public async destroyEnclave(enclaveIdentifier: string): Promise<Result<null, Error>>{
         const destroyEnclaveArgs: DestroyEnclaveArgs = newDestroyEnclaveArgs(enclaveIdentifier);
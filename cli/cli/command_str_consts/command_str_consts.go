@@ -23,9 +23,12 @@ const (
 	EnclaveInspectCmdStr    = "inspect"
 	EnclaveLsCmdStr         = "ls"
 	EnclaveAddCmdStr        = "add"
+	EnclaveStartCmdStr      = "start"
 	EnclaveStopCmdStr       = "stop"
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
+	EnclaveSnapshotCmdStr   = "snapshot"
+	EnclaveRestoreCmdStr    = "restore"
 	EngineCmdStr            = "engine"
 	EngineStartCmdStr       = "start"
 	EngineStatusCmdStr      = "status"
@@ -38,12 +41,22 @@ const (
 	FilesStoreWebCmdStr     = "storeweb"
 	FilesStoreServiceCmdStr = "storeservice"
 	FilesRenderTemplate     = "rendertemplate"
+	PackageCmdStr           = "package"
+	PackageLockCmdStr       = "lock"
+	PackageVendorCmdStr     = "vendor"
+	PortCmdStr              = "port"
+	PortForwardCmdStr       = "forward"
+	PortLsCmdStr            = "ls"
+	PortRmCmdStr            = "rm"
 	ServiceCmdStr           = "service"
 	ServiceAddCmdStr        = "add"
 	ServiceLogsCmdStr       = "logs"
+	ServicePcapCmdStr       = "pcap"
+	ServiceRestartCmdStr    = "restart"
 	ServiceRmCmdStr         = "rm"
 	ServiceShellCmdStr      = "shell"
 	StarlarkRunCmdStr       = "run"
+	TestCmdStr              = "test"
 	ConfigCmdStr            = "config"
 	InitCmdStr              = "init"
 	PathCmdStr              = "path"
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -24,11 +25,13 @@ const (
 	apiContainerVersionFlagKey  = "api-container-version"
 	apiContainerLogLevelFlagKey = "api-container-log-level"
 	isSubnetworksEnabledFlagKey = "with-subnetworks"
+	isIpv6EnabledFlagKey        = "ipv6"
 	// TODO(deprecation) remove enclave ids in favor of names
 	enclaveIdFlagKey   = "id"
 	enclaveNameFlagKey = "name"
 
 	defaultIsSubnetworksEnabled = "false"
+	defaultIsIpv6Enabled        = "false"
 
 	// Signifies that an enclave ID should be auto-generated
 	autogenerateEnclaveIdKeyword = ""
@@ -71,6 +74,11 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   defaultIsSubnetworksEnabled,
 			Usage:     "If set to true then the enclave that gets created will have subnetwork capabilities",
+		}, {
+			Key:     isIpv6EnabledFlagKey,
+			Type:    flags.FlagType_Bool,
+			Default: defaultIsIpv6Enabled,
+			Usage:   "If set to true then the services of the enclave that gets created will get an IPv6 address in addition to their IPv4 address (Docker only)",
 		}, {
 			Key:       enclaveIdFlagKey,
 			Shorthand: "i",
@@ -115,6 +123,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the is-subnetwork-enabled setting using flag key '%v'; this is a bug in Kurtosis", isSubnetworksEnabledFlagKey)
 	}
 
+	isIpv6Enabled, err := flags.GetBool(isIpv6EnabledFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the is-IPv6-enabled setting using flag key '%v'; this is a bug in Kurtosis", isIpv6EnabledFlagKey)
+	}
+
 	kurtosisLogLevelStr, err := flags.GetString(apiContainerLogLevelFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting the API Container log level using flag with key '%v'; this is a bug in Kurtosis", apiContainerLogLevelFlagKey)
@@ -156,11 +169,18 @@ func run(
 		logrus.Warn("An error occurred while logging the create enclave event")
 	}
 
+	gitCredentialsPerGitHost, err := kurtosis_config_getter.GetGitCredentialsPerGitHost()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git credentials to create the enclave with")
+	}
+
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
-		EnclaveName:            enclaveName,
-		ApiContainerVersionTag: apiContainerVersion,
-		ApiContainerLogLevel:   kurtosisLogLevelStr,
-		IsPartitioningEnabled:  isPartitioningEnabled,
+		EnclaveName:              enclaveName,
+		ApiContainerVersionTag:   apiContainerVersion,
+		ApiContainerLogLevel:     kurtosisLogLevelStr,
+		IsPartitioningEnabled:    isPartitioningEnabled,
+		IsIpv6Enabled:            isIpv6Enabled,
+		GitCredentialsPerGitHost: gitCredentialsPerGitHost,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
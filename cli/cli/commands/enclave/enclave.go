@@ -11,7 +11,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/restore"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/snapshot"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
 	"github.com/spf13/cobra"
 )
@@ -29,6 +32,9 @@ func init() {
 	EnclaveCmd.AddCommand(inspect.EnclaveInspectCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(add.EnclaveAddCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(stop.EnclaveStopCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(start.EnclaveStartCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(snapshot.EnclaveSnapshotCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(restore.EnclaveRestoreCmd.MustGetCobraCommand())
 }
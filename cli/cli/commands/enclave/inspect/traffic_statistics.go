@@ -0,0 +1,93 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	trafficSourceColHeader         = "Source"
+	trafficDestinationColHeader    = "Destination"
+	trafficSentBytesColHeader      = "Sent Bytes"
+	trafficSentPacketsColHeader    = "Sent Packets"
+	trafficDroppedPacketsColHeader = "Dropped Packets"
+
+	allServicesIdentifier = ""
+)
+
+// printTrafficStatistics prints the traffic each service sent to the other ones, as counted by the networking sidecars.
+// Only the links of services whose traffic is impaired by at least one connection are listed
+func printTrafficStatistics(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, _ bool, isAPIContainerRunning bool) error {
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())
+	networkingSidecarFilters := &networking_sidecar.NetworkingSidecarFilters{
+		EnclaveUUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		UserServiceUUIDs: nil,
+		Statuses:         nil,
+	}
+	networkingSidecars, err := kurtosisBackend.GetNetworkingSidecars(ctx, networkingSidecarFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the networking sidecars in enclave '%v'", enclaveUuid)
+	}
+	// Services only get a networking sidecar when network partitioning is enabled
+	if len(networkingSidecars) == 0 {
+		out.PrintOutLn("No service has a networking sidecar; traffic statistics are only collected when network partitioning is enabled")
+		return nil
+	}
+	if !isAPIContainerRunning {
+		out.PrintOutLn("Traffic statistics can't be retrieved as the API container isn't running")
+		return nil
+	}
+
+	conn, err := connectToApiContainer(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	defer func() {
+		conn.Close()
+	}()
+	apiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn)
+
+	response, err := apiContainerClient.GetTrafficStatistics(ctx, binding_constructors.NewGetTrafficStatisticsArgs(allServicesIdentifier))
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get the traffic statistics of the services in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		trafficSourceColHeader,
+		trafficDestinationColHeader,
+		trafficSentBytesColHeader,
+		trafficSentPacketsColHeader,
+		trafficDroppedPacketsColHeader,
+	)
+	// The API container returns the links sorted already
+	for _, link := range response.GetLinkTrafficStatistics() {
+		if err := tablePrinter.AddRow(
+			link.GetSourceServiceName(),
+			link.GetDestinationServiceName(),
+			fmt.Sprint(link.GetSentBytes()),
+			fmt.Sprint(link.GetSentPackets()),
+			fmt.Sprint(link.GetDroppedPackets()),
+		); err != nil {
+			return stacktrace.Propagate(
+				err,
+				"An error occurred adding row for the traffic from service '%v' to service '%v' to the table printer",
+				link.GetSourceServiceName(),
+				link.GetDestinationServiceName(),
+			)
+		}
+	}
+	tablePrinter.Print()
+
+	return nil
+}
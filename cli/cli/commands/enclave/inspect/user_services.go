@@ -194,6 +194,27 @@ func getUserServicePortBindingStrings(userService *service.Service,
 }
 
 func getUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+	conn, err := connectToApiContainer(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	defer func() {
+		conn.Close()
+	}()
+	apiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn)
+
+	getAllServicesMap := map[string]bool{}
+	getAllServicesArgs := binding_constructors.NewGetServicesArgs(getAllServicesMap)
+	allServicesResponse, err := apiContainerClient.GetServices(ctx, getAllServicesArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get service information for all services in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	serviceInfoMapFromAPIC := allServicesResponse.GetServiceInfo()
+	return serviceInfoMapFromAPIC, nil
+}
+
+// connectToApiContainer returns a connection to the API container of the enclave, which the caller is responsible for closing
+func connectToApiContainer(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*grpc.ClientConn, error) {
 	apicHostMachineIp, apicHostMachineGrpcPort, err := enclave_liveness_validator.ValidateEnclaveLiveness(enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred verifying that the enclave was running")
@@ -213,19 +234,7 @@ func getUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kur
 			enclaveInfo.EnclaveUuid,
 		)
 	}
-	defer func() {
-		conn.Close()
-	}()
-	apiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn)
-
-	getAllServicesMap := map[string]bool{}
-	getAllServicesArgs := binding_constructors.NewGetServicesArgs(getAllServicesMap)
-	allServicesResponse, err := apiContainerClient.GetServices(ctx, getAllServicesArgs)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to get service information for all services in enclave '%v'", enclaveInfo.GetEnclaveUuid())
-	}
-	serviceInfoMapFromAPIC := allServicesResponse.GetServiceInfo()
-	return serviceInfoMapFromAPIC, nil
+	return conn, nil
 }
 
 func colorizeServiceStatus(serviceStatus string) string {
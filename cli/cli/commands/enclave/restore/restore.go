@@ -0,0 +1,106 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	snapshotFilepathArgKey        = "snapshot-filepath"
+	isSnapshotFilepathArgOptional = false
+
+	enclaveNameFlagKey = "name"
+
+	// Signifies that the name of the snapshotted enclave should be used
+	snapshottedEnclaveNameKeyword = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var noSnapshotFilepathValidationExceptionFunc = func(_ string) bool {
+	return false
+}
+
+var EnclaveRestoreCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRestoreCmdStr,
+	ShortDescription: "Restores an enclave snapshot",
+	LongDescription: "Creates a new enclave reproducing the state captured in a snapshot taken with '" +
+		command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveSnapshotCmdStr + "'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       enclaveNameFlagKey,
+			Shorthand: "n",
+			Default:   snapshottedEnclaveNameKeyword,
+			Usage: fmt.Sprintf(
+				"The enclave name to give the restored enclave, which must match regex '%v' "+
+					"(emptystring will use the name of the snapshotted enclave)",
+				enclave_consts.AllowedEnclaveNameCharsRegexStr,
+			),
+			Type: flags.FlagType_String,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			snapshotFilepathArgKey,
+			isSnapshotFilepathArgOptional,
+			noSnapshotFilepathValidationExceptionFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	snapshotFilepath, err := args.GetNonGreedyArg(snapshotFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the snapshot filepath using key '%v'", snapshotFilepathArgKey)
+	}
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
+	}
+
+	snapshotFile, err := os.Open(snapshotFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening snapshot file '%v'", snapshotFilepath)
+	}
+	defer snapshotFile.Close()
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	logrus.Infof("Restoring enclave snapshot '%v'...", snapshotFilepath)
+	enclaveCtx, err := kurtosisCtx.RestoreEnclave(ctx, enclaveName, snapshotFile)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring enclave snapshot '%v'", snapshotFilepath)
+	}
+
+	defer output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
+
+	return nil
+}
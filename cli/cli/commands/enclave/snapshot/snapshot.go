@@ -0,0 +1,99 @@
+package snapshot
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave-identifier"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFilepathArgKey = "output-filepath"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveSnapshotCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveSnapshotCmdStr,
+	ShortDescription: "Takes a snapshot of an enclave",
+	LongDescription: "Writes a snapshot of the enclave to the given file, capturing the configs of its services, its " +
+		"partition topology, its files artifacts and the filesystems of its service containers, so that it can be " +
+		"recreated later with '" + command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveRestoreCmdStr + "'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key: outputFilepathArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+	outputFilepath, err := args.GetNonGreedyArg(outputFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output filepath using key '%v'", outputFilepathArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	outputFile, err := os.Create(outputFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating snapshot file '%v'", outputFilepath)
+	}
+	shouldRemoveOutputFile := true
+	defer func() {
+		if shouldRemoveOutputFile {
+			if err := os.Remove(outputFilepath); err != nil {
+				logrus.Warnf("An error occurred removing incomplete snapshot file '%v':\n%v", outputFilepath, err)
+			}
+		}
+	}()
+	defer outputFile.Close()
+
+	logrus.Infof("Taking a snapshot of enclave '%v'...", enclaveIdentifier)
+	if err = kurtosisCtx.SnapshotEnclave(ctx, enclaveIdentifier, outputFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred taking a snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	if err = outputFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing snapshot file '%v'", outputFilepath)
+	}
+	shouldRemoveOutputFile = false
+	logrus.Infof("Snapshot of enclave '%v' written to '%v'", enclaveIdentifier, outputFilepath)
+	return nil
+}
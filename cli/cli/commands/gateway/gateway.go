@@ -4,19 +4,13 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
-	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	kubernetes_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"os"
-	"path"
-	"plugin"
-)
-
-const (
-	emptyConfigMasterUrl       = ""
-	runEngineGatewaySymbolName = "RunEngineGateway"
+	"os/signal"
 )
 
 // GatewayCmd Suppressing exhaustruct requirement because this struct has ~40 properties
@@ -24,6 +18,7 @@ const (
 var GatewayCmd = &cobra.Command{
 	Use:   command_str_consts.GatewayCmdStr,
 	Short: "Starts a local gateway to a Kurtosis cluster running in Kubernetes",
+	Long:  "Forwards the ports of the engine and of the API containers of the Kubernetes cluster of the current kubeconfig context to the local machine, until interrupted",
 	RunE:  run,
 }
 
@@ -31,38 +26,24 @@ func init() {
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
-
 	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get Kurtosis cluster configuration, instead a non-nil error was returned")
 	}
-
-	kurtosisBackend, err := clusterConfig.GetKurtosisBackend(ctx)
-	if err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to get a Kurtosis backend connected to the cluster, instead a non-nil error was returned")
+	if clusterConfig.GetClusterType() != resolved_config.KurtosisClusterType_Kubernetes {
+		return stacktrace.NewError(
+			"The gateway is only needed to reach Kurtosis in a '%v' cluster, but the current cluster is of type '%v'",
+			resolved_config.KurtosisClusterType_Kubernetes.String(),
+			clusterConfig.GetClusterType().String(),
+		)
 	}
 
-	// TODO Store kube config path in configuration and read from there
-	kubeConfigPath := path.Join(os.Getenv("HOME"), ".kube", "config")
-
-	kubernetesConfig, err := clientcmd.BuildConfigFromFlags(emptyConfigMasterUrl, kubeConfigPath)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating Kubernetes configuration from flags in file '%v'", kubeConfigPath)
-	}
+	ctx, stopGateway := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopGateway()
 
-	pluginPath := backend_interface.GetPluginPathForCLI(backend_interface.KubernetesPluginName)
-	pluginFile, err := plugin.Open(pluginPath)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred opening Kubernetes plugin on path '%s'", pluginPath)
-	}
-	runEngineGatewaySymbol, err := pluginFile.Lookup(runEngineGatewaySymbolName)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred looking up symbol '%s'  from Kubernetes plugin (path '%s')", runEngineGatewaySymbol, pluginPath)
-	}
-	runEngineGateway, ok := runEngineGatewaySymbol.(func(ctx context.Context, kubernetesConfig *rest.Config, kurtosisBackend backend_interface.KurtosisBackend) error)
-	if !ok {
-		return stacktrace.NewError("An error occurred when parsing gateway function from plugin")
+	logrus.Infof("Starting the gateway to the Kubernetes cluster, press Ctrl+C to stop it")
+	if err := kubernetes_backend_creator.RunCLIGateway(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the gateway to the Kubernetes cluster")
 	}
-	return runEngineGateway(cmd.Context(), kubernetesConfig, kurtosisBackend)
+	return nil
 }
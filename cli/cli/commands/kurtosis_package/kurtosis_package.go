@@ -0,0 +1,21 @@
+package kurtosis_package
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/lock"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/vendor"
+	"github.com/spf13/cobra"
+)
+
+// PackageCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PackageCmd = &cobra.Command{
+	Use:   command_str_consts.PackageCmdStr,
+	Short: "Manage Kurtosis packages",
+	RunE:  nil,
+}
+
+func init() {
+	PackageCmd.AddCommand(lock.LockCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(vendor.VendorCmd.MustGetCobraCommand())
+}
@@ -0,0 +1,98 @@
+package lock
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_dependencies"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sort"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = true
+	defaultPackageDirpath       = "."
+
+	repositoryColumnHeader = "Repository"
+	versionColumnHeader    = "Version"
+	commitColumnHeader     = "Commit"
+)
+
+var noPackageDirpathValidationExceptionFunc = func(_ string) bool {
+	return false
+}
+
+var LockCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageLockCmdStr,
+	ShortDescription: "Locks the dependencies of a package",
+	LongDescription: "Resolves the version constraints of the dependencies declared in the '" + package_dependencies.KurtosisYamlFilename +
+		"' of a package, including the transitive ones, and locks each of their repositories to a commit in a '" +
+		package_dependencies.KurtosisLockFilename + "' next to it. The imports of the package that don't pin a version " +
+		"then resolve to the locked commits when it runs",
+	Flags:                    nil,
+	Args:                     []*args.ArgConfig{newPackageDirpathArg()},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package dirpath using key '%v'", packageDirpathArgKey)
+	}
+	kurtosisYaml, err := package_dependencies.ReadKurtosisYaml(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the '%v' of the package at '%v'", package_dependencies.KurtosisYamlFilename, packageDirpath)
+	}
+
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	configProvider := kurtosis_config.NewKurtosisConfigProvider(kurtosisConfigStore)
+	kurtosisConfig, err := configProvider.GetOrInitializeConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get or initialize Kurtosis configuration")
+	}
+	repositories, cloneUrlPrefixPerGitHost, err := package_dependencies.NewRemoteGitRepositoriesFromKurtosisConfig(kurtosisConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git repositories of the Git hosts of the Kurtosis config")
+	}
+
+	logrus.Infof("Resolving the dependencies of package '%v'...", kurtosisYaml.PackageName)
+	kurtosisLock, err := package_dependencies.ResolveDependencies(kurtosisYaml.PackageName, kurtosisYaml.Dependencies, cloneUrlPrefixPerGitHost, repositories)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred resolving the dependencies of package '%v'", kurtosisYaml.PackageName)
+	}
+	if err = package_dependencies.WriteKurtosisLock(packageDirpath, kurtosisLock); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the '%v' of the package at '%v'", package_dependencies.KurtosisLockFilename, packageDirpath)
+	}
+	logrus.Infof("Locked the %v repositories the package depends on in '%v'", len(kurtosisLock.Dependencies), package_dependencies.KurtosisLockFilename)
+
+	repositoryLocators := []string{}
+	for repositoryLocator := range kurtosisLock.Dependencies {
+		repositoryLocators = append(repositoryLocators, repositoryLocator)
+	}
+	sort.Strings(repositoryLocators)
+	tablePrinter := output_printers.NewTablePrinter(repositoryColumnHeader, versionColumnHeader, commitColumnHeader)
+	for _, repositoryLocator := range repositoryLocators {
+		lockedDependency := kurtosisLock.Dependencies[repositoryLocator]
+		if err := tablePrinter.AddRow(repositoryLocator, lockedDependency.Version, lockedDependency.Commit); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the row of repository '%v' to the table printer", repositoryLocator)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func newPackageDirpathArg() *args.ArgConfig {
+	packageDirpathArg := file_system_path_arg.NewDirpathArg(packageDirpathArgKey, isPackageDirpathArgOptional, noPackageDirpathValidationExceptionFunc)
+	// the package of the working directory gets locked by default
+	packageDirpathArg.DefaultValue = defaultPackageDirpath
+	return packageDirpathArg
+}
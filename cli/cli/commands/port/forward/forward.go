@@ -0,0 +1,137 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave-identifier"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service-identifier"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	portIdArgKey = "port-id"
+
+	localPortArgKey        = "local-port"
+	isLocalPortArgOptional = true
+	isLocalPortArgGreedy   = false
+	// The engine uses the number of the private port when no local port is given
+	defaultLocalPort = ""
+
+	portNumberUintParsingBase = 10
+	portNumberUintParsingBits = 16
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PortForwardCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PortForwardCmdStr,
+	ShortDescription: "Forwards a local port to a service",
+	LongDescription: fmt.Sprintf(
+		"Forwards the given local port, or the same port number as the private port if omitted, to the private port "+
+			"with the given ID of the service. The forward is managed by the Kurtosis engine, so it keeps working after "+
+			"this command returns, comes back when the engine or the enclave restarts, and is removed along with the "+
+			"enclave or with '%v %v %v'",
+		command_str_consts.KurtosisCmdStr,
+		command_str_consts.PortCmdStr,
+		command_str_consts.PortRmCmdStr,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			isServiceIdentifierArgGreedy,
+			isServiceIdentifierArgOptional,
+		),
+		{
+			Key: portIdArgKey,
+		},
+		{
+			Key:          localPortArgKey,
+			IsOptional:   isLocalPortArgOptional,
+			DefaultValue: defaultLocalPort,
+			IsGreedy:     isLocalPortArgGreedy,
+		},
+	},
+	Flags:   []*flags.FlagConfig{},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier value using key '%v'", serviceIdentifierArgKey)
+	}
+
+	portId, err := args.GetNonGreedyArg(portIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port ID value using key '%v'", portIdArgKey)
+	}
+
+	localPortStr, err := args.GetNonGreedyArg(localPortArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port value using key '%v'", localPortArgKey)
+	}
+	localPortNumber := uint64(0)
+	if localPortStr != defaultLocalPort {
+		localPortNumber, err = strconv.ParseUint(localPortStr, portNumberUintParsingBase, portNumberUintParsingBits)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing local port '%v'; it should be a port number", localPortStr)
+		}
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	portForwardInfo, err := kurtosisCtx.CreatePortForward(ctx, enclaveIdentifier, serviceIdentifier, portId, uint32(localPortNumber))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v' in enclave '%v'", portId, serviceIdentifier, enclaveIdentifier)
+	}
+	out.PrintOutLn(fmt.Sprintf(
+		"Forwarding local port %v to port '%v' of service '%v' in enclave '%v'",
+		portForwardInfo.GetLocalPortNumber(),
+		portForwardInfo.GetPortId(),
+		portForwardInfo.GetServiceName(),
+		portForwardInfo.GetEnclaveName(),
+	))
+	return nil
+}
@@ -0,0 +1,90 @@
+package ls
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+)
+
+const (
+	localPortColumnHeader = "Local Port"
+	enclaveColumnHeader   = "Enclave"
+	serviceColumnHeader   = "Service"
+	portIdColumnHeader    = "Port ID"
+	statusColumnHeader    = "Status"
+
+	runningStatus = "RUNNING"
+	stoppedStatus = "STOPPED"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PortLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PortLsCmdStr,
+	ShortDescription: "Lists port forwards",
+	LongDescription: "Lists the local ports forwarded to services by the Kurtosis engine. A port forward is stopped " +
+		"while its enclave or its service is stopped",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args:                      nil,
+	RunFunc:                   run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	portForwards, err := kurtosisCtx.GetPortForwards(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port forwards")
+	}
+
+	localPortNumbers := []uint32{}
+	for localPortNumber := range portForwards {
+		localPortNumbers = append(localPortNumbers, localPortNumber)
+	}
+	sort.Slice(localPortNumbers, func(firstIdx, secondIdx int) bool {
+		return localPortNumbers[firstIdx] < localPortNumbers[secondIdx]
+	})
+
+	tablePrinter := output_printers.NewTablePrinter(localPortColumnHeader, enclaveColumnHeader, serviceColumnHeader, portIdColumnHeader, statusColumnHeader)
+	for _, localPortNumber := range localPortNumbers {
+		portForwardInfo := portForwards[localPortNumber]
+		status := stoppedStatus
+		if portForwardInfo.GetIsRunning() {
+			status = runningStatus
+		}
+		if err := tablePrinter.AddRow(
+			fmt.Sprint(localPortNumber),
+			portForwardInfo.GetEnclaveName(),
+			portForwardInfo.GetServiceName(),
+			portForwardInfo.GetPortId(),
+			status,
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding row for the port forward of local port '%v' to the table printer", localPortNumber)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
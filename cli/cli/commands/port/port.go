@@ -0,0 +1,23 @@
+package port
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/forward"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/rm"
+	"github.com/spf13/cobra"
+)
+
+// PortCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PortCmd = &cobra.Command{
+	Use:   command_str_consts.PortCmdStr,
+	Short: "Manage the forwarding of local ports to services",
+	RunE:  nil,
+}
+
+func init() {
+	PortCmd.AddCommand(forward.PortForwardCmd.MustGetCobraCommand())
+	PortCmd.AddCommand(ls.PortLsCmd.MustGetCobraCommand())
+	PortCmd.AddCommand(rm.PortRmCmd.MustGetCobraCommand())
+}
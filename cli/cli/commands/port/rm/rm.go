@@ -0,0 +1,71 @@
+package rm
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+)
+
+const (
+	localPortArgKey = "local-port"
+
+	portNumberUintParsingBase = 10
+	portNumberUintParsingBits = 16
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PortRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.PortRmCmdStr,
+	ShortDescription:          "Removes a port forward",
+	LongDescription:           "Stops forwarding the given local port",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		{
+			Key: localPortArgKey,
+		},
+	},
+	Flags:   []*flags.FlagConfig{},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	localPortStr, err := args.GetNonGreedyArg(localPortArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port value using key '%v'", localPortArgKey)
+	}
+	localPortNumber, err := strconv.ParseUint(localPortStr, portNumberUintParsingBase, portNumberUintParsingBits)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing local port '%v'; it should be a port number", localPortStr)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	if err = kurtosisCtx.DestroyPortForward(ctx, uint32(localPortNumber)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the port forward of local port '%v'", localPortNumber)
+	}
+	out.PrintOutLn(fmt.Sprintf("Stopped forwarding local port %v", localPortNumber))
+	return nil
+}
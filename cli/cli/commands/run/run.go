@@ -15,6 +15,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -64,6 +65,15 @@ const (
 	parallelismFlagKey = "parallelism"
 	defaultParallelism = "4"
 
+	resumeFlagKey = "resume"
+	defaultResume = "false"
+
+	applyFlagKey = "apply"
+	defaultApply = "false"
+
+	removeUndeclaredServicesFlagKey = "remove-undeclared-services"
+	defaultRemoveUndeclaredServices = "false"
+
 	githubDomainPrefix          = "github.com/"
 	isNewEnclaveFlagWhenCreated = true
 	interruptChanBufferSize     = 5
@@ -134,6 +144,29 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Shorthand: "p",
 			Default:   defaultParallelism,
 		},
+		{
+			Key: resumeFlagKey,
+			Usage: "If true, the instructions of the package that were already applied to the enclave by the previous run " +
+				"and that are unchanged are skipped, and the execution resumes at the first instruction that changed or failed. " +
+				"Only supported for packages",
+			Type:    flags.FlagType_Bool,
+			Default: defaultResume,
+		},
+		{
+			Key: applyFlagKey,
+			Usage: "If true, the package is applied to the enclave: its instructions are compared against the current " +
+				"state of the enclave, the planned changes are printed, and only the instructions adding, updating or " +
+				"removing something are executed. Only supported for packages",
+			Type:    flags.FlagType_Bool,
+			Default: defaultApply,
+		},
+		{
+			Key: removeUndeclaredServicesFlagKey,
+			Usage: fmt.Sprintf("If true, the services running in the enclave that are not declared by the package are "+
+				"removed. Only supported together with the '%v' flag", applyFlagKey),
+			Type:    flags.FlagType_Bool,
+			Default: defaultRemoveUndeclaredServices,
+		},
 		{
 			Key:       verbosityFlagKey,
 			Usage:     fmt.Sprintf("The verbosity of the command output: %s. If unset, it defaults to `brief` for a concise and explicit output. Use `detailed` to display the exhaustive list of arguments for each command. `executable` will generate executable Starlark instructions.", strings.Join(command_args_run.VerbosityStrings(), ", ")),
@@ -219,6 +252,28 @@ func run(
 	}
 	castedParallelism := int32(parallelism)
 
+	resume, err := flags.GetBool(resumeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", resumeFlagKey)
+	}
+
+	apply, err := flags.GetBool(applyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", applyFlagKey)
+	}
+
+	removeUndeclaredServices, err := flags.GetBool(removeUndeclaredServicesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", removeUndeclaredServicesFlagKey)
+	}
+
+	if resume && apply {
+		return stacktrace.NewError("The '%v' and '%v' flags cannot be used together", resumeFlagKey, applyFlagKey)
+	}
+	if removeUndeclaredServices && !apply {
+		return stacktrace.NewError("The '%v' flag can only be used together with the '%v' flag", removeUndeclaredServicesFlagKey, applyFlagKey)
+	}
+
 	verbosity, err := parseVerbosityFlag(flags)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the verbosity using flag key '%s'", verbosityFlagKey)
@@ -260,10 +315,18 @@ func run(
 	var cancelFunc context.CancelFunc
 	var errRunningKurtosis error
 
+	packageRunOptions := enclaves.NewStarlarkRunOptionsBuilder().
+		WithDryRun(dryRun).
+		WithParallelism(castedParallelism).
+		WithResume(resume).
+		WithApply(apply).
+		WithRemoveUndeclaredServices(removeUndeclaredServices).
+		Build()
+
 	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
 	isStandAloneScript := false
 	if isRemotePackage {
-		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
+		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, packageRunOptions)
 	} else {
 		fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
 		if err != nil {
@@ -275,6 +338,12 @@ func run(
 			if !strings.HasSuffix(starlarkScriptOrPackagePath, starlarkExtension) {
 				return stacktrace.NewError("Expected a script with a '%s' extension but got file '%v' with a different extension", starlarkExtension, starlarkScriptOrPackagePath)
 			}
+			if resume {
+				return stacktrace.NewError("The '%v' flag is only supported for packages but '%v' is a standalone script", resumeFlagKey, starlarkScriptOrPackagePath)
+			}
+			if apply {
+				return stacktrace.NewError("The '%v' flag is only supported for packages but '%v' is a standalone script", applyFlagKey, starlarkScriptOrPackagePath)
+			}
 			responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
 		} else {
 			// if the path is a file with `kurtosis.yml` at the end it's a module dir
@@ -282,7 +351,7 @@ func run(
 			if isKurtosisYMLFileInPackageDir(fileOrDir, kurtosisYMLFilePath) {
 				starlarkScriptOrPackagePath = path.Dir(starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
+			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, packageRunOptions)
 		}
 	}
 	if errRunningKurtosis != nil {
@@ -333,7 +402,7 @@ func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scr
 	return enclaveCtx.RunStarlarkScript(ctx, string(fileContentBytes), serializedParams, dryRun, parallelism)
 }
 
-func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packagePath string, serializedParams string, dryRun bool, parallelism int32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packagePath string, serializedParams string, runOptions *enclaves.StarlarkRunOptions) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	// we get the absolute path so that the logs make more sense
 	absolutePackagePath, err := filepath.Abs(packagePath)
 	logrus.Infof("Executing Starlark package at '%v' as the passed argument '%v' looks like a directory", absolutePackagePath, packagePath)
@@ -341,11 +410,11 @@ func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, pa
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the absolute path for '%v'", packagePath)
 	}
-	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, serializedParams, dryRun, parallelism)
+	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, serializedParams, runOptions)
 }
 
-func executeRemotePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageId string, serializedParams string, dryRun bool, parallelism int32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
-	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism)
+func executeRemotePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageId string, serializedParams string, runOptions *enclaves.StarlarkRunOptions) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, runOptions)
 }
 
 func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) error {
@@ -404,8 +473,12 @@ func getOrCreateEnclaveContext(
 			return enclaveContext, false, nil
 		}
 	}
+	gitCredentialsPerGitHost, err := kurtosis_config_getter.GetGitCredentialsPerGitHost()
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting the Git credentials to create the enclave with")
+	}
 	logrus.Infof("Creating a new enclave for Starlark to run inside...")
-	enclaveContext, err := kurtosisContext.CreateEnclave(ctx, enclaveIdentifierOrName, isPartitioningEnabled)
+	enclaveContext, err := kurtosisContext.CreateEnclaveWithGitCredentials(ctx, enclaveIdentifierOrName, isPartitioningEnabled, gitCredentialsPerGitHost)
 	if err != nil {
 		return nil, false, stacktrace.Propagate(err, fmt.Sprintf("Unable to create new enclave with name '%s'", enclaveIdentifierOrName))
 	}
@@ -0,0 +1,201 @@
+package pcap
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave-identifier"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service-identifier"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	durationFlagKey     = "duration"
+	defaultDurationSecs = "10"
+
+	filterFlagKey = "filter"
+	defaultFilter = ""
+
+	outputFlagKey = "output"
+	defaultOutput = ""
+	// Writing the capture to STDOUT allows piping it to tools like tshark or Wireshark
+	stdoutOutput = "-"
+
+	artifactNameFlagKey = "artifact-name"
+	defaultArtifactName = ""
+
+	pcapFileExtension  = ".pcap"
+	pcapFilePermission = 0o644
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServicePcapCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServicePcapCmdStr,
+	ShortDescription: "Captures the traffic of a service",
+	LongDescription: "Captures the traffic of the service with the given identifier in the given enclave during the given " +
+		"duration, using its networking sidecar, and writes it in pcap format to a local file or stores it as a files " +
+		"artifact in the enclave. Network partitioning has to be enabled in the enclave for services to have a networking sidecar",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			isServiceIdentifierArgGreedy,
+			isServiceIdentifierArgOptional,
+		),
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       durationFlagKey,
+			Usage:     "How long to capture the traffic for, in seconds",
+			Type:      flags.FlagType_Uint32,
+			Shorthand: "d",
+			Default:   defaultDurationSecs,
+		},
+		{
+			Key:     filterFlagKey,
+			Usage:   "A BPF expression the captured packets have to match, e.g. 'tcp port 80'. All the traffic is captured if empty",
+			Type:    flags.FlagType_String,
+			Default: defaultFilter,
+		},
+		{
+			Key:       outputFlagKey,
+			Usage:     fmt.Sprintf("The file the capture is written to, defaulting to '<service name>%s' in the current directory. Use '%s' to write it to STDOUT", pcapFileExtension, stdoutOutput),
+			Type:      flags.FlagType_String,
+			Shorthand: "o",
+			Default:   defaultOutput,
+		},
+		{
+			Key:     artifactNameFlagKey,
+			Usage:   "If set, the capture is stored as a files artifact with this name in the enclave instead of being written locally",
+			Type:    flags.FlagType_String,
+			Default: defaultArtifactName,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier value using key '%v'", serviceIdentifierArgKey)
+	}
+
+	durationSecs, err := flags.GetUint32(durationFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the duration using key '%v'", durationFlagKey)
+	}
+	if durationSecs == 0 {
+		return stacktrace.NewError("The capture duration must be at least one second")
+	}
+	duration := time.Duration(durationSecs) * time.Second
+
+	filter, err := flags.GetString(filterFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the filter using key '%v'", filterFlagKey)
+	}
+
+	output, err := flags.GetString(outputFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output using key '%v'", outputFlagKey)
+	}
+
+	artifactName, err := flags.GetString(artifactNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact name using key '%v'", artifactNameFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+	if err != nil {
+		return stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
+	}
+	serviceName := serviceContext.GetServiceName()
+
+	// Progress goes to STDERR so that it never mixes with a capture written to STDOUT
+	out.PrintErrLn(fmt.Sprintf("Capturing the traffic of service '%v' for %v...", serviceName, duration))
+
+	if artifactName != defaultArtifactName {
+		filesArtifactUuid, filesArtifactName, err := enclaveCtx.StoreServiceTrafficCapture(ctx, serviceIdentifier, duration, filter, artifactName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred storing the traffic capture of service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+		}
+		out.PrintErrLn(fmt.Sprintf("Traffic capture stored as files artifact '%v' with UUID '%v'", filesArtifactName, filesArtifactUuid))
+		return nil
+	}
+
+	if output == stdoutOutput {
+		if err = enclaveCtx.CaptureServiceTraffic(ctx, serviceIdentifier, duration, filter, out.GetOut()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred capturing the traffic of service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+		}
+		return nil
+	}
+	if output == defaultOutput {
+		output = string(serviceName) + pcapFileExtension
+	}
+	pcapFile, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, pcapFilePermission)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the traffic capture file '%v'", output)
+	}
+	captureErr := enclaveCtx.CaptureServiceTraffic(ctx, serviceIdentifier, duration, filter, pcapFile)
+	if err = pcapFile.Close(); err != nil && captureErr == nil {
+		return stacktrace.Propagate(err, "An error occurred closing the traffic capture file '%v'", output)
+	}
+	if captureErr != nil {
+		// A partial capture would be mistaken for a complete one
+		if err = os.Remove(output); err != nil {
+			logrus.Warnf("An error occurred removing the incomplete traffic capture file '%v':\n%v", output, err)
+		}
+		return stacktrace.Propagate(captureErr, "An error occurred capturing the traffic of service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+	out.PrintErrLn(fmt.Sprintf("Traffic capture written to '%v'", output))
+	return nil
+}
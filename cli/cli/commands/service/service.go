@@ -9,6 +9,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/logs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/pcap"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/restart"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
 	"github.com/spf13/cobra"
@@ -25,6 +27,8 @@ var ServiceCmd = &cobra.Command{
 func init() {
 	ServiceCmd.AddCommand(add.ServiceAddCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(logs.ServiceLogsCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(pcap.ServicePcapCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(restart.ServiceRestartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(rm.ServiceRmCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(shell.ServiceShellCmd.MustGetCobraCommand())
 }
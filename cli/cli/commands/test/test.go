@@ -0,0 +1,198 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	packagePathArgKey        = "package-path"
+	isPackagePathArgOptional = true
+	defaultPackagePath       = "."
+
+	enclaveIdentifierFlagKey = "enclave-identifier"
+	// Signifies that the tests run in a new enclave, destroyed once they're done
+	newEnclaveIdentifierKeyword = ""
+
+	githubDomainPrefix = "github.com/"
+
+	testFileSuffix = "_test.star"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	passedTestStatus = "PASS"
+	failedTestStatus = "FAIL"
+)
+
+var (
+	githubPackagePathValidationExceptionFunc = func(packagePath string) bool {
+		// if it's a Github path we don't validate further, the APIC will do it for us
+		return strings.HasPrefix(packagePath, githubDomainPrefix)
+	}
+
+	colorizePassedTest = color.New(color.FgGreen).SprintFunc()
+	colorizeFailedTest = color.New(color.FgRed).SprintFunc()
+)
+
+var TestCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.TestCmdStr,
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	ShortDescription:          "Run the Starlark tests of a package",
+	LongDescription: "Runs the functions prefixed with 'test_' of the '*" + testFileSuffix + "' files of a package, " +
+		"given either the path to its directory or its locator on Github. The tests get interpreted against a mocked " +
+		"enclave, so no service gets started; each test function can take a 'plan' argument to stub the results of its " +
+		"instructions and to assert on the instructions it generated. If the '" + enclaveIdentifierFlagKey + "' flag " +
+		"isn't provided, the tests run in a new enclave that gets destroyed once they're done.",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     enclaveIdentifierFlagKey,
+			Usage:   "The enclave identifier of an existing enclave in which the tests will be interpreted.",
+			Type:    flags.FlagType_String,
+			Default: newEnclaveIdentifierKeyword,
+		},
+	},
+	Args: []*args.ArgConfig{
+		newPackagePathArg(),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	metricsClient metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packagePath, err := args.GetNonGreedyArg(packagePathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package path using key '%v'", packagePathArgKey)
+	}
+
+	enclaveIdentifier, err := flags.GetString(enclaveIdentifierFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using flag key '%s'", enclaveIdentifierFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := getOrCreateEnclaveContext(ctx, enclaveIdentifier, kurtosisCtx, metricsClient)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	if enclaveIdentifier == newEnclaveIdentifierKeyword {
+		defer func() {
+			if err := kurtosisCtx.DestroyEnclave(ctx, string(enclaveCtx.GetEnclaveUuid())); err != nil {
+				logrus.Errorf("An error occurred destroying enclave '%v' created to run the tests; it must be destroyed manually:\n%v", enclaveCtx.GetEnclaveName(), err)
+			}
+		}()
+	}
+
+	var testResults []*kurtosis_core_rpc_api_bindings.StarlarkTestResult
+	if strings.HasPrefix(packagePath, githubDomainPrefix) {
+		logrus.Infof("Running the tests of Starlark package '%v'...", packagePath)
+		testResults, err = enclaveCtx.RunStarlarkRemotePackageTests(ctx, packagePath)
+	} else {
+		// we get the absolute path so that the logs make more sense
+		absolutePackagePath, absolutePathErr := filepath.Abs(packagePath)
+		if absolutePathErr != nil {
+			return stacktrace.Propagate(absolutePathErr, "An error occurred while getting the absolute path for '%v'", packagePath)
+		}
+		logrus.Infof("Running the tests of Starlark package at '%v'...", absolutePackagePath)
+		testResults, err = enclaveCtx.RunStarlarkPackageTests(ctx, packagePath)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the tests of package '%v'", packagePath)
+	}
+
+	numFailedTests := printTestResults(testResults)
+	if numFailedTests > 0 {
+		return stacktrace.NewError("%v of the %v tests of package '%v' failed", numFailedTests, len(testResults), packagePath)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func newPackagePathArg() *args.ArgConfig {
+	packagePathArg := file_system_path_arg.NewDirpathArg(
+		packagePathArgKey,
+		isPackagePathArgOptional,
+		githubPackagePathValidationExceptionFunc,
+	)
+	packagePathArg.DefaultValue = defaultPackagePath
+	return packagePathArg
+}
+
+func getOrCreateEnclaveContext(
+	ctx context.Context,
+	enclaveIdentifier string,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	metricsClient metrics_client.MetricsClient,
+) (*enclaves.EnclaveContext, error) {
+	if enclaveIdentifier != newEnclaveIdentifierKeyword {
+		enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while getting context for existing enclave with identifier '%v'", enclaveIdentifier)
+		}
+		return enclaveCtx, nil
+	}
+	gitCredentialsPerGitHost, err := kurtosis_config_getter.GetGitCredentialsPerGitHost()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Git credentials to create the enclave with")
+	}
+	logrus.Infof("Creating a new enclave for the tests to run inside...")
+	enclaveCtx, err := kurtosisCtx.CreateEnclaveWithGitCredentials(ctx, enclaveIdentifier, false, gitCredentialsPerGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to create a new enclave for the tests")
+	}
+	if err = metricsClient.TrackCreateEnclave(enclaveCtx.GetEnclaveName()); err != nil {
+		logrus.Error("An error occurred while logging the create enclave event")
+	}
+	return enclaveCtx, nil
+}
+
+// printTestResults prints the status of each test and returns how many failed
+func printTestResults(testResults []*kurtosis_core_rpc_api_bindings.StarlarkTestResult) int {
+	numFailedTests := 0
+	for _, testResult := range testResults {
+		testName := testResult.GetTestFilepath()
+		if testResult.GetTestFunctionName() != "" {
+			testName = fmt.Sprintf("%v::%v", testResult.GetTestFilepath(), testResult.GetTestFunctionName())
+		}
+		if testResult.Failure == nil {
+			fmt.Printf("%v %v\n", colorizePassedTest(passedTestStatus), testName)
+			continue
+		}
+		numFailedTests += 1
+		fmt.Printf("%v %v\n%v\n", colorizeFailedTest(failedTestStatus), testName, testResult.GetFailure().GetErrorMessage())
+	}
+	fmt.Printf("%v passed, %v failed\n", len(testResults)-numFailedTests, numFailedTests)
+	return numFailedTests
+}
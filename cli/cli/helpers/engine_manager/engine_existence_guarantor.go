@@ -38,6 +38,9 @@ type engineExistenceGuarantor struct {
 	// Whether any engine that gets started should send metrics
 	shouldSendMetrics bool
 
+	// The Git hosts other than GitHub any engine that gets started lets the enclaves fetch packages from
+	cloneUrlPrefixPerCustomGitHost map[string]string
+
 	// Host machine IP:port of the maybe-started, maybe-not engine (will only be present if the engine status isn't "stopped")
 	preVisitingMaybeHostMachineIpAndPort *hostMachineIpAndPort
 
@@ -68,6 +71,7 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	preVisitingMaybeHostMachineIpAndPort *hostMachineIpAndPort,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	shouldSendMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier,
 	logLevel logrus.Level,
 	maybeCurrentlyRunningEngineVersionTag string,
@@ -78,6 +82,7 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		preVisitingMaybeHostMachineIpAndPort,
 		kurtosisBackend,
 		shouldSendMetrics,
+		cloneUrlPrefixPerCustomGitHost,
 		engineServerKurtosisBackendConfigSupplier,
 		defaultEngineImageVersionTag,
 		logLevel,
@@ -91,6 +96,7 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	preVisitingMaybeHostMachineIpAndPort *hostMachineIpAndPort,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	shouldSendMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier,
 	imageVersionTag string,
 	logLevel logrus.Level,
@@ -108,6 +114,7 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		maybeCurrentlyRunningEngineVersionTag:     maybeCurrentlyRunningEngineVersionTag,
 		postVisitingHostMachineIpAndPort:          nil, // Will be filled in upon successful visitation
 		shouldSendMetrics:                         shouldSendMetrics,
+		cloneUrlPrefixPerCustomGitHost:            cloneUrlPrefixPerCustomGitHost,
 		kurtosisClusterType:                       kurtosisClusterType,
 	}
 }
@@ -135,6 +142,7 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			kurtosis_context.DefaultGrpcProxyEngineServerPortNum,
 			metricsUserId,
 			guarantor.shouldSendMetrics,
+			guarantor.cloneUrlPrefixPerCustomGitHost,
 			guarantor.engineServerKurtosisBackendConfigSupplier,
 		)
 	} else {
@@ -146,6 +154,7 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			kurtosis_context.DefaultGrpcProxyEngineServerPortNum,
 			metricsUserId,
 			guarantor.shouldSendMetrics,
+			guarantor.cloneUrlPrefixPerCustomGitHost,
 			guarantor.engineServerKurtosisBackendConfigSupplier,
 		)
 	}
@@ -28,6 +28,7 @@ const (
 type EngineManager struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	shouldSendMetrics                         bool
+	cloneUrlPrefixPerCustomGitHost            map[string]string
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
 	// Make engine IP, port, and protocol configurable in the future
@@ -73,10 +74,11 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 	engineBackendConfigSupplier := clusterConfig.GetEngineBackendConfigSupplier()
 
 	return &EngineManager{
-		kurtosisBackend:   kurtosisBackend,
-		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
+		kurtosisBackend:                           kurtosisBackend,
+		shouldSendMetrics:                         kurtosisConfig.GetShouldSendMetrics(),
+		cloneUrlPrefixPerCustomGitHost:            kurtosisConfig.GetCloneUrlPrefixPerCustomGitHost(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
-		clusterConfig: clusterConfig,
+		clusterConfig:                             clusterConfig,
 	}, nil
 }
 
@@ -142,6 +144,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(ctx cont
 		maybeHostMachinePortBinding,
 		manager.kurtosisBackend,
 		manager.shouldSendMetrics,
+		manager.cloneUrlPrefixPerCustomGitHost,
 		manager.engineServerKurtosisBackendConfigSupplier,
 		logLevel,
 		engineVersion,
@@ -169,6 +172,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		maybeHostMachinePortBinding,
 		manager.kurtosisBackend,
 		manager.shouldSendMetrics,
+		manager.cloneUrlPrefixPerCustomGitHost,
 		manager.engineServerKurtosisBackendConfigSupplier,
 		engineImageVersionTag,
 		logLevel,
@@ -235,9 +239,9 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 	}
 
 	clusterType := manager.clusterConfig.GetClusterType()
-	// If we're in docker, we can make a health check
+	// If we're in docker (or podman, which publishes the engine ports the same way), we can make a health check
 	// In the kubernetes case, this health check will fail if the gateway isn't running
-	if clusterType == resolved_config.KurtosisClusterType_Docker {
+	if clusterType == resolved_config.KurtosisClusterType_Docker || clusterType == resolved_config.KurtosisClusterType_Podman {
 		// Final verification to ensure that the engine server is responding
 		if _, err := getEngineInfoWithTimeout(ctx, engineClient); err != nil {
 			return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the engine server after starting it ")
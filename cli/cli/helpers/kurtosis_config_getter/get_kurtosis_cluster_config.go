@@ -1,6 +1,7 @@
 package kurtosis_config_getter
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
@@ -59,3 +60,17 @@ func GetKurtosisClusterConfig() (*resolved_config.KurtosisClusterConfig, error)
 
 	return clusterConfig, nil
 }
+
+// GetGitCredentialsPerGitHost returns the credentials of the Git hosts of the Kurtosis config, which the enclaves get
+// created with so that their packages can be fetched from private repositories
+func GetGitCredentialsPerGitHost() (map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials, error) {
+	kurtosisConfig, err := getKurtosisConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
+	}
+	gitCredentialsPerGitHost, err := kurtosisConfig.LoadGitCredentialsPerGitHost()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the credentials of the Git hosts of the Kurtosis configuration")
+	}
+	return gitCredentialsPerGitHost, nil
+}
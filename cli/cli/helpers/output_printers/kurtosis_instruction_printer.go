@@ -28,6 +28,10 @@ const (
 
 	codeCommentPrefix = "# "
 
+	applyPlanHeader            = "Changes planned to apply the package to the enclave:"
+	applyPlanEmptyMsg          = "The enclave is already up-to-date with the package, no change is planned"
+	applyPlanChangeIndentation = "  "
+
 	newlineChar = "\n"
 )
 
@@ -37,6 +41,11 @@ var (
 	colorizeError            = color.New(color.FgRed).SprintFunc()
 	colorizeRunSuccessfulMsg = color.New(color.FgGreen).SprintFunc()
 
+	colorizeApplyPlanAdd    = color.New(color.FgGreen).SprintFunc()
+	colorizeApplyPlanUpdate = color.New(color.FgYellow).SprintFunc()
+	colorizeApplyPlanRemove = color.New(color.FgRed).SprintFunc()
+	colorizeApplyPlanOther  = color.New(color.FgWhite).SprintFunc()
+
 	colorizeProgressBarIsDone    = color.New(color.FgGreen).SprintFunc()
 	colorizeProgressBarRemaining = color.New(color.FgWhite).SprintFunc()
 )
@@ -130,6 +139,12 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 		if err := printer.printPersistentLineToStdOut(formattedError); err != nil {
 			return stacktrace.Propagate(err, "An error happened executing Starlark code but the error couldn't be printed to the CLI output. Error message was: \n%v", errorMsg)
 		}
+	} else if responseLine.GetApplyPlan() != nil {
+		formattedApplyPlan := formatApplyPlan(responseLine.GetApplyPlan())
+		formattedApplyPlanWithNewline := fmt.Sprintf("\n%s", formattedApplyPlan)
+		if err := printer.printPersistentLineToStdOut(formattedApplyPlanWithNewline); err != nil {
+			return stacktrace.Propagate(err, "Error printing the changes planned to apply the package: \n%v", formattedApplyPlan)
+		}
 	} else if responseLine.GetProgressInfo() != nil {
 		if printer.isSpinnerBeingUsed {
 			progress := responseLine.GetProgressInfo()
@@ -229,6 +244,36 @@ func formatInstructionToExecutable(instruction *kurtosis_core_rpc_api_bindings.S
 	return multiLineInstruction.String()
 }
 
+func formatApplyPlan(applyPlan *kurtosis_core_rpc_api_bindings.StarlarkApplyPlan) string {
+	formattedChanges := []string{applyPlanHeader}
+	isEnclaveChanging := false
+	for _, change := range applyPlan.GetChanges() {
+		resourceType := strings.ReplaceAll(strings.ToLower(change.GetResourceType().String()), "_", " ")
+		formattedChange := fmt.Sprintf("%s%s %s '%s'", applyPlanChangeIndentation, strings.ToLower(change.GetAction().String()), resourceType, change.GetResourceName())
+		if change.InstructionNumber != nil {
+			formattedChange = fmt.Sprintf("%s (instruction #%d)", formattedChange, change.GetInstructionNumber())
+		}
+		switch change.GetAction() {
+		case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ADD:
+			isEnclaveChanging = true
+			formattedChange = colorizeApplyPlanAdd(formattedChange)
+		case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE:
+			isEnclaveChanging = true
+			formattedChange = colorizeApplyPlanUpdate(formattedChange)
+		case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE:
+			isEnclaveChanging = true
+			formattedChange = colorizeApplyPlanRemove(formattedChange)
+		default:
+			formattedChange = colorizeApplyPlanOther(formattedChange)
+		}
+		formattedChanges = append(formattedChanges, formattedChange)
+	}
+	if !isEnclaveChanging {
+		formattedChanges = append(formattedChanges, applyPlanEmptyMsg)
+	}
+	return strings.Join(formattedChanges, newlineChar)
+}
+
 func formatProgressMessage(messageLines []string) string {
 	return strings.Join(messageLines, newlineChar)
 }
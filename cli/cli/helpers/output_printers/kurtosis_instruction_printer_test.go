@@ -153,3 +153,33 @@ func TestFormatRunOutput_Failure_ExecutedRun(t *testing.T) {
 	expectedMessage := `Error encountered running Starlark code.`
 	require.Equal(t, expectedMessage, message)
 }
+
+func TestFormatApplyPlan(t *testing.T) {
+	addedService := binding_constructors.NewStarlarkApplyPlanChange(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ADD, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, "serviceA")
+	instructionNumber := uint32(2)
+	addedService.InstructionNumber = &instructionNumber
+	removedService := binding_constructors.NewStarlarkApplyPlanChange(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, "serviceB")
+	applyPlan := binding_constructors.NewStarlarkRunResponseLineFromApplyPlan([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		addedService,
+		removedService,
+	}).GetApplyPlan()
+
+	formattedApplyPlan := formatApplyPlan(applyPlan)
+	expectedResult := `Changes planned to apply the package to the enclave:
+  add service 'serviceA' (instruction #2)
+  remove service 'serviceB'`
+	require.Equal(t, expectedResult, formattedApplyPlan)
+}
+
+func TestFormatApplyPlan_NothingChanged(t *testing.T) {
+	unchangedArtifact := binding_constructors.NewStarlarkApplyPlanChange(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_FILES_ARTIFACT, "artifactA")
+	applyPlan := binding_constructors.NewStarlarkRunResponseLineFromApplyPlan([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		unchangedArtifact,
+	}).GetApplyPlan()
+
+	formattedApplyPlan := formatApplyPlan(applyPlan)
+	expectedResult := `Changes planned to apply the package to the enclave:
+  unchanged files artifact 'artifactA'
+The enclave is already up-to-date with the package, no change is planned`
+	require.Equal(t, expectedResult, formattedApplyPlan)
+}
@@ -0,0 +1,108 @@
+package package_dependencies
+
+import (
+	"errors"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path"
+)
+
+const (
+	KurtosisYamlFilename = "kurtosis.yml"
+	KurtosisLockFilename = "kurtosis-lock.yml"
+
+	VendorDirname = "vendor"
+	// the manifest of the vendor directory has the format of the lockfile
+	KurtosisVendorManifestFilename = "kurtosis-vendor.yml"
+
+	generatedFilePerms           = 0644
+	kurtosisLockHeader           = "# This file is generated by 'kurtosis package lock' and shouldn't be edited by hand\n"
+	kurtosisVendorManifestHeader = "# This file is generated by 'kurtosis package vendor' and shouldn't be edited by hand\n"
+)
+
+// KurtosisYaml is the kurtosis.yml at the root of a package; fields are public because it's needed for YAML decoding
+type KurtosisYaml struct {
+	PackageName string `yaml:"name"`
+
+	// The version constraints per package locator, e.g. 'github.com/author/repo: ^1.2.0'
+	Dependencies map[string]string `yaml:"dependencies"`
+}
+
+// KurtosisLock is the kurtosis-lock.yml written next to the kurtosis.yml of a package, locking the repositories of
+// its dependencies, including the transitive ones, to a commit
+type KurtosisLock struct {
+	// The dependencies per repository locator, e.g. 'github.com/author/repo'
+	Dependencies map[string]*LockedDependency `yaml:"dependencies"`
+}
+
+type LockedDependency struct {
+	// The tag, branch or commit the version constraints of the dependency resolved to, for humans reading the lockfile
+	Version string `yaml:"version"`
+
+	Commit string `yaml:"commit"`
+}
+
+func ParseKurtosisYaml(kurtosisYamlContent []byte) (*KurtosisYaml, error) {
+	var kurtosisYaml KurtosisYaml
+	if err := yaml.Unmarshal(kurtosisYamlContent, &kurtosisYaml); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the content of the '%v'", KurtosisYamlFilename)
+	}
+	if kurtosisYaml.PackageName == "" {
+		return nil, stacktrace.NewError("Field 'name', which is the Starlark package's name, in %v needs to be set and cannot be empty", KurtosisYamlFilename)
+	}
+	return &kurtosisYaml, nil
+}
+
+func ReadKurtosisYaml(packageDirpath string) (*KurtosisYaml, error) {
+	kurtosisYamlFilepath := path.Join(packageDirpath, KurtosisYamlFilename)
+	kurtosisYamlContent, err := os.ReadFile(kurtosisYamlFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the '%v' of the package at '%v'", KurtosisYamlFilename, packageDirpath)
+	}
+	kurtosisYaml, err := ParseKurtosisYaml(kurtosisYamlContent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing '%v'", kurtosisYamlFilepath)
+	}
+	return kurtosisYaml, nil
+}
+
+// ReadKurtosisLock returns the lockfile of the package, or nil if it has none
+func ReadKurtosisLock(packageDirpath string) (*KurtosisLock, error) {
+	kurtosisLockFilepath := path.Join(packageDirpath, KurtosisLockFilename)
+	kurtosisLockContent, err := os.ReadFile(kurtosisLockFilepath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the '%v' of the package at '%v'", KurtosisLockFilename, packageDirpath)
+	}
+	var kurtosisLock KurtosisLock
+	if err = yaml.Unmarshal(kurtosisLockContent, &kurtosisLock); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing '%v'", kurtosisLockFilepath)
+	}
+	for repositoryLocator, lockedDependency := range kurtosisLock.Dependencies {
+		if lockedDependency == nil || lockedDependency.Commit == "" {
+			return nil, stacktrace.NewError("The dependency '%v' of '%v' isn't locked to any commit", repositoryLocator, kurtosisLockFilepath)
+		}
+	}
+	return &kurtosisLock, nil
+}
+
+func WriteKurtosisLock(packageDirpath string, kurtosisLock *KurtosisLock) error {
+	if err := writeGeneratedYamlFile(path.Join(packageDirpath, KurtosisLockFilename), kurtosisLockHeader, kurtosisLock); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the '%v' of the package at '%v'", KurtosisLockFilename, packageDirpath)
+	}
+	return nil
+}
+
+func writeGeneratedYamlFile(filepath string, header string, content interface{}) error {
+	serializedContent, err := yaml.Marshal(content)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the content of '%v'", filepath)
+	}
+	if err = os.WriteFile(filepath, append([]byte(header), serializedContent...), generatedFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing '%v'", filepath)
+	}
+	return nil
+}
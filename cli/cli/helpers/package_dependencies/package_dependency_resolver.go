@@ -0,0 +1,314 @@
+package package_dependencies
+
+import (
+	"fmt"
+	"github.com/Masterminds/semver/v3"
+	"github.com/kurtosis-tech/stacktrace"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// The dependencies of each round get resolved using the kurtosis.yml of the versions the previous round resolved
+	// to, until a round doesn't change any version
+	maxResolutionRounds = 20
+
+	defaultBranchConstraint = ""
+)
+
+var commitHashRegex = regexp.MustCompile("^[0-9a-f]{40}$")
+
+// RepositoryRefs are the refs of a repository, each mapped to the commit it points to
+type RepositoryRefs struct {
+	CommitPerTag map[string]string
+
+	CommitPerBranch map[string]string
+
+	DefaultBranch string
+}
+
+// GitRepositories gives access to the repositories dependencies get resolved against
+type GitRepositories interface {
+	GetRefs(repositoryLocator string) (*RepositoryRefs, error)
+
+	// GetFileContent returns the content of a file of a repository at a commit, and false if the file doesn't exist
+	GetFileContent(repositoryLocator string, commit string, filepath string) ([]byte, bool, error)
+
+	// GetFilepaths returns the paths of the files of a repository at a commit that are inside the given directory,
+	// relative to the root of the repository; an empty directory stands for the root
+	GetFilepaths(repositoryLocator string, commit string, dirpath string) ([]string, error)
+}
+
+type dependencyConstraint struct {
+	// Either a semantic version constraint, like '^1.2.0', a tag, a branch, a commit or empty for the default branch
+	constraint string
+
+	// The name of the package declaring the dependency
+	requiredBy string
+}
+
+func (constraint *dependencyConstraint) String() string {
+	return fmt.Sprintf("'%v' required by '%v'", constraint.constraint, constraint.requiredBy)
+}
+
+type resolvedVersion struct {
+	version string
+	commit  string
+}
+
+// ResolveDependencies resolves the dependencies of a package, including the transitive ones, to the commit each of
+// their repositories gets locked to. The semantic version constraints of a repository resolve to the highest of its
+// tags satisfying all of them, while a tag, a branch or a commit has to be the same for every package depending on it.
+func ResolveDependencies(
+	packageName string,
+	dependencies map[string]string,
+	cloneUrlPrefixPerGitHost map[string]string,
+	repositories GitRepositories,
+) (*KurtosisLock, error) {
+	rootPackageLocator, err := parsePackageLocator(packageName, cloneUrlPrefixPerGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the name '%v' of the package", packageName)
+	}
+	resolver := &dependencyResolver{
+		cloneUrlPrefixPerGitHost: cloneUrlPrefixPerGitHost,
+		repositories:             repositories,
+		refsPerRepository:        map[string]*RepositoryRefs{},
+		kurtosisYamlPerPackage:   map[string]*KurtosisYaml{},
+	}
+
+	resolvedVersionPerRepository := map[string]resolvedVersion{}
+	for round := 0; round < maxResolutionRounds; round++ {
+		constraintsPerRepository, err := resolver.collectConstraints(rootPackageLocator, dependencies, resolvedVersionPerRepository)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred collecting the version constraints of the dependencies")
+		}
+		newResolvedVersionPerRepository := map[string]resolvedVersion{}
+		for repositoryLocator, constraints := range constraintsPerRepository {
+			version, err := resolver.resolveRepository(repositoryLocator, constraints)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred resolving the version of repository '%v'", repositoryLocator)
+			}
+			newResolvedVersionPerRepository[repositoryLocator] = *version
+		}
+		if reflect.DeepEqual(newResolvedVersionPerRepository, resolvedVersionPerRepository) {
+			return newKurtosisLock(resolvedVersionPerRepository), nil
+		}
+		resolvedVersionPerRepository = newResolvedVersionPerRepository
+	}
+	return nil, stacktrace.NewError("The versions of the dependencies were still changing after %v rounds of resolution; the constraints of the '%v' of some versions might be contradicting each other", maxResolutionRounds, KurtosisYamlFilename)
+}
+
+type dependencyResolver struct {
+	cloneUrlPrefixPerGitHost map[string]string
+	repositories             GitRepositories
+
+	refsPerRepository      map[string]*RepositoryRefs
+	kurtosisYamlPerPackage map[string]*KurtosisYaml
+}
+
+// collectConstraints walks the dependencies of the package, expanding the ones whose repository got resolved by the
+// previous round with the dependencies of the kurtosis.yml at its resolved commit
+func (resolver *dependencyResolver) collectConstraints(
+	rootPackageLocator *packageLocator,
+	rootDependencies map[string]string,
+	resolvedVersionPerRepository map[string]resolvedVersion,
+) (map[string][]*dependencyConstraint, error) {
+	constraintsPerRepository := map[string][]*dependencyConstraint{}
+	visitedPackages := map[string]bool{}
+
+	var addDependencies func(requiredBy *packageLocator, dependencies map[string]string) error
+	addDependencies = func(requiredBy *packageLocator, dependencies map[string]string) error {
+		for _, dependencyLocatorStr := range getSortedKeys(dependencies) {
+			dependencyLocator, err := parsePackageLocator(dependencyLocatorStr, resolver.cloneUrlPrefixPerGitHost)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred parsing dependency '%v' of package '%v'", dependencyLocatorStr, requiredBy)
+			}
+			// the packages of a repository import each other at the commit they're at, and the repository of the
+			// package being locked is the one on disk
+			if dependencyLocator.repositoryLocator == requiredBy.repositoryLocator || dependencyLocator.repositoryLocator == rootPackageLocator.repositoryLocator {
+				continue
+			}
+			constraintsPerRepository[dependencyLocator.repositoryLocator] = append(
+				constraintsPerRepository[dependencyLocator.repositoryLocator],
+				&dependencyConstraint{
+					constraint: strings.TrimSpace(dependencies[dependencyLocatorStr]),
+					requiredBy: requiredBy.String(),
+				},
+			)
+
+			version, found := resolvedVersionPerRepository[dependencyLocator.repositoryLocator]
+			if !found || visitedPackages[dependencyLocator.String()] {
+				continue
+			}
+			visitedPackages[dependencyLocator.String()] = true
+			kurtosisYaml, err := resolver.getKurtosisYaml(dependencyLocator, version.commit)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the '%v' of dependency '%v' at commit '%v'", KurtosisYamlFilename, dependencyLocator, version.commit)
+			}
+			if err = addDependencies(dependencyLocator, kurtosisYaml.Dependencies); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := addDependencies(rootPackageLocator, rootDependencies); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the dependencies of package '%v'", rootPackageLocator)
+	}
+	return constraintsPerRepository, nil
+}
+
+func (resolver *dependencyResolver) resolveRepository(repositoryLocator string, constraints []*dependencyConstraint) (*resolvedVersion, error) {
+	refs, err := resolver.getRefs(repositoryLocator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the refs of repository '%v'", repositoryLocator)
+	}
+
+	semverConstraints := map[*dependencyConstraint]*semver.Constraints{}
+	var refConstraint *dependencyConstraint
+	for _, constraint := range constraints {
+		if constraint.constraint != defaultBranchConstraint && !commitHashRegex.MatchString(constraint.constraint) {
+			if parsedConstraint, err := semver.NewConstraint(constraint.constraint); err == nil {
+				semverConstraints[constraint] = parsedConstraint
+				continue
+			}
+		}
+		if refConstraint != nil && refConstraint.constraint != constraint.constraint {
+			return nil, stacktrace.NewError("The version constraints %v and %v of repository '%v' conflict; a tag, a branch or a commit has to be the same for every package depending on the repository", refConstraint, constraint, repositoryLocator)
+		}
+		refConstraint = constraint
+	}
+
+	if refConstraint == nil {
+		return resolveSemverConstraints(repositoryLocator, refs, semverConstraints)
+	}
+	version, err := resolveRefConstraint(repositoryLocator, refs, refConstraint)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving version constraint %v", refConstraint)
+	}
+	for constraint, parsedConstraint := range semverConstraints {
+		if _, isTag := refs.CommitPerTag[version.version]; !isTag {
+			return nil, stacktrace.NewError("The version constraints %v and %v of repository '%v' conflict; '%v' isn't a tag", constraint, refConstraint, repositoryLocator, version.version)
+		}
+		tagVersion, err := semver.NewVersion(version.version)
+		if err != nil || !parsedConstraint.Check(tagVersion) {
+			return nil, stacktrace.NewError("The version constraints %v and %v of repository '%v' conflict; tag '%v' doesn't satisfy the first one", constraint, refConstraint, repositoryLocator, version.version)
+		}
+	}
+	return version, nil
+}
+
+// resolveSemverConstraints returns the highest tag satisfying all the constraints, ignoring the tags that aren't
+// semantic versions
+func resolveSemverConstraints(repositoryLocator string, refs *RepositoryRefs, constraints map[*dependencyConstraint]*semver.Constraints) (*resolvedVersion, error) {
+	var highestTag string
+	var highestVersion *semver.Version
+	for _, tag := range getSortedKeys(refs.CommitPerTag) {
+		tagVersion, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		satisfiesAllConstraints := true
+		for _, parsedConstraint := range constraints {
+			if !parsedConstraint.Check(tagVersion) {
+				satisfiesAllConstraints = false
+				break
+			}
+		}
+		if satisfiesAllConstraints && (highestVersion == nil || tagVersion.GreaterThan(highestVersion)) {
+			highestTag = tag
+			highestVersion = tagVersion
+		}
+	}
+	if highestVersion == nil {
+		constraintStrs := []string{}
+		for constraint := range constraints {
+			constraintStrs = append(constraintStrs, constraint.String())
+		}
+		sort.Strings(constraintStrs)
+		return nil, stacktrace.NewError("No tag of repository '%v' satisfies all the version constraints %v", repositoryLocator, strings.Join(constraintStrs, ", "))
+	}
+	return &resolvedVersion{
+		version: highestTag,
+		commit:  refs.CommitPerTag[highestTag],
+	}, nil
+}
+
+func resolveRefConstraint(repositoryLocator string, refs *RepositoryRefs, constraint *dependencyConstraint) (*resolvedVersion, error) {
+	ref := constraint.constraint
+	if ref == defaultBranchConstraint {
+		ref = refs.DefaultBranch
+	}
+	if commit, found := refs.CommitPerTag[ref]; found {
+		return &resolvedVersion{version: ref, commit: commit}, nil
+	}
+	if commit, found := refs.CommitPerBranch[ref]; found {
+		return &resolvedVersion{version: ref, commit: commit}, nil
+	}
+	// the commit gets checked to exist when its kurtosis.yml gets read
+	if commitHashRegex.MatchString(ref) {
+		return &resolvedVersion{version: ref, commit: ref}, nil
+	}
+	return nil, stacktrace.NewError("'%v' is neither a semantic version constraint, a tag, a branch nor a full commit hash of repository '%v'", ref, repositoryLocator)
+}
+
+func (resolver *dependencyResolver) getRefs(repositoryLocator string) (*RepositoryRefs, error) {
+	if refs, found := resolver.refsPerRepository[repositoryLocator]; found {
+		return refs, nil
+	}
+	refs, err := resolver.repositories.GetRefs(repositoryLocator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the refs of repository '%v'", repositoryLocator)
+	}
+	resolver.refsPerRepository[repositoryLocator] = refs
+	return refs, nil
+}
+
+func (resolver *dependencyResolver) getKurtosisYaml(locator *packageLocator, commit string) (*KurtosisYaml, error) {
+	cacheKey := locator.String() + locatorVersionSeparator + commit
+	if kurtosisYaml, found := resolver.kurtosisYamlPerPackage[cacheKey]; found {
+		return kurtosisYaml, nil
+	}
+	kurtosisYamlFilepath := path.Join(locator.pathInRepository, KurtosisYamlFilename)
+	kurtosisYamlContent, found, err := resolver.repositories.GetFileContent(locator.repositoryLocator, commit, kurtosisYamlFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading '%v' of repository '%v' at commit '%v'", kurtosisYamlFilepath, locator.repositoryLocator, commit)
+	}
+	if !found {
+		return nil, stacktrace.NewError("No '%v' was found at '%v' of repository '%v' at commit '%v', so it isn't a Kurtosis package", KurtosisYamlFilename, kurtosisYamlFilepath, locator.repositoryLocator, commit)
+	}
+	kurtosisYaml, err := ParseKurtosisYaml(kurtosisYamlContent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing '%v' of repository '%v' at commit '%v'", kurtosisYamlFilepath, locator.repositoryLocator, commit)
+	}
+	if kurtosisYaml.PackageName != locator.String() {
+		return nil, stacktrace.NewError("The package name in '%v' of repository '%v' at commit '%v' must match the location it is in; package name is '%v' while its locator is '%v'", kurtosisYamlFilepath, locator.repositoryLocator, commit, kurtosisYaml.PackageName, locator)
+	}
+	resolver.kurtosisYamlPerPackage[cacheKey] = kurtosisYaml
+	return kurtosisYaml, nil
+}
+
+func newKurtosisLock(resolvedVersionPerRepository map[string]resolvedVersion) *KurtosisLock {
+	lockedDependencies := map[string]*LockedDependency{}
+	for repositoryLocator, version := range resolvedVersionPerRepository {
+		lockedDependencies[repositoryLocator] = &LockedDependency{
+			Version: version.version,
+			Commit:  version.commit,
+		}
+	}
+	return &KurtosisLock{
+		Dependencies: lockedDependencies,
+	}
+}
+
+func getSortedKeys(stringsPerKey map[string]string) []string {
+	keys := []string{}
+	for key := range stringsPerKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+package package_dependencies
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const (
+	rootPackageName = "github.com/me/root"
+	libRepository   = "github.com/author/lib"
+	utilsRepository = "gitlab.mycompany.com/author/utils"
+
+	libCommitV1_0_0        = "1000000000000000000000000000000000000000"
+	libCommitV1_2_0        = "1200000000000000000000000000000000000000"
+	libCommitV2_0_0        = "2000000000000000000000000000000000000000"
+	libCommitMain          = "3000000000000000000000000000000000000000"
+	utilsCommitV1          = "4000000000000000000000000000000000000000"
+	utilsCommitV1_1        = "4100000000000000000000000000000000000000"
+	utilsCommitMain        = "4200000000000000000000000000000000000000"
+	unknownCommit          = "5000000000000000000000000000000000000000"
+	defaultBranch          = ""
+	kurtosisYamlNamePrefix = "name: "
+)
+
+var testCloneUrlPrefixPerGitHost = map[string]string{
+	"github.com":           "https://github.com",
+	"gitlab.mycompany.com": "https://gitlab.mycompany.com",
+}
+
+// fakeGitRepositories serves the refs and the kurtosis.yml files of each repository per commit
+type fakeGitRepositories struct {
+	refsPerRepository map[string]*RepositoryRefs
+
+	fileContentPerRepositoryCommitAndPath map[string]string
+}
+
+func (repositories *fakeGitRepositories) GetRefs(repositoryLocator string) (*RepositoryRefs, error) {
+	refs, found := repositories.refsPerRepository[repositoryLocator]
+	if !found {
+		return nil, stacktrace.NewError("Repository '%v' doesn't exist", repositoryLocator)
+	}
+	return refs, nil
+}
+
+func (repositories *fakeGitRepositories) GetFileContent(repositoryLocator string, commit string, filepath string) ([]byte, bool, error) {
+	content, found := repositories.fileContentPerRepositoryCommitAndPath[repositoryLocator+"@"+commit+"/"+filepath]
+	if !found {
+		return nil, false, nil
+	}
+	return []byte(content), true, nil
+}
+
+func (repositories *fakeGitRepositories) GetFilepaths(repositoryLocator string, commit string, dirpath string) ([]string, error) {
+	filepathPrefix := repositoryLocator + "@" + commit + "/"
+	if dirpath != "" {
+		filepathPrefix += dirpath + "/"
+	}
+	filepaths := []string{}
+	for key := range repositories.fileContentPerRepositoryCommitAndPath {
+		if strings.HasPrefix(key, filepathPrefix) {
+			filepaths = append(filepaths, strings.TrimPrefix(key, repositoryLocator+"@"+commit+"/"))
+		}
+	}
+	sort.Strings(filepaths)
+	return filepaths, nil
+}
+
+func newTestGitRepositories() *fakeGitRepositories {
+	return &fakeGitRepositories{
+		refsPerRepository: map[string]*RepositoryRefs{
+			libRepository: {
+				CommitPerTag: map[string]string{
+					"v1.0.0":       libCommitV1_0_0,
+					"v1.2.0":       libCommitV1_2_0,
+					"v2.0.0":       libCommitV2_0_0,
+					"not-a-semver": libCommitMain,
+				},
+				CommitPerBranch: map[string]string{"main": libCommitMain},
+				DefaultBranch:   "main",
+			},
+			utilsRepository: {
+				CommitPerTag: map[string]string{
+					"1.0.0": utilsCommitV1,
+					"1.1.0": utilsCommitV1_1,
+				},
+				CommitPerBranch: map[string]string{"master": utilsCommitMain},
+				DefaultBranch:   "master",
+			},
+		},
+		fileContentPerRepositoryCommitAndPath: map[string]string{
+			libRepository + "@" + libCommitV1_0_0 + "/kurtosis.yml":       kurtosisYamlNamePrefix + libRepository + "\ndependencies:\n  " + utilsRepository + "/sub: ~1.0.0\n",
+			libRepository + "@" + libCommitV1_2_0 + "/kurtosis.yml":       kurtosisYamlNamePrefix + libRepository + "\ndependencies:\n  " + utilsRepository + "/sub: ^1.0.0\n",
+			libRepository + "@" + libCommitV2_0_0 + "/kurtosis.yml":       kurtosisYamlNamePrefix + libRepository + "\n",
+			libRepository + "@" + libCommitMain + "/kurtosis.yml":         kurtosisYamlNamePrefix + libRepository + "\n",
+			utilsRepository + "@" + utilsCommitV1 + "/sub/kurtosis.yml":   kurtosisYamlNamePrefix + utilsRepository + "/sub\ndependencies:\n  " + rootPackageName + ": \"\"\n",
+			utilsRepository + "@" + utilsCommitV1_1 + "/sub/kurtosis.yml": kurtosisYamlNamePrefix + utilsRepository + "/sub\ndependencies:\n  " + utilsRepository + "/other: \"\"\n",
+			utilsRepository + "@" + utilsCommitMain + "/sub/kurtosis.yml": kurtosisYamlNamePrefix + utilsRepository + "/sub\n",
+		},
+	}
+}
+
+func TestResolveDependencies_ResolvesTransitiveDependencies(t *testing.T) {
+	kurtosisLock, err := ResolveDependencies(rootPackageName, map[string]string{libRepository: "^1.0.0"}, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+	require.NoError(t, err)
+
+	expectedKurtosisLock := &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{
+			libRepository:   {Version: "v1.2.0", Commit: libCommitV1_2_0},
+			utilsRepository: {Version: "1.1.0", Commit: utilsCommitV1_1},
+		},
+	}
+	require.Equal(t, expectedKurtosisLock, kurtosisLock)
+}
+
+func TestResolveDependencies_SatisfiesTheConstraintsOfEveryPackage(t *testing.T) {
+	kurtosisLock, err := ResolveDependencies(rootPackageName, map[string]string{libRepository: "1.0.0"}, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+	require.NoError(t, err)
+
+	// lib 1.0.0 depends on utils ~1.0.0, whose package depends on the root package which is skipped
+	expectedKurtosisLock := &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{
+			libRepository:   {Version: "v1.0.0", Commit: libCommitV1_0_0},
+			utilsRepository: {Version: "1.0.0", Commit: utilsCommitV1},
+		},
+	}
+	require.Equal(t, expectedKurtosisLock, kurtosisLock)
+}
+
+func TestResolveDependencies_ResolvesRefs(t *testing.T) {
+	kurtosisLock, err := ResolveDependencies(rootPackageName, map[string]string{
+		libRepository:            defaultBranch,
+		utilsRepository + "/sub": utilsCommitV1_1,
+	}, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+	require.NoError(t, err)
+
+	expectedKurtosisLock := &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{
+			libRepository:   {Version: "main", Commit: libCommitMain},
+			utilsRepository: {Version: utilsCommitV1_1, Commit: utilsCommitV1_1},
+		},
+	}
+	require.Equal(t, expectedKurtosisLock, kurtosisLock)
+}
+
+func TestResolveDependencies_FailsOnConflictingConstraints(t *testing.T) {
+	_, err := ResolveDependencies(rootPackageName, map[string]string{
+		libRepository:            "^1.0.0",
+		utilsRepository + "/sub": "master",
+	}, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "conflict")
+
+	_, err = ResolveDependencies(rootPackageName, map[string]string{
+		libRepository:            "^1.0.0",
+		utilsRepository + "/sub": ">=2.0.0",
+	}, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "No tag of repository '"+utilsRepository+"' satisfies all the version constraints")
+}
+
+func TestResolveDependencies_FailsOnInvalidDependencies(t *testing.T) {
+	invalidDependencies := []map[string]string{
+		{libRepository + "@v1.0.0": defaultBranch},
+		{"github.com/author": defaultBranch},
+		{"bitbucket.org/author/repo": defaultBranch},
+		{libRepository: "unknown-branch"},
+		{libRepository: unknownCommit},
+		{libRepository + "/not-a-package": defaultBranch},
+	}
+	for _, dependencies := range invalidDependencies {
+		_, err := ResolveDependencies(rootPackageName, dependencies, testCloneUrlPrefixPerGitHost, newTestGitRepositories())
+		require.Error(t, err, "Expected dependencies '%+v' to be invalid", dependencies)
+	}
+}
+
+func TestParsePackageLocator(t *testing.T) {
+	locator, err := parsePackageLocator("gitlab.mycompany.com/author/utils/path/to/package/", testCloneUrlPrefixPerGitHost)
+	require.NoError(t, err)
+	require.Equal(t, utilsRepository, locator.repositoryLocator)
+	require.Equal(t, "path/to/package", locator.pathInRepository)
+	require.Equal(t, "gitlab.mycompany.com/author/utils/path/to/package", locator.String())
+}
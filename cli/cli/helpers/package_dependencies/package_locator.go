@@ -0,0 +1,55 @@
+package package_dependencies
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	locatorPathSeparator          = "/"
+	locatorVersionSeparator       = "@"
+	locatorSchemeSeparator        = "://"
+	numLocatorRepositoryPathParts = 3
+)
+
+// packageLocator is the locator of a package, e.g. 'github.com/author/repo/path/to/package', split into the
+// repository it lives in and its path within it
+type packageLocator struct {
+	// e.g. 'github.com/author/repo'
+	repositoryLocator string
+
+	// e.g. 'path/to/package', empty for a package at the root of its repository
+	pathInRepository string
+}
+
+func parsePackageLocator(locator string, cloneUrlPrefixPerGitHost map[string]string) (*packageLocator, error) {
+	if strings.Contains(locator, locatorSchemeSeparator) {
+		return nil, stacktrace.NewError("Package locator '%v' is invalid; it shouldn't have any scheme", locator)
+	}
+	if strings.Contains(locator, locatorVersionSeparator) {
+		return nil, stacktrace.NewError("Package locator '%v' is invalid; the version of a dependency is declared as its value in the '%v' rather than in its locator", locator, KurtosisYamlFilename)
+	}
+	locatorParts := strings.Split(strings.Trim(path.Clean(locator), locatorPathSeparator), locatorPathSeparator)
+	if len(locatorParts) < numLocatorRepositoryPathParts {
+		return nil, stacktrace.NewError("Package locator '%v' is invalid; it should be a Git host followed by the author and the name of a repository, like 'github.com/author/repo'", locator)
+	}
+	gitHost := locatorParts[0]
+	if _, found := cloneUrlPrefixPerGitHost[gitHost]; !found {
+		allowedGitHosts := []string{}
+		for allowedGitHost := range cloneUrlPrefixPerGitHost {
+			allowedGitHosts = append(allowedGitHosts, allowedGitHost)
+		}
+		sort.Strings(allowedGitHosts)
+		return nil, stacktrace.NewError("Package locator '%v' is invalid; its host '%v' isn't one of the allowed Git hosts '%v'", locator, gitHost, allowedGitHosts)
+	}
+	return &packageLocator{
+		repositoryLocator: strings.Join(locatorParts[:numLocatorRepositoryPathParts], locatorPathSeparator),
+		pathInRepository:  strings.Join(locatorParts[numLocatorRepositoryPathParts:], locatorPathSeparator),
+	}, nil
+}
+
+func (locator *packageLocator) String() string {
+	return path.Join(locator.repositoryLocator, locator.pathInRepository)
+}
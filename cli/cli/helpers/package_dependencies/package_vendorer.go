@@ -0,0 +1,316 @@
+package package_dependencies
+
+import (
+	"errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	vendoredDirPerms  = 0755
+	vendoredFilePerms = 0644
+
+	hiddenFilenamePrefix  = "."
+	repositoryRootDirpath = ""
+)
+
+// VendorDependencies downloads the remote packages the package imports, including the transitive ones, into its vendor
+// directory, from where the API container resolves them without cloning. The remote packages are the dependencies of
+// the kurtosis.yml files, at the commits of the lockfile, and the ones whose locators are passed as string literals to
+// import_module, read_file and upload_files. Returns the vendored version of each repository, which is also written
+// to the manifest of the vendor directory
+func VendorDependencies(
+	packageDirpath string,
+	kurtosisYaml *KurtosisYaml,
+	kurtosisLock *KurtosisLock,
+	cloneUrlPrefixPerGitHost map[string]string,
+	repositories GitRepositories,
+) (*KurtosisLock, error) {
+	rootPackageLocator, err := parsePackageLocator(kurtosisYaml.PackageName, cloneUrlPrefixPerGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the name '%v' of the package", kurtosisYaml.PackageName)
+	}
+	if len(kurtosisYaml.Dependencies) > 0 && kurtosisLock == nil {
+		return nil, stacktrace.NewError("The '%v' of the package declares dependencies but the package has no '%v'; run 'kurtosis package lock' first so that they get vendored at their locked commits", KurtosisYamlFilename, KurtosisLockFilename)
+	}
+	lockedDependencyPerRepository := map[string]*LockedDependency{}
+	if kurtosisLock != nil {
+		lockedDependencyPerRepository = kurtosisLock.Dependencies
+	}
+
+	vendorDirpath := path.Join(packageDirpath, VendorDirname)
+	if err = removeVendorDir(vendorDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the previous vendor directory '%v'", vendorDirpath)
+	}
+
+	vendorer := &packageVendorer{
+		rootPackageLocator:            rootPackageLocator,
+		lockedDependencyPerRepository: lockedDependencyPerRepository,
+		cloneUrlPrefixPerGitHost:      cloneUrlPrefixPerGitHost,
+		repositories:                  repositories,
+		refsPerRepository:             map[string]*RepositoryRefs{},
+		vendorDirpath:                 vendorDirpath,
+		vendoredVersionPerRepository:  map[string]resolvedVersion{},
+		vendoredPackageDirpaths:       map[string][]string{},
+		locatorsToVendor:              []*locatorToVendor{},
+	}
+	if err = vendorer.addDependencies(rootPackageLocator, kurtosisYaml.Dependencies); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the dependencies of the package")
+	}
+	if err = vendorer.addLocatorsOfPackageOnDisk(packageDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred finding the locators of the Starlark files of the package")
+	}
+	for len(vendorer.locatorsToVendor) > 0 {
+		locator := vendorer.locatorsToVendor[0]
+		vendorer.locatorsToVendor = vendorer.locatorsToVendor[1:]
+		if err = vendorer.vendor(locator); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred vendoring '%v' required by '%v'", locator.locator, locator.requiredBy)
+		}
+	}
+
+	vendorManifest := newKurtosisLock(vendorer.vendoredVersionPerRepository)
+	if len(vendorManifest.Dependencies) > 0 {
+		vendorManifestFilepath := path.Join(vendorDirpath, KurtosisVendorManifestFilename)
+		if err = writeGeneratedYamlFile(vendorManifestFilepath, kurtosisVendorManifestHeader, vendorManifest); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred writing the manifest '%v' of the vendor directory", vendorManifestFilepath)
+		}
+	}
+	return vendorManifest, nil
+}
+
+type locatorToVendor struct {
+	// A locator optionally followed by a version, e.g. 'github.com/author/repo/main.star@v1.0.0'
+	locator string
+
+	// Whether the locator is the one of a package rather than the one of a file inside a package
+	isPackageLocator bool
+
+	requiredBy string
+}
+
+type packageVendorer struct {
+	rootPackageLocator            *packageLocator
+	lockedDependencyPerRepository map[string]*LockedDependency
+	cloneUrlPrefixPerGitHost      map[string]string
+	repositories                  GitRepositories
+	refsPerRepository             map[string]*RepositoryRefs
+
+	vendorDirpath                string
+	vendoredVersionPerRepository map[string]resolvedVersion
+	vendoredPackageDirpaths      map[string][]string
+	locatorsToVendor             []*locatorToVendor
+}
+
+func (vendorer *packageVendorer) addDependencies(requiredBy *packageLocator, dependencies map[string]string) error {
+	for _, dependencyLocator := range getSortedKeys(dependencies) {
+		if strings.Contains(dependencyLocator, locatorVersionSeparator) {
+			return stacktrace.NewError("Dependency '%v' of package '%v' is invalid; the version of a dependency is declared as its value in the '%v' rather than in its locator", dependencyLocator, requiredBy, KurtosisYamlFilename)
+		}
+		// dependencies get vendored at their locked version rather than their constraint
+		vendorer.locatorsToVendor = append(vendorer.locatorsToVendor, &locatorToVendor{
+			locator:          dependencyLocator,
+			isPackageLocator: true,
+			requiredBy:       requiredBy.String(),
+		})
+	}
+	return nil
+}
+
+func (vendorer *packageVendorer) addLocatorsOfStarlarkFile(requiredBy string, filepath string, content []byte) error {
+	locators, err := getLocatorsOfStarlarkFile(filepath, content)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the locators of Starlark file '%v'", filepath)
+	}
+	for _, locator := range locators {
+		vendorer.locatorsToVendor = append(vendorer.locatorsToVendor, &locatorToVendor{
+			locator:          locator,
+			isPackageLocator: false,
+			requiredBy:       requiredBy,
+		})
+	}
+	return nil
+}
+
+// addLocatorsOfPackageOnDisk adds the locators of the Starlark files of the package, skipping its vendor directory
+// and its hidden directories
+func (vendorer *packageVendorer) addLocatorsOfPackageOnDisk(packageDirpath string) error {
+	return filepath.WalkDir(packageDirpath, func(filepathOnDisk string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred walking through '%v'", filepathOnDisk)
+		}
+		if entry.IsDir() {
+			if filepathOnDisk != packageDirpath && (filepathOnDisk == vendorer.vendorDirpath || strings.HasPrefix(entry.Name(), hiddenFilenamePrefix)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if path.Ext(filepathOnDisk) != starlarkFileExtension {
+			return nil
+		}
+		content, err := os.ReadFile(filepathOnDisk)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading Starlark file '%v'", filepathOnDisk)
+		}
+		return vendorer.addLocatorsOfStarlarkFile(filepathOnDisk, filepathOnDisk, content)
+	})
+}
+
+func (vendorer *packageVendorer) vendor(locatorAndVersion *locatorToVendor) error {
+	locatorStr, version, _ := strings.Cut(locatorAndVersion.locator, locatorVersionSeparator)
+	locator, err := parsePackageLocator(locatorStr, vendorer.cloneUrlPrefixPerGitHost)
+	if err != nil {
+		if locatorAndVersion.isPackageLocator {
+			return stacktrace.Propagate(err, "An error occurred parsing the locator of the dependency")
+		}
+		// the string literals of the Starlark files that aren't remote locators are left as they are
+		logrus.Debugf("Not vendoring '%v' of '%v', which isn't the locator of a remote package: %v", locatorStr, locatorAndVersion.requiredBy, err)
+		return nil
+	}
+	if locator.repositoryLocator == vendorer.rootPackageLocator.repositoryLocator {
+		return nil
+	}
+
+	vendoredVersion, err := vendorer.getVersionToVendor(locator.repositoryLocator, version)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the version of repository '%v' to vendor", locator.repositoryLocator)
+	}
+	packageDirpath := locator.pathInRepository
+	if !locatorAndVersion.isPackageLocator {
+		packageDirpath, err = vendorer.getPackageDirpath(locator, vendoredVersion.commit)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the package '%v' belongs to", locator)
+		}
+	}
+	if vendorer.isVendored(locator.repositoryLocator, packageDirpath) {
+		return nil
+	}
+	logrus.Debugf("Vendoring package '%v' at '%v'", path.Join(locator.repositoryLocator, packageDirpath), vendoredVersion.version)
+	return vendorer.vendorPackage(locator.repositoryLocator, packageDirpath, vendoredVersion.commit)
+}
+
+// getVersionToVendor returns the locked version of a repository for locators without version, and the given one
+// otherwise. A single version of each repository can be vendored
+func (vendorer *packageVendorer) getVersionToVendor(repositoryLocator string, version string) (*resolvedVersion, error) {
+	var versionToVendor *resolvedVersion
+	lockedDependency, isLocked := vendorer.lockedDependencyPerRepository[repositoryLocator]
+	if isLocked && (version == defaultBranchConstraint || version == lockedDependency.Version || version == lockedDependency.Commit) {
+		versionToVendor = &resolvedVersion{version: lockedDependency.Version, commit: lockedDependency.Commit}
+	} else {
+		refs, found := vendorer.refsPerRepository[repositoryLocator]
+		if !found {
+			var err error
+			refs, err = vendorer.repositories.GetRefs(repositoryLocator)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the refs of repository '%v'", repositoryLocator)
+			}
+			vendorer.refsPerRepository[repositoryLocator] = refs
+		}
+		resolvedRef, err := resolveRefConstraint(repositoryLocator, refs, &dependencyConstraint{constraint: version, requiredBy: ""})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving version '%v' of repository '%v'", version, repositoryLocator)
+		}
+		versionToVendor = resolvedRef
+	}
+
+	if vendoredVersion, found := vendorer.vendoredVersionPerRepository[repositoryLocator]; found && vendoredVersion.commit != versionToVendor.commit {
+		return nil, stacktrace.NewError("Repository '%v' is imported both at '%v' and at '%v', but a single version of each repository can be vendored; import it at the same version everywhere, or lock it and import it without version", repositoryLocator, vendoredVersion.version, versionToVendor.version)
+	}
+	vendorer.vendoredVersionPerRepository[repositoryLocator] = *versionToVendor
+	return versionToVendor, nil
+}
+
+// getPackageDirpath returns the directory of the package the file of the given locator belongs to, which is the
+// closest of its parent directories with a kurtosis.yml
+func (vendorer *packageVendorer) getPackageDirpath(fileLocator *packageLocator, commit string) (string, error) {
+	dirpath := path.Dir(fileLocator.pathInRepository)
+	for {
+		if dirpath == "." {
+			dirpath = repositoryRootDirpath
+		}
+		_, found, err := vendorer.repositories.GetFileContent(fileLocator.repositoryLocator, commit, path.Join(dirpath, KurtosisYamlFilename))
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred looking for a '%v' in '%v' of repository '%v'", KurtosisYamlFilename, dirpath, fileLocator.repositoryLocator)
+		}
+		if found {
+			return dirpath, nil
+		}
+		if dirpath == repositoryRootDirpath {
+			return "", stacktrace.NewError("No '%v' was found in the path of '%v' at commit '%v', so it doesn't belong to a Kurtosis package", KurtosisYamlFilename, fileLocator, commit)
+		}
+		dirpath = path.Dir(dirpath)
+	}
+}
+
+// isVendored returns whether the package, or one of the packages containing it, got vendored already
+func (vendorer *packageVendorer) isVendored(repositoryLocator string, packageDirpath string) bool {
+	for _, vendoredPackageDirpath := range vendorer.vendoredPackageDirpaths[repositoryLocator] {
+		if vendoredPackageDirpath == repositoryRootDirpath || packageDirpath == vendoredPackageDirpath || strings.HasPrefix(packageDirpath, vendoredPackageDirpath+locatorPathSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+// vendorPackage writes the files of the package to the vendor directory, adding the locators of its Starlark files
+// and the dependencies of its kurtosis.yml files to the ones to vendor
+func (vendorer *packageVendorer) vendorPackage(repositoryLocator string, packageDirpath string, commit string) error {
+	vendorer.vendoredPackageDirpaths[repositoryLocator] = append(vendorer.vendoredPackageDirpaths[repositoryLocator], packageDirpath)
+	filepaths, err := vendorer.repositories.GetFilepaths(repositoryLocator, commit, packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files of '%v' of repository '%v' at commit '%v'", packageDirpath, repositoryLocator, commit)
+	}
+	sort.Strings(filepaths)
+	for _, filepathInRepository := range filepaths {
+		content, found, err := vendorer.repositories.GetFileContent(repositoryLocator, commit, filepathInRepository)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading '%v' of repository '%v' at commit '%v'", filepathInRepository, repositoryLocator, commit)
+		}
+		if !found {
+			return stacktrace.NewError("File '%v' listed in repository '%v' at commit '%v' wasn't found", filepathInRepository, repositoryLocator, commit)
+		}
+		vendoredFilepath := path.Join(vendorer.vendorDirpath, repositoryLocator, filepathInRepository)
+		if err = os.MkdirAll(path.Dir(vendoredFilepath), vendoredDirPerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the directory of vendored file '%v'", vendoredFilepath)
+		}
+		if err = os.WriteFile(vendoredFilepath, content, vendoredFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing vendored file '%v'", vendoredFilepath)
+		}
+
+		fileLocator := path.Join(repositoryLocator, filepathInRepository)
+		switch {
+		case path.Base(filepathInRepository) == KurtosisYamlFilename:
+			kurtosisYaml, err := ParseKurtosisYaml(content)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred parsing '%v'", fileLocator)
+			}
+			if err = vendorer.addDependencies(&packageLocator{repositoryLocator: repositoryLocator, pathInRepository: path.Dir(filepathInRepository)}, kurtosisYaml.Dependencies); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding the dependencies of '%v'", fileLocator)
+			}
+		case path.Ext(filepathInRepository) == starlarkFileExtension:
+			if err = vendorer.addLocatorsOfStarlarkFile(fileLocator, filepathInRepository, content); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding the locators of '%v'", fileLocator)
+			}
+		}
+	}
+	return nil
+}
+
+// removeVendorDir removes the vendor directory of a previous vendoring, refusing to remove a directory it didn't create
+func removeVendorDir(vendorDirpath string) error {
+	if _, err := os.Stat(vendorDirpath); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if _, err := os.Stat(path.Join(vendorDirpath, KurtosisVendorManifestFilename)); err != nil {
+		return stacktrace.Propagate(err, "Directory '%v' doesn't have the '%v' manifest of a vendor directory; it has to be removed or renamed for the packages to be vendored there", vendorDirpath, KurtosisVendorManifestFilename)
+	}
+	if err := os.RemoveAll(vendorDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing '%v'", vendorDirpath)
+	}
+	return nil
+}
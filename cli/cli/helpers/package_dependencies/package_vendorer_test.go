@@ -0,0 +1,179 @@
+package package_dependencies
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+const (
+	testFilePerms = 0644
+	testDirPerms  = 0755
+)
+
+func newTestVendoredGitRepositories() *fakeGitRepositories {
+	repositories := newTestGitRepositories()
+	repositories.fileContentPerRepositoryCommitAndPath[libRepository+"@"+libCommitV1_2_0+"/main.star"] = "utils = import_module(\"" + utilsRepository + "/sub/helpers.star\")\n"
+	repositories.fileContentPerRepositoryCommitAndPath[libRepository+"@"+libCommitV1_2_0+"/README.md"] = "# lib\n"
+	repositories.fileContentPerRepositoryCommitAndPath[utilsRepository+"@"+utilsCommitV1_1+"/sub/helpers.star"] = "local = read_file(src = \"./local.txt\")\n"
+	repositories.fileContentPerRepositoryCommitAndPath[utilsRepository+"@"+utilsCommitV1_1+"/other/kurtosis.yml"] = kurtosisYamlNamePrefix + utilsRepository + "/other\n"
+	repositories.fileContentPerRepositoryCommitAndPath[utilsRepository+"@"+utilsCommitV1_1+"/other/data.txt"] = "data\n"
+	repositories.fileContentPerRepositoryCommitAndPath[utilsRepository+"@"+utilsCommitV1_1+"/unrelated/file.txt"] = "unrelated\n"
+	return repositories
+}
+
+func writeTestPackage(t *testing.T, fileContentPerFilepath map[string]string) string {
+	packageDirpath := t.TempDir()
+	for filepathInPackage, content := range fileContentPerFilepath {
+		filepathOnDisk := path.Join(packageDirpath, filepathInPackage)
+		require.NoError(t, os.MkdirAll(path.Dir(filepathOnDisk), testDirPerms))
+		require.NoError(t, os.WriteFile(filepathOnDisk, []byte(content), testFilePerms))
+	}
+	return packageDirpath
+}
+
+func getFilepathsOnDisk(t *testing.T, dirpath string) []string {
+	filepaths := []string{}
+	err := filepath.Walk(dirpath, func(filepathOnDisk string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		if !info.IsDir() {
+			relativeFilepath, err := filepath.Rel(dirpath, filepathOnDisk)
+			require.NoError(t, err)
+			filepaths = append(filepaths, filepath.ToSlash(relativeFilepath))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	sort.Strings(filepaths)
+	return filepaths
+}
+
+func TestVendorDependencies_VendorsTransitiveDependenciesAtLockedCommits(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{
+		"main.star":         "lib = import_module(\"" + libRepository + "/main.star\")\ndata = read_file(\"static/file.txt\")\n",
+		".git/ignored.star": "ignored = import_module(\"" + libRepository + "/main.star@v2.0.0\")\n",
+	})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{libRepository: "^1.0.0"}}
+	kurtosisLock := &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{
+			libRepository:   {Version: "v1.2.0", Commit: libCommitV1_2_0},
+			utilsRepository: {Version: "1.1.0", Commit: utilsCommitV1_1},
+		},
+	}
+
+	vendorManifest, err := VendorDependencies(packageDirpath, kurtosisYaml, kurtosisLock, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.NoError(t, err)
+	require.Equal(t, kurtosisLock, vendorManifest)
+
+	expectedFilepaths := []string{
+		"github.com/author/lib/README.md",
+		"github.com/author/lib/kurtosis.yml",
+		"github.com/author/lib/main.star",
+		KurtosisVendorManifestFilename,
+		"gitlab.mycompany.com/author/utils/other/data.txt",
+		"gitlab.mycompany.com/author/utils/other/kurtosis.yml",
+		"gitlab.mycompany.com/author/utils/sub/helpers.star",
+		"gitlab.mycompany.com/author/utils/sub/kurtosis.yml",
+	}
+	sort.Strings(expectedFilepaths)
+	vendorDirpath := path.Join(packageDirpath, VendorDirname)
+	require.Equal(t, expectedFilepaths, getFilepathsOnDisk(t, vendorDirpath))
+
+	vendoredContent, err := os.ReadFile(path.Join(vendorDirpath, "github.com/author/lib/README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "# lib\n", string(vendoredContent))
+
+	// vendoring again replaces the previous vendor directory
+	_, err = VendorDependencies(packageDirpath, kurtosisYaml, kurtosisLock, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.NoError(t, err)
+	require.Equal(t, expectedFilepaths, getFilepathsOnDisk(t, vendorDirpath))
+}
+
+func TestVendorDependencies_VendorsImportsWithoutDependencies(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{
+		"main.star": "lib = import_module(module_file = \"" + libRepository + "/main.star@v2.0.0\")\n",
+	})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{}}
+
+	vendorManifest, err := VendorDependencies(packageDirpath, kurtosisYaml, nil, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.NoError(t, err)
+	expectedVendorManifest := &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{
+			libRepository: {Version: "v2.0.0", Commit: libCommitV2_0_0},
+		},
+	}
+	require.Equal(t, expectedVendorManifest, vendorManifest)
+}
+
+func TestVendorDependencies_DoesNothingWithoutRemoteImports(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{
+		"main.star": "helpers = import_module(\"" + rootPackageName + "/helpers.star\")\n",
+	})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{}}
+
+	vendorManifest, err := VendorDependencies(packageDirpath, kurtosisYaml, nil, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.NoError(t, err)
+	require.Empty(t, vendorManifest.Dependencies)
+	_, err = os.Stat(path.Join(packageDirpath, VendorDirname))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestVendorDependencies_FailsOnDependenciesWithoutLockfile(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{libRepository: "^1.0.0"}}
+
+	_, err := VendorDependencies(packageDirpath, kurtosisYaml, nil, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "kurtosis package lock")
+}
+
+func TestVendorDependencies_FailsOnSeveralVersionsOfARepository(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{
+		"main.star": "lib = import_module(\"" + libRepository + "/main.star@v1.0.0\")\nother_lib = import_module(\"" + libRepository + "/main.star@v2.0.0\")\n",
+	})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{}}
+
+	_, err := VendorDependencies(packageDirpath, kurtosisYaml, nil, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a single version of each repository can be vendored")
+}
+
+func TestVendorDependencies_FailsOnVendorDirectoryNotCreatedByVendoring(t *testing.T) {
+	packageDirpath := writeTestPackage(t, map[string]string{
+		"vendor/file.txt": "not vendored\n",
+	})
+	kurtosisYaml := &KurtosisYaml{PackageName: rootPackageName, Dependencies: map[string]string{}}
+
+	_, err := VendorDependencies(packageDirpath, kurtosisYaml, nil, testCloneUrlPrefixPerGitHost, newTestVendoredGitRepositories())
+	require.Error(t, err)
+	_, err = os.Stat(path.Join(packageDirpath, "vendor/file.txt"))
+	require.NoError(t, err)
+}
+
+func TestGetLocatorsOfStarlarkFile(t *testing.T) {
+	content := `
+lib = import_module("github.com/author/lib/main.star")
+config = read_file(src = "github.com/author/lib/config.json@v1.0.0")
+
+def run(plan, path = "github.com/author/lib/computed.star"):
+    plan.upload_files("gitlab.mycompany.com/author/utils/static", name = "static")
+    plan.upload_files(name = "other", src = "./local")
+    plan.add_service(name = "github.com/not/a-locator")
+    read_file(path)
+`
+	locators, err := getLocatorsOfStarlarkFile("main.star", []byte(content))
+	require.NoError(t, err)
+	expectedLocators := []string{
+		"github.com/author/lib/main.star",
+		"github.com/author/lib/config.json@v1.0.0",
+		"gitlab.mycompany.com/author/utils/static",
+		"./local",
+	}
+	require.Equal(t, expectedLocators, locators)
+
+	_, err = getLocatorsOfStarlarkFile("main.star", []byte("def run(plan:\n"))
+	require.Error(t, err)
+}
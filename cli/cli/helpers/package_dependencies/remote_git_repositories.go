@@ -0,0 +1,226 @@
+package package_dependencies
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	GithubGitHost                  = "github.com"
+	GithubCloneUrlPrefix           = "https://github.com"
+	gitRepositorySuffix            = ".git"
+	remoteBranchRefPrefix          = "refs/remotes/origin/"
+	temporaryKnownHostsFilePattern = "kurtosis-known-hosts-*"
+	noSshPrivateKeyPassword        = ""
+)
+
+// RemoteGitRepositories clones the repositories of the dependencies in memory, the same way the API container clones
+// them when they get imported
+type RemoteGitRepositories struct {
+	cloneUrlPrefixPerGitHost map[string]string
+	credentialsPerGitHost    map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials
+
+	clonePerRepository map[string]*git.Repository
+}
+
+func NewRemoteGitRepositories(cloneUrlPrefixPerGitHost map[string]string, credentialsPerGitHost map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials) *RemoteGitRepositories {
+	return &RemoteGitRepositories{
+		cloneUrlPrefixPerGitHost: cloneUrlPrefixPerGitHost,
+		credentialsPerGitHost:    credentialsPerGitHost,
+		clonePerRepository:       map[string]*git.Repository{},
+	}
+}
+
+// NewRemoteGitRepositoriesFromKurtosisConfig returns the repositories of the Git hosts of the Kurtosis config, along
+// with the clone URL prefix of each of these hosts
+func NewRemoteGitRepositoriesFromKurtosisConfig(kurtosisConfig *resolved_config.KurtosisConfig) (*RemoteGitRepositories, map[string]string, error) {
+	cloneUrlPrefixPerGitHost := map[string]string{
+		GithubGitHost: GithubCloneUrlPrefix,
+	}
+	for gitHost, cloneUrlPrefix := range kurtosisConfig.GetCloneUrlPrefixPerCustomGitHost() {
+		cloneUrlPrefixPerGitHost[gitHost] = cloneUrlPrefix
+	}
+	credentialsPerGitHost, err := kurtosisConfig.LoadGitCredentialsPerGitHost()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred loading the credentials of the Git hosts")
+	}
+	return NewRemoteGitRepositories(cloneUrlPrefixPerGitHost, credentialsPerGitHost), cloneUrlPrefixPerGitHost, nil
+}
+
+func (repositories *RemoteGitRepositories) GetRefs(repositoryLocator string) (*RepositoryRefs, error) {
+	clone, err := repositories.getClone(repositoryLocator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning repository '%v'", repositoryLocator)
+	}
+	head, err := clone.Head()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the default branch of repository '%v'", repositoryLocator)
+	}
+	refs := &RepositoryRefs{
+		CommitPerTag:    map[string]string{},
+		CommitPerBranch: map[string]string{},
+		DefaultBranch:   head.Name().Short(),
+	}
+
+	references, err := clone.References()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the references of repository '%v'", repositoryLocator)
+	}
+	err = references.ForEach(func(reference *plumbing.Reference) error {
+		if reference.Type() != plumbing.HashReference {
+			return nil
+		}
+		switch {
+		case reference.Name().IsTag():
+			// annotated tags point to a tag object rather than to a commit
+			commit := reference.Hash()
+			tagObject, err := clone.TagObject(commit)
+			if err == nil {
+				commit = tagObject.Target
+			} else if err != plumbing.ErrObjectNotFound {
+				return stacktrace.Propagate(err, "An error occurred getting the object of tag '%v'", reference.Name().Short())
+			}
+			refs.CommitPerTag[reference.Name().Short()] = commit.String()
+		case strings.HasPrefix(reference.Name().String(), remoteBranchRefPrefix):
+			refs.CommitPerBranch[strings.TrimPrefix(reference.Name().String(), remoteBranchRefPrefix)] = reference.Hash().String()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred iterating through the references of repository '%v'", repositoryLocator)
+	}
+	return refs, nil
+}
+
+func (repositories *RemoteGitRepositories) GetFileContent(repositoryLocator string, commit string, filepath string) ([]byte, bool, error) {
+	clone, err := repositories.getClone(repositoryLocator)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred cloning repository '%v'", repositoryLocator)
+	}
+	commitObject, err := clone.CommitObject(plumbing.NewHash(commit))
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "Commit '%v' wasn't found in repository '%v'", commit, repositoryLocator)
+	}
+	file, err := commitObject.File(filepath)
+	if err == object.ErrFileNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting file '%v' of commit '%v'", filepath, commit)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred reading file '%v' of commit '%v'", filepath, commit)
+	}
+	return []byte(content), true, nil
+}
+
+func (repositories *RemoteGitRepositories) GetFilepaths(repositoryLocator string, commit string, dirpath string) ([]string, error) {
+	clone, err := repositories.getClone(repositoryLocator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning repository '%v'", repositoryLocator)
+	}
+	commitObject, err := clone.CommitObject(plumbing.NewHash(commit))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Commit '%v' wasn't found in repository '%v'", commit, repositoryLocator)
+	}
+	files, err := commitObject.Files()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files of commit '%v'", commit)
+	}
+	filepaths := []string{}
+	err = files.ForEach(func(file *object.File) error {
+		if dirpath == "" || strings.HasPrefix(file.Name, dirpath+locatorPathSeparator) {
+			filepaths = append(filepaths, file.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred iterating through the files of commit '%v'", commit)
+	}
+	return filepaths, nil
+}
+
+func (repositories *RemoteGitRepositories) getClone(repositoryLocator string) (*git.Repository, error) {
+	if clone, found := repositories.clonePerRepository[repositoryLocator]; found {
+		return clone, nil
+	}
+	gitHost, repositoryPath, _ := strings.Cut(repositoryLocator, locatorPathSeparator)
+	cloneUrlPrefix, found := repositories.cloneUrlPrefixPerGitHost[gitHost]
+	if !found {
+		return nil, stacktrace.NewError("Git host '%v' of repository '%v' isn't one of the allowed Git hosts", gitHost, repositoryLocator)
+	}
+	cloneUrl := strings.TrimSuffix(cloneUrlPrefix, locatorPathSeparator) + locatorPathSeparator + repositoryPath + gitRepositorySuffix
+
+	var auth transport.AuthMethod
+	if credentials, found := repositories.credentialsPerGitHost[gitHost]; found {
+		authMethod, err := getAuthMethod(credentials)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the authentication of the credentials of Git host '%v'", gitHost)
+		}
+		auth = authMethod
+	}
+
+	// clones are kept in memory without any worktree, so nothing gets written to the checkout of the package
+	clone, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+		URL:               cloneUrl,
+		Auth:              auth,
+		RemoteName:        "",
+		ReferenceName:     "",
+		SingleBranch:      false,
+		NoCheckout:        false,
+		Depth:             0,
+		RecurseSubmodules: 0,
+		Progress:          io.Discard,
+		Tags:              git.AllTags,
+		InsecureSkipTLS:   false,
+		CABundle:          nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning repository '%v' from '%v'; if it's private, its Git host needs credentials in the 'git-hosts' of the Kurtosis config", repositoryLocator, cloneUrl)
+	}
+	repositories.clonePerRepository[repositoryLocator] = clone
+	return clone, nil
+}
+
+// getAuthMethod returns the go-git authentication of the credentials, the same way the API container builds it
+func getAuthMethod(credentials *kurtosis_engine_rpc_api_bindings.GitCredentials) (transport.AuthMethod, error) {
+	if credentials.GetSshPrivateKey() == "" {
+		return &http.BasicAuth{
+			Username: credentials.GetUsername(),
+			Password: credentials.GetToken(),
+		}, nil
+	}
+
+	publicKeys, err := ssh.NewPublicKeys(credentials.GetUsername(), []byte(credentials.GetSshPrivateKey()), noSshPrivateKeyPassword)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the SSH private key; only private keys that aren't protected by a password are supported")
+	}
+	knownHostsFile, err := os.CreateTemp("", temporaryKnownHostsFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file to write the SSH known hosts to")
+	}
+	defer os.Remove(knownHostsFile.Name())
+	_, err = knownHostsFile.WriteString(credentials.GetSshKnownHosts())
+	closeErr := knownHostsFile.Close()
+	if err != nil || closeErr != nil {
+		return nil, stacktrace.NewError("An error occurred writing the SSH known hosts to temporary file '%v'", knownHostsFile.Name())
+	}
+	hostKeyCallback, err := ssh.NewKnownHostsCallback(knownHostsFile.Name())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the SSH known hosts")
+	}
+	publicKeys.HostKeyCallback = hostKeyCallback
+	return publicKeys, nil
+}
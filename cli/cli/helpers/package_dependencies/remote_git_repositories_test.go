@@ -0,0 +1,62 @@
+package package_dependencies
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+var noGitCredentials = map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials{}
+
+func TestRemoteGitRepositories_ResolvesRefsAndReadsFilesOfFileMirror(t *testing.T) {
+	mirrorDirpath := t.TempDir()
+	repositoryDirpath := path.Join(mirrorDirpath, "author", "repo.git")
+	repository, err := git.PlainInit(repositoryDirpath, false)
+	require.NoError(t, err)
+	workTree, err := repository.Worktree()
+	require.NoError(t, err)
+	signature := &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}
+
+	require.NoError(t, os.WriteFile(path.Join(repositoryDirpath, KurtosisYamlFilename), []byte("name: git.mycompany.com/author/repo\n"), 0644))
+	_, err = workTree.Add(KurtosisYamlFilename)
+	require.NoError(t, err)
+	firstCommit, err := workTree.Commit("First commit", &git.CommitOptions{All: true, Author: signature, Committer: nil, Parents: nil, SignKey: nil})
+	require.NoError(t, err)
+	_, err = repository.CreateTag("v1.0.0", firstCommit, &git.CreateTagOptions{Tagger: signature, Message: "Annotated tag", SignKey: nil})
+	require.NoError(t, err)
+	_, err = repository.CreateTag("v0.1.0", firstCommit, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path.Join(repositoryDirpath, KurtosisYamlFilename), []byte("name: git.mycompany.com/author/repo\ndependencies: {}\n"), 0644))
+	secondCommit, err := workTree.Commit("Second commit", &git.CommitOptions{All: true, Author: signature, Committer: nil, Parents: nil, SignKey: nil})
+	require.NoError(t, err)
+	head, err := repository.Head()
+	require.NoError(t, err)
+
+	repositories := NewRemoteGitRepositories(map[string]string{"git.mycompany.com": "file://" + mirrorDirpath}, noGitCredentials)
+	refs, err := repositories.GetRefs("git.mycompany.com/author/repo")
+	require.NoError(t, err, "This test depends on the git binary being installed")
+	expectedRefs := &RepositoryRefs{
+		CommitPerTag: map[string]string{
+			"v1.0.0": firstCommit.String(),
+			"v0.1.0": firstCommit.String(),
+		},
+		CommitPerBranch: map[string]string{head.Name().Short(): secondCommit.String()},
+		DefaultBranch:   head.Name().Short(),
+	}
+	require.Equal(t, expectedRefs, refs)
+
+	content, found, err := repositories.GetFileContent("git.mycompany.com/author/repo", firstCommit.String(), KurtosisYamlFilename)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "name: git.mycompany.com/author/repo\n", string(content))
+
+	_, found, err = repositories.GetFileContent("git.mycompany.com/author/repo", secondCommit.String(), "main.star")
+	require.NoError(t, err)
+	require.False(t, found)
+}
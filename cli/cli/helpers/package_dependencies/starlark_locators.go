@@ -0,0 +1,64 @@
+package package_dependencies
+
+import (
+	"github.com/bazelbuild/buildtools/build"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	starlarkFileExtension = ".star"
+	firstPositionalArgIdx = 0
+)
+
+// The builtins taking a locator, mapped to the name of the argument it's passed as
+var locatorArgNamePerBuiltinName = map[string]string{
+	"import_module": "module_file",
+	"read_file":     "src",
+	"upload_files":  "src",
+}
+
+// getLocatorsOfStarlarkFile returns the locators the given Starlark file passes as string literals to the builtins
+// taking one; locators computed at runtime can't be found
+func getLocatorsOfStarlarkFile(filepath string, content []byte) ([]string, error) {
+	parsedFile, err := build.ParseDefault(filepath, content)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing Starlark file '%v'", filepath)
+	}
+	locators := []string{}
+	build.Walk(parsedFile, func(expr build.Expr, _ []build.Expr) {
+		callExpr, ok := expr.(*build.CallExpr)
+		if !ok {
+			return
+		}
+		var builtinName string
+		switch callee := callExpr.X.(type) {
+		case *build.Ident:
+			builtinName = callee.Name
+		case *build.DotExpr:
+			// e.g. 'plan.upload_files'
+			builtinName = callee.Name
+		default:
+			return
+		}
+		locatorArgName, found := locatorArgNamePerBuiltinName[builtinName]
+		if !found {
+			return
+		}
+		for argIdx, arg := range callExpr.List {
+			argValue := arg
+			if assignExpr, ok := arg.(*build.AssignExpr); ok {
+				argNameIdent, ok := assignExpr.LHS.(*build.Ident)
+				if !ok || argNameIdent.Name != locatorArgName {
+					continue
+				}
+				argValue = assignExpr.RHS
+			} else if argIdx != firstPositionalArgIdx {
+				continue
+			}
+			if stringExpr, ok := argValue.(*build.StringExpr); ok {
+				locators = append(locators, stringExpr.Value)
+			}
+		}
+	})
+	return locators, nil
+}
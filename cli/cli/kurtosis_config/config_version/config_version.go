@@ -8,4 +8,5 @@ const (
 	ConfigVersion_v0 ConfigVersion = iota
 	ConfigVersion_v1
 	ConfigVersion_v2	// Fixed a typo in Kubernetes config, `enclave-size-in-Megabytes` -> `enclave-size-in-megabytes`
+	ConfigVersion_v3	// Added the Git hosts packages can be fetched from
 )
@@ -0,0 +1,28 @@
+package v3
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type GitHostConfigV3 struct {
+	// The prefix of the URLs the repositories of the host get cloned from, e.g. 'https://gitlab.mycompany.com' or
+	// 'file:///srv/git-mirrors'. Defaults to 'https://' followed by the host
+	CloneUrlPrefix *string `yaml:"clone-url-prefix,omitempty"`
+
+	// The username the credentials below get sent with. Defaults to 'git'
+	Username *string `yaml:"username,omitempty"`
+
+	// A token, like a personal access token, the private repositories of the host get cloned over HTTPS with
+	Token *string `yaml:"token,omitempty"`
+
+	// The path to an SSH private key without password, like a deploy key, the private repositories of the host get
+	// cloned over SSH with, along with the path to the known hosts the key of the host gets verified against, which
+	// defaults to '~/.ssh/known_hosts'. Both files are read when the engine gets started
+	SshPrivateKeyFilepath *string `yaml:"ssh-private-key-filepath,omitempty"`
+	SshKnownHostsFilepath *string `yaml:"ssh-known-hosts-filepath,omitempty"`
+}
@@ -0,0 +1,161 @@
+package resolved_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/stacktrace"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	defaultCloneUrlScheme = "https"
+	tokenCloneUrlScheme   = "https"
+	sshCloneUrlScheme     = "ssh"
+
+	defaultGitCredentialsUsername = "git"
+	defaultSshKnownHostsFilepath  = "~/.ssh/known_hosts"
+	homeDirFilepathPrefix         = "~/"
+)
+
+// The API container clones with go-git, which also needs the git binary for the 'file' scheme
+var allowedCloneUrlSchemes = map[string]bool{
+	"https":           true,
+	"http":            true,
+	"file":            true,
+	sshCloneUrlScheme: true,
+}
+
+// GitCredentialsConfig are the credentials the private repositories of a Git host get cloned with. The SSH files only
+// get read when loading the credentials, so that a missing file doesn't break the commands not starting an engine
+type GitCredentialsConfig struct {
+	username string
+	token    string
+
+	sshPrivateKeyFilepath string
+	sshKnownHostsFilepath string
+}
+
+// LoadGitCredentials returns the credentials, along with the content of their SSH files, to pass to the engine when
+// creating an enclave
+func (credentialsConfig *GitCredentialsConfig) LoadGitCredentials() (*kurtosis_engine_rpc_api_bindings.GitCredentials, error) {
+	credentials := &kurtosis_engine_rpc_api_bindings.GitCredentials{
+		Username:      credentialsConfig.username,
+		Token:         credentialsConfig.token,
+		SshPrivateKey: "",
+		SshKnownHosts: "",
+	}
+	if credentialsConfig.sshPrivateKeyFilepath == "" {
+		return credentials, nil
+	}
+	sshPrivateKey, err := readFileExpandingHomeDir(credentialsConfig.sshPrivateKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the SSH private key file '%v'", credentialsConfig.sshPrivateKeyFilepath)
+	}
+	sshKnownHosts, err := readFileExpandingHomeDir(credentialsConfig.sshKnownHostsFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the SSH known hosts file '%v'", credentialsConfig.sshKnownHostsFilepath)
+	}
+	credentials.SshPrivateKey = sshPrivateKey
+	credentials.SshKnownHosts = sshKnownHosts
+	return credentials, nil
+}
+
+// getCloneUrlPrefixPerCustomGitHost validates the Git hosts of the overrides and maps each to the prefix of the URLs
+// its repositories get cloned from, defaulting to 'https://' followed by the host
+func getCloneUrlPrefixPerCustomGitHost(gitHostOverrides map[string]*v3.GitHostConfigV3) (map[string]string, error) {
+	result := map[string]string{}
+	for gitHost, gitHostOverride := range gitHostOverrides {
+		defaultCloneUrlPrefix := defaultCloneUrlScheme + "://" + gitHost
+		parsedDefaultCloneUrlPrefix, err := url.Parse(defaultCloneUrlPrefix)
+		if err != nil || gitHost == "" || parsedDefaultCloneUrlPrefix.Host != gitHost {
+			return nil, stacktrace.NewError("Git host '%v' is invalid; it should be a host like 'gitlab.mycompany.com', optionally followed by a port, without any scheme or path", gitHost)
+		}
+
+		cloneUrlPrefix := defaultCloneUrlPrefix
+		if gitHostOverride != nil && gitHostOverride.CloneUrlPrefix != nil {
+			cloneUrlPrefix = *gitHostOverride.CloneUrlPrefix
+		}
+		parsedCloneUrlPrefix, err := url.Parse(cloneUrlPrefix)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the clone URL prefix '%v' of Git host '%v'", cloneUrlPrefix, gitHost)
+		}
+		if _, found := allowedCloneUrlSchemes[parsedCloneUrlPrefix.Scheme]; !found {
+			return nil, stacktrace.NewError("The clone URL prefix '%v' of Git host '%v' has scheme '%v', but only the schemes '%v' are supported", cloneUrlPrefix, gitHost, parsedCloneUrlPrefix.Scheme, []string{"https", "http", "file", sshCloneUrlScheme})
+		}
+		result[gitHost] = cloneUrlPrefix
+	}
+	return result, nil
+}
+
+// getGitCredentialsConfigPerGitHost validates the credentials of the Git hosts of the overrides: tokens are only sent
+// over HTTPS and SSH private keys over SSH, which needs one
+func getGitCredentialsConfigPerGitHost(gitHostOverrides map[string]*v3.GitHostConfigV3, cloneUrlPrefixPerCustomGitHost map[string]string) (map[string]*GitCredentialsConfig, error) {
+	result := map[string]*GitCredentialsConfig{}
+	for gitHost, cloneUrlPrefix := range cloneUrlPrefixPerCustomGitHost {
+		parsedCloneUrlPrefix, err := url.Parse(cloneUrlPrefix)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the clone URL prefix '%v' of Git host '%v'", cloneUrlPrefix, gitHost)
+		}
+		gitHostOverride := gitHostOverrides[gitHost]
+		if gitHostOverride == nil || (gitHostOverride.Token == nil && gitHostOverride.SshPrivateKeyFilepath == nil) {
+			if gitHostOverride != nil && (gitHostOverride.Username != nil || gitHostOverride.SshKnownHostsFilepath != nil) {
+				return nil, stacktrace.NewError("Git host '%v' has a username or SSH known hosts but neither a token nor an SSH private key", gitHost)
+			}
+			if parsedCloneUrlPrefix.Scheme == sshCloneUrlScheme {
+				return nil, stacktrace.NewError("Git host '%v' gets cloned over SSH, so it needs an SSH private key", gitHost)
+			}
+			continue
+		}
+		if gitHostOverride.Token != nil && gitHostOverride.SshPrivateKeyFilepath != nil {
+			return nil, stacktrace.NewError("Git host '%v' has both a token and an SSH private key, but only one of them can be used", gitHost)
+		}
+
+		credentialsConfig := &GitCredentialsConfig{
+			username:              defaultGitCredentialsUsername,
+			token:                 "",
+			sshPrivateKeyFilepath: "",
+			sshKnownHostsFilepath: "",
+		}
+		if gitHostOverride.Username != nil {
+			credentialsConfig.username = *gitHostOverride.Username
+		}
+		if gitHostOverride.Token != nil {
+			if parsedCloneUrlPrefix.Scheme != tokenCloneUrlScheme {
+				return nil, stacktrace.NewError("Git host '%v' has a token, but tokens are only sent over '%v' while its clone URL prefix is '%v'", gitHost, tokenCloneUrlScheme, cloneUrlPrefix)
+			}
+			if *gitHostOverride.Token == "" {
+				return nil, stacktrace.NewError("The token of Git host '%v' is empty", gitHost)
+			}
+			credentialsConfig.token = *gitHostOverride.Token
+		} else {
+			if parsedCloneUrlPrefix.Scheme != sshCloneUrlScheme {
+				return nil, stacktrace.NewError("Git host '%v' has an SSH private key, but its clone URL prefix '%v' isn't an SSH one like '%v://git@%v'", gitHost, cloneUrlPrefix, sshCloneUrlScheme, gitHost)
+			}
+			credentialsConfig.sshPrivateKeyFilepath = *gitHostOverride.SshPrivateKeyFilepath
+			credentialsConfig.sshKnownHostsFilepath = defaultSshKnownHostsFilepath
+			if gitHostOverride.SshKnownHostsFilepath != nil {
+				credentialsConfig.sshKnownHostsFilepath = *gitHostOverride.SshKnownHostsFilepath
+			}
+		}
+		result[gitHost] = credentialsConfig
+	}
+	return result, nil
+}
+
+func readFileExpandingHomeDir(filepath string) (string, error) {
+	if strings.HasPrefix(filepath, homeDirFilepathPrefix) {
+		homeDirpath, err := os.UserHomeDir()
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting the home directory of the user")
+		}
+		filepath = path.Join(homeDirpath, strings.TrimPrefix(filepath, homeDirFilepathPrefix))
+	}
+	content, err := os.ReadFile(filepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading file '%v'", filepath)
+	}
+	return string(content), nil
+}
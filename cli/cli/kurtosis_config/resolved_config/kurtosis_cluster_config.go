@@ -2,8 +2,10 @@ package resolved_config
 
 import (
 	"context"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	kubernetes_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/backend_creator"
+	podman_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/podman/podman_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
@@ -14,7 +16,7 @@ const (
 	defaultKubernetesEnclaveDataVolumeSizeInMegabytes = uint(1024)
 )
 
-// Nil because the CLI will never operate in API container mode
+// Nil because the CLI will never operate in API container mode; this is used by the Podman backend too
 var dockerBackendApiContainerModeArgs *backend_creator.APIContainerModeArgs = nil
 
 type kurtosisBackendSupplier func(ctx context.Context) (backend_interface.KurtosisBackend, error)
@@ -25,7 +27,7 @@ type KurtosisClusterConfig struct {
 	clusterType                 KurtosisClusterType
 }
 
-func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v2.KurtosisClusterConfigV2) (*KurtosisClusterConfig, error) {
+func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v3.KurtosisClusterConfigV3) (*KurtosisClusterConfig, error) {
 	if overrides.Type == nil {
 		return nil, stacktrace.NewError("Kurtosis cluster must have a defined type")
 	}
@@ -74,7 +76,7 @@ func (clusterConfig *KurtosisClusterConfig) GetClusterType() KurtosisClusterType
 //	Private Helpers
 //
 // ====================================================================================================
-func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v2.KubernetesClusterConfigV2) (
+func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v3.KubernetesClusterConfigV3) (
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	error,
@@ -133,16 +135,7 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 		}
 
 		backendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
-			pluginPath := backend_interface.GetPluginPathForCLI(backend_interface.KubernetesPluginName)
-			plugin, err := backend_interface.OpenBackendPlugin(pluginPath)
-			if err != nil {
-				return nil, stacktrace.Propagate(
-					err,
-					"An error occurred loading a Kurtosis Kubernetes backend plugin on path '%s'",
-					pluginPath,
-				)
-			}
-			backend, err := plugin.GetCLIBackend(ctx)
+			backend, err := kubernetes_backend_creator.GetCLIBackend(ctx)
 			if err != nil {
 				return nil, stacktrace.Propagate(
 					err,
@@ -156,6 +149,30 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 		}
 
 		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb)
+	case KurtosisClusterType_Podman:
+		if kubernetesConfig != nil {
+			return nil, nil, stacktrace.NewError(
+				"Cluster '%v' defines cluster config, but config must not be provided when cluster type is '%v'",
+				clusterId,
+				clusterType.String(),
+			)
+		}
+		// The socket is looked up here rather than when getting the backend because the engine needs to know it to
+		// bind-mount it. As all clusters get resolved, not finding it mustn't fail unless this cluster is actually used;
+		// the engine config is never used in that case as no backend is available to start the engine with
+		podmanSocketFilepath, podmanSocketErr := podman_backend_creator.GetLocalPodmanSocketFilepath()
+		backendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
+			if podmanSocketErr != nil {
+				return nil, stacktrace.Propagate(podmanSocketErr, "An error occurred getting the filepath of the local Podman socket")
+			}
+			backend, err := podman_backend_creator.GetLocalPodmanKurtosisBackend(ctx, dockerBackendApiContainerModeArgs)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the Podman Kurtosis backend")
+			}
+			return backend, nil
+		}
+
+		engineConfigSupplier = engine_server_launcher.NewPodmanKurtosisBackendConfigSupplier(podmanSocketFilepath)
 	default:
 		// This should never happen because we enforce this via unit tests
 		return nil, nil, stacktrace.NewError(
@@ -1,8 +1,9 @@
 package resolved_config
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -10,6 +11,8 @@ const (
 	// public so it can be used as default in CLI engine manager
 	DefaultDockerClusterName = "docker"
 
+	defaultPodmanClusterName = "podman"
+
 	defaultMinikubeClusterName = "minikube"
 
 	defaultMinikubeClusterKubernetesClusterNameStr = "minikube"
@@ -31,10 +34,17 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v2.KurtosisConfigV2
+	overrides *v3.KurtosisConfigV3
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
+
+	// The Git hosts other than GitHub that packages can be fetched from, mapped to the prefix of the URLs their
+	// repositories get cloned from
+	cloneUrlPrefixPerCustomGitHost map[string]string
+
+	// The credentials the private repositories of the Git hosts get cloned with
+	gitCredentialsConfigPerGitHost map[string]*GitCredentialsConfig
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -47,9 +57,11 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 	}
 
 	config := &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: false,
-		clusters:          nil,
+		overrides:                      overrides,
+		shouldSendMetrics:              false,
+		clusters:                       nil,
+		cloneUrlPrefixPerCustomGitHost: nil,
+		gitCredentialsConfigPerGitHost: nil,
 	}
 
 	// Get latest config version
@@ -88,19 +100,31 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		allClusterConfigs[clusterId] = clusterConfig
 	}
 
+	cloneUrlPrefixPerCustomGitHost, err := getCloneUrlPrefixPerCustomGitHost(overrides.GitHosts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the Git hosts")
+	}
+	gitCredentialsConfigPerGitHost, err := getGitCredentialsConfigPerGitHost(overrides.GitHosts, cloneUrlPrefixPerCustomGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the credentials of the Git hosts")
+	}
+
 	return &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          allClusterConfigs,
+		overrides:                      overrides,
+		shouldSendMetrics:              shouldSendMetrics,
+		clusters:                       allClusterConfigs,
+		cloneUrlPrefixPerCustomGitHost: cloneUrlPrefixPerCustomGitHost,
+		gitCredentialsConfigPerGitHost: gitCredentialsConfigPerGitHost,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v2.KurtosisConfigV2{
+	overrides := &v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
+		GitHosts:          nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -111,9 +135,11 @@ func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfi
 
 func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, shouldSendMetrics bool) *KurtosisConfig {
 	newConfig := &KurtosisConfig{
-		overrides:         config.overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          config.clusters,
+		overrides:                      config.overrides,
+		shouldSendMetrics:              shouldSendMetrics,
+		clusters:                       config.clusters,
+		cloneUrlPrefixPerCustomGitHost: config.cloneUrlPrefixPerCustomGitHost,
+		gitCredentialsConfigPerGitHost: config.gitCredentialsConfigPerGitHost,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
@@ -127,7 +153,31 @@ func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*Kurtosis
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
+// GetCloneUrlPrefixPerCustomGitHost returns the Git hosts other than GitHub that packages can be fetched from, mapped to
+// the prefix of the URLs their repositories get cloned from
+func (kurtosisConfig *KurtosisConfig) GetCloneUrlPrefixPerCustomGitHost() map[string]string {
+	return kurtosisConfig.cloneUrlPrefixPerCustomGitHost
+}
+
+// GetGitCredentialsConfigPerGitHost returns the credentials the private repositories of the Git hosts get cloned with
+func (kurtosisConfig *KurtosisConfig) GetGitCredentialsConfigPerGitHost() map[string]*GitCredentialsConfig {
+	return kurtosisConfig.gitCredentialsConfigPerGitHost
+}
+
+// LoadGitCredentialsPerGitHost returns the credentials of the Git hosts, along with the content of their SSH files
+func (kurtosisConfig *KurtosisConfig) LoadGitCredentialsPerGitHost() (map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials, error) {
+	gitCredentialsPerGitHost := map[string]*kurtosis_engine_rpc_api_bindings.GitCredentials{}
+	for gitHost, gitCredentialsConfig := range kurtosisConfig.gitCredentialsConfigPerGitHost {
+		gitCredentials, err := gitCredentialsConfig.LoadGitCredentials()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the credentials of Git host '%v'", gitHost)
+		}
+		gitCredentialsPerGitHost[gitHost] = gitCredentials
+	}
+	return gitCredentialsPerGitHost, nil
+}
+
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v3.KurtosisConfigV3 {
 	return kurtosisConfig.overrides
 }
 
@@ -137,29 +187,34 @@ func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v2.KurtosisConfigV2, error) {
-	castedOverrides, ok := uncastedOverrides.(*v2.KurtosisConfigV2)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v3.KurtosisConfigV3, error) {
+	castedOverrides, ok := uncastedOverrides.(*v3.KurtosisConfigV3)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v2.KurtosisClusterConfigV2 {
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v3.KurtosisClusterConfigV3 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
+	podmanClusterType := KurtosisClusterType_Podman.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
 	minikubeStorageClass := defaultMinikubeStorageClass
 	minikubeEnclaveDataVolSizeMB := defaultMinikubeEnclaveDataVolumeMB
 
-	result := map[string]*v2.KurtosisClusterConfigV2{
+	result := map[string]*v3.KurtosisClusterConfigV3{
 		DefaultDockerClusterName: {
 			Type:   &dockerClusterType,
 			Config: nil, // Must be nil for Docker
 		},
+		defaultPodmanClusterName: {
+			Type:   &podmanClusterType,
+			Config: nil, // Must be nil for Podman
+		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v2.KubernetesClusterConfigV2{
+			Config: &v3.KubernetesClusterConfigV3{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
@@ -3,8 +3,10 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
 	"github.com/stretchr/testify/require"
+	"os"
+	"path"
 	"sort"
 	"testing"
 )
@@ -50,10 +52,11 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
+	_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
+		GitHosts:          nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -62,10 +65,11 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
+		GitHosts:          nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -87,3 +91,122 @@ func TestNewKurtosisConfigOverridesAreLatestVersion(t *testing.T) {
 	// check that overrides are actually the latest version
 	require.Equal(t, latestVersion, overrides.ConfigVersion.String())
 }
+
+func TestNewKurtosisConfigWithGitHosts(t *testing.T) {
+	shouldSendMetrics := true
+	mirrorCloneUrlPrefix := "file:///srv/git-mirrors"
+	config, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
+		ConfigVersion:     0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		GitHosts: map[string]*v3.GitHostConfigV3{
+			"gitlab.mycompany.com": {CloneUrlPrefix: nil, Username: nil, Token: nil, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil},
+			"gitea.internal:3000":  nil,
+			"mirror.internal":      {CloneUrlPrefix: &mirrorCloneUrlPrefix, Username: nil, Token: nil, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil},
+		},
+	})
+	require.NoError(t, err)
+
+	expectedCloneUrlPrefixPerCustomGitHost := map[string]string{
+		"gitlab.mycompany.com": "https://gitlab.mycompany.com",
+		"gitea.internal:3000":  "https://gitea.internal:3000",
+		"mirror.internal":      "file:///srv/git-mirrors",
+	}
+	require.Equal(t, expectedCloneUrlPrefixPerCustomGitHost, config.GetCloneUrlPrefixPerCustomGitHost())
+}
+
+func TestNewKurtosisConfigWithInvalidGitHosts(t *testing.T) {
+	shouldSendMetrics := true
+	ftpCloneUrlPrefix := "ftp://gitlab.mycompany.com"
+	invalidGitHostsOverrides := []map[string]*v3.GitHostConfigV3{
+		{"https://gitlab.mycompany.com": nil},
+		{"gitlab.mycompany.com/team": nil},
+		{"": nil},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: &ftpCloneUrlPrefix, Username: nil, Token: nil, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+	}
+	for _, gitHostsOverrides := range invalidGitHostsOverrides {
+		_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
+			ConfigVersion:     0,
+			ShouldSendMetrics: &shouldSendMetrics,
+			KurtosisClusters:  nil,
+			GitHosts:          gitHostsOverrides,
+		})
+		require.Error(t, err, "Expected Git hosts '%+v' to be invalid", gitHostsOverrides)
+	}
+}
+
+func TestNewKurtosisConfigWithGitCredentials(t *testing.T) {
+	shouldSendMetrics := true
+	token := "my-token"
+	sshCloneUrlPrefix := "ssh://git@gitea.internal"
+	sshPrivateKeyFilepath, sshKnownHostsFilepath := createSshFiles(t)
+	config, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
+		ConfigVersion:     0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		GitHosts: map[string]*v3.GitHostConfigV3{
+			"gitlab.mycompany.com": {CloneUrlPrefix: nil, Username: nil, Token: &token, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil},
+			"gitea.internal":       {CloneUrlPrefix: &sshCloneUrlPrefix, Username: nil, Token: nil, SshPrivateKeyFilepath: &sshPrivateKeyFilepath, SshKnownHostsFilepath: &sshKnownHostsFilepath},
+			"mirror.internal":      nil,
+		},
+	})
+	require.NoError(t, err)
+
+	gitCredentialsConfigPerGitHost := config.GetGitCredentialsConfigPerGitHost()
+	require.Len(t, gitCredentialsConfigPerGitHost, 2)
+
+	gitCredentialsPerGitHost, err := config.LoadGitCredentialsPerGitHost()
+	require.NoError(t, err)
+	require.Len(t, gitCredentialsPerGitHost, 2)
+
+	tokenCredentials := gitCredentialsPerGitHost["gitlab.mycompany.com"]
+	require.Equal(t, "git", tokenCredentials.GetUsername())
+	require.Equal(t, token, tokenCredentials.GetToken())
+	require.Empty(t, tokenCredentials.GetSshPrivateKey())
+	require.Empty(t, tokenCredentials.GetSshKnownHosts())
+
+	sshCredentials := gitCredentialsPerGitHost["gitea.internal"]
+	require.Equal(t, "git", sshCredentials.GetUsername())
+	require.Empty(t, sshCredentials.GetToken())
+	require.Equal(t, "private key", sshCredentials.GetSshPrivateKey())
+	require.Equal(t, "known hosts", sshCredentials.GetSshKnownHosts())
+}
+
+func TestNewKurtosisConfigWithInvalidGitCredentials(t *testing.T) {
+	shouldSendMetrics := true
+	token := "my-token"
+	emptyToken := ""
+	username := "kurtosis"
+	httpCloneUrlPrefix := "http://gitlab.mycompany.com"
+	sshCloneUrlPrefix := "ssh://git@gitlab.mycompany.com"
+	sshPrivateKeyFilepath, _ := createSshFiles(t)
+	invalidGitHostsOverrides := []map[string]*v3.GitHostConfigV3{
+		// tokens are only sent over HTTPS
+		{"gitlab.mycompany.com": {CloneUrlPrefix: &httpCloneUrlPrefix, Username: nil, Token: &token, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: &sshCloneUrlPrefix, Username: nil, Token: &token, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: nil, Username: nil, Token: &emptyToken, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+		// SSH private keys are only sent over SSH, which needs one
+		{"gitlab.mycompany.com": {CloneUrlPrefix: nil, Username: nil, Token: nil, SshPrivateKeyFilepath: &sshPrivateKeyFilepath, SshKnownHostsFilepath: nil}},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: &sshCloneUrlPrefix, Username: nil, Token: nil, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: &sshCloneUrlPrefix, Username: nil, Token: &token, SshPrivateKeyFilepath: &sshPrivateKeyFilepath, SshKnownHostsFilepath: nil}},
+		{"gitlab.mycompany.com": {CloneUrlPrefix: nil, Username: &username, Token: nil, SshPrivateKeyFilepath: nil, SshKnownHostsFilepath: nil}},
+	}
+	for _, gitHostsOverrides := range invalidGitHostsOverrides {
+		_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
+			ConfigVersion:     0,
+			ShouldSendMetrics: &shouldSendMetrics,
+			KurtosisClusters:  nil,
+			GitHosts:          gitHostsOverrides,
+		})
+		require.Error(t, err, "Expected the credentials of Git hosts '%+v' to be invalid", gitHostsOverrides)
+	}
+}
+
+func createSshFiles(t *testing.T) (string, string) {
+	dirpath := t.TempDir()
+	sshPrivateKeyFilepath := path.Join(dirpath, "id_ed25519")
+	sshKnownHostsFilepath := path.Join(dirpath, "known_hosts")
+	require.NoError(t, os.WriteFile(sshPrivateKeyFilepath, []byte("private key"), 0600))
+	require.NoError(t, os.WriteFile(sshKnownHostsFilepath, []byte("known hosts"), 0600))
+	return sshPrivateKeyFilepath, sshKnownHostsFilepath
+}
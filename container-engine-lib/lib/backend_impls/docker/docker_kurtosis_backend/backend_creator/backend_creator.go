@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/docker/docker/client"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_network_allocator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
@@ -35,6 +37,21 @@ func GetLocalDockerKurtosisBackend(
 		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker client connected to the local environment")
 	}
 
+	backend, err := GetDockerKurtosisBackendForClient(dockerClient, consts.DockerSocketFilepath, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Docker Kurtosis backend")
+	}
+	return backend, nil
+}
+
+// GetDockerKurtosisBackendForClient creates a Docker Kurtosis backend talking to any engine that serves the Docker API
+// (e.g. Podman) through the given client. The host socket filepath is the one that will be bind-mounted into the
+// engine & API containers
+func GetDockerKurtosisBackendForClient(
+	dockerClient *client.Client,
+	hostDockerSocketFilepath string,
+	optionalApiContainerModeArgs *APIContainerModeArgs,
+) (backend_interface.KurtosisBackend, error) {
 	dockerManager := docker_manager.NewDockerManager(dockerClient)
 
 	// If running within the API container context, detect the network that the API container is running inside
@@ -77,8 +94,18 @@ func GetLocalDockerKurtosisBackend(
 			apiContainerIp.String(): true,
 		}
 
-		freeIpAddrProvider, err := free_ip_addr_tracker.GetOrCreateNewFreeIpAddrTracker(
-			network.GetIpAndMask(),
+		// Only IPv6 enclaves have an IPv6 subnet
+		var staticIpv6Subnet *net.IPNet
+		if ipv6Subnet := network.GetIpv6AndMask(); ipv6Subnet != nil {
+			staticIpv6Subnet = docker_network_allocator.GetStaticIpv6Subnet(ipv6Subnet)
+			alreadyTakenIps[ipv6Subnet.IP.String()] = true
+			alreadyTakenIps[network.GetIpv6GatewayIp()] = true
+		}
+
+		// The IPs of the other half of the network are given by Docker to the containers joining it without a static IP
+		freeIpAddrProvider, err := free_ip_addr_tracker.GetOrCreateNewDualStackFreeIpAddrTracker(
+			docker_network_allocator.GetStaticIpSubnet(network.GetIpAndMask()),
+			staticIpv6Subnet,
 			alreadyTakenIps,
 			enclaveDb,
 		)
@@ -89,7 +116,7 @@ func GetLocalDockerKurtosisBackend(
 		enclaveFreeIpAddrTrackers[enclaveUuid] = freeIpAddrProvider
 	}
 
-	dockerKurtosisBackend := docker_kurtosis_backend.NewDockerKurtosisBackend(dockerManager, enclaveFreeIpAddrTrackers)
+	dockerKurtosisBackend := docker_kurtosis_backend.NewDockerKurtosisBackend(dockerManager, hostDockerSocketFilepath, enclaveFreeIpAddrTrackers)
 
 	wrappedBackend := metrics_reporting.NewMetricsReportingKurtosisBackend(dockerKurtosisBackend)
 
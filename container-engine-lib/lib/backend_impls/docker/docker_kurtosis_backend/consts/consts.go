@@ -24,7 +24,8 @@ const (
 	// means that its grpc-proxy must listen on TCP
 	EngineTransportProtocol = port_spec.TransportProtocol_TCP
 
-	// This needs to be bind-mounted into the engine & API containers so they can manipulate Docker
+	// This needs to be bind-mounted into the engine & API containers so they can manipulate Docker; it's also where
+	// the socket of the host's Docker-API-compatible engine (e.g. Podman) is mounted inside them
 	DockerSocketFilepath = "/var/run/docker.sock"
 
 	//The Docker network name where all the containers in the engine and logs service context will be added
@@ -31,6 +31,10 @@ import (
 type DockerKurtosisBackend struct {
 	dockerManager *docker_manager.DockerManager
 
+	// The filepath, on the host machine, of the socket of the Docker-API-compatible engine that dockerManager talks to.
+	// It gets bind-mounted into the engine & API containers so they can manipulate it too
+	hostDockerSocketFilepath string
+
 	dockerNetworkAllocator *docker_network_allocator.DockerNetworkAllocator
 
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider
@@ -57,6 +61,7 @@ type DockerKurtosisBackend struct {
 
 func NewDockerKurtosisBackend(
 	dockerManager *docker_manager.DockerManager,
+	hostDockerSocketFilepath string,
 	enclaveFreeIpProviders map[enclave.EnclaveUUID]*free_ip_addr_tracker.FreeIpAddrTracker,
 ) *DockerKurtosisBackend {
 	dockerNetworkAllocator := docker_network_allocator.NewDockerNetworkAllocator(dockerManager)
@@ -66,6 +71,7 @@ func NewDockerKurtosisBackend(
 	}
 	return &DockerKurtosisBackend{
 		dockerManager:            dockerManager,
+		hostDockerSocketFilepath: hostDockerSocketFilepath,
 		dockerNetworkAllocator:   dockerNetworkAllocator,
 		objAttrsProvider:         object_attributes_provider.GetDockerObjectAttributesProvider(),
 		enclaveFreeIpProviders:   enclaveFreeIpProviders,
@@ -82,6 +88,26 @@ func (backend *DockerKurtosisBackend) FetchImage(ctx context.Context, image stri
 	return nil
 }
 
+func (backend *DockerKurtosisBackend) SaveImages(ctx context.Context, imageNames []string, output io.Writer) error {
+	imagesReadCloser, err := backend.dockerManager.SaveImages(ctx, imageNames)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving images '%+v'", imageNames)
+	}
+	defer imagesReadCloser.Close()
+
+	if _, err := io.Copy(output, imagesReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the bytes of the TAR'd up images '%+v' to the output", imageNames)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) LoadImages(ctx context.Context, imagesTarContent io.Reader) error {
+	if err := backend.dockerManager.LoadImages(ctx, imagesTarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading images")
+	}
+	return nil
+}
+
 func (backend *DockerKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -100,6 +126,7 @@ func (backend *DockerKurtosisBackend) CreateEngine(
 		grpcPortNum,
 		grpcProxyPortNum,
 		envVars,
+		backend.hostDockerSocketFilepath,
 		backend.dockerManager,
 		backend.objAttrsProvider,
 	)
@@ -298,6 +325,18 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CreateUserServiceImages(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	resultSuccessfulServiceImageNames map[service.ServiceUUID]string,
+	resultErroredServiceUUIDs map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	return user_service_functions.CreateUserServiceImages(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -18,6 +18,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
 	"net"
 	"time"
 )
@@ -43,6 +44,7 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 	enclaveDataVolumeDirpath string,
 	ownIpAddressEnvVar string,
 	customEnvVars map[string]string,
+	secretFiles map[string][]byte,
 ) (*api_container.APIContainer, error) {
 	// Verify no API container already exists in the enclave
 	apiContainersInEnclaveFilters := &api_container.APIContainerFilters{
@@ -142,7 +144,7 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 
 	bindMounts := map[string]string{
 		// Necessary so that the API container can interact with the Docker engine
-		consts.DockerSocketFilepath: consts.DockerSocketFilepath,
+		backend.hostDockerSocketFilepath: consts.DockerSocketFilepath,
 	}
 
 	volumeMounts := map[string]string{
@@ -170,6 +172,8 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		ipAddr,
 	).WithLabels(
 		labelStrs,
+	).WithFilesToCopyBeforeStart(
+		secretFiles,
 	).Build()
 
 	// Best-effort pull attempt
@@ -342,6 +346,70 @@ func (backend *DockerKurtosisBackend) DestroyAPIContainers(ctx context.Context,
 	return successfulEnclaveIds, erroredEnclaveIds, nil
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesFromAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	srcPathOnContainer string,
+	output io.Writer,
+) error {
+	apiContainerId, err := backend.getApiContainerIdForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+
+	tarStreamReadCloser, err := backend.dockerManager.CopyFromContainer(ctx, apiContainerId, srcPathOnContainer)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content from sourcepath '%v' in the API container of enclave '%v'", srcPathOnContainer, enclaveUuid)
+	}
+	defer tarStreamReadCloser.Close()
+
+	if _, err := io.Copy(output, tarStreamReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the bytes of TAR'd up files at '%v' on the API container of enclave '%v' to the output", srcPathOnContainer, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) CopyFilesToAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	destDirpathOnContainer string,
+	tarContent io.Reader,
+) error {
+	apiContainerId, err := backend.getApiContainerIdForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+
+	if err := backend.dockerManager.CopyToContainer(ctx, apiContainerId, destDirpathOnContainer, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to directory '%v' in the API container of enclave '%v'", destDirpathOnContainer, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) PauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	apiContainerId, err := backend.getApiContainerIdForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+
+	if err := backend.dockerManager.PauseContainer(ctx, apiContainerId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pausing the API container of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UnpauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	apiContainerId, err := backend.getApiContainerIdForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+
+	if err := backend.dockerManager.UnpauseContainer(ctx, apiContainerId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unpausing the API container of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -393,6 +461,29 @@ func (backend *DockerKurtosisBackend) getMatchingApiContainers(ctx context.Conte
 	return allMatchingApiContainers, nil
 }
 
+func (backend *DockerKurtosisBackend) getApiContainerIdForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (string, error) {
+	apiContainerFilters := &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	matchingApiContainers, err := backend.getMatchingApiContainers(ctx, apiContainerFilters)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting API containers matching filters '%+v'", apiContainerFilters)
+	}
+	if len(matchingApiContainers) == 0 {
+		return "", stacktrace.NewError("No API container was found for enclave '%v'", enclaveUuid)
+	}
+	if len(matchingApiContainers) > 1 {
+		return "", stacktrace.NewError("Expected to find only one API container for enclave '%v', but '%v' were found", enclaveUuid, len(matchingApiContainers))
+	}
+	var apiContainerId string
+	for apiContainerId = range matchingApiContainers {
+	}
+	return apiContainerId, nil
+}
+
 func getApiContainerObjectFromContainerInfo(
 	containerId string,
 	labels map[string]string,
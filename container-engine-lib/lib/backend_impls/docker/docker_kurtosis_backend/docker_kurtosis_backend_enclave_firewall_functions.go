@@ -0,0 +1,332 @@
+package docker_kurtosis_backend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"net"
+	"sort"
+	"strings"
+)
+
+// The enclave firewall is a container running in the network namespace of the machine running Docker, which drops the
+// traffic blocked between the containers of the enclave with iptables rules. Docker sends the traffic between the
+// containers of a bridge network through the FORWARD chain, where the DOCKER-USER chain lets us add our own rules.
+// The rules of an enclave live in their own chain, which only matches the traffic of the enclave bridge network, so that
+// updating them never affects the other enclaves
+// There's one firewall per enclave, rather than one networking sidecar per service, and as the rules live on the
+// machine running Docker they can't be reset from inside the services
+// In IPv6 enclaves, the traffic between the IPv6 addresses of the services is dropped the same way with ip6tables rules
+
+const (
+	// The networking sidecar image ships iptables
+	enclaveFirewallImageName = networkingSidecarImageName
+
+	enclaveFirewallShellCommand = "sh"
+	enclaveFirewallShellFlag    = "-c"
+
+	enclaveFirewallSuccessExitCode = 0
+
+	dockerUserIptablesChain = "DOCKER-USER"
+	// Docker names the bridge interface of a network after the first characters of the network ID
+	dockerBridgeInterfacePrefix = "br-"
+	dockerNetworkIdPrefixLength = 12
+	enclaveFirewallChainPrefix  = "KURTOSIS-"
+
+	iptablesCmd  = "iptables"
+	ip6tablesCmd = "ip6tables"
+
+	checkBridgeNetfilterCmdFormat = `if [ "$(cat /proc/sys/net/bridge/bridge-nf-call-%s 2> /dev/null)" != "1" ]; then ` +
+		`echo "The traffic between containers doesn't go through %s on the machine running Docker; the br_netfilter kernel module needs to be loaded and net.bridge.bridge-nf-call-%s set to 1" >&2; exit 1; fi`
+
+	checkDockerUserChainCmdFormat = `if ! %s -n -L %s > /dev/null 2>&1; then ` +
+		`echo "Chain '%s' wasn't found; Docker has to manage %s on the machine running it for Kurtosis to block traffic without networking sidecars" >&2; exit 1; fi`
+)
+
+// We sleep forever because all the commands this container will run will be executed via Docker exec
+var enclaveFirewallContainerCommand = []string{
+	"sleep", "infinity",
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveBlockedTraffic(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	blockedTraffic map[string]map[string]bool,
+) error {
+	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	enclaveNetworkId := enclaveNetwork.GetId()
+
+	var script string
+	if len(blockedTraffic) == 0 {
+		script = generateRemoveEnclaveFirewallRulesScript(enclaveNetworkId)
+	} else {
+		script, err = generateUpdateEnclaveFirewallRulesScript(enclaveNetworkId, blockedTraffic)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred generating the firewall rules blocking the traffic in enclave '%v'", enclaveUuid)
+		}
+	}
+
+	containerId, err := backend.getOrCreateEnclaveFirewallContainer(ctx, enclaveUuid, enclaveNetworkId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the firewall container of enclave '%v'", enclaveUuid)
+	}
+	if err = backend.runEnclaveFirewallScript(ctx, containerId, script); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the firewall rules blocking the traffic in enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+
+// removeEnclaveFirewallRules removes the rules the firewall of the enclave added to the machine running Docker, if the
+// enclave has a firewall. It has to be called before the containers of the enclave get destroyed
+func (backend *DockerKurtosisBackend) removeEnclaveFirewallRules(ctx context.Context, networkInfo *matchingNetworkInformation) error {
+	for _, enclaveContainer := range networkInfo.containers {
+		containerType := enclaveContainer.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()]
+		if containerType != label_value_consts.EnclaveFirewallContainerTypeDockerLabelValue.GetString() {
+			continue
+		}
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[enclaveContainer.GetStatus()]
+		if !found {
+			// This should never happen because we enforce completeness in a unit test
+			return stacktrace.NewError("No is-running designation found for enclave firewall container status '%v'; this is a bug in Kurtosis!", enclaveContainer.GetStatus().String())
+		}
+		if !isContainerRunning {
+			if err := backend.dockerManager.StartContainer(ctx, enclaveContainer.GetId()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred starting the firewall container of enclave '%v' to remove its rules", networkInfo.enclaveUuid)
+			}
+		}
+		script := generateRemoveEnclaveFirewallRulesScript(networkInfo.dockerNetwork.GetId())
+		if err := backend.runEnclaveFirewallScript(ctx, enclaveContainer.GetId(), script); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the firewall rules of enclave '%v'", networkInfo.enclaveUuid)
+		}
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) getOrCreateEnclaveFirewallContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+) (string, error) {
+	searchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveUuid),
+		label_key_consts.ContainerTypeDockerLabelKey.GetString(): label_value_consts.EnclaveFirewallContainerTypeDockerLabelValue.GetString(),
+	}
+	existingContainers, err := backend.dockerManager.GetContainersByLabels(ctx, searchLabels, shouldFetchStoppedContainersWhenGettingEnclaveStatus)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the firewall container of enclave '%v' by labels '%+v'", enclaveUuid, searchLabels)
+	}
+	if len(existingContainers) > 1 {
+		return "", stacktrace.NewError("Found %v firewall containers in enclave '%v' when there should be at most one; this is a bug in Kurtosis", len(existingContainers), enclaveUuid)
+	}
+	if len(existingContainers) == 1 {
+		existingContainer := existingContainers[0]
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[existingContainer.GetStatus()]
+		if !found {
+			// This should never happen because we enforce completeness in a unit test
+			return "", stacktrace.NewError("No is-running designation found for enclave firewall container status '%v'; this is a bug in Kurtosis!", existingContainer.GetStatus().String())
+		}
+		if !isContainerRunning {
+			if err = backend.dockerManager.StartContainer(ctx, existingContainer.GetId()); err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred starting the stopped firewall container of enclave '%v'", enclaveUuid)
+			}
+		}
+		return existingContainer.GetId(), nil
+	}
+
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+	containerAttrs, err := enclaveObjAttrsProvider.ForEnclaveFirewallContainer()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while trying to get the firewall container attributes for enclave '%v'", enclaveUuid)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for dockerLabelKey, dockerLabelValue := range containerAttrs.GetLabels() {
+		containerLabels[dockerLabelKey.GetString()] = dockerLabelValue.GetString()
+	}
+
+	// The firewall doesn't get an IP address in the enclave network as it runs in the network of the machine running Docker
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		enclaveFirewallImageName,
+		containerName,
+		enclaveNetworkId,
+	).WithAddedCapabilities(map[docker_manager.ContainerCapability]bool{
+		docker_manager.NetAdmin: true,
+	}).WithNetworkMode(
+		docker_manager.HostNetworkMode,
+	).WithCmdArgs(
+		enclaveFirewallContainerCommand,
+	).WithLabels(
+		containerLabels,
+	).Build()
+
+	// The image is pinned to a version, so it only gets pulled when it isn't available locally yet
+	containerId, _, err := backend.dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred starting the firewall container of enclave '%v'", enclaveUuid)
+	}
+	return containerId, nil
+}
+
+func (backend *DockerKurtosisBackend) runEnclaveFirewallScript(ctx context.Context, containerId string, script string) error {
+	execOutputBuf := &bytes.Buffer{}
+	exitCode, err := backend.dockerManager.RunExecCommand(
+		ctx,
+		containerId,
+		[]string{enclaveFirewallShellCommand, enclaveFirewallShellFlag, script},
+		execOutputBuf,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the firewall script in container '%v'", containerId)
+	}
+	if exitCode != enclaveFirewallSuccessExitCode {
+		return stacktrace.NewError(
+			"The firewall script in container '%v' exited with non-%v exit code '%v' and output:\n%v",
+			containerId,
+			enclaveFirewallSuccessExitCode,
+			exitCode,
+			execOutputBuf.String(),
+		)
+	}
+	return nil
+}
+
+// generateUpdateEnclaveFirewallRulesScript replaces the rules of the enclave chain atomically with iptables-restore, so
+// that the traffic which stays blocked doesn't get through while the rules are updated
+func generateUpdateEnclaveFirewallRulesScript(enclaveNetworkId string, blockedTraffic map[string]map[string]bool) (string, error) {
+	chain := getEnclaveFirewallChain(enclaveNetworkId)
+	bridgeInterface := getEnclaveNetworkBridgeInterface(enclaveNetworkId)
+
+	sourceIpAddresses := []string{}
+	for sourceIpAddress := range blockedTraffic {
+		sourceIpAddresses = append(sourceIpAddresses, sourceIpAddress)
+	}
+	sort.Strings(sourceIpAddresses)
+
+	ipv4DropRules := []string{}
+	ipv6DropRules := []string{}
+	for _, sourceIpAddress := range sourceIpAddresses {
+		isSourceIpv6, err := validateIpAddress(sourceIpAddress)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "Invalid source IP address of the blocked traffic")
+		}
+		destinationIpAddresses := []string{}
+		for destinationIpAddress := range blockedTraffic[sourceIpAddress] {
+			destinationIpAddresses = append(destinationIpAddresses, destinationIpAddress)
+		}
+		sort.Strings(destinationIpAddresses)
+		for _, destinationIpAddress := range destinationIpAddresses {
+			isDestinationIpv6, err := validateIpAddress(destinationIpAddress)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "Invalid destination IP address of the traffic blocked from '%v'", sourceIpAddress)
+			}
+			if isSourceIpv6 != isDestinationIpv6 {
+				return "", stacktrace.NewError("Can't block the traffic from '%v' to '%v' as they aren't addresses of the same IP version", sourceIpAddress, destinationIpAddress)
+			}
+			dropRule := fmt.Sprintf("-A %s -s %s -d %s -j DROP", chain, sourceIpAddress, destinationIpAddress)
+			if isSourceIpv6 {
+				ipv6DropRules = append(ipv6DropRules, dropRule)
+			} else {
+				ipv4DropRules = append(ipv4DropRules, dropRule)
+			}
+		}
+	}
+
+	scriptLines := []string{
+		"set -e",
+	}
+	if len(ipv6DropRules) == 0 {
+		// The enclave might have blocked IPv6 traffic before, and the machine running Docker might not support IPv6
+		scriptLines = append(scriptLines, fmt.Sprintf("%s -F %s 2> /dev/null || true", ip6tablesCmd, chain))
+	} else {
+		scriptLines = append(scriptLines, generateReplaceEnclaveFirewallChainCmds(ip6tablesCmd, chain, bridgeInterface, ipv6DropRules)...)
+	}
+	scriptLines = append(scriptLines, generateReplaceEnclaveFirewallChainCmds(iptablesCmd, chain, bridgeInterface, ipv4DropRules)...)
+	return strings.Join(scriptLines, "\n"), nil
+}
+
+// generateReplaceEnclaveFirewallChainCmds returns the commands replacing the rules of the enclave chain with the given
+// rules, using iptables or ip6tables
+func generateReplaceEnclaveFirewallChainCmds(iptablesCmdName string, chain string, bridgeInterface string, dropRules []string) []string {
+	restoreLines := []string{
+		"*filter",
+		// Declaring a chain which already exists flushes it, even with --noflush
+		fmt.Sprintf(":%s - [0:0]", chain),
+	}
+	restoreLines = append(restoreLines, dropRules...)
+	restoreLines = append(restoreLines, "COMMIT")
+
+	quotedRestoreLines := []string{}
+	for _, restoreLine := range restoreLines {
+		quotedRestoreLines = append(quotedRestoreLines, "'"+restoreLine+"'")
+	}
+	jumpRuleArgs := fmt.Sprintf("%s -i %s -o %s -j %s", dockerUserIptablesChain, bridgeInterface, bridgeInterface, chain)
+
+	return []string{
+		fmt.Sprintf(checkBridgeNetfilterCmdFormat, iptablesCmdName, iptablesCmdName, iptablesCmdName),
+		fmt.Sprintf(checkDockerUserChainCmdFormat, iptablesCmdName, dockerUserIptablesChain, dockerUserIptablesChain, iptablesCmdName),
+		fmt.Sprintf("printf '%%s\\n' %s | %s-restore --noflush", strings.Join(quotedRestoreLines, " "), iptablesCmdName),
+		fmt.Sprintf("%s -C %s 2> /dev/null || %s -I %s", iptablesCmdName, jumpRuleArgs, iptablesCmdName, jumpRuleArgs),
+	}
+}
+
+// generateRemoveEnclaveFirewallRulesScript removes the enclave chain, ignoring the errors as the chain might not exist
+func generateRemoveEnclaveFirewallRulesScript(enclaveNetworkId string) string {
+	chain := getEnclaveFirewallChain(enclaveNetworkId)
+	bridgeInterface := getEnclaveNetworkBridgeInterface(enclaveNetworkId)
+	scriptLines := []string{
+		"set -e",
+	}
+	for _, iptablesCmdName := range []string{iptablesCmd, ip6tablesCmd} {
+		scriptLines = append(
+			scriptLines,
+			fmt.Sprintf("%s -D %s -i %s -o %s -j %s 2> /dev/null || true", iptablesCmdName, dockerUserIptablesChain, bridgeInterface, bridgeInterface, chain),
+			fmt.Sprintf("%s -F %s 2> /dev/null || true", iptablesCmdName, chain),
+			fmt.Sprintf("%s -X %s 2> /dev/null || true", iptablesCmdName, chain),
+		)
+	}
+	return strings.Join(scriptLines, "\n")
+}
+
+func getEnclaveFirewallChain(enclaveNetworkId string) string {
+	return enclaveFirewallChainPrefix + getDockerNetworkIdPrefix(enclaveNetworkId)
+}
+
+func getEnclaveNetworkBridgeInterface(enclaveNetworkId string) string {
+	return dockerBridgeInterfacePrefix + getDockerNetworkIdPrefix(enclaveNetworkId)
+}
+
+func getDockerNetworkIdPrefix(networkId string) string {
+	if len(networkId) <= dockerNetworkIdPrefixLength {
+		return networkId
+	}
+	return networkId[:dockerNetworkIdPrefixLength]
+}
+
+// The rules end up in a shell script, so only well-formed IP addresses are accepted
+func validateIpAddress(ipAddress string) (bool, error) {
+	parsedIpAddress := net.ParseIP(ipAddress)
+	if parsedIpAddress == nil || parsedIpAddress.String() != ipAddress {
+		return false, stacktrace.NewError("'%v' isn't a valid IP address", ipAddress)
+	}
+	isIpv6 := parsedIpAddress.To4() == nil
+	return isIpv6, nil
+}
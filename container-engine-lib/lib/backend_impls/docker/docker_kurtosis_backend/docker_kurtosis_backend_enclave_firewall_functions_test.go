@@ -0,0 +1,98 @@
+package docker_kurtosis_backend
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	testNetworkId = "0123456789abcdef0123456789abcdef"
+)
+
+func TestGenerateUpdateEnclaveFirewallRulesScript(t *testing.T) {
+	blockedTraffic := map[string]map[string]bool{
+		"172.16.0.5": {
+			"172.16.0.4": true,
+		},
+		"172.16.0.3": {
+			"172.16.0.5": true,
+			"172.16.0.4": true,
+		},
+	}
+	script, err := generateUpdateEnclaveFirewallRulesScript(testNetworkId, blockedTraffic)
+	require.Nil(t, err)
+
+	expectedRestoreInput := "printf '%s\\n' '*filter' ':KURTOSIS-0123456789ab - [0:0]' " +
+		"'-A KURTOSIS-0123456789ab -s 172.16.0.3 -d 172.16.0.4 -j DROP' " +
+		"'-A KURTOSIS-0123456789ab -s 172.16.0.3 -d 172.16.0.5 -j DROP' " +
+		"'-A KURTOSIS-0123456789ab -s 172.16.0.5 -d 172.16.0.4 -j DROP' " +
+		"'COMMIT' | iptables-restore --noflush"
+	require.Contains(t, script, expectedRestoreInput)
+	expectedJumpRule := "iptables -C DOCKER-USER -i br-0123456789ab -o br-0123456789ab -j KURTOSIS-0123456789ab 2> /dev/null || " +
+		"iptables -I DOCKER-USER -i br-0123456789ab -o br-0123456789ab -j KURTOSIS-0123456789ab"
+	require.True(t, strings.HasSuffix(script, expectedJumpRule))
+}
+
+func TestGenerateUpdateEnclaveFirewallRulesScript_Ipv6(t *testing.T) {
+	blockedTraffic := map[string]map[string]bool{
+		"172.16.0.3": {
+			"172.16.0.4": true,
+		},
+		"fd00::3": {
+			"fd00::4": true,
+		},
+	}
+	script, err := generateUpdateEnclaveFirewallRulesScript(testNetworkId, blockedTraffic)
+	require.Nil(t, err)
+
+	expectedIpv4RestoreInput := "printf '%s\\n' '*filter' ':KURTOSIS-0123456789ab - [0:0]' " +
+		"'-A KURTOSIS-0123456789ab -s 172.16.0.3 -d 172.16.0.4 -j DROP' " +
+		"'COMMIT' | iptables-restore --noflush"
+	require.Contains(t, script, expectedIpv4RestoreInput)
+	expectedIpv6RestoreInput := "printf '%s\\n' '*filter' ':KURTOSIS-0123456789ab - [0:0]' " +
+		"'-A KURTOSIS-0123456789ab -s fd00::3 -d fd00::4 -j DROP' " +
+		"'COMMIT' | ip6tables-restore --noflush"
+	require.Contains(t, script, expectedIpv6RestoreInput)
+	require.Contains(t, script, "ip6tables -I DOCKER-USER -i br-0123456789ab -o br-0123456789ab -j KURTOSIS-0123456789ab")
+	require.Contains(t, script, "net.bridge.bridge-nf-call-ip6tables")
+}
+
+func TestGenerateUpdateEnclaveFirewallRulesScript_Ipv4OnlyFlushesIpv6Chain(t *testing.T) {
+	blockedTraffic := map[string]map[string]bool{
+		"172.16.0.3": {
+			"172.16.0.4": true,
+		},
+	}
+	script, err := generateUpdateEnclaveFirewallRulesScript(testNetworkId, blockedTraffic)
+	require.Nil(t, err)
+	require.Contains(t, script, "ip6tables -F KURTOSIS-0123456789ab 2> /dev/null || true")
+	require.NotContains(t, script, "ip6tables-restore")
+}
+
+func TestGenerateUpdateEnclaveFirewallRulesScript_MixedIpVersionsError(t *testing.T) {
+	blockedTraffic := map[string]map[string]bool{
+		"172.16.0.3": {
+			"fd00::4": true,
+		},
+	}
+	_, err := generateUpdateEnclaveFirewallRulesScript(testNetworkId, blockedTraffic)
+	require.NotNil(t, err)
+}
+
+func TestGenerateUpdateEnclaveFirewallRulesScript_InvalidIpAddressError(t *testing.T) {
+	blockedTraffic := map[string]map[string]bool{
+		"172.16.0.3": {
+			"172.16.0.4; reboot": true,
+		},
+	}
+	_, err := generateUpdateEnclaveFirewallRulesScript(testNetworkId, blockedTraffic)
+	require.NotNil(t, err)
+}
+
+func TestGenerateRemoveEnclaveFirewallRulesScript(t *testing.T) {
+	script := generateRemoveEnclaveFirewallRulesScript(testNetworkId)
+	require.Contains(t, script, "iptables -D DOCKER-USER -i br-0123456789ab -o br-0123456789ab -j KURTOSIS-0123456789ab 2> /dev/null || true")
+	require.Contains(t, script, "iptables -X KURTOSIS-0123456789ab 2> /dev/null || true")
+	require.Contains(t, script, "ip6tables -X KURTOSIS-0123456789ab 2> /dev/null || true")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
@@ -43,6 +44,17 @@ const (
 	containerSpecJsonSerializationPrefix = ""
 )
 
+// The order in which the containers of a stopped enclave get started again. The networking sidecars join the network
+// namespace of the user service they belong to, so they come after the user services, and the API container comes last
+// so that it finds the services it reconciles its state against already running. Files artifacts expanders are one-off
+// containers and are never started again
+var enclaveContainerTypesInStartOrder = []*docker_label_value.DockerLabelValue{
+	label_value_consts.UserServiceContainerTypeDockerLabelValue,
+	label_value_consts.NetworkingSidecarContainerTypeDockerLabelValue,
+	label_value_consts.EnclaveFirewallContainerTypeDockerLabelValue,
+	label_value_consts.APIContainerContainerTypeDockerLabelValue,
+}
+
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
 type matchingNetworkInformation struct {
@@ -52,7 +64,7 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool, isIpv6Enabled bool) (*enclave.Enclave, error) {
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -113,6 +125,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 		ctx,
 		enclaveNetworkName.GetString(),
 		enclaveNetworkLabels,
+		isIpv6Enabled,
 	)
 	if err != nil {
 		// TODO If the user Ctrl-C's while the CreateNetwork call is ongoing then the CreateNetwork will error saying
@@ -160,7 +173,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 		}
 	}()
 
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, isPartitioningEnabled)
 
 	shouldDeleteNetwork = false
 	shouldDeleteVolume = false
@@ -196,6 +209,7 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			enclaveName,
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
+			getIsPartitioningEnabledFromNetwork(matchingNetworkInfo.dockerNetwork),
 		)
 	}
 
@@ -278,6 +292,41 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+func (backend *DockerKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		if networkInfo.enclaveStatus != enclave.EnclaveStatus_Stopped {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.NewError(
+				"Enclave '%v' can't be started because it isn't stopped; its status is '%v'",
+				enclaveUuid,
+				networkInfo.enclaveStatus.String(),
+			)
+			continue
+		}
+		if err := backend.startEnclaveContainers(ctx, networkInfo.containers); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred starting the containers in enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
 func (backend *DockerKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -395,6 +444,13 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 
 	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
 
+	// This is best-effort, as the rules left behind only match the network of the enclave which is about to be removed
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		if err := backend.removeEnclaveFirewallRules(ctx, networkInfo); err != nil {
+			logrus.Warnf("An error occurred removing the firewall rules of enclave '%v', so they'll be left on the machine running Docker:\n%v", enclaveUuid, err)
+		}
+	}
+
 	successfulContainerRemovalEnclaveUuids, erroredContainerRemovalEnclaveUuids, err := destroyContainersInEnclaves(ctx, backend.dockerManager, matchingNetworkInfo)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying containers in enclaves matching filters '%+v'", filters)
@@ -549,6 +605,57 @@ func (backend *DockerKurtosisBackend) getAllEnclaveContainers(
 	return containers, nil
 }
 
+// Starts the given enclave containers one container type at a time, in the order defined by enclaveContainerTypesInStartOrder
+func (backend *DockerKurtosisBackend) startEnclaveContainers(
+	ctx context.Context,
+	enclaveContainers []*types.Container,
+) error {
+	containerIdsByContainerType := map[string]map[string]bool{}
+	for _, enclaveContainer := range enclaveContainers {
+		containerType, found := enclaveContainer.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		if _, found := containerIdsByContainerType[containerType]; !found {
+			containerIdsByContainerType[containerType] = map[string]bool{}
+		}
+		containerIdsByContainerType[containerType][enclaveContainer.GetId()] = true
+	}
+
+	var startEnclaveContainerOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
+		if err := dockerManager.StartContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting enclave container with ID '%v'", dockerObjectId)
+		}
+		return nil
+	}
+
+	for _, containerTypeLabelValue := range enclaveContainerTypesInStartOrder {
+		containerIdsToStart, found := containerIdsByContainerType[containerTypeLabelValue.GetString()]
+		if !found || len(containerIdsToStart) == 0 {
+			continue
+		}
+		_, erroredContainerIds := docker_operation_parallelizer.RunDockerOperationInParallel(
+			ctx,
+			containerIdsToStart,
+			backend.dockerManager,
+			startEnclaveContainerOperation,
+		)
+		if len(erroredContainerIds) == 0 {
+			continue
+		}
+		containerStartErrorStrs := []string{}
+		for _, startContainerErr := range erroredContainerIds {
+			containerStartErrorStrs = append(containerStartErrorStrs, startContainerErr.Error())
+		}
+		return stacktrace.NewError(
+			"One or more errors occurred starting the '%v' containers:\n%v",
+			containerTypeLabelValue.GetString(),
+			strings.Join(containerStartErrorStrs, "\n\n"),
+		)
+	}
+	return nil
+}
+
 func getAllEnclaveVolumes(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
@@ -902,6 +1009,12 @@ func getEnclaveCreationTimeFromNetwork(network *types.Network) (*time.Time, erro
 	return &enclaveCreationTime, nil
 }
 
+func getIsPartitioningEnabledFromNetwork(network *types.Network) bool {
+	labels := network.GetLabels()
+	isPartitioningEnabledStr := labels[label_key_consts.IsNetworkPartitioningEnabledDockerLabelKey.GetString()]
+	return isPartitioningEnabledStr == label_value_consts.NetworkPartitioningEnabledDockerLabelValue.GetString()
+}
+
 func getEnclaveNameFromNetwork(network *types.Network) string {
 
 	labels := network.GetLabels()
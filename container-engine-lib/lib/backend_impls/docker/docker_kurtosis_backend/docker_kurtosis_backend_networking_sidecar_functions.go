@@ -17,10 +17,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
 )
 
 const (
-	networkingSidecarImageName = "kurtosistech/iproute2"
+	// Built from the 'core/networking_sidecar' subproject, see its '_constants.env' before changing this
+	networkingSidecarImageName = "kurtosistech/networking-sidecar:1.1.0"
 	skipAddingToBridgeNetwork  = true
 )
 
@@ -114,11 +116,7 @@ func (backend *DockerKurtosisBackend) CreateNetworkingSidecar(
 		skipAddingToBridgeNetwork,
 	).Build()
 
-	// Best-effort pull attempt
-	if err = backend.dockerManager.PullImage(ctx, networkingSidecarImageName); err != nil {
-		logrus.Warnf("Failed to pull the latest version of networking sidecar container image '%v'; you may be running an out-of-date version", networkingSidecarImageName)
-	}
-
+	// The image is pinned to a version, so it only gets pulled when it isn't available locally yet
 	containerId, _, err := backend.dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred starting the networking sidecar container")
@@ -247,6 +245,44 @@ func (backend *DockerKurtosisBackend) RunNetworkingSidecarExecCommands(
 	return successfulNetworkingSidecarExecResults, erroredUserServiceUuids, nil
 }
 
+func (backend *DockerKurtosisBackend) RunNetworkingSidecarExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	networkingSidecarCommand []string,
+	output io.Writer,
+) (
+	int32,
+	string,
+	error,
+) {
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		EnclaveUUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		UserServiceUUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	networkingSidecars, err := backend.getMatchingNetworkingSidecars(ctx, filters)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred getting networking sidecars matching filters '%+v'", filters)
+	}
+	if len(networkingSidecars) != 1 {
+		return 0, "", stacktrace.NewError("Expected exactly one networking sidecar for user service with UUID '%v' in enclave '%v', but found '%v'", serviceUuid, enclaveUuid, len(networkingSidecars))
+	}
+
+	for containerId := range networkingSidecars {
+		exitCode, errorOutput, err := backend.dockerManager.RunExecCommandWithSeparatedOutput(ctx, containerId, networkingSidecarCommand, output)
+		if err != nil {
+			return 0, "", stacktrace.Propagate(err, "An error occurred executing command '%+v' on networking sidecar with user service UUID '%v'", networkingSidecarCommand, serviceUuid)
+		}
+		return exitCode, errorOutput, nil
+	}
+	return 0, "", stacktrace.NewError("No networking sidecar was found for user service with UUID '%v'; this is a bug in Kurtosis", serviceUuid)
+}
+
 func (backend *DockerKurtosisBackend) StopNetworkingSidecars(
 	ctx context.Context,
 	filters *networking_sidecar.NetworkingSidecarFilters,
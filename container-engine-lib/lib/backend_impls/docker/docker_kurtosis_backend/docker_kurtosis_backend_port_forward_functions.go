@@ -0,0 +1,337 @@
+package docker_kurtosis_backend
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strconv"
+)
+
+// A port forward is a container of the enclave network running socat, which publishes the local port on the machine
+// running Docker and relays the traffic it receives to the private port of the user service
+// The port forwards are created by the engine, which doesn't track the IP addresses the API container gives to the
+// services, so they get their IP address from Docker, in the part of the enclave network the API container never
+// gives IP addresses from
+
+const (
+	portForwardImageName = "alpine/socat:1.7.4.4"
+
+	portForwardListenAddressFormat = "%s-LISTEN:%d,fork,reuseaddr"
+	portForwardTargetAddressFormat = "%s:%s:%d"
+
+	decimalBase   = 10
+	uint16BitSize = 16
+)
+
+// The socat address types, which the image entrypoint receives as arguments
+var portForwardSocatProtocols = map[port_spec.TransportProtocol]string{
+	port_spec.TransportProtocol_TCP: "TCP",
+	port_spec.TransportProtocol_UDP: "UDP",
+}
+
+func (backend *DockerKurtosisBackend) CreatePortForward(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	portId string,
+	localPortNumber uint16,
+) (
+	*port_forward.PortForward,
+	error,
+) {
+	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave network by enclave UUID '%v'", enclaveUuid)
+	}
+
+	userService, _, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service '%v' to forward a port to", serviceUuid)
+	}
+	privatePortSpec, found := userService.GetPrivatePorts()[portId]
+	if !found {
+		return nil, stacktrace.NewError("Service '%v' doesn't have a private port with ID '%v'", serviceUuid, portId)
+	}
+	socatProtocol, found := portForwardSocatProtocols[privatePortSpec.GetTransportProtocol()]
+	if !found {
+		return nil, stacktrace.NewError("Port '%v' of service '%v' uses transport protocol '%v', which can't be forwarded", portId, serviceUuid, privatePortSpec.GetTransportProtocol().String())
+	}
+	dockerPort, err := shared_helpers.TransformPortSpecToDockerPort(privatePortSpec)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting port '%v' of service '%v' to a Docker port", portId, serviceUuid)
+	}
+
+	allPortForwards, err := backend.getMatchingPortForwards(ctx, &port_forward.PortForwardFilters{
+		EnclaveUUIDs:     nil,
+		UserServiceUUIDs: nil,
+		LocalPortNumbers: nil,
+		Statuses:         nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the existing port forwards")
+	}
+	for _, existingPortForward := range allPortForwards {
+		if existingPortForward.GetLocalPortNumber() == localPortNumber {
+			return nil, stacktrace.NewError("Local port '%v' is already forwarded to port '%v' of service '%v' in enclave '%v'", localPortNumber, existingPortForward.GetPortId(), existingPortForward.GetServiceUUID(), existingPortForward.GetEnclaveUUID())
+		}
+	}
+
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+	containerAttrs, err := enclaveObjAttrsProvider.ForPortForwardContainer(serviceUuid, portId, localPortNumber)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the port forward container attributes for local port '%v'", localPortNumber)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for dockerLabelKey, dockerLabelValue := range containerAttrs.GetLabels() {
+		containerLabels[dockerLabelKey.GetString()] = dockerLabelValue.GetString()
+	}
+
+	privatePortNumber := privatePortSpec.GetNumber()
+	socatArgs := []string{
+		fmt.Sprintf(portForwardListenAddressFormat, socatProtocol, privatePortNumber),
+		fmt.Sprintf(portForwardTargetAddressFormat, socatProtocol, userService.GetRegistration().GetPrivateIP().String(), privatePortNumber),
+	}
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		portForwardImageName,
+		containerName,
+		enclaveNetwork.GetId(),
+	).WithUsedPorts(map[nat.Port]docker_manager.PortPublishSpec{
+		dockerPort: docker_manager.NewManualPublishingSpec(localPortNumber),
+	}).WithCmdArgs(
+		socatArgs,
+	).WithLabels(
+		containerLabels,
+	).Build()
+
+	// Best-effort pull attempt
+	if err = backend.dockerManager.PullImage(ctx, portForwardImageName); err != nil {
+		logrus.Warnf("Failed to pull the latest version of port forward container image '%v'; you may be running an out-of-date version", portForwardImageName)
+	}
+
+	if _, _, err = backend.dockerManager.CreateAndStartContainer(ctx, createAndStartArgs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the container forwarding local port '%v' to port '%v' of service '%v'", localPortNumber, portId, serviceUuid)
+	}
+
+	portForward, err := getPortForwardObjectFromContainerInfo(containerLabels, types.ContainerStatus_Running)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting port forward object from container info with labels '%+v' and container status '%v'", containerLabels, types.ContainerStatus_Running)
+	}
+	return portForward, nil
+}
+
+func (backend *DockerKurtosisBackend) GetPortForwards(
+	ctx context.Context,
+	filters *port_forward.PortForwardFilters,
+) (
+	map[uint16]*port_forward.PortForward,
+	error,
+) {
+	portForwards, err := backend.getMatchingPortForwards(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting port forwards matching filters '%+v'", filters)
+	}
+
+	result := map[uint16]*port_forward.PortForward{}
+	for _, portForward := range portForwards {
+		result[portForward.GetLocalPortNumber()] = portForward
+	}
+	return result, nil
+}
+
+func (backend *DockerKurtosisBackend) DestroyPortForwards(
+	ctx context.Context,
+	filters *port_forward.PortForwardFilters,
+) (
+	map[uint16]bool,
+	map[uint16]error,
+	error,
+) {
+	portForwards, err := backend.getMatchingPortForwards(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting port forwards matching filters '%+v'", filters)
+	}
+
+	matchingUncastedObjectsByContainerId := map[string]interface{}{}
+	for containerId, object := range portForwards {
+		matchingUncastedObjectsByContainerId[containerId] = interface{}(object)
+	}
+
+	var dockerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing port forward container with ID '%v'", dockerObjectId)
+		}
+		return nil
+	}
+
+	successfulLocalPortNumberStrs, erroredLocalPortNumberStrs, err := docker_operation_parallelizer.RunDockerOperationInParallelForKurtosisObjects(
+		ctx,
+		matchingUncastedObjectsByContainerId,
+		backend.dockerManager,
+		extractLocalPortNumberFromPortForwardObj,
+		dockerOperation,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing port forward containers matching filters '%+v'", filters)
+	}
+
+	successfulLocalPortNumbers := map[uint16]bool{}
+	for localPortNumberStr := range successfulLocalPortNumberStrs {
+		localPortNumber, err := parseLocalPortNumber(localPortNumberStr)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the local port of a destroyed port forward")
+		}
+		successfulLocalPortNumbers[localPortNumber] = true
+	}
+	erroredLocalPortNumbers := map[uint16]error{}
+	for localPortNumberStr, removalErr := range erroredLocalPortNumberStrs {
+		localPortNumber, err := parseLocalPortNumber(localPortNumberStr)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the local port of a port forward which failed to be destroyed")
+		}
+		erroredLocalPortNumbers[localPortNumber] = removalErr
+	}
+	return successfulLocalPortNumbers, erroredLocalPortNumbers, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+
+// Returns the matching port forwards by the ID of their container
+func (backend *DockerKurtosisBackend) getMatchingPortForwards(
+	ctx context.Context,
+	filters *port_forward.PortForwardFilters,
+) (map[string]*port_forward.PortForward, error) {
+	searchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.ContainerTypeDockerLabelKey.GetString(): label_value_consts.PortForwardContainerTypeDockerLabelValue.GetString(),
+	}
+	matchingContainers, err := backend.dockerManager.GetContainersByLabels(ctx, searchLabels, consts.ShouldFetchAllContainersWhenRetrievingContainers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching containers using labels: %+v", searchLabels)
+	}
+
+	matchingObjects := map[string]*port_forward.PortForward{}
+	for _, container := range matchingContainers {
+		object, err := getPortForwardObjectFromContainerInfo(container.GetLabels(), container.GetStatus())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred converting container with ID '%v' into a port forward object", container.GetId())
+		}
+
+		if filters.EnclaveUUIDs != nil && len(filters.EnclaveUUIDs) > 0 {
+			if _, found := filters.EnclaveUUIDs[object.GetEnclaveUUID()]; !found {
+				continue
+			}
+		}
+
+		if filters.UserServiceUUIDs != nil && len(filters.UserServiceUUIDs) > 0 {
+			if _, found := filters.UserServiceUUIDs[object.GetServiceUUID()]; !found {
+				continue
+			}
+		}
+
+		if filters.LocalPortNumbers != nil && len(filters.LocalPortNumbers) > 0 {
+			if _, found := filters.LocalPortNumbers[object.GetLocalPortNumber()]; !found {
+				continue
+			}
+		}
+
+		if filters.Statuses != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[object.GetStatus()]; !found {
+				continue
+			}
+		}
+
+		matchingObjects[container.GetId()] = object
+	}
+	return matchingObjects, nil
+}
+
+func getPortForwardObjectFromContainerInfo(
+	labels map[string]string,
+	containerStatus types.ContainerStatus,
+) (*port_forward.PortForward, error) {
+	enclaveUuid, found := labels[label_key_consts.EnclaveUUIDDockerLabelKey.GetString()]
+	if !found {
+		return nil, stacktrace.NewError("Expected the port forward's enclave UUID to be found under label '%v' but the label wasn't present", label_key_consts.EnclaveUUIDDockerLabelKey.GetString())
+	}
+
+	serviceUuid, found := labels[label_key_consts.UserServiceGUIDDockerLabelKey.GetString()]
+	if !found {
+		return nil, stacktrace.NewError("Expected the port forward's service UUID to be found under label '%v' but the label wasn't present", label_key_consts.UserServiceGUIDDockerLabelKey.GetString())
+	}
+
+	portId, found := labels[label_key_consts.ForwardedPortIdDockerLabelKey.GetString()]
+	if !found {
+		return nil, stacktrace.NewError("Expected the port forward's port ID to be found under label '%v' but the label wasn't present", label_key_consts.ForwardedPortIdDockerLabelKey.GetString())
+	}
+
+	localPortNumberStr, found := labels[label_key_consts.LocalPortNumberDockerLabelKey.GetString()]
+	if !found {
+		return nil, stacktrace.NewError("Expected the port forward's local port to be found under label '%v' but the label wasn't present", label_key_consts.LocalPortNumberDockerLabelKey.GetString())
+	}
+	localPortNumber, err := parseLocalPortNumber(localPortNumberStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the port forward's local port")
+	}
+
+	isContainerRunning, found := consts.IsContainerRunningDeterminer[containerStatus]
+	if !found {
+		// This should never happen because we enforce completeness in a unit test
+		return nil, stacktrace.NewError("No is-running designation found for port forward container status '%v'; this is a bug in Kurtosis!", containerStatus.String())
+	}
+	var status container_status.ContainerStatus
+	if isContainerRunning {
+		status = container_status.ContainerStatus_Running
+	} else {
+		status = container_status.ContainerStatus_Stopped
+	}
+
+	return port_forward.NewPortForward(
+		enclave.EnclaveUUID(enclaveUuid),
+		service.ServiceUUID(serviceUuid),
+		portId,
+		localPortNumber,
+		status,
+	), nil
+}
+
+func extractLocalPortNumberFromPortForwardObj(uncastedObj interface{}) (string, error) {
+	castedObj, ok := uncastedObj.(*port_forward.PortForward)
+	if !ok {
+		return "", stacktrace.NewError("An error occurred downcasting the port forward object")
+	}
+	return strconv.FormatUint(uint64(castedObj.GetLocalPortNumber()), decimalBase), nil
+}
+
+func parseLocalPortNumber(localPortNumberStr string) (uint16, error) {
+	localPortNumber, err := strconv.ParseUint(localPortNumberStr, decimalBase, uint16BitSize)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing local port '%v'", localPortNumberStr)
+	}
+	return uint16(localPortNumber), nil
+}
@@ -29,6 +29,7 @@ func CreateEngine(
 	grpcPortNum uint16,
 	grpcProxyPortNum uint16,
 	envVars map[string]string,
+	hostDockerSocketFilepath string,
 	dockerManager *docker_manager.DockerManager,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 ) (
@@ -94,7 +95,7 @@ func CreateEngine(
 
 	bindMounts := map[string]string{
 		// Necessary so that the engine server can interact with the Docker engine
-		consts.DockerSocketFilepath: consts.DockerSocketFilepath,
+		hostDockerSocketFilepath: consts.DockerSocketFilepath,
 	}
 
 	containerImageAndTag := fmt.Sprintf(
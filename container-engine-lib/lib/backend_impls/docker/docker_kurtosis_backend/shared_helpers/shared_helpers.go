@@ -201,6 +201,19 @@ func TransformPortSpecToDockerPort(portSpec *port_spec.PortSpec) (nat.Port, erro
 	return dockerPort, nil
 }
 
+// getMaybePrivateIpv6FromContainer returns nil if the container doesn't have an IPv6, i.e. it doesn't belong to an IPv6 enclave
+func getMaybePrivateIpv6FromContainer(containerName string, labels map[string]string) (net.IP, error) {
+	privateIpv6AddrStr, found := labels[label_key_consts.PrivateIPv6DockerLabelKey.GetString()]
+	if !found {
+		return nil, nil
+	}
+	privateIpv6 := net.ParseIP(privateIpv6AddrStr)
+	if privateIpv6 == nil {
+		return nil, stacktrace.NewError("Couldn't parse private IPv6 string '%v' on container '%v' to an IP address", privateIpv6AddrStr, containerName)
+	}
+	return privateIpv6, nil
+}
+
 // TODO Extract this to DockerKurtosisBackend and use it everywhere, for Engines and API containers?
 func GetIpAndPortInfoFromContainer(
 	containerName string,
@@ -582,11 +595,17 @@ func getUserServiceObjsFromDockerResources(
 			return nil, stacktrace.Propagate(err, "An error occurred getting IP & port info from container '%v'", container.GetName())
 		}
 
-		registration := service.NewServiceRegistration(
+		maybePrivateIpv6, err := getMaybePrivateIpv6FromContainer(containerName, containerLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the private IPv6 of container '%v'", containerName)
+		}
+
+		registration := service.NewDualStackServiceRegistration(
 			serviceName,
 			serviceUuid,
 			enclaveId,
 			privateIp,
+			maybePrivateIpv6,
 			string(serviceName), // in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
 		)
 
@@ -0,0 +1,100 @@
+package user_service_functions
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	// The created images are named after the service UUID, and tagged with the time they got created at so that
+	// creating images of the same service several times doesn't overwrite the previous ones
+	userServiceImageNameFormat      = "kurtosis-user-service-image/%v:%v"
+	userServiceImageTagTimestampFmt = "20060102150405"
+)
+
+func CreateUserServiceImages(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	resultSuccessfulServiceImageNames map[service.ServiceUUID]string,
+	resultErroredServiceUUIDs map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	allServiceObjs, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	imageTag := time.Now().Format(userServiceImageTagTimestampFmt)
+	servicesToCommitByContainerId := map[string]interface{}{}
+	imageNamesByContainerId := map[string]string{}
+	imageNamesByServiceUuid := map[service.ServiceUUID]string{}
+	for uuid, serviceResources := range allDockerResources {
+		serviceObj, found := allServiceObjs[uuid]
+		if !found {
+			// Should never happen; there should be a 1:1 mapping between service_objects:docker_resources by GUID
+			return nil, nil, stacktrace.NewError("No service object found for service '%v' that had Docker resources", uuid)
+		}
+		if serviceResources.ServiceContainer == nil {
+			// Registered services that never got started have no filesystem to create an image out of
+			continue
+		}
+		containerId := serviceResources.ServiceContainer.GetId()
+		imageName := fmt.Sprintf(userServiceImageNameFormat, uuid, imageTag)
+		servicesToCommitByContainerId[containerId] = serviceObj
+		imageNamesByContainerId[containerId] = imageName
+		imageNamesByServiceUuid[uuid] = imageName
+	}
+
+	var dockerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		imageName, found := imageNamesByContainerId[dockerObjectId]
+		if !found {
+			return stacktrace.NewError("No image name was defined for user service container with ID '%v'; this is a bug in Kurtosis", dockerObjectId)
+		}
+		if err := dockerManager.CommitContainer(ctx, dockerObjectId, imageName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating image '%v' out of user service container with ID '%v'", imageName, dockerObjectId)
+		}
+		return nil
+	}
+
+	successfulUuidStrs, erroredUuidStrs, err := docker_operation_parallelizer.RunDockerOperationInParallelForKurtosisObjects(
+		ctx,
+		servicesToCommitByContainerId,
+		dockerManager,
+		extractServiceUUIDFromServiceObj,
+		dockerOperation,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating images of user service containers matching filters '%+v'", filters)
+	}
+
+	successfulImageNames := map[service.ServiceUUID]string{}
+	for uuidStr := range successfulUuidStrs {
+		uuid := service.ServiceUUID(uuidStr)
+		successfulImageNames[uuid] = imageNamesByServiceUuid[uuid]
+	}
+
+	erroredUuids := map[service.ServiceUUID]error{}
+	for uuidStr, err := range erroredUuidStrs {
+		erroredUuids[service.ServiceUUID(uuidStr)] = stacktrace.Propagate(
+			err,
+			"An error occurred creating an image of service '%v'",
+			uuidStr,
+		)
+	}
+
+	return successfulImageNames, erroredUuids, nil
+}
@@ -127,6 +127,11 @@ func destroyUserServicesUnlocked(
 		if err = enclaveFreeIpProvidersForEnclave.ReleaseIpAddr(ipAddr); err != nil {
 			logrus.Errorf("Error releasing IP address '%v'", ipAddr)
 		}
+		if ipv6Addr := registration.GetPrivateIPv6(); ipv6Addr != nil {
+			if err = enclaveFreeIpProvidersForEnclave.ReleaseIpAddr(ipv6Addr); err != nil {
+				logrus.Errorf("Error releasing IPv6 address '%v'", ipv6Addr)
+			}
+		}
 		delete(serviceRegistrationsForEnclave, uuid)
 	}
 
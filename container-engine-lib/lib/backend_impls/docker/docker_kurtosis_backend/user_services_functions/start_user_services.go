@@ -15,6 +15,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"net"
 	"strings"
 	"sync"
 )
@@ -83,10 +84,16 @@ func UnregisterUserServices(
 		serviceIpAddr := serviceRegistration.GetPrivateIP()
 		if err := freeIpAddrProviderForEnclave.ReleaseIpAddr(serviceIpAddr); err != nil {
 			servicesFailed[serviceUuid] = err
-		} else {
-			delete(enclaveServiceRegistrations, serviceUuid)
-			servicesSuccessfullyUnregistered[serviceUuid] = true
+			continue
 		}
+		if serviceIpv6Addr := serviceRegistration.GetPrivateIPv6(); serviceIpv6Addr != nil {
+			if err := freeIpAddrProviderForEnclave.ReleaseIpAddr(serviceIpv6Addr); err != nil {
+				servicesFailed[serviceUuid] = err
+				continue
+			}
+		}
+		delete(enclaveServiceRegistrations, serviceUuid)
+		servicesSuccessfullyUnregistered[serviceUuid] = true
 	}
 	return servicesSuccessfullyUnregistered, servicesFailed
 }
@@ -144,6 +151,19 @@ func StartUserServices(
 	}
 	//TODO END huge hack to temporarily enable static ports for NEAR
 
+	// Services that were stopped get restarted by re-creating their container, so the old one needs to go first
+	failedContainerRemovals, err := removeStoppedUserServiceContainers(ctx, enclaveUuid, serviceConfigsToStart, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing the containers of the stopped services to restart")
+	}
+	for serviceUuid, removalErr := range failedContainerRemovals {
+		failedServicesPool[serviceUuid] = removalErr
+		delete(serviceConfigsToStart, serviceUuid)
+	}
+	if len(serviceConfigsToStart) == 0 {
+		return successfulServicesPool, failedServicesPool, nil
+	}
+
 	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network by enclave ID '%v'", enclaveUuid)
@@ -186,6 +206,54 @@ func StartUserServices(
 //	Private helper functions
 //
 // ====================================================================================================
+// Removes the existing containers of the services to start, which must all be stopped, returning the services for which
+// this failed
+func removeStoppedUserServiceContainers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceConfigsToStart map[service.ServiceUUID]*service.ServiceConfig,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]error, error) {
+	failedServices := map[service.ServiceUUID]error{}
+
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range serviceConfigsToStart {
+		serviceUuids[serviceUuid] = true
+	}
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	existingServiceObjs, existingDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the existing containers of services '%+v'", serviceUuids)
+	}
+
+	serviceObjsToRemove := map[service.ServiceUUID]*service.Service{}
+	dockerResourcesToRemove := map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources{}
+	for serviceUuid, existingServiceObj := range existingServiceObjs {
+		if existingServiceObj.GetStatus() != container_status.ContainerStatus_Stopped {
+			failedServices[serviceUuid] = stacktrace.NewError("Service '%v' cannot be started as it is already running; it needs to be stopped first", serviceUuid)
+			continue
+		}
+		serviceObjsToRemove[serviceUuid] = existingServiceObj
+		dockerResourcesToRemove[serviceUuid] = existingDockerResources[serviceUuid]
+	}
+	if len(serviceObjsToRemove) == 0 {
+		return failedServices, nil
+	}
+
+	_, erroredRemovals, err := removeUserServiceDockerResources(ctx, serviceObjsToRemove, dockerResourcesToRemove, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the Docker resources of stopped services '%+v'", serviceUuids)
+	}
+	for serviceUuid, removalErr := range erroredRemovals {
+		failedServices[serviceUuid] = stacktrace.Propagate(removalErr, "An error occurred removing the Docker resources of stopped service '%v' before restarting it", serviceUuid)
+	}
+	return failedServices, nil
+}
+
 func runStartServiceOperationsInParallel(
 	ctx context.Context,
 	enclaveNetworkId string,
@@ -254,6 +322,7 @@ func createStartServiceOperation(
 ) operation_parallelizer.Operation {
 	id := serviceRegistration.GetName()
 	privateIpAddr := serviceRegistration.GetPrivateIP()
+	privateIpv6Addr := serviceRegistration.GetPrivateIPv6()
 
 	return func() (interface{}, error) {
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
@@ -314,6 +383,7 @@ func createStartServiceOperation(
 			id,
 			serviceUUID,
 			privateIpAddr,
+			privateIpv6Addr,
 			privatePorts,
 		)
 		if err != nil {
@@ -350,6 +420,8 @@ func createStartServiceOperation(
 			enclaveNetworkId,
 		).WithStaticIP(
 			privateIpAddr,
+		).WithStaticIPv6(
+			privateIpv6Addr,
 		).WithUsedPorts(
 			dockerUsedPorts,
 		).WithEnvironmentVariables(
@@ -475,6 +547,24 @@ func registerUserServices(
 			}
 		}()
 
+		// Services of IPv6 enclaves get an IPv6 in addition to their IPv4
+		var ipv6Addr net.IP
+		if freeIpAddrProvider.IsIpv6Enabled() {
+			ipv6Addr, err = freeIpAddrProvider.GetFreeIpv6Addr()
+			if err != nil {
+				failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred getting a free IPv6 address to give to service '%v' in enclave '%v'", serviceName, enclaveUuid)
+				continue
+			}
+		}
+		shouldFreeIpv6 := ipv6Addr != nil
+		defer func() {
+			if shouldFreeIpv6 {
+				if err = freeIpAddrProvider.ReleaseIpAddr(ipv6Addr); err != nil {
+					logrus.Errorf("Error releasing IPv6 address '%v'", ipv6Addr)
+				}
+			}
+		}()
+
 		uuidStr, err := uuid_generator.GenerateUUIDString()
 		if err != nil {
 			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred generating a UUID to use for the service UUID")
@@ -482,11 +572,12 @@ func registerUserServices(
 		}
 
 		serviceUuid := service.ServiceUUID(uuidStr)
-		registration := service.NewServiceRegistration(
+		registration := service.NewDualStackServiceRegistration(
 			serviceName,
 			serviceUuid,
 			enclaveUuid,
 			ipAddr,
+			ipv6Addr,
 			string(serviceName), // in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
 		)
 
@@ -499,6 +590,7 @@ func registerUserServices(
 		}()
 
 		shouldFreeIp = false
+		shouldFreeIpv6 = false
 		shouldRemoveRegistration = false
 		successfulRegistrations[serviceName] = registration
 	}
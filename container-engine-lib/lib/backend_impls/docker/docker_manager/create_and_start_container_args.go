@@ -13,6 +13,7 @@ type CreateAndStartContainerArgs struct {
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
@@ -27,6 +28,7 @@ type CreateAndStartContainerArgs struct {
 	memoryAllocationMegabytes                uint64
 	loggingDriverConfig                      LoggingDriver
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
+	filesToCopyBeforeStart                   map[string][]byte
 }
 
 // Builder for creating CreateAndStartContainerArgs object
@@ -37,6 +39,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
@@ -51,6 +54,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	memoryAllocationMegabytes                uint64
 	loggingDriverCnfg                        LoggingDriver
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
+	filesToCopyBeforeStart                   map[string][]byte
 }
 
 /*
@@ -68,6 +72,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		interactiveModeTtySize:                   nil,
 		networkId:                                networkId,
 		staticIp:                                 nil,
+		staticIpv6:                               nil,
 		addedCapabilities:                        map[ContainerCapability]bool{},
 		networkMode:                              DefaultNetworkMode,
 		usedPorts:                                map[nat.Port]PortPublishSpec{},
@@ -82,6 +87,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		memoryAllocationMegabytes:                0,
 		loggingDriverCnfg:                        nil,
 		skipAddingToBridgeNetworkIfStaticIpIsSet: false,
+		filesToCopyBeforeStart:                   map[string][]byte{},
 	}
 }
 
@@ -94,6 +100,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		interactiveModeTtySize:                   builder.interactiveModeTtySize,
 		networkId:                                builder.networkId,
 		staticIp:                                 builder.staticIp,
+		staticIpv6:                               builder.staticIpv6,
 		addedCapabilities:                        builder.addedCapabilities,
 		networkMode:                              builder.networkMode,
 		usedPorts:                                builder.usedPorts,
@@ -107,6 +114,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		memoryAllocationMegabytes:                builder.memoryAllocationMegabytes,
 		loggingDriverConfig:                      builder.loggingDriverCnfg,
 		skipAddingToBridgeNetworkIfStaticIpIsSet: builder.skipAddingToBridgeNetworkIfStaticIpIsSet,
+		filesToCopyBeforeStart:                   builder.filesToCopyBeforeStart,
 	}
 }
 
@@ -129,6 +137,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithStaticIP(ip net.IP) *Crea
 	return builder
 }
 
+// IPv6 the container will be assigned in addition to the static IP, which only works with dual-stack networks
+// (leave nil to not assign any IPv6)
+func (builder *CreateAndStartContainerArgsBuilder) WithStaticIPv6(ip net.IP) *CreateAndStartContainerArgsBuilder {
+	builder.staticIpv6 = ip
+	return builder
+}
+
 // A "set" of capabilities to add to the container, corresponding to the --cap-add Docker flag
 // For more info, see the --cap-add section of https://docs.docker.com/engine/reference/run/
 func (builder *CreateAndStartContainerArgsBuilder) WithAddedCapabilities(capabilities map[ContainerCapability]bool) *CreateAndStartContainerArgsBuilder {
@@ -225,3 +240,11 @@ func (builder *CreateAndStartContainerArgsBuilder) WithSkipAddingToBridgeNetwork
 	builder.skipAddingToBridgeNetworkIfStaticIpIsSet = skipAddingToBridgeNetworkIfStaticIpIsSet
 	return builder
 }
+
+// Contents of files, keyed by their absolute filepath inside the container, that get copied to the container after it's
+// created and before it starts; they are only readable by the container user, and unlike the environment variables they
+// don't show up when inspecting the container
+func (builder *CreateAndStartContainerArgsBuilder) WithFilesToCopyBeforeStart(filesToCopyBeforeStart map[string][]byte) *CreateAndStartContainerArgsBuilder {
+	builder.filesToCopyBeforeStart = filesToCopyBeforeStart
+	return builder
+}
@@ -6,6 +6,8 @@
 package docker_manager
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/docker/docker/api/types"
@@ -14,6 +16,7 @@ import (
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
@@ -35,6 +38,9 @@ const (
 	dockerNetworkDriver      = "bridge"
 	bridgeNetworkNetworkName = "bridge"
 
+	isIpv6EnabledInIpv4OnlyNetworks  = false
+	isIpv6EnabledInDualStackNetworks = true
+
 	// Per https://docs.docker.com/engine/reference/commandline/kill/ , this seems to mean "the default
 	//  kill signal"
 	dockerKillSignal = "KILL"
@@ -61,6 +67,16 @@ const (
 	// the value of HostGatewayIP daemon config value
 	hostGatewayName = "host-gateway"
 
+	// Pausing the container while it gets committed guarantees that the created image holds a consistent filesystem
+	shouldPauseContainerWhenCommitting = true
+
+	shouldLoadImagesQuietly = true
+
+	// The files copied to a container before it starts are given by absolute filepath, so they get extracted from the root
+	containerRootDirpath = "/"
+	// The files copied to a container before it starts may be secrets, so only the container user can read them
+	filesToCopyBeforeStartMode = 0600
+
 	// ------------------ Filter Search Keys ----------------------
 	// All these defined in https://docs.docker.com/engine/api/v1.24
 
@@ -114,6 +130,9 @@ const (
 	emptyNetworkAlias = ""
 )
 
+// Containers connected to a network through ConnectContainerToNetwork only get an IPv4 address
+var noStaticIpv6Addr net.IP = nil
+
 /*
 InteractiveModeTtySize
 The dimensions of the TTY that the container should output to when in interactive mode
@@ -155,6 +174,7 @@ Args:
 	context: The Context that this request is running in (useful for cancellation)
 	name: The name to give the new Docker network
 	subnetMask: The subnet mask defining allowed IPs for the Docker network
+	ipRange: The part of the subnet from which Docker gives IPs to the containers joining the network without a static IP
 	gatewayIP: The IP to give the network gateway
 	labels: Labels to give the network object
 
@@ -162,36 +182,54 @@ Returns:
 
 	id: The Docker-managed ID of the network
 */
-func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, labels map[string]string) (id string, err error) {
+func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, ipRange string, gatewayIP net.IP, labels map[string]string) (id string, err error) {
 	ipamConfig := []network.IPAMConfig{{
 		Subnet:     subnetMask,
-		IPRange:    "",
+		IPRange:    ipRange,
 		Gateway:    gatewayIP.String(),
 		AuxAddress: nil,
 	}}
 
-	resp, err := manager.dockerClient.NetworkCreate(context, name, types.NetworkCreate{
-		CheckDuplicate: false,
-		Driver:         dockerNetworkDriver,
-		Scope:          "",
-		EnableIPv6:     false,
-		IPAM: &network.IPAM{
-			Driver:  "",
-			Options: nil,
-			Config:  ipamConfig,
-		},
-		Internal:   false,
-		Attachable: false,
-		Ingress:    false,
-		ConfigOnly: false,
-		ConfigFrom: nil,
-		Options:    nil,
-		Labels:     labels,
-	})
+	networkId, err := manager.createNetworkWithIpamConfig(context, name, ipamConfig, isIpv6EnabledInIpv4OnlyNetworks, labels)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Failed to create network %s with subnet %s", name, subnetMask)
 	}
-	return resp.ID, nil
+	return networkId, nil
+}
+
+// CreateDualStackNetwork creates a network whose containers can get both an IPv4 and an IPv6 address. The containers
+// without a static IP or IPv6 get one from the given IP range or IPv6 range
+func (manager *DockerManager) CreateDualStackNetwork(
+	context context.Context,
+	name string,
+	subnetMask string,
+	ipRange string,
+	gatewayIP net.IP,
+	ipv6SubnetMask string,
+	ipv6IpRange string,
+	ipv6GatewayIP net.IP,
+	labels map[string]string,
+) (id string, err error) {
+	ipamConfig := []network.IPAMConfig{
+		{
+			Subnet:     subnetMask,
+			IPRange:    ipRange,
+			Gateway:    gatewayIP.String(),
+			AuxAddress: nil,
+		},
+		{
+			Subnet:     ipv6SubnetMask,
+			IPRange:    ipv6IpRange,
+			Gateway:    ipv6GatewayIP.String(),
+			AuxAddress: nil,
+		},
+	}
+
+	networkId, err := manager.createNetworkWithIpamConfig(context, name, ipamConfig, isIpv6EnabledInDualStackNetworks, labels)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to create network %s with subnets %s and %s", name, subnetMask, ipv6SubnetMask)
+	}
+	return networkId, nil
 }
 
 func (manager *DockerManager) ListNetworks(ctx context.Context) ([]types.NetworkResource, error) {
@@ -471,7 +509,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// note a nil network config would connect to bridge network by default
 	var networkConfig *network.NetworkingConfig
 	if args.staticIp != nil && args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), args.alias)
+		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), getIpAddressStr(args.staticIpv6), args.alias)
 		endpointSettingsByNetworkId := map[string]*network.EndpointSettings{}
 		endpointSettingsByNetworkId[args.networkId] = targetNetworkEndPointSettings
 		networkConfig = &network.NetworkingConfig{
@@ -503,12 +541,22 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// static ip is provided and the user wants the connection to bridge network to happen
 	// in the container start the bridge network got connected and now we connect to target network
 	if args.staticIp != nil && !args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		if err = manager.ConnectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.alias); err != nil {
+		if err = manager.connectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.staticIpv6, args.alias); err != nil {
 			return "", nil, stacktrace.Propagate(err, "Failed to connect container %s to network.", containerId)
 		}
 	}
 	// TODO defer a disconnct-from-network if this function doesn't succeed??
 
+	if len(args.filesToCopyBeforeStart) > 0 {
+		filesTar, err := getTarOfFilesToCopy(args.filesToCopyBeforeStart)
+		if err != nil {
+			return "", nil, stacktrace.Propagate(err, "An error occurred archiving the files to copy to container '%v'", containerId)
+		}
+		if err = manager.CopyToContainer(ctx, containerId, containerRootDirpath, filesTar); err != nil {
+			return "", nil, stacktrace.Propagate(err, "An error occurred copying the files to container '%v' before starting it", containerId)
+		}
+	}
+
 	options := types.ContainerStartOptions{
 		CheckpointID:  "",
 		CheckpointDir: "",
@@ -702,6 +750,26 @@ func (manager *DockerManager) StopContainer(context context.Context, containerId
 	return nil
 }
 
+/*
+StartContainer
+Starts again the stopped container with the given ID. The container keeps the configuration it was created with,
+including its static IP addresses in the networks it is connected to
+
+Args:
+
+	context: The context that the start runs in
+	containerId: ID of Docker container to start
+*/
+func (manager *DockerManager) StartContainer(ctx context.Context, containerId string) error {
+	if err := manager.dockerClient.ContainerStart(ctx, containerId, types.ContainerStartOptions{
+		CheckpointID:  "",
+		CheckpointDir: "",
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting container with ID '%v'", containerId)
+	}
+	return nil
+}
+
 /*
 KillContainer
 Kills the container with the given ID if it's running, giving it no opportunity to gracefully exit
@@ -837,6 +905,29 @@ RunExecCommand
 Executes the given command inside the container with the given ID, blocking until the command completes
 */
 func (manager *DockerManager) RunExecCommand(context context.Context, containerId string, command []string, logOutput io.Writer) (int32, error) {
+	concurrentWriter := concurrent_writer.NewConcurrentWriter(logOutput)
+	exitCode, err := manager.runExecCommand(context, containerId, command, concurrentWriter, concurrentWriter)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running exec command '%v' on container '%v'", command, containerId)
+	}
+	return exitCode, nil
+}
+
+/*
+RunExecCommandWithSeparatedOutput
+Like RunExecCommand, but only writes the stdout of the command to the output, as it comes, returning its stderr
+separately. It is meant for commands producing binary output, like 'tcpdump'
+*/
+func (manager *DockerManager) RunExecCommandWithSeparatedOutput(context context.Context, containerId string, command []string, output io.Writer) (int32, string, error) {
+	errorOutput := &bytes.Buffer{}
+	exitCode, err := manager.runExecCommand(context, containerId, command, output, errorOutput)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred running exec command '%v' on container '%v'", command, containerId)
+	}
+	return exitCode, errorOutput.String(), nil
+}
+
+func (manager *DockerManager) runExecCommand(context context.Context, containerId string, command []string, stdoutOutput io.Writer, stderrOutput io.Writer) (int32, error) {
 	dockerClient := manager.dockerClient
 	execConfig := types.ExecConfig{
 		User:         "",
@@ -885,8 +976,7 @@ func (manager *DockerManager) RunExecCommand(context context.Context, containerI
 
 	// NOTE: We have to demultiplex the logs that come back
 	// This will keep reading until it receives EOF
-	concurrentWriter := concurrent_writer.NewConcurrentWriter(logOutput)
-	if _, err := stdcopy.StdCopy(concurrentWriter, concurrentWriter, attachResp.Reader); err != nil {
+	if _, err := stdcopy.StdCopy(stdoutOutput, stderrOutput, attachResp.Reader); err != nil {
 		return 0, stacktrace.Propagate(
 			err,
 			"An error occurred copying the exec command output to the given output writer")
@@ -915,20 +1005,24 @@ Connects the container with the given container ID to the network with the given
 If the IP address passed is nil then we get a random ip address
 */
 func (manager *DockerManager) ConnectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, alias string) (err error) {
+	if err = manager.connectContainerToNetwork(ctx, networkId, containerId, staticIpAddr, noStaticIpv6Addr, alias); err != nil {
+		return stacktrace.Propagate(err, "Failed to connect container %s to network with ID %s.", containerId, networkId)
+	}
+	return nil
+}
+
+// connectContainerToNetwork is ConnectContainerToNetwork with an optional static IPv6 address for dual-stack networks
+func (manager *DockerManager) connectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, staticIpv6Addr net.IP, alias string) error {
 	logrus.Tracef(
-		"Connecting container ID %v to network ID %v using static IP %v",
+		"Connecting container ID %v to network ID %v using static IP %v and static IPv6 %v",
 		containerId,
 		networkId,
-		staticIpAddr.String())
-
-	staticIpAddressStr := ""
-	if staticIpAddr != nil {
-		staticIpAddressStr = staticIpAddr.String()
-	}
+		staticIpAddr.String(),
+		staticIpv6Addr.String())
 
-	config := getEndpointSettingsForIpAddress(staticIpAddressStr, alias)
+	config := getEndpointSettingsForIpAddress(getIpAddressStr(staticIpAddr), getIpAddressStr(staticIpv6Addr), alias)
 
-	err = manager.dockerClient.NetworkConnect(
+	err := manager.dockerClient.NetworkConnect(
 		ctx,
 		networkId,
 		containerId,
@@ -1071,6 +1165,61 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// CopyToContainer extracts the given TAR'd files inside the container, under the given destination directory
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, destDirpath string, tarContent io.Reader) error {
+	copyOptions := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                false,
+	}
+	if err := manager.dockerClient.CopyToContainer(ctx, containerId, destDirpath, tarContent, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to directory '%v' of container with ID '%v'", destDirpath, containerId)
+	}
+	return nil
+}
+
+// CommitContainer creates an image with the given name out of the current filesystem of the container. The container
+// is paused while the image is being created
+func (manager *DockerManager) CommitContainer(ctx context.Context, containerId string, imageName string) error {
+	commitOptions := types.ContainerCommitOptions{
+		Reference: imageName,
+		Comment:   "",
+		Author:    "",
+		Changes:   nil,
+		Pause:     shouldPauseContainerWhenCommitting,
+		Config:    nil,
+	}
+	if _, err := manager.dockerClient.ContainerCommit(ctx, containerId, commitOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred committing container with ID '%v' to image '%v'", containerId, imageName)
+	}
+	return nil
+}
+
+// SaveImages returns a io.ReadCloser representing the bytes of the TAR archive holding the given images, in the format
+// accepted by LoadImages
+// The caller must close the result
+func (manager *DockerManager) SaveImages(ctx context.Context, imageNames []string) (io.ReadCloser, error) {
+	imagesReadCloser, err := manager.dockerClient.ImageSave(ctx, imageNames)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred saving images '%+v'", imageNames)
+	}
+	return imagesReadCloser, nil
+}
+
+// LoadImages loads the images of a TAR archive produced by SaveImages
+func (manager *DockerManager) LoadImages(ctx context.Context, imagesTarContent io.Reader) error {
+	loadResponse, err := manager.dockerClient.ImageLoad(ctx, imagesTarContent, shouldLoadImagesQuietly)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading images")
+	}
+	defer loadResponse.Body.Close()
+
+	// The errors happening while the images are being loaded are only reported in the response body
+	if err := jsonmessage.DisplayJSONMessagesStream(loadResponse.Body, io.Discard, 0, false, nil); err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading images")
+	}
+	return nil
+}
+
 // =================================================================================================================
 //
 //	INSTANCE HELPER FUNCTIONS
@@ -1576,27 +1725,71 @@ func newNetworkListFromDockerNetworkList(dockerNetworks []types.NetworkResource)
 	return networks, nil
 }
 
+func (manager *DockerManager) createNetworkWithIpamConfig(context context.Context, name string, ipamConfig []network.IPAMConfig, isIpv6Enabled bool, labels map[string]string) (string, error) {
+	resp, err := manager.dockerClient.NetworkCreate(context, name, types.NetworkCreate{
+		CheckDuplicate: false,
+		Driver:         dockerNetworkDriver,
+		Scope:          "",
+		EnableIPv6:     isIpv6Enabled,
+		IPAM: &network.IPAM{
+			Driver:  "",
+			Options: nil,
+			Config:  ipamConfig,
+		},
+		Internal:   false,
+		Attachable: false,
+		Ingress:    false,
+		ConfigOnly: false,
+		ConfigFrom: nil,
+		Options:    nil,
+		Labels:     labels,
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating network '%s'", name)
+	}
+	return resp.ID, nil
+}
+
 func newNetworkFromDockerNetwork(dockerNetwork types.NetworkResource) (*docker_manager_types.Network, error) {
 	if len(dockerNetwork.IPAM.Config) == 0 {
 		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contains any IPAM config.", dockerNetwork.ID)
 	}
-	if len(dockerNetwork.IPAM.Config) > 1 {
-		return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
-	}
-	firstIpamConfig := dockerNetwork.IPAM.Config[0]
 
-	_, ipAndMask, err := net.ParseCIDR(firstIpamConfig.Subnet)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", firstIpamConfig.Subnet)
+	// Dual-stack networks have one IPAM config for each IP family
+	var ipAndMask *net.IPNet
+	var gatewayIp string
+	var ipv6AndMask *net.IPNet
+	var ipv6GatewayIp string
+	for _, ipamConfig := range dockerNetwork.IPAM.Config {
+		_, subnet, err := net.ParseCIDR(ipamConfig.Subnet)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", ipamConfig.Subnet)
+		}
+		if subnet.IP.To4() != nil {
+			if ipAndMask != nil {
+				return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPv4 IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
+			}
+			ipAndMask = subnet
+			gatewayIp = ipamConfig.Gateway
+		} else {
+			if ipv6AndMask != nil {
+				return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPv6 IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
+			}
+			ipv6AndMask = subnet
+			ipv6GatewayIp = ipamConfig.Gateway
+		}
+	}
+	if ipAndMask == nil {
+		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contain any IPv4 IPAM config.", dockerNetwork.ID)
 	}
-
-	gatewayIp := firstIpamConfig.Gateway
 
 	networkWrapper := docker_manager_types.NewNetwork(
 		dockerNetwork.Name,
 		dockerNetwork.ID,
 		ipAndMask,
 		gatewayIp,
+		ipv6AndMask,
+		ipv6GatewayIp,
 		dockerNetwork.Labels,
 	)
 
@@ -1636,6 +1829,14 @@ func (manager *DockerManager) getFailedContainerLogsOrErrorString(ctx context.Co
 	return containerLogs
 }
 
+// getIpAddressStr returns an empty string, which Docker interprets as "no static IP", for nil IPs
+func getIpAddressStr(ipAddr net.IP) string {
+	if ipAddr == nil {
+		return ""
+	}
+	return ipAddr.String()
+}
+
 func convertMegabytesToBytes(value uint64) uint64 {
 	return value * megabytesToBytesFactor
 }
@@ -1646,10 +1847,10 @@ func convertMillicpusToNanoCPUs(value uint64) uint64 {
 	return value * millicpusToNanoCPUsFactor
 }
 
-func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.EndpointSettings {
+func getEndpointSettingsForIpAddress(ipAddress string, ipv6Address string, alias string) *network.EndpointSettings {
 	ipamConfig := &network.EndpointIPAMConfig{
 		IPv4Address:  ipAddress,
-		IPv6Address:  "",
+		IPv6Address:  ipv6Address,
 		LinkLocalIPs: nil,
 	}
 
@@ -1676,3 +1877,30 @@ func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.En
 
 	return config
 }
+
+func getTarOfFilesToCopy(filesToCopy map[string][]byte) (io.Reader, error) {
+	tarBuffer := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(tarBuffer)
+	for filepath, content := range filesToCopy {
+		if !strings.HasPrefix(filepath, containerRootDirpath) {
+			return nil, stacktrace.NewError("Filepath '%v' of a file to copy to a container isn't absolute", filepath)
+		}
+		header := &tar.Header{ //nolint:exhaustruct
+			Typeflag: tar.TypeReg,
+			Name:     strings.TrimPrefix(filepath, containerRootDirpath),
+			Mode:     filesToCopyBeforeStartMode,
+			Size:     int64(len(content)),
+			ModTime:  time.Now(),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred writing the TAR header of file '%v'", filepath)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred writing the content of file '%v' to the TAR", filepath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred closing the TAR of the files to copy")
+	}
+	return tarBuffer, nil
+}
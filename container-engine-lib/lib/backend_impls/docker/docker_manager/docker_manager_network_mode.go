@@ -9,12 +9,16 @@ import "github.com/docker/docker/api/types/container"
 
 const (
 	defaultNetworkModeStr = "default"
+	hostNetworkModeStr    = "host"
 )
 
 type DockerManagerNetworkMode container.NetworkMode
 
 var DefaultNetworkMode = DockerManagerNetworkMode(defaultNetworkModeStr)
 
+// HostNetworkMode runs the container in the network namespace of the machine running Docker
+var HostNetworkMode = DockerManagerNetworkMode(hostNetworkModeStr)
+
 func NewContainerNetworkMode(containerId string) DockerManagerNetworkMode {
 	str := "container:" + containerId
 	return DockerManagerNetworkMode(str)
@@ -1,10 +1,12 @@
 package docker_manager
 
 import (
+	"archive/tar"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"io"
 	"testing"
 )
 
@@ -141,3 +143,24 @@ func TestCorrectSelectionWhenTwoOfSameIPs(t *testing.T) {
 	require.Equal(t, "127.0.0.1", publicBinding2.HostIP)
 	require.Equal(t, "9711", publicBinding2.HostPort)
 }
+
+func TestGetTarOfFilesToCopy(t *testing.T) {
+	filesTar, err := getTarOfFilesToCopy(map[string][]byte{"/run/secrets/credentials.json": []byte("secret")})
+	require.NoError(t, err)
+
+	tarReader := tar.NewReader(filesTar)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "run/secrets/credentials.json", header.Name)
+	require.Equal(t, int64(filesToCopyBeforeStartMode), header.Mode)
+	content, err := io.ReadAll(tarReader)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), content)
+	_, err = tarReader.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestGetTarOfFilesToCopy_RelativeFilepathFails(t *testing.T) {
+	_, err := getTarOfFilesToCopy(map[string][]byte{"credentials.json": []byte("secret")})
+	require.Error(t, err)
+}
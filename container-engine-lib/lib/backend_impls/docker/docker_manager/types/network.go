@@ -3,16 +3,18 @@ package types
 import "net"
 
 type Network struct {
-	name      string
-	id        string
-	ipAndMask *net.IPNet
-	gatewayIpStr   string
+	name         string
+	id           string
+	ipAndMask    *net.IPNet
+	gatewayIpStr string
+	// Nil if the network is IPv4-only
+	ipv6AndMask      *net.IPNet
+	ipv6GatewayIpStr string
 	labels           map[string]string
-
 }
 
-func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, labels map[string]string) *Network {
-	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, labels: labels}
+func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, ipv6AndMask *net.IPNet, ipv6GatewayIpStr string, labels map[string]string) *Network {
+	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, ipv6AndMask: ipv6AndMask, ipv6GatewayIpStr: ipv6GatewayIpStr, labels: labels}
 }
 
 func (network Network) GetName() string {
@@ -31,6 +33,16 @@ func (network Network) GetGatewayIp() string {
 	return network.gatewayIpStr
 }
 
+// GetIpv6AndMask returns the IPv6 subnet of a dual-stack network, and nil if the network is IPv4-only
+func (network Network) GetIpv6AndMask() *net.IPNet {
+	return network.ipv6AndMask
+}
+
+// GetIpv6GatewayIp returns an empty string if the network is IPv4-only
+func (network Network) GetIpv6GatewayIp() string {
+	return network.ipv6GatewayIpStr
+}
+
 func (network Network) GetLabels() map[string]string {
 	return network.labels
 }
@@ -28,9 +28,35 @@ const (
 	maxNumNetworkAllocationRetries = 10
 
 	timeBetweenNetworkCreationRetries = 1 * time.Second
+
+	// Like the IPv6 networks below, the networks are split in two halves: Kurtosis statically assigns the IPs of the first
+	// half to the user services, and Docker dynamically assigns the ones of the second half to the containers joining the
+	// network without a static IP (e.g. the port forwards), so that the two never collide
+	halfNetworkWidthBits = networkWidthBits - 1
+
+	// IPv6 networks are /64 subnets of the unique local address space fd00::/8, whose 40-bit global ID and 16-bit
+	// subnet ID are random, as per RFC 4193
+	ipv6AddrBitLength             = 128
+	ipv6NetworkPrefixBitLength    = 64
+	ipv6AddrByteLength            = 16
+	uniqueLocalIpv6AddrPrefixByte = 0xfd
+	numIpv6NetworkRandomBytes     = 7
+
+	// The IPv6 address space is so big that colliding networks are extremely unlikely, so we don't need to walk it
+	maxNumIpv6NetworkRolls = 100
+
+	// Only the containers of the enclave that aren't user services (e.g. the API container) get their IPv6 from
+	// Docker, so we split IPv6 networks in two halves: Kurtosis statically assigns the IPv6s of the first half and Docker
+	// dynamically assigns the ones of the second half, so that the two never collide
+	ipv6HalfNetworkPrefixBitLength = ipv6NetworkPrefixBitLength + 1
+	ipv6HalfNetworkFirstByteIdx    = ipv6NetworkPrefixBitLength / 8
+	ipv6SecondHalfNetworkBitMask   = 0x80
 )
 
 var networkCidrMask = net.CIDRMask(int(supportedIpAddrBitLength-networkWidthBits), int(supportedIpAddrBitLength))
+var halfNetworkCidrMask = net.CIDRMask(int(supportedIpAddrBitLength-halfNetworkWidthBits), int(supportedIpAddrBitLength))
+var ipv6NetworkCidrMask = net.CIDRMask(ipv6NetworkPrefixBitLength, ipv6AddrBitLength)
+var ipv6HalfNetworkCidrMask = net.CIDRMask(ipv6HalfNetworkPrefixBitLength, ipv6AddrBitLength)
 var networkWidthUint64 = uint64(math.Pow(float64(2), float64(networkWidthBits)))
 var maxUint32PlusOne = uint64(math.MaxUint32) + 1
 var emptyIpSet = map[string]bool{}
@@ -133,6 +159,7 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 	ctx context.Context,
 	networkName string,
 	labels map[string]string,
+	isIpv6Enabled bool,
 ) (resultNetworkId string, resultErr error) {
 	if !provider.isConstructedViaConstructor {
 		return "", stacktrace.NewError("This instance of Docker network allocator was constructed without the constructor, which means that the rand.Seed won't have been initialized!")
@@ -146,6 +173,7 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 		}
 
 		usedSubnets := []*net.IPNet{}
+		usedIpv6Subnets := []*net.IPNet{}
 		for _, network := range networks {
 			for _, ipamConfig := range network.IPAM.Config {
 				subnetCidrStr := ipamConfig.Subnet
@@ -158,7 +186,11 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 						network.Name,
 					)
 				}
-				usedSubnets = append(usedSubnets, parsedSubnet)
+				if parsedSubnet.IP.To4() == nil {
+					usedIpv6Subnets = append(usedIpv6Subnets, parsedSubnet)
+				} else {
+					usedSubnets = append(usedSubnets, parsedSubnet)
+				}
 			}
 		}
 
@@ -172,7 +204,24 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 			return "", stacktrace.Propagate(err, "An error occurred getting a free IP for the network gateway")
 		}
 
-		networkId, err := provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), gatewayIp, labels)
+		dynamicIpRange := getDynamicIpSubnet(freeNetworkIpAndMask)
+		var networkId string
+		if isIpv6Enabled {
+			freeIpv6NetworkIpAndMask, err := findRandomFreeIpv6Network(usedIpv6Subnets)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred finding a free IPv6 network")
+			}
+
+			ipv6GatewayIp, err := network_helpers.GetFreeIpAddrFromSubnet(emptyIpSet, freeIpv6NetworkIpAndMask)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred getting a free IPv6 for the network gateway")
+			}
+
+			dynamicIpv6Range := getDynamicIpv6Subnet(freeIpv6NetworkIpAndMask)
+			networkId, err = provider.dockerManager.CreateDualStackNetwork(ctx, networkName, freeNetworkIpAndMask.String(), dynamicIpRange.String(), gatewayIp, freeIpv6NetworkIpAndMask.String(), dynamicIpv6Range.String(), ipv6GatewayIp, labels)
+		} else {
+			networkId, err = provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), dynamicIpRange.String(), gatewayIp, labels)
+		}
 		if err == nil {
 			return networkId, nil
 		}
@@ -270,6 +319,73 @@ func findRandomFreeNetwork(networks []*net.IPNet) (*net.IPNet, error) {
 	return nil, stacktrace.NewError("There is no IP address space available for a new network with %v bits of width", networkWidthBits)
 }
 
+// findRandomFreeIpv6Network returns a random unique local IPv6 network that doesn't collide with the given networks
+func findRandomFreeIpv6Network(networks []*net.IPNet) (*net.IPNet, error) {
+	for numRolls := 0; numRolls < maxNumIpv6NetworkRolls; numRolls++ {
+		resultNetworkIp := make([]byte, ipv6AddrByteLength)
+		resultNetworkIp[0] = uniqueLocalIpv6AddrPrefixByte
+		// The rest of the bytes are zero, as they're the interface IDs of the network
+		if _, err := rand.Read(resultNetworkIp[1 : 1+numIpv6NetworkRandomBytes]); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred generating a random IPv6 network")
+		}
+		resultNetwork := &net.IPNet{
+			IP:   resultNetworkIp,
+			Mask: ipv6NetworkCidrMask,
+		}
+
+		hasCollision := false
+		for _, network := range networks {
+			if resultNetwork.Contains(network.IP) || network.Contains(resultNetworkIp) {
+				hasCollision = true
+				break
+			}
+		}
+		if !hasCollision {
+			return resultNetwork, nil
+		}
+	}
+
+	return nil, stacktrace.NewError("Couldn't find a free IPv6 network even after trying %v random networks", maxNumIpv6NetworkRolls)
+}
+
+// GetStaticIpSubnet returns the part of an IPv4 network created by this allocator from which static IPs can be
+// assigned to containers without colliding with the IPs that Docker assigns
+func GetStaticIpSubnet(network *net.IPNet) *net.IPNet {
+	return &net.IPNet{
+		IP:   network.IP,
+		Mask: halfNetworkCidrMask,
+	}
+}
+
+func getDynamicIpSubnet(network *net.IPNet) *net.IPNet {
+	dynamicSubnetIpUint32 := binary.BigEndian.Uint32(network.IP.To4()) | uint32(1)<<halfNetworkWidthBits
+	dynamicSubnetIp := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(dynamicSubnetIp, dynamicSubnetIpUint32)
+	return &net.IPNet{
+		IP:   dynamicSubnetIp,
+		Mask: halfNetworkCidrMask,
+	}
+}
+
+// GetStaticIpv6Subnet returns the part of an IPv6 network created by this allocator from which static IPv6s can be
+// assigned to containers without colliding with the IPv6s that Docker assigns
+func GetStaticIpv6Subnet(ipv6Network *net.IPNet) *net.IPNet {
+	return &net.IPNet{
+		IP:   ipv6Network.IP,
+		Mask: ipv6HalfNetworkCidrMask,
+	}
+}
+
+func getDynamicIpv6Subnet(ipv6Network *net.IPNet) *net.IPNet {
+	dynamicSubnetIp := make(net.IP, ipv6AddrByteLength)
+	copy(dynamicSubnetIp, ipv6Network.IP.To16())
+	dynamicSubnetIp[ipv6HalfNetworkFirstByteIdx] |= ipv6SecondHalfNetworkBitMask
+	return &net.IPNet{
+		IP:   dynamicSubnetIp,
+		Mask: ipv6HalfNetworkCidrMask,
+	}
+}
+
 func isIpInDisallowedRange(ipUint32 uint32) bool {
 	for _, disallowedRange := range disallowedIpRanges {
 		rangeStartBytes := disallowedRange[0]
@@ -12,7 +12,7 @@ func TestErrorOnInstantiationWithoutConstructor(t *testing.T) {
 		isConstructedViaConstructor: false,
 		dockerManager:               nil,
 	}
-	_, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{})
+	_, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{}, false)
 	assert.Error(t, err)
 }
 
@@ -94,6 +94,53 @@ func TestNetworkFoundOnVariousCases(t *testing.T) {
 	}
 }
 
+func TestIpv6NetworkFound(t *testing.T) {
+	networks := parseNetworks(t, []string{
+		"1.0.0.0/20",
+		"fd00::/8",
+	})
+	_, err := findRandomFreeIpv6Network(networks)
+	assert.Error(t, err)
+
+	networks = parseNetworks(t, []string{
+		"1.0.0.0/20",
+		"fd12:3456:789a:1::/64",
+	})
+	result, err := findRandomFreeIpv6Network(networks)
+	assert.NoError(t, err)
+
+	_, uniqueLocalAddrSpace, err := net.ParseCIDR("fd00::/8")
+	assert.NoError(t, err)
+	assert.True(t, uniqueLocalAddrSpace.Contains(result.IP))
+	maskNumOnes, maskTotalBits := result.Mask.Size()
+	assert.Equal(t, ipv6NetworkPrefixBitLength, maskNumOnes)
+	assert.Equal(t, ipv6AddrBitLength, maskTotalBits)
+}
+
+func TestStaticAndDynamicIpv6SubnetsDontOverlap(t *testing.T) {
+	_, ipv6Network, err := net.ParseCIDR("fd12:3456:789a:1::/64")
+	assert.NoError(t, err)
+
+	staticSubnet := GetStaticIpv6Subnet(ipv6Network)
+	dynamicSubnet := getDynamicIpv6Subnet(ipv6Network)
+	assert.Equal(t, "fd12:3456:789a:1::/65", staticSubnet.String())
+	assert.Equal(t, "fd12:3456:789a:1:8000::/65", dynamicSubnet.String())
+	assert.False(t, staticSubnet.Contains(dynamicSubnet.IP))
+	assert.False(t, dynamicSubnet.Contains(staticSubnet.IP))
+}
+
+func TestStaticAndDynamicIpSubnetsDontOverlap(t *testing.T) {
+	_, network, err := net.ParseCIDR("1.2.16.0/20")
+	assert.NoError(t, err)
+
+	staticSubnet := GetStaticIpSubnet(network)
+	dynamicSubnet := getDynamicIpSubnet(network)
+	assert.Equal(t, "1.2.16.0/21", staticSubnet.String())
+	assert.Equal(t, "1.2.24.0/21", dynamicSubnet.String())
+	assert.False(t, staticSubnet.Contains(dynamicSubnet.IP))
+	assert.False(t, dynamicSubnet.Contains(staticSubnet.IP))
+}
+
 func assertExpectedResultGivenCidrs(t *testing.T, cidrs []string, expectedIp net.IP) {
 	networks := parseNetworks(t, cidrs)
 	result, err := findRandomFreeNetwork(networks)
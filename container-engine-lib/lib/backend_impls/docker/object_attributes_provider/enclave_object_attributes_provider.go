@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -21,9 +22,13 @@ const (
 	networkingSidecarContainerNameFragment = "networking-sidecar"
 	artifactExpansionVolumeNameFragment    = "files-artifact-expansion"
 	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
+	enclaveFirewallContainerNameFragment   = "enclave-firewall"
+	portForwardContainerNameFragment       = "port-forward"
 	logsCollectorFragment                  = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
+
+	decimalBase = 10
 )
 
 type DockerEnclaveObjectAttributesProvider interface {
@@ -40,6 +45,7 @@ type DockerEnclaveObjectAttributesProvider interface {
 		serviceName service.ServiceName,
 		serviceUuid service.ServiceUUID,
 		privateIpAddr net.IP,
+		privateIpv6Addr net.IP, // Nil if the enclave isn't an IPv6 enclave
 		privatePorts map[string]*port_spec.PortSpec,
 	) (DockerObjectAttributes, error)
 	ForNetworkingSidecarContainer(
@@ -51,6 +57,12 @@ type DockerEnclaveObjectAttributesProvider interface {
 	ForSingleFilesArtifactExpansionVolume(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
+	ForEnclaveFirewallContainer() (DockerObjectAttributes, error)
+	ForPortForwardContainer(
+		serviceUuid service.ServiceUUID,
+		portId string,
+		localPortNumber uint16,
+	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
 }
@@ -205,6 +217,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	serviceName service.ServiceName,
 	serviceUuid service.ServiceUUID,
 	privateIpAddr net.IP,
+	privateIpv6Addr net.IP,
 	privatePorts map[string]*port_spec.PortSpec,
 ) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForUserServiceContainer(
@@ -239,6 +252,17 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.UserServiceContainerTypeDockerLabelValue
 	labels[label_key_consts.PortSpecsDockerLabelKey] = serializedPortsSpec
 	labels[label_key_consts.PrivateIPDockerLabelKey] = privateIpLabelValue
+	if privateIpv6Addr != nil {
+		privateIpv6LabelValue, err := docker_label_value.CreateNewDockerLabelValue(privateIpv6Addr.String())
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred creating a Docker label value object from user service container private IPv6 address '%v'",
+				privateIpv6Addr.String(),
+			)
+		}
+		labels[label_key_consts.PrivateIPv6DockerLabelKey] = privateIpv6LabelValue
+	}
 
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
@@ -369,6 +393,73 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpa
 	return objectAttributes, nil
 }
 
+// There's a single firewall container per enclave, so its name is derived from the enclave UUID only
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveFirewallContainer() (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveFirewallContainerNameFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave firewall Docker container name object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.EnclaveFirewallContainerTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
+// There's at most one port forward per local port, so the local port identifies the container in the enclave
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForPortForwardContainer(
+	serviceUuid service.ServiceUUID,
+	portId string,
+	localPortNumber uint16,
+) (DockerObjectAttributes, error) {
+	localPortNumberStr := strconv.FormatUint(uint64(localPortNumber), decimalBase)
+	name, err := provider.getNameForEnclaveObject([]string{portForwardContainerNameFragment, localPortNumberStr})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the port forward Docker container name object")
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithGUID(string(serviceUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for enclave object with UUID '%v'", serviceUuid)
+	}
+	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.PortForwardContainerTypeDockerLabelValue
+
+	labelValueStrs := map[*docker_label_key.DockerLabelKey]string{
+		label_key_consts.UserServiceGUIDDockerLabelKey: string(serviceUuid),
+		label_key_consts.ForwardedPortIdDockerLabelKey: portId,
+		label_key_consts.LocalPortNumberDockerLabelKey: localPortNumberStr,
+	}
+	for labelKey, labelValueStr := range labelValueStrs {
+		labelValue, err := docker_label_value.CreateNewDockerLabelValue(labelValueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from string '%v'", labelValueStr)
+		}
+		labels[labelKey] = labelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForEnclaveObject([]string{logsCollectorFragment})
 	if err != nil {
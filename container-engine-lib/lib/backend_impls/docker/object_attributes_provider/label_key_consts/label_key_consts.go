@@ -37,6 +37,12 @@ const (
 	isNetworkPartitioningEnabledKeyStr = labelNamespaceStr + "is-network-partitioning-enabled"
 
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
+	// Only set on the user services of IPv6 enclaves
+	privateIpv6AddrLabelKeyStr = labelNamespaceStr + "private-ipv6"
+
+	// The private port of the user service a port forward sends the traffic to, and the local port it listens on
+	forwardedPortIdLabelKeyStr = labelNamespaceStr + "forwarded-port-id"
+	localPortNumberLabelKeyStr = labelNamespaceStr + "local-port"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -59,4 +65,7 @@ var EnclaveNameDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enc
 var EnclaveCreationTimeLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveCreationTime)
 var IsNetworkPartitioningEnabledDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(isNetworkPartitioningEnabledKeyStr)
 var PrivateIPDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
+var PrivateIPv6DockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(privateIpv6AddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
+var ForwardedPortIdDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(forwardedPortIdLabelKeyStr)
+var LocalPortNumberDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(localPortNumberLabelKeyStr)
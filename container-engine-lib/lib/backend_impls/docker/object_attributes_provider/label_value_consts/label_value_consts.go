@@ -21,6 +21,8 @@ const (
 	userServiceContainerTypeLabelValueStr            = "user-service"
 	networkingSidecarContainerTypeLabelValueStr      = "networking-sidecar"
 	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
+	enclaveFirewallContainerTypeLabelValueStr        = "enclave-firewall"
+	portForwardContainerTypeLabelValueStr            = "port-forward"
 
 	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
 	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
@@ -50,6 +52,8 @@ var NetworkingSidecarContainerTypeDockerLabelValue = docker_label_value.MustCrea
 var NetworkPartitioningEnabledDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(trueValueStr)
 var NetworkPartitioningDisabledDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(falseValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
+var EnclaveFirewallContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveFirewallContainerTypeLabelValueStr)
+var PortForwardContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(portForwardContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
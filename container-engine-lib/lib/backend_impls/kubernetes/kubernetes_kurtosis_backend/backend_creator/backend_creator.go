@@ -0,0 +1,130 @@
+package backend_creator
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/stacktrace"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"os"
+	"path/filepath"
+)
+
+const (
+	kubeConfigEnvVar = "KUBECONFIG"
+
+	kubeConfigDirname  = ".kube"
+	kubeConfigFilename = "config"
+
+	// Only the engine creates enclaves, so the other Kubernetes backends never use these
+	unusedStorageClass           = ""
+	unusedEnclaveSizeInMegabytes = 0
+)
+
+// GetCLIBackend returns a backend connected to the cluster of the current context of the local kubeconfig, which is
+// the one pointed to by the KUBECONFIG environment variable if set, and ~/.kube/config otherwise
+func GetCLIBackend(_ context.Context) (backend_interface.KurtosisBackend, error) {
+	kubernetesKurtosisBackend, err := getCLIKubernetesKurtosisBackend()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend of the CLI")
+	}
+	return metrics_reporting.NewMetricsReportingKurtosisBackend(kubernetesKurtosisBackend), nil
+}
+
+// RunCLIGateway connects the local machine to the engine and the API containers of the cluster of the current context
+// of the local kubeconfig, until the context is cancelled
+func RunCLIGateway(ctx context.Context) error {
+	kubernetesKurtosisBackend, err := getCLIKubernetesKurtosisBackend()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend of the gateway")
+	}
+	if err := kubernetesKurtosisBackend.RunGateway(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the gateway")
+	}
+	return nil
+}
+
+// GetEngineServerBackend returns a backend for the engine, which runs inside the cluster
+func GetEngineServerBackend(_ context.Context, storageClass string, enclaveSizeInMegabytes uint) (backend_interface.KurtosisBackend, error) {
+	kubernetesRestConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the in-cluster Kubernetes configuration of the engine")
+	}
+	backend, err := getWrappedKubernetesKurtosisBackend(kubernetesRestConfig, storageClass, enclaveSizeInMegabytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend of the engine")
+	}
+	return backend, nil
+}
+
+// GetApiContainerBackend returns a backend for the API container, which runs inside the cluster
+func GetApiContainerBackend(_ context.Context) (backend_interface.KurtosisBackend, error) {
+	kubernetesRestConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the in-cluster Kubernetes configuration of the API container")
+	}
+	backend, err := getWrappedKubernetesKurtosisBackend(kubernetesRestConfig, unusedStorageClass, unusedEnclaveSizeInMegabytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend of the API container")
+	}
+	return backend, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getCLIKubernetesKurtosisBackend() (*kubernetes_kurtosis_backend.KubernetesKurtosisBackend, error) {
+	kubeConfigFilepath, err := getKubeConfigFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the path to the kubeconfig file")
+	}
+	kubernetesRestConfig, err := clientcmd.BuildConfigFromFlags("", kubeConfigFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the Kubernetes configuration from kubeconfig file '%v'", kubeConfigFilepath)
+	}
+	kubernetesKurtosisBackend, err := getKubernetesKurtosisBackend(kubernetesRestConfig, unusedStorageClass, unusedEnclaveSizeInMegabytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend from kubeconfig file '%v'", kubeConfigFilepath)
+	}
+	return kubernetesKurtosisBackend, nil
+}
+
+func getWrappedKubernetesKurtosisBackend(kubernetesRestConfig *rest.Config, storageClass string, enclaveSizeInMegabytes uint) (backend_interface.KurtosisBackend, error) {
+	kubernetesKurtosisBackend, err := getKubernetesKurtosisBackend(kubernetesRestConfig, storageClass, enclaveSizeInMegabytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes backend")
+	}
+
+	wrappedBackend := metrics_reporting.NewMetricsReportingKurtosisBackend(kubernetesKurtosisBackend)
+
+	return wrappedBackend, nil
+}
+
+func getKubernetesKurtosisBackend(kubernetesRestConfig *rest.Config, storageClass string, enclaveSizeInMegabytes uint) (*kubernetes_kurtosis_backend.KubernetesKurtosisBackend, error) {
+	kubernetesClientSet, err := kubernetes.NewForConfig(kubernetesRestConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes client set")
+	}
+	podExecutor := kubernetes_manager.NewRemoteCommandPodExecutor(kubernetesClientSet, kubernetesRestConfig)
+	podPortForwarder := kubernetes_manager.NewSpdyPodPortForwarder(kubernetesClientSet, kubernetesRestConfig)
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(kubernetesClientSet, podExecutor, podPortForwarder)
+
+	return kubernetes_kurtosis_backend.NewKubernetesKurtosisBackend(kubernetesManager, storageClass, enclaveSizeInMegabytes), nil
+}
+
+func getKubeConfigFilepath() (string, error) {
+	if kubeConfigFilepath := os.Getenv(kubeConfigEnvVar); kubeConfigFilepath != "" {
+		return kubeConfigFilepath, nil
+	}
+	homeDirpath, err := os.UserHomeDir()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the home directory of the user")
+	}
+	return filepath.Join(homeDirpath, kubeConfigDirname, kubeConfigFilename), nil
+}
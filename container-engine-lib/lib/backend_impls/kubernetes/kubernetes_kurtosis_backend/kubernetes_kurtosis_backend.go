@@ -0,0 +1,91 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"sync"
+)
+
+// KubernetesKurtosisBackend maps the Kurtosis objects to Kubernetes ones:
+//   - the engine and each enclave live in their own namespace
+//   - the engine, the API containers and the user services are pods, exposed through a ClusterIP service whose IP
+//     is the private IP of the Kurtosis object
+//   - the enclave data lives in a persistent volume claim mounted by the API container
+//   - the networking sidecar of a user service is a privileged container of the pod of the service
+//   - the engine and the API containers are reached from outside the cluster through the gateway, see RunGateway
+//
+// The Kubernetes backend isn't a drop-in replacement of the Docker one: the cluster has no equivalent of pausing a
+// container, saving and loading images or committing a container to an image, so these operations fail, and so do the
+// enclave snapshots relying on them. The logs of the services are read from their pods, there is no logs database or
+// logs collector.
+type KubernetesKurtosisBackend struct {
+	kubernetesManager *kubernetes_manager.KubernetesManager
+
+	// Held while allocating the gateway ports of an API container, see createApiContainerKubernetesService
+	gatewayPortsMutex *sync.Mutex
+
+	// The storage class of the enclave data volumes; the default storage class of the cluster is used if empty
+	storageClass string
+
+	enclaveSizeInMegabytes uint
+}
+
+func NewKubernetesKurtosisBackend(
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	storageClass string,
+	enclaveSizeInMegabytes uint,
+) *KubernetesKurtosisBackend {
+	return &KubernetesKurtosisBackend{
+		kubernetesManager:      kubernetesManager,
+		gatewayPortsMutex:      &sync.Mutex{},
+		storageClass:           storageClass,
+		enclaveSizeInMegabytes: enclaveSizeInMegabytes,
+	}
+}
+
+// FetchImage is a no-op as images are pulled by the kubelet of the node the pods get scheduled on
+func (backend *KubernetesKurtosisBackend) FetchImage(_ context.Context, _ string) error {
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) SaveImages(_ context.Context, _ []string, _ io.Writer) error {
+	return stacktrace.NewError("Saving images isn't supported by the Kubernetes backend, as the images live in the nodes of the cluster")
+}
+
+func (backend *KubernetesKurtosisBackend) LoadImages(_ context.Context, _ io.Reader) error {
+	return stacktrace.NewError("Loading images isn't supported by the Kubernetes backend, as the images live in the nodes of the cluster")
+}
+
+func (backend *KubernetesKurtosisBackend) CreateLogsDatabase(_ context.Context, _ uint16) (*logs_database.LogsDatabase, error) {
+	return nil, stacktrace.NewError("The Kubernetes backend has no logs database, the logs of the services are read from their pods")
+}
+
+func (backend *KubernetesKurtosisBackend) GetLogsDatabase(_ context.Context) (*logs_database.LogsDatabase, error) {
+	return nil, stacktrace.NewError("The Kubernetes backend has no logs database, the logs of the services are read from their pods")
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyLogsDatabase(_ context.Context) error {
+	return stacktrace.NewError("The Kubernetes backend has no logs database, the logs of the services are read from their pods")
+}
+
+func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(_ context.Context, _ enclave.EnclaveUUID, _ uint16, _ uint16) (*logs_collector.LogsCollector, error) {
+	return nil, stacktrace.NewError("The Kubernetes backend has no logs collector, the logs of the services are read from their pods")
+}
+
+func (backend *KubernetesKurtosisBackend) GetLogsCollectorForEnclave(_ context.Context, _ enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
+	return nil, stacktrace.NewError("The Kubernetes backend has no logs collector, the logs of the services are read from their pods")
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyLogsCollectorForEnclave(_ context.Context, _ enclave.EnclaveUUID) error {
+	return stacktrace.NewError("The Kubernetes backend has no logs collector, the logs of the services are read from their pods")
+}
+
+// DestroyDeprecatedCentralizedLogsResources is a no-op as the centralized logs resources never existed in Kubernetes
+func (backend *KubernetesKurtosisBackend) DestroyDeprecatedCentralizedLogsResources(_ context.Context) error {
+	return nil
+}
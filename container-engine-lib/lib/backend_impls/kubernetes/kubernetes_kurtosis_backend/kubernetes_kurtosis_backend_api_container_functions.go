@@ -0,0 +1,520 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"net"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+const (
+	apiContainerObjectsName          = "kurtosis-api"
+	apiContainerContainerName        = "kurtosis-api"
+	apiContainerGrpcPortName         = "grpc"
+	apiContainerGrpcProxyPortName    = "grpc-proxy"
+	apiContainerDataVolumeIsReadOnly = false
+
+	// The secret files of the API container are the keys of a secret, each of them mounted at its filepath
+	secretFilesVolumeName       = "secret-files"
+	secretFileKeyPrefix         = "secret-file-"
+	secretFilesVolumeIsReadOnly = true
+
+	// The API containers aren't reachable from outside the cluster, so the gateway forwards a pair of ports of the
+	// local machine to each of them, which are the public ports of the API container
+	firstApiContainerGatewayPortNum     = uint16(9730)
+	maxNumberOfApiContainerGatewayPorts = 100
+	gatewayPortNumBase                  = 10
+	gatewayPortNumBitSize               = 16
+)
+
+var apiContainerGatewayIpAddress = net.ParseIP("127.0.0.1")
+
+// The secret files may hold credentials, so only the API container user can read them
+var secretFilesMode = int32(0400)
+
+// The API container manages the user services of its enclave, which all live in the namespace of the enclave
+var apiContainerRoleRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{apiv1.GroupName},
+		Resources: []string{"services", "pods", "pods/exec", "pods/log"},
+		Verbs:     []string{"*"},
+	},
+	{
+		APIGroups: []string{apiv1.GroupName},
+		Resources: []string{"persistentvolumeclaims"},
+		Verbs:     []string{"get", "list"},
+	},
+}
+
+func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
+	ctx context.Context,
+	image string,
+	enclaveUuid enclave.EnclaveUUID,
+	grpcPortNum uint16,
+	grpcProxyPortNum uint16,
+	enclaveDataVolumeDirpath string,
+	ownIpAddressEnvVar string,
+	customEnvVars map[string]string,
+	secretFiles map[string][]byte,
+) (
+	*api_container.APIContainer,
+	error,
+) {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	existingApiContainers, err := backend.GetAPIContainers(ctx, &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{enclaveUuid: true},
+		Statuses:   nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking for existing API containers in enclave '%v'", enclaveUuid)
+	}
+	if len(existingApiContainers) > 0 {
+		return nil, stacktrace.NewError("Cannot create an API container in enclave '%v' because one already exists", enclaveUuid)
+	}
+	if _, found := customEnvVars[ownIpAddressEnvVar]; found {
+		return nil, stacktrace.NewError("Requested own IP environment variable '%v' conflicts with a custom environment variable", ownIpAddressEnvVar)
+	}
+
+	apiContainerLabels := getEnclaveObjectLabels(enclaveUuid, label_value_consts.APIContainerResourceTypeLabelValue)
+	shouldRemoveApiContainer := true
+	defer func() {
+		if shouldRemoveApiContainer {
+			// Use background context so we delete these even if input context was cancelled
+			if err := backend.removeApiContainer(context.Background(), enclaveUuid); err != nil {
+				logrus.Errorf("Creating the API container of enclave '%v' didn't complete successfully so we tried to remove it, but doing so threw an error:\n%v", enclaveUuid, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the '%v' objects in namespace '%v'!!!!!!", apiContainerObjectsName, namespaceName)
+			}
+		}
+	}()
+
+	if _, err := backend.kubernetesManager.CreateServiceAccount(ctx, namespaceName, apiContainerObjectsName, apiContainerLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service account of the API container of enclave '%v'", enclaveUuid)
+	}
+	if err := backend.kubernetesManager.CreateRoleWithBinding(ctx, namespaceName, apiContainerObjectsName, apiContainerRoleRules, apiContainerObjectsName, apiContainerLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the role of the API container of enclave '%v'", enclaveUuid)
+	}
+
+	servicePorts := []apiv1.ServicePort{
+		{
+			Name:     apiContainerGrpcPortName,
+			Protocol: apiv1.ProtocolTCP,
+			Port:     int32(grpcPortNum),
+		},
+		{
+			Name:     apiContainerGrpcProxyPortName,
+			Protocol: apiv1.ProtocolTCP,
+			Port:     int32(grpcProxyPortNum),
+		},
+	}
+	kubernetesService, err := backend.createApiContainerKubernetesService(ctx, namespaceName, apiContainerLabels, servicePorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes service of the API container of enclave '%v'", enclaveUuid)
+	}
+	privateIp, err := getPrivateIpFromService(kubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the private IP of the API container of enclave '%v'", enclaveUuid)
+	}
+
+	envVars := map[string]string{}
+	for key, value := range customEnvVars {
+		envVars[key] = value
+	}
+	envVars[ownIpAddressEnvVar] = privateIp.String()
+
+	apiContainerContainer := apiv1.Container{
+		Name:  apiContainerContainerName,
+		Image: image,
+		Env:   getKubernetesEnvVars(envVars),
+		Ports: []apiv1.ContainerPort{
+			{
+				Name:          apiContainerGrpcPortName,
+				ContainerPort: int32(grpcPortNum),
+				Protocol:      apiv1.ProtocolTCP,
+			},
+			{
+				Name:          apiContainerGrpcProxyPortName,
+				ContainerPort: int32(grpcProxyPortNum),
+				Protocol:      apiv1.ProtocolTCP,
+			},
+		},
+		VolumeMounts: []apiv1.VolumeMount{
+			{
+				Name:      enclaveDataVolumeName,
+				ReadOnly:  apiContainerDataVolumeIsReadOnly,
+				MountPath: enclaveDataVolumeDirpath,
+			},
+		},
+	}
+	volumes := []apiv1.Volume{
+		{
+			Name: enclaveDataVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: enclaveDataPersistentVolumeClaimName,
+					ReadOnly:  apiContainerDataVolumeIsReadOnly,
+				},
+			},
+		},
+	}
+	if len(secretFiles) > 0 {
+		secretFilepaths := []string{}
+		for secretFilepath := range secretFiles {
+			secretFilepaths = append(secretFilepaths, secretFilepath)
+		}
+		sort.Strings(secretFilepaths)
+		secretData := map[string][]byte{}
+		for index, secretFilepath := range secretFilepaths {
+			// Secret keys can't hold slashes, so the files are mounted from keys named after their position
+			secretKey := secretFileKeyPrefix + strconv.Itoa(index)
+			secretData[secretKey] = secretFiles[secretFilepath]
+			apiContainerContainer.VolumeMounts = append(apiContainerContainer.VolumeMounts, apiv1.VolumeMount{
+				Name:      secretFilesVolumeName,
+				ReadOnly:  secretFilesVolumeIsReadOnly,
+				MountPath: secretFilepath,
+				SubPath:   secretKey,
+			})
+		}
+		if _, err := backend.kubernetesManager.CreateSecret(ctx, namespaceName, apiContainerObjectsName, apiContainerLabels, secretData); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the secret holding the secret files of the API container of enclave '%v'", enclaveUuid)
+		}
+		volumes = append(volumes, apiv1.Volume{
+			Name: secretFilesVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				Secret: &apiv1.SecretVolumeSource{
+					SecretName:  apiContainerObjectsName,
+					DefaultMode: &secretFilesMode,
+				},
+			},
+		})
+	}
+	if _, err := backend.kubernetesManager.CreatePod(ctx, namespaceName, apiContainerObjectsName, apiContainerLabels, map[string]string{}, nil, []apiv1.Container{apiContainerContainer}, volumes, apiContainerObjectsName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the pod of the API container of enclave '%v'", enclaveUuid)
+	}
+	if _, err := backend.kubernetesManager.WaitForPodRunning(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for the pod of the API container of enclave '%v' to be running", enclaveUuid)
+	}
+
+	apiContainer, err := getApiContainerFromKubernetesService(enclaveUuid, container_status.ContainerStatus_Running, kubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v' from its Kubernetes service", enclaveUuid)
+	}
+
+	shouldRemoveApiContainer = false
+	return apiContainer, nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	map[enclave.EnclaveUUID]*api_container.APIContainer,
+	error,
+) {
+	apiContainerLabels := getKurtosisLabels(label_value_consts.APIContainerResourceTypeLabelValue)
+	namespacesToSearch := []string{kubernetes_manager.AllNamespaces}
+	if len(filters.EnclaveIDs) > 0 {
+		namespacesToSearch = []string{}
+		for enclaveUuid := range filters.EnclaveIDs {
+			namespacesToSearch = append(namespacesToSearch, getEnclaveNamespaceName(enclaveUuid))
+		}
+	}
+
+	result := map[enclave.EnclaveUUID]*api_container.APIContainer{}
+	for _, namespaceName := range namespacesToSearch {
+		kubernetesServices, err := backend.kubernetesManager.GetServicesByLabels(ctx, namespaceName, apiContainerLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of the API containers")
+		}
+		pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, namespaceName, apiContainerLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the pods of the API containers")
+		}
+		podsByEnclaveUuid := getPodsByLabelValue(pods, label_key_consts.EnclaveUuidLabelKey)
+
+		for index := range kubernetesServices {
+			kubernetesService := &kubernetesServices[index]
+			enclaveUuidStr, found := kubernetesService.Labels[label_key_consts.EnclaveUuidLabelKey]
+			if !found {
+				return nil, stacktrace.NewError("Expected to find label '%v' on API container Kubernetes service '%v' but it was missing", label_key_consts.EnclaveUuidLabelKey, kubernetesService.Name)
+			}
+			enclaveUuid := enclave.EnclaveUUID(enclaveUuidStr)
+			if len(filters.EnclaveIDs) > 0 && !filters.EnclaveIDs[enclaveUuid] {
+				continue
+			}
+			status := getContainerStatusFromPod(podsByEnclaveUuid[enclaveUuidStr])
+			if len(filters.Statuses) > 0 && !filters.Statuses[status] {
+				continue
+			}
+			apiContainer, err := getApiContainerFromKubernetesService(enclaveUuid, status, kubernetesService)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v' from its Kubernetes service", enclaveUuid)
+			}
+			result[enclaveUuid] = apiContainer
+		}
+	}
+	return result, nil
+}
+
+func (backend *KubernetesKurtosisBackend) StopAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingApiContainers, err := backend.GetAPIContainers(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting API containers matching filters '%+v'", filters)
+	}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range matchingApiContainers {
+		if err := backend.kubernetesManager.RemovePod(ctx, getEnclaveNamespaceName(enclaveUuid), apiContainerObjectsName); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred removing the pod of the API container of enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingApiContainers, err := backend.GetAPIContainers(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting API containers matching filters '%+v'", filters)
+	}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range matchingApiContainers {
+		if err := backend.removeApiContainer(ctx, enclaveUuid); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred destroying the API container of enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+// CopyFilesFromAPIContainer writes a TAR stream of the path to the output, using the 'tar' binary of the API container
+// like CopyFilesFromUserService does
+func (backend *KubernetesKurtosisBackend) CopyFilesFromAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	srcPathOnContainer string,
+	output io.Writer,
+) error {
+	namespaceName, err := backend.getRunningApiContainerNamespace(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	srcPathParentDirpath, srcPathBasename := filepath.Split(filepath.Clean(srcPathOnContainer))
+	if srcPathParentDirpath == "" {
+		srcPathParentDirpath = "."
+	}
+	tarCommand := []string{"tar", "-cf", "-", "-C", srcPathParentDirpath, srcPathBasename}
+	exitCode, errorOutput, err := backend.kubernetesManager.RunExecCommandWithSeparatedOutput(namespaceName, apiContainerObjectsName, apiContainerContainerName, tarCommand, output)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the files at '%v' out of the API container of enclave '%v'", srcPathOnContainer, enclaveUuid)
+	}
+	if exitCode != successExecExitCode {
+		return stacktrace.NewError("Copying the files at '%v' out of the API container of enclave '%v' failed with exit code %d:\n%v", srcPathOnContainer, enclaveUuid, exitCode, errorOutput)
+	}
+	return nil
+}
+
+// CopyFilesToAPIContainer extracts the TAR stream in the directory, using the 'tar' binary of the API container
+func (backend *KubernetesKurtosisBackend) CopyFilesToAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	destDirpathOnContainer string,
+	tarContent io.Reader,
+) error {
+	namespaceName, err := backend.getRunningApiContainerNamespace(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	tarCommand := []string{"tar", "-xf", "-", "-C", destDirpathOnContainer}
+	exitCode, errorOutput, err := backend.kubernetesManager.RunExecCommandWithInput(namespaceName, apiContainerObjectsName, apiContainerContainerName, tarCommand, tarContent, io.Discard)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to directory '%v' in the API container of enclave '%v'", destDirpathOnContainer, enclaveUuid)
+	}
+	if exitCode != successExecExitCode {
+		return stacktrace.NewError("Copying content to directory '%v' in the API container of enclave '%v' failed with exit code %d:\n%v", destDirpathOnContainer, enclaveUuid, exitCode, errorOutput)
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) PauseAPIContainer(_ context.Context, _ enclave.EnclaveUUID) error {
+	return stacktrace.NewError("Pausing the API container isn't supported in Kubernetes")
+}
+
+func (backend *KubernetesKurtosisBackend) UnpauseAPIContainer(_ context.Context, _ enclave.EnclaveUUID) error {
+	return stacktrace.NewError("Unpausing the API container isn't supported in Kubernetes")
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getApiContainerFromKubernetesService(enclaveUuid enclave.EnclaveUUID, status container_status.ContainerStatus, kubernetesService *apiv1.Service) (*api_container.APIContainer, error) {
+	privateIp, err := getPrivateIpFromService(kubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the private IP of the API container")
+	}
+	var grpcPortNum, grpcProxyPortNum uint16
+	for _, servicePort := range kubernetesService.Spec.Ports {
+		switch servicePort.Name {
+		case apiContainerGrpcPortName:
+			grpcPortNum = uint16(servicePort.Port)
+		case apiContainerGrpcProxyPortName:
+			grpcProxyPortNum = uint16(servicePort.Port)
+		}
+	}
+	if grpcPortNum == 0 || grpcProxyPortNum == 0 {
+		return nil, stacktrace.NewError("Expected Kubernetes service '%v' to have ports '%v' and '%v' but got '%+v'", kubernetesService.Name, apiContainerGrpcPortName, apiContainerGrpcProxyPortName, kubernetesService.Spec.Ports)
+	}
+	grpcPortSpec, grpcProxyPortSpec, err := newKurtosisInternalGrpcPortSpecs(grpcPortNum, grpcProxyPortNum)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the port specs of the API container")
+	}
+
+	// API containers created before the gateway ports were introduced have no public ports
+	gatewayGrpcPortNum, gatewayGrpcPortFound, err := getGatewayPortNumFromAnnotation(kubernetesService, annotation_key_consts.GatewayGrpcPortAnnotationKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the gateway gRPC port of the API container")
+	}
+	gatewayGrpcProxyPortNum, gatewayGrpcProxyPortFound, err := getGatewayPortNumFromAnnotation(kubernetesService, annotation_key_consts.GatewayGrpcProxyPortAnnotationKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the gateway gRPC proxy port of the API container")
+	}
+	if !gatewayGrpcPortFound || !gatewayGrpcProxyPortFound {
+		return api_container.NewAPIContainer(enclaveUuid, status, privateIp, grpcPortSpec, grpcProxyPortSpec, nil, nil, nil), nil
+	}
+	publicGrpcPortSpec, publicGrpcProxyPortSpec, err := newKurtosisInternalGrpcPortSpecs(gatewayGrpcPortNum, gatewayGrpcProxyPortNum)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the public port specs of the API container")
+	}
+	return api_container.NewAPIContainer(enclaveUuid, status, privateIp, grpcPortSpec, grpcProxyPortSpec, apiContainerGatewayIpAddress, publicGrpcPortSpec, publicGrpcProxyPortSpec), nil
+}
+
+func getGatewayPortNumFromAnnotation(kubernetesService *apiv1.Service, annotationKey string) (uint16, bool, error) {
+	portNumStr, found := kubernetesService.Annotations[annotationKey]
+	if !found {
+		return 0, false, nil
+	}
+	portNum, err := strconv.ParseUint(portNumStr, gatewayPortNumBase, gatewayPortNumBitSize)
+	if err != nil {
+		return 0, false, stacktrace.Propagate(err, "An error occurred parsing the value '%v' of annotation '%v' of Kubernetes service '%v'", portNumStr, annotationKey, kubernetesService.Name)
+	}
+	return uint16(portNum), true, nil
+}
+
+// createApiContainerKubernetesService creates the Kubernetes service of the API container, recording the gateway ports
+// allocated to it in its annotations
+func (backend *KubernetesKurtosisBackend) createApiContainerKubernetesService(
+	ctx context.Context,
+	namespaceName string,
+	apiContainerLabels map[string]string,
+	servicePorts []apiv1.ServicePort,
+) (*apiv1.Service, error) {
+	// the gateway ports are allocated along with the creation of the Kubernetes service recording them, such that
+	// API containers created at the same time don't get the same ports
+	backend.gatewayPortsMutex.Lock()
+	defer backend.gatewayPortsMutex.Unlock()
+	gatewayGrpcPortNum, gatewayGrpcProxyPortNum, err := backend.getFreeApiContainerGatewayPorts(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred allocating the gateway ports of the API container")
+	}
+	serviceAnnotations := map[string]string{
+		annotation_key_consts.GatewayGrpcPortAnnotationKey:      strconv.FormatUint(uint64(gatewayGrpcPortNum), gatewayPortNumBase),
+		annotation_key_consts.GatewayGrpcProxyPortAnnotationKey: strconv.FormatUint(uint64(gatewayGrpcProxyPortNum), gatewayPortNumBase),
+	}
+	kubernetesService, err := backend.kubernetesManager.CreateService(ctx, namespaceName, apiContainerObjectsName, apiContainerLabels, serviceAnnotations, apiContainerLabels, servicePorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes service of the API container")
+	}
+	return kubernetesService, nil
+}
+
+// getFreeApiContainerGatewayPorts returns the first pair of gateway ports no API container of the cluster uses. The
+// caller must hold the gateway ports mutex until the Kubernetes service recording the ports is created
+func (backend *KubernetesKurtosisBackend) getFreeApiContainerGatewayPorts(ctx context.Context) (uint16, uint16, error) {
+	kubernetesServices, err := backend.kubernetesManager.GetServicesByLabels(ctx, kubernetes_manager.AllNamespaces, getKurtosisLabels(label_value_consts.APIContainerResourceTypeLabelValue))
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of the API containers")
+	}
+	usedGatewayGrpcPortNums := map[uint16]bool{}
+	for index := range kubernetesServices {
+		gatewayGrpcPortNum, found, err := getGatewayPortNumFromAnnotation(&kubernetesServices[index], annotation_key_consts.GatewayGrpcPortAnnotationKey)
+		if err != nil {
+			return 0, 0, stacktrace.Propagate(err, "An error occurred getting the gateway gRPC port of an existing API container")
+		}
+		if found {
+			usedGatewayGrpcPortNums[gatewayGrpcPortNum] = true
+		}
+	}
+	for index := 0; index < maxNumberOfApiContainerGatewayPorts; index++ {
+		gatewayGrpcPortNum := firstApiContainerGatewayPortNum + uint16(2*index)
+		if !usedGatewayGrpcPortNums[gatewayGrpcPortNum] {
+			return gatewayGrpcPortNum, gatewayGrpcPortNum + 1, nil
+		}
+	}
+	return 0, 0, stacktrace.NewError("All the %d gateway ports pairs starting at port '%v' are used by existing API containers", maxNumberOfApiContainerGatewayPorts, firstApiContainerGatewayPortNum)
+}
+
+// getRunningApiContainerNamespace returns the namespace of the API container of the enclave, failing if it isn't running
+func (backend *KubernetesKurtosisBackend) getRunningApiContainerNamespace(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (string, error) {
+	apiContainers, err := backend.GetAPIContainers(ctx, &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{enclaveUuid: true},
+		Statuses:   map[container_status.ContainerStatus]bool{container_status.ContainerStatus_Running: true},
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	if _, found := apiContainers[enclaveUuid]; !found {
+		return "", stacktrace.NewError("No running API container was found in enclave '%v'", enclaveUuid)
+	}
+	return getEnclaveNamespaceName(enclaveUuid), nil
+}
+
+func (backend *KubernetesKurtosisBackend) removeApiContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	if err := backend.kubernetesManager.RemovePod(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the pod of the API container")
+	}
+	if err := backend.kubernetesManager.RemoveService(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the Kubernetes service of the API container")
+	}
+	if err := backend.kubernetesManager.RemoveSecret(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the secret of the API container")
+	}
+	if err := backend.kubernetesManager.RemoveRoleWithBinding(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the role of the API container")
+	}
+	if err := backend.kubernetesManager.RemoveServiceAccount(ctx, namespaceName, apiContainerObjectsName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the service account of the API container")
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	"os"
+	"path"
+	"time"
+)
+
+const (
+	podSpecFilename = "spec.json"
+	podLogsFilename = "output.log"
+
+	createdDirPerms  = 0755
+	createdFilePerms = 0644
+
+	shouldFollowPodLogsWhenDumping = false
+
+	podSpecJsonSerializationIndent = "  "
+	podSpecJsonSerializationPrefix = ""
+)
+
+func (backend *KubernetesKurtosisBackend) CreateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	isPartitioningEnabled bool,
+	isIpv6Enabled bool,
+) (
+	*enclave.Enclave,
+	error,
+) {
+	if isIpv6Enabled {
+		return nil, stacktrace.NewError("IPv6 enclaves aren't yet supported in Kubernetes")
+	}
+
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	existingNamespaces, err := backend.kubernetesManager.GetNamespacesByLabels(ctx, getEnclaveObjectLabels(enclaveUuid, label_value_consts.EnclaveResourceTypeLabelValue))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking for namespaces of enclave '%v'", enclaveUuid)
+	}
+	if len(existingNamespaces) > 0 {
+		return nil, stacktrace.NewError("Cannot create enclave '%v' because a namespace for this enclave already exists", enclaveUuid)
+	}
+
+	partitioningLabelValue := label_value_consts.NetworkPartitioningDisabledLabelValue
+	if isPartitioningEnabled {
+		partitioningLabelValue = label_value_consts.NetworkPartitioningEnabledLabelValue
+	}
+	namespaceLabels := getEnclaveObjectLabels(enclaveUuid, label_value_consts.EnclaveResourceTypeLabelValue)
+	namespaceLabels[label_key_consts.IsNetworkPartitioningEnabledLabelKey] = partitioningLabelValue
+	creationTime := time.Now()
+	namespaceAnnotations := map[string]string{
+		annotation_key_consts.EnclaveNameAnnotationKey:         enclaveName,
+		annotation_key_consts.EnclaveCreationTimeAnnotationKey: creationTime.Format(time.RFC3339),
+	}
+	if _, err := backend.kubernetesManager.CreateNamespace(ctx, namespaceName, namespaceLabels, namespaceAnnotations); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the namespace of enclave '%v'", enclaveUuid)
+	}
+	shouldRemoveNamespace := true
+	defer func() {
+		if shouldRemoveNamespace {
+			// Use background context so we delete the namespace even if input context was cancelled
+			if err := backend.kubernetesManager.RemoveNamespace(context.Background(), namespaceName); err != nil {
+				logrus.Errorf("Creating enclave '%v' didn't complete successfully so we tried to remove namespace '%v' that we created, but doing so threw an error:\n%v", enclaveUuid, namespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove namespace '%v'!!!!!!", namespaceName)
+			}
+		}
+	}()
+
+	// The API container reads the partitioning setting from the enclave data volume claim, as it isn't allowed to read
+	// the namespace it runs in
+	dataVolumeLabels := getEnclaveObjectLabels(enclaveUuid, label_value_consts.EnclaveDataResourceTypeLabelValue)
+	dataVolumeLabels[label_key_consts.IsNetworkPartitioningEnabledLabelKey] = partitioningLabelValue
+	if _, err := backend.kubernetesManager.CreatePersistentVolumeClaim(
+		ctx,
+		namespaceName,
+		enclaveDataPersistentVolumeClaimName,
+		dataVolumeLabels,
+		backend.storageClass,
+		backend.enclaveSizeInMegabytes,
+	); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the data volume claim of enclave '%v'", enclaveUuid)
+	}
+
+	shouldRemoveNamespace = false
+	return enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, isPartitioningEnabled), nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]*enclave.Enclave,
+	error,
+) {
+	namespaces, err := backend.kubernetesManager.GetNamespacesByLabels(ctx, getKurtosisLabels(label_value_consts.EnclaveResourceTypeLabelValue))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave namespaces")
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
+	for _, namespace := range namespaces {
+		enclaveUuidStr, found := namespace.Labels[label_key_consts.EnclaveUuidLabelKey]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave namespace '%v' but it was missing", label_key_consts.EnclaveUuidLabelKey, namespace.Name)
+		}
+		enclaveUuid := enclave.EnclaveUUID(enclaveUuidStr)
+		if len(filters.UUIDs) > 0 && !filters.UUIDs[enclaveUuid] {
+			continue
+		}
+
+		enclaveStatus, err := backend.getEnclaveStatus(ctx, enclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the status of enclave '%v'", enclaveUuid)
+		}
+		if len(filters.Statuses) > 0 && !filters.Statuses[enclaveStatus] {
+			continue
+		}
+
+		var creationTime *time.Time
+		if creationTimeStr, found := namespace.Annotations[annotation_key_consts.EnclaveCreationTimeAnnotationKey]; found {
+			parsedCreationTime, err := time.Parse(time.RFC3339, creationTimeStr)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing the creation time '%v' of enclave '%v'", creationTimeStr, enclaveUuid)
+			}
+			creationTime = &parsedCreationTime
+		}
+		enclaveName := namespace.Annotations[annotation_key_consts.EnclaveNameAnnotationKey]
+		isPartitioningEnabled := namespace.Labels[label_key_consts.IsNetworkPartitioningEnabledLabelKey] == label_value_consts.NetworkPartitioningEnabledLabelValue
+
+		result[enclaveUuid] = enclave.NewEnclave(enclaveUuid, enclaveName, enclaveStatus, creationTime, isPartitioningEnabled)
+	}
+	return result, nil
+}
+
+// StopEnclaves removes all the pods of the enclaves, as Kubernetes has no notion of stopped pod. The Kubernetes
+// services and the data volume are kept, so the enclave is seen as stopped
+func (backend *KubernetesKurtosisBackend) StopEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingEnclaves, err := backend.GetEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclaves matching filters '%+v'", filters)
+	}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range matchingEnclaves {
+		if err := backend.removeAllEnclavePods(ctx, enclaveUuid); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+// StartEnclaves is not supported on Kubernetes because StopEnclaves removes the pods of the enclaves, so there is nothing
+// left to start again
+func (backend *KubernetesKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingEnclaves, err := backend.GetEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclaves matching filters '%+v'", filters)
+	}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range matchingEnclaves {
+		erroredEnclaveUuids[enclaveUuid] = stacktrace.NewError(
+			"Enclave '%v' can't be started; starting a stopped enclave isn't supported on Kubernetes because its pods are removed when the enclave gets stopped",
+			enclaveUuid,
+		)
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DumpEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	outputDirpath string,
+) error {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, namespaceName, getKurtosisLabelsForEnclave(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the pods of enclave '%v'", enclaveUuid)
+	}
+
+	if _, err := os.Stat(outputDirpath); !os.IsNotExist(err) {
+		return stacktrace.NewError("Cannot create enclave dump directory '%v' because a file or directory already exists at that location", outputDirpath)
+	}
+	if err := os.Mkdir(outputDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating enclave dump directory '%v'", outputDirpath)
+	}
+
+	for index := range pods {
+		if err := backend.dumpPod(ctx, &pods[index], outputDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping pod '%v' of enclave '%v'", pods[index].Name, enclaveUuid)
+		}
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingEnclaves, err := backend.GetEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclaves matching filters '%+v'", filters)
+	}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range matchingEnclaves {
+		// Removing the namespace removes everything inside of it
+		if err := backend.kubernetesManager.RemoveNamespace(ctx, getEnclaveNamespaceName(enclaveUuid)); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred destroying enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+
+// Matches every Kurtosis object of the enclave, whatever its type
+func getKurtosisLabelsForEnclave(enclaveUuid enclave.EnclaveUUID) map[string]string {
+	return map[string]string{
+		label_key_consts.AppIdLabelKey:       label_value_consts.AppIdLabelValue,
+		label_key_consts.EnclaveUuidLabelKey: string(enclaveUuid),
+	}
+}
+
+// The enclave is empty if it has neither pods nor Kubernetes services, running if any of its pods is running, and
+// stopped otherwise (i.e. its pods were removed when stopping it but its Kubernetes services remain)
+func (backend *KubernetesKurtosisBackend) getEnclaveStatus(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (enclave.EnclaveStatus, error) {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, namespaceName, getKurtosisLabelsForEnclave(enclaveUuid))
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the pods of enclave '%v'", enclaveUuid)
+	}
+	for index := range pods {
+		if getContainerStatusFromPod(&pods[index]) == container_status.ContainerStatus_Running {
+			return enclave.EnclaveStatus_Running, nil
+		}
+	}
+	kubernetesServices, err := backend.kubernetesManager.GetServicesByLabels(ctx, namespaceName, getKurtosisLabelsForEnclave(enclaveUuid))
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of enclave '%v'", enclaveUuid)
+	}
+	if len(pods) == 0 && len(kubernetesServices) == 0 {
+		return enclave.EnclaveStatus_Empty, nil
+	}
+	return enclave.EnclaveStatus_Stopped, nil
+}
+
+func (backend *KubernetesKurtosisBackend) removeAllEnclavePods(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, namespaceName, getKurtosisLabelsForEnclave(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the pods of enclave '%v'", enclaveUuid)
+	}
+	for _, pod := range pods {
+		if err := backend.kubernetesManager.RemovePod(ctx, namespaceName, pod.Name); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing pod '%v' of enclave '%v'", pod.Name, enclaveUuid)
+		}
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) dumpPod(ctx context.Context, pod *apiv1.Pod, enclaveOutputDirpath string) error {
+	podOutputDirpath := path.Join(enclaveOutputDirpath, pod.Name)
+	if err := os.Mkdir(podOutputDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating directory '%v' to dump pod '%v'", podOutputDirpath, pod.Name)
+	}
+
+	specJsonBytes, err := json.MarshalIndent(pod, podSpecJsonSerializationPrefix, podSpecJsonSerializationIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the spec of pod '%v' to JSON", pod.Name)
+	}
+	specOutputFilepath := path.Join(podOutputDirpath, podSpecFilename)
+	if err := os.WriteFile(specOutputFilepath, specJsonBytes, createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the spec of pod '%v' to file '%v'", pod.Name, specOutputFilepath)
+	}
+
+	if len(pod.Spec.Containers) == 0 {
+		return nil
+	}
+	// The first container of a Kurtosis pod is always the main one, the others being sidecars
+	mainContainerName := pod.Spec.Containers[0].Name
+	logsReadCloser, err := backend.kubernetesManager.GetContainerLogs(ctx, pod.Namespace, pod.Name, mainContainerName, shouldFollowPodLogsWhenDumping)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs of pod '%v'", pod.Name)
+	}
+	defer logsReadCloser.Close()
+
+	logsOutputFilepath := path.Join(podOutputDirpath, podLogsFilename)
+	logsOutputFile, err := os.Create(logsOutputFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v' to hold the logs of pod '%v'", logsOutputFilepath, pod.Name)
+	}
+	defer logsOutputFile.Close()
+
+	if _, err := io.Copy(logsOutputFile, logsReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the logs of pod '%v' to file '%v'", pod.Name, logsOutputFilepath)
+	}
+	return nil
+}
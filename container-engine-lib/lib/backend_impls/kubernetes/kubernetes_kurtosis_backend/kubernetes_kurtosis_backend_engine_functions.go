@@ -0,0 +1,240 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	engineObjectsName       = "kurtosis-engine"
+	engineContainerName     = "kurtosis-engine"
+	engineGrpcPortName      = "grpc"
+	engineGrpcProxyPortName = "grpc-proxy"
+)
+
+// The engine manages the enclaves, so it needs to create namespaces and everything inside them, including the access
+// control objects of the API containers
+var engineClusterRoleRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{apiv1.GroupName},
+		Resources: []string{"namespaces", "serviceaccounts", "persistentvolumeclaims", "secrets", "services", "pods", "pods/exec", "pods/log"},
+		Verbs:     []string{"*"},
+	},
+	{
+		APIGroups: []string{rbacv1.GroupName},
+		Resources: []string{"roles", "rolebindings"},
+		Verbs:     []string{"*"},
+	},
+}
+
+func (backend *KubernetesKurtosisBackend) CreateEngine(
+	ctx context.Context,
+	imageOrgAndRepo string,
+	imageVersionTag string,
+	grpcPortNum uint16,
+	grpcProxyPortNum uint16,
+	envVars map[string]string,
+) (
+	*engine.Engine,
+	error,
+) {
+	engineGuidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID string for the engine")
+	}
+	engineGuid := engine.EngineGUID(engineGuidStr)
+
+	engineLabels := getEngineObjectLabels(engineGuid)
+	namespaceName := getEngineNamespaceName(engineGuid)
+	if _, err := backend.kubernetesManager.CreateNamespace(ctx, namespaceName, engineLabels, map[string]string{}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the namespace of engine '%v'", engineGuid)
+	}
+	shouldRemoveEngine := true
+	defer func() {
+		if shouldRemoveEngine {
+			// Use background context so we delete these even if input context was cancelled
+			if err := backend.removeEngine(context.Background(), engineGuid); err != nil {
+				logrus.Errorf("Creating engine '%v' didn't complete successfully so we tried to remove it, but doing so threw an error:\n%v", engineGuid, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove namespace '%v' and cluster role '%v'!!!!!!", namespaceName, getEngineClusterRoleName(engineGuid))
+			}
+		}
+	}()
+
+	if _, err := backend.kubernetesManager.CreateServiceAccount(ctx, namespaceName, engineObjectsName, engineLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service account of engine '%v'", engineGuid)
+	}
+	if err := backend.kubernetesManager.CreateClusterRoleWithBinding(ctx, getEngineClusterRoleName(engineGuid), engineClusterRoleRules, namespaceName, engineObjectsName, engineLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the cluster role of engine '%v'", engineGuid)
+	}
+
+	servicePorts := []apiv1.ServicePort{
+		{
+			Name:     engineGrpcPortName,
+			Protocol: apiv1.ProtocolTCP,
+			Port:     int32(grpcPortNum),
+		},
+		{
+			Name:     engineGrpcProxyPortName,
+			Protocol: apiv1.ProtocolTCP,
+			Port:     int32(grpcProxyPortNum),
+		},
+	}
+	podSelector := map[string]string{
+		label_key_consts.GuidLabelKey: string(engineGuid),
+	}
+	if _, err := backend.kubernetesManager.CreateService(ctx, namespaceName, engineObjectsName, engineLabels, map[string]string{}, podSelector, servicePorts); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes service of engine '%v'", engineGuid)
+	}
+
+	engineContainer := apiv1.Container{
+		Name:  engineContainerName,
+		Image: fmt.Sprintf("%v:%v", imageOrgAndRepo, imageVersionTag),
+		Env:   getKubernetesEnvVars(envVars),
+		Ports: []apiv1.ContainerPort{
+			{
+				Name:          engineGrpcPortName,
+				ContainerPort: int32(grpcPortNum),
+				Protocol:      apiv1.ProtocolTCP,
+			},
+			{
+				Name:          engineGrpcProxyPortName,
+				ContainerPort: int32(grpcProxyPortNum),
+				Protocol:      apiv1.ProtocolTCP,
+			},
+		},
+	}
+	if _, err := backend.kubernetesManager.CreatePod(ctx, namespaceName, engineObjectsName, engineLabels, map[string]string{}, nil, []apiv1.Container{engineContainer}, nil, engineObjectsName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the pod of engine '%v'", engineGuid)
+	}
+	if _, err := backend.kubernetesManager.WaitForPodRunning(ctx, namespaceName, engineObjectsName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for the pod of engine '%v' to be running", engineGuid)
+	}
+
+	shouldRemoveEngine = false
+	return engine.NewEngine(engineGuid, container_status.ContainerStatus_Running, nil, nil, nil), nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetEngines(
+	ctx context.Context,
+	filters *engine.EngineFilters,
+) (
+	map[engine.EngineGUID]*engine.Engine,
+	error,
+) {
+	namespaces, err := backend.kubernetesManager.GetNamespacesByLabels(ctx, getKurtosisLabels(label_value_consts.EngineResourceTypeLabelValue))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine namespaces")
+	}
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, kubernetes_manager.AllNamespaces, getKurtosisLabels(label_value_consts.EngineResourceTypeLabelValue))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine pods")
+	}
+	podsByEngineGuid := getPodsByLabelValue(pods, label_key_consts.GuidLabelKey)
+
+	result := map[engine.EngineGUID]*engine.Engine{}
+	for _, namespace := range namespaces {
+		engineGuidStr, found := namespace.Labels[label_key_consts.GuidLabelKey]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on engine namespace '%v' but it was missing", label_key_consts.GuidLabelKey, namespace.Name)
+		}
+		engineGuid := engine.EngineGUID(engineGuidStr)
+		if len(filters.GUIDs) > 0 && !filters.GUIDs[engineGuid] {
+			continue
+		}
+		engineStatus := getContainerStatusFromPod(podsByEngineGuid[engineGuidStr])
+		if len(filters.Statuses) > 0 && !filters.Statuses[engineStatus] {
+			continue
+		}
+		// The engine isn't reachable from outside the cluster without a port forward, so it has no public ports
+		result[engineGuid] = engine.NewEngine(engineGuid, engineStatus, nil, nil, nil)
+	}
+	return result, nil
+}
+
+func (backend *KubernetesKurtosisBackend) StopEngines(
+	ctx context.Context,
+	filters *engine.EngineFilters,
+) (
+	resultSuccessfulEngineGuids map[engine.EngineGUID]bool,
+	resultErroredEngineGuids map[engine.EngineGUID]error,
+	resultErr error,
+) {
+	matchingEngines, err := backend.GetEngines(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting engines matching filters '%+v'", filters)
+	}
+	successfulEngineGuids := map[engine.EngineGUID]bool{}
+	erroredEngineGuids := map[engine.EngineGUID]error{}
+	for engineGuid := range matchingEngines {
+		if err := backend.kubernetesManager.RemovePod(ctx, getEngineNamespaceName(engineGuid), engineObjectsName); err != nil {
+			erroredEngineGuids[engineGuid] = stacktrace.Propagate(err, "An error occurred removing the pod of engine '%v'", engineGuid)
+			continue
+		}
+		successfulEngineGuids[engineGuid] = true
+	}
+	return successfulEngineGuids, erroredEngineGuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyEngines(
+	ctx context.Context,
+	filters *engine.EngineFilters,
+) (
+	resultSuccessfulEngineGuids map[engine.EngineGUID]bool,
+	resultErroredEngineGuids map[engine.EngineGUID]error,
+	resultErr error,
+) {
+	matchingEngines, err := backend.GetEngines(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting engines matching filters '%+v'", filters)
+	}
+	successfulEngineGuids := map[engine.EngineGUID]bool{}
+	erroredEngineGuids := map[engine.EngineGUID]error{}
+	for engineGuid := range matchingEngines {
+		if err := backend.removeEngine(ctx, engineGuid); err != nil {
+			erroredEngineGuids[engineGuid] = stacktrace.Propagate(err, "An error occurred destroying engine '%v'", engineGuid)
+			continue
+		}
+		successfulEngineGuids[engineGuid] = true
+	}
+	return successfulEngineGuids, erroredEngineGuids, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getEngineNamespaceName(engineGuid engine.EngineGUID) string {
+	return engineNamespacePrefix + string(engineGuid)
+}
+
+// Cluster roles aren't namespaced, so their name needs to be unique across engines
+func getEngineClusterRoleName(engineGuid engine.EngineGUID) string {
+	return engineNamespacePrefix + string(engineGuid)
+}
+
+func getEngineObjectLabels(engineGuid engine.EngineGUID) map[string]string {
+	engineLabels := getKurtosisLabels(label_value_consts.EngineResourceTypeLabelValue)
+	engineLabels[label_key_consts.GuidLabelKey] = string(engineGuid)
+	return engineLabels
+}
+
+func (backend *KubernetesKurtosisBackend) removeEngine(ctx context.Context, engineGuid engine.EngineGUID) error {
+	if err := backend.kubernetesManager.RemoveClusterRoleWithBinding(ctx, getEngineClusterRoleName(engineGuid)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the cluster role of engine '%v'", engineGuid)
+	}
+	if err := backend.kubernetesManager.RemoveNamespace(ctx, getEngineNamespaceName(engineGuid)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the namespace of engine '%v'", engineGuid)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	"time"
+)
+
+const (
+	gatewayRefreshInterval = 2 * time.Second
+)
+
+// gatewayPortForward is a port of the local machine forwarded to a port of a pod of the cluster
+type gatewayPortForward struct {
+	namespaceName string
+	podName       string
+	localPortNum  uint16
+	podPortNum    uint16
+}
+
+type runningGatewayPortForward struct {
+	cancelFunc context.CancelFunc
+
+	// Closed once the forward ended, because it was cancelled or because the connection to the pod was lost
+	doneChan chan struct{}
+}
+
+// RunGateway connects the local machine to the Kurtosis objects of the cluster until the context is cancelled: the
+// ports of the running engine are forwarded to the same local ports, so the CLI reaches it like a Docker engine, and
+// the ports of each running API container are forwarded to its gateway ports, which the engine reports as the public
+// ports of the API container. The forwards follow the engine and the API containers as they come and go
+func (backend *KubernetesKurtosisBackend) RunGateway(ctx context.Context) error {
+	runningForwards := map[gatewayPortForward]*runningGatewayPortForward{}
+	defer func() {
+		for _, runningForward := range runningForwards {
+			runningForward.cancelFunc()
+		}
+		for _, runningForward := range runningForwards {
+			<-runningForward.doneChan
+		}
+	}()
+
+	// Failing to reach the cluster the first time is most likely a configuration error, so it fails the gateway
+	// instead of being retried forever
+	wantedForwards, err := backend.getWantedGatewayPortForwards(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the ports of the cluster to forward to the local machine")
+	}
+	ticker := time.NewTicker(gatewayRefreshInterval)
+	defer ticker.Stop()
+	for {
+		backend.updateGatewayPortForwards(ctx, runningForwards, wantedForwards)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		wantedForwards, err = backend.getWantedGatewayPortForwards(ctx)
+		if err != nil {
+			logrus.Warnf("An error occurred getting the ports of the cluster to forward to the local machine, the forwards will be updated on the next attempt:\n%v", err)
+			// Keeping the running forwards as they are until the cluster answers again
+			wantedForwards = map[gatewayPortForward]bool{}
+			for forward := range runningForwards {
+				wantedForwards[forward] = true
+			}
+		}
+	}
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func (backend *KubernetesKurtosisBackend) updateGatewayPortForwards(
+	ctx context.Context,
+	runningForwards map[gatewayPortForward]*runningGatewayPortForward,
+	wantedForwards map[gatewayPortForward]bool,
+) {
+	for forward, runningForward := range runningForwards {
+		select {
+		case <-runningForward.doneChan:
+			// The forward ended on its own, so it gets started again below if it's still wanted
+			delete(runningForwards, forward)
+			continue
+		default:
+		}
+		if !wantedForwards[forward] {
+			runningForward.cancelFunc()
+			<-runningForward.doneChan
+			delete(runningForwards, forward)
+			logrus.Infof("Stopped forwarding local port '%v' to port '%v' of pod '%v' in namespace '%v'", forward.localPortNum, forward.podPortNum, forward.podName, forward.namespaceName)
+		}
+	}
+
+	for forward := range wantedForwards {
+		if _, found := runningForwards[forward]; found {
+			continue
+		}
+		runningForwards[forward] = backend.startGatewayPortForward(ctx, forward)
+	}
+}
+
+func (backend *KubernetesKurtosisBackend) startGatewayPortForward(ctx context.Context, forward gatewayPortForward) *runningGatewayPortForward {
+	forwardCtx, cancelFunc := context.WithCancel(ctx)
+	doneChan := make(chan struct{})
+	readyChan := make(chan struct{})
+	go func() {
+		defer close(doneChan)
+		if err := backend.kubernetesManager.ForwardPodPort(forwardCtx, forward.namespaceName, forward.podName, forward.localPortNum, forward.podPortNum, readyChan); err != nil {
+			logrus.Warnf("Forwarding local port '%v' to port '%v' of pod '%v' in namespace '%v' ended with an error, it will be retried:\n%v", forward.localPortNum, forward.podPortNum, forward.podName, forward.namespaceName, err)
+		}
+	}()
+	go func() {
+		select {
+		case <-readyChan:
+			logrus.Infof("Forwarding local port '%v' to port '%v' of pod '%v' in namespace '%v'", forward.localPortNum, forward.podPortNum, forward.podName, forward.namespaceName)
+		case <-doneChan:
+		}
+	}()
+	return &runningGatewayPortForward{
+		cancelFunc: cancelFunc,
+		doneChan:   doneChan,
+	}
+}
+
+func (backend *KubernetesKurtosisBackend) getWantedGatewayPortForwards(ctx context.Context) (map[gatewayPortForward]bool, error) {
+	result := map[gatewayPortForward]bool{}
+
+	enginePods, err := backend.kubernetesManager.GetPodsByLabels(ctx, kubernetes_manager.AllNamespaces, getKurtosisLabels(label_value_consts.EngineResourceTypeLabelValue))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine pods")
+	}
+	for _, enginePod := range enginePods {
+		if enginePod.Status.Phase != apiv1.PodRunning {
+			continue
+		}
+		for _, engineContainer := range enginePod.Spec.Containers {
+			for _, containerPort := range engineContainer.Ports {
+				if containerPort.Name != engineGrpcPortName && containerPort.Name != engineGrpcProxyPortName {
+					continue
+				}
+				portNum := uint16(containerPort.ContainerPort)
+				result[gatewayPortForward{
+					namespaceName: enginePod.Namespace,
+					podName:       enginePod.Name,
+					localPortNum:  portNum,
+					podPortNum:    portNum,
+				}] = true
+			}
+		}
+	}
+
+	apiContainerFilters := &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{},
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	apiContainers, err := backend.GetAPIContainers(ctx, apiContainerFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running API containers")
+	}
+	for enclaveUuid, apiContainer := range apiContainers {
+		// API containers created before the gateway ports existed can't be reached from outside the cluster
+		if apiContainer.GetPublicGRPCPort() == nil || apiContainer.GetPublicGRPCProxyPort() == nil {
+			continue
+		}
+		namespaceName := getEnclaveNamespaceName(enclaveUuid)
+		result[gatewayPortForward{
+			namespaceName: namespaceName,
+			podName:       apiContainerObjectsName,
+			localPortNum:  apiContainer.GetPublicGRPCPort().GetNumber(),
+			podPortNum:    apiContainer.GetPrivateGRPCPort().GetNumber(),
+		}] = true
+		result[gatewayPortForward{
+			namespaceName: namespaceName,
+			podName:       apiContainerObjectsName,
+			localPortNum:  apiContainer.GetPublicGRPCProxyPort().GetNumber(),
+			podPortNum:    apiContainer.GetPrivateGRPCProxyPort().GetNumber(),
+		}] = true
+	}
+	return result, nil
+}
@@ -0,0 +1,227 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// The networking sidecar of a service is a container of the pod of the service, added when the service is started in
+// an enclave with network partitioning enabled. Its lifecycle is therefore tied to the one of the pod: creating it only
+// checks that it exists, and stopping or destroying it is a no-op as this happens along with the service
+
+func (backend *KubernetesKurtosisBackend) CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (
+	*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	pod, err := backend.getRunningUserServicePod(ctx, enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pod of service '%v'", serviceUuid)
+	}
+	if !hasNetworkingSidecarContainer(pod) {
+		return nil, stacktrace.NewError("Service '%v' has no networking sidecar container; this means network partitioning isn't enabled in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, getContainerStatusFromPod(pod)), nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	namespacesToSearch := []string{kubernetes_manager.AllNamespaces}
+	if len(filters.EnclaveUUIDs) > 0 {
+		namespacesToSearch = []string{}
+		for enclaveUuid := range filters.EnclaveUUIDs {
+			namespacesToSearch = append(namespacesToSearch, getEnclaveNamespaceName(enclaveUuid))
+		}
+	}
+
+	result := map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{}
+	for _, namespaceName := range namespacesToSearch {
+		pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, namespaceName, getKurtosisLabels(label_value_consts.UserServiceResourceTypeLabelValue))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the pods of the user services")
+		}
+		for index := range pods {
+			pod := &pods[index]
+			if !hasNetworkingSidecarContainer(pod) {
+				continue
+			}
+			enclaveUuid := enclave.EnclaveUUID(pod.Labels[label_key_consts.EnclaveUuidLabelKey])
+			if len(filters.EnclaveUUIDs) > 0 && !filters.EnclaveUUIDs[enclaveUuid] {
+				continue
+			}
+			serviceUuid := service.ServiceUUID(pod.Labels[label_key_consts.GuidLabelKey])
+			if len(filters.UserServiceUUIDs) > 0 && !filters.UserServiceUUIDs[serviceUuid] {
+				continue
+			}
+			status := getContainerStatusFromPod(pod)
+			if len(filters.Statuses) > 0 && !filters.Statuses[status] {
+				continue
+			}
+			result[serviceUuid] = networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, status)
+		}
+	}
+	return result, nil
+}
+
+func (backend *KubernetesKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarsCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return backend.runExecCommandsInPodContainers(ctx, enclaveUuid, networkingSidecarContainerName, networkingSidecarsCommands)
+}
+
+func (backend *KubernetesKurtosisBackend) RunNetworkingSidecarExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	networkingSidecarCommand []string,
+	output io.Writer,
+) (
+	int32,
+	string,
+	error,
+) {
+	filters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	_, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred getting the running services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	pod, found := podsByUuid[serviceUuid]
+	if !found || !hasNetworkingSidecarContainer(pod) {
+		return 0, "", stacktrace.NewError("Cannot run exec command '%+v' as no running networking sidecar exists for service '%v' in enclave '%v'", networkingSidecarCommand, serviceUuid, enclaveUuid)
+	}
+	exitCode, errorOutput, err := backend.kubernetesManager.RunExecCommandWithSeparatedOutput(pod.Namespace, pod.Name, networkingSidecarContainerName, networkingSidecarCommand, output)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred running exec command '%+v' in the networking sidecar of service '%v'", networkingSidecarCommand, serviceUuid)
+	}
+	return exitCode, errorOutput, nil
+}
+
+func (backend *KubernetesKurtosisBackend) StopNetworkingSidecars(
+	_ context.Context,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return getSuccessfulNetworkingSidecarOperationResults(filters)
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyNetworkingSidecars(
+	_ context.Context,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return getSuccessfulNetworkingSidecarOperationResults(filters)
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveBlockedTraffic(
+	_ context.Context,
+	_ enclave.EnclaveUUID,
+	_ map[string]map[string]bool,
+) error {
+	return stacktrace.NewError("Blocking traffic without networking sidecars isn't supported in Kubernetes")
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func hasNetworkingSidecarContainer(pod *apiv1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == networkingSidecarContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+func getSuccessfulNetworkingSidecarOperationResults(filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range filters.UserServiceUUIDs {
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+// Runs each command in the container of the running pod of the corresponding user service
+func (backend *KubernetesKurtosisBackend) runExecCommandsInPodContainers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	containerName string,
+	commands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	filters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{},
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	for serviceUuid := range commands {
+		filters.UUIDs[serviceUuid] = true
+	}
+	_, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the running services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+
+	successfulExecResults := map[service.ServiceUUID]*exec_result.ExecResult{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, command := range commands {
+		pod, found := podsByUuid[serviceUuid]
+		if !found {
+			erroredUuids[serviceUuid] = stacktrace.NewError("Cannot run exec command '%+v' on service '%v' as no running service with this UUID exists in enclave '%v'", command, serviceUuid, enclaveUuid)
+			continue
+		}
+		output := &bytes.Buffer{}
+		exitCode, err := backend.kubernetesManager.RunExecCommand(pod.Namespace, pod.Name, containerName, command, output)
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred running exec command '%+v' in container '%v' of service '%v'", command, containerName, serviceUuid)
+			continue
+		}
+		successfulExecResults[serviceUuid] = exec_result.NewExecResult(exitCode, output.String())
+	}
+	return successfulExecResults, erroredUuids, nil
+}
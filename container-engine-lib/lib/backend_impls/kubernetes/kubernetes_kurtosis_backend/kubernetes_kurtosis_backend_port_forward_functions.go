@@ -0,0 +1,46 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Port forwards can't be created in Kubernetes, where 'kubectl port-forward' serves the same purpose, so there are
+// never any to get or destroy
+
+func (backend *KubernetesKurtosisBackend) CreatePortForward(
+	_ context.Context,
+	_ enclave.EnclaveUUID,
+	_ service.ServiceUUID,
+	_ string,
+	_ uint16,
+) (
+	*port_forward.PortForward,
+	error,
+) {
+	return nil, stacktrace.NewError("Port forwards managed by the engine aren't supported in Kubernetes; use 'kubectl port-forward' instead")
+}
+
+func (backend *KubernetesKurtosisBackend) GetPortForwards(
+	_ context.Context,
+	_ *port_forward.PortForwardFilters,
+) (
+	map[uint16]*port_forward.PortForward,
+	error,
+) {
+	return map[uint16]*port_forward.PortForward{}, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyPortForwards(
+	_ context.Context,
+	_ *port_forward.PortForwardFilters,
+) (
+	map[uint16]bool,
+	map[uint16]error,
+	error,
+) {
+	return map[uint16]bool{}, map[uint16]error{}, nil
+}
@@ -0,0 +1,554 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/files_artifacts_expansion"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("e2b3a1a0c1a64d3d9a1b2c3d4e5f6a7b")
+	testEnclaveName = "test-enclave"
+	testServiceName = service.ServiceName("test-service")
+
+	testStorageClass           = "standard"
+	testEnclaveSizeInMegabytes = 10
+
+	testGrpcPortNum      = uint16(7443)
+	testGrpcProxyPortNum = uint16(7444)
+
+	testIpPlaceholder = "<<IP_ADDRESS>>"
+
+	testExecOutput   = "hello from the pod"
+	testExecExitCode = int32(3)
+
+	testGatewayTimeout      = 10 * time.Second
+	testGatewayPollInterval = 100 * time.Millisecond
+
+	isPartitioningEnabled  = true
+	isPartitioningDisabled = false
+
+	isIpv6Enabled  = true
+	isIpv6Disabled = false
+)
+
+func TestEnclaveLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+
+	createdEnclave, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Empty, createdEnclave.GetStatus())
+
+	_, err = backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.Error(t, err, "Creating the same enclave twice should fail")
+
+	enclaves, err := backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, enclaves, 1)
+	foundEnclave := enclaves[testEnclaveUuid]
+	require.NotNil(t, foundEnclave)
+	require.Equal(t, testEnclaveName, foundEnclave.GetName())
+	require.Equal(t, enclave.EnclaveStatus_Empty, foundEnclave.GetStatus())
+	require.NotNil(t, foundEnclave.GetCreationTime())
+
+	persistentVolumeClaims, err := backend.kubernetesManager.GetPersistentVolumeClaimsByLabels(ctx, getEnclaveNamespaceName(testEnclaveUuid), getKurtosisLabelsForEnclave(testEnclaveUuid))
+	require.NoError(t, err)
+	require.Len(t, persistentVolumeClaims, 1)
+	require.Equal(t, testStorageClass, *persistentVolumeClaims[0].Spec.StorageClassName)
+	requestedStorage := persistentVolumeClaims[0].Spec.Resources.Requests[apiv1.ResourceStorage]
+	require.Equal(t, int64(testEnclaveSizeInMegabytes*1024*1024), requestedStorage.Value())
+
+	successfulUuids, erroredUuids, err := backend.DestroyEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: map[enclave.EnclaveUUID]bool{testEnclaveUuid: true}, Statuses: nil})
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	require.Equal(t, map[enclave.EnclaveUUID]bool{testEnclaveUuid: true}, successfulUuids)
+
+	enclaves, err = backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Empty(t, enclaves)
+}
+
+func TestCreateEnclave_Ipv6NotSupported(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Enabled)
+	require.Error(t, err)
+
+	enclaves, err := backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Empty(t, enclaves)
+}
+
+func TestAPIContainerLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend, clientSet, _, _ := newBackendForTest(t)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+
+	ownIpAddressEnvVar := "OWN_IP"
+	secretFilepath := "/run/secrets/credentials.json"
+	secretFiles := map[string][]byte{secretFilepath: []byte("secret")}
+	apiContainer, err := backend.CreateAPIContainer(ctx, "kurtosistech/core", testEnclaveUuid, testGrpcPortNum, testGrpcProxyPortNum, "/kurtosis-data", ownIpAddressEnvVar, map[string]string{"CUSTOM": "value"}, secretFiles)
+	require.NoError(t, err)
+	require.Equal(t, container_status.ContainerStatus_Running, apiContainer.GetStatus())
+	require.Equal(t, testGrpcPortNum, apiContainer.GetPrivateGRPCPort().GetNumber())
+	require.Equal(t, apiContainerGatewayIpAddress, apiContainer.GetPublicIPAddress())
+	require.Equal(t, firstApiContainerGatewayPortNum, apiContainer.GetPublicGRPCPort().GetNumber())
+	require.Equal(t, firstApiContainerGatewayPortNum+1, apiContainer.GetPublicGRPCProxyPort().GetNumber())
+
+	pod := getSinglePodForTest(t, backend, apiContainerObjectsName)
+	require.Equal(t, apiContainerObjectsName, pod.Spec.ServiceAccountName)
+	require.Contains(t, pod.Spec.Containers[0].Env, apiv1.EnvVar{Name: ownIpAddressEnvVar, Value: apiContainer.GetPrivateIPAddress().String(), ValueFrom: nil})
+	require.Contains(t, pod.Spec.Containers[0].Env, apiv1.EnvVar{Name: "CUSTOM", Value: "value", ValueFrom: nil})
+	require.Equal(t, "/kurtosis-data", pod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	require.Equal(t, enclaveDataPersistentVolumeClaimName, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	require.Equal(t, secretFilepath, pod.Spec.Containers[0].VolumeMounts[1].MountPath)
+	require.Equal(t, apiContainerObjectsName, pod.Spec.Volumes[1].Secret.SecretName)
+	secret, err := clientSet.CoreV1().Secrets(getEnclaveNamespaceName(testEnclaveUuid)).Get(ctx, apiContainerObjectsName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), secret.Data[pod.Spec.Containers[0].VolumeMounts[1].SubPath])
+
+	enclaves, err := backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Running, enclaves[testEnclaveUuid].GetStatus())
+
+	apiContainerFilters := &api_container.APIContainerFilters{EnclaveIDs: map[enclave.EnclaveUUID]bool{testEnclaveUuid: true}, Statuses: nil}
+	_, erroredUuids, err := backend.StopAPIContainers(ctx, apiContainerFilters)
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+
+	apiContainers, err := backend.GetAPIContainers(ctx, apiContainerFilters)
+	require.NoError(t, err)
+	require.Len(t, apiContainers, 1)
+	require.Equal(t, container_status.ContainerStatus_Stopped, apiContainers[testEnclaveUuid].GetStatus())
+	require.Equal(t, apiContainer.GetPrivateIPAddress(), apiContainers[testEnclaveUuid].GetPrivateIPAddress())
+	require.Equal(t, apiContainer.GetPublicGRPCPort().GetNumber(), apiContainers[testEnclaveUuid].GetPublicGRPCPort().GetNumber())
+
+	enclaves, err = backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Stopped, enclaves[testEnclaveUuid].GetStatus())
+
+	_, erroredUuids, err = backend.DestroyAPIContainers(ctx, apiContainerFilters)
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	apiContainers, err = backend.GetAPIContainers(ctx, apiContainerFilters)
+	require.NoError(t, err)
+	require.Empty(t, apiContainers)
+	_, err = clientSet.CoreV1().Secrets(getEnclaveNamespaceName(testEnclaveUuid)).Get(ctx, apiContainerObjectsName, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestCreateAPIContainer_GatewayPortsAreNotShared(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+	otherEnclaveUuid := enclave.EnclaveUUID("f3c4b2b1d2b75e4eab2c3d4e5f6a7b8c")
+	for _, enclaveUuid := range []enclave.EnclaveUUID{testEnclaveUuid, otherEnclaveUuid} {
+		_, err := backend.CreateEnclave(ctx, enclaveUuid, string(enclaveUuid), isPartitioningDisabled, isIpv6Disabled)
+		require.NoError(t, err)
+	}
+
+	apiContainer, err := backend.CreateAPIContainer(ctx, "kurtosistech/core", testEnclaveUuid, testGrpcPortNum, testGrpcProxyPortNum, "/kurtosis-data", "OWN_IP", map[string]string{}, map[string][]byte{})
+	require.NoError(t, err)
+	otherApiContainer, err := backend.CreateAPIContainer(ctx, "kurtosistech/core", otherEnclaveUuid, testGrpcPortNum, testGrpcProxyPortNum, "/kurtosis-data", "OWN_IP", map[string]string{}, map[string][]byte{})
+	require.NoError(t, err)
+	require.Equal(t, firstApiContainerGatewayPortNum, apiContainer.GetPublicGRPCPort().GetNumber())
+	require.Equal(t, firstApiContainerGatewayPortNum+2, otherApiContainer.GetPublicGRPCPort().GetNumber())
+	require.Equal(t, firstApiContainerGatewayPortNum+3, otherApiContainer.GetPublicGRPCProxyPort().GetNumber())
+
+	// the ports of a destroyed API container are reused
+	apiContainerFilters := &api_container.APIContainerFilters{EnclaveIDs: map[enclave.EnclaveUUID]bool{testEnclaveUuid: true}, Statuses: nil}
+	_, erroredUuids, err := backend.DestroyAPIContainers(ctx, apiContainerFilters)
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	apiContainer, err = backend.CreateAPIContainer(ctx, "kurtosistech/core", testEnclaveUuid, testGrpcPortNum, testGrpcProxyPortNum, "/kurtosis-data", "OWN_IP", map[string]string{}, map[string][]byte{})
+	require.NoError(t, err)
+	require.Equal(t, firstApiContainerGatewayPortNum, apiContainer.GetPublicGRPCPort().GetNumber())
+}
+
+func TestRunGateway(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, podPortForwarder := newBackendForTest(t)
+	createdEngine, err := backend.CreateEngine(ctx, "kurtosistech/engine", "1.0.0", testGrpcPortNum, testGrpcProxyPortNum, map[string]string{})
+	require.NoError(t, err)
+	_, err = backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+	_, err = backend.CreateAPIContainer(ctx, "kurtosistech/core", testEnclaveUuid, testGrpcPortNum, testGrpcProxyPortNum, "/kurtosis-data", "OWN_IP", map[string]string{}, map[string][]byte{})
+	require.NoError(t, err)
+
+	gatewayCtx, cancelGateway := context.WithCancel(ctx)
+	gatewayErrChan := make(chan error)
+	go func() {
+		gatewayErrChan <- backend.RunGateway(gatewayCtx)
+	}()
+
+	engineNamespaceName := getEngineNamespaceName(createdEngine.GetGUID())
+	enclaveNamespaceName := getEnclaveNamespaceName(testEnclaveUuid)
+	engineForwards := map[gatewayPortForward]bool{
+		{namespaceName: engineNamespaceName, podName: engineObjectsName, localPortNum: testGrpcPortNum, podPortNum: testGrpcPortNum}:           true,
+		{namespaceName: engineNamespaceName, podName: engineObjectsName, localPortNum: testGrpcProxyPortNum, podPortNum: testGrpcProxyPortNum}: true,
+	}
+	allForwards := map[gatewayPortForward]bool{
+		{namespaceName: enclaveNamespaceName, podName: apiContainerObjectsName, localPortNum: firstApiContainerGatewayPortNum, podPortNum: testGrpcPortNum}:          true,
+		{namespaceName: enclaveNamespaceName, podName: apiContainerObjectsName, localPortNum: firstApiContainerGatewayPortNum + 1, podPortNum: testGrpcProxyPortNum}: true,
+	}
+	for forward := range engineForwards {
+		allForwards[forward] = true
+	}
+	require.Eventually(t, func() bool {
+		return reflect.DeepEqual(allForwards, podPortForwarder.getActiveForwards())
+	}, testGatewayTimeout, testGatewayPollInterval)
+
+	// the forwards of the API container stop with it
+	_, erroredUuids, err := backend.StopAPIContainers(ctx, &api_container.APIContainerFilters{EnclaveIDs: map[enclave.EnclaveUUID]bool{testEnclaveUuid: true}, Statuses: nil})
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	require.Eventually(t, func() bool {
+		return reflect.DeepEqual(engineForwards, podPortForwarder.getActiveForwards())
+	}, testGatewayTimeout, testGatewayPollInterval)
+
+	cancelGateway()
+	require.NoError(t, <-gatewayErrChan)
+	require.Empty(t, podPortForwarder.getActiveForwards())
+}
+
+func TestUserServiceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend, _, podExecutor, _ := newBackendForTest(t)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningEnabled, isIpv6Disabled)
+	require.NoError(t, err)
+
+	registrations, failedRegistrations, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, failedRegistrations)
+	registration := registrations[testServiceName]
+	require.NotNil(t, registration)
+	require.Equal(t, string(testServiceName), registration.GetHostname())
+	serviceUuid := registration.GetUUID()
+
+	// Registered services aren't returned until they are started
+	userServices, err := backend.GetUserServices(ctx, testEnclaveUuid, newServiceFiltersForTest(serviceUuid))
+	require.NoError(t, err)
+	require.Empty(t, userServices)
+
+	httpPort, err := port_spec.NewPortSpec(8080, port_spec.TransportProtocol_TCP, "http")
+	require.NoError(t, err)
+	serviceConfig := service.NewServiceConfig(
+		"nginx:latest",
+		map[string]*port_spec.PortSpec{"http": httpPort},
+		nil,
+		[]string{"/entrypoint.sh"},
+		[]string{"--listen", testIpPlaceholder},
+		map[string]string{"OWN_ADDRESS": testIpPlaceholder},
+		&files_artifacts_expansion.FilesArtifactsExpansion{
+			ExpanderImage:                     "kurtosistech/files-artifacts-expander",
+			ExpanderEnvVars:                   map[string]string{"API_CONTAINER_IP": "10.0.0.2"},
+			ExpanderDirpathsToServiceDirpaths: map[string]string{"/expansion": "/config"},
+		},
+		500,
+		256,
+		testIpPlaceholder,
+	)
+	startedServices, failedServices, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{serviceUuid: serviceConfig})
+	require.NoError(t, err)
+	require.Empty(t, failedServices)
+	startedService := startedServices[serviceUuid]
+	require.NotNil(t, startedService)
+	require.Equal(t, container_status.ContainerStatus_Running, startedService.GetStatus())
+	require.Equal(t, httpPort, startedService.GetPrivatePorts()["http"])
+
+	privateIpStr := registration.GetPrivateIP().String()
+	pod := getSinglePodForTest(t, backend, string(testServiceName))
+	require.Len(t, pod.Spec.Containers, 2, "Expected the user service container and the networking sidecar container")
+	userServiceContainer := pod.Spec.Containers[0]
+	require.Equal(t, userServiceContainerName, userServiceContainer.Name)
+	require.Equal(t, []string{"/entrypoint.sh"}, userServiceContainer.Command)
+	require.Equal(t, []string{"--listen", privateIpStr}, userServiceContainer.Args)
+	require.Contains(t, userServiceContainer.Env, apiv1.EnvVar{Name: "OWN_ADDRESS", Value: privateIpStr, ValueFrom: nil})
+	require.Equal(t, int32(8080), userServiceContainer.Ports[0].ContainerPort)
+	require.Equal(t, "/config", userServiceContainer.VolumeMounts[0].MountPath)
+	require.Equal(t, "500m", userServiceContainer.Resources.Limits.Cpu().String())
+	require.Equal(t, networkingSidecarContainerName, pod.Spec.Containers[1].Name)
+	require.Len(t, pod.Spec.InitContainers, 1)
+	require.Equal(t, "/expansion", pod.Spec.InitContainers[0].VolumeMounts[0].MountPath)
+
+	kubernetesServicesByUuid, err := backend.getUserServiceKubernetesServicesByUuid(ctx, testEnclaveUuid)
+	require.NoError(t, err)
+	require.Equal(t, int32(8080), kubernetesServicesByUuid[serviceUuid].Spec.Ports[0].Port)
+
+	execResults, failedExecs, err := backend.RunUserServiceExecCommands(ctx, testEnclaveUuid, map[service.ServiceUUID][]string{serviceUuid: {"echo", "hello"}})
+	require.NoError(t, err)
+	require.Empty(t, failedExecs)
+	require.Equal(t, testExecExitCode, execResults[serviceUuid].GetExitCode())
+	require.Equal(t, testExecOutput, execResults[serviceUuid].GetOutput())
+	require.Equal(t, userServiceContainerName, podExecutor.getLastContainerName())
+
+	sidecar, err := backend.CreateNetworkingSidecar(ctx, testEnclaveUuid, serviceUuid)
+	require.NoError(t, err)
+	require.Equal(t, container_status.ContainerStatus_Running, sidecar.GetStatus())
+	sidecars, err := backend.GetNetworkingSidecars(ctx, &networking_sidecar.NetworkingSidecarFilters{EnclaveUUIDs: nil, UserServiceUUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+	_, failedExecs, err = backend.RunNetworkingSidecarExecCommands(ctx, testEnclaveUuid, map[service.ServiceUUID][]string{serviceUuid: {"tc", "qdisc"}})
+	require.NoError(t, err)
+	require.Empty(t, failedExecs)
+	require.Equal(t, networkingSidecarContainerName, podExecutor.getLastContainerName())
+
+	logs, failedLogs, err := backend.GetUserServiceLogs(ctx, testEnclaveUuid, newServiceFiltersForTest(serviceUuid), false)
+	require.NoError(t, err)
+	require.Empty(t, failedLogs)
+	logsBytes, err := io.ReadAll(logs[serviceUuid])
+	require.NoError(t, err)
+	require.NotEmpty(t, logsBytes)
+	require.NoError(t, logs[serviceUuid].Close())
+
+	_, failedStops, err := backend.StopUserServices(ctx, testEnclaveUuid, newServiceFiltersForTest(serviceUuid))
+	require.NoError(t, err)
+	require.Empty(t, failedStops)
+	userServices, err = backend.GetUserServices(ctx, testEnclaveUuid, newServiceFiltersForTest(serviceUuid))
+	require.NoError(t, err)
+	require.Equal(t, container_status.ContainerStatus_Stopped, userServices[serviceUuid].GetStatus())
+
+	_, failedExecs, err = backend.RunUserServiceExecCommands(ctx, testEnclaveUuid, map[service.ServiceUUID][]string{serviceUuid: {"echo", "hello"}})
+	require.NoError(t, err)
+	require.Contains(t, failedExecs, serviceUuid, "Running a command on a stopped service should fail")
+
+	_, failedDestroys, err := backend.DestroyUserServices(ctx, testEnclaveUuid, newServiceFiltersForTest(serviceUuid))
+	require.NoError(t, err)
+	require.Empty(t, failedDestroys)
+	kubernetesServicesByUuid, err = backend.getUserServiceKubernetesServicesByUuid(ctx, testEnclaveUuid)
+	require.NoError(t, err)
+	require.Empty(t, kubernetesServicesByUuid)
+}
+
+func TestStartRegisteredUserServices_NoSidecarWithoutPartitioning(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+	registrations, _, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	serviceUuid := registrations[testServiceName].GetUUID()
+
+	serviceConfig := service.NewServiceConfig("alpine", nil, nil, nil, nil, nil, nil, 0, 0, testIpPlaceholder)
+	_, failedServices, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{serviceUuid: serviceConfig})
+	require.NoError(t, err)
+	require.Empty(t, failedServices)
+
+	pod := getSinglePodForTest(t, backend, string(testServiceName))
+	require.Len(t, pod.Spec.Containers, 1)
+	require.Empty(t, pod.Spec.InitContainers)
+
+	_, err = backend.CreateNetworkingSidecar(ctx, testEnclaveUuid, serviceUuid)
+	require.Error(t, err)
+}
+
+func TestStartRegisteredUserServices_FailingPodIsRemoved(t *testing.T) {
+	ctx := context.Background()
+	backend, clientSet, _, _ := newBackendForTest(t)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+	registrations, _, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	serviceUuid := registrations[testServiceName].GetUUID()
+
+	clientSet.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*apiv1.Pod)
+		pod.Status.Phase = apiv1.PodPending
+		pod.Status.ContainerStatuses = []apiv1.ContainerStatus{
+			{
+				Name: userServiceContainerName,
+				State: apiv1.ContainerState{
+					Waiting: &apiv1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "image not found"},
+				},
+			},
+		}
+		return false, nil, nil
+	})
+
+	serviceConfig := service.NewServiceConfig("does-not-exist", nil, nil, nil, nil, nil, nil, 0, 0, testIpPlaceholder)
+	startedServices, failedServices, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{serviceUuid: serviceConfig})
+	require.NoError(t, err)
+	require.Empty(t, startedServices)
+	require.Contains(t, failedServices, serviceUuid)
+	require.Contains(t, failedServices[serviceUuid].Error(), "ImagePullBackOff")
+
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, getEnclaveNamespaceName(testEnclaveUuid), getKurtosisLabelsForEnclave(testEnclaveUuid))
+	require.NoError(t, err)
+	require.Empty(t, pods)
+}
+
+func TestRegisterUserServices_InvalidKubernetesName(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName, isPartitioningDisabled, isIpv6Disabled)
+	require.NoError(t, err)
+
+	invalidServiceName := service.ServiceName("Invalid_Name")
+	registrations, failedRegistrations, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{invalidServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, registrations)
+	require.Contains(t, failedRegistrations, invalidServiceName)
+}
+
+func TestEngineLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend, _, _, _ := newBackendForTest(t)
+
+	createdEngine, err := backend.CreateEngine(ctx, "kurtosistech/engine", "1.0.0", testGrpcPortNum, testGrpcProxyPortNum, map[string]string{"ENGINE_ARGS": "{}"})
+	require.NoError(t, err)
+	require.Equal(t, container_status.ContainerStatus_Running, createdEngine.GetStatus())
+
+	engineGuid := createdEngine.GetGUID()
+	pods, err := backend.kubernetesManager.GetPodsByLabels(ctx, getEngineNamespaceName(engineGuid), getEngineObjectLabels(engineGuid))
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	require.Equal(t, "kurtosistech/engine:1.0.0", pods[0].Spec.Containers[0].Image)
+
+	engineFilters := &engine.EngineFilters{GUIDs: map[engine.EngineGUID]bool{engineGuid: true}, Statuses: nil}
+	_, erroredGuids, err := backend.StopEngines(ctx, engineFilters)
+	require.NoError(t, err)
+	require.Empty(t, erroredGuids)
+	engines, err := backend.GetEngines(ctx, engineFilters)
+	require.NoError(t, err)
+	require.Equal(t, container_status.ContainerStatus_Stopped, engines[engineGuid].GetStatus())
+
+	_, erroredGuids, err = backend.DestroyEngines(ctx, engineFilters)
+	require.NoError(t, err)
+	require.Empty(t, erroredGuids)
+	engines, err = backend.GetEngines(ctx, engineFilters)
+	require.NoError(t, err)
+	require.Empty(t, engines)
+}
+
+// ====================================================================================================
+//
+//	Test helpers
+//
+// ====================================================================================================
+
+// The fake clientset has no controllers, so the reactors play their part: pods are running as soon as they're created
+// (unless a reactor prepended by the test already set their phase) and services get a cluster IP
+func newBackendForTest(t *testing.T) (*KubernetesKurtosisBackend, *fake.Clientset, *podExecutorForTest, *podPortForwarderForTest) {
+	clientSet := fake.NewSimpleClientset()
+	clientSet.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*apiv1.Pod)
+		if pod.Status.Phase == "" {
+			pod.Status.Phase = apiv1.PodRunning
+		}
+		return false, nil, nil
+	})
+	nextClusterIpSuffix := 1
+	clientSet.PrependReactor("create", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		kubernetesService := action.(k8stesting.CreateAction).GetObject().(*apiv1.Service)
+		kubernetesService.Spec.ClusterIP = fmt.Sprintf("10.96.0.%d", nextClusterIpSuffix)
+		nextClusterIpSuffix++
+		return false, nil, nil
+	})
+	podExecutor := &podExecutorForTest{
+		mutex:             &sync.Mutex{},
+		lastContainerName: "",
+	}
+	podPortForwarder := &podPortForwarderForTest{
+		mutex:          &sync.Mutex{},
+		activeForwards: map[gatewayPortForward]bool{},
+	}
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, podExecutor, podPortForwarder)
+	return NewKubernetesKurtosisBackend(kubernetesManager, testStorageClass, testEnclaveSizeInMegabytes), clientSet, podExecutor, podPortForwarder
+}
+
+func getSinglePodForTest(t *testing.T, backend *KubernetesKurtosisBackend, podName string) *apiv1.Pod {
+	pod, err := backend.kubernetesManager.WaitForPodRunning(context.Background(), getEnclaveNamespaceName(testEnclaveUuid), podName)
+	require.NoError(t, err)
+	return pod
+}
+
+func newServiceFiltersForTest(serviceUuid service.ServiceUUID) *service.ServiceFilters {
+	return &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    map[service.ServiceUUID]bool{serviceUuid: true},
+		Statuses: nil,
+	}
+}
+
+// The exec API is a streaming protocol that the fake clientset doesn't support
+type podExecutorForTest struct {
+	mutex *sync.Mutex
+
+	lastContainerName string
+}
+
+func (executor *podExecutorForTest) Exec(_ string, _ string, containerName string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) (int32, error) {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+	executor.lastContainerName = containerName
+	if _, err := io.Copy(stdout, strings.NewReader(testExecOutput)); err != nil {
+		return 0, err
+	}
+	return testExecExitCode, nil
+}
+
+func (executor *podExecutorForTest) getLastContainerName() string {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+	return executor.lastContainerName
+}
+
+// Like the exec API, the port forwarding API is a streaming protocol, so the forwards are only recorded while active
+type podPortForwarderForTest struct {
+	mutex *sync.Mutex
+
+	activeForwards map[gatewayPortForward]bool
+}
+
+func (forwarder *podPortForwarderForTest) ForwardPort(ctx context.Context, namespace string, podName string, localPort uint16, podPort uint16, readyChan chan struct{}) error {
+	forward := gatewayPortForward{
+		namespaceName: namespace,
+		podName:       podName,
+		localPortNum:  localPort,
+		podPortNum:    podPort,
+	}
+	forwarder.mutex.Lock()
+	forwarder.activeForwards[forward] = true
+	forwarder.mutex.Unlock()
+	close(readyChan)
+
+	<-ctx.Done()
+
+	forwarder.mutex.Lock()
+	delete(forwarder.activeForwards, forward)
+	forwarder.mutex.Unlock()
+	return nil
+}
+
+func (forwarder *podPortForwarderForTest) getActiveForwards() map[gatewayPortForward]bool {
+	forwarder.mutex.Lock()
+	defer forwarder.mutex.Unlock()
+	result := map[gatewayPortForward]bool{}
+	for forward := range forwarder.activeForwards {
+		result[forward] = true
+	}
+	return result
+}
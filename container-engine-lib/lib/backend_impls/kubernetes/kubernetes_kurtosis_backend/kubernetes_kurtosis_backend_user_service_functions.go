@@ -0,0 +1,696 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_port_spec_serializer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	userServiceContainerName            = "user-service"
+	filesArtifactsExpanderContainerName = "files-artifacts-expander"
+	networkingSidecarContainerName      = "networking-sidecar"
+
+	// Built from the 'core/networking_sidecar' subproject, see its '_constants.env' before changing this
+	networkingSidecarImageName = "kurtosistech/networking-sidecar:1.1.0"
+
+	filesArtifactsExpansionVolumeNameFormat = "files-artifacts-expansion-%d"
+
+	// Kubernetes services need at least one port, so the ones of registered services that aren't started yet, or that
+	// don't declare any port, get this one
+	unboundPortName   = "unbound"
+	unboundPortNumber = 1
+
+	unlimitedReplacements = -1
+
+	megabytesToBytesFactor = 1024 * 1024
+
+	successExecExitCode = 0
+)
+
+// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
+var commandToRunWhenCreatingUserServiceShell = []string{
+	"sh",
+	"-c",
+	"if command -v 'bash' > /dev/null; then echo \"Found bash on container; creating bash shell...\"; bash; else echo \"No bash found on container; dropping down to sh shell...\"; sh; fi",
+}
+
+// The networking sidecar only needs to stay alive so commands can be exec'd in it
+var networkingSidecarCommand = []string{"sleep", "infinity"}
+
+var unboundServicePorts = []apiv1.ServicePort{
+	{
+		Name:     unboundPortName,
+		Protocol: apiv1.ProtocolTCP,
+		Port:     unboundPortNumber,
+	},
+}
+
+// RegisterUserServices creates a Kubernetes service per user service, whose cluster IP becomes the private IP of the
+// user service. The service is named after the user service so that the name can be resolved by the cluster DNS
+func (backend *KubernetesKurtosisBackend) RegisterUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceName]bool,
+) (
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
+	error,
+) {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	successfulRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	failedRegistrations := map[service.ServiceName]error{}
+	for serviceName := range services {
+		if validationErrs := validation.IsDNS1035Label(string(serviceName)); len(validationErrs) > 0 {
+			failedRegistrations[serviceName] = stacktrace.NewError("Service name '%v' can't be used as a Kubernetes service name: %v", serviceName, strings.Join(validationErrs, "; "))
+			continue
+		}
+		serviceUuidStr, err := uuid_generator.GenerateUUIDString()
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred generating a UUID for service '%v'", serviceName)
+			continue
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+
+		kubernetesService, err := backend.kubernetesManager.CreateService(
+			ctx,
+			namespaceName,
+			string(serviceName),
+			getUserServiceObjectLabels(enclaveUuid, serviceUuid),
+			map[string]string{},
+			getUserServicePodSelector(serviceUuid),
+			unboundServicePorts,
+		)
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred creating the Kubernetes service of service '%v'", serviceName)
+			continue
+		}
+		privateIp, err := getPrivateIpFromService(kubernetesService)
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred getting the private IP of service '%v'", serviceName)
+			continue
+		}
+		successfulRegistrations[serviceName] = service.NewServiceRegistration(serviceName, serviceUuid, enclaveUuid, privateIp, string(serviceName))
+	}
+	return successfulRegistrations, failedRegistrations, nil
+}
+
+func (backend *KubernetesKurtosisBackend) UnregisterUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]bool,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	kubernetesServicesByUuid, err := backend.getUserServiceKubernetesServicesByUuid(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of enclave '%v'", enclaveUuid)
+	}
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range services {
+		kubernetesService, found := kubernetesServicesByUuid[serviceUuid]
+		if !found {
+			// Nothing to unregister
+			successfulUuids[serviceUuid] = true
+			continue
+		}
+		if err := backend.kubernetesManager.RemoveService(ctx, kubernetesService.Namespace, kubernetesService.Name); err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the Kubernetes service of service '%v'", serviceUuid)
+			continue
+		}
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) StartRegisteredUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]*service.ServiceConfig,
+) (
+	map[service.ServiceUUID]*service.Service,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	isPartitioningEnabled, err := backend.isNetworkPartitioningEnabled(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred checking whether network partitioning is enabled in enclave '%v'", enclaveUuid)
+	}
+	kubernetesServicesByUuid, err := backend.getUserServiceKubernetesServicesByUuid(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of enclave '%v'", enclaveUuid)
+	}
+
+	failedServices := map[service.ServiceUUID]error{}
+	createdPodKubernetesServices := map[service.ServiceUUID]*apiv1.Service{}
+	for serviceUuid, serviceConfig := range services {
+		kubernetesService, found := kubernetesServicesByUuid[serviceUuid]
+		if !found {
+			failedServices[serviceUuid] = stacktrace.NewError("Cannot start service '%v' because it isn't registered", serviceUuid)
+			continue
+		}
+		updatedKubernetesService, err := backend.createUserServicePod(ctx, enclaveUuid, serviceUuid, serviceConfig, kubernetesService, isPartitioningEnabled)
+		if err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred creating the pod of service '%v'", serviceUuid)
+			continue
+		}
+		createdPodKubernetesServices[serviceUuid] = updatedKubernetesService
+	}
+
+	successfulServices := map[service.ServiceUUID]*service.Service{}
+	for serviceUuid, kubernetesService := range createdPodKubernetesServices {
+		if _, err := backend.kubernetesManager.WaitForPodRunning(ctx, namespaceName, kubernetesService.Name); err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred waiting for the pod of service '%v' to be running", serviceUuid)
+			// Use background context so we delete the pod even if input context was cancelled
+			if err := backend.kubernetesManager.RemovePod(context.Background(), namespaceName, kubernetesService.Name); err != nil {
+				logrus.Errorf("Starting service '%v' didn't complete successfully so we tried to remove its pod, but doing so threw an error:\n%v", serviceUuid, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove pod '%v' in namespace '%v'!!!!!!", kubernetesService.Name, namespaceName)
+			}
+			continue
+		}
+		startedService, err := getUserServiceFromKubernetesService(enclaveUuid, kubernetesService, container_status.ContainerStatus_Running)
+		if err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting service '%v' from its Kubernetes service", serviceUuid)
+			continue
+		}
+		successfulServices[serviceUuid] = startedService
+	}
+	return successfulServices, failedServices, nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.Service,
+	error,
+) {
+	userServices, _, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	return userServices, nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetUserServiceLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	shouldFollowLogs bool,
+) (
+	map[service.ServiceUUID]io.ReadCloser,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServices, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	successfulLogs := map[service.ServiceUUID]io.ReadCloser{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range userServices {
+		pod, found := podsByUuid[serviceUuid]
+		if !found {
+			erroredUuids[serviceUuid] = stacktrace.NewError("Cannot get the logs of service '%v' as it has no pod anymore", serviceUuid)
+			continue
+		}
+		logsReadCloser, err := backend.kubernetesManager.GetContainerLogs(ctx, pod.Namespace, pod.Name, userServiceContainerName, shouldFollowLogs)
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+			continue
+		}
+		successfulLogs[serviceUuid] = logsReadCloser
+	}
+	return successfulLogs, erroredUuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) PauseService(_ context.Context, _ enclave.EnclaveUUID, _ service.ServiceUUID) error {
+	return stacktrace.NewError("Pausing services isn't supported in Kubernetes")
+}
+
+func (backend *KubernetesKurtosisBackend) UnpauseService(_ context.Context, _ enclave.EnclaveUUID, _ service.ServiceUUID) error {
+	return stacktrace.NewError("Unpausing services isn't supported in Kubernetes")
+}
+
+func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	userServiceCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return backend.runExecCommandsInPodContainers(ctx, enclaveUuid, userServiceContainerName, userServiceCommands)
+}
+
+func (backend *KubernetesKurtosisBackend) GetConnectionWithUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (
+	net.Conn,
+	error,
+) {
+	pod, err := backend.getRunningUserServicePod(ctx, enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pod of service '%v'", serviceUuid)
+	}
+	newConnection, err := backend.kubernetesManager.GetExecStream(pod.Namespace, pod.Name, userServiceContainerName, commandToRunWhenCreatingUserServiceShell)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a shell on service '%v'", serviceUuid)
+	}
+	return newConnection, nil
+}
+
+// CopyFilesFromUserService writes a TAR stream of the path to the output. Kubernetes has no API to copy files out of a
+// container, so like 'kubectl cp' this requires the 'tar' binary to be present in the container
+func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	srcPathOnService string,
+	output io.Writer,
+) error {
+	pod, err := backend.getRunningUserServicePod(ctx, enclaveUuid, serviceUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the pod of service '%v'", serviceUuid)
+	}
+	srcPathParentDirpath, srcPathBasename := filepath.Split(filepath.Clean(srcPathOnService))
+	if srcPathParentDirpath == "" {
+		srcPathParentDirpath = "."
+	}
+	tarCommand := []string{"tar", "-cf", "-", "-C", srcPathParentDirpath, srcPathBasename}
+	exitCode, errorOutput, err := backend.kubernetesManager.RunExecCommandWithSeparatedOutput(pod.Namespace, pod.Name, userServiceContainerName, tarCommand, output)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the files at '%v' out of service '%v'", srcPathOnService, serviceUuid)
+	}
+	if exitCode != successExecExitCode {
+		return stacktrace.NewError("Copying the files at '%v' out of service '%v' failed with exit code %d:\n%v", srcPathOnService, serviceUuid, exitCode, errorOutput)
+	}
+	return nil
+}
+
+// CreateUserServiceImages isn't supported as Kubernetes has no API to create an image out of a running container
+func (backend *KubernetesKurtosisBackend) CreateUserServiceImages(
+	_ context.Context,
+	_ enclave.EnclaveUUID,
+	_ *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]string,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return nil, nil, stacktrace.NewError("Creating images of user services isn't supported in Kubernetes")
+}
+
+// StopUserServices removes the pods of the services, as Kubernetes has no notion of stopped pod. The Kubernetes
+// services are kept, so the services are seen as stopped
+func (backend *KubernetesKurtosisBackend) StopUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServices, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range userServices {
+		if pod, found := podsByUuid[serviceUuid]; found {
+			if err := backend.kubernetesManager.RemovePod(ctx, pod.Namespace, pod.Name); err != nil {
+				erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the pod of service '%v'", serviceUuid)
+				continue
+			}
+		}
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServices, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	namespaceName := getEnclaveNamespaceName(enclaveUuid)
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, userService := range userServices {
+		if pod, found := podsByUuid[serviceUuid]; found {
+			if err := backend.kubernetesManager.RemovePod(ctx, pod.Namespace, pod.Name); err != nil {
+				erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the pod of service '%v'", serviceUuid)
+				continue
+			}
+		}
+		kubernetesServiceName := string(userService.GetRegistration().GetName())
+		if err := backend.kubernetesManager.RemoveService(ctx, namespaceName, kubernetesServiceName); err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the Kubernetes service of service '%v'", serviceUuid)
+			continue
+		}
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getUserServiceObjectLabels(enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) map[string]string {
+	objectLabels := getEnclaveObjectLabels(enclaveUuid, label_value_consts.UserServiceResourceTypeLabelValue)
+	objectLabels[label_key_consts.GuidLabelKey] = string(serviceUuid)
+	return objectLabels
+}
+
+func getUserServicePodSelector(serviceUuid service.ServiceUUID) map[string]string {
+	return map[string]string{
+		label_key_consts.ResourceTypeLabelKey: label_value_consts.UserServiceResourceTypeLabelValue,
+		label_key_consts.GuidLabelKey:         string(serviceUuid),
+	}
+}
+
+func (backend *KubernetesKurtosisBackend) isNetworkPartitioningEnabled(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (bool, error) {
+	persistentVolumeClaims, err := backend.kubernetesManager.GetPersistentVolumeClaimsByLabels(
+		ctx,
+		getEnclaveNamespaceName(enclaveUuid),
+		getEnclaveObjectLabels(enclaveUuid, label_value_consts.EnclaveDataResourceTypeLabelValue),
+	)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the data volume claim of enclave '%v'", enclaveUuid)
+	}
+	if len(persistentVolumeClaims) != 1 {
+		return false, stacktrace.NewError("Expected exactly one data volume claim in enclave '%v' but found %d", enclaveUuid, len(persistentVolumeClaims))
+	}
+	partitioningLabelValue := persistentVolumeClaims[0].Labels[label_key_consts.IsNetworkPartitioningEnabledLabelKey]
+	return partitioningLabelValue == label_value_consts.NetworkPartitioningEnabledLabelValue, nil
+}
+
+func (backend *KubernetesKurtosisBackend) getUserServiceKubernetesServicesByUuid(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (map[service.ServiceUUID]*apiv1.Service, error) {
+	kubernetesServices, err := backend.kubernetesManager.GetServicesByLabels(
+		ctx,
+		getEnclaveNamespaceName(enclaveUuid),
+		getEnclaveObjectLabels(enclaveUuid, label_value_consts.UserServiceResourceTypeLabelValue),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user service Kubernetes services")
+	}
+	result := map[service.ServiceUUID]*apiv1.Service{}
+	for index := range kubernetesServices {
+		kubernetesService := &kubernetesServices[index]
+		serviceUuidStr, found := kubernetesService.Labels[label_key_consts.GuidLabelKey]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on Kubernetes service '%v' but it was missing", label_key_consts.GuidLabelKey, kubernetesService.Name)
+		}
+		result[service.ServiceUUID(serviceUuidStr)] = kubernetesService
+	}
+	return result, nil
+}
+
+// Returns the services matching the filters, along with the pods of the ones still having one. Services that are only
+// registered aren't returned, as they don't exist for the Kurtosis backend until they are started
+func (backend *KubernetesKurtosisBackend) getMatchingUserServicesAndPods(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.Service,
+	map[service.ServiceUUID]*apiv1.Pod,
+	error,
+) {
+	kubernetesServicesByUuid, err := backend.getUserServiceKubernetesServicesByUuid(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes services of enclave '%v'", enclaveUuid)
+	}
+	pods, err := backend.kubernetesManager.GetPodsByLabels(
+		ctx,
+		getEnclaveNamespaceName(enclaveUuid),
+		getEnclaveObjectLabels(enclaveUuid, label_value_consts.UserServiceResourceTypeLabelValue),
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the pods of enclave '%v'", enclaveUuid)
+	}
+	podsByUuidStr := getPodsByLabelValue(pods, label_key_consts.GuidLabelKey)
+
+	matchingServices := map[service.ServiceUUID]*service.Service{}
+	matchingPods := map[service.ServiceUUID]*apiv1.Pod{}
+	for serviceUuid, kubernetesService := range kubernetesServicesByUuid {
+		if _, found := kubernetesService.Annotations[annotation_key_consts.PortSpecsAnnotationKey]; !found {
+			continue
+		}
+		if len(filters.UUIDs) > 0 && !filters.UUIDs[serviceUuid] {
+			continue
+		}
+		if len(filters.Names) > 0 && !filters.Names[service.ServiceName(kubernetesService.Name)] {
+			continue
+		}
+		pod, found := podsByUuidStr[string(serviceUuid)]
+		status := getContainerStatusFromPod(pod)
+		if len(filters.Statuses) > 0 && !filters.Statuses[status] {
+			continue
+		}
+		userService, err := getUserServiceFromKubernetesService(enclaveUuid, kubernetesService, status)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting service '%v' from its Kubernetes service", serviceUuid)
+		}
+		matchingServices[serviceUuid] = userService
+		if found {
+			matchingPods[serviceUuid] = pod
+		}
+	}
+	return matchingServices, matchingPods, nil
+}
+
+func (backend *KubernetesKurtosisBackend) getRunningUserServicePod(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*apiv1.Pod, error) {
+	filters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	_, podsByUuid, err := backend.getMatchingUserServicesAndPods(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	pod, found := podsByUuid[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("No running service with UUID '%v' was found in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return pod, nil
+}
+
+func getUserServiceFromKubernetesService(enclaveUuid enclave.EnclaveUUID, kubernetesService *apiv1.Service, status container_status.ContainerStatus) (*service.Service, error) {
+	serviceUuid := service.ServiceUUID(kubernetesService.Labels[label_key_consts.GuidLabelKey])
+	serviceName := service.ServiceName(kubernetesService.Name)
+	privateIp, err := getPrivateIpFromService(kubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the private IP of service '%v'", serviceName)
+	}
+	privatePorts := map[string]*port_spec.PortSpec{}
+	if serializedPortSpecs, found := kubernetesService.Annotations[annotation_key_consts.PortSpecsAnnotationKey]; found {
+		privatePorts, err = kubernetes_port_spec_serializer.DeserializePortSpecs(serializedPortSpecs)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the port specs of service '%v'", serviceName)
+		}
+	}
+	registration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveUuid, privateIp, string(serviceName))
+	// The services aren't reachable from outside the cluster, so they have no public IP and ports
+	return service.NewService(registration, status, privatePorts, nil, nil), nil
+}
+
+// Updates the Kubernetes service of the user service with its ports, and creates its pod. The updated Kubernetes service
+// is returned
+func (backend *KubernetesKurtosisBackend) createUserServicePod(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	serviceConfig *service.ServiceConfig,
+	kubernetesService *apiv1.Service,
+	isPartitioningEnabled bool,
+) (*apiv1.Service, error) {
+	if len(serviceConfig.GetPublicPorts()) > 0 {
+		return nil, stacktrace.NewError("Service '%v' declares public ports, which aren't supported in Kubernetes", serviceUuid)
+	}
+	if serviceConfig.GetPrivateIPAddrPlaceholder() == "" {
+		return nil, stacktrace.NewError("Service with UUID '%v' has an empty private IP Address placeholder. Expect this to be of length greater than zero.", serviceUuid)
+	}
+	privateIp, err := getPrivateIpFromService(kubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the private IP of service '%v'", serviceUuid)
+	}
+
+	privatePorts := serviceConfig.GetPrivatePorts()
+	containerPorts, err := getKubernetesContainerPorts(privatePorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the container ports of service '%v'", serviceUuid)
+	}
+	servicePorts, err := getKubernetesServicePorts(privatePorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes service ports of service '%v'", serviceUuid)
+	}
+	if len(servicePorts) == 0 {
+		servicePorts = unboundServicePorts
+	}
+	serializedPortSpecs, err := kubernetes_port_spec_serializer.SerializePortSpecs(privatePorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the port specs of service '%v'", serviceUuid)
+	}
+
+	// We replace the placeholder value with the actual private IP address
+	privateIpAddrPlaceholder := serviceConfig.GetPrivateIPAddrPlaceholder()
+	privateIpAddrStr := privateIp.String()
+	entrypointArgs := replacePlaceholderInStrings(serviceConfig.GetEntrypointArgs(), privateIpAddrPlaceholder, privateIpAddrStr)
+	cmdArgs := replacePlaceholderInStrings(serviceConfig.GetCmdArgs(), privateIpAddrPlaceholder, privateIpAddrStr)
+	envVars := map[string]string{}
+	for key, value := range serviceConfig.GetEnvVars() {
+		envVars[key] = strings.Replace(value, privateIpAddrPlaceholder, privateIpAddrStr, unlimitedReplacements)
+	}
+
+	resourceLimits := apiv1.ResourceList{}
+	if cpuAllocationMillicpus := serviceConfig.GetCPUAllocationMillicpus(); cpuAllocationMillicpus > 0 {
+		resourceLimits[apiv1.ResourceCPU] = *resource.NewMilliQuantity(int64(cpuAllocationMillicpus), resource.DecimalSI)
+	}
+	if memoryAllocationMegabytes := serviceConfig.GetMemoryAllocationMegabytes(); memoryAllocationMegabytes > 0 {
+		resourceLimits[apiv1.ResourceMemory] = *resource.NewQuantity(int64(memoryAllocationMegabytes)*megabytesToBytesFactor, resource.BinarySI)
+	}
+
+	userServiceContainer := apiv1.Container{
+		Name:    userServiceContainerName,
+		Image:   serviceConfig.GetContainerImageName(),
+		Command: entrypointArgs,
+		Args:    cmdArgs,
+		Env:     getKubernetesEnvVars(envVars),
+		Ports:   containerPorts,
+		Resources: apiv1.ResourceRequirements{
+			Limits: resourceLimits,
+		},
+	}
+
+	var initContainers []apiv1.Container
+	var volumes []apiv1.Volume
+	if filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion(); filesArtifactsExpansion != nil {
+		expanderContainer := apiv1.Container{
+			Name:  filesArtifactsExpanderContainerName,
+			Image: filesArtifactsExpansion.ExpanderImage,
+			Env:   getKubernetesEnvVars(filesArtifactsExpansion.ExpanderEnvVars),
+		}
+		expanderDirpaths := []string{}
+		for expanderDirpath := range filesArtifactsExpansion.ExpanderDirpathsToServiceDirpaths {
+			expanderDirpaths = append(expanderDirpaths, expanderDirpath)
+		}
+		sort.Strings(expanderDirpaths)
+		// The expander runs as an init container, so the files artifacts are expanded into volumes shared with the
+		// user service before it starts
+		for index, expanderDirpath := range expanderDirpaths {
+			volumeName := fmt.Sprintf(filesArtifactsExpansionVolumeNameFormat, index)
+			volumes = append(volumes, apiv1.Volume{
+				Name: volumeName,
+				VolumeSource: apiv1.VolumeSource{
+					EmptyDir: &apiv1.EmptyDirVolumeSource{},
+				},
+			})
+			expanderContainer.VolumeMounts = append(expanderContainer.VolumeMounts, apiv1.VolumeMount{
+				Name:      volumeName,
+				MountPath: expanderDirpath,
+			})
+			userServiceContainer.VolumeMounts = append(userServiceContainer.VolumeMounts, apiv1.VolumeMount{
+				Name:      volumeName,
+				MountPath: filesArtifactsExpansion.ExpanderDirpathsToServiceDirpaths[expanderDirpath],
+			})
+		}
+		initContainers = append(initContainers, expanderContainer)
+	}
+
+	// The first container must be the user service one
+	containers := []apiv1.Container{userServiceContainer}
+	if isPartitioningEnabled {
+		containers = append(containers, apiv1.Container{
+			Name:    networkingSidecarContainerName,
+			Image:   networkingSidecarImageName,
+			Command: networkingSidecarCommand,
+			SecurityContext: &apiv1.SecurityContext{
+				Capabilities: &apiv1.Capabilities{
+					Add: []apiv1.Capability{"NET_ADMIN"},
+				},
+			},
+		})
+	}
+
+	updatedKubernetesService := kubernetesService.DeepCopy()
+	updatedKubernetesService.Spec.Ports = servicePorts
+	if updatedKubernetesService.Annotations == nil {
+		updatedKubernetesService.Annotations = map[string]string{}
+	}
+	updatedKubernetesService.Annotations[annotation_key_consts.PortSpecsAnnotationKey] = serializedPortSpecs
+	updatedKubernetesService, err = backend.kubernetesManager.UpdateService(ctx, updatedKubernetesService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the Kubernetes service of service '%v' with its ports", serviceUuid)
+	}
+
+	if _, err := backend.kubernetesManager.CreatePod(
+		ctx,
+		kubernetesService.Namespace,
+		kubernetesService.Name,
+		getUserServiceObjectLabels(enclaveUuid, serviceUuid),
+		map[string]string{},
+		initContainers,
+		containers,
+		volumes,
+		"",
+	); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the pod of service '%v'", serviceUuid)
+	}
+	return updatedKubernetesService, nil
+}
+
+func replacePlaceholderInStrings(values []string, placeholder string, replacement string) []string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, len(values))
+	for index, value := range values {
+		result[index] = strings.Replace(value, placeholder, replacement, unlimitedReplacements)
+	}
+	return result
+}
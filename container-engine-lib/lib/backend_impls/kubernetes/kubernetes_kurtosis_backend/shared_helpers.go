@@ -0,0 +1,156 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	enclaveNamespacePrefix = "kurtosis-enclave-"
+	engineNamespacePrefix  = "kurtosis-engine-"
+
+	enclaveDataPersistentVolumeClaimName = "kurtosis-enclave-data"
+	enclaveDataVolumeName                = "enclave-data"
+
+	// Kubernetes port names must be unique, at most 15 characters long and contain a letter
+	kubernetesPortNameFormat = "p%d-%s"
+)
+
+var kurtosisTransportProtocolToKubernetesProtocol = map[port_spec.TransportProtocol]apiv1.Protocol{
+	port_spec.TransportProtocol_TCP:  apiv1.ProtocolTCP,
+	port_spec.TransportProtocol_UDP:  apiv1.ProtocolUDP,
+	port_spec.TransportProtocol_SCTP: apiv1.ProtocolSCTP,
+}
+
+// The namespace of an enclave is derived from its UUID, so the API container can find it without having permissions
+// to list namespaces
+func getEnclaveNamespaceName(enclaveUuid enclave.EnclaveUUID) string {
+	return enclaveNamespacePrefix + string(enclaveUuid)
+}
+
+func getKurtosisLabels(resourceTypeLabelValue string) map[string]string {
+	return map[string]string{
+		label_key_consts.AppIdLabelKey:        label_value_consts.AppIdLabelValue,
+		label_key_consts.ResourceTypeLabelKey: resourceTypeLabelValue,
+	}
+}
+
+func getEnclaveObjectLabels(enclaveUuid enclave.EnclaveUUID, resourceTypeLabelValue string) map[string]string {
+	objectLabels := getKurtosisLabels(resourceTypeLabelValue)
+	objectLabels[label_key_consts.EnclaveUuidLabelKey] = string(enclaveUuid)
+	return objectLabels
+}
+
+func getContainerStatusFromPod(pod *apiv1.Pod) container_status.ContainerStatus {
+	if pod != nil && pod.Status.Phase == apiv1.PodRunning && pod.DeletionTimestamp == nil {
+		return container_status.ContainerStatus_Running
+	}
+	return container_status.ContainerStatus_Stopped
+}
+
+func getPrivateIpFromService(kubernetesService *apiv1.Service) (net.IP, error) {
+	privateIp := net.ParseIP(kubernetesService.Spec.ClusterIP)
+	if privateIp == nil {
+		return nil, stacktrace.NewError("Expected Kubernetes service '%v' in namespace '%v' to have a valid cluster IP but got '%v'", kubernetesService.Name, kubernetesService.Namespace, kubernetesService.Spec.ClusterIP)
+	}
+	return privateIp, nil
+}
+
+func getKubernetesPortName(portSpec *port_spec.PortSpec) string {
+	return fmt.Sprintf(kubernetesPortNameFormat, portSpec.GetNumber(), strings.ToLower(portSpec.GetTransportProtocol().String()))
+}
+
+// Kurtosis ports are identified by their ID whereas Kubernetes ports are identified by their number and protocol, so
+// ports sharing the same number and protocol end up as a single Kubernetes port
+func getKubernetesServicePorts(ports map[string]*port_spec.PortSpec) ([]apiv1.ServicePort, error) {
+	result := []apiv1.ServicePort{}
+	usedPortNames := map[string]bool{}
+	for portId, portSpec := range ports {
+		protocol, found := kurtosisTransportProtocolToKubernetesProtocol[portSpec.GetTransportProtocol()]
+		if !found {
+			return nil, stacktrace.NewError("Port '%v' uses transport protocol '%v' which has no Kubernetes equivalent", portId, portSpec.GetTransportProtocol())
+		}
+		portName := getKubernetesPortName(portSpec)
+		if usedPortNames[portName] {
+			continue
+		}
+		usedPortNames[portName] = true
+		result = append(result, apiv1.ServicePort{
+			Name:     portName,
+			Protocol: protocol,
+			Port:     int32(portSpec.GetNumber()),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+func getKubernetesContainerPorts(ports map[string]*port_spec.PortSpec) ([]apiv1.ContainerPort, error) {
+	servicePorts, err := getKubernetesServicePorts(ports)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting the ports to Kubernetes ports")
+	}
+	result := []apiv1.ContainerPort{}
+	for _, servicePort := range servicePorts {
+		result = append(result, apiv1.ContainerPort{
+			Name:          servicePort.Name,
+			ContainerPort: servicePort.Port,
+			Protocol:      servicePort.Protocol,
+		})
+	}
+	return result, nil
+}
+
+func getKubernetesEnvVars(envVars map[string]string) []apiv1.EnvVar {
+	keys := []string{}
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	// Sorted so the pod specs are deterministic
+	sort.Strings(keys)
+	result := []apiv1.EnvVar{}
+	for _, key := range keys {
+		result = append(result, apiv1.EnvVar{
+			Name:  key,
+			Value: envVars[key],
+		})
+	}
+	return result
+}
+
+// Indexes the pods by the value they have for the given label, ignoring the pods without the label
+func getPodsByLabelValue(pods []apiv1.Pod, labelKey string) map[string]*apiv1.Pod {
+	result := map[string]*apiv1.Pod{}
+	for index := range pods {
+		pod := &pods[index]
+		labelValue, found := pod.Labels[labelKey]
+		if !found {
+			continue
+		}
+		result[labelValue] = pod
+	}
+	return result
+}
+
+func newKurtosisInternalGrpcPortSpecs(grpcPortNum uint16, grpcProxyPortNum uint16) (*port_spec.PortSpec, *port_spec.PortSpec, error) {
+	grpcPortSpec, err := port_spec.NewPortSpec(grpcPortNum, port_spec.TransportProtocol_TCP, "")
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the gRPC port spec")
+	}
+	grpcProxyPortSpec, err := port_spec.NewPortSpec(grpcProxyPortNum, port_spec.TransportProtocol_TCP, "")
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the gRPC proxy port spec")
+	}
+	return grpcPortSpec, grpcProxyPortSpec, nil
+}
@@ -0,0 +1,550 @@
+package kubernetes_manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"net"
+	"time"
+)
+
+const (
+	// Passing this as namespace to the list functions matches objects in all namespaces
+	AllNamespaces = ""
+
+	waitForPodPhaseRetriesDelay     = 500 * time.Millisecond
+	maxWaitForPodRunningRetries     = 240
+	maxWaitForPodDeletionRetries    = 120
+	podStatusWaitingReasonSeparator = ", "
+
+	megabytesToBytesFactor = 1024 * 1024
+
+	roleKind           = "Role"
+	clusterRoleKind    = "ClusterRole"
+	serviceAccountKind = "ServiceAccount"
+)
+
+// If one of the containers of a pod is waiting for one of these reasons, the pod will never start on its own
+var podWaitingReasonsConsideredFailures = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CrashLoopBackOff":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// KubernetesManager is a thin wrapper over the Kubernetes API, similar to what the DockerManager is to the Docker API.
+// It contains no Kurtosis logic
+type KubernetesManager struct {
+	kubernetesClientSet kubernetes.Interface
+
+	podExecutor PodExecutor
+
+	podPortForwarder PodPortForwarder
+}
+
+func NewKubernetesManager(kubernetesClientSet kubernetes.Interface, podExecutor PodExecutor, podPortForwarder PodPortForwarder) *KubernetesManager {
+	return &KubernetesManager{
+		kubernetesClientSet: kubernetesClientSet,
+		podExecutor:         podExecutor,
+		podPortForwarder:    podPortForwarder,
+	}
+}
+
+// ====================================================================================================
+//
+//	Namespaces
+//
+// ====================================================================================================
+func (manager *KubernetesManager) CreateNamespace(ctx context.Context, name string, namespaceLabels map[string]string, namespaceAnnotations map[string]string) (*apiv1.Namespace, error) {
+	namespace := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      namespaceLabels,
+			Annotations: namespaceAnnotations,
+		},
+	}
+	createdNamespace, err := manager.kubernetesClientSet.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating namespace '%v' with labels '%+v'", name, namespaceLabels)
+	}
+	return createdNamespace, nil
+}
+
+func (manager *KubernetesManager) GetNamespacesByLabels(ctx context.Context, searchLabels map[string]string) ([]apiv1.Namespace, error) {
+	namespaces, err := manager.kubernetesClientSet.CoreV1().Namespaces().List(ctx, newListOptions(searchLabels))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting namespaces matching labels '%+v'", searchLabels)
+	}
+	return namespaces.Items, nil
+}
+
+// RemoveNamespace deletes the namespace and, with it, all the objects it contains. It is a no-op if the namespace
+// doesn't exist
+func (manager *KubernetesManager) RemoveNamespace(ctx context.Context, name string) error {
+	if err := manager.kubernetesClientSet.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing namespace '%v'", name)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Access control
+//
+// ====================================================================================================
+func (manager *KubernetesManager) CreateServiceAccount(ctx context.Context, namespace string, name string, objectLabels map[string]string) (*apiv1.ServiceAccount, error) {
+	serviceAccount := &apiv1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+	}
+	createdServiceAccount, err := manager.kubernetesClientSet.CoreV1().ServiceAccounts(namespace).Create(ctx, serviceAccount, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating service account '%v' in namespace '%v'", name, namespace)
+	}
+	return createdServiceAccount, nil
+}
+
+func (manager *KubernetesManager) RemoveServiceAccount(ctx context.Context, namespace string, name string) error {
+	if err := manager.kubernetesClientSet.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing service account '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+// CreateRoleWithBinding creates a role with the given rules in the namespace, and binds it to the service account
+func (manager *KubernetesManager) CreateRoleWithBinding(ctx context.Context, namespace string, name string, rules []rbacv1.PolicyRule, serviceAccountName string, objectLabels map[string]string) error {
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+		Rules: rules,
+	}
+	if _, err := manager.kubernetesClientSet.RbacV1().Roles(namespace).Create(ctx, role, metav1.CreateOptions{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating role '%v' in namespace '%v'", name, namespace)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      serviceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     roleKind,
+			Name:     name,
+		},
+	}
+	if _, err := manager.kubernetesClientSet.RbacV1().RoleBindings(namespace).Create(ctx, roleBinding, metav1.CreateOptions{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating role binding '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+func (manager *KubernetesManager) RemoveRoleWithBinding(ctx context.Context, namespace string, name string) error {
+	if err := manager.kubernetesClientSet.RbacV1().RoleBindings(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing role binding '%v' in namespace '%v'", name, namespace)
+	}
+	if err := manager.kubernetesClientSet.RbacV1().Roles(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing role '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+// CreateClusterRoleWithBinding creates a cluster-wide role with the given rules, and binds it to the service account
+func (manager *KubernetesManager) CreateClusterRoleWithBinding(ctx context.Context, name string, rules []rbacv1.PolicyRule, serviceAccountNamespace string, serviceAccountName string, objectLabels map[string]string) error {
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: objectLabels,
+		},
+		Rules: rules,
+	}
+	if _, err := manager.kubernetesClientSet.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating cluster role '%v'", name)
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: objectLabels,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      serviceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     clusterRoleKind,
+			Name:     name,
+		},
+	}
+	if _, err := manager.kubernetesClientSet.RbacV1().ClusterRoleBindings().Create(ctx, clusterRoleBinding, metav1.CreateOptions{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating cluster role binding '%v'", name)
+	}
+	return nil
+}
+
+func (manager *KubernetesManager) RemoveClusterRoleWithBinding(ctx context.Context, name string) error {
+	if err := manager.kubernetesClientSet.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing cluster role binding '%v'", name)
+	}
+	if err := manager.kubernetesClientSet.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing cluster role '%v'", name)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Persistent volume claims
+//
+// ====================================================================================================
+
+// CreatePersistentVolumeClaim creates a claim of the given size. If the storage class is empty, the default storage
+// class of the cluster is used
+func (manager *KubernetesManager) CreatePersistentVolumeClaim(ctx context.Context, namespace string, name string, objectLabels map[string]string, storageClass string, sizeInMegabytes uint) (*apiv1.PersistentVolumeClaim, error) {
+	var maybeStorageClass *string
+	if storageClass != "" {
+		maybeStorageClass = &storageClass
+	}
+	quantity := resource.NewQuantity(int64(sizeInMegabytes)*megabytesToBytesFactor, resource.BinarySI)
+	persistentVolumeClaim := &apiv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+		Spec: apiv1.PersistentVolumeClaimSpec{
+			AccessModes: []apiv1.PersistentVolumeAccessMode{
+				apiv1.ReadWriteOnce,
+			},
+			Resources: apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{
+					apiv1.ResourceStorage: *quantity,
+				},
+			},
+			StorageClassName: maybeStorageClass,
+		},
+	}
+	createdPersistentVolumeClaim, err := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace).Create(ctx, persistentVolumeClaim, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating persistent volume claim '%v' in namespace '%v'", name, namespace)
+	}
+	return createdPersistentVolumeClaim, nil
+}
+
+func (manager *KubernetesManager) GetPersistentVolumeClaimsByLabels(ctx context.Context, namespace string, searchLabels map[string]string) ([]apiv1.PersistentVolumeClaim, error) {
+	persistentVolumeClaims, err := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace).List(ctx, newListOptions(searchLabels))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent volume claims matching labels '%+v' in namespace '%v'", searchLabels, namespace)
+	}
+	return persistentVolumeClaims.Items, nil
+}
+
+// ====================================================================================================
+//
+//	Secrets
+//
+// ====================================================================================================
+func (manager *KubernetesManager) CreateSecret(ctx context.Context, namespace string, name string, objectLabels map[string]string, data map[string][]byte) (*apiv1.Secret, error) {
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+		Type: apiv1.SecretTypeOpaque,
+		Data: data,
+	}
+	createdSecret, err := manager.kubernetesClientSet.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating secret '%v' in namespace '%v'", name, namespace)
+	}
+	return createdSecret, nil
+}
+
+func (manager *KubernetesManager) RemoveSecret(ctx context.Context, namespace string, name string) error {
+	if err := manager.kubernetesClientSet.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing secret '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Services
+//
+// ====================================================================================================
+func (manager *KubernetesManager) CreateService(
+	ctx context.Context,
+	namespace string,
+	name string,
+	objectLabels map[string]string,
+	objectAnnotations map[string]string,
+	podSelector map[string]string,
+	ports []apiv1.ServicePort,
+) (*apiv1.Service, error) {
+	service := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      objectLabels,
+			Annotations: objectAnnotations,
+		},
+		Spec: apiv1.ServiceSpec{
+			Type:     apiv1.ServiceTypeClusterIP,
+			Selector: podSelector,
+			Ports:    ports,
+		},
+	}
+	createdService, err := manager.kubernetesClientSet.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating service '%v' in namespace '%v'", name, namespace)
+	}
+	return createdService, nil
+}
+
+func (manager *KubernetesManager) UpdateService(ctx context.Context, service *apiv1.Service) (*apiv1.Service, error) {
+	updatedService, err := manager.kubernetesClientSet.CoreV1().Services(service.Namespace).Update(ctx, service, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating service '%v' in namespace '%v'", service.Name, service.Namespace)
+	}
+	return updatedService, nil
+}
+
+func (manager *KubernetesManager) GetServicesByLabels(ctx context.Context, namespace string, searchLabels map[string]string) ([]apiv1.Service, error) {
+	services, err := manager.kubernetesClientSet.CoreV1().Services(namespace).List(ctx, newListOptions(searchLabels))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting services matching labels '%+v' in namespace '%v'", searchLabels, namespace)
+	}
+	return services.Items, nil
+}
+
+func (manager *KubernetesManager) RemoveService(ctx context.Context, namespace string, name string) error {
+	if err := manager.kubernetesClientSet.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "An error occurred removing service '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Pods
+//
+// ====================================================================================================
+func (manager *KubernetesManager) CreatePod(
+	ctx context.Context,
+	namespace string,
+	name string,
+	objectLabels map[string]string,
+	objectAnnotations map[string]string,
+	initContainers []apiv1.Container,
+	containers []apiv1.Container,
+	volumes []apiv1.Volume,
+	serviceAccountName string,
+) (*apiv1.Pod, error) {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      objectLabels,
+			Annotations: objectAnnotations,
+		},
+		Spec: apiv1.PodSpec{
+			InitContainers:     initContainers,
+			Containers:         containers,
+			Volumes:            volumes,
+			ServiceAccountName: serviceAccountName,
+			// Kurtosis containers are never restarted, like in the Docker backend
+			RestartPolicy: apiv1.RestartPolicyNever,
+		},
+	}
+	createdPod, err := manager.kubernetesClientSet.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' in namespace '%v'", name, namespace)
+	}
+	return createdPod, nil
+}
+
+func (manager *KubernetesManager) GetPodsByLabels(ctx context.Context, namespace string, searchLabels map[string]string) ([]apiv1.Pod, error) {
+	pods, err := manager.kubernetesClientSet.CoreV1().Pods(namespace).List(ctx, newListOptions(searchLabels))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting pods matching labels '%+v' in namespace '%v'", searchLabels, namespace)
+	}
+	return pods.Items, nil
+}
+
+// WaitForPodRunning waits until all the containers of the pod are running, and returns the running pod
+func (manager *KubernetesManager) WaitForPodRunning(ctx context.Context, namespace string, name string) (*apiv1.Pod, error) {
+	var lastPhase apiv1.PodPhase
+	for i := 0; i < maxWaitForPodRunningRetries; i++ {
+		pod, err := manager.kubernetesClientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting pod '%v' in namespace '%v' while waiting for it to be running", name, namespace)
+		}
+		lastPhase = pod.Status.Phase
+		switch lastPhase {
+		case apiv1.PodRunning:
+			return pod, nil
+		case apiv1.PodSucceeded, apiv1.PodFailed:
+			return nil, stacktrace.NewError("Pod '%v' in namespace '%v' exited before we could detect it running, with phase '%v'", name, namespace, lastPhase)
+		case apiv1.PodPending, apiv1.PodUnknown:
+			if failureReasons := getContainersFailureReasons(pod); len(failureReasons) > 0 {
+				return nil, stacktrace.NewError("Pod '%v' in namespace '%v' will never start as its containers are failing: %v", name, namespace, failureReasons)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, stacktrace.Propagate(ctx.Err(), "The context was cancelled while waiting for pod '%v' in namespace '%v' to be running", name, namespace)
+		case <-time.After(waitForPodPhaseRetriesDelay):
+		}
+	}
+	return nil, stacktrace.NewError("Pod '%v' in namespace '%v' didn't reach the running phase in time, its last phase was '%v'", name, namespace, lastPhase)
+}
+
+// RemovePod deletes the pod and waits for it to be gone, such that a pod with the same name can be created right away.
+// It is a no-op if the pod doesn't exist
+func (manager *KubernetesManager) RemovePod(ctx context.Context, namespace string, name string) error {
+	if err := manager.kubernetesClientSet.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "An error occurred removing pod '%v' in namespace '%v'", name, namespace)
+	}
+	for i := 0; i < maxWaitForPodDeletionRetries; i++ {
+		_, err := manager.kubernetesClientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting pod '%v' in namespace '%v' while waiting for it to be removed", name, namespace)
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "The context was cancelled while waiting for pod '%v' in namespace '%v' to be removed", name, namespace)
+		case <-time.After(waitForPodPhaseRetriesDelay):
+		}
+	}
+	return stacktrace.NewError("Pod '%v' in namespace '%v' was still present after waiting for its removal", name, namespace)
+}
+
+// GetContainerLogs returns the logs of the container of the pod. The caller is responsible for closing the reader
+func (manager *KubernetesManager) GetContainerLogs(ctx context.Context, namespace string, podName string, containerName string, shouldFollowLogs bool) (io.ReadCloser, error) {
+	logOptions := &apiv1.PodLogOptions{
+		Container: containerName,
+		Follow:    shouldFollowLogs,
+	}
+	logsReader, err := manager.kubernetesClientSet.CoreV1().Pods(namespace).GetLogs(podName, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of container '%v' of pod '%v' in namespace '%v'", containerName, podName, namespace)
+	}
+	return logsReader, nil
+}
+
+// RunExecCommand runs the command in the container and returns its exit code, writing its combined stdout and stderr
+// to the output
+func (manager *KubernetesManager) RunExecCommand(namespace string, podName string, containerName string, command []string, output io.Writer) (int32, error) {
+	exitCode, err := manager.podExecutor.Exec(namespace, podName, containerName, command, nil, output, output, false)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running exec command '%+v' in container '%v' of pod '%v' in namespace '%v'", command, containerName, podName, namespace)
+	}
+	return exitCode, nil
+}
+
+// RunExecCommandWithSeparatedOutput is like RunExecCommand but only writes the stdout of the command to the output,
+// returning its stderr separately. It is meant for commands producing binary output, like 'tar'
+func (manager *KubernetesManager) RunExecCommandWithSeparatedOutput(namespace string, podName string, containerName string, command []string, output io.Writer) (int32, string, error) {
+	errorOutput := &bytes.Buffer{}
+	exitCode, err := manager.podExecutor.Exec(namespace, podName, containerName, command, nil, output, errorOutput, false)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred running exec command '%+v' in container '%v' of pod '%v' in namespace '%v'", command, containerName, podName, namespace)
+	}
+	return exitCode, errorOutput.String(), nil
+}
+
+// RunExecCommandWithInput is like RunExecCommandWithSeparatedOutput but streams the input to the stdin of the command.
+// It is meant for commands consuming binary input, like 'tar'
+func (manager *KubernetesManager) RunExecCommandWithInput(namespace string, podName string, containerName string, command []string, input io.Reader, output io.Writer) (int32, string, error) {
+	errorOutput := &bytes.Buffer{}
+	exitCode, err := manager.podExecutor.Exec(namespace, podName, containerName, command, input, output, errorOutput, false)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred running exec command '%+v' in container '%v' of pod '%v' in namespace '%v'", command, containerName, podName, namespace)
+	}
+	return exitCode, errorOutput.String(), nil
+}
+
+// ForwardPodPort forwards the local port to the port of the pod until the context is cancelled or the connection to
+// the pod is lost. The ready channel is closed once the local port accepts connections
+func (manager *KubernetesManager) ForwardPodPort(ctx context.Context, namespace string, podName string, localPort uint16, podPort uint16, readyChan chan struct{}) error {
+	if err := manager.podPortForwarder.ForwardPort(ctx, namespace, podName, localPort, podPort, readyChan); err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding local port '%v' to port '%v' of pod '%v' in namespace '%v'", localPort, podPort, podName, namespace)
+	}
+	return nil
+}
+
+// GetExecStream starts the command with a TTY in the container and returns a connection attached to it
+func (manager *KubernetesManager) GetExecStream(namespace string, podName string, containerName string, command []string) (net.Conn, error) {
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		defer serverConn.Close()
+		if _, err := manager.podExecutor.Exec(namespace, podName, containerName, command, serverConn, serverConn, nil, true); err != nil {
+			logrus.Errorf("The interactive command '%+v' in container '%v' of pod '%v' in namespace '%v' exited with an error:\n%v", command, containerName, podName, namespace, err)
+		}
+	}()
+	return clientConn, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func newListOptions(searchLabels map[string]string) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(searchLabels).String(),
+	}
+}
+
+func getContainersFailureReasons(pod *apiv1.Pod) []string {
+	var failureReasons []string
+	allContainerStatuses := append(append([]apiv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, containerStatus := range allContainerStatuses {
+		waitingState := containerStatus.State.Waiting
+		if waitingState != nil && podWaitingReasonsConsideredFailures[waitingState.Reason] {
+			failureReasons = append(failureReasons, fmt.Sprintf("container '%v' is in state '%v' (%v)", containerStatus.Name, waitingState.Reason, waitingState.Message))
+		}
+		terminatedState := containerStatus.State.Terminated
+		if terminatedState != nil && terminatedState.ExitCode != successExecExitCode {
+			failureReasons = append(failureReasons, fmt.Sprintf("container '%v' exited with code %d (%v)", containerStatus.Name, terminatedState.ExitCode, terminatedState.Reason))
+		}
+	}
+	return failureReasons
+}
@@ -0,0 +1,101 @@
+package kubernetes_manager
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	podExecHttpMethod  = "POST"
+	podExecSubresource = "exec"
+
+	successExecExitCode = 0
+)
+
+// PodExecutor runs commands inside the containers of a pod. It is an interface because the Kubernetes exec API is
+// a streaming protocol that isn't supported by the fake clientsets, so tests can swap the implementation
+type PodExecutor interface {
+	// Exec runs the command inside the container of the pod and returns its exit code. An error is returned only if the
+	// command couldn't be run, not if it exited with a non-zero exit code
+	Exec(
+		namespace string,
+		podName string,
+		containerName string,
+		command []string,
+		stdin io.Reader,
+		stdout io.Writer,
+		stderr io.Writer,
+		tty bool,
+	) (int32, error)
+}
+
+// remoteCommandPodExecutor runs commands in pods using the SPDY-based 'exec' subresource of the Kubernetes API
+type remoteCommandPodExecutor struct {
+	kubernetesClientSet kubernetes.Interface
+
+	kubernetesRestConfig *rest.Config
+}
+
+func NewRemoteCommandPodExecutor(kubernetesClientSet kubernetes.Interface, kubernetesRestConfig *rest.Config) PodExecutor {
+	return &remoteCommandPodExecutor{
+		kubernetesClientSet:  kubernetesClientSet,
+		kubernetesRestConfig: kubernetesRestConfig,
+	}
+}
+
+func (executor *remoteCommandPodExecutor) Exec(
+	namespace string,
+	podName string,
+	containerName string,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+) (int32, error) {
+	execOptions := &apiv1.PodExecOptions{
+		Container: containerName,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		// A TTY merges stderr into stdout, and the API rejects requests asking for both
+		Stderr: stderr != nil && !tty,
+		TTY:    tty,
+	}
+	request := executor.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource(podExecSubresource).
+		VersionedParams(execOptions, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(executor.kubernetesRestConfig, podExecHttpMethod, request.URL())
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred creating the executor to run command '%+v' in container '%v' of pod '%v' in namespace '%v'", command, containerName, podName, namespace)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Stderr:            nil,
+		Tty:               tty,
+		TerminalSizeQueue: nil,
+	}
+	if execOptions.Stderr {
+		streamOptions.Stderr = stderr
+	}
+	if err := exec.Stream(streamOptions); err != nil {
+		if exitError, ok := err.(utilexec.ExitError); ok {
+			return int32(exitError.ExitStatus()), nil
+		}
+		return 0, stacktrace.Propagate(err, "An error occurred running command '%+v' in container '%v' of pod '%v' in namespace '%v'", command, containerName, podName, namespace)
+	}
+	return successExecExitCode, nil
+}
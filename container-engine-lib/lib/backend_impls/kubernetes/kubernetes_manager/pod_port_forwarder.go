@@ -0,0 +1,99 @@
+package kubernetes_manager
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"net/http"
+)
+
+const (
+	podPortForwardSubresource = "portforward"
+
+	localhostIpAddress = "127.0.0.1"
+)
+
+// PodPortForwarder forwards ports of the local machine to ports of pods. Like PodExecutor, it is an interface because
+// the Kubernetes port forwarding API is a streaming protocol that isn't supported by the fake clientsets
+type PodPortForwarder interface {
+	// ForwardPort forwards the local port to the port of the pod until the context is cancelled or the connection to
+	// the pod is lost. The ready channel is closed once the local port accepts connections
+	ForwardPort(
+		ctx context.Context,
+		namespace string,
+		podName string,
+		localPort uint16,
+		podPort uint16,
+		readyChan chan struct{},
+	) error
+}
+
+// spdyPodPortForwarder forwards ports using the SPDY-based 'portforward' subresource of the Kubernetes API, like
+// 'kubectl port-forward' does
+type spdyPodPortForwarder struct {
+	kubernetesClientSet kubernetes.Interface
+
+	kubernetesRestConfig *rest.Config
+}
+
+func NewSpdyPodPortForwarder(kubernetesClientSet kubernetes.Interface, kubernetesRestConfig *rest.Config) PodPortForwarder {
+	return &spdyPodPortForwarder{
+		kubernetesClientSet:  kubernetesClientSet,
+		kubernetesRestConfig: kubernetesRestConfig,
+	}
+}
+
+func (forwarder *spdyPodPortForwarder) ForwardPort(
+	ctx context.Context,
+	namespace string,
+	podName string,
+	localPort uint16,
+	podPort uint16,
+	readyChan chan struct{},
+) error {
+	request := forwarder.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource(podPortForwardSubresource)
+
+	transport, upgrader, err := spdy.RoundTripperFor(forwarder.kubernetesRestConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the transport to forward port '%v' of pod '%v' in namespace '%v'", podPort, podName, namespace)
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, request.URL()) //nolint:exhaustruct
+
+	stopChan := make(chan struct{})
+	forwardingDoneChan := make(chan struct{})
+	defer close(forwardingDoneChan)
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(stopChan)
+		case <-forwardingDoneChan:
+		}
+	}()
+
+	portForwarder, err := portforward.NewOnAddresses(
+		dialer,
+		[]string{localhostIpAddress},
+		[]string{fmt.Sprintf("%d:%d", localPort, podPort)},
+		stopChan,
+		readyChan,
+		io.Discard,
+		io.Discard,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the forwarder of local port '%v' to port '%v' of pod '%v' in namespace '%v'", localPort, podPort, podName, namespace)
+	}
+	if err := portForwarder.ForwardPorts(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding local port '%v' to port '%v' of pod '%v' in namespace '%v'", localPort, podPort, podName, namespace)
+	}
+	return nil
+}
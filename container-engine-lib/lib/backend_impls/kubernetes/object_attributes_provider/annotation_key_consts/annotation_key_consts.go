@@ -0,0 +1,18 @@
+package annotation_key_consts
+
+// Unlike labels, annotations can't be used to select objects but have no restriction on their values, so they store
+// the Kurtosis attributes that don't fit in a label
+const (
+	annotationNamespaceStr = "kurtosistech.com/"
+
+	EnclaveNameAnnotationKey = annotationNamespaceStr + "enclave-name"
+
+	// Stored in the RFC3339 format
+	EnclaveCreationTimeAnnotationKey = annotationNamespaceStr + "enclave-creation-time"
+
+	PortSpecsAnnotationKey = annotationNamespaceStr + "ports"
+
+	// The ports of the local machine the gateway forwards to the API container of an enclave
+	GatewayGrpcPortAnnotationKey      = annotationNamespaceStr + "gateway-grpc-port"
+	GatewayGrpcProxyPortAnnotationKey = annotationNamespaceStr + "gateway-grpc-proxy-port"
+)
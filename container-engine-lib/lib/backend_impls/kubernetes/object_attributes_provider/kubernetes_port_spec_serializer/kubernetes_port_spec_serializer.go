@@ -0,0 +1,54 @@
+package kubernetes_port_spec_serializer
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Kubernetes annotations have no restriction on their values, so the port specs are stored as JSON
+type serializablePortSpec struct {
+	Number              uint16 `json:"number"`
+	TransportProtocol   string `json:"transportProtocol"`
+	ApplicationProtocol string `json:"applicationProtocol,omitempty"`
+}
+
+func SerializePortSpecs(ports map[string]*port_spec.PortSpec) (string, error) {
+	serializablePorts := map[string]serializablePortSpec{}
+	for portId, spec := range ports {
+		applicationProtocol := ""
+		if maybeApplicationProtocol := spec.GetMaybeApplicationProtocol(); maybeApplicationProtocol != nil {
+			applicationProtocol = *maybeApplicationProtocol
+		}
+		serializablePorts[portId] = serializablePortSpec{
+			Number:              spec.GetNumber(),
+			TransportProtocol:   spec.GetTransportProtocol().String(),
+			ApplicationProtocol: applicationProtocol,
+		}
+	}
+	serializedPorts, err := json.Marshal(serializablePorts)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing port specs '%+v'", ports)
+	}
+	return string(serializedPorts), nil
+}
+
+func DeserializePortSpecs(specsStr string) (map[string]*port_spec.PortSpec, error) {
+	serializablePorts := map[string]serializablePortSpec{}
+	if err := json.Unmarshal([]byte(specsStr), &serializablePorts); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing port specs string '%v'", specsStr)
+	}
+	result := map[string]*port_spec.PortSpec{}
+	for portId, serializablePort := range serializablePorts {
+		transportProtocol, err := port_spec.TransportProtocolString(serializablePort.TransportProtocol)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unrecognized transport protocol '%v' for port '%v'", serializablePort.TransportProtocol, portId)
+		}
+		spec, err := port_spec.NewPortSpec(serializablePort.Number, transportProtocol, serializablePort.ApplicationProtocol)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the spec of port '%v'", portId)
+		}
+		result[portId] = spec
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package kubernetes_port_spec_serializer
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPortSpecsRoundTrip(t *testing.T) {
+	httpPort, err := port_spec.NewPortSpec(8080, port_spec.TransportProtocol_TCP, "http")
+	require.NoError(t, err)
+	dnsPort, err := port_spec.NewPortSpec(53, port_spec.TransportProtocol_UDP, "")
+	require.NoError(t, err)
+	ports := map[string]*port_spec.PortSpec{
+		"http": httpPort,
+		"dns":  dnsPort,
+	}
+
+	serializedPorts, err := SerializePortSpecs(ports)
+	require.NoError(t, err)
+
+	deserializedPorts, err := DeserializePortSpecs(serializedPorts)
+	require.NoError(t, err)
+	require.Equal(t, ports, deserializedPorts)
+}
+
+func TestDeserializePortSpecs_UnknownTransportProtocol(t *testing.T) {
+	_, err := DeserializePortSpecs(`{"http":{"number":80,"transportProtocol":"QUIC"}}`)
+	require.Error(t, err)
+}
@@ -0,0 +1,26 @@
+package label_key_consts
+
+const (
+	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+	// If these value change, it will lead to the Kurtosis engine losing track of old Kubernetes objects
+	// which will cause a resource leak on the user's cluster!
+	//
+	// If you add new immutable values to this section, MAKE SURE TO UPDATE THE UNIT TEST!
+	// These immutable values track resources between Kurtosis versions.
+	labelNamespaceStr = "kurtosistech.com/"
+	AppIdLabelKey     = labelNamespaceStr + "app-id"
+	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+	// The type of Kurtosis resource (engine, enclave, user service, ...) that the Kubernetes object backs
+	ResourceTypeLabelKey = labelNamespaceStr + "resource-type"
+
+	// Used for things like engine GUID, user service UUID, etc.
+	GuidLabelKey = labelNamespaceStr + "guid"
+
+	// A label to identify a Kurtosis resource by its id, like the engine ID
+	IdLabelKey = labelNamespaceStr + "id"
+
+	EnclaveUuidLabelKey = labelNamespaceStr + "enclave-uuid"
+
+	IsNetworkPartitioningEnabledLabelKey = labelNamespaceStr + "is-network-partitioning-enabled"
+)
@@ -0,0 +1,31 @@
+package label_key_consts
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// We expect these strings to be reliable between versions.
+const (
+	expectedLabelNamespaceStr = "kurtosistech.com/"
+	expectedAppIdLabelKeyStr  = "kurtosistech.com/app-id"
+)
+
+// When Kurtosis versions change, these particular label key strings must be equal.
+// If these change between versions, Kurtosis will not be able to find and manage resources with these label keys.
+// They will effectively be lost to Kurtosis and the user will have to clean up any mess.
+var crossVersionLabelKeyStringsToEnsure = map[string]string{
+	labelNamespaceStr: expectedLabelNamespaceStr,
+	AppIdLabelKey:     expectedAppIdLabelKeyStr,
+}
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// It is VERY important that certain constants don't get modified, else Kurtosis will silently lose track
+// of preexisting resources (thereby causing a resource leak). This test ensures that certain constants
+// are never modified.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+func TestImmutableConstantsArentModified(t *testing.T) {
+	for actualValue, expectedValue := range crossVersionLabelKeyStringsToEnsure {
+		require.Equal(t, expectedValue, actualValue, "An immutable label key string was modified! Got '%v' but should be '%v'", actualValue, expectedValue)
+	}
+}
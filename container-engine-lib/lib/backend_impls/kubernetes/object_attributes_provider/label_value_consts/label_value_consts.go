@@ -0,0 +1,21 @@
+package label_value_consts
+
+const (
+	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+	// If these value change, it will lead to the Kurtosis engine losing track of old Kubernetes objects
+	//  which will cause a resource leak on the user's cluster!
+	//
+	//   If you add new immutable values to this section, MAKE SURE TO UPDATE THE UNIT TEST!
+	//
+	AppIdLabelValue              = "kurtosis"
+	EngineResourceTypeLabelValue = "kurtosis-engine"
+	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+	EnclaveResourceTypeLabelValue      = "enclave"
+	APIContainerResourceTypeLabelValue = "api-container"
+	UserServiceResourceTypeLabelValue  = "user-service"
+	EnclaveDataResourceTypeLabelValue  = "enclave-data"
+
+	NetworkPartitioningEnabledLabelValue  = "true"
+	NetworkPartitioningDisabledLabelValue = "false"
+)
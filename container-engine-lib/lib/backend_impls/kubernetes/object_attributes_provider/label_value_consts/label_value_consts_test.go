@@ -0,0 +1,31 @@
+package label_value_consts
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// We expect these strings to be reliable between versions.
+const (
+	expectedAppIdLabelValueStr              = "kurtosis"
+	expectedEngineResourceTypeLabelValueStr = "kurtosis-engine"
+)
+
+// When Kurtosis versions change, these particular label value strings must be equal.
+// If these change between versions, Kurtosis will not be able to find and manage resources with these label values.
+// They will effectively be lost to Kurtosis and the user will have to clean up any mess.
+var crossVersionLabelValueStringsToEnsure = map[string]string{
+	AppIdLabelValue:              expectedAppIdLabelValueStr,
+	EngineResourceTypeLabelValue: expectedEngineResourceTypeLabelValueStr,
+}
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// It is VERY important that certain constants don't get modified, else Kurtosis will silently lose track
+// of preexisting resources (thereby causing a resource leak). This test ensures that certain constants
+// are never modified.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+func TestImmutableConstantsArentModified(t *testing.T) {
+	for actualValue, expectedValue := range crossVersionLabelValueStringsToEnsure {
+		require.Equal(t, expectedValue, actualValue, "An immutable label value string was modified! Got '%v' but should be '%v'", actualValue, expectedValue)
+	}
+}
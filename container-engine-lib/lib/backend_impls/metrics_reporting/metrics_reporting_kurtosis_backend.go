@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
 	"io"
@@ -32,6 +33,20 @@ func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context,
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) SaveImages(ctx context.Context, imageNames []string, output io.Writer) error {
+	if err := backend.underlying.SaveImages(ctx, imageNames, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving images '%+v'", imageNames)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) LoadImages(ctx context.Context, imagesTarContent io.Reader) error {
+	if err := backend.underlying.LoadImages(ctx, imagesTarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading images")
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -87,10 +102,10 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEngines(ctx context.Conte
 	return successes, failures, nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool, isIpv6Enabled bool) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v' and is-partitioning-enabled value '%v'", enclaveUuid, isPartitioningEnabled)
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v', is-partitioning-enabled value '%v' and is-IPv6-enabled value '%v'", enclaveUuid, isPartitioningEnabled, isIpv6Enabled)
 	}
 	return result, nil
 }
@@ -124,6 +139,21 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+	erroredEnclaveIds map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.StartEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting enclaves using filters: %+v", filters)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -159,6 +189,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 	enclaveDataVolumeDirpath string,
 	ownIpEnvVar string,
 	customEnvVars map[string]string,
+	secretFiles map[string][]byte,
 ) (*api_container.APIContainer, error) {
 	if _, found := customEnvVars[ownIpEnvVar]; found {
 		return nil, stacktrace.NewError("Requested own IP environment variable '%v' conflicts with custom environment variable", ownIpEnvVar)
@@ -173,6 +204,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 		enclaveDataVolumeDirpath,
 		ownIpEnvVar,
 		customEnvVars,
+		secretFiles,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -209,6 +241,54 @@ func (backend *MetricsReportingKurtosisBackend) DestroyAPIContainers(ctx context
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesFromAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	srcPath string,
+	output io.Writer,
+) error {
+	if err := backend.underlying.CopyFilesFromAPIContainer(ctx, enclaveUuid, srcPath, output); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files from sourcepath '%v' in the API container of enclave with UUID '%v'",
+			srcPath,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToAPIContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	destDirpath string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToAPIContainer(ctx, enclaveUuid, destDirpath, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to directory '%v' in the API container of enclave with UUID '%v'",
+			destDirpath,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) PauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	if err := backend.underlying.PauseAPIContainer(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pausing the API container of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UnpauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	if err := backend.underlying.UnpauseAPIContainer(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unpausing the API container of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RegisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
 	successes, failures, err := backend.underlying.RegisterUserServices(ctx, enclaveUuid, services)
 	if err != nil {
@@ -344,6 +424,22 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CreateUserServiceImages(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	successfulUserServiceImageNames map[service.ServiceUUID]string,
+	erroredUserServiceUuids map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.CreateUserServiceImages(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating images of user services in enclave '%v' using filters: %+v", enclaveUuid, filters)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -421,6 +517,24 @@ func (backend *MetricsReportingKurtosisBackend) RunNetworkingSidecarExecCommands
 	return successfulNetworkingSidecarExecResults, erroredUserServiceUuids, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) RunNetworkingSidecarExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	networkingSidecarCommand []string,
+	output io.Writer,
+) (
+	int32,
+	string,
+	error,
+) {
+	exitCode, errorOutput, err := backend.underlying.RunNetworkingSidecarExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred running command '%+v' in the networking sidecar of user service with UUID '%v' in enclave with UUID '%v'", networkingSidecarCommand, serviceUuid, enclaveUuid)
+	}
+	return exitCode, errorOutput, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopNetworkingSidecars(
 	ctx context.Context,
 	filters *networking_sidecar.NetworkingSidecarFilters,
@@ -451,6 +565,63 @@ func (backend *MetricsReportingKurtosisBackend) DestroyNetworkingSidecars(
 	return successfulUserServiceUuids, erroredUserServiceUuids, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveBlockedTraffic(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	blockedTraffic map[string]map[string]bool,
+) error {
+	if err := backend.underlying.UpdateEnclaveBlockedTraffic(ctx, enclaveUuid, blockedTraffic); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the traffic blocked in enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CreatePortForward(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	portId string,
+	localPortNumber uint16,
+) (
+	*port_forward.PortForward,
+	error,
+) {
+	portForward, err := backend.underlying.CreatePortForward(ctx, enclaveUuid, serviceUuid, portId, localPortNumber)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred forwarding local port '%v' to port '%v' of service '%v' in enclave '%v'", localPortNumber, portId, serviceUuid, enclaveUuid)
+	}
+	return portForward, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetPortForwards(
+	ctx context.Context,
+	filters *port_forward.PortForwardFilters,
+) (
+	map[uint16]*port_forward.PortForward,
+	error,
+) {
+	portForwards, err := backend.underlying.GetPortForwards(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting port forwards using filters '%+v'", filters)
+	}
+	return portForwards, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyPortForwards(
+	ctx context.Context,
+	filters *port_forward.PortForwardFilters,
+) (
+	map[uint16]bool,
+	map[uint16]error,
+	error,
+) {
+	successfulLocalPortNumbers, erroredLocalPortNumbers, err := backend.underlying.DestroyPortForwards(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying port forwards using filters '%+v'", filters)
+	}
+	return successfulLocalPortNumbers, erroredLocalPortNumbers, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateLogsDatabase(
 	ctx context.Context,
 	logsDatabaseHttpPortNumber uint16,
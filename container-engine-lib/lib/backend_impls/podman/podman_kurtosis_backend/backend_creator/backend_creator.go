@@ -0,0 +1,148 @@
+package backend_creator
+
+import (
+	"context"
+	"github.com/docker/docker/client"
+	docker_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/podman/podman_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path"
+	"strings"
+)
+
+// Podman serves the Docker Engine API on its socket, so the Podman backend drives it with the Docker backend logic.
+// This means that enclaves, services, etc. get exactly the same labels, names & port serialization as in Docker.
+// Rootless Podman is supported too, except that its containers can't change the firewall of the host, so when it's
+// detected the backend refuses to block traffic between services with the firewall (see RootlessPodmanKurtosisBackend).
+
+const (
+	// Set by 'podman system connection' & friends to point to the Podman socket to use
+	containerHostEnvVar = "CONTAINER_HOST"
+
+	unixSocketScheme = "unix://"
+
+	xdgRuntimeDirEnvVar = "XDG_RUNTIME_DIR"
+
+	// Relative to XDG_RUNTIME_DIR
+	rootlessPodmanSocketRelativeFilepath = "podman/podman.sock"
+
+	rootfulPodmanSocketFilepath = "/run/podman/podman.sock"
+
+	// Podman reports this security option in the Docker-compatible system info when it runs rootless
+	rootlessSecurityOption = "name=rootless"
+)
+
+// GetLocalPodmanKurtosisBackend returns a backend talking to the Podman running on this machine; see
+// GetLocalPodmanSocketFilepath for how its socket is found
+// ONLY the API container should pass in the extra API container args, which will unlock extra API container functionality
+func GetLocalPodmanKurtosisBackend(
+	ctx context.Context,
+	optionalApiContainerModeArgs *docker_backend_creator.APIContainerModeArgs,
+) (backend_interface.KurtosisBackend, error) {
+	podmanSocketFilepath, err := GetLocalPodmanSocketFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the filepath of the local Podman socket")
+	}
+	backend, err := getPodmanKurtosisBackend(ctx, podmanSocketFilepath, podmanSocketFilepath, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Podman Kurtosis backend using socket '%v'", podmanSocketFilepath)
+	}
+	return backend, nil
+}
+
+// GetInContainerPodmanKurtosisBackend returns a backend for the engine & API container, which talk to Podman through
+// the socket that is bind-mounted into them. The host socket filepath is the one that was bind-mounted, which they'll
+// bind-mount in turn into the containers they create
+// ONLY the API container should pass in the extra API container args, which will unlock extra API container functionality
+func GetInContainerPodmanKurtosisBackend(
+	ctx context.Context,
+	hostPodmanSocketFilepath string,
+	optionalApiContainerModeArgs *docker_backend_creator.APIContainerModeArgs,
+) (backend_interface.KurtosisBackend, error) {
+	backend, err := getPodmanKurtosisBackend(ctx, consts.DockerSocketFilepath, hostPodmanSocketFilepath, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Podman Kurtosis backend using the socket bind-mounted from host path '%v'", hostPodmanSocketFilepath)
+	}
+	return backend, nil
+}
+
+// GetLocalPodmanSocketFilepath returns the filepath of the socket of the Podman running on this machine, which is, in
+// order of precedence:
+//  1. the one in the CONTAINER_HOST environment variable, if set (only 'unix://' sockets are supported)
+//  2. the one of rootless Podman under XDG_RUNTIME_DIR, if it exists
+//  3. the one of rootful Podman, if it exists
+func GetLocalPodmanSocketFilepath() (string, error) {
+	if containerHost := os.Getenv(containerHostEnvVar); containerHost != "" {
+		if !strings.HasPrefix(containerHost, unixSocketScheme) {
+			return "", stacktrace.NewError(
+				"Environment variable '%v' is set to '%v', but only local Podman sockets (starting with '%v') are supported",
+				containerHostEnvVar,
+				containerHost,
+				unixSocketScheme,
+			)
+		}
+		return strings.TrimPrefix(containerHost, unixSocketScheme), nil
+	}
+
+	candidateSocketFilepaths := []string{}
+	if xdgRuntimeDirpath := os.Getenv(xdgRuntimeDirEnvVar); xdgRuntimeDirpath != "" {
+		candidateSocketFilepaths = append(candidateSocketFilepaths, path.Join(xdgRuntimeDirpath, rootlessPodmanSocketRelativeFilepath))
+	}
+	candidateSocketFilepaths = append(candidateSocketFilepaths, rootfulPodmanSocketFilepath)
+
+	for _, candidateSocketFilepath := range candidateSocketFilepaths {
+		if _, err := os.Stat(candidateSocketFilepath); err == nil {
+			return candidateSocketFilepath, nil
+		}
+	}
+	return "", stacktrace.NewError(
+		"No Podman socket was found in any of '%v'; make sure the Podman API service is running (e.g. with "+
+			"'systemctl --user enable --now podman.socket') or set environment variable '%v' to the socket to use",
+		strings.Join(candidateSocketFilepaths, "', '"),
+		containerHostEnvVar,
+	)
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getPodmanKurtosisBackend(
+	ctx context.Context,
+	socketFilepath string,
+	hostSocketFilepath string,
+	optionalApiContainerModeArgs *docker_backend_creator.APIContainerModeArgs,
+) (backend_interface.KurtosisBackend, error) {
+	podmanClient, err := client.NewClientWithOpts(
+		client.WithHost(unixSocketScheme+socketFilepath),
+		client.WithAPIVersionNegotiation(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a client connected to the Podman socket '%v'", socketFilepath)
+	}
+	podmanInfo, err := podmanClient.Info(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the system info of the Podman behind socket '%v'", socketFilepath)
+	}
+	backend, err := docker_backend_creator.GetDockerKurtosisBackendForClient(podmanClient, hostSocketFilepath, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a backend talking to the Podman socket '%v'", socketFilepath)
+	}
+	if isRootless(podmanInfo.SecurityOptions) {
+		return podman_kurtosis_backend.NewRootlessPodmanKurtosisBackend(backend), nil
+	}
+	return backend, nil
+}
+
+func isRootless(securityOptions []string) bool {
+	for _, securityOption := range securityOptions {
+		if securityOption == rootlessSecurityOption {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package backend_creator
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestGetLocalPodmanSocketFilepath_ContainerHost(t *testing.T) {
+	t.Setenv(containerHostEnvVar, "unix:///some/dir/podman.sock")
+
+	socketFilepath, err := GetLocalPodmanSocketFilepath()
+	require.NoError(t, err)
+	require.Equal(t, "/some/dir/podman.sock", socketFilepath)
+}
+
+func TestGetLocalPodmanSocketFilepath_ContainerHostTakesPrecedence(t *testing.T) {
+	createRootlessPodmanSocket(t)
+	t.Setenv(containerHostEnvVar, "unix:///some/dir/podman.sock")
+
+	socketFilepath, err := GetLocalPodmanSocketFilepath()
+	require.NoError(t, err)
+	require.Equal(t, "/some/dir/podman.sock", socketFilepath)
+}
+
+func TestGetLocalPodmanSocketFilepath_Rootless(t *testing.T) {
+	rootlessSocketFilepath := createRootlessPodmanSocket(t)
+	t.Setenv(containerHostEnvVar, "")
+
+	socketFilepath, err := GetLocalPodmanSocketFilepath()
+	require.NoError(t, err)
+	require.Equal(t, rootlessSocketFilepath, socketFilepath)
+}
+
+func TestGetLocalPodmanSocketFilepath_RemoteContainerHostIsRejected(t *testing.T) {
+	t.Setenv(containerHostEnvVar, "ssh://core@localhost:2222/run/podman/podman.sock")
+
+	_, err := GetLocalPodmanSocketFilepath()
+	require.Error(t, err)
+}
+
+func TestGetLocalPodmanSocketFilepath_NoSocket(t *testing.T) {
+	if _, err := os.Stat(rootfulPodmanSocketFilepath); err == nil {
+		t.Skipf("Rootful Podman socket '%v' exists on this machine", rootfulPodmanSocketFilepath)
+	}
+	t.Setenv(containerHostEnvVar, "")
+	t.Setenv(xdgRuntimeDirEnvVar, t.TempDir())
+
+	_, err := GetLocalPodmanSocketFilepath()
+	require.Error(t, err)
+}
+
+func TestIsRootless(t *testing.T) {
+	require.True(t, isRootless([]string{"name=seccomp,profile=default", "name=rootless"}))
+	require.False(t, isRootless([]string{"name=seccomp,profile=default", "name=selinux"}))
+	require.False(t, isRootless(nil))
+}
+
+// createRootlessPodmanSocket creates a fake rootless Podman socket in a new XDG_RUNTIME_DIR & returns its filepath
+func createRootlessPodmanSocket(t *testing.T) string {
+	xdgRuntimeDirpath := t.TempDir()
+	t.Setenv(xdgRuntimeDirEnvVar, xdgRuntimeDirpath)
+	socketFilepath := path.Join(xdgRuntimeDirpath, rootlessPodmanSocketRelativeFilepath)
+	require.NoError(t, os.MkdirAll(path.Dir(socketFilepath), 0700))
+	require.NoError(t, os.WriteFile(socketFilepath, []byte{}, 0600))
+	return socketFilepath
+}
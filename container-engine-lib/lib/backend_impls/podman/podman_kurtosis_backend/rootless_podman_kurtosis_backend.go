@@ -0,0 +1,38 @@
+package podman_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// RootlessPodmanKurtosisBackend is the backend of a Podman running rootless. The containers of rootless Podman live in
+// a user namespace, so they can't change the firewall of the machine running them: the traffic between the services
+// of an enclave can't be blocked without networking sidecars. Everything else is handled by the backend it wraps
+type RootlessPodmanKurtosisBackend struct {
+	backend_interface.KurtosisBackend
+}
+
+func NewRootlessPodmanKurtosisBackend(backend backend_interface.KurtosisBackend) *RootlessPodmanKurtosisBackend {
+	return &RootlessPodmanKurtosisBackend{
+		KurtosisBackend: backend,
+	}
+}
+
+func (backend *RootlessPodmanKurtosisBackend) UpdateEnclaveBlockedTraffic(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	blockedTraffic map[string]map[string]bool,
+) error {
+	if len(blockedTraffic) == 0 {
+		// nothing was ever blocked by the firewall of the machine, so there's nothing to unblock
+		return nil
+	}
+	return stacktrace.NewError(
+		"The traffic between the services of enclave '%v' can't be blocked by the firewall of the machine as Podman "+
+			"runs rootless, and rootless containers can't change the firewall of the machine running them; create the "+
+			"enclave with subnetworks enabled so the traffic gets blocked by networking sidecars, or use rootful Podman",
+		enclaveUuid,
+	)
+}
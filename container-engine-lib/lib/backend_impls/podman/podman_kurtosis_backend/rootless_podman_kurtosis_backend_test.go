@@ -0,0 +1,30 @@
+package podman_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	enclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+)
+
+func TestUpdateEnclaveBlockedTraffic_BlockingIsRefused(t *testing.T) {
+	backend := NewRootlessPodmanKurtosisBackend(backend_interface.NewMockKurtosisBackend(t))
+
+	err := backend.UpdateEnclaveBlockedTraffic(context.Background(), enclaveUuid, map[string]map[string]bool{
+		"172.16.0.2": {"172.16.0.3": true},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rootless")
+}
+
+func TestUpdateEnclaveBlockedTraffic_UnblockingIsANoOp(t *testing.T) {
+	backend := NewRootlessPodmanKurtosisBackend(backend_interface.NewMockKurtosisBackend(t))
+
+	err := backend.UpdateEnclaveBlockedTraffic(context.Background(), enclaveUuid, map[string]map[string]bool{})
+	require.NoError(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"io"
 	"net"
@@ -27,6 +28,12 @@ import (
 type KurtosisBackend interface {
 	FetchImage(ctx context.Context, image string) error
 
+	// Writes the given images, packaged as a TAR, to the given output writer
+	SaveImages(ctx context.Context, imageNames []string, output io.Writer) error
+
+	// Loads the images packaged in a TAR written by SaveImages
+	LoadImages(ctx context.Context, imagesTarContent io.Reader) error
+
 	// Creates an engine with the given parameters
 	CreateEngine(
 		ctx context.Context,
@@ -63,8 +70,9 @@ type KurtosisBackend interface {
 		resultErr error, // Represents an error with the function itself, rather than the engines
 	)
 
-	// Creates an enclave with the given enclave ID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error)
+	// Creates an enclave with the given enclave ID; if IPv6 is enabled, the enclave network will be dual-stack and the
+	//  user services will get an IPv6 address in addition to their IPv4 address
+	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool, isIpv6Enabled bool) (*enclave.Enclave, error)
 
 	// Gets enclaves matching the given filters
 	GetEnclaves(
@@ -85,6 +93,17 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Starts again the containers of the stopped enclaves matching the given filters, with the same IP addresses they had
+	// before being stopped
+	StartEnclaves(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+		erroredEnclaveIds map[enclave.EnclaveUUID]error,
+		resultErr error,
+	)
+
 	// Dumps the contents of the given enclave to the given directory
 	DumpEnclave(
 		ctx context.Context,
@@ -113,6 +132,9 @@ type KurtosisBackend interface {
 		// Must not conflict with the custom environment variables
 		ownIpAddressEnvVar string,
 		customEnvVars map[string]string,
+		// Contents of the files, keyed by their absolute filepath inside the API container, that only the API container
+		// can read; unlike the environment variables, they don't show up when inspecting the API container
+		secretFiles map[string][]byte,
 	) (
 		*api_container.APIContainer,
 		error,
@@ -149,6 +171,35 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Copy files, packaged as a TAR, from the API container of the given enclave and writes the bytes to the given output writer
+	CopyFilesFromAPIContainer(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		srcPathOnContainer string,
+		output io.Writer,
+	) error
+
+	// Extracts the files packaged in the given TAR under the given directory of the API container of the given enclave
+	CopyFilesToAPIContainer(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		destDirpathOnContainer string,
+		tarContent io.Reader,
+	) error
+
+	// Pauses execution of all processes of the API container of the given enclave, so that its files can be copied
+	// without being modified in the meantime
+	PauseAPIContainer(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) error
+
+	// Unpauses the API container of the given enclave, resuming execution of its processes that were previously paused
+	UnpauseAPIContainer(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) error
+
 	/*
 		KURTOSIS SERVICE STATE DIAGRAM
 
@@ -158,15 +209,15 @@ type KurtosisBackend interface {
 			                                V
 			                            REGISTERED
 			                                |
-			                    StartRegisteredUserServices
-			                                |
-			                                V
-			            .--------------- STARTED
-			            |                   |
-			            |           StopUserService
-			            |                   |
-			            |                   V
-			    DestroyUserServices      STOPPED
+			                    StartRegisteredUserServices <-------.
+			                                |                        |
+			                                V                        |
+			            .--------------- STARTED                     |
+			            |                   |                        |
+			            |           StopUserService                  |
+			            |                   |                        |
+			            |                   V                        |
+			    DestroyUserServices      STOPPED --------------------'
 			            |                   |
 			            |           DestroyUserServices
 			            |                   |
@@ -174,7 +225,8 @@ type KurtosisBackend interface {
 			            '-------------> DESTROYED
 
 			- Note the above state diagram doesn't account for PauseService or UnpauseService
-			- As of 2022-05-15, Kurtosis services can never be restarted once stopped.
+			- A stopped service can be started again, possibly with a different config. Its container is then re-created
+			  under the same registration, so it keeps its UUID, name & private IP
 	*/
 
 	// RegisterUserServices registers the services allocating them an IP address and a UUID. The service is not started!
@@ -200,6 +252,7 @@ type KurtosisBackend interface {
 	)
 
 	// StartRegisteredUserServices consumes service registrations to create auser container for each registration, given each service config
+	// If a registration already has a stopped container, that container gets replaced; starting a running service is an error
 	StartRegisteredUserServices(
 		ctx context.Context,
 		enclaveUuid enclave.EnclaveUUID,
@@ -281,8 +334,21 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Creates an image out of the current filesystem of each user service matching the given filters, so that the
+	// services can later be started again from the state they are in now
+	CreateUserServiceImages(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		// Names of the created images, keyed by service UUID
+		successfulUserServiceImageNames map[service.ServiceUUID]string,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultErr error,
+	)
+
 	// StopUserServices stops the user containers for the services matching the given filters
-	// A stopped service cannot be activated again as of 2022-05-14
+	// A stopped service can be started again with StartRegisteredUserServices, which re-creates its container
 	StopUserServices(
 		ctx context.Context,
 		enclaveUuid enclave.EnclaveUUID,
@@ -335,6 +401,21 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Executes a shell command inside the networking sidecar of the user service, writing its stdout to the output as
+	// it comes, and returns its exit code along with its stderr. It is meant for long-running commands producing
+	// binary output, like 'tcpdump'
+	RunNetworkingSidecarExecCommandWithStreamedOutput(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		networkingSidecarCommand []string,
+		output io.Writer,
+	) (
+		exitCode int32,
+		errorOutput string,
+		resultErr error,
+	)
+
 	// Stop networking sidecars using the given filters,
 	StopNetworkingSidecars(
 		ctx context.Context,
@@ -355,6 +436,48 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Replaces the traffic blocked inside the enclave network: the packets going from each source IP address to any of
+	// its destination IP addresses get dropped by the firewall of the machine running the containers, so partitions can
+	// be enforced without networking sidecars. An empty map unblocks all the traffic
+	UpdateEnclaveBlockedTraffic(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		// Source IP address -> destination IP addresses
+		blockedTraffic map[string]map[string]bool,
+	) error
+
+	// Forwards the given local port of the machine running the containers to the private port with the given ID of a
+	// user service. The port forward keeps running independently of the engine, until it gets destroyed
+	CreatePortForward(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		portId string,
+		localPortNumber uint16,
+	) (
+		*port_forward.PortForward,
+		error,
+	)
+
+	// Gets port forwards using the given filters, returning a map of matched port forwards identified by their local port
+	GetPortForwards(
+		ctx context.Context,
+		filters *port_forward.PortForwardFilters,
+	) (
+		map[uint16]*port_forward.PortForward,
+		error,
+	)
+
+	// Destroy port forwards using the given filters
+	DestroyPortForwards(
+		ctx context.Context,
+		filters *port_forward.PortForwardFilters,
+	) (
+		successfulLocalPortNumbers map[uint16]bool,
+		erroredLocalPortNumbers map[uint16]error,
+		resultErr error,
+	)
+
 	// Create a new Logs Database for storing and requesting the container's logs
 	CreateLogsDatabase(
 		ctx context.Context,
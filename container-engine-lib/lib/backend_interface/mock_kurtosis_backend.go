@@ -25,6 +25,8 @@ import (
 
 	networking_sidecar "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 
+	port_forward "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
+
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 )
 
@@ -41,6 +43,46 @@ func (_m *MockKurtosisBackend) EXPECT() *MockKurtosisBackend_Expecter {
 	return &MockKurtosisBackend_Expecter{mock: &_m.Mock}
 }
 
+// CopyFilesFromAPIContainer provides a mock function with given fields: ctx, enclaveUuid, srcPathOnContainer, output
+func (_m *MockKurtosisBackend) CopyFilesFromAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID, srcPathOnContainer string, output io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, srcPathOnContainer, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, srcPathOnContainer, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesFromAPIContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromAPIContainer'
+type MockKurtosisBackend_CopyFilesFromAPIContainer_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromAPIContainer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - srcPathOnContainer string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) CopyFilesFromAPIContainer(ctx interface{}, enclaveUuid interface{}, srcPathOnContainer interface{}, output interface{}) *MockKurtosisBackend_CopyFilesFromAPIContainer_Call {
+	return &MockKurtosisBackend_CopyFilesFromAPIContainer_Call{Call: _e.mock.On("CopyFilesFromAPIContainer", ctx, enclaveUuid, srcPathOnContainer, output)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromAPIContainer_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, srcPathOnContainer string, output io.Writer)) *MockKurtosisBackend_CopyFilesFromAPIContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromAPIContainer_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesFromAPIContainer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -82,13 +124,53 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) Return(_a0 error) *
 	return _c
 }
 
-// CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars
-func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string) (*api_container.APIContainer, error) {
-	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+// CopyFilesToAPIContainer provides a mock function with given fields: ctx, enclaveUuid, destDirpathOnContainer, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID, destDirpathOnContainer string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, destDirpathOnContainer, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, destDirpathOnContainer, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToAPIContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToAPIContainer'
+type MockKurtosisBackend_CopyFilesToAPIContainer_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToAPIContainer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - destDirpathOnContainer string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToAPIContainer(ctx interface{}, enclaveUuid interface{}, destDirpathOnContainer interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToAPIContainer_Call {
+	return &MockKurtosisBackend_CopyFilesToAPIContainer_Call{Call: _e.mock.On("CopyFilesToAPIContainer", ctx, enclaveUuid, destDirpathOnContainer, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToAPIContainer_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, destDirpathOnContainer string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToAPIContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToAPIContainer_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToAPIContainer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, secretFiles
+func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, secretFiles map[string][]byte) (*api_container.APIContainer, error) {
+	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, secretFiles)
 
 	var r0 *api_container.APIContainer
-	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, uint16, string, string, map[string]string) *api_container.APIContainer); ok {
-		r0 = rf(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, uint16, string, string, map[string]string, map[string][]byte) *api_container.APIContainer); ok {
+		r0 = rf(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, secretFiles)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*api_container.APIContainer)
@@ -96,8 +178,8 @@ func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image str
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, enclave.EnclaveUUID, uint16, uint16, string, string, map[string]string) error); ok {
-		r1 = rf(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+	if rf, ok := ret.Get(1).(func(context.Context, string, enclave.EnclaveUUID, uint16, uint16, string, string, map[string]string, map[string][]byte) error); ok {
+		r1 = rf(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, secretFiles)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -119,13 +201,14 @@ type MockKurtosisBackend_CreateAPIContainer_Call struct {
 //   - enclaveDataVolumeDirpath string
 //   - ownIpAddressEnvVar string
 //   - customEnvVars map[string]string
-func (_e *MockKurtosisBackend_Expecter) CreateAPIContainer(ctx interface{}, image interface{}, enclaveUuid interface{}, grpcPortNum interface{}, grpcProxyPortNum interface{}, enclaveDataVolumeDirpath interface{}, ownIpAddressEnvVar interface{}, customEnvVars interface{}) *MockKurtosisBackend_CreateAPIContainer_Call {
-	return &MockKurtosisBackend_CreateAPIContainer_Call{Call: _e.mock.On("CreateAPIContainer", ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)}
+//   - secretFiles map[string][]byte
+func (_e *MockKurtosisBackend_Expecter) CreateAPIContainer(ctx interface{}, image interface{}, enclaveUuid interface{}, grpcPortNum interface{}, grpcProxyPortNum interface{}, enclaveDataVolumeDirpath interface{}, ownIpAddressEnvVar interface{}, customEnvVars interface{}, secretFiles interface{}) *MockKurtosisBackend_CreateAPIContainer_Call {
+	return &MockKurtosisBackend_CreateAPIContainer_Call{Call: _e.mock.On("CreateAPIContainer", ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, secretFiles)}
 }
 
-func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Run(run func(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string)) *MockKurtosisBackend_CreateAPIContainer_Call {
+func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Run(run func(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, secretFiles map[string][]byte)) *MockKurtosisBackend_CreateAPIContainer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(enclave.EnclaveUUID), args[3].(uint16), args[4].(uint16), args[5].(string), args[6].(string), args[7].(map[string]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(enclave.EnclaveUUID), args[3].(uint16), args[4].(uint16), args[5].(string), args[6].(string), args[7].(map[string]string), args[8].(map[string][]byte))
 	})
 	return _c
 }
@@ -135,13 +218,13 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Return(_a0 *api_container
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, isPartitioningEnabled
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool, isIpv6Enabled bool) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)
 
 	var r0 *enclave.Enclave
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, bool) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, bool, bool) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
@@ -149,8 +232,8 @@ func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid en
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, bool) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, bool, bool) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,13 +251,14 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
 //   - isPartitioningEnabled bool
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, isPartitioningEnabled interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, isPartitioningEnabled)}
+//   - isIpv6Enabled bool
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, isPartitioningEnabled interface{}, isIpv6Enabled interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool, isIpv6Enabled bool)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(bool))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(bool), args[4].(bool))
 	})
 	return _c
 }
@@ -379,6 +463,113 @@ func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) Return(_a0 *networki
 	return _c
 }
 
+// CreatePortForward provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, portId, localPortNumber
+func (_m *MockKurtosisBackend) CreatePortForward(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, portId string, localPortNumber uint16) (*port_forward.PortForward, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, portId, localPortNumber)
+
+	var r0 *port_forward.PortForward
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, uint16) *port_forward.PortForward); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, portId, localPortNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*port_forward.PortForward)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, uint16) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, portId, localPortNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_CreatePortForward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePortForward'
+type MockKurtosisBackend_CreatePortForward_Call struct {
+	*mock.Call
+}
+
+// CreatePortForward is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - portId string
+//   - localPortNumber uint16
+func (_e *MockKurtosisBackend_Expecter) CreatePortForward(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, portId interface{}, localPortNumber interface{}) *MockKurtosisBackend_CreatePortForward_Call {
+	return &MockKurtosisBackend_CreatePortForward_Call{Call: _e.mock.On("CreatePortForward", ctx, enclaveUuid, serviceUuid, portId, localPortNumber)}
+}
+
+func (_c *MockKurtosisBackend_CreatePortForward_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, portId string, localPortNumber uint16)) *MockKurtosisBackend_CreatePortForward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(uint16))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreatePortForward_Call) Return(_a0 *port_forward.PortForward, _a1 error) *MockKurtosisBackend_CreatePortForward_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CreateUserServiceImages provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) CreateUserServiceImages(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]string, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]string
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]string); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]string)
+		}
+	}
+
+	var r1 map[service.ServiceUUID]error
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_CreateUserServiceImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserServiceImages'
+type MockKurtosisBackend_CreateUserServiceImages_Call struct {
+	*mock.Call
+}
+
+// CreateUserServiceImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) CreateUserServiceImages(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_CreateUserServiceImages_Call {
+	return &MockKurtosisBackend_CreateUserServiceImages_Call{Call: _e.mock.On("CreateUserServiceImages", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_CreateUserServiceImages_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_CreateUserServiceImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateUserServiceImages_Call) Return(successfulUserServiceImageNames map[service.ServiceUUID]string, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_CreateUserServiceImages_Call {
+	_c.Call.Return(successfulUserServiceImageNames, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
 // DestroyAPIContainers provides a mock function with given fields: ctx, filters
 func (_m *MockKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
 	ret := _m.Called(ctx, filters)
@@ -715,6 +906,62 @@ func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) Return(successfulU
 	return _c
 }
 
+// DestroyPortForwards provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) DestroyPortForwards(ctx context.Context, filters *port_forward.PortForwardFilters) (map[uint16]bool, map[uint16]error, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[uint16]bool
+	if rf, ok := ret.Get(0).(func(context.Context, *port_forward.PortForwardFilters) map[uint16]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint16]bool)
+		}
+	}
+
+	var r1 map[uint16]error
+	if rf, ok := ret.Get(1).(func(context.Context, *port_forward.PortForwardFilters) map[uint16]error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[uint16]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *port_forward.PortForwardFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_DestroyPortForwards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyPortForwards'
+type MockKurtosisBackend_DestroyPortForwards_Call struct {
+	*mock.Call
+}
+
+// DestroyPortForwards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *port_forward.PortForwardFilters
+func (_e *MockKurtosisBackend_Expecter) DestroyPortForwards(ctx interface{}, filters interface{}) *MockKurtosisBackend_DestroyPortForwards_Call {
+	return &MockKurtosisBackend_DestroyPortForwards_Call{Call: _e.mock.On("DestroyPortForwards", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_DestroyPortForwards_Call) Run(run func(ctx context.Context, filters *port_forward.PortForwardFilters)) *MockKurtosisBackend_DestroyPortForwards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*port_forward.PortForwardFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyPortForwards_Call) Return(successfulLocalPortNumbers map[uint16]bool, erroredLocalPortNumbers map[uint16]error, resultErr error) *MockKurtosisBackend_DestroyPortForwards_Call {
+	_c.Call.Return(successfulLocalPortNumbers, erroredLocalPortNumbers, resultErr)
+	return _c
+}
+
 // DestroyUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
 func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
@@ -1178,6 +1425,53 @@ func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) Return(_a0 map[service
 	return _c
 }
 
+// GetPortForwards provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) GetPortForwards(ctx context.Context, filters *port_forward.PortForwardFilters) (map[uint16]*port_forward.PortForward, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[uint16]*port_forward.PortForward
+	if rf, ok := ret.Get(0).(func(context.Context, *port_forward.PortForwardFilters) map[uint16]*port_forward.PortForward); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint16]*port_forward.PortForward)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *port_forward.PortForwardFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetPortForwards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPortForwards'
+type MockKurtosisBackend_GetPortForwards_Call struct {
+	*mock.Call
+}
+
+// GetPortForwards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *port_forward.PortForwardFilters
+func (_e *MockKurtosisBackend_Expecter) GetPortForwards(ctx interface{}, filters interface{}) *MockKurtosisBackend_GetPortForwards_Call {
+	return &MockKurtosisBackend_GetPortForwards_Call{Call: _e.mock.On("GetPortForwards", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetPortForwards_Call) Run(run func(ctx context.Context, filters *port_forward.PortForwardFilters)) *MockKurtosisBackend_GetPortForwards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*port_forward.PortForwardFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPortForwards_Call) Return(_a0 map[uint16]*port_forward.PortForward, _a1 error) *MockKurtosisBackend_GetPortForwards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetUserServiceLogs provides a mock function with given fields: ctx, enclaveUuid, filters, shouldFollowLogs
 func (_m *MockKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters, shouldFollowLogs)
@@ -1284,6 +1578,82 @@ func (_c *MockKurtosisBackend_GetUserServices_Call) Return(_a0 map[service.Servi
 	return _c
 }
 
+// LoadImages provides a mock function with given fields: ctx, imagesTarContent
+func (_m *MockKurtosisBackend) LoadImages(ctx context.Context, imagesTarContent io.Reader) error {
+	ret := _m.Called(ctx, imagesTarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) error); ok {
+		r0 = rf(ctx, imagesTarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_LoadImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadImages'
+type MockKurtosisBackend_LoadImages_Call struct {
+	*mock.Call
+}
+
+// LoadImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imagesTarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) LoadImages(ctx interface{}, imagesTarContent interface{}) *MockKurtosisBackend_LoadImages_Call {
+	return &MockKurtosisBackend_LoadImages_Call{Call: _e.mock.On("LoadImages", ctx, imagesTarContent)}
+}
+
+func (_c *MockKurtosisBackend_LoadImages_Call) Run(run func(ctx context.Context, imagesTarContent io.Reader)) *MockKurtosisBackend_LoadImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_LoadImages_Call) Return(_a0 error) *MockKurtosisBackend_LoadImages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// PauseAPIContainer provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) PauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_PauseAPIContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseAPIContainer'
+type MockKurtosisBackend_PauseAPIContainer_Call struct {
+	*mock.Call
+}
+
+// PauseAPIContainer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) PauseAPIContainer(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_PauseAPIContainer_Call {
+	return &MockKurtosisBackend_PauseAPIContainer_Call{Call: _e.mock.On("PauseAPIContainer", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_PauseAPIContainer_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_PauseAPIContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PauseAPIContainer_Call) Return(_a0 error) *MockKurtosisBackend_PauseAPIContainer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // PauseService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
 func (_m *MockKurtosisBackend) PauseService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
@@ -1380,6 +1750,61 @@ func (_c *MockKurtosisBackend_RegisterUserServices_Call) Return(_a0 map[service.
 	return _c
 }
 
+// RunNetworkingSidecarExecCommandWithStreamedOutput provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output
+func (_m *MockKurtosisBackend) RunNetworkingSidecarExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, networkingSidecarCommand []string, output io.Writer) (int32, string, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) int32); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) string); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) error); ok {
+		r2 = rf(ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunNetworkingSidecarExecCommandWithStreamedOutput'
+type MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call struct {
+	*mock.Call
+}
+
+// RunNetworkingSidecarExecCommandWithStreamedOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - networkingSidecarCommand []string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) RunNetworkingSidecarExecCommandWithStreamedOutput(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, networkingSidecarCommand interface{}, output interface{}) *MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call {
+	return &MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call{Call: _e.mock.On("RunNetworkingSidecarExecCommandWithStreamedOutput", ctx, enclaveUuid, serviceUuid, networkingSidecarCommand, output)}
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, networkingSidecarCommand []string, output io.Writer)) *MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].([]string), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call) Return(exitCode int32, errorOutput string, resultErr error) *MockKurtosisBackend_RunNetworkingSidecarExecCommandWithStreamedOutput_Call {
+	_c.Call.Return(exitCode, errorOutput, resultErr)
+	return _c
+}
+
 // RunNetworkingSidecarExecCommands provides a mock function with given fields: ctx, enclaveUuid, networkingSidecarsCommands
 func (_m *MockKurtosisBackend) RunNetworkingSidecarExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarsCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, networkingSidecarsCommands)
@@ -1494,6 +1919,101 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) Return(succesfulU
 	return _c
 }
 
+// SaveImages provides a mock function with given fields: ctx, imageNames, output
+func (_m *MockKurtosisBackend) SaveImages(ctx context.Context, imageNames []string, output io.Writer) error {
+	ret := _m.Called(ctx, imageNames, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, io.Writer) error); ok {
+		r0 = rf(ctx, imageNames, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_SaveImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveImages'
+type MockKurtosisBackend_SaveImages_Call struct {
+	*mock.Call
+}
+
+// SaveImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imageNames []string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) SaveImages(ctx interface{}, imageNames interface{}, output interface{}) *MockKurtosisBackend_SaveImages_Call {
+	return &MockKurtosisBackend_SaveImages_Call{Call: _e.mock.On("SaveImages", ctx, imageNames, output)}
+}
+
+func (_c *MockKurtosisBackend_SaveImages_Call) Run(run func(ctx context.Context, imageNames []string, output io.Writer)) *MockKurtosisBackend_SaveImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_SaveImages_Call) Return(_a0 error) *MockKurtosisBackend_SaveImages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// StartEnclaves provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) StartEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]bool
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]bool)
+		}
+	}
+
+	var r1 map[enclave.EnclaveUUID]error
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[enclave.EnclaveUUID]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_StartEnclaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartEnclaves'
+type MockKurtosisBackend_StartEnclaves_Call struct {
+	*mock.Call
+}
+
+// StartEnclaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) StartEnclaves(ctx interface{}, filters interface{}) *MockKurtosisBackend_StartEnclaves_Call {
+	return &MockKurtosisBackend_StartEnclaves_Call{Call: _e.mock.On("StartEnclaves", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Return(successfulEnclaveIds map[enclave.EnclaveUUID]bool, erroredEnclaveIds map[enclave.EnclaveUUID]error, resultErr error) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(successfulEnclaveIds, erroredEnclaveIds, resultErr)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -1832,6 +2352,44 @@ func (_c *MockKurtosisBackend_StopUserServices_Call) Return(successfulUserServic
 	return _c
 }
 
+// UnpauseAPIContainer provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) UnpauseAPIContainer(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UnpauseAPIContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpauseAPIContainer'
+type MockKurtosisBackend_UnpauseAPIContainer_Call struct {
+	*mock.Call
+}
+
+// UnpauseAPIContainer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) UnpauseAPIContainer(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_UnpauseAPIContainer_Call {
+	return &MockKurtosisBackend_UnpauseAPIContainer_Call{Call: _e.mock.On("UnpauseAPIContainer", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_UnpauseAPIContainer_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_UnpauseAPIContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UnpauseAPIContainer_Call) Return(_a0 error) *MockKurtosisBackend_UnpauseAPIContainer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // UnpauseService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
 func (_m *MockKurtosisBackend) UnpauseService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
@@ -1928,6 +2486,45 @@ func (_c *MockKurtosisBackend_UnregisterUserServices_Call) Return(_a0 map[servic
 	return _c
 }
 
+// UpdateEnclaveBlockedTraffic provides a mock function with given fields: ctx, enclaveUuid, blockedTraffic
+func (_m *MockKurtosisBackend) UpdateEnclaveBlockedTraffic(ctx context.Context, enclaveUuid enclave.EnclaveUUID, blockedTraffic map[string]map[string]bool) error {
+	ret := _m.Called(ctx, enclaveUuid, blockedTraffic)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[string]map[string]bool) error); ok {
+		r0 = rf(ctx, enclaveUuid, blockedTraffic)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveBlockedTraffic'
+type MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveBlockedTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - blockedTraffic map[string]map[string]bool
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveBlockedTraffic(ctx interface{}, enclaveUuid interface{}, blockedTraffic interface{}) *MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call {
+	return &MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call{Call: _e.mock.On("UpdateEnclaveBlockedTraffic", ctx, enclaveUuid, blockedTraffic)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, blockedTraffic map[string]map[string]bool)) *MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(map[string]map[string]bool))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveBlockedTraffic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 type mockConstructorTestingTNewMockKurtosisBackend interface {
 	mock.TestingT
 	Cleanup(func())
@@ -65,7 +65,7 @@ func (apiContainer *APIContainer) GetPrivateGRPCPort() *port_spec.PortSpec {
 }
 
 func (apiContainer *APIContainer) GetPrivateGRPCProxyPort() *port_spec.PortSpec {
-	return apiContainer.privateGrpcPort
+	return apiContainer.privateGrpcProxyPort
 }
 
 func (apiContainer *APIContainer) GetPublicIPAddress() net.IP {
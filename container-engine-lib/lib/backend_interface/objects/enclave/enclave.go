@@ -5,14 +5,15 @@ import "time"
 type EnclaveUUID string
 
 type Enclave struct {
-	uuid         EnclaveUUID
-	name         string
-	status       EnclaveStatus
-	creationTime *time.Time
+	uuid                  EnclaveUUID
+	name                  string
+	status                EnclaveStatus
+	creationTime          *time.Time
+	isPartitioningEnabled bool
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, isPartitioningEnabled bool) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isPartitioningEnabled: isPartitioningEnabled}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -30,3 +31,7 @@ func (enclave *Enclave) GetCreationTime() *time.Time {
 func (enclave *Enclave) GetName() string {
 	return enclave.name
 }
+
+func (enclave *Enclave) IsPartitioningEnabled() bool {
+	return enclave.isPartitioningEnabled
+}
@@ -0,0 +1,54 @@
+package port_forward
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// PortForward forwards a port of the machine running the engine to a private port of a user service, so that the
+// service can be reached at a stable local address. There's at most one port forward per local port
+type PortForward struct {
+	enclaveUuid     enclave.EnclaveUUID
+	serviceUuid     service.ServiceUUID
+	portId          string
+	localPortNumber uint16
+	status          container_status.ContainerStatus
+}
+
+func NewPortForward(
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	portId string,
+	localPortNumber uint16,
+	status container_status.ContainerStatus,
+) *PortForward {
+	return &PortForward{
+		enclaveUuid:     enclaveUuid,
+		serviceUuid:     serviceUuid,
+		portId:          portId,
+		localPortNumber: localPortNumber,
+		status:          status,
+	}
+}
+
+func (portForward *PortForward) GetEnclaveUUID() enclave.EnclaveUUID {
+	return portForward.enclaveUuid
+}
+
+func (portForward *PortForward) GetServiceUUID() service.ServiceUUID {
+	return portForward.serviceUuid
+}
+
+// GetPortId returns the ID of the private port of the service the traffic is forwarded to
+func (portForward *PortForward) GetPortId() string {
+	return portForward.portId
+}
+
+func (portForward *PortForward) GetLocalPortNumber() uint16 {
+	return portForward.localPortNumber
+}
+
+func (portForward *PortForward) GetStatus() container_status.ContainerStatus {
+	return portForward.status
+}
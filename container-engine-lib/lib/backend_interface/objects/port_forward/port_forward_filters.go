@@ -0,0 +1,25 @@
+package port_forward
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+type PortForwardFilters struct {
+	// Disjunctive set of enclave UUIDs for which to return port forwards
+	// If nil or empty, will match all enclave UUIDs
+	EnclaveUUIDs map[enclave.EnclaveUUID]bool
+
+	// Disjunctive set of user service UUIDs to find port forwards for
+	// If nil or empty, will match all UUIDs
+	UserServiceUUIDs map[service.ServiceUUID]bool
+
+	// Disjunctive set of local port numbers the returned port forwards must listen on
+	// If nil or empty, will match all local ports
+	LocalPortNumbers map[uint16]bool
+
+	// Disjunctive set of statuses that returned port forwards must conform to
+	// If nil or empty, will match all statuses
+	Statuses map[container_status.ContainerStatus]bool
+}
@@ -20,6 +20,9 @@ type ServiceRegistration struct {
 	// with the service
 	privateIp net.IP
 
+	// The private IPv6 of the service within the enclave, or nil if the enclave isn't an IPv6 enclave
+	privateIpv6 net.IP
+
 	// The hostname differs whether Kurtosis is using a Kubernetes backend of a Docker backend.
 	// In Docker, we set the serviceName as the hostname, whereas in Kubernetes the hostname is automatically assigned
 	// to the "Kubernetes Service Name", which is something like user-services-<SERVICE_UUID>
@@ -28,12 +31,19 @@ type ServiceRegistration struct {
 }
 
 func NewServiceRegistration(name ServiceName, guid ServiceUUID, enclaveId enclave.EnclaveUUID, privateIp net.IP, hostname string) *ServiceRegistration {
+	return NewDualStackServiceRegistration(name, guid, enclaveId, privateIp, nil, hostname)
+}
+
+// NewDualStackServiceRegistration creates the registration of a service of an IPv6 enclave, which has both an IPv4 and an
+// IPv6 private IP
+func NewDualStackServiceRegistration(name ServiceName, guid ServiceUUID, enclaveId enclave.EnclaveUUID, privateIp net.IP, privateIpv6 net.IP, hostname string) *ServiceRegistration {
 	return &ServiceRegistration{
-		name:      name,
-		uuid:      guid,
-		enclaveId: enclaveId,
-		privateIp: privateIp,
-		hostname:  hostname,
+		name:        name,
+		uuid:        guid,
+		enclaveId:   enclaveId,
+		privateIp:   privateIp,
+		privateIpv6: privateIpv6,
+		hostname:    hostname,
 	}
 }
 
@@ -53,6 +63,11 @@ func (registration *ServiceRegistration) GetPrivateIP() net.IP {
 	return registration.privateIp
 }
 
+// GetPrivateIPv6 returns nil if the service doesn't belong to an IPv6 enclave
+func (registration *ServiceRegistration) GetPrivateIPv6() net.IP {
+	return registration.privateIpv6
+}
+
 func (registration *ServiceRegistration) GetHostname() string {
 	return registration.hostname
 }
@@ -0,0 +1,74 @@
+package applied_instructions
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	appliedInstructionsBucketName = []byte("applied-instructions")
+)
+
+// AppliedInstructionsBucket stores, for each package run in the enclave, the instructions its last run successfully
+// applied. Instructions are stored in their canonical form, along with the number of times they were applied
+type AppliedInstructionsBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newAppliedInstructionsBucket(db *enclave_db.EnclaveDB) *AppliedInstructionsBucket {
+	return &AppliedInstructionsBucket{
+		db,
+	}
+}
+
+// ReplaceAppliedInstructions overrides the instructions applied by the last run of the package
+func (bucket *AppliedInstructionsBucket) ReplaceAppliedInstructions(packageId string, appliedInstructions map[string]int) error {
+	appliedInstructionsBytes, err := json.Marshal(appliedInstructions)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while converting the instructions applied by package '%v' to json. This is a bug in Kurtosis.", packageId)
+	}
+	replaceAppliedInstructionsFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(appliedInstructionsBucketName).Put([]byte(packageId), appliedInstructionsBytes)
+	}
+	if err := bucket.db.Update(replaceAppliedInstructionsFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while storing the instructions applied by package '%v'", packageId)
+	}
+	return nil
+}
+
+// GetAllAppliedInstructions returns the instructions applied by the last run of each package, indexed by package ID
+func (bucket *AppliedInstructionsBucket) GetAllAppliedInstructions() (map[string]map[string]int, error) {
+	result := map[string]map[string]int{}
+	getAllAppliedInstructionsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(packageId, appliedInstructionsBytes []byte) error {
+			appliedInstructions := map[string]int{}
+			if err := json.Unmarshal(appliedInstructionsBytes, &appliedInstructions); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting the instructions '%s' stored against package '%s' in bolt to a usable Go type; This is a bug in Kurtosis", appliedInstructionsBytes, packageId)
+			}
+			result[string(packageId)] = appliedInstructions
+			return nil
+		}
+		return tx.Bucket(appliedInstructionsBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := bucket.db.View(getAllAppliedInstructionsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all applied instructions")
+	}
+	return result, nil
+}
+
+func GetOrCreateAppliedInstructionsBucket(db *enclave_db.EnclaveDB) (*AppliedInstructionsBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(appliedInstructionsBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating applied instructions database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building applied instructions")
+	}
+	return newAppliedInstructionsBucket(db), nil
+}
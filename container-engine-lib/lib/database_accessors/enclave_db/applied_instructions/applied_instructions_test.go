@@ -0,0 +1,32 @@
+package applied_instructions
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testPackageIdA = "github.com/package-author/package-a"
+	testPackageIdB = "github.com/package-author/package-b"
+)
+
+func TestReplaceAppliedInstructions(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	appliedInstructionsBucket, err := GetOrCreateAppliedInstructionsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	require.Nil(t, appliedInstructionsBucket.ReplaceAppliedInstructions(testPackageIdA, map[string]int{"print(msg=\"a\")": 2}))
+	require.Nil(t, appliedInstructionsBucket.ReplaceAppliedInstructions(testPackageIdB, map[string]int{"print(msg=\"b\")": 1}))
+	require.Nil(t, appliedInstructionsBucket.ReplaceAppliedInstructions(testPackageIdA, map[string]int{"print(msg=\"c\")": 1}))
+
+	result, err := appliedInstructionsBucket.GetAllAppliedInstructions()
+	require.Nil(t, err)
+	expectedResult := map[string]map[string]int{
+		testPackageIdA: {"print(msg=\"c\")": 1},
+		testPackageIdB: {"print(msg=\"b\")": 1},
+	}
+	require.Equal(t, expectedResult, result)
+}
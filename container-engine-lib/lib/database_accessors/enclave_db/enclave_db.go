@@ -23,19 +23,7 @@ type EnclaveDB struct {
 
 func GetOrCreateEnclaveDatabase() (*EnclaveDB, error) {
 	openDatabaseOnce.Do(func() {
-		databaseInstance, databaseOpenError = bolt.Open(enclaveDbFilePath, readWritePermissionToDatabase, &bolt.Options{
-			Timeout:         0,
-			NoGrowSync:      false,
-			NoFreelistSync:  false,
-			FreelistType:    "",
-			ReadOnly:        false,
-			MmapFlags:       0,
-			InitialMmapSize: 0,
-			PageSize:        0,
-			NoSync:          false,
-			OpenFile:        nil,
-			Mlock:           false,
-		})
+		databaseInstance, databaseOpenError = openBoltDatabase(enclaveDbFilePath)
 	})
 	if databaseOpenError != nil {
 		return nil, stacktrace.Propagate(databaseOpenError, "An error occurred while opening the enclave database")
@@ -43,3 +31,30 @@ func GetOrCreateEnclaveDatabase() (*EnclaveDB, error) {
 
 	return &EnclaveDB{databaseInstance}, nil
 }
+
+// OpenEnclaveDatabaseFile opens the enclave database stored in the given file, e.g. the database of another enclave
+// captured by an enclave snapshot. Unlike GetOrCreateEnclaveDatabase, a new database is opened on each call and the
+// caller is responsible for closing it
+func OpenEnclaveDatabaseFile(filepath string) (*EnclaveDB, error) {
+	database, err := openBoltDatabase(filepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while opening the enclave database stored in '%v'", filepath)
+	}
+	return &EnclaveDB{database}, nil
+}
+
+func openBoltDatabase(filepath string) (*bolt.DB, error) {
+	return bolt.Open(filepath, readWritePermissionToDatabase, &bolt.Options{
+		Timeout:         0,
+		NoGrowSync:      false,
+		NoFreelistSync:  false,
+		FreelistType:    "",
+		ReadOnly:        false,
+		MmapFlags:       0,
+		InitialMmapSize: 0,
+		PageSize:        0,
+		NoSync:          false,
+		OpenFile:        nil,
+		Mlock:           false,
+	})
+}
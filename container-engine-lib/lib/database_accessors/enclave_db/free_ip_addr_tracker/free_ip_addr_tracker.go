@@ -11,36 +11,57 @@ import (
 )
 
 type FreeIpAddrTracker struct {
-	subnet    *net.IPNet
-	enclaveDb *enclave_db.EnclaveDB
+	subnet *net.IPNet
+	// Nil if the enclave network is IPv4-only
+	ipv6Subnet *net.IPNet
+	enclaveDb  *enclave_db.EnclaveDB
 }
 
+const (
+	ipv6TrackerNotEnabledErrorFmt = "IPv6 addresses can't be given out as the subnet '%v' the tracker was initialized with is IPv4-only"
+)
+
 var (
-	takenIpAddressBucketName = []byte("free-ip-addr-tracker")
+	takenIpAddressBucketName   = []byte("free-ip-addr-tracker")
+	takenIpv6AddressBucketName = []byte("free-ipv6-addr-tracker")
 )
 
 func (tracker *FreeIpAddrTracker) GetFreeIpAddr() (net.IP, error) {
-	var ipAddr net.IP
-	err := tracker.enclaveDb.Update(func(tx *bolt.Tx) error {
-		takenIps, err := getTakenIpAddrs(tx)
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred while getting taken IP addresses")
-		}
-		ipAddr, err = network_helpers.GetFreeIpAddrFromSubnet(takenIps, tracker.subnet)
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred while getting a free IP address from subnet")
-		}
-		return tx.Bucket(takenIpAddressBucketName).Put([]byte(ipAddr.String()), consts.EmptyValueForKeySet)
-	})
+	ipAddr, err := getFreeIpAddrFromBucket(tracker.enclaveDb, takenIpAddressBucketName, tracker.subnet)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while getting a free IP address")
 	}
 	return ipAddr, nil
 }
 
+// IsIpv6Enabled returns true if the tracker also gives out IPv6 addresses, i.e. if the enclave network is dual-stack
+func (tracker *FreeIpAddrTracker) IsIpv6Enabled() bool {
+	return tracker.ipv6Subnet != nil
+}
+
+func (tracker *FreeIpAddrTracker) GetFreeIpv6Addr() (net.IP, error) {
+	if !tracker.IsIpv6Enabled() {
+		return nil, stacktrace.NewError(ipv6TrackerNotEnabledErrorFmt, tracker.subnet)
+	}
+	ipAddr, err := getFreeIpAddrFromBucket(tracker.enclaveDb, takenIpv6AddressBucketName, tracker.ipv6Subnet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting a free IPv6 address")
+	}
+	return ipAddr, nil
+}
+
+// ReleaseIpAddr releases either an IPv4 or an IPv6 address
 func (tracker *FreeIpAddrTracker) ReleaseIpAddr(ip net.IP) error {
+	bucketName := takenIpAddressBucketName
+	if ip.To4() == nil {
+		bucketName = takenIpv6AddressBucketName
+	}
 	err := tracker.enclaveDb.Update(func(tx *bolt.Tx) error {
-		return tx.Bucket(takenIpAddressBucketName).Delete([]byte(ip.String()))
+		bucket := tx.Bucket(bucketName)
+		if bucket == nil {
+			return stacktrace.NewError(ipv6TrackerNotEnabledErrorFmt, tracker.subnet)
+		}
+		return bucket.Delete([]byte(ip.String()))
 	})
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while releasing used IP address '%v'", ip)
@@ -49,9 +70,42 @@ func (tracker *FreeIpAddrTracker) ReleaseIpAddr(ip net.IP) error {
 }
 
 func GetOrCreateNewFreeIpAddrTracker(subnet *net.IPNet, alreadyTakenIps map[string]bool, db *enclave_db.EnclaveDB) (*FreeIpAddrTracker, error) {
+	return GetOrCreateNewDualStackFreeIpAddrTracker(subnet, nil, alreadyTakenIps, db)
+}
+
+// GetOrCreateNewDualStackFreeIpAddrTracker creates a tracker giving out both IPv4 and IPv6 addresses, the latter from
+// the given IPv6 subnet. The already taken IPs can be of both families
+// If the IPv6 subnet is nil, the tracker only gives out IPv4 addresses
+func GetOrCreateNewDualStackFreeIpAddrTracker(subnet *net.IPNet, ipv6Subnet *net.IPNet, alreadyTakenIps map[string]bool, db *enclave_db.EnclaveDB) (*FreeIpAddrTracker, error) {
+	alreadyTakenIpv4s := map[string]bool{}
+	alreadyTakenIpv6s := map[string]bool{}
+	for ipAddr := range alreadyTakenIps {
+		if parsedIpAddr := net.ParseIP(ipAddr); parsedIpAddr != nil && parsedIpAddr.To4() == nil {
+			alreadyTakenIpv6s[ipAddr] = true
+		} else {
+			alreadyTakenIpv4s[ipAddr] = true
+		}
+	}
+
+	if err := createTakenIpAddrsBucketIfNotExists(db, takenIpAddressBucketName, alreadyTakenIpv4s); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building free IP address tracker")
+	}
+	if ipv6Subnet != nil {
+		if err := createTakenIpAddrsBucketIfNotExists(db, takenIpv6AddressBucketName, alreadyTakenIpv6s); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while building free IPv6 address tracker")
+		}
+	}
+	return &FreeIpAddrTracker{
+		subnet,
+		ipv6Subnet,
+		db,
+	}, nil
+}
+
+func createTakenIpAddrsBucketIfNotExists(db *enclave_db.EnclaveDB, bucketName []byte, alreadyTakenIps map[string]bool) error {
 	bucketExists := false
 	err := db.Update(func(tx *bolt.Tx) error {
-		bucket, err := tx.CreateBucket(takenIpAddressBucketName)
+		bucket, err := tx.CreateBucket(bucketName)
 		if err != nil {
 			bucketExists = true
 			return stacktrace.Propagate(err, "An error occurred while creating IP tracker database bucket")
@@ -65,23 +119,39 @@ func GetOrCreateNewFreeIpAddrTracker(subnet *net.IPNet, alreadyTakenIps map[stri
 		return nil
 	})
 	if err != nil && !bucketExists {
-		return nil, stacktrace.Propagate(err, "An error occurred while building free IP address tracker")
+		return stacktrace.Propagate(err, "An error occurred while creating IP tracker database bucket '%s'", bucketName)
 	}
 	// Bucket does exist, skipping population step
 	if err == bolt.ErrBucketExists {
-		logrus.Debugf("Taken IP addresses loaded from database")
+		logrus.Debugf("Taken IP addresses loaded from database bucket '%s'", bucketName)
 	} else {
-		logrus.Debugf("Taken IP addresses saved to database")
+		logrus.Debugf("Taken IP addresses saved to database bucket '%s'", bucketName)
 	}
-	return &FreeIpAddrTracker{
-		subnet,
-		db,
-	}, nil
+	return nil
+}
+
+func getFreeIpAddrFromBucket(db *enclave_db.EnclaveDB, bucketName []byte, subnet *net.IPNet) (net.IP, error) {
+	var ipAddr net.IP
+	err := db.Update(func(tx *bolt.Tx) error {
+		takenIps, err := getTakenIpAddrs(tx, bucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting taken IP addresses")
+		}
+		ipAddr, err = network_helpers.GetFreeIpAddrFromSubnet(takenIps, subnet)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting a free IP address from subnet")
+		}
+		return tx.Bucket(bucketName).Put([]byte(ipAddr.String()), consts.EmptyValueForKeySet)
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while taking a free IP address from database bucket '%s'", bucketName)
+	}
+	return ipAddr, nil
 }
 
-func getTakenIpAddrs(tx *bolt.Tx) (map[string]bool, error) {
+func getTakenIpAddrs(tx *bolt.Tx, bucketName []byte) (map[string]bool, error) {
 	takenIps := map[string]bool{}
-	bucket := tx.Bucket(takenIpAddressBucketName)
+	bucket := tx.Bucket(bucketName)
 	err := bucket.ForEach(func(k, v []byte) error {
 		takenIps[string(k)] = true
 		return nil
@@ -88,3 +88,48 @@ func TestIpTrackerDiskPersistence(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "1.2.0.4", ip2.String())
 }
+
+func TestGetIpv6(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	_, parsedSubnetMask, err := net.ParseCIDR("1.2.3.4/16")
+	require.Nil(t, err)
+	_, parsedIpv6SubnetMask, err := net.ParseCIDR("fd00:1:2:3::/64")
+	require.Nil(t, err)
+	addrTracker, err := GetOrCreateNewDualStackFreeIpAddrTracker(parsedSubnetMask, parsedIpv6SubnetMask, map[string]bool{
+		"1.2.0.1":       true,
+		"fd00:1:2:3::1": true,
+	}, enclaveDb)
+	require.Nil(t, err)
+	require.True(t, addrTracker.IsIpv6Enabled())
+
+	ip, err := addrTracker.GetFreeIpAddr()
+	require.Nil(t, err)
+	require.Equal(t, "1.2.0.2", ip.String())
+
+	ipv6, err := addrTracker.GetFreeIpv6Addr()
+	require.Nil(t, err)
+	require.Equal(t, "fd00:1:2:3::2", ipv6.String())
+
+	err = addrTracker.ReleaseIpAddr(ipv6)
+	require.Nil(t, err)
+
+	ipv6Again, err := addrTracker.GetFreeIpv6Addr()
+	require.Nil(t, err)
+	require.Equal(t, "fd00:1:2:3::2", ipv6Again.String())
+}
+
+func TestGetIpv6_Ipv4OnlyTrackerError(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	_, parsedSubnetMask, err := net.ParseCIDR("1.2.3.4/16")
+	require.Nil(t, err)
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, map[string]bool{}, enclaveDb)
+	require.Nil(t, err)
+	require.False(t, addrTracker.IsIpv6Enabled())
+
+	_, err = addrTracker.GetFreeIpv6Addr()
+	require.NotNil(t, err)
+}
@@ -0,0 +1,168 @@
+package partition_connection_overrides
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DirectionalPartitionConnectionOverridesBucket stores the connection overrides that only apply to the traffic going
+// from one partition to another
+type DirectionalPartitionConnectionOverridesBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+var (
+	directionalPartitionConnectionOverridesBucketName = []byte("directional-partition-connection-overrides")
+)
+
+func newDirectionalPartitionConnectionOverridesBucket(db *enclave_db.EnclaveDB) *DirectionalPartitionConnectionOverridesBucket {
+	return &DirectionalPartitionConnectionOverridesBucket{
+		db: db,
+	}
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) GetPartitionConnectionOverride(connectionId DirectionalPartitionConnectionID) (PartitionConnection, error) {
+	var connection PartitionConnection
+	getPartitionConnectionOverride := func(tx *bolt.Tx) error {
+		jsonifiedPartitionConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting directional partition connection ID '%v' to json", connectionId)
+		}
+		values := tx.Bucket(directionalPartitionConnectionOverridesBucketName).Get(jsonifiedPartitionConnectionId)
+		if values == nil {
+			return nil
+		}
+		if err = json.Unmarshal(values, &connection); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' from json bytes to Golang type; This is a bug in Kurtosis", values)
+		}
+		return nil
+	}
+	if err := pc.db.View(getPartitionConnectionOverride); err != nil {
+		return EmptyPartitionConnection, stacktrace.Propagate(err, "An error occurred while fetching directional partition connection override for connection with ID '%v'", connectionId)
+	}
+	return connection, nil
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) DoesPartitionConnectionOverrideExist(connectionId DirectionalPartitionConnectionID) (bool, error) {
+	var exists bool
+	getPartitionConnection := func(tx *bolt.Tx) error {
+		jsonifiedPartitionConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting directional partition connection with ID '%v' to json", connectionId)
+		}
+		if values := tx.Bucket(directionalPartitionConnectionOverridesBucketName).Get(jsonifiedPartitionConnectionId); values == nil {
+			return nil
+		}
+		exists = true
+		return nil
+	}
+	if err := pc.db.View(getPartitionConnection); err != nil {
+		return exists, stacktrace.Propagate(err, "An error occurred while verifying whether directional connection override with ID exists '%v'", connectionId)
+	}
+	return exists, nil
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) AddPartitionConnectionOverride(connectionId DirectionalPartitionConnectionID, connection PartitionConnection) error {
+	addPartitionConnectionFunc := func(tx *bolt.Tx) error {
+		jsonifiedConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting directional partition connection ID '%v' to json", connectionId)
+		}
+		jsonifiedPartitionConnection, err := json.Marshal(connection)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' to json", connection)
+		}
+		return tx.Bucket(directionalPartitionConnectionOverridesBucketName).Put(jsonifiedConnectionId, jsonifiedPartitionConnection)
+	}
+	if err := pc.db.Update(addPartitionConnectionFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding directional partition connection override '%v' with ID '%v' to bucket", connection, connectionId)
+	}
+	return nil
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) GetAllPartitionConnectionOverrides() (map[DirectionalPartitionConnectionID]PartitionConnection, error) {
+	result := map[DirectionalPartitionConnectionID]PartitionConnection{}
+	getAllPartitionConnectionsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(connectionIdBytes, connectionBytes []byte) error {
+			var connectionIdUnmarshalled DirectionalPartitionConnectionID
+			if err := json.Unmarshal(connectionIdBytes, &connectionIdUnmarshalled); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting directional partition connection ID in bucket '%v' to Golang Type; this is a bug in Kurtosis", connectionIdBytes)
+			}
+			var connectionUnmarshalled PartitionConnection
+			if err := json.Unmarshal(connectionBytes, &connectionUnmarshalled); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting connection override in bucket '%v' to Golang Type' this is a bug in Kurtosis", connectionBytes)
+			}
+			result[connectionIdUnmarshalled] = connectionUnmarshalled
+			return nil
+		}
+		return tx.Bucket(directionalPartitionConnectionOverridesBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := pc.db.View(getAllPartitionConnectionsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all directional partition connection overrides")
+	}
+	return result, nil
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) ReplaceBucketContents(newConnections map[DirectionalPartitionConnectionID]PartitionConnection) error {
+	deleteAndReplaceBucketFunc := func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(directionalPartitionConnectionOverridesBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the bucket")
+		}
+		bucket, err := tx.CreateBucket(directionalPartitionConnectionOverridesBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred recreating the bucket")
+		}
+		for partitionConnectionId, connection := range newConnections {
+			jsonifiedConnectionId, err := json.Marshal(partitionConnectionId)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting directional partition connection ID '%v' to json", partitionConnectionId)
+			}
+			jsonifiedPartitionConnection, err := json.Marshal(connection)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' to json", connection)
+			}
+			if err = bucket.Put(jsonifiedConnectionId, jsonifiedPartitionConnection); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while storing directional connection override with connection ID '%v' and values '%v' to bucket", jsonifiedConnectionId, jsonifiedPartitionConnection)
+			}
+		}
+		return nil
+	}
+	if err := pc.db.Update(deleteAndReplaceBucketFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while replacing the existing directional partition connection bucket with new contents")
+	}
+	return nil
+}
+
+func (pc *DirectionalPartitionConnectionOverridesBucket) RemovePartitionConnectionOverride(connectionId DirectionalPartitionConnectionID) error {
+	removeConnectionFromBucketFunc := func(tx *bolt.Tx) error {
+		jsonifiedConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting directional partition connection ID '%v' to json", connectionId)
+		}
+		return tx.Bucket(directionalPartitionConnectionOverridesBucketName).Delete(jsonifiedConnectionId)
+	}
+	if err := pc.db.Update(removeConnectionFromBucketFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing directional partition connection override with ID '%v' from bucket", connectionId)
+	}
+	return nil
+}
+
+func GetOrCreateDirectionalPartitionConnectionOverrideBucket(db *enclave_db.EnclaveDB) (*DirectionalPartitionConnectionOverridesBucket, error) {
+	createOrReplaceBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(directionalPartitionConnectionOverridesBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating directional partition connection override bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createOrReplaceBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building directional partition connection override bucket")
+	}
+
+	return newDirectionalPartitionConnectionOverridesBucket(
+		db,
+	), nil
+}
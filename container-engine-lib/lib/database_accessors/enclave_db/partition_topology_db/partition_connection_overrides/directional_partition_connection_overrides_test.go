@@ -0,0 +1,78 @@
+package partition_connection_overrides
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	testDirectionalConnectionIdA = DirectionalPartitionConnectionID{
+		From: "bat",
+		To:   "apple",
+	}
+
+	testDirectionalConnectionIdB = DirectionalPartitionConnectionID{
+		From: "apple",
+		To:   "bat",
+	}
+)
+
+func TestDirectionalPartitionConnection_DirectionMatters(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateDirectionalPartitionConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddPartitionConnectionOverride(testDirectionalConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	exists, err := partitionConnections.DoesPartitionConnectionOverrideExist(testDirectionalConnectionIdA)
+	require.Nil(t, err)
+	require.True(t, exists)
+	exists, err = partitionConnections.DoesPartitionConnectionOverrideExist(testDirectionalConnectionIdB)
+	require.Nil(t, err)
+	require.False(t, exists)
+
+	connection, err := partitionConnections.GetPartitionConnectionOverride(testDirectionalConnectionIdA)
+	require.Nil(t, err)
+	require.Equal(t, testConnectionA, connection)
+}
+
+func TestDirectionalPartitionConnection_ReplaceBucketContents(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateDirectionalPartitionConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddPartitionConnectionOverride(testDirectionalConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	replacedConnections := map[DirectionalPartitionConnectionID]PartitionConnection{testDirectionalConnectionIdB: testConnectionB}
+	err = partitionConnections.ReplaceBucketContents(replacedConnections)
+	require.Nil(t, err)
+
+	allConnections, err := partitionConnections.GetAllPartitionConnectionOverrides()
+	require.Nil(t, err)
+	require.Equal(t, replacedConnections, allConnections)
+}
+
+func TestDirectionalPartitionConnection_DeleteConnection(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateDirectionalPartitionConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddPartitionConnectionOverride(testDirectionalConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	err = partitionConnections.RemovePartitionConnectionOverride(testDirectionalConnectionIdA)
+	require.Nil(t, err)
+
+	allConnections, err := partitionConnections.GetAllPartitionConnectionOverrides()
+	require.Nil(t, err)
+	require.Empty(t, allConnections)
+}
@@ -19,6 +19,19 @@ var (
 			Jitter:      10,
 			Correlation: 32,
 		},
+		BandwidthLimitKbit: 1000,
+		PacketDuplication: PacketPercentage{
+			Percentage:  1,
+			Correlation: 25,
+		},
+		PacketCorruption: PacketPercentage{
+			Percentage:  2,
+			Correlation: 30,
+		},
+		PacketReordering: PacketPercentage{
+			Percentage:  3,
+			Correlation: 35,
+		},
 	}
 
 	testConnectionIdB = PartitionConnectionID{
@@ -33,6 +46,19 @@ var (
 			Jitter:      11,
 			Correlation: 33,
 		},
+		BandwidthLimitKbit: 2000,
+		PacketDuplication: PacketPercentage{
+			Percentage:  4,
+			Correlation: 40,
+		},
+		PacketCorruption: PacketPercentage{
+			Percentage:  5,
+			Correlation: 45,
+		},
+		PacketReordering: PacketPercentage{
+			Percentage:  6,
+			Correlation: 50,
+		},
 	}
 )
 
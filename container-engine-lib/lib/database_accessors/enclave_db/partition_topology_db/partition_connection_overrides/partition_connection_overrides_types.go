@@ -1,6 +1,9 @@
 package partition_connection_overrides
 
-import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/partition"
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/partition"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
 
 // PartitionConnectionID The fields have to be upper-cased for JSON serialization to work
 type PartitionConnectionID struct {
@@ -8,6 +11,20 @@ type PartitionConnectionID struct {
 	LexicalSecond partition.PartitionID `json:"lexical_second"`
 }
 
+// DirectionalPartitionConnectionID Identifies the traffic going from one partition to another, where order matters
+// The fields have to be upper-cased for JSON serialization to work
+type DirectionalPartitionConnectionID struct {
+	From partition.PartitionID `json:"from"`
+	To   partition.PartitionID `json:"to"`
+}
+
+// ServiceConnectionID Identifies the connection between two services, the names being sorted lexically
+// The fields have to be upper-cased for JSON serialization to work
+type ServiceConnectionID struct {
+	LexicalFirst  service.ServiceName `json:"lexical_first"`
+	LexicalSecond service.ServiceName `json:"lexical_second"`
+}
+
 // DelayDistribution The fields have to be upper cased for JSON serialization to work
 type DelayDistribution struct {
 	AvgDelayMs  uint32  `json:"avg_delay"`
@@ -15,10 +32,21 @@ type DelayDistribution struct {
 	Correlation float32 `json:"correlation"`
 }
 
+// PacketPercentage The fields have to be upper cased for JSON serialization to work
+type PacketPercentage struct {
+	Percentage  float32 `json:"percentage"`
+	Correlation float32 `json:"correlation"`
+}
+
 var EmptyPartitionConnection PartitionConnection
 
 // PartitionConnection The fields have to be upper-cased for JSON serialization to work
 type PartitionConnection struct {
 	PacketLoss              float32           `json:"packet_loss"`
 	PacketDelayDistribution DelayDistribution `json:"delay_distribution"`
+	// 0 means no bandwidth limit
+	BandwidthLimitKbit uint32           `json:"bandwidth_limit_kbit"`
+	PacketDuplication  PacketPercentage `json:"packet_duplication"`
+	PacketCorruption   PacketPercentage `json:"packet_corruption"`
+	PacketReordering   PacketPercentage `json:"packet_reordering"`
 }
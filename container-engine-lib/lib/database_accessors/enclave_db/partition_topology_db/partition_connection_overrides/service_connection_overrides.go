@@ -0,0 +1,168 @@
+package partition_connection_overrides
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ServiceConnectionOverridesBucket stores the connection overrides set between two services, which take precedence
+// over the connection set between their partitions
+type ServiceConnectionOverridesBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+var (
+	serviceConnectionOverridesBucketName = []byte("service-connection-overrides")
+)
+
+func newServiceConnectionOverridesBucket(db *enclave_db.EnclaveDB) *ServiceConnectionOverridesBucket {
+	return &ServiceConnectionOverridesBucket{
+		db: db,
+	}
+}
+
+func (pc *ServiceConnectionOverridesBucket) GetServiceConnectionOverride(connectionId ServiceConnectionID) (PartitionConnection, error) {
+	var connection PartitionConnection
+	getPartitionConnectionOverride := func(tx *bolt.Tx) error {
+		jsonifiedPartitionConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting service connection ID '%v' to json", connectionId)
+		}
+		values := tx.Bucket(serviceConnectionOverridesBucketName).Get(jsonifiedPartitionConnectionId)
+		if values == nil {
+			return nil
+		}
+		if err = json.Unmarshal(values, &connection); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' from json bytes to Golang type; This is a bug in Kurtosis", values)
+		}
+		return nil
+	}
+	if err := pc.db.View(getPartitionConnectionOverride); err != nil {
+		return EmptyPartitionConnection, stacktrace.Propagate(err, "An error occurred while fetching service connection override for connection with ID '%v'", connectionId)
+	}
+	return connection, nil
+}
+
+func (pc *ServiceConnectionOverridesBucket) DoesServiceConnectionOverrideExist(connectionId ServiceConnectionID) (bool, error) {
+	var exists bool
+	getPartitionConnection := func(tx *bolt.Tx) error {
+		jsonifiedPartitionConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting service connection with ID '%v' to json", connectionId)
+		}
+		if values := tx.Bucket(serviceConnectionOverridesBucketName).Get(jsonifiedPartitionConnectionId); values == nil {
+			return nil
+		}
+		exists = true
+		return nil
+	}
+	if err := pc.db.View(getPartitionConnection); err != nil {
+		return exists, stacktrace.Propagate(err, "An error occurred while verifying whether service connection override with ID exists '%v'", connectionId)
+	}
+	return exists, nil
+}
+
+func (pc *ServiceConnectionOverridesBucket) AddServiceConnectionOverride(connectionId ServiceConnectionID, connection PartitionConnection) error {
+	addPartitionConnectionFunc := func(tx *bolt.Tx) error {
+		jsonifiedConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting service connection ID '%v' to json", connectionId)
+		}
+		jsonifiedPartitionConnection, err := json.Marshal(connection)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' to json", connection)
+		}
+		return tx.Bucket(serviceConnectionOverridesBucketName).Put(jsonifiedConnectionId, jsonifiedPartitionConnection)
+	}
+	if err := pc.db.Update(addPartitionConnectionFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding service connection override '%v' with ID '%v' to bucket", connection, connectionId)
+	}
+	return nil
+}
+
+func (pc *ServiceConnectionOverridesBucket) GetAllServiceConnectionOverrides() (map[ServiceConnectionID]PartitionConnection, error) {
+	result := map[ServiceConnectionID]PartitionConnection{}
+	getAllPartitionConnectionsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(connectionIdBytes, connectionBytes []byte) error {
+			var connectionIdUnmarshalled ServiceConnectionID
+			if err := json.Unmarshal(connectionIdBytes, &connectionIdUnmarshalled); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting service connection ID in bucket '%v' to Golang Type; this is a bug in Kurtosis", connectionIdBytes)
+			}
+			var connectionUnmarshalled PartitionConnection
+			if err := json.Unmarshal(connectionBytes, &connectionUnmarshalled); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting connection override in bucket '%v' to Golang Type' this is a bug in Kurtosis", connectionBytes)
+			}
+			result[connectionIdUnmarshalled] = connectionUnmarshalled
+			return nil
+		}
+		return tx.Bucket(serviceConnectionOverridesBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := pc.db.View(getAllPartitionConnectionsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service connection overrides")
+	}
+	return result, nil
+}
+
+func (pc *ServiceConnectionOverridesBucket) ReplaceBucketContents(newConnections map[ServiceConnectionID]PartitionConnection) error {
+	deleteAndReplaceBucketFunc := func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(serviceConnectionOverridesBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the bucket")
+		}
+		bucket, err := tx.CreateBucket(serviceConnectionOverridesBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred recreating the bucket")
+		}
+		for partitionConnectionId, connection := range newConnections {
+			jsonifiedConnectionId, err := json.Marshal(partitionConnectionId)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting service connection ID '%v' to json", partitionConnectionId)
+			}
+			jsonifiedPartitionConnection, err := json.Marshal(connection)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting partition connection '%v' to json", connection)
+			}
+			if err = bucket.Put(jsonifiedConnectionId, jsonifiedPartitionConnection); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while storing service connection override with connection ID '%v' and values '%v' to bucket", jsonifiedConnectionId, jsonifiedPartitionConnection)
+			}
+		}
+		return nil
+	}
+	if err := pc.db.Update(deleteAndReplaceBucketFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while replacing the existing service connection bucket with new contents")
+	}
+	return nil
+}
+
+func (pc *ServiceConnectionOverridesBucket) RemoveServiceConnectionOverride(connectionId ServiceConnectionID) error {
+	removeConnectionFromBucketFunc := func(tx *bolt.Tx) error {
+		jsonifiedConnectionId, err := json.Marshal(connectionId)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while converting service connection ID '%v' to json", connectionId)
+		}
+		return tx.Bucket(serviceConnectionOverridesBucketName).Delete(jsonifiedConnectionId)
+	}
+	if err := pc.db.Update(removeConnectionFromBucketFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing service connection override with ID '%v' from bucket", connectionId)
+	}
+	return nil
+}
+
+func GetOrCreateServiceConnectionOverrideBucket(db *enclave_db.EnclaveDB) (*ServiceConnectionOverridesBucket, error) {
+	createOrReplaceBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(serviceConnectionOverridesBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating service connection override bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createOrReplaceBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building service connection override bucket")
+	}
+
+	return newServiceConnectionOverridesBucket(
+		db,
+	), nil
+}
@@ -0,0 +1,78 @@
+package partition_connection_overrides
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	testServiceConnectionIdA = ServiceConnectionID{
+		LexicalFirst:  "apple",
+		LexicalSecond: "bat",
+	}
+
+	testServiceConnectionIdB = ServiceConnectionID{
+		LexicalFirst:  "apple",
+		LexicalSecond: "cat",
+	}
+)
+
+func TestServiceConnection_AddAndGet(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateServiceConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddServiceConnectionOverride(testServiceConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	exists, err := partitionConnections.DoesServiceConnectionOverrideExist(testServiceConnectionIdA)
+	require.Nil(t, err)
+	require.True(t, exists)
+	exists, err = partitionConnections.DoesServiceConnectionOverrideExist(testServiceConnectionIdB)
+	require.Nil(t, err)
+	require.False(t, exists)
+
+	connection, err := partitionConnections.GetServiceConnectionOverride(testServiceConnectionIdA)
+	require.Nil(t, err)
+	require.Equal(t, testConnectionA, connection)
+}
+
+func TestServiceConnection_ReplaceBucketContents(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateServiceConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddServiceConnectionOverride(testServiceConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	replacedConnections := map[ServiceConnectionID]PartitionConnection{testServiceConnectionIdB: testConnectionB}
+	err = partitionConnections.ReplaceBucketContents(replacedConnections)
+	require.Nil(t, err)
+
+	allConnections, err := partitionConnections.GetAllServiceConnectionOverrides()
+	require.Nil(t, err)
+	require.Equal(t, replacedConnections, allConnections)
+}
+
+func TestServiceConnection_DeleteConnection(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	partitionConnections, err := GetOrCreateServiceConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+
+	err = partitionConnections.AddServiceConnectionOverride(testServiceConnectionIdA, testConnectionA)
+	require.Nil(t, err)
+
+	err = partitionConnections.RemoveServiceConnectionOverride(testServiceConnectionIdA)
+	require.Nil(t, err)
+
+	allConnections, err := partitionConnections.GetAllServiceConnectionOverrides()
+	require.Nil(t, err)
+	require.Empty(t, allConnections)
+}
@@ -0,0 +1,82 @@
+package runtime_values
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	runtimeValuesBucketName = []byte("runtime-values")
+)
+
+// RuntimeValuesBucket stores the runtime values produced by the instructions run in the enclave. Each runtime value
+// is a set of fields, and each field value is stored serialized as the value type isn't known at this level
+type RuntimeValuesBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newRuntimeValues(db *enclave_db.EnclaveDB) *RuntimeValuesBucket {
+	return &RuntimeValuesBucket{
+		db,
+	}
+}
+
+func (rv *RuntimeValuesBucket) SetRuntimeValue(uuid string, serializedFields map[string]string) error {
+	serializedFieldsBytes, err := json.Marshal(serializedFields)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while converting the fields of runtime value '%v' to json. This is a bug in Kurtosis.", uuid)
+	}
+	setRuntimeValueFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(runtimeValuesBucketName).Put([]byte(uuid), serializedFieldsBytes)
+	}
+	if err := rv.db.Update(setRuntimeValueFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while storing runtime value '%v'", uuid)
+	}
+	return nil
+}
+
+func (rv *RuntimeValuesBucket) RemoveRuntimeValue(uuid string) error {
+	removeRuntimeValueFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(runtimeValuesBucketName).Delete([]byte(uuid))
+	}
+	if err := rv.db.Update(removeRuntimeValueFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing runtime value '%v' from bucket", uuid)
+	}
+	return nil
+}
+
+func (rv *RuntimeValuesBucket) GetAllRuntimeValues() (map[string]map[string]string, error) {
+	result := map[string]map[string]string{}
+	getAllRuntimeValuesFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(uuid, serializedFieldsBytes []byte) error {
+			serializedFields := map[string]string{}
+			if err := json.Unmarshal(serializedFieldsBytes, &serializedFields); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting the fields '%s' stored against runtime value '%s' in bolt to a usable Go type; This is a bug in Kurtosis", serializedFieldsBytes, uuid)
+			}
+			result[string(uuid)] = serializedFields
+			return nil
+		}
+		return tx.Bucket(runtimeValuesBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := rv.db.View(getAllRuntimeValuesFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all runtime values")
+	}
+	return result, nil
+}
+
+func GetOrCreateRuntimeValuesBucket(db *enclave_db.EnclaveDB) (*RuntimeValuesBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(runtimeValuesBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating runtime values database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building runtime values")
+	}
+	return newRuntimeValues(db), nil
+}
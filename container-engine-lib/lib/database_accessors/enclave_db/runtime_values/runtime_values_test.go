@@ -0,0 +1,43 @@
+package runtime_values
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testRuntimeValueUuidA = "runtime-value-a"
+	testRuntimeValueUuidB = "runtime-value-b"
+)
+
+func TestSetAndRemoveRuntimeValues(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	runtimeValues, err := GetOrCreateRuntimeValuesBucket(enclaveDb)
+	require.Nil(t, err)
+
+	serializedFields := map[string]string{
+		"code": "200",
+		"body": "\"hello\"",
+	}
+	require.Nil(t, runtimeValues.SetRuntimeValue(testRuntimeValueUuidA, map[string]string{}))
+	require.Nil(t, runtimeValues.SetRuntimeValue(testRuntimeValueUuidB, serializedFields))
+
+	result, err := runtimeValues.GetAllRuntimeValues()
+	require.Nil(t, err)
+	expectedResult := map[string]map[string]string{
+		testRuntimeValueUuidA: {},
+		testRuntimeValueUuidB: serializedFields,
+	}
+	require.Equal(t, expectedResult, result)
+
+	require.Nil(t, runtimeValues.RemoveRuntimeValue(testRuntimeValueUuidA))
+	result, err = runtimeValues.GetAllRuntimeValues()
+	require.Nil(t, err)
+	expectedResult = map[string]map[string]string{
+		testRuntimeValueUuidB: serializedFields,
+	}
+	require.Equal(t, expectedResult, result)
+}
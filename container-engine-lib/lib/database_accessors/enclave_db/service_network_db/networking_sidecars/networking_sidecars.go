@@ -0,0 +1,73 @@
+package networking_sidecars
+
+import (
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	networkingSidecarsBucketName = []byte("networking-sidecars")
+)
+
+// NetworkingSidecarsBucket keeps track of the services that have a networking sidecar attached to them
+type NetworkingSidecarsBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newNetworkingSidecars(db *enclave_db.EnclaveDB) *NetworkingSidecarsBucket {
+	return &NetworkingSidecarsBucket{
+		db,
+	}
+}
+
+func (ns *NetworkingSidecarsBucket) AddNetworkingSidecar(serviceName service.ServiceName, serviceUuid service.ServiceUUID) error {
+	addNetworkingSidecarFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(networkingSidecarsBucketName).Put([]byte(serviceName), []byte(serviceUuid))
+	}
+	if err := ns.db.Update(addNetworkingSidecarFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding the networking sidecar of service '%v'", serviceName)
+	}
+	return nil
+}
+
+func (ns *NetworkingSidecarsBucket) RemoveNetworkingSidecar(serviceName service.ServiceName) error {
+	removeNetworkingSidecarFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(networkingSidecarsBucketName).Delete([]byte(serviceName))
+	}
+	if err := ns.db.Update(removeNetworkingSidecarFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing the networking sidecar of service '%v' from bucket", serviceName)
+	}
+	return nil
+}
+
+func (ns *NetworkingSidecarsBucket) GetAllNetworkingSidecars() (map[service.ServiceName]service.ServiceUUID, error) {
+	result := map[service.ServiceName]service.ServiceUUID{}
+	getAllNetworkingSidecarsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(serviceName, serviceUuid []byte) error {
+			result[service.ServiceName(serviceName)] = service.ServiceUUID(serviceUuid)
+			return nil
+		}
+		return tx.Bucket(networkingSidecarsBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := ns.db.View(getAllNetworkingSidecarsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all networking sidecars")
+	}
+	return result, nil
+}
+
+func GetOrCreateNetworkingSidecarsBucket(db *enclave_db.EnclaveDB) (*NetworkingSidecarsBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(networkingSidecarsBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating networking sidecars database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building networking sidecars")
+	}
+	return newNetworkingSidecars(db), nil
+}
@@ -0,0 +1,34 @@
+package networking_sidecars
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testServiceNameA = service.ServiceName("test-service-a")
+	testServiceUuidA = service.ServiceUUID("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	testServiceNameB = service.ServiceName("test-service-b")
+	testServiceUuidB = service.ServiceUUID("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+)
+
+func TestAddAndRemoveNetworkingSidecars(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	networkingSidecars, err := GetOrCreateNetworkingSidecarsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	require.Nil(t, networkingSidecars.AddNetworkingSidecar(testServiceNameA, testServiceUuidA))
+	require.Nil(t, networkingSidecars.AddNetworkingSidecar(testServiceNameB, testServiceUuidB))
+	require.Nil(t, networkingSidecars.RemoveNetworkingSidecar(testServiceNameA))
+
+	result, err := networkingSidecars.GetAllNetworkingSidecars()
+	require.Nil(t, err)
+	expectedResult := map[service.ServiceName]service.ServiceUUID{
+		testServiceNameB: testServiceUuidB,
+	}
+	require.Equal(t, expectedResult, result)
+}
@@ -0,0 +1,77 @@
+package service_configs
+
+import (
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	serviceConfigsBucketName = []byte("service-configs")
+)
+
+// ServiceConfigsBucket stores the config each service was started with. Configs are stored already serialized as the
+// config type belongs to the API and is not known at this level
+type ServiceConfigsBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newServiceConfigs(db *enclave_db.EnclaveDB) *ServiceConfigsBucket {
+	return &ServiceConfigsBucket{
+		db,
+	}
+}
+
+func (sc *ServiceConfigsBucket) PutServiceConfig(serviceName service.ServiceName, serializedServiceConfig []byte) error {
+	putServiceConfigFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(serviceConfigsBucketName).Put([]byte(serviceName), serializedServiceConfig)
+	}
+	if err := sc.db.Update(putServiceConfigFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while storing the config of service '%v'", serviceName)
+	}
+	return nil
+}
+
+func (sc *ServiceConfigsBucket) RemoveServiceConfig(serviceName service.ServiceName) error {
+	removeServiceConfigFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(serviceConfigsBucketName).Delete([]byte(serviceName))
+	}
+	if err := sc.db.Update(removeServiceConfigFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing the config of service '%v' from bucket", serviceName)
+	}
+	return nil
+}
+
+func (sc *ServiceConfigsBucket) GetAllServiceConfigs() (map[service.ServiceName][]byte, error) {
+	result := map[service.ServiceName][]byte{}
+	getAllServiceConfigsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(serviceName, serializedServiceConfig []byte) error {
+			// values returned by bolt are only valid for the life of the transaction, hence the copy
+			serializedServiceConfigCopy := make([]byte, len(serializedServiceConfig))
+			copy(serializedServiceConfigCopy, serializedServiceConfig)
+			result[service.ServiceName(serviceName)] = serializedServiceConfigCopy
+			return nil
+		}
+		return tx.Bucket(serviceConfigsBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := sc.db.View(getAllServiceConfigsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service configs")
+	}
+	return result, nil
+}
+
+func GetOrCreateServiceConfigsBucket(db *enclave_db.EnclaveDB) (*ServiceConfigsBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(serviceConfigsBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating service configs database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building service configs")
+	}
+	return newServiceConfigs(db), nil
+}
@@ -0,0 +1,54 @@
+package service_configs
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testServiceNameA = service.ServiceName("test-service-a")
+	testServiceNameB = service.ServiceName("test-service-b")
+)
+
+var (
+	testServiceConfigA        = []byte("service-config-a")
+	testServiceConfigB        = []byte("service-config-b")
+	testUpdatedServiceConfigA = []byte("updated-service-config-a")
+)
+
+func TestPutAndGetAllServiceConfigs(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceConfigs, err := GetOrCreateServiceConfigsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	require.Nil(t, serviceConfigs.PutServiceConfig(testServiceNameA, testServiceConfigA))
+	require.Nil(t, serviceConfigs.PutServiceConfig(testServiceNameB, testServiceConfigB))
+	require.Nil(t, serviceConfigs.PutServiceConfig(testServiceNameA, testUpdatedServiceConfigA))
+
+	result, err := serviceConfigs.GetAllServiceConfigs()
+	require.Nil(t, err)
+	expectedResult := map[service.ServiceName][]byte{
+		testServiceNameA: testUpdatedServiceConfigA,
+		testServiceNameB: testServiceConfigB,
+	}
+	require.Equal(t, expectedResult, result)
+}
+
+func TestRemoveServiceConfig(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceConfigs, err := GetOrCreateServiceConfigsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	require.Nil(t, serviceConfigs.PutServiceConfig(testServiceNameA, testServiceConfigA))
+	require.Nil(t, serviceConfigs.RemoveServiceConfig(testServiceNameA))
+
+	result, err := serviceConfigs.GetAllServiceConfigs()
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
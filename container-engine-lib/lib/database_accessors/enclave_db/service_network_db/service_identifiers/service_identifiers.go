@@ -0,0 +1,96 @@
+package service_identifiers
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	serviceIdentifiersBucketName = []byte("service-identifiers")
+)
+
+type ServiceIdentifier struct {
+	ServiceUuid   service.ServiceUUID `json:"service_uuid"`
+	Name          service.ServiceName `json:"name"`
+	ShortenedUuid string              `json:"shortened_uuid"`
+}
+
+// ServiceIdentifiersBucket holds the identifiers of all services ever successfully created in the enclave. It is
+// append only and keys are the bucket sequence numbers, so that identifiers are returned in the order they were added
+type ServiceIdentifiersBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newServiceIdentifiers(db *enclave_db.EnclaveDB) *ServiceIdentifiersBucket {
+	return &ServiceIdentifiersBucket{
+		db,
+	}
+}
+
+func (si *ServiceIdentifiersBucket) AppendServiceIdentifier(serviceIdentifier *ServiceIdentifier) error {
+	serviceIdentifierBytes, err := json.Marshal(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while converting the identifiers of service '%v' to json. This is a bug in Kurtosis.", serviceIdentifier.Name)
+	}
+	appendServiceIdentifierFunc := func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceIdentifiersBucketName)
+		sequence, err := bucket.NextSequence()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting the next sequence number of the bucket")
+		}
+		return bucket.Put(sequenceToKey(sequence), serviceIdentifierBytes)
+	}
+	if err := si.db.Update(appendServiceIdentifierFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while appending the identifiers of service '%v'", serviceIdentifier.Name)
+	}
+	return nil
+}
+
+func (si *ServiceIdentifiersBucket) GetAllServiceIdentifiers() ([]*ServiceIdentifier, error) {
+	var result []*ServiceIdentifier
+	getAllServiceIdentifiersFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(key, serviceIdentifierBytes []byte) error {
+			serviceIdentifier := &ServiceIdentifier{
+				ServiceUuid:   "",
+				Name:          "",
+				ShortenedUuid: "",
+			}
+			if err := json.Unmarshal(serviceIdentifierBytes, serviceIdentifier); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting the service identifiers '%s' stored in bolt to a usable Go type; This is a bug in Kurtosis", serviceIdentifierBytes)
+			}
+			result = append(result, serviceIdentifier)
+			return nil
+		}
+		return tx.Bucket(serviceIdentifiersBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := si.db.View(getAllServiceIdentifiersFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service identifiers")
+	}
+	return result, nil
+}
+
+func GetOrCreateServiceIdentifiersBucket(db *enclave_db.EnclaveDB) (*ServiceIdentifiersBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(serviceIdentifiersBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating service identifiers database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building service identifiers")
+	}
+	return newServiceIdentifiers(db), nil
+}
+
+// bolt iterates over keys in byte-sorted order, so the sequence is stored big endian to preserve the insertion order
+func sequenceToKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	return key
+}
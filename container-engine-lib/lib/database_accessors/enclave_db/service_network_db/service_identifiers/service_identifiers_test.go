@@ -0,0 +1,48 @@
+package service_identifiers
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	numServiceIdentifiers = 300
+)
+
+func TestAppendServiceIdentifier_KeepsInsertionOrder(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceIdentifiers, err := GetOrCreateServiceIdentifiersBucket(enclaveDb)
+	require.Nil(t, err)
+
+	var expectedServiceIdentifiers []*ServiceIdentifier
+	for i := 0; i < numServiceIdentifiers; i++ {
+		serviceIdentifier := &ServiceIdentifier{
+			ServiceUuid:   service.ServiceUUID(fmt.Sprintf("uuid-%d", i)),
+			Name:          service.ServiceName(fmt.Sprintf("service-%d", i)),
+			ShortenedUuid: fmt.Sprintf("short-%d", i),
+		}
+		require.Nil(t, serviceIdentifiers.AppendServiceIdentifier(serviceIdentifier))
+		expectedServiceIdentifiers = append(expectedServiceIdentifiers, serviceIdentifier)
+	}
+
+	result, err := serviceIdentifiers.GetAllServiceIdentifiers()
+	require.Nil(t, err)
+	require.Equal(t, expectedServiceIdentifiers, result)
+}
+
+func TestGetAllServiceIdentifiers_EmptyBucket(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceIdentifiers, err := GetOrCreateServiceIdentifiersBucket(enclaveDb)
+	require.Nil(t, err)
+
+	result, err := serviceIdentifiers.GetAllServiceIdentifiers()
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
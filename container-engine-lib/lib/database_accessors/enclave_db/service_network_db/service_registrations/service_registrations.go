@@ -0,0 +1,132 @@
+package service_registrations
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"net"
+)
+
+var (
+	serviceRegistrationsBucketName = []byte("service-registrations")
+)
+
+// serviceRegistrationDbType is the representation of a service.ServiceRegistration stored in the bucket, as the
+// fields of the registration aren't exported
+type serviceRegistrationDbType struct {
+	Name        service.ServiceName `json:"name"`
+	Uuid        service.ServiceUUID `json:"uuid"`
+	EnclaveUuid enclave.EnclaveUUID `json:"enclave_uuid"`
+	PrivateIp   string              `json:"private_ip"`
+	PrivateIpv6 string              `json:"private_ipv6,omitempty"` // Empty if the service doesn't have an IPv6
+	Hostname    string              `json:"hostname"`
+}
+
+type ServiceRegistrationsBucket struct {
+	db *enclave_db.EnclaveDB
+}
+
+func newServiceRegistrations(db *enclave_db.EnclaveDB) *ServiceRegistrationsBucket {
+	return &ServiceRegistrationsBucket{
+		db,
+	}
+}
+
+func (sr *ServiceRegistrationsBucket) AddServiceRegistration(serviceRegistration *service.ServiceRegistration) error {
+	serviceName := serviceRegistration.GetName()
+	privateIpv6Str := ""
+	if privateIpv6 := serviceRegistration.GetPrivateIPv6(); privateIpv6 != nil {
+		privateIpv6Str = privateIpv6.String()
+	}
+	serviceRegistrationDbValue := &serviceRegistrationDbType{
+		Name:        serviceName,
+		Uuid:        serviceRegistration.GetUUID(),
+		EnclaveUuid: serviceRegistration.GetEnclaveID(),
+		PrivateIp:   serviceRegistration.GetPrivateIP().String(),
+		PrivateIpv6: privateIpv6Str,
+		Hostname:    serviceRegistration.GetHostname(),
+	}
+	serviceRegistrationBytes, err := json.Marshal(serviceRegistrationDbValue)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while converting the registration of service '%v' to json. This is a bug in Kurtosis.", serviceName)
+	}
+	addServiceRegistrationFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(serviceRegistrationsBucketName).Put([]byte(serviceName), serviceRegistrationBytes)
+	}
+	if err := sr.db.Update(addServiceRegistrationFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding the registration of service '%v'", serviceName)
+	}
+	return nil
+}
+
+func (sr *ServiceRegistrationsBucket) RemoveServiceRegistration(serviceName service.ServiceName) error {
+	removeServiceRegistrationFunc := func(tx *bolt.Tx) error {
+		return tx.Bucket(serviceRegistrationsBucketName).Delete([]byte(serviceName))
+	}
+	if err := sr.db.Update(removeServiceRegistrationFunc); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing the registration of service '%v' from bucket", serviceName)
+	}
+	return nil
+}
+
+func (sr *ServiceRegistrationsBucket) GetAllServiceRegistrations() (map[service.ServiceName]*service.ServiceRegistration, error) {
+	result := map[service.ServiceName]*service.ServiceRegistration{}
+	getAllServiceRegistrationsFunc := func(tx *bolt.Tx) error {
+		iterateThroughBucketAndPopulateResult := func(serviceName, serviceRegistrationBytes []byte) error {
+			serviceRegistrationDbValue := &serviceRegistrationDbType{
+				Name:        "",
+				Uuid:        "",
+				EnclaveUuid: "",
+				PrivateIp:   "",
+				PrivateIpv6: "",
+				Hostname:    "",
+			}
+			if err := json.Unmarshal(serviceRegistrationBytes, serviceRegistrationDbValue); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while converting the registration '%s' stored against service '%s' in bolt to a usable Go type; This is a bug in Kurtosis", serviceRegistrationBytes, serviceName)
+			}
+			privateIp := net.ParseIP(serviceRegistrationDbValue.PrivateIp)
+			if privateIp == nil {
+				return stacktrace.NewError("The private IP '%s' stored against service '%s' is not a valid IP address; This is a bug in Kurtosis", serviceRegistrationDbValue.PrivateIp, serviceName)
+			}
+			var privateIpv6 net.IP
+			if serviceRegistrationDbValue.PrivateIpv6 != "" {
+				privateIpv6 = net.ParseIP(serviceRegistrationDbValue.PrivateIpv6)
+				if privateIpv6 == nil {
+					return stacktrace.NewError("The private IPv6 '%s' stored against service '%s' is not a valid IP address; This is a bug in Kurtosis", serviceRegistrationDbValue.PrivateIpv6, serviceName)
+				}
+			}
+			result[service.ServiceName(serviceName)] = service.NewDualStackServiceRegistration(
+				serviceRegistrationDbValue.Name,
+				serviceRegistrationDbValue.Uuid,
+				serviceRegistrationDbValue.EnclaveUuid,
+				privateIp,
+				privateIpv6,
+				serviceRegistrationDbValue.Hostname,
+			)
+			return nil
+		}
+		return tx.Bucket(serviceRegistrationsBucketName).ForEach(iterateThroughBucketAndPopulateResult)
+	}
+	if err := sr.db.View(getAllServiceRegistrationsFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service registrations")
+	}
+	return result, nil
+}
+
+func GetOrCreateServiceRegistrationsBucket(db *enclave_db.EnclaveDB) (*ServiceRegistrationsBucket, error) {
+	createBucketFunc := func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(serviceRegistrationsBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketExists) {
+			return stacktrace.Propagate(err, "An error occurred while creating service registrations database bucket")
+		}
+		return nil
+	}
+	if err := db.Update(createBucketFunc); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building service registrations")
+	}
+	return newServiceRegistrations(db), nil
+}
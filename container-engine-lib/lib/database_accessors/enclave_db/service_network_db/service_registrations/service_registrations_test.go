@@ -0,0 +1,99 @@
+package service_registrations
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+const (
+	testServiceNameA = service.ServiceName("test-service-a")
+	testServiceUuidA = service.ServiceUUID("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	testServiceNameB = service.ServiceName("test-service-b")
+	testServiceUuidB = service.ServiceUUID("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	testEnclaveUuid  = enclave.EnclaveUUID("test-enclave")
+)
+
+var (
+	testServiceIpA = net.ParseIP("10.0.0.1")
+	testServiceIpB = net.ParseIP("10.0.0.2")
+
+	testServiceIpv6A = net.ParseIP("fd00::2")
+)
+
+func TestAddAndGetAllServiceRegistrations(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceRegistrations, err := GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	registrationA := service.NewServiceRegistration(testServiceNameA, testServiceUuidA, testEnclaveUuid, testServiceIpA, string(testServiceNameA))
+	registrationB := service.NewServiceRegistration(testServiceNameB, testServiceUuidB, testEnclaveUuid, testServiceIpB, string(testServiceNameB))
+	require.Nil(t, serviceRegistrations.AddServiceRegistration(registrationA))
+	require.Nil(t, serviceRegistrations.AddServiceRegistration(registrationB))
+
+	result, err := serviceRegistrations.GetAllServiceRegistrations()
+	require.Nil(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, testServiceUuidA, result[testServiceNameA].GetUUID())
+	require.Equal(t, testEnclaveUuid, result[testServiceNameA].GetEnclaveID())
+	require.True(t, testServiceIpA.Equal(result[testServiceNameA].GetPrivateIP()))
+	require.Equal(t, string(testServiceNameA), result[testServiceNameA].GetHostname())
+	require.Equal(t, testServiceUuidB, result[testServiceNameB].GetUUID())
+	require.Nil(t, result[testServiceNameB].GetPrivateIPv6())
+}
+
+func TestAddAndGetAllServiceRegistrations_DualStack(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceRegistrations, err := GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	registrationA := service.NewDualStackServiceRegistration(testServiceNameA, testServiceUuidA, testEnclaveUuid, testServiceIpA, testServiceIpv6A, string(testServiceNameA))
+	require.Nil(t, serviceRegistrations.AddServiceRegistration(registrationA))
+
+	result, err := serviceRegistrations.GetAllServiceRegistrations()
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.True(t, testServiceIpA.Equal(result[testServiceNameA].GetPrivateIP()))
+	require.True(t, testServiceIpv6A.Equal(result[testServiceNameA].GetPrivateIPv6()))
+}
+
+func TestRemoveServiceRegistration(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceRegistrations, err := GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	registrationA := service.NewServiceRegistration(testServiceNameA, testServiceUuidA, testEnclaveUuid, testServiceIpA, string(testServiceNameA))
+	require.Nil(t, serviceRegistrations.AddServiceRegistration(registrationA))
+	require.Nil(t, serviceRegistrations.RemoveServiceRegistration(testServiceNameA))
+
+	result, err := serviceRegistrations.GetAllServiceRegistrations()
+	require.Nil(t, err)
+	require.Empty(t, result)
+}
+
+func TestGetOrCreateServiceRegistrationsBucket_KeepsExistingContent(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	serviceRegistrations, err := GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	require.Nil(t, err)
+
+	registrationA := service.NewServiceRegistration(testServiceNameA, testServiceUuidA, testEnclaveUuid, testServiceIpA, string(testServiceNameA))
+	require.Nil(t, serviceRegistrations.AddServiceRegistration(registrationA))
+
+	reopenedServiceRegistrations, err := GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	require.Nil(t, err)
+	result, err := reopenedServiceRegistrations.GetAllServiceRegistrations()
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, testServiceUuidA, result[testServiceNameA].GetUUID())
+}
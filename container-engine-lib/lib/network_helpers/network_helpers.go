@@ -1,14 +1,18 @@
 package network_helpers
 
 import (
-	"encoding/binary"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
 )
 
+const (
+	maxByteValue = 0xff
+)
+
 /*
 GetFreeIpAddrFromSubnet
-Gets a free IP address from the subnet that the IP tracker was initialized with.
+Gets a free IP address from the subnet that the IP tracker was initialized with. Both IPv4 and IPv6 subnets are
+supported.
 
 Returns:
 
@@ -16,38 +20,39 @@ Returns:
 		actual IP returned is undefined.
 */
 func GetFreeIpAddrFromSubnet(takenIps map[string]bool, subnet *net.IPNet) (net.IP, error) {
-	// NOTE: This whole function will need to be rewritten if we support IPv6
-
-	// convert IPNet struct mask and address to uint32
-	// network is BigEndian
-	mask := binary.BigEndian.Uint32(subnet.Mask)
-
-	subnetIp := subnet.IP
-
 	// The IP can be either 4 bytes or 16 bytes long; we need to handle both!
 	// See https://gist.github.com/ammario/649d4c0da650162efd404af23e25b86b
-	var intIp uint32
-	if len(subnetIp) == 16 {
-		intIp = binary.BigEndian.Uint32(subnetIp[12:16])
-	} else {
-		intIp = binary.BigEndian.Uint32(subnetIp)
+	subnetIp := subnet.IP.To4()
+	if subnetIp == nil || len(subnet.Mask) != net.IPv4len {
+		subnetIp = subnet.IP.To16()
+	}
+	if subnetIp == nil || len(subnetIp) != len(subnet.Mask) {
+		return nil, stacktrace.NewError("Subnet '%v' is neither an IPv4 nor an IPv6 subnet", subnet)
 	}
 
 	// We remove the zeroth IP because it's only used for specifying the network itself
-	start := intIp + 1
-
-	// find the final address
-	finish := (start & mask) | (mask ^ 0xffffffff)
-	// loop through addresses as uint32
-	for i := start; i <= finish; i++ {
-		// convert back to net.IP
-		ip := make(net.IP, 4)
-		binary.BigEndian.PutUint32(ip, i)
+	ip, hasNext := getNextIpAddr(subnetIp.Mask(subnet.Mask))
+	for hasNext && subnet.Contains(ip) {
 		ipStr := ip.String()
 		if !takenIps[ipStr] {
 			takenIps[ipStr] = true
 			return ip, nil
 		}
+		ip, hasNext = getNextIpAddr(ip)
 	}
 	return nil, stacktrace.NewError("Failed to allocate IpAddr on subnet %v - all taken.", subnet)
 }
+
+// getNextIpAddr returns the IP address following the given one, and false if the given one is the last IP address
+func getNextIpAddr(ip net.IP) (net.IP, bool) {
+	nextIp := make(net.IP, len(ip))
+	copy(nextIp, ip)
+	for idx := len(nextIp) - 1; idx >= 0; idx-- {
+		if nextIp[idx] < maxByteValue {
+			nextIp[idx]++
+			return nextIp, true
+		}
+		nextIp[idx] = 0
+	}
+	return nil, false
+}
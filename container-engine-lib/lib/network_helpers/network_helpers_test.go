@@ -0,0 +1,57 @@
+package network_helpers
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+func TestGetFreeIpAddrFromSubnet_IPv4(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("172.16.0.0/30")
+	require.Nil(t, err)
+	takenIps := map[string]bool{
+		"172.16.0.1": true,
+	}
+
+	ip, err := GetFreeIpAddrFromSubnet(takenIps, subnet)
+	require.Nil(t, err)
+	require.Equal(t, "172.16.0.2", ip.String())
+	require.True(t, takenIps["172.16.0.2"])
+
+	ip, err = GetFreeIpAddrFromSubnet(takenIps, subnet)
+	require.Nil(t, err)
+	require.Equal(t, "172.16.0.3", ip.String())
+
+	_, err = GetFreeIpAddrFromSubnet(takenIps, subnet)
+	require.NotNil(t, err)
+}
+
+func TestGetFreeIpAddrFromSubnet_IPv6(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("fd00:1:2:3::/64")
+	require.Nil(t, err)
+	takenIps := map[string]bool{
+		"fd00:1:2:3::1": true,
+	}
+
+	ip, err := GetFreeIpAddrFromSubnet(takenIps, subnet)
+	require.Nil(t, err)
+	require.Equal(t, "fd00:1:2:3::2", ip.String())
+}
+
+func TestGetFreeIpAddrFromSubnet_IPv6CarriesOver(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("fd00::/120")
+	require.Nil(t, err)
+	takenIps := map[string]bool{}
+	for _, ip := range []string{"fd00::1", "fd00::2"} {
+		takenIps[ip] = true
+	}
+
+	ip, err := GetFreeIpAddrFromSubnet(takenIps, subnet)
+	require.Nil(t, err)
+	require.Equal(t, "fd00::3", ip.String())
+
+	_, lastIp := getNextIpAddr(net.ParseIP("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"))
+	require.False(t, lastIp)
+	nextIp, _ := getNextIpAddr(net.ParseIP("fd00::ff"))
+	require.Equal(t, "fd00::100", nextIp.String())
+}
@@ -40,6 +40,8 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	isPartitioningEnabled bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
+	gitCredentialsPerGitHost map[string]args.GitCredentials,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 ) (
 	resultApiContainer *api_container.APIContainer,
@@ -55,6 +57,8 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		isPartitioningEnabled,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		cloneUrlPrefixPerCustomGitHost,
+		gitCredentialsPerGitHost,
 		backendConfigSupplier,
 	)
 	if err != nil {
@@ -73,6 +77,8 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	isPartitioningEnabled bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
+	gitCredentialsPerGitHost map[string]args.GitCredentials,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 ) (
 	resultApiContainer *api_container.APIContainer,
@@ -88,6 +94,7 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isPartitioningEnabled,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		cloneUrlPrefixPerCustomGitHost,
 		enclaveDataVolumeDirpath,
 		kurtosisBackendType,
 		kurtosisBackendConfig,
@@ -101,6 +108,11 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		return nil, stacktrace.Propagate(err, "An error occurred generating the API container's environment variables")
 	}
 
+	secretFiles, err := args.GetSecretFilesFromGitCredentials(gitCredentialsPerGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the API container's secret files")
+	}
+
 	containerImageAndTag := fmt.Sprintf(
 		"%v:%v",
 		containerImage,
@@ -117,6 +129,7 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		enclaveDataVolumeDirpath,
 		ownIpAddressEnvvar,
 		envVars,
+		secretFiles,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container")
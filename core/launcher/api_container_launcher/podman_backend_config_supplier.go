@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2022 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package api_container_launcher
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
+)
+
+type PodmanBackendConfigSupplier struct {
+	hostPodmanSocketFilepath string
+}
+
+func NewPodmanKurtosisBackendConfigSupplier(hostPodmanSocketFilepath string) PodmanBackendConfigSupplier {
+	return PodmanBackendConfigSupplier{
+		hostPodmanSocketFilepath: hostPodmanSocketFilepath,
+	}
+}
+
+func (backendConfigSupplier PodmanBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
+	podmanBackendConfig := kurtosis_backend_config.PodmanBackendConfig{
+		HostPodmanSocketFilepath: backendConfigSupplier.hostPodmanSocketFilepath,
+	}
+	return args.KurtosisBackendType_Podman, podmanBackendConfig
+}
@@ -36,6 +36,10 @@ type APIContainerArgs struct {
 	//User consent to send metrics
 	DidUserAcceptSendingMetrics bool `json:"didUserAcceptSendingMetrics"`
 
+	// The Git hosts other than GitHub that packages can be fetched from, mapped to the prefix of the URLs their
+	// repositories get cloned from
+	CloneUrlPrefixPerCustomGitHost map[string]string `json:"cloneUrlPrefixPerCustomGitHost"`
+
 	// The directory on the API container where the enclave data directory will have been mounted
 	EnclaveDataVolumeDirpath string `json:"enclaveDataVolume"`
 
@@ -69,6 +73,12 @@ func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
 			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", apiContainerArgsMirror.KurtosisBackendConfig, apiContainerArgsMirror.KurtosisBackendType.String())
 		}
 		apiContainerArgsMirror.KurtosisBackendConfig = kubernetesConfig
+	case KurtosisBackendType_Podman:
+		var podmanConfig kurtosis_backend_config.PodmanBackendConfig
+		if err := json.Unmarshal(byteArray, &podmanConfig); err != nil {
+			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", apiContainerArgsMirror.KurtosisBackendConfig, apiContainerArgsMirror.KurtosisBackendType.String())
+		}
+		apiContainerArgsMirror.KurtosisBackendConfig = podmanConfig
 	default:
 		return stacktrace.NewError("Unmarshalled an unrecognized Kurtosis backend type: '%v'", apiContainerArgsMirror.KurtosisBackendType.String())
 	}
@@ -88,22 +98,24 @@ func NewAPIContainerArgs(
 	isPartitioningEnabled bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	enclaveDataVolumeDirpath string,
 	kurtosisBackendType KurtosisBackendType,
 	kurtosisBackendConfig interface{},
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
-		Version:                     version,
-		LogLevel:                    logLevel,
-		GrpcListenPortNum:           grpcListenPortNum,
-		GrpcProxyListenPortNum:      grpcProxyListenPortNum,
-		EnclaveUUID:                 enclaveUuid,
-		IsPartitioningEnabled:       isPartitioningEnabled,
-		MetricsUserID:               metricsUserID,
-		DidUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		EnclaveDataVolumeDirpath:    enclaveDataVolumeDirpath,
-		KurtosisBackendType:         kurtosisBackendType,
-		KurtosisBackendConfig:       kurtosisBackendConfig,
+		Version:                        version,
+		LogLevel:                       logLevel,
+		GrpcListenPortNum:              grpcListenPortNum,
+		GrpcProxyListenPortNum:         grpcProxyListenPortNum,
+		EnclaveUUID:                    enclaveUuid,
+		IsPartitioningEnabled:          isPartitioningEnabled,
+		MetricsUserID:                  metricsUserID,
+		DidUserAcceptSendingMetrics:    didUserAcceptSendingMetrics,
+		CloneUrlPrefixPerCustomGitHost: cloneUrlPrefixPerCustomGitHost,
+		EnclaveDataVolumeDirpath:       enclaveDataVolumeDirpath,
+		KurtosisBackendType:            kurtosisBackendType,
+		KurtosisBackendConfig:          kurtosisBackendConfig,
 	}
 
 	if err := result.validate(); err != nil {
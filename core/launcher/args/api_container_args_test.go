@@ -7,6 +7,7 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -14,6 +15,7 @@ import (
 const (
 	kubernetesArgsJson = `{"version": "X.X.X", "grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "isPartitioningEnabled": false, "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
 	dockerArgsJson     = `{"version": "X.X.X", "grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "isPartitioningEnabled": false, "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
+	podmanArgsJson     = `{"version": "X.X.X", "grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "isPartitioningEnabled": false, "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"podman","kurtosisBackendConfig":{"hostPodmanSocketFilepath":"/run/podman/podman.sock"}}`
 )
 
 func TestArgsUnmarshalKubernetes(t *testing.T) {
@@ -29,3 +31,13 @@ func TestArgsUnmarshalDocker(t *testing.T) {
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
 }
+
+func TestArgsUnmarshalPodman(t *testing.T) {
+	paramsJsonBytes := []byte(podmanArgsJson)
+	var args APIContainerArgs
+	err := json.Unmarshal(paramsJsonBytes, &args)
+	require.NoError(t, err)
+	podmanConfig, ok := args.KurtosisBackendConfig.(kurtosis_backend_config.PodmanBackendConfig)
+	require.True(t, ok)
+	require.Equal(t, "/run/podman/podman.sock", podmanConfig.HostPodmanSocketFilepath)
+}
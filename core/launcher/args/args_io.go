@@ -2,7 +2,9 @@ package args
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/kurtosis-tech/stacktrace"
+	"io/fs"
 	"net"
 	"os"
 )
@@ -16,6 +18,10 @@ const (
 	// The KurtosisBackend can populate environment variables with own IP addrsses, and this is the environment
 	// variable that we'll ask the backend to use
 	ownIpAddressEnvVar = "OWN_IP_ADDRESS"
+
+	// The Git credentials are secrets, so rather than in the serialized args they get handed to the API container in a
+	// file that the backend only lets the API container read
+	gitCredentialsFilepath = "/run/secrets/kurtosis/git-credentials.json"
 )
 
 // Intended to be used when starting the container - gets the environment variables that the container should be started with
@@ -65,4 +71,36 @@ func GetArgsFromEnv() (*APIContainerArgs, net.IP, error) {
 	}
 
 	return &args, ownIpAddr, nil
-}
\ No newline at end of file
+}
+
+// Intended to be used when starting the container - gets the secret files, keyed by their filepath on the container,
+// that the container should be started with
+func GetSecretFilesFromGitCredentials(gitCredentialsPerGitHost map[string]GitCredentials) (map[string][]byte, error) {
+	if len(gitCredentialsPerGitHost) == 0 {
+		return map[string][]byte{}, nil
+	}
+	gitCredentialsBytes, err := json.Marshal(gitCredentialsPerGitHost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the Git credentials to JSON")
+	}
+	return map[string][]byte{
+		gitCredentialsFilepath: gitCredentialsBytes,
+	}, nil
+}
+
+// Intended to be used in the container main.go function - gets the Git credentials from the secret file, which is
+// missing when the enclave was created without any
+func GetGitCredentialsFromSecretFile() (map[string]GitCredentials, error) {
+	gitCredentialsBytes, err := os.ReadFile(gitCredentialsFilepath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]GitCredentials{}, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the Git credentials file '%v'", gitCredentialsFilepath)
+	}
+	gitCredentialsPerGitHost := map[string]GitCredentials{}
+	if err := json.Unmarshal(gitCredentialsBytes, &gitCredentialsPerGitHost); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the Git credentials of file '%v'", gitCredentialsFilepath)
+	}
+	return gitCredentialsPerGitHost, nil
+}
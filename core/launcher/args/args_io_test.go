@@ -0,0 +1,31 @@
+package args
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetSecretFilesFromGitCredentials(t *testing.T) {
+	gitCredentialsPerGitHost := map[string]GitCredentials{
+		"github.com": {
+			Username:      "user",
+			Token:         "token",
+			SshPrivateKey: "",
+			SshKnownHosts: "",
+		},
+	}
+	secretFiles, err := GetSecretFilesFromGitCredentials(gitCredentialsPerGitHost)
+	require.NoError(t, err)
+	require.Len(t, secretFiles, 1)
+
+	deserializedGitCredentialsPerGitHost := map[string]GitCredentials{}
+	require.NoError(t, json.Unmarshal(secretFiles[gitCredentialsFilepath], &deserializedGitCredentialsPerGitHost))
+	require.Equal(t, gitCredentialsPerGitHost, deserializedGitCredentialsPerGitHost)
+}
+
+func TestGetSecretFilesFromGitCredentials_NoFileWithoutCredentials(t *testing.T) {
+	secretFiles, err := GetSecretFilesFromGitCredentials(map[string]GitCredentials{})
+	require.NoError(t, err)
+	require.Empty(t, secretFiles)
+}
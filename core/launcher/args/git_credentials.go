@@ -0,0 +1,14 @@
+package args
+
+// GitCredentials are the credentials the private repositories of a Git host get cloned with, either a token sent over
+// HTTPS or an SSH private key. Fields are public for JSON de/serialization
+type GitCredentials struct {
+	Username string `json:"username"`
+
+	// Set when cloning over HTTPS
+	Token string `json:"token"`
+
+	// Set when cloning over SSH, along with the known hosts the key of the Git server gets verified against
+	SshPrivateKey string `json:"sshPrivateKey"`
+	SshKnownHosts string `json:"sshKnownHosts"`
+}
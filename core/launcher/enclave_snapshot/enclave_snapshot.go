@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2023 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package enclave_snapshot
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// An enclave snapshot is a gzipped TAR archive containing:
+//   - the manifest, describing the snapshotted enclave
+//   - the enclave database of the API container
+//   - the directory of the files artifact store of the enclave
+//   - a TAR archive of the images the filesystems of the user services were committed to, as produced by 'docker save'
+const (
+	ManifestFilename = "manifest.json"
+
+	EnclaveDbFilename = "enclave.db"
+
+	FilesArtifactStoreDirname = "artifact-store"
+
+	ServiceImagesFilename = "service-images.tar"
+
+	// When restoring a snapshot, the manifest, the enclave database and the files artifact store directory are
+	// copied to this directory INSIDE THE ENCLAVE DATA DIR of the new enclave. The API container restores them when
+	// it starts, and removes the directory afterwards
+	SnapshotToRestoreDirname = "snapshot-to-restore"
+
+	// The API container opens its enclave database in its working directory
+	EnclaveDbFilepathOnApiContainer = "/run/" + EnclaveDbFilename
+
+	// This has to match the directory the enclave data volume is mounted at on the API container
+	EnclaveDataDirpathOnApiContainer = "/kurtosis-data"
+
+	// This has to match the directory the API container stores the files artifacts in, inside the enclave data dir
+	FilesArtifactStoreDirpathOnApiContainer = EnclaveDataDirpathOnApiContainer + "/" + FilesArtifactStoreDirname
+)
+
+type Manifest struct {
+	// The version of Kurtosis the snapshot was created with
+	KurtosisVersion string `json:"kurtosisVersion"`
+
+	EnclaveName string `json:"enclaveName"`
+
+	// Whether the snapshotted enclave had subnetwork capabilities, which the restored enclave gets as well so that the
+	// partitions of the snapshot can be restored
+	IsPartitioningEnabled bool `json:"isPartitioningEnabled"`
+
+	// The images the filesystems of the user services were committed to, by service name
+	ServiceImages map[string]string `json:"serviceImages"`
+}
+
+func NewManifest(kurtosisVersion string, enclaveName string, isPartitioningEnabled bool, serviceImages map[string]string) *Manifest {
+	return &Manifest{
+		KurtosisVersion:       kurtosisVersion,
+		EnclaveName:           enclaveName,
+		IsPartitioningEnabled: isPartitioningEnabled,
+		ServiceImages:         serviceImages,
+	}
+}
+
+func (manifest *Manifest) Serialize() ([]byte, error) {
+	serializedManifest, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the enclave snapshot manifest '%+v'", manifest)
+	}
+	return serializedManifest, nil
+}
+
+func DeserializeManifest(serializedManifest []byte) (*Manifest, error) {
+	manifest := new(Manifest)
+	if err := json.Unmarshal(serializedManifest, manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave snapshot manifest")
+	}
+	return manifest, nil
+}
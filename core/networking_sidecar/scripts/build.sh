@@ -0,0 +1,33 @@
+#
+# Copyright (c) 2023 - present Kurtosis Technologies Inc.
+# All Rights Reserved.
+#
+
+set -euo pipefail   # Bash "strict mode"
+script_dirpath="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+sidecar_root_dirpath="$(dirname "${script_dirpath}")"
+# ==================================================================================================
+#                                             Constants
+# ==================================================================================================
+source "${script_dirpath}/_constants.env"
+
+# =============================================================================
+#                                 Main Code
+# =============================================================================
+# Checks if dockerignore file is in the root path
+if ! [ -f "${sidecar_root_dirpath}"/.dockerignore ]; then
+  echo "Error: No .dockerignore file found in networking sidecar root '${sidecar_root_dirpath}'; this is required so Docker caching is enabled and the image builds remain quick" >&2
+  exit 1
+fi
+
+# Build Docker image
+# Unlike the other images, it is tagged with its pinned version rather than the commit, as the backends reference
+# that version
+dockerfile_filepath="${sidecar_root_dirpath}/Dockerfile"
+image_name="${IMAGE_ORG_AND_REPO}:${IMAGE_VERSION}"
+echo "Building networking sidecar into a Docker image named '${image_name}'..."
+if ! docker build -t "${image_name}" -f "${dockerfile_filepath}" "${sidecar_root_dirpath}"; then
+  echo "Error: Docker build of the networking sidecar failed" >&2
+  exit 1
+fi
+echo "Successfully built Docker image '${image_name}' containing the networking sidecar"
@@ -14,6 +14,7 @@ BUILD_SCRIPT_RELATIVE_FILEPATHS=(
     "launcher/scripts/build.sh"
     "server/scripts/build.sh"
     "files_artifacts_expander/scripts/build.sh"
+    "networking_sidecar/scripts/build.sh"
 )
 
 
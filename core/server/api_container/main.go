@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	kubernetes_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/backend_creator"
+	podman_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/podman/podman_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
@@ -18,8 +20,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/snapshot_restorer"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_journal"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
@@ -110,7 +115,11 @@ func runMain() error {
 		return stacktrace.NewError("Kurtosis backend type is '%v' but cluster configuration parameters are null.", args.KurtosisBackendType_Kubernetes.String())
 	}
 
-	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider()
+	gitCredentialsArgsPerGitHost, err := args.GetGitCredentialsFromSecretFile()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git credentials")
+	}
+	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider(serverArgs.CloneUrlPrefixPerCustomGitHost, getGitCredentialsPerGitHost(gitCredentialsArgsPerGitHost))
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while creating the Git module content provider")
 	}
@@ -144,37 +153,59 @@ func runMain() error {
 				args.KurtosisBackendType_Kubernetes.String(),
 			)
 		}
-		pluginPath := backend_interface.GetPluginPathForApiContainer(backend_interface.KubernetesPluginName)
-		plugin, err := backend_interface.OpenBackendPlugin(pluginPath)
+		kurtosisBackend, err = kubernetes_backend_creator.GetApiContainerBackend(ctx)
 		if err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred loading a Kurtosis Kubernetes backend plugin on path '%s'",
-				pluginPath,
+			return stacktrace.Propagate(err, "An error occurred getting Kubernetes Kurtosis backend")
+		}
+	case args.KurtosisBackendType_Podman:
+		podmanBackendConfig, ok := (clusterConfig).(kurtosis_backend_config.PodmanBackendConfig)
+		if !ok {
+			return stacktrace.NewError(
+				"Failed to cast untyped cluster configuration object '%+v' to the appropriate type, even though "+
+					"Kurtosis backend type is '%v'",
+				clusterConfig,
+				args.KurtosisBackendType_Podman.String(),
 			)
 		}
-		kurtosisBackend, err = plugin.GetApiContainerBackend(ctx)
+		apiContainerModeArgs := &backend_creator.APIContainerModeArgs{
+			Context:        ctx,
+			EnclaveID:      enclave.EnclaveUUID(serverArgs.EnclaveUUID),
+			APIContainerIP: ownIpAddress,
+		}
+		kurtosisBackend, err = podman_backend_creator.GetInContainerPodmanKurtosisBackend(ctx, podmanBackendConfig.HostPodmanSocketFilepath, apiContainerModeArgs)
 		if err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred casting a Kurtosis Kubernetes backend loaded from plugin on path '%s'",
-				pluginPath,
-			)
+			return stacktrace.Propagate(err, "An error occurred getting Podman Kurtosis backend using host socket '%v'", podmanBackendConfig.HostPodmanSocketFilepath)
 		}
 	default:
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
+	serviceNetwork, err := createServiceNetwork(ctx, kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
 
+	if err = snapshot_restorer.RestoreSnapshotIfPresent(ctx, serverArgs.EnclaveDataVolumeDirpath, serviceNetwork, filesArtifactStore); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot copied to the enclave data directory")
+	}
+
+	runtimeValueStore, err := runtime_value_store.NewPersistedRuntimeValueStore(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the runtime value store")
+	}
+
+	executionJournal, err := execution_journal.NewPersistedExecutionJournal(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the execution journal")
+	}
+
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
 	startosisRunner := startosis_engine.NewStartosisRunner(
-		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtime_value_store.NewRuntimeValueStore()),
+		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore),
+		startosis_engine.NewStartosisApplyPlanner(serviceNetwork),
 		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor())
+		startosis_engine.NewStartosisExecutor(executionJournal),
+		runtimeValueStore)
 
 	//Creation of ApiContainerService
 	apiContainerService, err := server.NewApiContainerService(
@@ -207,16 +238,19 @@ func runMain() error {
 }
 
 func createServiceNetwork(
+	ctx context.Context,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
-	args *args.APIContainerArgs,
+	serverArgs *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
 ) (service_network.ServiceNetwork, error) {
-	enclaveIdStr := args.EnclaveUUID
+	enclaveIdStr := serverArgs.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
 
-	isPartitioningEnabled := args.IsPartitioningEnabled
+	isPartitioningEnabled := serverArgs.IsPartitioningEnabled
+	// Only the Docker backend can block the traffic between services with the firewall of the machine running them
+	canBlockTrafficWithoutSidecars := serverArgs.KurtosisBackendType == args.KurtosisBackendType_Docker
 
 	networkingSidecarManager := networking_sidecar.NewStandardNetworkingSidecarManager(
 		kurtosisBackend,
@@ -225,9 +259,10 @@ func createServiceNetwork(
 	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
 		enclaveUuid,
 		ownIpAddress,
-		args.GrpcListenPortNum,
-		args.Version,
+		serverArgs.GrpcListenPortNum,
+		serverArgs.Version,
 		isPartitioningEnabled,
+		canBlockTrafficWithoutSidecars,
 		kurtosisBackend,
 		enclaveDataDir,
 		networkingSidecarManager,
@@ -237,9 +272,27 @@ func createServiceNetwork(
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the default service network")
 	}
+
+	// The enclave might have been running before this API container started, in which case its services are
+	// loaded from the enclave database and need to be checked against what's actually running
+	if err = serviceNetwork.ReconcileWithBackend(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reconciling the persisted enclave state with the backend")
+	}
 	return serviceNetwork, nil
 }
 
+func getGitCredentialsPerGitHost(gitCredentialsArgsPerGitHost map[string]args.GitCredentials) map[string]*git_package_content_provider.GitCredentials {
+	gitCredentialsPerGitHost := map[string]*git_package_content_provider.GitCredentials{}
+	for gitHost, gitCredentialsArgs := range gitCredentialsArgsPerGitHost {
+		if gitCredentialsArgs.SshPrivateKey != "" {
+			gitCredentialsPerGitHost[gitHost] = git_package_content_provider.NewSshGitCredentials(gitCredentialsArgs.Username, gitCredentialsArgs.SshPrivateKey, gitCredentialsArgs.SshKnownHosts)
+		} else {
+			gitCredentialsPerGitHost[gitHost] = git_package_content_provider.NewTokenGitCredentials(gitCredentialsArgs.Username, gitCredentialsArgs.Token)
+		}
+	}
+	return gitCredentialsPerGitHost
+}
+
 func formatFilenameFunctionForLogs(filename string, functionName string) string {
 	var output strings.Builder
 	output.WriteString("[")
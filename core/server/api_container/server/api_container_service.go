@@ -7,9 +7,9 @@ package server
 
 import (
 	"bufio"
-	"bytes"
 	"context"
 	"fmt"
+	"github.com/cenkalti/backoff/v4"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
@@ -18,21 +18,30 @@ import (
 	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 )
@@ -47,15 +56,39 @@ const (
 	// The string returned by the API if a service's public IP address doesn't exist
 	missingPublicIpAddrStr = ""
 
+	// The string returned by the API if a service doesn't have an IPv6 address
+	missingPrivateIpv6AddrStr = ""
+
 	defaultStartosisDryRun = false
 
+	// By default all instructions are executed. Standalone scripts always run all their instructions
+	defaultStartosisResume = false
+
+	// By default packages are run as scripts, executing all their instructions. Standalone scripts are never applied
+	defaultStartosisApply                    = false
+	defaultStartosisRemoveUndeclaredServices = false
+
 	// Overwrite existing module with new module, this allows user to iterate on an enclave with a
 	// given module
 	doOverwriteExistingModule = true
 
 	defaultParallelism = 4
+
+	// Endpoint availability is checked by running an HTTP request recipe until it returns a 200, just like ready
+	// conditions of services do
+	endpointAvailabilityRequestContentType = "application/json"
+	equalsAssertionToken                   = "=="
+	// the number of retries is what bounds the wait for an endpoint to become available
+	noEndpointAvailabilityTimeout = time.Duration(math.MaxInt64)
+
+	// Captures stored as files artifacts get compressed in memory, so captures are kept reasonably short
+	maxTrafficCaptureDuration = 10 * time.Minute
+	// Keeps each message well under the default gRPC max message size
+	trafficCaptureChunkSizeBytes = 1024 * 1024
 )
 
+var noRecipeExtractors = map[string]string{}
+
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
 var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_bindings.Port_TransportProtocol]port_spec.TransportProtocol{
 	kurtosis_core_rpc_api_bindings.Port_TCP:  port_spec.TransportProtocol_TCP,
@@ -63,6 +96,14 @@ var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_binding
 	kurtosis_core_rpc_api_bindings.Port_UDP:  port_spec.TransportProtocol_UDP,
 }
 
+// Guaranteed (by a unit test) to be a 1:1 mapping between connection change event types and API event types
+var connectionChangeEventTypeToApiEventType = map[connection_change_scheduler.ConnectionChangeEventType]kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_EventType{
+	connection_change_scheduler.StepApplied: kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_STEP_APPLIED,
+	connection_change_scheduler.StepFailed:  kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_STEP_FAILED,
+	connection_change_scheduler.Completed:   kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_COMPLETED,
+	connection_change_scheduler.Cancelled:   kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_CANCELLED,
+}
+
 type ApiContainerService struct {
 	filesArtifactStore *enclave_data_directory.FilesArtifactStore
 
@@ -95,7 +136,7 @@ func (apicService ApiContainerService) RunStarlarkScript(args *kurtosis_core_rpc
 	parallelism := int(args.GetParallelism())
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
 
-	apicService.runStarlark(parallelism, dryRun, startosis_constants.PackageIdPlaceholderForStandaloneScript, serializedStarlarkScript, serializedParams, stream)
+	apicService.runStarlark(parallelism, dryRun, defaultStartosisResume, defaultStartosisApply, defaultStartosisRemoveUndeclaredServices, startosis_constants.PackageIdPlaceholderForStandaloneScript, serializedStarlarkScript, serializedParams, stream)
 	return nil
 }
 
@@ -106,6 +147,15 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 	parallelism := int(args.GetParallelism())
 	serializedParams := args.SerializedParams
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	resume := shared_utils.GetOrDefaultBool(args.Resume, defaultStartosisResume)
+	apply := shared_utils.GetOrDefaultBool(args.Apply, defaultStartosisApply)
+	removeUndeclaredServices := shared_utils.GetOrDefaultBool(args.RemoveUndeclaredServices, defaultStartosisRemoveUndeclaredServices)
+	if resume && apply {
+		return stacktrace.NewError("Package '%s' cannot be both resumed and applied to the enclave. Apply mode already skips the instructions the enclave is up-to-date with", packageId)
+	}
+	if removeUndeclaredServices && !apply {
+		return stacktrace.NewError("Undeclared services can only be removed when package '%s' is applied to the enclave", packageId)
+	}
 
 	scriptWithRunFunction, interpretationError := apicService.runStarlarkPackageSetup(packageId, isRemote, moduleContentIfLocal)
 	if interpretationError != nil {
@@ -114,10 +164,23 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 		}
 		return nil
 	}
-	apicService.runStarlark(parallelism, dryRun, packageId, scriptWithRunFunction, serializedParams, stream)
+	apicService.runStarlark(parallelism, dryRun, resume, apply, removeUndeclaredServices, packageId, scriptWithRunFunction, serializedParams, stream)
 	return nil
 }
 
+func (apicService ApiContainerService) RunStarlarkPackageTests(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse, error) {
+	packageId := args.GetPackageId()
+	packageRootPathOnDisk, interpretationError := apicService.storeStarlarkPackage(packageId, args.GetRemote(), args.GetLocal())
+	if interpretationError != nil {
+		return nil, stacktrace.Propagate(interpretationError, "An error occurred getting the content of package '%v'", packageId)
+	}
+	testResults, err := apicService.startosisRunner.RunTests(ctx, packageId, packageRootPathOnDisk)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of package '%v'", packageId)
+	}
+	return binding_constructors.NewRunStarlarkPackageTestsResponse(testResults), nil
+}
+
 func (apicService ApiContainerService) StartServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StartServicesArgs) (*kurtosis_core_rpc_api_bindings.StartServicesResponse, error) {
 	failedServicesPool := map[kurtosis_backend_service.ServiceName]error{}
 	serviceNamesToAPIConfigs := map[kurtosis_backend_service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
@@ -163,7 +226,7 @@ func (apicService ApiContainerService) StartServices(ctx context.Context, args *
 			publicIpAddrStr = maybePublicIpAddr.String()
 		}
 
-		serviceNamesToServiceInfo[string(serviceName)] = binding_constructors.NewServiceInfo(serviceUuidStr, string(serviceName), uuid_generator.ShortenedUUIDString(serviceUuidStr), privateServiceIpStr, privateApiPorts, publicIpAddrStr, publicApiPorts)
+		serviceNamesToServiceInfo[string(serviceName)] = binding_constructors.NewServiceInfo(serviceUuidStr, string(serviceName), uuid_generator.ShortenedUUIDString(serviceUuidStr), privateServiceIpStr, getPrivateIpv6AddrStr(serviceRegistration), privateApiPorts, publicIpAddrStr, publicApiPorts, serviceNamesToAPIConfigs[serviceName].GetHostnames())
 		serviceStartLoglineSuffix := ""
 		if len(publicServicePortSpecs) > 0 {
 			serviceStartLoglineSuffix = fmt.Sprintf(
@@ -239,6 +302,44 @@ func (apicService ApiContainerService) Repartition(ctx context.Context, args *ku
 	return &emptypb.Empty{}, nil
 }
 
+func (apicService ApiContainerService) CancelConnectionChanges(_ context.Context, args *kurtosis_core_rpc_api_bindings.CancelConnectionChangesArgs) (*emptypb.Empty, error) {
+	if err := apicService.serviceNetwork.CancelConnectionChanges(args.Name); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cancelling connection changes '%s'", args.Name)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) WatchConnectionChanges(args *kurtosis_core_rpc_api_bindings.WatchConnectionChangesArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_WatchConnectionChangesServer) error {
+	eventsChan, err := apicService.serviceNetwork.WatchConnectionChanges(args.Name)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred watching connection changes '%s'", args.Name)
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			logrus.Debugf("The client stopped watching connection changes '%s'", args.Name)
+			return nil
+		case event, isChanOpen := <-eventsChan:
+			if !isChanOpen {
+				return nil
+			}
+			apiEventType, found := connectionChangeEventTypeToApiEventType[event.GetEventType()]
+			if !found {
+				return stacktrace.NewError("No API event type is defined for connection change event type '%s'; this is a bug in Kurtosis", event.GetEventType())
+			}
+			apiEvent := binding_constructors.NewConnectionChangeEvent(
+				apiEventType,
+				uint32(event.GetStepIndex()),
+				timestamppb.New(event.GetTimestamp()),
+				event.GetErrorMessage(),
+			)
+			if err = stream.Send(apiEvent); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending connection change event '%+v'", apiEvent)
+			}
+		}
+	}
+}
+
 func (service ApiContainerService) PauseService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.PauseServiceArgs) (*emptypb.Empty, error) {
 	serviceIdentifier := args.ServiceIdentifier
 	err := service.serviceNetwork.PauseService(ctx, serviceIdentifier)
@@ -448,11 +549,90 @@ func (apicService ApiContainerService) RenderTemplatesToFilesArtifact(ctx contex
 	return response, nil
 }
 
+func (apicService ApiContainerService) CaptureServiceTraffic(args *kurtosis_core_rpc_api_bindings.CaptureServiceTrafficArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_CaptureServiceTrafficServer) error {
+	serviceIdentifier := args.GetServiceIdentifier()
+	duration, err := getTrafficCaptureDuration(args.GetDurationSeconds())
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid traffic capture duration")
+	}
+
+	// The packets are sent to the client as they get captured
+	output := &trafficCaptureStreamWriter{stream: stream}
+	if err = apicService.serviceNetwork.CaptureServiceTraffic(stream.Context(), serviceIdentifier, duration, args.GetFilter(), output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred capturing the traffic of service with identifier '%v'", serviceIdentifier)
+	}
+	return nil
+}
+
+func (apicService ApiContainerService) StoreServiceTrafficCapture(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureArgs) (*kurtosis_core_rpc_api_bindings.StoreServiceTrafficCaptureResponse, error) {
+	serviceIdentifier := args.GetServiceIdentifier()
+	duration, err := getTrafficCaptureDuration(args.GetDurationSeconds())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid traffic capture duration")
+	}
+
+	artifactName := args.GetName()
+	if artifactName == "" {
+		artifactName, err = apicService.serviceNetwork.GetUniqueNameForFileArtifact()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred generating a name for the files artifact the traffic capture will be stored in")
+		}
+	}
+
+	filesArtifactUuid, err := apicService.serviceNetwork.StoreServiceTrafficCapture(ctx, serviceIdentifier, duration, args.GetFilter(), artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred storing the traffic capture of service with identifier '%v'", serviceIdentifier)
+	}
+	return binding_constructors.NewStoreServiceTrafficCaptureResponse(string(filesArtifactUuid), artifactName), nil
+}
+
+func (apicService ApiContainerService) GetTrafficStatistics(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetTrafficStatisticsArgs) (*kurtosis_core_rpc_api_bindings.GetTrafficStatisticsResponse, error) {
+	serviceIdentifier := args.GetServiceIdentifier()
+	trafficStatistics, err := apicService.serviceNetwork.GetTrafficStatistics(ctx, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the traffic statistics for service identifier '%v'", serviceIdentifier)
+	}
+	return binding_constructors.NewGetTrafficStatisticsResponse(transformTrafficStatisticsToApiLinkTrafficStatistics(trafficStatistics)), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+func transformTrafficStatisticsToApiLinkTrafficStatistics(trafficStatistics map[kurtosis_backend_service.ServiceName]map[kurtosis_backend_service.ServiceName]*networking_sidecar.TrafficStatistics) []*kurtosis_core_rpc_api_bindings.LinkTrafficStatistics {
+	result := []*kurtosis_core_rpc_api_bindings.LinkTrafficStatistics{}
+	for sourceServiceName, trafficStatisticsPerDestination := range trafficStatistics {
+		for destinationServiceName, linkTrafficStatistics := range trafficStatisticsPerDestination {
+			result = append(result, binding_constructors.NewLinkTrafficStatistics(
+				string(sourceServiceName),
+				string(destinationServiceName),
+				linkTrafficStatistics.SentBytes,
+				linkTrafficStatistics.SentPackets,
+				linkTrafficStatistics.DroppedPackets,
+			))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GetSourceServiceName() != result[j].GetSourceServiceName() {
+			return result[i].GetSourceServiceName() < result[j].GetSourceServiceName()
+		}
+		return result[i].GetDestinationServiceName() < result[j].GetDestinationServiceName()
+	})
+	return result
+}
+
+func getTrafficCaptureDuration(durationSeconds uint32) (time.Duration, error) {
+	duration := time.Duration(durationSeconds) * time.Second
+	if duration <= 0 {
+		return 0, stacktrace.NewError("The traffic capture duration must be at least one second")
+	}
+	if duration > maxTrafficCaptureDuration {
+		return 0, stacktrace.NewError("The traffic capture duration can't exceed '%v', got '%v'", maxTrafficCaptureDuration, duration)
+	}
+	return duration, nil
+}
+
 func transformPortSpecToApiPort(port *port_spec.PortSpec) (*kurtosis_core_rpc_api_bindings.Port, error) {
 	portNumUint16 := port.GetNumber()
 	portSpecProto := port.GetTransportProtocol()
@@ -504,11 +684,6 @@ func (apicService ApiContainerService) waitForEndpointAvailability(
 	requestBody string,
 	bodyText string) error {
 
-	var (
-		resp *http.Response
-		err  error
-	)
-
 	serviceObj, err := apicService.serviceNetwork.GetService(
 		ctx,
 		serviceIdStr,
@@ -519,73 +694,79 @@ func (apicService ApiContainerService) waitForEndpointAvailability(
 	if serviceObj.GetStatus() != container_status.ContainerStatus_Running {
 		return stacktrace.NewError("Service '%v' isn't running so can never become available", serviceIdStr)
 	}
-	privateIp := serviceObj.GetRegistration().GetPrivateIP()
+	serviceName := serviceObj.GetRegistration().GetName()
 
-	url := fmt.Sprintf("http://%v:%v/%v", privateIp.String(), port, path)
-
-	time.Sleep(time.Duration(initialDelayMilliseconds) * time.Millisecond)
+	portId, found := getPortIdForPortNumber(serviceObj.GetPrivatePorts(), port)
+	if !found {
+		return stacktrace.NewError("Service '%v' has no private port with number '%v'", serviceIdStr, port)
+	}
 
-	for i := uint32(0); i < retries; i++ {
-		resp, err = makeHttpRequest(httpMethod, url, requestBody)
-		if err == nil {
-			break
-		}
-		time.Sleep(time.Duration(retriesDelayMilliseconds) * time.Millisecond)
+	endpoint := "/" + path
+	var httpRecipe *recipe.HttpRequestRecipe
+	switch httpMethod {
+	case http.MethodGet:
+		httpRecipe = recipe.NewGetHttpRequestRecipe(serviceName, portId, endpoint, noRecipeExtractors)
+	case http.MethodPost:
+		httpRecipe = recipe.NewPostHttpRequestRecipe(serviceName, portId, endpointAvailabilityRequestContentType, endpoint, requestBody, noRecipeExtractors)
+	default:
+		return stacktrace.NewError("HTTP method '%v' not allowed", httpMethod)
 	}
 
+	time.Sleep(time.Duration(initialDelayMilliseconds) * time.Millisecond)
+
+	retriesBackoff := backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Duration(retriesDelayMilliseconds)*time.Millisecond), uint64(retries))
+	lastResult, _, err := wait.ExecuteServiceAssertionWithRecipe(
+		ctx,
+		apicService.serviceNetwork,
+		runtime_value_store.NewRuntimeValueStore(),
+		serviceName,
+		httpRecipe,
+		recipe.StatusCodeResultKey,
+		equalsAssertionToken,
+		starlark.MakeInt(http.StatusOK),
+		retriesBackoff,
+		noEndpointAvailabilityTimeout,
+	)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
-			"The HTTP endpoint '%v' didn't return a success code, even after %v retries with %v milliseconds in between retries",
-			url,
+			"The HTTP endpoint '%v' of service '%v' didn't return a success code, even after %v retries with %v milliseconds in between retries",
+			endpoint,
+			serviceIdStr,
 			retries,
 			retriesDelayMilliseconds,
 		)
 	}
 
 	if bodyText != "" {
-		body := resp.Body
-		defer body.Close()
-
-		bodyBytes, err := ioutil.ReadAll(body)
-
-		if err != nil {
-			return stacktrace.Propagate(err,
-				"An error occurred reading the response body from endpoint '%v'", url)
+		bodyStr, ok := lastResult[recipe.BodyResultKey].(starlark.String)
+		if !ok {
+			return stacktrace.NewError("Expected the result of the request to endpoint '%v' to contain a body but it had none. This is a Kurtosis internal bug", endpoint)
 		}
-
-		bodyStr := string(bodyBytes)
-
-		if bodyStr != bodyText {
-			return stacktrace.NewError("Expected response body text '%v' from endpoint '%v' but got '%v' instead", bodyText, url, bodyStr)
+		if bodyStr.GoString() != bodyText {
+			return stacktrace.NewError("Expected response body text '%v' from endpoint '%v' but got '%v' instead", bodyText, endpoint, bodyStr.GoString())
 		}
 	}
 
 	return nil
 }
 
-func makeHttpRequest(httpMethod string, url string, body string) (*http.Response, error) {
-	var (
-		resp *http.Response
-		err  error
-	)
-
-	if httpMethod == http.MethodPost {
-		var bodyByte = []byte(body)
-		resp, err = http.Post(url, "application/json", bytes.NewBuffer(bodyByte))
-	} else if httpMethod == http.MethodGet {
-		resp, err = http.Get(url)
-	} else {
-		return nil, stacktrace.NewError("HTTP method '%v' not allowed", httpMethod)
+// getPrivateIpv6AddrStr returns an empty string for the services which aren't in an IPv6 enclave
+func getPrivateIpv6AddrStr(serviceRegistration *kurtosis_backend_service.ServiceRegistration) string {
+	privateIpv6 := serviceRegistration.GetPrivateIPv6()
+	if privateIpv6 == nil {
+		return missingPrivateIpv6AddrStr
 	}
+	return privateIpv6.String()
+}
 
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An HTTP error occurred when sending GET request to endpoint '%v'", url)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, stacktrace.NewError("Received non-OK status code: '%v'", resp.StatusCode)
+func getPortIdForPortNumber(ports map[string]*port_spec.PortSpec, portNumber uint32) (string, bool) {
+	for portId, portSpec := range ports {
+		if uint32(portSpec.GetNumber()) == portNumber {
+			return portId, true
+		}
 	}
-	return resp, nil
+	return "", false
 }
 
 func (apicService ApiContainerService) getServiceInfo(ctx context.Context, serviceIdentifier string) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
@@ -602,6 +783,8 @@ func (apicService ApiContainerService) getServiceInfo(ctx context.Context, servi
 	maybePublicPorts := serviceObj.GetMaybePublicPorts()
 	serviceUuidStr := string(serviceObj.GetRegistration().GetUUID())
 	serviceNameStr := string(serviceObj.GetRegistration().GetName())
+	// The service config is missing for the services Kurtosis didn't start, which have no additional hostname
+	serviceConfig, _ := apicService.serviceNetwork.GetServiceConfig(serviceObj.GetRegistration().GetName())
 
 	privateApiPorts, err := transformPortSpecMapToApiPortsMap(privatePorts)
 	if err != nil {
@@ -624,21 +807,17 @@ func (apicService ApiContainerService) getServiceInfo(ctx context.Context, servi
 		serviceNameStr,
 		uuid_generator.ShortenedUUIDString(serviceUuidStr),
 		privateIp.String(),
+		getPrivateIpv6AddrStr(serviceObj.GetRegistration()),
 		privateApiPorts,
 		publicIpAddrStr,
 		publicApiPorts,
+		serviceConfig.GetHostnames(),
 	)
 	return serviceInfoResponse, nil
 }
 
 func (apicService ApiContainerService) runStarlarkPackageSetup(packageId string, isRemote bool, moduleContentIfLocal []byte) (string, *startosis_errors.InterpretationError) {
-	var packageRootPathOnDisk string
-	var interpretationError *startosis_errors.InterpretationError
-	if isRemote {
-		packageRootPathOnDisk, interpretationError = apicService.startosisModuleContentProvider.ClonePackage(packageId)
-	} else {
-		packageRootPathOnDisk, interpretationError = apicService.startosisModuleContentProvider.StorePackageContents(packageId, moduleContentIfLocal, doOverwriteExistingModule)
-	}
+	packageRootPathOnDisk, interpretationError := apicService.storeStarlarkPackage(packageId, isRemote, moduleContentIfLocal)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -656,8 +835,17 @@ func (apicService ApiContainerService) runStarlarkPackageSetup(packageId string,
 	return string(mainScriptToExecute), nil
 }
 
-func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool, packageId string, serializedStarlark string, serializedParams string, stream grpc.ServerStream) {
-	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, serializedStarlark, serializedParams)
+// storeStarlarkPackage clones the package if it's remote, or writes the uploaded content of the local package, and
+// returns its root path on disk
+func (apicService ApiContainerService) storeStarlarkPackage(packageId string, isRemote bool, moduleContentIfLocal []byte) (string, *startosis_errors.InterpretationError) {
+	if isRemote {
+		return apicService.startosisModuleContentProvider.ClonePackage(packageId)
+	}
+	return apicService.startosisModuleContentProvider.StorePackageContents(packageId, moduleContentIfLocal, doOverwriteExistingModule)
+}
+
+func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool, resume bool, apply bool, removeUndeclaredServices bool, packageId string, serializedStarlark string, serializedParams string, stream grpc.ServerStream) {
+	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, resume, apply, removeUndeclaredServices, packageId, serializedStarlark, serializedParams)
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -679,3 +867,21 @@ func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool,
 		}
 	}
 }
+
+// trafficCaptureStreamWriter sends what gets written to it to the client of a traffic capture, in chunks
+type trafficCaptureStreamWriter struct {
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_CaptureServiceTrafficServer
+}
+
+func (writer *trafficCaptureStreamWriter) Write(data []byte) (int, error) {
+	for chunkStart := 0; chunkStart < len(data); chunkStart += trafficCaptureChunkSizeBytes {
+		chunkEnd := chunkStart + trafficCaptureChunkSizeBytes
+		if chunkEnd > len(data) {
+			chunkEnd = len(data)
+		}
+		if err := writer.stream.Send(binding_constructors.NewServiceTrafficCaptureChunk(data[chunkStart:chunkEnd])); err != nil {
+			return chunkStart, stacktrace.Propagate(err, "An error occurred sending a chunk of the traffic capture")
+		}
+	}
+	return len(data), nil
+}
@@ -8,6 +8,9 @@ package server
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -35,3 +38,42 @@ func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 		seenPortSpecProtos[portSpecProto] = apiPortProto
 	}
 }
+
+func TestOneToOneConnectionChangeEventTypeAndApiEventTypeMapping(t *testing.T) {
+	require.Equal(t, len(kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_EventType_name), len(connectionChangeEventTypeToApiEventType))
+	seenApiEventTypes := map[kurtosis_core_rpc_api_bindings.ConnectionChangeEvent_EventType]connection_change_scheduler.ConnectionChangeEventType{}
+	for eventType, apiEventType := range connectionChangeEventTypeToApiEventType {
+		preexistingEventType, found := seenApiEventTypes[apiEventType]
+		require.False(
+			t,
+			found,
+			"API event type '%v' is already mapped to connection change event type '%v'",
+			apiEventType.String(),
+			preexistingEventType,
+		)
+		seenApiEventTypes[apiEventType] = eventType
+	}
+}
+
+func TestTransformTrafficStatisticsToApiLinkTrafficStatistics_SortsLinks(t *testing.T) {
+	trafficStatistics := map[kurtosis_backend_service.ServiceName]map[kurtosis_backend_service.ServiceName]*networking_sidecar.TrafficStatistics{
+		"service-b": {
+			"service-a": {SentBytes: 100, SentPackets: 1, DroppedPackets: 0},
+		},
+		"service-a": {
+			"service-c": {SentBytes: 0, SentPackets: 0, DroppedPackets: 5},
+			"service-b": {SentBytes: 200, SentPackets: 2, DroppedPackets: 1},
+		},
+	}
+
+	linkTrafficStatistics := transformTrafficStatisticsToApiLinkTrafficStatistics(trafficStatistics)
+	require.Len(t, linkTrafficStatistics, 3)
+	require.Equal(t, "service-a", linkTrafficStatistics[0].GetSourceServiceName())
+	require.Equal(t, "service-b", linkTrafficStatistics[0].GetDestinationServiceName())
+	require.Equal(t, uint64(200), linkTrafficStatistics[0].GetSentBytes())
+	require.Equal(t, uint64(1), linkTrafficStatistics[0].GetDroppedPackets())
+	require.Equal(t, "service-a", linkTrafficStatistics[1].GetSourceServiceName())
+	require.Equal(t, "service-c", linkTrafficStatistics[1].GetDestinationServiceName())
+	require.Equal(t, "service-b", linkTrafficStatistics[2].GetSourceServiceName())
+	require.Equal(t, "service-a", linkTrafficStatistics[2].GetDestinationServiceName())
+}
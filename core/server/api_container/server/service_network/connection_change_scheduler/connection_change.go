@@ -0,0 +1,79 @@
+package connection_change_scheduler
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+// ConnectionChange is a single step of a timeline of connection changes. It sets (or removes, if the connection is nil)
+// the connection between two subnetworks once the offset has elapsed since the timeline got scheduled
+type ConnectionChange struct {
+	offset time.Duration
+
+	subnetwork1 service_network_types.PartitionID
+	subnetwork2 service_network_types.PartitionID
+
+	// nil means the connection override between the two subnetworks gets removed
+	connection *partition_topology.PartitionConnection
+
+	// When false, the change only applies to the traffic going from subnetwork1 to subnetwork2
+	isBidirectional bool
+}
+
+func NewConnectionChange(
+	offset time.Duration,
+	subnetwork1 service_network_types.PartitionID,
+	subnetwork2 service_network_types.PartitionID,
+	connection *partition_topology.PartitionConnection,
+	isBidirectional bool,
+) *ConnectionChange {
+	return &ConnectionChange{
+		offset:          offset,
+		subnetwork1:     subnetwork1,
+		subnetwork2:     subnetwork2,
+		connection:      connection,
+		isBidirectional: isBidirectional,
+	}
+}
+
+func (change *ConnectionChange) GetOffset() time.Duration {
+	return change.offset
+}
+
+func (change *ConnectionChange) GetSubnetwork1() service_network_types.PartitionID {
+	return change.subnetwork1
+}
+
+func (change *ConnectionChange) GetSubnetwork2() service_network_types.PartitionID {
+	return change.subnetwork2
+}
+
+// GetConnection returns nil if the change removes the connection override
+func (change *ConnectionChange) GetConnection() *partition_topology.PartitionConnection {
+	return change.connection
+}
+
+func (change *ConnectionChange) IsBidirectional() bool {
+	return change.isBidirectional
+}
+
+func (change *ConnectionChange) apply(ctx context.Context, updater ConnectionUpdater) error {
+	var err error
+	switch {
+	case change.connection != nil && change.isBidirectional:
+		err = updater.SetConnection(ctx, change.subnetwork1, change.subnetwork2, *change.connection)
+	case change.connection != nil:
+		err = updater.SetDirectionalConnection(ctx, change.subnetwork1, change.subnetwork2, *change.connection)
+	case change.isBidirectional:
+		err = updater.UnsetConnection(ctx, change.subnetwork1, change.subnetwork2)
+	default:
+		err = updater.UnsetDirectionalConnection(ctx, change.subnetwork1, change.subnetwork2)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the connection between subnetworks '%s' and '%s'", change.subnetwork1, change.subnetwork2)
+	}
+	return nil
+}
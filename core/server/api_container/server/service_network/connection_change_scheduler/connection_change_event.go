@@ -0,0 +1,58 @@
+package connection_change_scheduler
+
+import "time"
+
+type ConnectionChangeEventType string
+
+const (
+	// StepApplied is emitted each time a step of the timeline got applied successfully
+	StepApplied ConnectionChangeEventType = "STEP_APPLIED"
+	// StepFailed is emitted each time a step of the timeline could not be applied. The following steps still get applied
+	StepFailed ConnectionChangeEventType = "STEP_FAILED"
+	// Completed is the last event of a timeline which got applied until the end
+	Completed ConnectionChangeEventType = "COMPLETED"
+	// Cancelled is the last event of a timeline which got cancelled before the end
+	Cancelled ConnectionChangeEventType = "CANCELLED"
+)
+
+type ConnectionChangeEvent struct {
+	eventType ConnectionChangeEventType
+
+	// The index of the step in the timeline. For COMPLETED and CANCELLED events, it is the number of steps that got
+	// processed before the timeline ended
+	stepIndex int
+
+	timestamp time.Time
+
+	// Only set for STEP_FAILED events
+	errorMessage string
+}
+
+func newConnectionChangeEvent(eventType ConnectionChangeEventType, stepIndex int, errorMessage string) *ConnectionChangeEvent {
+	return &ConnectionChangeEvent{
+		eventType:    eventType,
+		stepIndex:    stepIndex,
+		timestamp:    time.Now(),
+		errorMessage: errorMessage,
+	}
+}
+
+func (event *ConnectionChangeEvent) GetEventType() ConnectionChangeEventType {
+	return event.eventType
+}
+
+func (event *ConnectionChangeEvent) GetStepIndex() int {
+	return event.stepIndex
+}
+
+func (event *ConnectionChangeEvent) GetTimestamp() time.Time {
+	return event.timestamp
+}
+
+func (event *ConnectionChangeEvent) GetErrorMessage() string {
+	return event.errorMessage
+}
+
+func (event *ConnectionChangeEvent) isFinal() bool {
+	return event.eventType == Completed || event.eventType == Cancelled
+}
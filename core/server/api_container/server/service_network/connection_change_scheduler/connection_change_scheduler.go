@@ -0,0 +1,170 @@
+package connection_change_scheduler
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// A timeline emits one event per step plus a final one, at most
+	numFinalEvents = 1
+)
+
+// ConnectionUpdater is the part of the service network the scheduler applies the connection changes to
+type ConnectionUpdater interface {
+	SetConnection(ctx context.Context, subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID, connection partition_topology.PartitionConnection) error
+	UnsetConnection(ctx context.Context, subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID) error
+	SetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID, connection partition_topology.PartitionConnection) error
+	UnsetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID) error
+}
+
+// ConnectionChangeScheduler applies timelines of connection changes in the background. Each timeline is identified by
+// a name, and its events are kept once it's over so that they can still be watched
+// Timelines live in memory only, they don't survive an API container restart
+type ConnectionChangeScheduler struct {
+	updater ConnectionUpdater
+
+	mutex *sync.Mutex
+
+	timelines map[string]*timeline
+}
+
+type timeline struct {
+	numSteps int
+
+	cancelFunc context.CancelFunc
+
+	// Closed once the timeline is over, whether it completed or got cancelled
+	doneChan chan struct{}
+
+	events []*ConnectionChangeEvent
+
+	watchers []chan *ConnectionChangeEvent
+}
+
+func NewConnectionChangeScheduler(updater ConnectionUpdater) *ConnectionChangeScheduler {
+	return &ConnectionChangeScheduler{
+		updater:   updater,
+		mutex:     &sync.Mutex{},
+		timelines: map[string]*timeline{},
+	}
+}
+
+// Schedule starts applying the changes in the background. The offset of each change is relative to the time this
+// function gets called, and changes must be ordered by offset. A timeline which is over can be replaced by scheduling
+// a new one with the same name
+func (scheduler *ConnectionChangeScheduler) Schedule(name string, changes []*ConnectionChange) error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	if existingTimeline, found := scheduler.timelines[name]; found && !existingTimeline.isOver() {
+		return stacktrace.NewError("Connection changes named '%s' are already scheduled and not applied yet; cancel them first to schedule new ones under this name", name)
+	}
+	for idx := 1; idx < len(changes); idx++ {
+		if changes[idx].offset < changes[idx-1].offset {
+			return stacktrace.NewError("Connection changes have to be ordered by offset, but change #%d at offset '%v' comes after change #%d at offset '%v'", idx, changes[idx].offset, idx-1, changes[idx-1].offset)
+		}
+	}
+
+	ctxWithCancel, cancelFunc := context.WithCancel(context.Background())
+	newTimeline := &timeline{
+		numSteps:   len(changes),
+		cancelFunc: cancelFunc,
+		doneChan:   make(chan struct{}),
+		events:     []*ConnectionChangeEvent{},
+		watchers:   []chan *ConnectionChangeEvent{},
+	}
+	scheduler.timelines[name] = newTimeline
+	go scheduler.runTimeline(ctxWithCancel, name, newTimeline, changes)
+	return nil
+}
+
+// Cancel stops the timeline before its next step gets applied, and returns once the timeline is over. Cancelling a
+// timeline which is already over is a no-op
+func (scheduler *ConnectionChangeScheduler) Cancel(name string) error {
+	scheduler.mutex.Lock()
+	timelineToCancel, found := scheduler.timelines[name]
+	scheduler.mutex.Unlock()
+	if !found {
+		return stacktrace.NewError("No connection changes were scheduled under the name '%s'", name)
+	}
+	timelineToCancel.cancelFunc()
+	<-timelineToCancel.doneChan
+	return nil
+}
+
+// Watch returns a channel receiving all the events of the timeline, starting with the ones which already happened.
+// The channel gets closed once the timeline is over
+func (scheduler *ConnectionChangeScheduler) Watch(name string) (<-chan *ConnectionChangeEvent, error) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	watchedTimeline, found := scheduler.timelines[name]
+	if !found {
+		return nil, stacktrace.NewError("No connection changes were scheduled under the name '%s'", name)
+	}
+	// The channel can hold all the events the timeline will ever emit so that publishing never blocks on a slow watcher
+	eventsChan := make(chan *ConnectionChangeEvent, watchedTimeline.numSteps+numFinalEvents)
+	for _, event := range watchedTimeline.events {
+		eventsChan <- event
+	}
+	if watchedTimeline.isOver() {
+		close(eventsChan)
+		return eventsChan, nil
+	}
+	watchedTimeline.watchers = append(watchedTimeline.watchers, eventsChan)
+	return eventsChan, nil
+}
+
+func (scheduler *ConnectionChangeScheduler) runTimeline(ctx context.Context, name string, runningTimeline *timeline, changes []*ConnectionChange) {
+	defer close(runningTimeline.doneChan)
+
+	startTime := time.Now()
+	for idx, change := range changes {
+		timer := time.NewTimer(time.Until(startTime.Add(change.offset)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+		// Checked again after the timer fired, as both cases could be ready at the same time
+		if ctx.Err() != nil {
+			logrus.Debugf("Connection changes '%s' got cancelled before step #%d", name, idx)
+			scheduler.publishEvent(runningTimeline, newConnectionChangeEvent(Cancelled, idx, ""))
+			return
+		}
+		if err := change.apply(ctx, scheduler.updater); err != nil {
+			logrus.Errorf("An error occurred applying step #%d of connection changes '%s':\n%v", idx, name, err)
+			scheduler.publishEvent(runningTimeline, newConnectionChangeEvent(StepFailed, idx, err.Error()))
+			continue
+		}
+		scheduler.publishEvent(runningTimeline, newConnectionChangeEvent(StepApplied, idx, ""))
+	}
+	scheduler.publishEvent(runningTimeline, newConnectionChangeEvent(Completed, len(changes), ""))
+}
+
+func (scheduler *ConnectionChangeScheduler) publishEvent(runningTimeline *timeline, event *ConnectionChangeEvent) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	runningTimeline.events = append(runningTimeline.events, event)
+	for _, watcher := range runningTimeline.watchers {
+		watcher <- event
+		if event.isFinal() {
+			close(watcher)
+		}
+	}
+	if event.isFinal() {
+		runningTimeline.watchers = nil
+	}
+}
+
+func (runningTimeline *timeline) isOver() bool {
+	lastEventIdx := len(runningTimeline.events) - 1
+	return lastEventIdx >= 0 && runningTimeline.events[lastEventIdx].isFinal()
+}
@@ -0,0 +1,201 @@
+package connection_change_scheduler
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	subnetwork1 = service_network_types.PartitionID("subnetwork1")
+	subnetwork2 = service_network_types.PartitionID("subnetwork2")
+
+	timelineName = "timeline"
+
+	failingSubnetwork = service_network_types.PartitionID("failing")
+)
+
+type recordedUpdate struct {
+	method      string
+	subnetwork1 service_network_types.PartitionID
+	subnetwork2 service_network_types.PartitionID
+}
+
+type fakeConnectionUpdater struct {
+	mutex   *sync.Mutex
+	updates []recordedUpdate
+}
+
+func newFakeConnectionUpdater() *fakeConnectionUpdater {
+	return &fakeConnectionUpdater{
+		mutex:   &sync.Mutex{},
+		updates: []recordedUpdate{},
+	}
+}
+
+func (updater *fakeConnectionUpdater) SetConnection(_ context.Context, subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID, _ partition_topology.PartitionConnection) error {
+	return updater.record("SetConnection", subnetwork1, subnetwork2)
+}
+
+func (updater *fakeConnectionUpdater) UnsetConnection(_ context.Context, subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID) error {
+	return updater.record("UnsetConnection", subnetwork1, subnetwork2)
+}
+
+func (updater *fakeConnectionUpdater) SetDirectionalConnection(_ context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID, _ partition_topology.PartitionConnection) error {
+	return updater.record("SetDirectionalConnection", from, to)
+}
+
+func (updater *fakeConnectionUpdater) UnsetDirectionalConnection(_ context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID) error {
+	return updater.record("UnsetDirectionalConnection", from, to)
+}
+
+func (updater *fakeConnectionUpdater) record(method string, subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID) error {
+	if subnetwork1 == failingSubnetwork {
+		return stacktrace.NewError("Failing on purpose")
+	}
+	updater.mutex.Lock()
+	defer updater.mutex.Unlock()
+	updater.updates = append(updater.updates, recordedUpdate{
+		method:      method,
+		subnetwork1: subnetwork1,
+		subnetwork2: subnetwork2,
+	})
+	return nil
+}
+
+func (updater *fakeConnectionUpdater) getUpdates() []recordedUpdate {
+	updater.mutex.Lock()
+	defer updater.mutex.Unlock()
+	return updater.updates
+}
+
+func TestSchedule_AppliesAllChangesInOrder(t *testing.T) {
+	updater := newFakeConnectionUpdater()
+	scheduler := NewConnectionChangeScheduler(updater)
+	connection := partition_topology.ConnectionBlocked
+
+	changes := []*ConnectionChange{
+		NewConnectionChange(0, subnetwork1, subnetwork2, &connection, true),
+		NewConnectionChange(10*time.Millisecond, subnetwork1, subnetwork2, &connection, false),
+		NewConnectionChange(20*time.Millisecond, subnetwork1, subnetwork2, nil, false),
+		NewConnectionChange(20*time.Millisecond, subnetwork1, subnetwork2, nil, true),
+	}
+	require.Nil(t, scheduler.Schedule(timelineName, changes))
+
+	events := collectEvents(t, scheduler, timelineName)
+	require.Len(t, events, 5)
+	for idx := 0; idx < 4; idx++ {
+		require.Equal(t, StepApplied, events[idx].GetEventType())
+		require.Equal(t, idx, events[idx].GetStepIndex())
+	}
+	require.Equal(t, Completed, events[4].GetEventType())
+
+	expectedUpdates := []recordedUpdate{
+		{method: "SetConnection", subnetwork1: subnetwork1, subnetwork2: subnetwork2},
+		{method: "SetDirectionalConnection", subnetwork1: subnetwork1, subnetwork2: subnetwork2},
+		{method: "UnsetDirectionalConnection", subnetwork1: subnetwork1, subnetwork2: subnetwork2},
+		{method: "UnsetConnection", subnetwork1: subnetwork1, subnetwork2: subnetwork2},
+	}
+	require.Equal(t, expectedUpdates, updater.getUpdates())
+
+	// events are kept once the timeline is over
+	require.Equal(t, events, collectEvents(t, scheduler, timelineName))
+}
+
+func TestSchedule_FailedStepDoesNotStopTimeline(t *testing.T) {
+	updater := newFakeConnectionUpdater()
+	scheduler := NewConnectionChangeScheduler(updater)
+
+	changes := []*ConnectionChange{
+		NewConnectionChange(0, failingSubnetwork, subnetwork2, nil, true),
+		NewConnectionChange(0, subnetwork1, subnetwork2, nil, true),
+	}
+	require.Nil(t, scheduler.Schedule(timelineName, changes))
+
+	events := collectEvents(t, scheduler, timelineName)
+	require.Len(t, events, 3)
+	require.Equal(t, StepFailed, events[0].GetEventType())
+	require.Contains(t, events[0].GetErrorMessage(), "Failing on purpose")
+	require.Equal(t, StepApplied, events[1].GetEventType())
+	require.Equal(t, Completed, events[2].GetEventType())
+	require.Len(t, updater.getUpdates(), 1)
+}
+
+func TestSchedule_FailsIfNotOrderedByOffset(t *testing.T) {
+	scheduler := NewConnectionChangeScheduler(newFakeConnectionUpdater())
+
+	changes := []*ConnectionChange{
+		NewConnectionChange(time.Second, subnetwork1, subnetwork2, nil, true),
+		NewConnectionChange(0, subnetwork1, subnetwork2, nil, true),
+	}
+	err := scheduler.Schedule(timelineName, changes)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ordered by offset")
+}
+
+func TestSchedule_FailsIfNameIsAlreadyRunning(t *testing.T) {
+	scheduler := NewConnectionChangeScheduler(newFakeConnectionUpdater())
+
+	changes := []*ConnectionChange{
+		NewConnectionChange(time.Hour, subnetwork1, subnetwork2, nil, true),
+	}
+	require.Nil(t, scheduler.Schedule(timelineName, changes))
+	err := scheduler.Schedule(timelineName, changes)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "already scheduled")
+
+	// once cancelled, the name can be reused
+	require.Nil(t, scheduler.Cancel(timelineName))
+	require.Nil(t, scheduler.Schedule(timelineName, []*ConnectionChange{}))
+}
+
+func TestCancel(t *testing.T) {
+	updater := newFakeConnectionUpdater()
+	scheduler := NewConnectionChangeScheduler(updater)
+
+	changes := []*ConnectionChange{
+		NewConnectionChange(0, subnetwork1, subnetwork2, nil, true),
+		NewConnectionChange(time.Hour, subnetwork1, subnetwork2, nil, true),
+	}
+	require.Nil(t, scheduler.Schedule(timelineName, changes))
+	eventsChan, err := scheduler.Watch(timelineName)
+	require.Nil(t, err)
+	firstEvent := <-eventsChan
+	require.Equal(t, StepApplied, firstEvent.GetEventType())
+
+	require.Nil(t, scheduler.Cancel(timelineName))
+
+	lastEvent, isOpen := <-eventsChan
+	require.True(t, isOpen)
+	require.Equal(t, Cancelled, lastEvent.GetEventType())
+	require.Equal(t, 1, lastEvent.GetStepIndex())
+	_, isOpen = <-eventsChan
+	require.False(t, isOpen)
+	require.Len(t, updater.getUpdates(), 1)
+
+	// cancelling a timeline which is over no-ops
+	require.Nil(t, scheduler.Cancel(timelineName))
+}
+
+func TestCancel_FailsForUnknownName(t *testing.T) {
+	scheduler := NewConnectionChangeScheduler(newFakeConnectionUpdater())
+
+	require.NotNil(t, scheduler.Cancel(timelineName))
+	_, err := scheduler.Watch(timelineName)
+	require.NotNil(t, err)
+}
+
+func collectEvents(t *testing.T, scheduler *ConnectionChangeScheduler, name string) []*ConnectionChangeEvent {
+	eventsChan, err := scheduler.Watch(name)
+	require.Nil(t, err)
+	events := []*ConnectionChangeEvent{}
+	for event := range eventsChan {
+		events = append(events, event)
+	}
+	return events
+}
@@ -6,36 +6,48 @@
 package service_network
 
 import (
+	"archive/tar"
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/files_artifacts_expansion"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/networking_sidecars"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/service_configs"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/service_registrations"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	"io"
 	"math"
 	"net"
 	"net/http"
 	"os"
 	"path"
+	"reflect"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 )
 
 const (
@@ -50,12 +62,18 @@ const (
 	folderPermissionForRenderedTemplates = 0755
 	tempDirForRenderedTemplatesPrefix    = "temp-dir-for-rendered-templates-"
 
+	tempDirForTrafficCapturePrefix = "temp-dir-for-traffic-capture-"
+	trafficCaptureFileExtension    = ".pcap"
+	trafficCaptureFilePermissions  = 0644
+
 	ensureCompressedFileIsLesserThanGRPCLimit = false
 
 	emptyCollectionLength        = 0
 	exactlyOneShortenedUuidMatch = 1
 
 	singleServiceStartupBatch = 1
+
+	doNotFollowLogs = false
 )
 
 var (
@@ -69,6 +87,12 @@ var (
 	emptyServiceNamesSetToUpdateAllConnections = map[service.ServiceName]bool{}
 )
 
+// The traffic going from one partition to another
+type partitionConnectionDirection struct {
+	from service_network_types.PartitionID
+	to   service_network_types.PartitionID
+}
+
 type storeFilesArtifactResult struct {
 	err               error
 	filesArtifactUuid enclave_data_directory.FilesArtifactUUID
@@ -76,6 +100,7 @@ type storeFilesArtifactResult struct {
 
 // DefaultServiceNetwork is the in-memory representation of the service network that the API container will manipulate.
 // To make any changes to the test network, this struct must be used.
+// The state of the services is persisted in the enclave database as well, so that it survives API container restarts
 type DefaultServiceNetwork struct {
 	enclaveUuid enclave.EnclaveUUID
 
@@ -88,6 +113,22 @@ type DefaultServiceNetwork struct {
 	// Whether partitioning has been enabled for this particular test
 	isPartitioningEnabled bool
 
+	// Whether the backend can block the traffic between services without the networking sidecars, i.e. with a firewall
+	// If so, blocked connections are enforced even if partitioning is disabled, or if the traffic control of a service
+	// gets reset
+	canBlockTrafficWithoutSidecars bool
+
+	// The traffic currently blocked by the backend, by source and then destination IP address
+	// The services get started in parallel, each one updating the connections, hence the lock right below
+	blockedTrafficPerIpAddress map[string]map[string]bool
+	blockedTrafficLock         *sync.Mutex
+
+	// The entries last written to the hosts file of each service, so that the additional hostnames of the services
+	// resolve according to the topology. Like the blocked traffic, they are updated while the services get started in
+	// parallel, hence the lock right below
+	hostsFileEntriesPerService map[service.ServiceName][]string
+	hostsFileEntriesLock       *sync.Mutex
+
 	kurtosisBackend backend_interface.KurtosisBackend
 
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory
@@ -106,8 +147,25 @@ type DefaultServiceNetwork struct {
 	// this because the API container is the only client that modifies service state
 	registeredServiceInfo map[service.ServiceName]*service.ServiceRegistration
 
+	// The config each registered service was started with. It is used to find out whether a service needs to be
+	// re-created when a package is applied to the enclave
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+
+	// The configs of the services registered and being started, which aren't in serviceConfigs yet. Services get
+	// started without the network being locked, so this reserves their hostnames in the meantime
+	startingServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+
 	// This contains all service identifiers ever successfully created, this is append only
 	allExistingAndHistoricalIdentifiers []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers
+
+	// The buckets below persist the in-memory state above in the enclave database
+	serviceRegistrationsBucket *service_registrations.ServiceRegistrationsBucket
+	serviceConfigsBucket       *service_configs.ServiceConfigsBucket
+	serviceIdentifiersBucket   *service_identifiers.ServiceIdentifiersBucket
+	networkingSidecarsBucket   *networking_sidecars.NetworkingSidecarsBucket
+
+	// Applies the scheduled connection changes in the background, going through the connection setters of this struct
+	connectionChangeScheduler *connection_change_scheduler.ConnectionChangeScheduler
 }
 
 func NewDefaultServiceNetwork(
@@ -116,6 +174,7 @@ func NewDefaultServiceNetwork(
 	apiContainerGrpcPortNum uint16,
 	apiContainerVersion string,
 	isPartitioningEnabled bool,
+	canBlockTrafficWithoutSidecars bool,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	networkingSidecarManager networking_sidecar.NetworkingSidecarManager,
@@ -129,22 +188,151 @@ func NewDefaultServiceNetwork(
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the partition topology")
 	}
-	return &DefaultServiceNetwork{
+	serviceRegistrationsBucket, err := service_registrations.GetOrCreateServiceRegistrationsBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the service registrations bucket")
+	}
+	serviceConfigsBucket, err := service_configs.GetOrCreateServiceConfigsBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the service configs bucket")
+	}
+	serviceIdentifiersBucket, err := service_identifiers.GetOrCreateServiceIdentifiersBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the service identifiers bucket")
+	}
+	networkingSidecarsBucket, err := networking_sidecars.GetOrCreateNetworkingSidecarsBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the networking sidecars bucket")
+	}
+
+	registeredServiceInfo, err := serviceRegistrationsBucket.GetAllServiceRegistrations()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while loading the persisted service registrations")
+	}
+	serviceConfigs, err := loadPersistedServiceConfigs(serviceConfigsBucket)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while loading the persisted service configs")
+	}
+	allExistingAndHistoricalIdentifiers, err := loadPersistedServiceIdentifiers(serviceIdentifiersBucket)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while loading the persisted service identifiers")
+	}
+	if len(registeredServiceInfo) > 0 {
+		logrus.Infof("Loaded %d services from the enclave database", len(registeredServiceInfo))
+	}
+
+	network := &DefaultServiceNetwork{
 		enclaveUuid:                         enclaveUuid,
 		apiContainerIpAddress:               apiContainerIpAddr,
 		apiContainerGrpcPortNum:             apiContainerGrpcPortNum,
 		apiContainerVersion:                 apiContainerVersion,
 		mutex:                               &sync.Mutex{},
 		isPartitioningEnabled:               isPartitioningEnabled,
+		canBlockTrafficWithoutSidecars:      canBlockTrafficWithoutSidecars,
+		blockedTrafficPerIpAddress:          map[string]map[string]bool{},
+		blockedTrafficLock:                  &sync.Mutex{},
+		hostsFileEntriesPerService:          map[service.ServiceName][]string{},
+		hostsFileEntriesLock:                &sync.Mutex{},
 		kurtosisBackend:                     kurtosisBackend,
 		enclaveDataDir:                      enclaveDataDir,
 		topology:                            networkTopology,
 		networkingSidecars:                  map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper{},
 		networkSidecarsLock:                 &sync.Mutex{},
 		networkingSidecarManager:            networkingSidecarManager,
-		registeredServiceInfo:               map[service.ServiceName]*service.ServiceRegistration{},
-		allExistingAndHistoricalIdentifiers: []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers{},
-	}, nil
+		registeredServiceInfo:               registeredServiceInfo,
+		serviceConfigs:                      serviceConfigs,
+		startingServiceConfigs:              map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{},
+		allExistingAndHistoricalIdentifiers: allExistingAndHistoricalIdentifiers,
+		serviceRegistrationsBucket:          serviceRegistrationsBucket,
+		serviceConfigsBucket:                serviceConfigsBucket,
+		serviceIdentifiersBucket:            serviceIdentifiersBucket,
+		networkingSidecarsBucket:            networkingSidecarsBucket,
+		connectionChangeScheduler:           nil,
+	}
+	network.connectionChangeScheduler = connection_change_scheduler.NewConnectionChangeScheduler(network)
+	return network, nil
+}
+
+// ReconcileWithBackend checks the services loaded from the enclave database against the services that actually exist
+// in the backend. Services that no longer exist are dropped from the enclave state, and if partitioning is enabled
+// the networking sidecars of the running services are re-attached and their traffic control configured again
+// It is meant to be called once, when the API container starts
+func (network *DefaultServiceNetwork) ReconcileWithBackend(ctx context.Context) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	persistedNetworkingSidecars, err := network.networkingSidecarsBucket.GetAllNetworkingSidecars()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persisted networking sidecars")
+	}
+	if len(network.registeredServiceInfo) == emptyCollectionLength && len(persistedNetworkingSidecars) == emptyCollectionLength {
+		return nil
+	}
+
+	allEnclaveServicesFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	existingServices, err := network.kurtosisBackend.GetUserServices(ctx, network.enclaveUuid, allEnclaveServicesFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' from the backend", network.enclaveUuid)
+	}
+
+	for serviceName, serviceRegistration := range network.registeredServiceInfo {
+		if _, found := existingServices[serviceRegistration.GetUUID()]; found {
+			continue
+		}
+		logrus.Warnf("Service '%s' with UUID '%s' was persisted in the enclave database but doesn't exist anymore in the backend. It is removed from the enclave", serviceName, serviceRegistration.GetUUID())
+		if err := network.topology.RemoveService(serviceName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing service '%s' from the network topology", serviceName)
+		}
+		if err := network.cleanupInternalMapsUnlocked(serviceName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing service '%s' which doesn't exist anymore", serviceName)
+		}
+	}
+
+	registeredServiceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	for serviceName, serviceRegistration := range network.registeredServiceInfo {
+		registeredServiceNamesByUuid[serviceRegistration.GetUUID()] = serviceName
+	}
+	for serviceUuid, existingService := range existingServices {
+		if _, found := registeredServiceNamesByUuid[serviceUuid]; !found {
+			logrus.Warnf("Service '%s' with UUID '%s' exists in the backend but is unknown to the enclave. It will not be managed by Kurtosis", existingService.GetRegistration().GetName(), serviceUuid)
+		}
+	}
+
+	servicesWithReattachedSidecar := map[service.ServiceName]bool{}
+	for serviceName, serviceUuid := range persistedNetworkingSidecars {
+		existingService, found := existingServices[serviceUuid]
+		if !network.isPartitioningEnabled || !found || existingService.GetStatus() != container_status.ContainerStatus_Running {
+			if err := network.removePersistedNetworkingSidecarUnlocked(serviceName); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing the networking sidecar of service '%s' which can't be re-attached", serviceName)
+			}
+			continue
+		}
+		sidecar, err := network.networkingSidecarManager.Load(ctx, serviceUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred re-attaching the networking sidecar of service '%s'", serviceName)
+		}
+		if err := sidecar.InitializeTrafficControl(ctx); err != nil {
+			return stacktrace.Propagate(err, "An error occurred initializing the traffic control of the re-attached networking sidecar of service '%s'", serviceName)
+		}
+		network.networkingSidecars[serviceName] = sidecar
+		servicesWithReattachedSidecar[serviceName] = true
+	}
+	if len(servicesWithReattachedSidecar) > emptyCollectionLength {
+		if err := network.updateConnectionsFromTopology(ctx, servicesWithReattachedSidecar); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connections between the services")
+		}
+	} else if network.canBlockTrafficWithoutSidecars {
+		if err := network.updateBlockedTrafficFromTopology(ctx); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the traffic blocked between the services")
+		}
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	logrus.Infof("Enclave state reconciled with the backend: %d services and %d networking sidecars restored", len(network.registeredServiceInfo), len(servicesWithReattachedSidecar))
+	return nil
 }
 
 /*
@@ -159,9 +347,17 @@ func (network *DefaultServiceNetwork) Repartition(
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
 
-	if !network.isPartitioningEnabled {
+	if !network.isPartitionEnforcementEnabled() {
 		return stacktrace.NewError("Cannot repartition; partitioning is not enabled")
 	}
+	for partitionConnectionId, connection := range newPartitionConnections {
+		if err := network.validateConnectionCanBeEnforced(connection); err != nil {
+			return stacktrace.Propagate(err, "Cannot set the connection between '%s' and '%s'", partitionConnectionId.GetFirst(), partitionConnectionId.GetSecond())
+		}
+	}
+	if err := network.validateConnectionCanBeEnforced(newDefaultConnection); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the default connection")
+	}
 
 	if err := network.topology.Repartition(newPartitionServices, newPartitionConnections, newDefaultConnection); err != nil {
 		return stacktrace.Propagate(err, "An error occurred repartitioning the network topology")
@@ -170,6 +366,7 @@ func (network *DefaultServiceNetwork) Repartition(
 	if err := network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
 	return nil
 }
 
@@ -183,9 +380,12 @@ func (network *DefaultServiceNetwork) SetConnection(
 	defer network.mutex.Unlock()
 	isOperationSuccessful := false
 
-	if !network.isPartitioningEnabled {
+	if !network.isPartitionEnforcementEnabled() {
 		return stacktrace.NewError("Cannot set connection; partitioning is not enabled")
 	}
+	if err := network.validateConnectionCanBeEnforced(connection); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the connection between '%s' and '%s'", partition1, partition2)
+	}
 
 	currentPartitions, err := network.topology.GetPartitionServices()
 	if err != nil {
@@ -219,6 +419,11 @@ func (network *DefaultServiceNetwork) SetConnection(
 	if err != nil {
 		return stacktrace.Propagate(err, "Unable to fetch current connection between '%s' and '%s'", partition1, partition2)
 	}
+	// setting the connection drops the directional overrides between the two partitions
+	previousDirectionalConnections, err := network.getDirectionalConnectionOverridesUnlocked(partition1, partition2)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to fetch current directional connections between '%s' and '%s'", partition1, partition2)
+	}
 
 	err = network.topology.SetConnection(partition1, partition2, connection)
 	if err != nil {
@@ -234,6 +439,9 @@ func (network *DefaultServiceNetwork) SetConnection(
 		} else {
 			resetConnectionErr = network.topology.SetConnection(partition1, partition2, previousConnection)
 		}
+		if resetConnectionErr == nil {
+			resetConnectionErr = network.restoreDirectionalConnectionOverridesUnlocked(previousDirectionalConnections)
+		}
 		if resetConnectionErr != nil {
 			logrus.Errorf("A failure happened after setting the connection between '%s' and '%s', so it should "+
 				"be reset to its previous value. Unfortunately, an error happened trying to set it back to its "+
@@ -244,6 +452,7 @@ func (network *DefaultServiceNetwork) SetConnection(
 	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
 	isOperationSuccessful = true
 	return nil
 }
@@ -257,7 +466,7 @@ func (network *DefaultServiceNetwork) UnsetConnection(
 	defer network.mutex.Unlock()
 	isOperationSuccessful := false
 
-	if !network.isPartitioningEnabled {
+	if !network.isPartitionEnforcementEnabled() {
 		return stacktrace.NewError("Cannot unset connection; partitioning is not enabled")
 	}
 
@@ -277,7 +486,11 @@ func (network *DefaultServiceNetwork) UnsetConnection(
 	if err != nil {
 		return stacktrace.Propagate(err, "Unable to retrieve current connection between '%s' and '%s'", partition1, partition2)
 	}
-	if wasDefaultConnection {
+	previousDirectionalConnections, err := network.getDirectionalConnectionOverridesUnlocked(partition1, partition2)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to retrieve current directional connections between '%s' and '%s'", partition1, partition2)
+	}
+	if wasDefaultConnection && len(previousDirectionalConnections) == emptyCollectionLength {
 		logrus.Debugf("Unsetting connection between '%s' and '%s' but connection was already the default. This will no-op",
 			partition1, partition2)
 		return nil
@@ -290,7 +503,14 @@ func (network *DefaultServiceNetwork) UnsetConnection(
 		if isOperationSuccessful {
 			return
 		}
-		if resetConnectionErr := network.topology.SetConnection(partition1, partition2, previousConnection); resetConnectionErr != nil {
+		var resetConnectionErr error
+		if !wasDefaultConnection {
+			resetConnectionErr = network.topology.SetConnection(partition1, partition2, previousConnection)
+		}
+		if resetConnectionErr == nil {
+			resetConnectionErr = network.restoreDirectionalConnectionOverridesUnlocked(previousDirectionalConnections)
+		}
+		if resetConnectionErr != nil {
 			logrus.Errorf("An error happened resetting the connection between '%s' and '%s' and Kurtosis could not roll back the operation. Error was:\n%v", partition1, partition2, resetConnectionErr)
 		}
 	}()
@@ -298,10 +518,297 @@ func (network *DefaultServiceNetwork) UnsetConnection(
 	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	isOperationSuccessful = true
+	return nil
+}
+
+// SetDirectionalConnection overrides the connection applying to the traffic going from partition 'from' to partition
+// 'to'. The traffic going the other way is left untouched
+func (network *DefaultServiceNetwork) SetDirectionalConnection(
+	ctx context.Context,
+	from service_network_types.PartitionID,
+	to service_network_types.PartitionID,
+	connection partition_topology.PartitionConnection,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	isOperationSuccessful := false
+
+	if !network.isPartitionEnforcementEnabled() {
+		return stacktrace.NewError("Cannot set connection; partitioning is not enabled")
+	}
+	if err := network.validateConnectionCanBeEnforced(connection); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the connection from '%s' to '%s'", from, to)
+	}
+
+	currentPartitions, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	createdPartitionToRemoveIfFailure := map[service_network_types.PartitionID]bool{}
+	for _, partition := range []service_network_types.PartitionID{from, to} {
+		if _, found := currentPartitions[partition]; !found {
+			logrus.Debugf("Setting connection from '%s' to '%s' but '%s' isn't registered as a partition yet. Creating it",
+				from, to, partition)
+			if err := network.topology.CreateEmptyPartitionWithDefaultConnection(partition); err != nil {
+				return stacktrace.Propagate(err, "Partition '%v' creation failed", partition)
+			}
+			createdPartitionToRemoveIfFailure[partition] = true
+		}
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		for partition := range createdPartitionToRemoveIfFailure {
+			if err := network.topology.RemovePartition(partition); err != nil {
+				logrus.Errorf("Partition '%s' was created as part of a SetDirectionalConnection call, but due to a "+
+					"failure it should be removed. Unfortunately, the removal failed for the following reason so the "+
+					"partition will remain in place:\n%v", partition, err.Error())
+			}
+		}
+	}()
+
+	wasDirectionalOverride, previousConnection, err := network.topology.GetDirectionalPartitionConnection(from, to)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to fetch current connection from '%s' to '%s'", from, to)
+	}
+
+	if err = network.topology.SetDirectionalConnection(from, to, connection); err != nil {
+		return stacktrace.Propagate(err, "Error setting the connection from '%s' to '%s'", from, to)
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		var resetConnectionErr error
+		if wasDirectionalOverride {
+			resetConnectionErr = network.topology.SetDirectionalConnection(from, to, previousConnection)
+		} else {
+			resetConnectionErr = network.topology.UnsetDirectionalConnection(from, to)
+		}
+		if resetConnectionErr != nil {
+			logrus.Errorf("A failure happened after setting the connection from '%s' to '%s', so it should "+
+				"be reset to its previous value. Unfortunately, an error happened trying to set it back to its "+
+				"previous value:\n%v", from, to, resetConnectionErr)
+		}
+	}()
+
+	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
+		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	isOperationSuccessful = true
+	return nil
+}
+
+// UnsetDirectionalConnection removes the override of the connection applying to the traffic going from partition
+// 'from' to partition 'to'. This traffic falls back to the connection set between the two partitions, if any, or to the
+// default connection
+func (network *DefaultServiceNetwork) UnsetDirectionalConnection(
+	ctx context.Context,
+	from service_network_types.PartitionID,
+	to service_network_types.PartitionID,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	isOperationSuccessful := false
+
+	if !network.isPartitionEnforcementEnabled() {
+		return stacktrace.NewError("Cannot unset connection; partitioning is not enabled")
+	}
+
+	currentPartitions, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	for _, partition := range []service_network_types.PartitionID{from, to} {
+		if _, found := currentPartitions[partition]; !found {
+			logrus.Warnf("Unsetting connection from '%s' to '%s' but '%s' isn't registered as a partition yet. This will no-op",
+				from, to, partition)
+			return nil
+		}
+	}
+
+	wasDirectionalOverride, previousConnection, err := network.topology.GetDirectionalPartitionConnection(from, to)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to retrieve current connection from '%s' to '%s'", from, to)
+	}
+	if !wasDirectionalOverride {
+		logrus.Debugf("Unsetting connection from '%s' to '%s' but no connection was set in this direction. This will no-op",
+			from, to)
+		return nil
+	}
+
+	if err = network.topology.UnsetDirectionalConnection(from, to); err != nil {
+		return stacktrace.Propagate(err, "Unsetting connection from '%s' to '%s' failed", from, to)
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		if resetConnectionErr := network.topology.SetDirectionalConnection(from, to, previousConnection); resetConnectionErr != nil {
+			logrus.Errorf("An error happened resetting the connection from '%s' to '%s' and Kurtosis could not roll back the operation. Error was:\n%v", from, to, resetConnectionErr)
+		}
+	}()
+
+	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
+		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	isOperationSuccessful = true
+	return nil
+}
+
+// SetServiceConnection overrides the connection between the two services, in both directions. This connection takes
+// precedence over the one set between their partitions, and also applies if the two services share a partition
+func (network *DefaultServiceNetwork) SetServiceConnection(
+	ctx context.Context,
+	service1 service.ServiceName,
+	service2 service.ServiceName,
+	connection partition_topology.PartitionConnection,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	isOperationSuccessful := false
+
+	if !network.isPartitionEnforcementEnabled() {
+		return stacktrace.NewError("Cannot set connection; partitioning is not enabled")
+	}
+	if err := network.validateConnectionCanBeEnforced(connection); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the connection between services '%s' and '%s'", service1, service2)
+	}
+	if err := network.validateServicesAreRegisteredUnlocked(service1, service2); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the connection between services '%s' and '%s'", service1, service2)
+	}
+
+	wasServiceOverride, previousConnection, err := network.topology.GetServiceConnection(service1, service2)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to fetch current connection between services '%s' and '%s'", service1, service2)
+	}
+
+	if err = network.topology.SetServiceConnection(service1, service2, connection); err != nil {
+		return stacktrace.Propagate(err, "Error setting the connection between services '%s' and '%s'", service1, service2)
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		var resetConnectionErr error
+		if wasServiceOverride {
+			resetConnectionErr = network.topology.SetServiceConnection(service1, service2, previousConnection)
+		} else {
+			resetConnectionErr = network.topology.UnsetServiceConnection(service1, service2)
+		}
+		if resetConnectionErr != nil {
+			logrus.Errorf("An error happened updating the connection between services '%s' and '%s' and Kurtosis could not roll back the operation. Error was:\n%v", service1, service2, resetConnectionErr)
+		}
+	}()
+
+	servicesToUpdate := map[service.ServiceName]bool{
+		service1: true,
+		service2: true,
+	}
+	if err = network.updateConnectionsFromTopology(ctx, servicesToUpdate); err != nil {
+		return stacktrace.Propagate(err, "Unable to update the connection between services '%s' and '%s'", service1, service2)
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
 	isOperationSuccessful = true
 	return nil
 }
 
+// UnsetServiceConnection unsets the connection override between the two services. The traffic between them falls
+// back to the connection set between their partitions
+func (network *DefaultServiceNetwork) UnsetServiceConnection(
+	ctx context.Context,
+	service1 service.ServiceName,
+	service2 service.ServiceName,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	isOperationSuccessful := false
+
+	if !network.isPartitionEnforcementEnabled() {
+		return stacktrace.NewError("Cannot unset connection; partitioning is not enabled")
+	}
+	if err := network.validateServicesAreRegisteredUnlocked(service1, service2); err != nil {
+		return stacktrace.Propagate(err, "Cannot unset the connection between services '%s' and '%s'", service1, service2)
+	}
+
+	wasServiceOverride, previousConnection, err := network.topology.GetServiceConnection(service1, service2)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to retrieve current connection between services '%s' and '%s'", service1, service2)
+	}
+	if !wasServiceOverride {
+		logrus.Debugf("Unsetting connection between services '%s' and '%s' but no connection was set between them. This will no-op",
+			service1, service2)
+		return nil
+	}
+
+	if err = network.topology.UnsetServiceConnection(service1, service2); err != nil {
+		return stacktrace.Propagate(err, "Unsetting connection between services '%s' and '%s' failed", service1, service2)
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		if resetConnectionErr := network.topology.SetServiceConnection(service1, service2, previousConnection); resetConnectionErr != nil {
+			logrus.Errorf("An error happened resetting the connection between services '%s' and '%s' and Kurtosis could not roll back the operation. Error was:\n%v", service1, service2, resetConnectionErr)
+		}
+	}()
+
+	servicesToUpdate := map[service.ServiceName]bool{
+		service1: true,
+		service2: true,
+	}
+	if err = network.updateConnectionsFromTopology(ctx, servicesToUpdate); err != nil {
+		return stacktrace.Propagate(err, "Unable to update the connection between services '%s' and '%s'", service1, service2)
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	isOperationSuccessful = true
+	return nil
+}
+
+// ScheduleConnectionChanges applies the changes in the background, each one once its offset has elapsed. The
+// changes go through SetConnection, UnsetConnection and their directional counterparts
+// This doesn't lock the network mutex as each change locks it when it gets applied
+func (network *DefaultServiceNetwork) ScheduleConnectionChanges(name string, changes []*connection_change_scheduler.ConnectionChange) error {
+	if !network.isPartitionEnforcementEnabled() {
+		return stacktrace.NewError("Cannot schedule connection changes; partitioning is not enabled")
+	}
+	for _, change := range changes {
+		if change.GetConnection() == nil {
+			continue
+		}
+		if err := network.validateConnectionCanBeEnforced(*change.GetConnection()); err != nil {
+			return stacktrace.Propagate(err, "Cannot schedule the connection change between '%s' and '%s'", change.GetSubnetwork1(), change.GetSubnetwork2())
+		}
+	}
+	if err := network.connectionChangeScheduler.Schedule(name, changes); err != nil {
+		return stacktrace.Propagate(err, "An error occurred scheduling connection changes '%s'", name)
+	}
+	return nil
+}
+
+// CancelConnectionChanges stops applying the changes scheduled under this name. The changes already applied stay in
+// place
+// This must not lock the network mutex as it waits for the change being applied, if any, to complete
+func (network *DefaultServiceNetwork) CancelConnectionChanges(name string) error {
+	if err := network.connectionChangeScheduler.Cancel(name); err != nil {
+		return stacktrace.Propagate(err, "An error occurred cancelling connection changes '%s'", name)
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) WatchConnectionChanges(name string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error) {
+	eventsChan, err := network.connectionChangeScheduler.Watch(name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred watching connection changes '%s'", name)
+	}
+	return eventsChan, nil
+}
+
 func (network *DefaultServiceNetwork) SetDefaultConnection(
 	ctx context.Context,
 	connection partition_topology.PartitionConnection,
@@ -310,9 +817,12 @@ func (network *DefaultServiceNetwork) SetDefaultConnection(
 	defer network.mutex.Unlock()
 	isOperationSuccessful := false
 
-	if !network.isPartitioningEnabled {
+	if !network.isPartitionEnforcementEnabled() {
 		return stacktrace.NewError("Cannot set default connection; partitioning is not enabled")
 	}
+	if err := network.validateConnectionCanBeEnforced(connection); err != nil {
+		return stacktrace.Propagate(err, "Cannot set the default connection")
+	}
 
 	previousDefaultConnection := network.topology.GetDefaultConnection()
 
@@ -327,6 +837,7 @@ func (network *DefaultServiceNetwork) SetDefaultConnection(
 	if err := network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
 	isOperationSuccessful = true
 	return nil
 }
@@ -359,7 +870,7 @@ func (network *DefaultServiceNetwork) StartService(
 // StartServices starts the services in the given partition in their own containers. It is a bulk operation, if a
 // single service fails to start, the entire batch is rolled back.
 //
-// This is a bulk operation that follows a sequential approach with no parallelization yet.
+// The containers of the services get started in parallel, at most batchSize at a time, without the network being locked
 // This function returns:
 //   - successfulService - mapping of successful service ids to service objects with info about that service when the
 //     entire batch of service could be started
@@ -375,30 +886,39 @@ func (network *DefaultServiceNetwork) StartServices(
 	map[service.ServiceName]error,
 	error,
 ) {
+	// The network is only locked while its state gets read or written, and not while the containers of the services
+	// get started, so that other operations, like starting other services, can run in the meantime
+	serviceSuccessfullyRegistered, failedServices, err := network.registerServicesToStart(ctx, serviceConfigs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(failedServices) > 0 {
+		return map[service.ServiceName]*service.Service{}, failedServices, nil
+	}
+	servicesToStart := map[service.ServiceUUID]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
+		servicesToStart[serviceRegistration.GetUUID()] = serviceConfigs[serviceName]
+	}
+
+	startedServicesPerUuid, failedServicePerUuid := network.startRegisteredServices(ctx, servicesToStart, batchSize)
+
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
+	defer func() {
+		for serviceName := range serviceConfigs {
+			delete(network.startingServiceConfigs, serviceName)
+		}
+	}()
 	batchSuccessfullyStarted := false
 	startedServices := map[service.ServiceName]*service.Service{}
-	failedServices := map[service.ServiceName]error{}
-
-	// Save the services currently running in enclave for later
-	currentlyRunningServicesInEnclave := map[service.ServiceName]bool{}
-	for serviceName := range network.registeredServiceInfo {
-		currentlyRunningServicesInEnclave[serviceName] = true
-	}
-
-	// We register all the services one by one
-	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
-	servicesToStart := map[service.ServiceUUID]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
-	for serviceName, serviceConfig := range serviceConfigs {
-		servicePartitionId := partition_topology.ParsePartitionId(serviceConfig.Subnetwork)
-		serviceRegistration, err := network.registerService(ctx, serviceName, servicePartitionId)
-		if err != nil {
-			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
+	areHostsFilesUpdated := false
+	defer func() {
+		if batchSuccessfullyStarted || !areHostsFilesUpdated {
+			return
 		}
-		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
-		servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
-	}
+		// runs once the services of the batch are gone, so that their hostnames get removed from the other services
+		network.updateHostsFilesFromTopologyOrWarn(context.Background())
+	}()
 	defer func() {
 		if batchSuccessfullyStarted {
 			return
@@ -407,23 +927,8 @@ func (network *DefaultServiceNetwork) StartServices(
 			if err := network.unregisterService(ctx, serviceName); err != nil {
 				logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
 			}
-		}
-	}()
-	if len(failedServices) > 0 {
-		return map[service.ServiceName]*service.Service{}, failedServices, nil
-	}
-
-	// We update the networking setup of the currently running services such that services starting won't be able
-	// to communicate to services they should not communicate with.
-	if network.isPartitioningEnabled && len(currentlyRunningServicesInEnclave) > 0 {
-		if err := network.updateConnectionsFromTopology(ctx, currentlyRunningServicesInEnclave); err != nil {
-			return nil, nil, stacktrace.Propagate(err, "Failure updating the network connections of the existing "+
-				"services prior to starting the new services. Starting the following services will be aborted: %v. "+
-				"Existing services in enclave: '%v'", serviceConfigs, currentlyRunningServicesInEnclave)
-		}
-	}
-
-	startedServicesPerUuid, failedServicePerUuid := network.startRegisteredServices(ctx, servicesToStart, batchSize)
+		}
+	}()
 
 	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
 		serviceUuid := serviceRegistration.GetUUID()
@@ -468,15 +973,39 @@ func (network *DefaultServiceNetwork) StartServices(
 		return nil, nil, stacktrace.NewError("This is a Kurtosis internal bug. The batch of services being started does not fit the number of services that were requested. (service started: '%v', requested: '%v')", result, requested)
 	}
 
+	// the whole batch is rolled back if the services can't be persisted, as they would be lost on an API container restart
+	for serviceName := range startedServices {
+		if err := network.setServiceConfigUnlocked(serviceName, serviceConfigs[serviceName]); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred storing the config of service '%s'", serviceName)
+		}
+	}
+
+	// a service whose hostnames can't resolve from the other services, or which can't resolve theirs, would silently
+	// misbehave, so the whole batch is rolled back instead
+	hostsFileUpdateFailures, err := network.updateHostsFilesFromTopology(ctx)
+	areHostsFilesUpdated = true
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred installing the hostnames of the services in their hosts files")
+	}
+	startedServiceNames := map[service.ServiceName]bool{}
+	for serviceName := range startedServices {
+		startedServiceNames[serviceName] = true
+	}
+	if hostnamesFailures := getHostnamesInstallationFailures(startedServiceNames, hostsFileUpdateFailures, network.serviceConfigs); len(hostnamesFailures) > emptyCollectionLength {
+		return map[service.ServiceName]*service.Service{}, hostnamesFailures, nil
+	}
+
 	for _, service := range startedServices {
 		serviceNameStr := string(service.GetRegistration().GetName())
 		serviceUuidStr := string(service.GetRegistration().GetUUID())
 		shortenedUuidStr := uuid_generator.ShortenedUUIDString(serviceUuidStr)
-		network.allExistingAndHistoricalIdentifiers = append(network.allExistingAndHistoricalIdentifiers, &kurtosis_core_rpc_api_bindings.ServiceIdentifiers{
+		if err := network.appendServiceIdentifiersUnlocked(&kurtosis_core_rpc_api_bindings.ServiceIdentifiers{
 			ServiceUuid:   serviceUuidStr,
 			Name:          serviceNameStr,
 			ShortenedUuid: shortenedUuidStr,
-		})
+		}); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred storing the identifiers of service '%s'", serviceNameStr)
+		}
 	}
 
 	batchSuccessfullyStarted = true
@@ -484,6 +1013,8 @@ func (network *DefaultServiceNetwork) StartServices(
 }
 
 // UpdateService This is purely called from a Starlark context so this only works with Names
+// Services whose container attributes (image, ports, cmd, etc.) get updated have their container re-created under the
+// same registration, so they keep their UUID, name and IP address
 func (network *DefaultServiceNetwork) UpdateService(
 	ctx context.Context,
 	updateServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig,
@@ -491,14 +1022,133 @@ func (network *DefaultServiceNetwork) UpdateService(
 	map[service.ServiceName]bool,
 	map[service.ServiceName]error,
 	error,
+) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	failedServicesPool := map[service.ServiceName]error{}
+	updatedServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	servicesToRecreate := map[service.ServiceName]bool{}
+	newSubnetworks := map[service.ServiceName]*string{}
+	for serviceName, updateServiceConfig := range updateServiceConfigs {
+		currentServiceConfig, found := network.serviceConfigs[serviceName]
+		if !found && doesUpdateRequireRecreatingContainer(updateServiceConfig) {
+			failedServicesPool[serviceName] = stacktrace.NewError("Error updating service '%s' as this service does not exist", serviceName)
+			continue
+		}
+		// the existence of the services only updated to another subnetwork is checked against the topology
+		newSubnetworks[serviceName] = updateServiceConfig.Subnetwork
+		if !found {
+			continue
+		}
+		updatedServiceConfigs[serviceName] = applyUpdateToServiceConfig(currentServiceConfig, updateServiceConfig)
+		servicesToRecreate[serviceName] = doesUpdateRequireRecreatingContainer(updateServiceConfig)
+	}
+	successfullyUpdatedService, failedServices, err := network.updateServicesUnlocked(ctx, updatedServiceConfigs, servicesToRecreate, newSubnetworks)
+	if err != nil {
+		return nil, nil, err
+	}
+	for serviceName, serviceErr := range failedServices {
+		failedServicesPool[serviceName] = serviceErr
+	}
+	successfullyUpdatedServiceNames := map[service.ServiceName]bool{}
+	for serviceName := range successfullyUpdatedService {
+		successfullyUpdatedServiceNames[serviceName] = true
+	}
+	return successfullyUpdatedServiceNames, failedServicesPool, nil
+}
+
+// RecreateServices replaces the config of running services with new configs. Their containers are re-created under
+// their existing registration, so they keep their UUID, name and IP address, and they are moved to the subnetwork of
+// their new config. It is used when a package declaring a different version of a running service is applied
+func (network *DefaultServiceNetwork) RecreateServices(
+	ctx context.Context,
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (
+	map[service.ServiceName]*service.Service,
+	map[service.ServiceName]error,
+	error,
+) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	failedServicesPool := map[service.ServiceName]error{}
+	serviceConfigsToRecreate := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	servicesToRecreate := map[service.ServiceName]bool{}
+	newSubnetworks := map[service.ServiceName]*string{}
+	for serviceName, serviceConfig := range serviceConfigs {
+		if _, found := network.registeredServiceInfo[serviceName]; !found {
+			failedServicesPool[serviceName] = stacktrace.NewError("Error re-creating service '%s' as this service does not exist", serviceName)
+			continue
+		}
+		serviceConfigsToRecreate[serviceName] = serviceConfig
+		servicesToRecreate[serviceName] = true
+		// a service declared without subnetwork belongs to the default one
+		newSubnetwork := string(partition_topology.ParsePartitionId(serviceConfig.Subnetwork))
+		newSubnetworks[serviceName] = &newSubnetwork
+	}
+	recreatedServices, failedServices, err := network.updateServicesUnlocked(ctx, serviceConfigsToRecreate, servicesToRecreate, newSubnetworks)
+	if err != nil {
+		return nil, nil, err
+	}
+	for serviceName, serviceErr := range failedServices {
+		failedServicesPool[serviceName] = serviceErr
+	}
+	return recreatedServices, failedServicesPool, nil
+}
+
+// updateServicesUnlocked updates the services to their new config, re-creating the containers of the services in
+// servicesToRecreate, and moves the services to their new subnetwork if it's not nil. It returns the updated services,
+// which are only set for the services which container got re-created
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) updateServicesUnlocked(
+	ctx context.Context,
+	updatedServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+	servicesToRecreate map[service.ServiceName]bool,
+	newSubnetworks map[service.ServiceName]*string,
+) (
+	map[service.ServiceName]*service.Service,
+	map[service.ServiceName]error,
+	error,
 ) {
 	failedServicesPool := map[service.ServiceName]error{}
-	successfullyUpdatedService := map[service.ServiceName]bool{}
+	successfullyUpdatedService := map[service.ServiceName]*service.Service{}
+	recreatedServices := map[service.ServiceName]*service.Service{}
+	previousServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+
+	for serviceName, updatedServiceConfig := range updatedServiceConfigs {
+		if !servicesToRecreate[serviceName] {
+			continue
+		}
+		previousServiceConfigs[serviceName] = network.serviceConfigs[serviceName]
+		recreatedService, err := network.recreateServiceUnlocked(ctx, serviceName, updatedServiceConfig)
+		if err != nil {
+			failedServicesPool[serviceName] = stacktrace.Propagate(err, "An error occurred re-creating the container of service '%s' with its updated config", serviceName)
+			continue
+		}
+		recreatedServices[serviceName] = recreatedService
+	}
+	// This runs after the partitions got rolled back below, so that the services re-created with their previous config
+	// get the traffic rules of the topology they are back to
+	defer func() {
+		rolledBackServiceNames := network.rollbackRecreatedServicesUnlocked(recreatedServices, successfullyUpdatedService, previousServiceConfigs)
+		if len(rolledBackServiceNames) == emptyCollectionLength {
+			return
+		}
+		// Use background context so the traffic rules get restored even if input context was cancelled
+		if err := network.updateConnectionsFromTopology(context.Background(), rolledBackServiceNames); err != nil {
+			logrus.Errorf("Services '%v' were re-created with their previous config after failing to be updated, but "+
+				"restoring the connections between them and the other services failed. Their traffic might not be "+
+				"shaped as the topology says. Error was:\n%v", rolledBackServiceNames, err)
+		}
+		network.updateHostsFilesFromTopologyOrWarn(context.Background())
+	}()
 
 	previousServicePartitions := map[service.ServiceName]service_network_types.PartitionID{}
 	partitionCreatedDuringThisOperation := map[service_network_types.PartitionID]bool{}
-	for serviceName, updateServiceConfig := range updateServiceConfigs {
-		if updateServiceConfig.Subnetwork == nil {
+	for serviceName, newSubnetwork := range newSubnetworks {
+		if _, found := failedServicesPool[serviceName]; found {
+			continue
+		}
+		if newSubnetwork == nil {
 			// nothing to do for this service
 			continue
 		}
@@ -515,7 +1165,7 @@ func (network *DefaultServiceNetwork) UpdateService(
 		}
 		previousServicePartitions[serviceName] = previousServicePartition
 
-		newServicePartition := partition_topology.ParsePartitionId(updateServiceConfig.Subnetwork)
+		newServicePartition := partition_topology.ParsePartitionId(newSubnetwork)
 		if newServicePartition == previousServicePartition {
 			// nothing to do for this service
 			continue
@@ -594,19 +1244,94 @@ func (network *DefaultServiceNetwork) UpdateService(
 	}()
 
 	if err := network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
-		// successfullyUpdatedService is still empty here, so all services will be rolled back to their previous partition
+		// successfullyUpdatedService is still empty here, so all services will be rolled back to their previous
+		// partition, and the re-created ones to their previous config
 		return nil, nil, stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
+	// the re-created services come with a new hosts file, and a service can be re-created with other hostnames
+	hostsFileUpdateFailures, err := network.updateHostsFilesFromTopology(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred installing the hostnames of the services in their hosts files")
+	}
+	recreatedServiceNames := map[service.ServiceName]bool{}
+	for serviceName := range recreatedServices {
+		recreatedServiceNames[serviceName] = true
+	}
+	for serviceName, hostnamesErr := range getHostnamesInstallationFailures(recreatedServiceNames, hostsFileUpdateFailures, network.serviceConfigs) {
+		// the service is then rolled back like the other services which failed to be updated
+		failedServicesPool[serviceName] = hostnamesErr
+	}
 
-	for serviceName := range updateServiceConfigs {
+	for serviceName := range newSubnetworks {
 		if _, found := failedServicesPool[serviceName]; found {
 			continue
 		}
-		successfullyUpdatedService[serviceName] = true
+		if updatedServiceConfig, found := updatedServiceConfigs[serviceName]; found {
+			if err := network.setServiceConfigUnlocked(serviceName, updatedServiceConfig); err != nil {
+				// the service is then rolled back like the other services which failed to be updated
+				failedServicesPool[serviceName] = stacktrace.Propagate(err, "An error occurred storing the updated config of service '%s'", serviceName)
+				continue
+			}
+		}
+		successfullyUpdatedService[serviceName] = recreatedServices[serviceName]
 	}
 	return successfullyUpdatedService, failedServicesPool, nil
 }
 
+// rollbackRecreatedServicesUnlocked re-creates the services whose container got re-created with their updated config
+// but which were not successfully updated in the end with their previous config, so that no service is left running
+// a config it was not updated to without the traffic rules of the enclave. It returns the names of the rolled back
+// services
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) rollbackRecreatedServicesUnlocked(
+	recreatedServices map[service.ServiceName]*service.Service,
+	successfullyUpdatedService map[service.ServiceName]*service.Service,
+	previousServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) map[service.ServiceName]bool {
+	rolledBackServiceNames := map[service.ServiceName]bool{}
+	for serviceName := range recreatedServices {
+		if _, found := successfullyUpdatedService[serviceName]; found {
+			continue
+		}
+		previousServiceConfig := previousServiceConfigs[serviceName]
+		if previousServiceConfig == nil {
+			logrus.Errorf("Service '%s' failed to be updated after its container got re-created, but its previous "+
+				"config is unknown so it can't be rolled back. The service will be left running its updated config", serviceName)
+			continue
+		}
+		// Use background context so the service gets rolled back even if input context was cancelled
+		if _, err := network.recreateServiceUnlocked(context.Background(), serviceName, previousServiceConfig); err != nil {
+			logrus.Errorf("Service '%s' failed to be updated after its container got re-created, and re-creating it "+
+				"with its previous config failed as well. Error was:\n%v", serviceName, err)
+			continue
+		}
+		rolledBackServiceNames[serviceName] = true
+	}
+	return rolledBackServiceNames
+}
+
+// RestartService re-creates the container of the service with the config it is currently running with, keeping its
+// UUID, name and IP address
+func (network *DefaultServiceNetwork) RestartService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+	}
+	serviceConfig, found := network.serviceConfigs[serviceName]
+	if !found {
+		return nil, stacktrace.NewError("No config was found for service '%s' to restart it with", serviceName)
+	}
+	restartedService, err := network.recreateServiceUnlocked(ctx, serviceName, serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred restarting service '%s'", serviceName)
+	}
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
+	return restartedService, nil
+}
+
 func (network *DefaultServiceNetwork) RemoveService(
 	ctx context.Context,
 	serviceIdentifier string,
@@ -630,38 +1355,15 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred while removing service '%v' from the network topology", serviceName)
 	}
 
-	network.cleanupInternalMapsUnlocked(serviceName)
-
-	// We stop the service, rather than destroying it, so that we can keep logs around
-	stopServiceFilters := &service.ServiceFilters{
-		Names: nil,
-		UUIDs: map[service.ServiceUUID]bool{
-			serviceUuid: true,
-		},
-		Statuses: nil,
-	}
-	_, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred during the call to stop service '%v'", serviceUuid)
-	}
-	if err, found := erroredUuids[serviceUuid]; found {
-		return "", stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
+	if err := network.cleanupInternalMapsUnlocked(serviceName); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred removing service '%v' from the enclave", serviceName)
 	}
 
-	sidecar, foundSidecar := network.networkingSidecars[serviceName]
-	if network.isPartitioningEnabled && foundSidecar {
-		// NOTE: As of 2020-12-31, we don't need to update the iptables of the other services in the network to
-		//  clear the now-removed service's IP because:
-		// 	 a) nothing is using it so it doesn't do anything and
-		//	 b) all service's iptables get overwritten on the next Add/Repartition call
-		// If we ever do incremental iptables though, we'll need to fix all the other service's iptables here!
-		if err := network.networkingSidecarManager.Remove(ctx, sidecar); err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred destroying the sidecar for service with name '%v'", serviceName)
-		}
-		delete(network.networkingSidecars, serviceName)
-		logrus.Debugf("Successfully removed sidecar attached to service with name '%v'", serviceName)
+	// We stop the service, rather than destroying it, so that we can keep logs around
+	if err := network.stopServiceAndRemoveSidecarUnlocked(ctx, serviceName, serviceUuid); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceName)
 	}
-
+	network.updateHostsFilesFromTopologyOrWarn(ctx)
 	return serviceUuid, nil
 }
 
@@ -708,28 +1410,12 @@ func (network *DefaultServiceNetwork) UnpauseService(ctx context.Context, servic
 }
 
 func (network *DefaultServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
-	// NOTE: This will block all other operations while this command is running!!!! We might need to change this so it's
-	// asynchronous
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
-
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	// The network is only locked while looking the service up, so that other operations can run while the command runs
+	serviceUuid, err := network.getServiceUuidForIdentifier(serviceIdentifier)
 	if err != nil {
-		return 0, "", stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
-	}
-
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return 0, "", stacktrace.NewError(
-			"Service '%v does not exist in the network",
-			serviceIdentifier,
-		)
+		return 0, "", stacktrace.Propagate(err, "An error occurred while getting the UUID of service '%v'", serviceIdentifier)
 	}
 
-	// NOTE: This is a SYNCHRONOUS command, meaning that the entire network will be blocked until the command finishes
-	// In the future, this will likely be insufficient
-
-	serviceUuid := serviceObj.GetUUID()
 	userServiceCommand := map[service.ServiceUUID][]string{
 		serviceUuid: command,
 	}
@@ -841,45 +1527,291 @@ func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIde
 	}
 	serviceObj, found := matchingServices[serviceUuid]
 	if !found {
-		return nil, stacktrace.NewError("Found exactly one service object, but it didn't match expected UUID '%v'", serviceUuid)
+		return nil, stacktrace.NewError("Found exactly one service object, but it didn't match expected UUID '%v'", serviceUuid)
+	}
+
+	return serviceObj, nil
+}
+
+// GetServiceLogs returns the last lines of the logs of the service, at most numLogLines of them
+func (network *DefaultServiceNetwork) GetServiceLogs(ctx context.Context, serviceIdentifier string, numLogLines int) ([]string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+	}
+	registration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return nil, stacktrace.NewError("No service with name '%v' exists in network", serviceName)
+	}
+	serviceUuid := registration.GetUUID()
+
+	serviceLogsFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	successfulServiceLogs, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceLogs(ctx, network.enclaveUuid, serviceLogsFilters, doNotFollowLogs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+	}
+	if err, found := erroredServiceUuids[serviceUuid]; found {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+	}
+	serviceLogs, found := successfulServiceLogs[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("No logs were returned for service '%v' but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
+	}
+	defer serviceLogs.Close()
+
+	var lastLogLines []string
+	scanner := bufio.NewScanner(serviceLogs)
+	for scanner.Scan() {
+		lastLogLines = append(lastLogLines, scanner.Text())
+		if len(lastLogLines) > numLogLines {
+			lastLogLines = lastLogLines[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs of service '%v'", serviceUuid)
+	}
+	return lastLogLines, nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
+
+	serviceNames := make(map[service.ServiceName]bool, len(network.registeredServiceInfo))
+
+	for serviceName := range network.registeredServiceInfo {
+		if _, ok := serviceNames[serviceName]; !ok {
+			serviceNames[serviceName] = true
+		}
+	}
+	return serviceNames
+}
+
+func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	// The network is only locked while looking the service up, so that other operations can run while the files get copied
+	serviceUuid, err := network.getServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while getting the UUID of service '%v'", serviceIdentifier)
+	}
+
+	filesArtifactUuid, err := network.copyFilesFromService(ctx, serviceUuid, srcPath, artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "There was an error in copying files over to disk")
+	}
+	return filesArtifactUuid, nil
+}
+
+// CaptureServiceTraffic captures the traffic of the service during the given duration, using its networking sidecar,
+// and writes it in pcap format to the output as it gets captured. The filter is a BPF expression and can be empty to
+// capture all the traffic
+// The network isn't locked while capturing, so that it remains usable during the capture
+func (network *DefaultServiceNetwork) CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error {
+	serviceName, sidecar, err := network.getNetworkingSidecarForServiceIdentifier(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "Cannot capture the traffic of service '%s'", serviceIdentifier)
+	}
+	if err = sidecar.CaptureTraffic(ctx, duration, filter, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred capturing the traffic of service '%s'", serviceName)
+	}
+	return nil
+}
+
+// StoreServiceTrafficCapture captures the traffic of the service like CaptureServiceTraffic does, and stores the
+// capture as a files artifact containing a single '<service name>.pcap' file
+func (network *DefaultServiceNetwork) StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	serviceName, sidecar, err := network.getNetworkingSidecarForServiceIdentifier(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Cannot capture the traffic of service '%s'", serviceIdentifier)
+	}
+	compressedPcap, err := captureTrafficToCompressedFile(ctx, serviceName, sidecar, duration, filter)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred capturing the traffic of service '%s'", serviceName)
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactUuid, err := network.uploadFilesArtifactUnlocked(compressedPcap, artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the traffic capture of service '%s' as files artifact '%s'", serviceName, artifactName)
+	}
+	return filesArtifactUuid, nil
+}
+
+// GetTrafficStatistics returns the counters of the traffic each service sent to each other service, by source and then
+// destination service name. If the service identifier is empty, all the services having a networking sidecar are
+// returned. Destinations are only accounted for separately when at least one connection impairs the traffic of the
+// source service, see NetworkingSidecarWrapper.GetTrafficStatistics
+func (network *DefaultServiceNetwork) GetTrafficStatistics(ctx context.Context, serviceIdentifier string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if !network.isPartitioningEnabled {
+		return nil, stacktrace.NewError("Traffic statistics are collected by the networking sidecars, which only run when network partitioning is enabled")
+	}
+
+	sourceServiceNames := map[service.ServiceName]bool{}
+	if serviceIdentifier == "" {
+		for serviceName := range network.networkingSidecars {
+			sourceServiceNames[serviceName] = true
+		}
+	} else {
+		serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+		}
+		if _, found := network.networkingSidecars[serviceName]; !found {
+			return nil, stacktrace.NewError("Service '%s' has no networking sidecar; it might not be running", serviceName)
+		}
+		sourceServiceNames[serviceName] = true
+	}
+
+	serviceNamePerIpAddress := map[string]service.ServiceName{}
+	for serviceName, registration := range network.registeredServiceInfo {
+		serviceNamePerIpAddress[registration.GetPrivateIP().String()] = serviceName
+		if registration.GetPrivateIPv6() != nil {
+			serviceNamePerIpAddress[registration.GetPrivateIPv6().String()] = serviceName
+		}
+	}
+
+	result := map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics{}
+	for sourceServiceName := range sourceServiceNames {
+		trafficStatisticsPerIpAddress, err := network.networkingSidecars[sourceServiceName].GetTrafficStatistics(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the traffic statistics of service '%s'", sourceServiceName)
+		}
+		trafficStatisticsPerDestination := map[service.ServiceName]*networking_sidecar.TrafficStatistics{}
+		for ipAddress, trafficStatistics := range trafficStatisticsPerIpAddress {
+			destinationServiceName, found := serviceNamePerIpAddress[ipAddress]
+			if !found {
+				logrus.Debugf("Service '%s' has traffic statistics for IP '%s' which doesn't belong to any registered service; ignoring them", sourceServiceName, ipAddress)
+				continue
+			}
+			// Services of IPv6 enclaves have one address per IP family, so the statistics of both get added up
+			if otherFamilyTrafficStatistics, found := trafficStatisticsPerDestination[destinationServiceName]; found {
+				trafficStatistics = &networking_sidecar.TrafficStatistics{
+					SentBytes:      trafficStatistics.SentBytes + otherFamilyTrafficStatistics.SentBytes,
+					SentPackets:    trafficStatistics.SentPackets + otherFamilyTrafficStatistics.SentPackets,
+					DroppedPackets: trafficStatistics.DroppedPackets + otherFamilyTrafficStatistics.DroppedPackets,
+				}
+			}
+			trafficStatisticsPerDestination[destinationServiceName] = trafficStatistics
+		}
+		result[sourceServiceName] = trafficStatisticsPerDestination
+	}
+	return result, nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	registration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return nil, false
+	}
+	return registration, true
+}
+
+func (network *DefaultServiceNetwork) GetServiceConfig(serviceName service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	serviceConfig, found := network.serviceConfigs[serviceName]
+	if !found {
+		return nil, false
 	}
-
-	return serviceObj, nil
+	return serviceConfig, true
 }
 
-func (network *DefaultServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
-
-	serviceNames := make(map[service.ServiceName]bool, len(network.registeredServiceInfo))
+// GetServicePartition returns the partition the service currently belongs to, and false if the service does not exist
+func (network *DefaultServiceNetwork) GetServicePartition(serviceName service.ServiceName) (service_network_types.PartitionID, bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	servicePartitions, err := network.topology.GetServicePartitions()
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred while fetching service partitions mapping")
+	}
+	servicePartition, found := servicePartitions[serviceName]
+	return servicePartition, found, nil
+}
 
-	for serviceName := range network.registeredServiceInfo {
-		if _, ok := serviceNames[serviceName]; !ok {
-			serviceNames[serviceName] = true
+// GetConnection returns the connection currently configured between the two partitions, along with a boolean set to
+// true if this connection is the default connection. Partitions that do not exist yet are connected through the
+// default connection
+func (network *DefaultServiceNetwork) GetConnection(
+	partition1 service_network_types.PartitionID,
+	partition2 service_network_types.PartitionID,
+) (bool, partition_topology.PartitionConnection, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	currentPartitions, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	for _, partition := range []service_network_types.PartitionID{partition1, partition2} {
+		if _, found := currentPartitions[partition]; !found {
+			return true, network.topology.GetDefaultConnection(), nil
 		}
 	}
-	return serviceNames
+	isDefaultConnection, connection, err := network.topology.GetPartitionConnection(partition1, partition2)
+	if err != nil {
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "Unable to fetch current connection between '%s' and '%s'", partition1, partition2)
+	}
+	return isDefaultConnection, connection, nil
 }
 
-func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+// GetDirectionalConnection returns the connection currently applying to the traffic going from partition 'from' to
+// partition 'to', along with a boolean set to true if this connection was set for this direction only. Partitions that
+// do not exist yet are connected through the default connection
+func (network *DefaultServiceNetwork) GetDirectionalConnection(
+	from service_network_types.PartitionID,
+	to service_network_types.PartitionID,
+) (bool, partition_topology.PartitionConnection, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	currentPartitions, err := network.topology.GetPartitionServices()
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting all partitions")
 	}
-
-	filesArtifactUuid, err := network.copyFilesFromServiceUnlocked(ctx, serviceName, srcPath, artifactName)
+	for _, partition := range []service_network_types.PartitionID{from, to} {
+		if _, found := currentPartitions[partition]; !found {
+			return false, network.topology.GetDefaultConnection(), nil
+		}
+	}
+	isDirectionalOverride, connection, err := network.topology.GetDirectionalPartitionConnection(from, to)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "There was an error in copying files over to disk")
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "Unable to fetch current connection from '%s' to '%s'", from, to)
 	}
-	return filesArtifactUuid, nil
+	return isDirectionalOverride, connection, nil
 }
 
-func (network *DefaultServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
+// GetServiceConnection returns the connection currently applying to the traffic between the two services, along with a
+// boolean set to true if this connection was set between the two services specifically
+func (network *DefaultServiceNetwork) GetServiceConnection(
+	service1 service.ServiceName,
+	service2 service.ServiceName,
+) (bool, partition_topology.PartitionConnection, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
-	registration, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return nil, false
+	if err := network.validateServicesAreRegisteredUnlocked(service1, service2); err != nil {
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "Cannot get the connection between services '%s' and '%s'", service1, service2)
 	}
-	return registration, true
+	isServiceOverride, connection, err := network.topology.GetServiceConnection(service1, service2)
+	if err != nil {
+		return false, partition_topology.ConnectionAllowed, stacktrace.Propagate(err, "Unable to fetch current connection between services '%s' and '%s'", service1, service2)
+	}
+	return isServiceOverride, connection, nil
+}
+
+func (network *DefaultServiceNetwork) GetDefaultConnection() partition_topology.PartitionConnection {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.topology.GetDefaultConnection()
 }
 
 func (network *DefaultServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
@@ -898,10 +1830,57 @@ func (network *DefaultServiceNetwork) UploadFilesArtifact(data []byte, artifactN
 	return filesArtifactUuid, nil
 }
 
+func (network *DefaultServiceNetwork) DoesFilesArtifactExist(artifactName string) (bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	return filesArtifactStore.CheckIfArtifactNameExists(artifactName), nil
+}
+
+// IsFilesArtifactUpToDate returns true if the files artifact with the given name exists and holds the exact same files
+// as the compressed data. The modification times of the files are ignored
+func (network *DefaultServiceNetwork) IsFilesArtifactUpToDate(compressedData []byte, artifactName string) (bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.isFilesArtifactUpToDateUnlocked(compressedData, artifactName)
+}
+
+// AreRenderedTemplatesUpToDate returns true if the files artifact with the given name exists and holds the exact same
+// files as the ones the templates would be rendered to
+func (network *DefaultServiceNetwork) AreRenderedTemplatesUpToDate(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	compressedRenderedTemplates, err := renderTemplatesToCompressedData(templatesAndDataByDestinationRelFilepath)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "There was an error in rendering templates")
+	}
+	return network.isFilesArtifactUpToDateUnlocked(compressedRenderedTemplates, artifactName)
+}
+
+func (network *DefaultServiceNetwork) RemoveFilesArtifact(artifactName string) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	if err = filesArtifactStore.RemoveFile(artifactName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing files artifact '%s'", artifactName)
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) IsNetworkPartitioningEnabled() bool {
 	return network.isPartitioningEnabled
 }
 
+func (network *DefaultServiceNetwork) CanBlockTrafficWithoutSidecars() bool {
+	return network.canBlockTrafficWithoutSidecars
+}
+
 func (network *DefaultServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
 	return network.allExistingAndHistoricalIdentifiers
 }
@@ -923,6 +1902,17 @@ func (network *DefaultServiceNetwork) GetUniqueNameForFileArtifact() (string, er
 // according to it.
 // if serviceNames is empty, it updates the connection for all the services within the enclave
 func (network *DefaultServiceNetwork) updateConnectionsFromTopology(ctx context.Context, serviceNames map[service.ServiceName]bool) error {
+	if network.canBlockTrafficWithoutSidecars {
+		// The blocked traffic is enforced by the backend as well, so that it stays blocked even if the traffic control
+		// of a service gets reset
+		if err := network.updateBlockedTrafficFromTopology(ctx); err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating the traffic blocked between the services")
+		}
+	}
+	if !network.isPartitioningEnabled {
+		return nil
+	}
+
 	availablePartitionConnectionConfigsPerServiceNames, err := network.topology.GetServicePartitionConnectionConfigByServiceName()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the packet loss configuration by service ID "+
@@ -953,6 +1943,176 @@ func (network *DefaultServiceNetwork) updateConnectionsFromTopology(ctx context.
 	return nil
 }
 
+// updateBlockedTrafficFromTopology makes the backend block the traffic going through the blocking connections of the
+// topology, between all the services. Nothing is sent to the backend if the blocked traffic didn't change
+// NOTE: This is not thread-safe, so it must be within a function that locks mutex!
+func (network *DefaultServiceNetwork) updateBlockedTrafficFromTopology(ctx context.Context) error {
+	connectionConfigsPerServiceName, err := network.topology.GetServicePartitionConnectionConfigByServiceName()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the connections between the services")
+	}
+
+	blockedTrafficPerIpAddress := map[string]map[string]bool{}
+	for serviceName, otherServiceConnectionConfigs := range connectionConfigsPerServiceName {
+		serviceRegistration, found := network.registeredServiceInfo[serviceName]
+		if !found {
+			return stacktrace.NewError("Service with name '%s' is in the topology but doesn't have service registration info (i.e. an IP) associated with it", serviceName)
+		}
+		blockedIpAddresses := map[string]bool{}
+		blockedIpv6Addresses := map[string]bool{}
+		for otherServiceName, connection := range otherServiceConnectionConfigs {
+			if !connection.IsBlocking() {
+				continue
+			}
+			otherServiceRegistration, found := network.registeredServiceInfo[otherServiceName]
+			if !found {
+				return stacktrace.NewError("Service with name '%s' is in the topology but doesn't have service registration info (i.e. an IP) associated with it", otherServiceName)
+			}
+			blockedIpAddresses[otherServiceRegistration.GetPrivateIP().String()] = true
+			if serviceRegistration.GetPrivateIPv6() != nil && otherServiceRegistration.GetPrivateIPv6() != nil {
+				blockedIpv6Addresses[otherServiceRegistration.GetPrivateIPv6().String()] = true
+			}
+		}
+		if len(blockedIpAddresses) > emptyCollectionLength {
+			blockedTrafficPerIpAddress[serviceRegistration.GetPrivateIP().String()] = blockedIpAddresses
+		}
+		if len(blockedIpv6Addresses) > emptyCollectionLength {
+			blockedTrafficPerIpAddress[serviceRegistration.GetPrivateIPv6().String()] = blockedIpv6Addresses
+		}
+	}
+
+	network.blockedTrafficLock.Lock()
+	defer network.blockedTrafficLock.Unlock()
+	if reflect.DeepEqual(blockedTrafficPerIpAddress, network.blockedTrafficPerIpAddress) {
+		return nil
+	}
+	if err = network.kurtosisBackend.UpdateEnclaveBlockedTraffic(ctx, network.enclaveUuid, blockedTrafficPerIpAddress); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the traffic blocked in the enclave")
+	}
+	network.blockedTrafficPerIpAddress = blockedTrafficPerIpAddress
+	return nil
+}
+
+// updateHostsFilesFromTopology writes the additional hostnames of the services to the hosts file of each service
+// allowed to reach them, so that they don't resolve from the services blocked from reaching them. Only the services
+// whose entries changed get their hosts file rewritten. It returns the services whose hosts file couldn't be written
+// (e.g. because their image has no shell), which get their hosts file rewritten again on the next update
+// NOTE: This is not thread-safe, so it must be within a function that locks mutex!
+func (network *DefaultServiceNetwork) updateHostsFilesFromTopology(ctx context.Context) (map[service.ServiceName]error, error) {
+	connectionConfigsPerServiceName, err := network.topology.GetServicePartitionConnectionConfigByServiceName()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the connections between the services")
+	}
+
+	network.hostsFileEntriesLock.Lock()
+	defer network.hostsFileEntriesLock.Unlock()
+	updateHostsFileCmdPerServiceUuid := map[service.ServiceUUID][]string{}
+	hostsFileEntriesPerServiceUuid := map[service.ServiceUUID][]string{}
+	serviceNamesPerUuid := map[service.ServiceUUID]service.ServiceName{}
+	for serviceName := range network.serviceConfigs {
+		serviceRegistration, found := network.registeredServiceInfo[serviceName]
+		if !found {
+			continue
+		}
+		hostsFileEntries := getHostsFileEntries(serviceName, connectionConfigsPerServiceName[serviceName], network.registeredServiceInfo, network.serviceConfigs)
+		if areHostsFileEntriesEqual(hostsFileEntries, network.hostsFileEntriesPerService[serviceName]) {
+			continue
+		}
+		serviceUuid := serviceRegistration.GetUUID()
+		updateHostsFileCmdPerServiceUuid[serviceUuid] = generateUpdateHostsFileCmd(hostsFileEntries)
+		hostsFileEntriesPerServiceUuid[serviceUuid] = hostsFileEntries
+		serviceNamesPerUuid[serviceUuid] = serviceName
+	}
+	failedServices := map[service.ServiceName]error{}
+	if len(updateHostsFileCmdPerServiceUuid) == emptyCollectionLength {
+		return failedServices, nil
+	}
+
+	successfulExecs, failedExecs, err := network.kurtosisBackend.RunUserServiceExecCommands(ctx, network.enclaveUuid, updateHostsFileCmdPerServiceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the hosts files of services '%v'", serviceNamesPerUuid)
+	}
+	for serviceUuid, execErr := range failedExecs {
+		failedServices[serviceNamesPerUuid[serviceUuid]] = stacktrace.Propagate(execErr, "An error occurred running the command updating the hosts file")
+	}
+	for serviceUuid, execResult := range successfulExecs {
+		serviceName := serviceNamesPerUuid[serviceUuid]
+		if execResult.GetExitCode() != 0 {
+			failedServices[serviceName] = stacktrace.NewError("The command updating the hosts file exited with code '%d'. Output was:\n%s", execResult.GetExitCode(), execResult.GetOutput())
+			continue
+		}
+		network.hostsFileEntriesPerService[serviceName] = hostsFileEntriesPerServiceUuid[serviceUuid]
+	}
+	return failedServices, nil
+}
+
+// updateHostsFilesFromTopologyOrWarn updates the hosts files of the services after the operations which don't fail
+// because of them, like removing a service or changing the connections, so a hosts file which can't be written only
+// logs a warning
+// NOTE: This is not thread-safe, so it must be within a function that locks mutex!
+func (network *DefaultServiceNetwork) updateHostsFilesFromTopologyOrWarn(ctx context.Context) {
+	failedServices, err := network.updateHostsFilesFromTopology(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred updating the hosts files of the services. The additional hostnames of the "+
+			"services might not resolve as expected. Error was:\n%v", err)
+		return
+	}
+	for serviceName, hostsFileErr := range failedServices {
+		logrus.Warnf("An error occurred updating the hosts file of service '%s'. The additional hostnames of the "+
+			"services might not resolve as expected from it. Error was:\n%v", serviceName, hostsFileErr)
+	}
+}
+
+// validateConnectionCanBeEnforced returns an error if the connection can't be enforced without the networking sidecars
+// while partitioning is disabled. Only the connections letting all the traffic through or blocking all of it can be
+func (network *DefaultServiceNetwork) validateConnectionCanBeEnforced(connection partition_topology.PartitionConnection) error {
+	if network.isPartitioningEnabled || !connection.IsSet() || connection.IsBlocking() {
+		return nil
+	}
+	return stacktrace.NewError("Only connections allowing or blocking all the traffic can be set when partitioning is " +
+		"disabled, as impairing the traffic requires the networking sidecars")
+}
+
+// isPartitionEnforcementEnabled returns true if the connections of the topology are enforced, either by the networking
+// sidecars or by the backend blocking the traffic
+func (network *DefaultServiceNetwork) isPartitionEnforcementEnabled() bool {
+	return network.isPartitioningEnabled || network.canBlockTrafficWithoutSidecars
+}
+
+// Returns the connections set in one direction only between the two partitions
+// NOTE: This is not thread-safe, so it must be within a function that locks mutex!
+func (network *DefaultServiceNetwork) getDirectionalConnectionOverridesUnlocked(
+	partition1 service_network_types.PartitionID,
+	partition2 service_network_types.PartitionID,
+) (map[partitionConnectionDirection]partition_topology.PartitionConnection, error) {
+	result := map[partitionConnectionDirection]partition_topology.PartitionConnection{}
+	for _, direction := range []partitionConnectionDirection{
+		{from: partition1, to: partition2},
+		{from: partition2, to: partition1},
+	} {
+		isDirectionalOverride, connection, err := network.topology.GetDirectionalPartitionConnection(direction.from, direction.to)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the connection from '%s' to '%s'", direction.from, direction.to)
+		}
+		if isDirectionalOverride {
+			result[direction] = connection
+		}
+	}
+	return result, nil
+}
+
+// NOTE: This is not thread-safe, so it must be within a function that locks mutex!
+func (network *DefaultServiceNetwork) restoreDirectionalConnectionOverridesUnlocked(
+	directionalConnections map[partitionConnectionDirection]partition_topology.PartitionConnection,
+) error {
+	for direction, connection := range directionalConnections {
+		if err := network.topology.SetDirectionalConnection(direction.from, direction.to, connection); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connection from '%s' to '%s'", direction.from, direction.to)
+		}
+	}
+	return nil
+}
+
 // Updates the traffic control configuration of the services with the given Names to match the target services packet loss configuration
 // NOTE: This is not thread-safe, so it must be within a function that locks mutex!
 func updateTrafficControlConfiguration(
@@ -974,6 +2134,9 @@ func updateTrafficControlConfiguration(
 		}
 
 		partitionConnectionConfigPerIpAddress[connectedService.GetPrivateIP().String()] = partitionConnectionConfig
+		if connectedService.GetPrivateIPv6() != nil {
+			partitionConnectionConfigPerIpAddress[connectedService.GetPrivateIPv6().String()] = partitionConnectionConfig
+		}
 	}
 
 	sidecar, found := networkingSidecars[serviceName]
@@ -992,6 +2155,89 @@ func updateTrafficControlConfiguration(
 	return nil
 }
 
+// registerServicesToStart registers the services about to be started and updates the networking setup of the
+// services currently running in the enclave accordingly. If one of the services fails to be registered, none of them
+// remains registered. The configs of the registered services are kept in startingServiceConfigs until they're started
+func (network *DefaultServiceNetwork) registerServicesToStart(
+	ctx context.Context,
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
+	error,
+) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	// Save the services currently running in enclave for later
+	currentlyRunningServicesInEnclave := map[service.ServiceName]bool{}
+	for serviceName := range network.registeredServiceInfo {
+		currentlyRunningServicesInEnclave[serviceName] = true
+	}
+
+	// the services being started by other calls already have their hostnames reserved
+	existingServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serviceConfig := range network.serviceConfigs {
+		existingServiceConfigs[serviceName] = serviceConfig
+	}
+	for serviceName, serviceConfig := range network.startingServiceConfigs {
+		existingServiceConfigs[serviceName] = serviceConfig
+	}
+	failedServices := validateServiceHostnames(currentlyRunningServicesInEnclave, existingServiceConfigs, serviceConfigs)
+	if len(failedServices) > 0 {
+		return nil, failedServices, nil
+	}
+
+	// We register all the services one by one
+	areServicesSuccessfullyRegistered := false
+	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
+	defer func() {
+		if areServicesSuccessfullyRegistered {
+			return
+		}
+		for serviceName := range serviceSuccessfullyRegistered {
+			if err := network.unregisterService(ctx, serviceName); err != nil {
+				logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
+			}
+		}
+	}()
+	for serviceName, serviceConfig := range serviceConfigs {
+		servicePartitionId := partition_topology.ParsePartitionId(serviceConfig.Subnetwork)
+		serviceRegistration, err := network.registerService(ctx, serviceName, servicePartitionId)
+		if err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
+			continue
+		}
+		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
+	}
+	if len(failedServices) > 0 {
+		return nil, failedServices, nil
+	}
+
+	// We update the networking setup of the currently running services such that services starting won't be able
+	// to communicate to services they should not communicate with.
+	if network.isPartitioningEnabled && len(currentlyRunningServicesInEnclave) > 0 {
+		if err := network.updateConnectionsFromTopology(ctx, currentlyRunningServicesInEnclave); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Failure updating the network connections of the existing "+
+				"services prior to starting the new services. Starting the following services will be aborted: %v. "+
+				"Existing services in enclave: '%v'", serviceConfigs, currentlyRunningServicesInEnclave)
+		}
+	} else if network.canBlockTrafficWithoutSidecars {
+		// The new services already have an IP, so the traffic they shouldn't send or receive gets blocked before they start
+		if err := network.updateBlockedTrafficFromTopology(ctx); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Failure updating the network connections of the existing "+
+				"services prior to starting the new services. Starting the following services will be aborted: %v. "+
+				"Existing services in enclave: '%v'", serviceConfigs, currentlyRunningServicesInEnclave)
+		}
+	}
+
+	for serviceName, serviceConfig := range serviceConfigs {
+		network.startingServiceConfigs[serviceName] = serviceConfig
+	}
+	areServicesSuccessfullyRegistered = true
+	return serviceSuccessfullyRegistered, map[service.ServiceName]error{}, nil
+}
+
 // registerService handles all the operations necessary to register a service before is can be started with
 // startRegisteredService. If something fails along the way, the function takes care of rolling back the previous
 // changes such that the enclave remains in the state before the call
@@ -1079,8 +2325,14 @@ func (network *DefaultServiceNetwork) registerService(
 		if serviceSuccessfullyRegistered {
 			return
 		}
-		network.cleanupInternalMapsUnlocked(serviceName)
+		if err := network.cleanupInternalMapsUnlocked(serviceName); err != nil {
+			logrus.Errorf("An error occurred removing service '%s' from the enclave after it failed to be registered. "+
+				"It might be reloaded if the API container restarts. Error was:\n%v", serviceName, err)
+		}
 	}()
+	if err = network.serviceRegistrationsBucket.AddServiceRegistration(serviceRegistration); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred persisting the registration of service '%s'", serviceName)
+	}
 
 	err = network.addServiceToTopology(serviceName, partitionId)
 	if err != nil {
@@ -1133,7 +2385,9 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 		return stacktrace.NewError("Unregistering a service that has not been properly registered should not happen: '%s'. This is a Kurtosis internal bug", serviceName)
 	}
 
-	network.cleanupInternalMapsUnlocked(serviceName)
+	if err := network.cleanupInternalMapsUnlocked(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing service '%s' from the enclave", serviceName)
+	}
 	serviceUuid := serviceRegistration.GetUUID()
 	serviceToUnregister := map[service.ServiceUUID]bool{
 		serviceUuid: true,
@@ -1157,6 +2411,7 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 // - converting files artifacts mountpoints to FilesArtifactsExpansion's'
 // - passing down other data (eg. container image name, args, etc.)
 // If network partitioning is enabled, it also takes care of starting the sidecar corresponding to this service
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) startRegisteredService(
 	ctx context.Context,
 	serviceUuid service.ServiceUUID,
@@ -1165,7 +2420,27 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 	*service.Service,
 	error,
 ) {
-	serviceStartedSuccessfully := false
+	startedService, err := network.startRegisteredServiceContainer(ctx, serviceUuid, serviceConfigApi)
+	if err != nil {
+		return nil, err
+	}
+	if err = network.setUpStartedServiceNetworkingUnlocked(ctx, startedService); err != nil {
+		network.destroyServiceContainer(serviceUuid)
+		return nil, err
+	}
+	return startedService, nil
+}
+
+// startRegisteredServiceContainer starts the container of the registered service. It doesn't read nor write the state
+// of the network, so it can be called without the network being locked
+func (network *DefaultServiceNetwork) startRegisteredServiceContainer(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	serviceConfigApi *kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (
+	*service.Service,
+	error,
+) {
 	var serviceConfig *service.ServiceConfig
 
 	// Docker and K8s requires the minimum memory limit to be 6 megabytes to we make sure the allocation is at least that amount
@@ -1264,45 +2539,48 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 	if !isSuccessful {
 		return nil, stacktrace.NewError("Service '%s' did not start properly but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
 	}
-	defer func() {
-		if serviceStartedSuccessfully {
-			return
-		}
-		serviceToDestroyUuid := startedService.GetRegistration().GetUUID()
-		userServiceFilters := &service.ServiceFilters{
-			Names: nil,
-			UUIDs: map[service.ServiceUUID]bool{
-				serviceToDestroyUuid: true,
-			},
-			Statuses: nil,
-		}
-		_, failedToDestroyUuids, err := network.kurtosisBackend.DestroyUserServices(context.Background(), network.enclaveUuid, userServiceFilters)
-		if err != nil {
-			logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, err)
-			return
-		}
-		if failedToDestroyErr, found := failedToDestroyUuids[serviceToDestroyUuid]; found {
-			logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, failedToDestroyErr)
-		}
-	}()
+	return startedService, nil
+}
 
-	// if partition is enabled, create a sidecar associated with this service
-	if network.isPartitioningEnabled {
-		if err := network.createSidecarAndAddToMap(ctx, startedService); err != nil {
-			return nil, stacktrace.Propagate(err, "Error creating sidecar for service '%s'", serviceUuid)
-		}
-		serviceNameSet := map[service.ServiceName]bool{
-			startedService.GetRegistration().GetName(): true,
-		}
-		// update the connection for this service only
-		if err := network.updateConnectionsFromTopology(ctx, serviceNameSet); err != nil {
-			return nil, stacktrace.Propagate(err, "Error updating the networking rules for this service '%s' (UUID: '%s')", startedService.GetRegistration().GetName(), serviceUuid)
-		}
-		logrus.Debugf("Successfully created sidecars for service with ID '%v'", serviceUuid)
+// setUpStartedServiceNetworkingUnlocked creates the sidecar associated with the started service and configures its
+// connections if partitioning is enabled
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) setUpStartedServiceNetworkingUnlocked(ctx context.Context, startedService *service.Service) error {
+	if !network.isPartitioningEnabled {
+		return nil
+	}
+	serviceUuid := startedService.GetRegistration().GetUUID()
+	if err := network.createSidecarAndAddToMap(ctx, startedService); err != nil {
+		return stacktrace.Propagate(err, "Error creating sidecar for service '%s'", serviceUuid)
+	}
+	serviceNameSet := map[service.ServiceName]bool{
+		startedService.GetRegistration().GetName(): true,
+	}
+	// update the connection for this service only
+	if err := network.updateConnectionsFromTopology(ctx, serviceNameSet); err != nil {
+		return stacktrace.Propagate(err, "Error updating the networking rules for this service '%s' (UUID: '%s')", startedService.GetRegistration().GetName(), serviceUuid)
 	}
+	logrus.Debugf("Successfully created sidecars for service with ID '%v'", serviceUuid)
+	return nil
+}
 
-	serviceStartedSuccessfully = true
-	return startedService, nil
+// destroyServiceContainer destroys the container of a service that failed to be started
+func (network *DefaultServiceNetwork) destroyServiceContainer(serviceToDestroyUuid service.ServiceUUID) {
+	userServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceToDestroyUuid: true,
+		},
+		Statuses: nil,
+	}
+	_, failedToDestroyUuids, err := network.kurtosisBackend.DestroyUserServices(context.Background(), network.enclaveUuid, userServiceFilters)
+	if err != nil {
+		logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, err)
+		return
+	}
+	if failedToDestroyErr, found := failedToDestroyUuids[serviceToDestroyUuid]; found {
+		logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, failedToDestroyErr)
+	}
 }
 
 // destroyService is the opposite of startRegisteredService. It removes a started service from the enclave. Note that it does not
@@ -1332,6 +2610,9 @@ func (network *DefaultServiceNetwork) destroyService(ctx context.Context, servic
 	networkingSidecar, found := network.networkingSidecars[serviceName]
 	if found {
 		delete(network.networkingSidecars, serviceName)
+		if err = network.removePersistedNetworkingSidecarUnlocked(serviceName); errorResult == nil && err != nil {
+			errorResult = stacktrace.Propagate(err, "Attempted to forget the sidecar for service with name '%s' but an error occurred.", serviceName)
+		}
 		err = network.networkingSidecarManager.Remove(ctx, networkingSidecar)
 		if errorResult == nil && err != nil {
 			errorResult = stacktrace.Propagate(err, "Attempted to clean up the sidecar for service with name '%s' but an error occurred.", serviceName)
@@ -1340,6 +2621,93 @@ func (network *DefaultServiceNetwork) destroyService(ctx context.Context, servic
 	return errorResult
 }
 
+// recreateServiceUnlocked stops the service and starts it again with the given config under its existing registration.
+// If this fails and the config differs from the previous one, the service is started again with its previous config
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) recreateServiceUnlocked(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (*service.Service, error) {
+	serviceRegistration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return nil, stacktrace.NewError("Service '%s' cannot be re-created as it is not registered in the enclave", serviceName)
+	}
+	serviceUuid := serviceRegistration.GetUUID()
+	previousServiceConfig := network.serviceConfigs[serviceName]
+
+	if err := network.stopServiceAndRemoveSidecarUnlocked(ctx, serviceName, serviceUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping service '%s' before re-creating it", serviceName)
+	}
+
+	startPreviousServiceConfig := func() {
+		if previousServiceConfig == nil || previousServiceConfig == serviceConfig {
+			return
+		}
+		if _, rollbackErr := network.startRegisteredService(ctx, serviceUuid, previousServiceConfig); rollbackErr != nil {
+			logrus.Errorf("Service '%s' failed to be re-created and starting it again with its previous config "+
+				"failed as well. The service will be left stopped. Error was:\n%v", serviceName, rollbackErr)
+		}
+	}
+
+	startedService, err := network.startRegisteredService(ctx, serviceUuid, serviceConfig)
+	if err != nil {
+		startPreviousServiceConfig()
+		return nil, stacktrace.Propagate(err, "An error occurred starting service '%s' again", serviceName)
+	}
+	if err = network.setServiceConfigUnlocked(serviceName, serviceConfig); err != nil {
+		// the service must not keep running a config which would be lost if the API container restarts
+		if stopErr := network.stopServiceAndRemoveSidecarUnlocked(ctx, serviceName, serviceUuid); stopErr != nil {
+			logrus.Errorf("The config of re-created service '%s' failed to be persisted and stopping it failed as "+
+				"well. The service will keep running its new config. Error was:\n%v", serviceName, stopErr)
+		} else {
+			startPreviousServiceConfig()
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred storing the config of re-created service '%s'", serviceName)
+	}
+	// the new container comes with a new hosts file
+	network.forgetHostsFileEntriesUnlocked(serviceName)
+	return startedService, nil
+}
+
+// stopServiceAndRemoveSidecarUnlocked stops the service container, keeping its registration, and removes the
+// networking sidecar attached to it if any
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) stopServiceAndRemoveSidecarUnlocked(ctx context.Context, serviceName service.ServiceName, serviceUuid service.ServiceUUID) error {
+	stopServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	_, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred during the call to stop service '%v'", serviceUuid)
+	}
+	if err, found := erroredUuids[serviceUuid]; found {
+		return stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
+	}
+
+	sidecar, foundSidecar := network.networkingSidecars[serviceName]
+	if network.isPartitioningEnabled && foundSidecar {
+		// NOTE: As of 2020-12-31, we don't need to update the iptables of the other services in the network to
+		//  clear the now-removed service's IP because:
+		// 	 a) nothing is using it so it doesn't do anything and
+		//	 b) all service's iptables get overwritten on the next Add/Repartition call
+		// If we ever do incremental iptables though, we'll need to fix all the other service's iptables here!
+		if err := network.networkingSidecarManager.Remove(ctx, sidecar); err != nil {
+			return stacktrace.Propagate(err, "An error occurred destroying the sidecar for service with name '%v'", serviceName)
+		}
+		delete(network.networkingSidecars, serviceName)
+		if err := network.removePersistedNetworkingSidecarUnlocked(serviceName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred forgetting the sidecar of service with name '%v'", serviceName)
+		}
+		logrus.Debugf("Successfully removed sidecar attached to service with name '%v'", serviceName)
+	}
+	return nil
+}
+
 // startRegisteredServices starts multiple services in parallel
 //
 // It iterates over all the services to start and kicks off a go subroutine for each of them.
@@ -1371,7 +2739,10 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 		serviceToStartUuid := serviceUuid
 		serviceToStartConfig := serviceConfig
 
-		if len(failedServices) > 0 {
+		mapWriteMutex.Lock()
+		hasAServiceFailed := len(failedServices) > 0
+		mapWriteMutex.Unlock()
+		if hasAServiceFailed {
 			// stop scheduling more service start
 			// as one already failed, the full batch will be reverted anyway so no need to continue any further
 			break
@@ -1389,7 +2760,7 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 				<-concurrencyControlChan
 			}()
 			logrus.Debugf("Starting service '%s'", serviceToStartUuid)
-			startedService, err := network.startRegisteredService(ctx, serviceToStartUuid, serviceToStartConfig)
+			startedService, err := network.startRegisteredServiceWithoutLock(ctx, serviceToStartUuid, serviceToStartConfig)
 			mapWriteMutex.Lock()
 			defer mapWriteMutex.Unlock()
 			if err != nil {
@@ -1407,14 +2778,32 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 	return startedServices, failedServices
 }
 
-// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
-func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return "", stacktrace.NewError("Cannot copy files from service '%v' because it does not exist in the network", serviceName)
+// startRegisteredServiceWithoutLock is the counterpart of startRegisteredService for the callers that don't hold the
+// network lock: the container gets started without the network being locked, and the network only gets locked while
+// the networking of the service gets set up
+func (network *DefaultServiceNetwork) startRegisteredServiceWithoutLock(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	serviceConfigApi *kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (
+	*service.Service,
+	error,
+) {
+	startedService, err := network.startRegisteredServiceContainer(ctx, serviceUuid, serviceConfigApi)
+	if err != nil {
+		return nil, err
+	}
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	if err = network.setUpStartedServiceNetworkingUnlocked(ctx, startedService); err != nil {
+		network.destroyServiceContainer(serviceUuid)
+		return nil, err
 	}
-	serviceUuid := serviceObj.GetUUID()
+	return startedService, nil
+}
 
+// copyFilesFromService doesn't read nor write the state of the network, so it can be called without the network being locked
+func (network *DefaultServiceNetwork) copyFilesFromService(ctx context.Context, serviceUuid service.ServiceUUID, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	store, err := network.enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred getting the files artifact store")
@@ -1506,6 +2895,25 @@ func (network *DefaultServiceNetwork) moveServiceToPartitionInTopology(serviceNa
 	if !found {
 		return stacktrace.NewError("Service with name '%s' not found in the topology", serviceName)
 	}
+	// removing the service from the topology drops its service connections, they're set back once the service is moved
+	serviceConnectionsToRestore := map[service_network_types.ServiceConnectionID]partition_topology.PartitionConnection{}
+	allServiceConnections, err := network.topology.GetServiceConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching the service connections")
+	}
+	for serviceConnectionId, connection := range allServiceConnections {
+		if serviceConnectionId.GetFirst() == serviceName || serviceConnectionId.GetSecond() == serviceName {
+			serviceConnectionsToRestore[serviceConnectionId] = connection
+		}
+	}
+	restoreServiceConnections := func() error {
+		for serviceConnectionId, connection := range serviceConnectionsToRestore {
+			if err := network.topology.SetServiceConnection(serviceConnectionId.GetFirst(), serviceConnectionId.GetSecond(), connection); err != nil {
+				return stacktrace.Propagate(err, "An error occurred restoring the connection between services '%s' and '%s'", serviceConnectionId.GetFirst(), serviceConnectionId.GetSecond())
+			}
+		}
+		return nil
+	}
 	err = network.topology.RemoveService(serviceName)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while removing service '%v' from the network topology", serviceName)
@@ -1518,14 +2926,30 @@ func (network *DefaultServiceNetwork) moveServiceToPartitionInTopology(serviceNa
 			logrus.Errorf("Service '%s' could not be moved to partition '%s'. It should have been rolled back to its previous partition '%s' but this operation failed", serviceName, partitionID, serviceCurrentPartition)
 			return
 		}
+		if err := restoreServiceConnections(); err != nil {
+			logrus.Errorf("Service '%s' was rolled back to its previous partition '%s' but its service connections could not be restored. Error was:\n%v", serviceName, serviceCurrentPartition, err)
+		}
 	}()
 	if err := network.topology.AddService(serviceName, partitionID); err != nil {
 		return stacktrace.Propagate(err, "Error moving service '%s' to its new partition '%s'", serviceName, partitionID)
 	}
+	if err := restoreServiceConnections(); err != nil {
+		return stacktrace.Propagate(err, "Error moving the service connections of service '%s' to its new partition '%s'", serviceName, partitionID)
+	}
 	isOperationSuccessful = true
 	return nil
 }
 
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) validateServicesAreRegisteredUnlocked(serviceNames ...service.ServiceName) error {
+	for _, serviceName := range serviceNames {
+		if _, found := network.registeredServiceInfo[serviceName]; !found {
+			return stacktrace.NewError("No service found with name '%v'", serviceName)
+		}
+	}
+	return nil
+}
+
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) createSidecarAndAddToMap(ctx context.Context, service *service.Service) error {
 	serviceRegistration := service.GetRegistration()
@@ -1562,6 +2986,10 @@ func (network *DefaultServiceNetwork) createSidecarAndAddToMap(ctx context.Conte
 		return stacktrace.Propagate(err, "An error occurred initializing the newly-created networking-sidecar-traffic-control-qdisc-configuration for service `%v`", serviceName)
 	}
 
+	if err := network.networkingSidecarsBucket.AddNetworkingSidecar(serviceName, serviceUUID); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the networking sidecar of service `%v`", serviceName)
+	}
+
 	shouldRemoveSidecarFromMap = false
 	shouldRemoveSidecarFromManager = false
 	return nil
@@ -1569,9 +2997,73 @@ func (network *DefaultServiceNetwork) createSidecarAndAddToMap(ctx context.Conte
 
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	compressedFile, err := renderTemplatesToCompressedData(templatesAndDataByDestinationRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "There was an error in rendering templates")
+	}
+
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	filesArtifactUuid, err := store.StoreFile(bytes.NewReader(compressedFile), artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while storing the file '%v' in the files artifact store", compressedFile)
+	}
+	shouldDeleteFilesArtifact := true
+	defer func() {
+		if shouldDeleteFilesArtifact {
+			if err = store.RemoveFile(string(filesArtifactUuid)); err != nil {
+				logrus.Errorf("We tried to clean up the files artifact '%v' we had stored but failed:\n%v", artifactName, err)
+			}
+		}
+	}()
+
+	shouldDeleteFilesArtifact = false
+	return filesArtifactUuid, nil
+}
+
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) isFilesArtifactUpToDateUnlocked(compressedData []byte, artifactName string) (bool, error) {
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	if !store.CheckIfArtifactNameExists(artifactName) {
+		return false, nil
+	}
+	filesArtifact, err := store.GetFile(artifactName)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting files artifact '%s'", artifactName)
+	}
+	storedCompressedData, err := os.ReadFile(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading files artifact '%s'", artifactName)
+	}
+	storedFilesContent, err := getFilesContentFromCompressedData(storedCompressedData)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading the content of files artifact '%s'", artifactName)
+	}
+	filesContent, err := getFilesContentFromCompressedData(compressedData)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading the content of the files to compare with files artifact '%s'", artifactName)
+	}
+	if len(storedFilesContent) != len(filesContent) {
+		return false, nil
+	}
+	for filepath, fileContent := range filesContent {
+		storedFileContent, found := storedFilesContent[filepath]
+		if !found || storedFileContent != fileContent {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func renderTemplatesToCompressedData(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData) ([]byte, error) {
 	tempDirForRenderedTemplates, err := os.MkdirTemp("", tempDirForRenderedTemplatesPrefix)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while creating a temp dir for rendered templates '%v'", tempDirForRenderedTemplates)
+		return nil, stacktrace.Propagate(err, "An error occurred while creating a temp dir for rendered templates '%v'", tempDirForRenderedTemplates)
 	}
 	defer os.RemoveAll(tempDirForRenderedTemplates)
 
@@ -1590,39 +3082,105 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 
 		var templateData interface{}
 		if err = decoder.Decode(&templateData); err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred while decoding the template data json '%v' for file '%v'", templateDataAsJson, destinationRelFilepath)
+			return nil, stacktrace.Propagate(err, "An error occurred while decoding the template data json '%v' for file '%v'", templateDataAsJson, destinationRelFilepath)
 		}
 
 		destinationFilepath := path.Join(tempDirForRenderedTemplates, destinationRelFilepath)
 		if err = renderTemplateToFile(templateAsAString, templateData, destinationFilepath); err != nil {
-			return "", stacktrace.Propagate(err, "There was an error in rendering template for file '%v'", destinationRelFilepath)
+			return nil, stacktrace.Propagate(err, "There was an error in rendering template for file '%v'", destinationRelFilepath)
 		}
 	}
 
 	compressedFile, err := shared_utils.CompressPath(tempDirForRenderedTemplates, ensureCompressedFileIsLesserThanGRPCLimit)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "There was an error compressing dir '%v'", tempDirForRenderedTemplates)
+		return nil, stacktrace.Propagate(err, "There was an error compressing dir '%v'", tempDirForRenderedTemplates)
 	}
+	return compressedFile, nil
+}
 
-	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+// getNetworkingSidecarForServiceIdentifier locks the network only while looking the sidecar up, so that the sidecar
+// can then be used for long-running operations without blocking the network
+func (network *DefaultServiceNetwork) getNetworkingSidecarForServiceIdentifier(serviceIdentifier string) (service.ServiceName, networking_sidecar.NetworkingSidecarWrapper, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if !network.isPartitioningEnabled {
+		return "", nil, stacktrace.NewError("Capturing traffic requires the networking sidecars, which only run when network partitioning is enabled")
+	}
+	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+		return "", nil, stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
 	}
-	filesArtifactUuid, err := store.StoreFile(bytes.NewReader(compressedFile), artifactName)
+	sidecar, found := network.networkingSidecars[serviceName]
+	if !found {
+		return "", nil, stacktrace.NewError("Service '%s' has no networking sidecar; it might not be running", serviceName)
+	}
+	return serviceName, sidecar, nil
+}
+
+// captureTrafficToCompressedFile streams the capture to a single '<service name>.pcap' file on disk, and compresses it
+// the same way files artifacts get compressed
+func captureTrafficToCompressedFile(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	sidecar networking_sidecar.NetworkingSidecarWrapper,
+	duration time.Duration,
+	filter string,
+) ([]byte, error) {
+	tempDirForTrafficCapture, err := os.MkdirTemp("", tempDirForTrafficCapturePrefix)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while storing the file '%v' in the files artifact store", compressedFile)
+		return nil, stacktrace.Propagate(err, "An error occurred while creating a temp dir for the traffic capture")
 	}
-	shouldDeleteFilesArtifact := true
-	defer func() {
-		if shouldDeleteFilesArtifact {
-			if err = store.RemoveFile(string(filesArtifactUuid)); err != nil {
-				logrus.Errorf("We tried to clean up the files artifact '%v' we had stored but failed:\n%v", artifactName, err)
-			}
-		}
-	}()
+	defer os.RemoveAll(tempDirForTrafficCapture)
 
-	shouldDeleteFilesArtifact = false
-	return filesArtifactUuid, nil
+	pcapFilepath := path.Join(tempDirForTrafficCapture, string(serviceName)+trafficCaptureFileExtension)
+	pcapFile, err := os.OpenFile(pcapFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, trafficCaptureFilePermissions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the traffic capture file '%s'", pcapFilepath)
+	}
+	captureErr := sidecar.CaptureTraffic(ctx, duration, filter, pcapFile)
+	if err = pcapFile.Close(); err != nil && captureErr == nil {
+		return nil, stacktrace.Propagate(err, "An error occurred closing the traffic capture file '%s'", pcapFilepath)
+	}
+	if captureErr != nil {
+		return nil, stacktrace.Propagate(captureErr, "An error occurred writing the traffic capture to '%s'", pcapFilepath)
+	}
+
+	compressedFile, err := shared_utils.CompressPath(tempDirForTrafficCapture, ensureCompressedFileIsLesserThanGRPCLimit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "There was an error compressing dir '%v'", tempDirForTrafficCapture)
+	}
+	return compressedFile, nil
+}
+
+// getFilesContentFromCompressedData returns the content of each regular file of a compressed files artifact, keyed by
+// its path inside the files artifact
+func getFilesContentFromCompressedData(compressedData []byte) (map[string]string, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressedData))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a gzip reader for the compressed data")
+	}
+	defer gzipReader.Close()
+	filesContent := map[string]string{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the compressed data")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		fileContent, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%s' from the compressed data", header.Name)
+		}
+		filesContent[header.Name] = string(fileContent)
+	}
+	return filesContent, nil
 }
 
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
@@ -1642,16 +3200,123 @@ func (network *DefaultServiceNetwork) uploadFilesArtifactUnlocked(data []byte, a
 	return filesArtifactUuid, nil
 }
 
+// cleanupInternalMapsUnlocked forgets the service, in the enclave database and in memory. The service is kept in memory
+// if it can't be removed from the enclave database, so that both stay consistent
 // This isn't thread safe and must be called from a thread safe context
-func (network *DefaultServiceNetwork) cleanupInternalMapsUnlocked(serviceName service.ServiceName) {
+func (network *DefaultServiceNetwork) cleanupInternalMapsUnlocked(serviceName service.ServiceName) error {
 	_, found := network.registeredServiceInfo[serviceName]
 	if !found {
-		return
+		return nil
+	}
+	if err := network.serviceConfigsBucket.RemoveServiceConfig(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the config of service '%s' from the enclave database", serviceName)
+	}
+	if err := network.serviceRegistrationsBucket.RemoveServiceRegistration(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the registration of service '%s' from the enclave database", serviceName)
 	}
 	delete(network.registeredServiceInfo, serviceName)
+	delete(network.serviceConfigs, serviceName)
+	network.forgetHostsFileEntriesUnlocked(serviceName)
+	return nil
+}
+
+// forgetHostsFileEntriesUnlocked drops the entries last written to the hosts file of the service, so that they get
+// written again on the next update if the service still exists
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) forgetHostsFileEntriesUnlocked(serviceName service.ServiceName) {
+	network.hostsFileEntriesLock.Lock()
+	defer network.hostsFileEntriesLock.Unlock()
+	delete(network.hostsFileEntriesPerService, serviceName)
+}
+
+// setServiceConfigUnlocked stores the config the service is running with, in the enclave database and in memory. The
+// in-memory config is left untouched if it can't be persisted
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) setServiceConfigUnlocked(serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) error {
+	serializedServiceConfig, err := proto.Marshal(serviceConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the config of service '%s'", serviceName)
+	}
+	if err = network.serviceConfigsBucket.PutServiceConfig(serviceName, serializedServiceConfig); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the config of service '%s' in the enclave database", serviceName)
+	}
+	network.serviceConfigs[serviceName] = serviceConfig
+	return nil
+}
+
+// appendServiceIdentifiersUnlocked adds the identifiers of a newly created service to the enclave database and to the
+// in-memory list
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) appendServiceIdentifiersUnlocked(serviceIdentifiers *kurtosis_core_rpc_api_bindings.ServiceIdentifiers) error {
+	serviceIdentifierToPersist := &service_identifiers.ServiceIdentifier{
+		ServiceUuid:   service.ServiceUUID(serviceIdentifiers.GetServiceUuid()),
+		Name:          service.ServiceName(serviceIdentifiers.GetName()),
+		ShortenedUuid: serviceIdentifiers.GetShortenedUuid(),
+	}
+	if err := network.serviceIdentifiersBucket.AppendServiceIdentifier(serviceIdentifierToPersist); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the identifiers of service '%s' in the enclave database", serviceIdentifiers.GetName())
+	}
+	network.allExistingAndHistoricalIdentifiers = append(network.allExistingAndHistoricalIdentifiers, serviceIdentifiers)
+	return nil
+}
+
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) removePersistedNetworkingSidecarUnlocked(serviceName service.ServiceName) error {
+	if err := network.networkingSidecarsBucket.RemoveNetworkingSidecar(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the networking sidecar of service '%s' from the enclave database", serviceName)
+	}
+	return nil
+}
+
+func loadPersistedServiceConfigs(serviceConfigsBucket *service_configs.ServiceConfigsBucket) (map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, error) {
+	serializedServiceConfigs, err := serviceConfigsBucket.GetAllServiceConfigs()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persisted service configs")
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serializedServiceConfig := range serializedServiceConfigs {
+		serviceConfig := new(kurtosis_core_rpc_api_bindings.ServiceConfig)
+		if err = proto.Unmarshal(serializedServiceConfig, serviceConfig); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the persisted config of service '%s'", serviceName)
+		}
+		serviceConfigs[serviceName] = serviceConfig
+	}
+	return serviceConfigs, nil
+}
+
+func loadPersistedServiceIdentifiers(serviceIdentifiersBucket *service_identifiers.ServiceIdentifiersBucket) ([]*kurtosis_core_rpc_api_bindings.ServiceIdentifiers, error) {
+	persistedServiceIdentifiers, err := serviceIdentifiersBucket.GetAllServiceIdentifiers()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persisted service identifiers")
+	}
+	allServiceIdentifiers := []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers{}
+	for _, persistedServiceIdentifier := range persistedServiceIdentifiers {
+		allServiceIdentifiers = append(allServiceIdentifiers, &kurtosis_core_rpc_api_bindings.ServiceIdentifiers{
+			ServiceUuid:   string(persistedServiceIdentifier.ServiceUuid),
+			Name:          string(persistedServiceIdentifier.Name),
+			ShortenedUuid: persistedServiceIdentifier.ShortenedUuid,
+		})
+	}
+	return allServiceIdentifiers, nil
 }
 
 // This isn't thread safe and must be called from a thread safe context
+// getServiceUuidForIdentifier locks the network to look the service up
+func (network *DefaultServiceNetwork) getServiceUuidForIdentifier(serviceIdentifier string) (service.ServiceUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+	}
+	serviceRegistration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return "", stacktrace.NewError("Service '%v' does not exist in the network", serviceIdentifier)
+	}
+	return serviceRegistration.GetUUID(), nil
+}
+
 func (network *DefaultServiceNetwork) getServiceNameForIdentifierUnlocked(serviceIdentifier string) (service.ServiceName, error) {
 	maybeServiceUuid := service.ServiceUUID(serviceIdentifier)
 	serviceUuidToServiceName := map[service.ServiceUUID]service.ServiceName{}
@@ -1685,6 +3350,56 @@ func (network *DefaultServiceNetwork) getServiceNameForIdentifierUnlocked(servic
 	return "", stacktrace.NewError("Couldn't find a matching service name for identifier '%v'", serviceIdentifier)
 }
 
+// doesUpdateRequireRecreatingContainer returns true if the update changes anything else than the subnetwork of the
+// service, as the container has to be re-created for those changes to be applied
+func doesUpdateRequireRecreatingContainer(updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig) bool {
+	return updateServiceConfig.ContainerImageName != nil ||
+		len(updateServiceConfig.PrivatePorts) > 0 ||
+		len(updateServiceConfig.EntrypointArgs) > 0 ||
+		len(updateServiceConfig.CmdArgs) > 0 ||
+		len(updateServiceConfig.EnvVars) > 0 ||
+		len(updateServiceConfig.FilesArtifactMountpoints) > 0 ||
+		updateServiceConfig.CpuAllocationMillicpus != nil ||
+		updateServiceConfig.MemoryAllocationMegabytes != nil
+}
+
+// applyUpdateToServiceConfig returns a copy of the service config with the attributes set in the update overridden
+func applyUpdateToServiceConfig(
+	serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig,
+	updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig,
+) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	builder := services.NewServiceConfigBuilderFromServiceConfig(serviceConfig)
+	if len(updateServiceConfig.PrivatePorts) > 0 {
+		builder.WithPrivatePorts(updateServiceConfig.PrivatePorts)
+	}
+	if len(updateServiceConfig.EntrypointArgs) > 0 {
+		builder.WithEntryPointArgs(updateServiceConfig.EntrypointArgs)
+	}
+	if len(updateServiceConfig.CmdArgs) > 0 {
+		builder.WithCmdArgs(updateServiceConfig.CmdArgs)
+	}
+	if len(updateServiceConfig.EnvVars) > 0 {
+		builder.WithEnvVars(updateServiceConfig.EnvVars)
+	}
+	if len(updateServiceConfig.FilesArtifactMountpoints) > 0 {
+		builder.WithFilesArtifactMountDirpaths(updateServiceConfig.FilesArtifactMountpoints)
+	}
+	if updateServiceConfig.CpuAllocationMillicpus != nil {
+		builder.WithCpuAllocationMillicpus(*updateServiceConfig.CpuAllocationMillicpus)
+	}
+	if updateServiceConfig.MemoryAllocationMegabytes != nil {
+		builder.WithMemoryAllocationMegabytes(*updateServiceConfig.MemoryAllocationMegabytes)
+	}
+	if updateServiceConfig.Subnetwork != nil {
+		builder.WithSubnetwork(*updateServiceConfig.Subnetwork)
+	}
+	updatedServiceConfig := builder.Build()
+	if updateServiceConfig.ContainerImageName != nil {
+		updatedServiceConfig.ContainerImageName = *updateServiceConfig.ContainerImageName
+	}
+	return updatedServiceConfig
+}
+
 func convertAPIPortsToPortSpecs(
 	privateAPIPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	publicAPIPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
@@ -19,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
@@ -28,10 +29,15 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"io"
 	"net"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const (
@@ -42,6 +48,9 @@ const (
 	fakeApiContainerVersion = "0.0.0"
 	apiContainerPort        = uint16(1234)
 	testContainerImageName  = "kurtosistech/test-container"
+
+	// The tests checking the networking sidecars don't expect the firewall of the enclave to block any traffic
+	noTrafficBlockingWithoutSidecars = false
 )
 
 var (
@@ -80,6 +89,7 @@ func TestStartService_Successful(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -196,6 +206,7 @@ func TestStartService_FailedToStart(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -311,6 +322,7 @@ func TestStartService_SidecarFailedToStart(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -436,6 +448,7 @@ func TestStartServices_Success(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -528,6 +541,186 @@ func TestStartServices_Success(t *testing.T) {
 	require.Equal(t, expectedPartitionsInTopolody, partitionServices)
 }
 
+func TestStartServices_FailsIfHostnamesCantBeInstalled(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceIndex := 1
+	serviceName := testServiceNameFromInt(serviceIndex)
+	serviceUuid := testServiceUuidFromInt(serviceIndex)
+	serviceIp := testIpFromInt(serviceIndex)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	startedService := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithHostnames([]string{"alias-1"}).Build()
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		!partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	backend.EXPECT().RegisterUserServices(ctx, enclaveName, map[service.ServiceName]bool{serviceName: true}).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			serviceName: serviceRegistration,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: startedService,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	// The image of the service has no shell, so its own hostname can't be written to its hosts file
+	hostsFileEntry := fmt.Sprintf("%s alias-1 %s", serviceIp, hostsFileEntryMarker)
+	backend.EXPECT().RunUserServiceExecCommands(ctx, enclaveName, map[service.ServiceUUID][]string{
+		serviceUuid: generateUpdateHostsFileCmd([]string{hostsFileEntry}),
+	}).Times(1).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			serviceUuid: exec_result.NewExecResult(126, "exec: \"sh\": executable file not found in $PATH"),
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	// The service is then rolled back
+	backend.EXPECT().DestroyUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().UnregisterUserServices(ctx, enclaveName, map[service.ServiceUUID]bool{serviceUuid: true}).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.StartServices(
+		ctx,
+		map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+			serviceName: serviceConfig,
+		},
+		1,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 1)
+	require.Contains(t, failure[serviceName].Error(), "executable file not found")
+
+	require.Empty(t, network.registeredServiceInfo)
+	require.Empty(t, network.serviceConfigs)
+	require.Empty(t, network.allExistingAndHistoricalIdentifiers)
+}
+
+func TestStartService_ConcurrentCallsStartContainersInParallel(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		!partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	// Each container start waits for the other one to begin, which can only happen if the network isn't locked while
+	// the containers get started
+	numConcurrentServices := 2
+	containerStartsInProgress := sync.WaitGroup{}
+	containerStartsInProgress.Add(numConcurrentServices)
+	areContainerStartsOverlapping := make(chan bool)
+	go func() {
+		containerStartsInProgress.Wait()
+		close(areContainerStartsOverlapping)
+	}()
+
+	for serviceIndex := 1; serviceIndex <= numConcurrentServices; serviceIndex++ {
+		serviceName := testServiceNameFromInt(serviceIndex)
+		serviceUuid := testServiceUuidFromInt(serviceIndex)
+		serviceIp := testIpFromInt(serviceIndex)
+		serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+		serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+
+		backend.EXPECT().RegisterUserServices(ctx, enclaveName, map[service.ServiceName]bool{serviceName: true}).Times(1).Return(
+			map[service.ServiceName]*service.ServiceRegistration{serviceName: serviceRegistration},
+			map[service.ServiceName]error{},
+			nil,
+		)
+		backend.EXPECT().StartRegisteredUserServices(
+			ctx,
+			enclaveName,
+			mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+				_, foundService := services[serviceUuid]
+				return len(services) == 1 && foundService
+			}),
+		).Times(1).Run(func(_ mock.Arguments) {
+			containerStartsInProgress.Done()
+			select {
+			case <-areContainerStartsOverlapping:
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "The container of the other service didn't start while this one was starting")
+			}
+		}).Return(
+			map[service.ServiceUUID]*service.Service{serviceUuid: serviceObj},
+			map[service.ServiceUUID]error{},
+			nil,
+		)
+	}
+
+	startServiceErrs := make(chan error, numConcurrentServices)
+	for serviceIndex := 1; serviceIndex <= numConcurrentServices; serviceIndex++ {
+		serviceName := testServiceNameFromInt(serviceIndex)
+		go func() {
+			_, err := network.StartService(ctx, serviceName, services.NewServiceConfigBuilder(testContainerImageName).Build())
+			startServiceErrs <- err
+		}()
+	}
+	for serviceIndex := 1; serviceIndex <= numConcurrentServices; serviceIndex++ {
+		require.Nil(t, <-startServiceErrs)
+	}
+	require.Len(t, network.registeredServiceInfo, numConcurrentServices)
+	require.Len(t, network.serviceConfigs, numConcurrentServices)
+	require.Empty(t, network.startingServiceConfigs)
+}
+
 func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -575,6 +768,7 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -827,6 +1021,7 @@ func TestUpdateService(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -938,6 +1133,7 @@ func TestUpdateService_FullBatchFailureRollBack(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -996,7 +1192,7 @@ func TestUpdateService_FullBatchFailureRollBack(t *testing.T) {
 	require.Equal(t, expectedPartitions, partitionServices)
 }
 
-func TestSetDefaultConnection(t *testing.T) {
+func TestUpdateService_RecreatesContainerWithUpdatedConfig(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
@@ -1013,7 +1209,8 @@ func TestSetDefaultConnection(t *testing.T) {
 		ip,
 		apiContainerPort,
 		fakeApiContainerVersion,
-		partitioningEnabled,
+		!partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1021,17 +1218,70 @@ func TestSetDefaultConnection(t *testing.T) {
 	)
 	require.Nil(t, err)
 
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection("test-partition"))
-	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
-	network.networkingSidecars["test-service"] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+
+	network.registeredServiceInfo[serviceName] = serviceRegistration
+	network.serviceConfigs[serviceName] = services.NewServiceConfigBuilder(testContainerImageName).WithCmdArgs([]string{"run"}).Build()
+
+	updatedContainerImageName := "kurtosistech/test-container-updated"
+	updateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:                nil,
+		ContainerImageName:        &updatedContainerImageName,
+		PrivatePorts:              nil,
+		EntrypointArgs:            nil,
+		CmdArgs:                   nil,
+		EnvVars:                   nil,
+		FilesArtifactMountpoints:  nil,
+		CpuAllocationMillicpus:    nil,
+		MemoryAllocationMegabytes: nil,
+	}
 
-	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
-	err = network.SetDefaultConnection(ctx, newDefaultConnection)
+	// The container is stopped, and started again under the same registration with the updated image
+	backend.EXPECT().StopUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(filters *service.ServiceFilters) bool {
+			return len(filters.UUIDs) == 1 && filters.UUIDs[serviceUuid]
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			serviceConfig, found := services[serviceUuid]
+			return len(services) == 1 && found && serviceConfig.GetContainerImageName() == updatedContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.UpdateService(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		serviceName: updateServiceConfig,
+	})
 	require.Nil(t, err)
-	require.Equal(t, network.topology.GetDefaultConnection(), newDefaultConnection)
+	require.Empty(t, failure)
+	require.Equal(t, map[service.ServiceName]bool{serviceName: true}, success)
+
+	require.Equal(t, serviceRegistration, network.registeredServiceInfo[serviceName])
+	require.Equal(t, updatedContainerImageName, network.serviceConfigs[serviceName].GetContainerImageName())
+	// attributes that were not part of the update are left as they were
+	require.Equal(t, []string{"run"}, network.serviceConfigs[serviceName].GetCmdArgs())
 }
 
-func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
+func TestRecreateServices_KeepsRegistrationOfTheService(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
@@ -1048,7 +1298,8 @@ func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
 		ip,
 		apiContainerPort,
 		fakeApiContainerVersion,
-		partitioningEnabled,
+		!partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1056,19 +1307,65 @@ func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
 	)
 	require.Nil(t, err)
 
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection("test-partition"))
-	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
-	// not add the sidecar such that it won't be able to update the networking rules
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
 
-	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
-	err = network.SetDefaultConnection(ctx, newDefaultConnection)
-	require.NotNil(t, err)
-	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
-	// check connection was rolled back to ConnectionAllowed in the topology
-	require.Equal(t, network.topology.GetDefaultConnection(), partition_topology.ConnectionAllowed)
+	network.registeredServiceInfo[serviceName] = serviceRegistration
+	network.serviceConfigs[serviceName] = services.NewServiceConfigBuilder(testContainerImageName).WithCmdArgs([]string{"run"}).Build()
+	require.Nil(t, network.topology.AddService(serviceName, partition_topology.DefaultPartitionId))
+
+	unknownServiceName := testServiceNameFromInt(2)
+
+	updatedContainerImageName := "kurtosistech/test-container-updated"
+	updatedServiceConfig := services.NewServiceConfigBuilder(updatedContainerImageName).Build()
+
+	// The container is stopped, and started again under the same registration with the new config
+	backend.EXPECT().StopUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(filters *service.ServiceFilters) bool {
+			return len(filters.UUIDs) == 1 && filters.UUIDs[serviceUuid]
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			serviceConfig, found := services[serviceUuid]
+			return len(services) == 1 && found && serviceConfig.GetContainerImageName() == updatedContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	recreatedServices, failedServices, err := network.RecreateServices(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		serviceName:        updatedServiceConfig,
+		unknownServiceName: updatedServiceConfig,
+	})
+	require.Nil(t, err)
+	require.Len(t, failedServices, 1)
+	require.Contains(t, failedServices, unknownServiceName)
+	require.Equal(t, map[service.ServiceName]*service.Service{serviceName: serviceObj}, recreatedServices)
+
+	require.Equal(t, serviceRegistration, network.registeredServiceInfo[serviceName])
+	// the config is replaced as a whole, attributes absent from the new config are not kept
+	require.Equal(t, updatedContainerImageName, network.serviceConfigs[serviceName].GetContainerImageName())
+	require.Empty(t, network.serviceConfigs[serviceName].GetCmdArgs())
 }
 
-func TestSetConnection(t *testing.T) {
+func TestUpdateService_FailureToRecreateRestoresPreviousConfig(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
@@ -1085,7 +1382,8 @@ func TestSetConnection(t *testing.T) {
 		ip,
 		apiContainerPort,
 		fakeApiContainerVersion,
-		partitioningEnabled,
+		!partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1093,46 +1391,76 @@ func TestSetConnection(t *testing.T) {
 	)
 	require.Nil(t, err)
 
-	partition1 := service_network_types.PartitionID("partition1")
-	partition2 := service_network_types.PartitionID("partition2")
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+
+	previousServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).Build()
+	network.registeredServiceInfo[serviceName] = serviceRegistration
+	network.serviceConfigs[serviceName] = previousServiceConfig
+
+	brokenContainerImageName := "kurtosistech/does-not-exist"
+	updateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:                nil,
+		ContainerImageName:        &brokenContainerImageName,
+		PrivatePorts:              nil,
+		EntrypointArgs:            nil,
+		CmdArgs:                   nil,
+		EnvVars:                   nil,
+		FilesArtifactMountpoints:  nil,
+		CpuAllocationMillicpus:    nil,
+		MemoryAllocationMegabytes: nil,
+	}
 
-	service1Index := 1
-	service1 := service.NewServiceRegistration(
-		testServiceNameFromInt(service1Index),
-		testServiceUuidFromInt(service1Index),
+	backend.EXPECT().StopUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	// Starting the service with the updated image fails
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
 		enclaveName,
-		testIpFromInt(service1Index),
-		testServiceHostnameFromInt(service1Index))
-	service2Index := 2
-	service2 := service.NewServiceRegistration(
-		testServiceNameFromInt(service2Index),
-		testServiceUuidFromInt(service2Index),
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return services[serviceUuid].GetContainerImageName() == brokenContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{},
+		map[service.ServiceUUID]error{
+			serviceUuid: errors.New("Image not found"),
+		},
+		nil,
+	)
+	// So it is started again with its previous image
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
 		enclaveName,
-		testIpFromInt(service2Index),
-		testServiceHostnameFromInt(service2Index))
-
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
-	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
-	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
-
-	network.registeredServiceInfo[service1.GetName()] = service1
-	network.registeredServiceInfo[service2.GetName()] = service2
-
-	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
-	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return services[serviceUuid].GetContainerImageName() == testContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay)
-	err = network.SetConnection(ctx, partition1, partition2, connectionOverride)
+	success, failure, err := network.UpdateService(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		serviceName: updateServiceConfig,
+	})
 	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Contains(t, failure, serviceName)
 
-	// check that connection override was successfully set to connectionOverride
-	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
-	require.Nil(t, err)
-	require.Equal(t, connectionOverride, currentConnectionOverride)
+	require.Equal(t, serviceRegistration, network.registeredServiceInfo[serviceName])
+	require.Equal(t, previousServiceConfig, network.serviceConfigs[serviceName])
 }
 
-func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
+func TestUpdateService_FailureToUpdateConnectionsRestoresPreviousConfig(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
@@ -1149,7 +1477,8 @@ func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
 		ip,
 		apiContainerPort,
 		fakeApiContainerVersion,
-		partitioningEnabled,
+		!partitioningEnabled,
+		true,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1160,15 +1489,625 @@ func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
 	partition1 := service_network_types.PartitionID("partition1")
 	partition2 := service_network_types.PartitionID("partition2")
 
-	service1Index := 1
-	service1 := service.NewServiceRegistration(
-		testServiceNameFromInt(service1Index),
-		testServiceUuidFromInt(service1Index),
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+	otherServiceIndex := 2
+	otherService := service.NewServiceRegistration(
+		testServiceNameFromInt(otherServiceIndex),
+		testServiceUuidFromInt(otherServiceIndex),
 		enclaveName,
-		testIpFromInt(service1Index),
-		testServiceHostnameFromInt(service1Index))
-	service2Index := 2
-	service2 := service.NewServiceRegistration(
+		testIpFromInt(otherServiceIndex),
+		testServiceHostnameFromInt(otherServiceIndex))
+
+	// Moving the service to partition2 blocks its traffic with the other service
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.SetConnection(partition1, partition2, partition_topology.ConnectionBlocked))
+	require.Nil(t, network.topology.AddService(serviceName, partition1))
+	require.Nil(t, network.topology.AddService(otherService.GetName(), partition1))
+
+	previousServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).Build()
+	network.registeredServiceInfo[serviceName] = serviceRegistration
+	network.registeredServiceInfo[otherService.GetName()] = otherService
+	network.serviceConfigs[serviceName] = previousServiceConfig
+
+	updatedContainerImageName := "kurtosistech/test-container-updated"
+	partition2Str := string(partition2)
+	updateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:                &partition2Str,
+		ContainerImageName:        &updatedContainerImageName,
+		PrivatePorts:              nil,
+		EntrypointArgs:            nil,
+		CmdArgs:                   nil,
+		EnvVars:                   nil,
+		FilesArtifactMountpoints:  nil,
+		CpuAllocationMillicpus:    nil,
+		MemoryAllocationMegabytes: nil,
+	}
+
+	// The container is re-created with the updated image, and re-created again with its previous image once blocking
+	// the traffic of its new partition failed
+	backend.EXPECT().StopUserServices(mock.Anything, enclaveName, mock.Anything).Times(2).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return services[serviceUuid].GetContainerImageName() == updatedContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().UpdateEnclaveBlockedTraffic(ctx, enclaveName, mock.Anything).Times(1).Return(errors.New("Blocking traffic failed"))
+	backend.EXPECT().StartRegisteredUserServices(
+		mock.Anything,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return services[serviceUuid].GetContainerImageName() == testContainerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.UpdateService(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		serviceName: updateServiceConfig,
+	})
+	require.NotNil(t, err)
+	require.Nil(t, success)
+	require.Nil(t, failure)
+
+	require.Equal(t, serviceRegistration, network.registeredServiceInfo[serviceName])
+	require.Equal(t, previousServiceConfig, network.serviceConfigs[serviceName])
+	servicePartitions, err := network.topology.GetServicePartitions()
+	require.Nil(t, err)
+	require.Equal(t, partition1, servicePartitions[serviceName])
+}
+
+func TestRestartService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceInternalTestId := 1
+	servicePartitionId := testPartitionIdFromInt(serviceInternalTestId)
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(servicePartitionId)).Build()
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(servicePartitionId))
+	require.Nil(t, network.topology.AddService(serviceName, servicePartitionId))
+	network.registeredServiceInfo[serviceName] = serviceRegistration
+	network.serviceConfigs[serviceName] = serviceConfig
+
+	backend.EXPECT().StopUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(filters *service.ServiceFilters) bool {
+			return len(filters.UUIDs) == 1 && filters.UUIDs[serviceUuid]
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			_, found := services[serviceUuid]
+			return len(services) == 1 && found
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	// The sidecar of the service is re-created along with it
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, serviceUuid).Times(1).Return(
+		lib_networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveName, container_status.ContainerStatus_Running),
+		nil)
+	backend.EXPECT().RunNetworkingSidecarExecCommands(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(commands map[service.ServiceUUID][]string) bool {
+			_, foundService := commands[serviceUuid]
+			return len(commands) == 1 && foundService
+		})).Times(2).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			serviceUuid: exec_result.NewExecResult(0, ""),
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	restartedService, err := network.RestartService(ctx, string(serviceName))
+	require.Nil(t, err)
+	require.Equal(t, serviceRegistration, restartedService.GetRegistration())
+
+	require.Equal(t, serviceConfig, network.serviceConfigs[serviceName])
+	require.Contains(t, network.networkingSidecars, serviceName)
+	partitionServices, err := network.topology.GetPartitionServices()
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]bool{serviceName: true}, partitionServices[servicePartitionId])
+}
+
+func TestGetServiceLogs_ReturnsLastLines(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	network.registeredServiceInfo[serviceName] = service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+
+	backend.EXPECT().GetUserServiceLogs(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(filters *service.ServiceFilters) bool {
+			return len(filters.UUIDs) == 1 && filters.UUIDs[serviceUuid]
+		}),
+		false,
+	).Times(1).Return(
+		map[service.ServiceUUID]io.ReadCloser{
+			serviceUuid: io.NopCloser(strings.NewReader("line 1\nline 2\nline 3\nline 4\n")),
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	logLines, err := network.GetServiceLogs(ctx, string(serviceName), 2)
+	require.Nil(t, err)
+	require.Equal(t, []string{"line 3", "line 4"}, logLines)
+}
+
+func TestNewDefaultServiceNetwork_LoadsPersistedState(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceIp := testIpFromInt(serviceInternalTestId)
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithCmdArgs([]string{"sleep", "infinity"}).Build()
+	serviceIdentifiers := &kurtosis_core_rpc_api_bindings.ServiceIdentifiers{
+		ServiceUuid:   string(serviceUuid),
+		Name:          string(serviceName),
+		ShortenedUuid: "massive-uui",
+	}
+	require.Nil(t, network.serviceRegistrationsBucket.AddServiceRegistration(service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))))
+	require.Nil(t, network.setServiceConfigUnlocked(serviceName, serviceConfig))
+	require.Nil(t, network.appendServiceIdentifiersUnlocked(serviceIdentifiers))
+
+	reloadedNetwork, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	require.Len(t, reloadedNetwork.registeredServiceInfo, 1)
+	reloadedRegistration := reloadedNetwork.registeredServiceInfo[serviceName]
+	require.NotNil(t, reloadedRegistration)
+	require.Equal(t, serviceUuid, reloadedRegistration.GetUUID())
+	require.True(t, serviceIp.Equal(reloadedRegistration.GetPrivateIP()))
+
+	require.Len(t, reloadedNetwork.serviceConfigs, 1)
+	require.True(t, proto.Equal(serviceConfig, reloadedNetwork.serviceConfigs[serviceName]))
+
+	require.Len(t, reloadedNetwork.GetExistingAndHistoricalServiceIdentifiers(), 1)
+	require.True(t, proto.Equal(serviceIdentifiers, reloadedNetwork.GetExistingAndHistoricalServiceIdentifiers()[0]))
+}
+
+func TestSetServiceConfig_FailsWithoutChangingTheConfigIfItCantBePersisted(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceName := testServiceNameFromInt(1)
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithCmdArgs([]string{"sleep", "infinity"}).Build()
+	require.Nil(t, network.setServiceConfigUnlocked(serviceName, serviceConfig))
+
+	// the enclave database can't be written anymore once closed
+	require.Nil(t, db.Close())
+	updatedServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithCmdArgs([]string{"sleep", "1000"}).Build()
+	require.Error(t, network.setServiceConfigUnlocked(serviceName, updatedServiceConfig))
+	require.True(t, proto.Equal(serviceConfig, network.serviceConfigs[serviceName]))
+	require.Error(t, network.appendServiceIdentifiersUnlocked(&kurtosis_core_rpc_api_bindings.ServiceIdentifiers{
+		ServiceUuid:   string(testServiceUuidFromInt(1)),
+		Name:          string(serviceName),
+		ShortenedUuid: "massive-uui",
+	}))
+	require.Empty(t, network.GetExistingAndHistoricalServiceIdentifiers())
+}
+
+func TestReconcileWithBackend(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	// service 1 is still running, service 2 is gone, and service 3 is unknown to the enclave
+	runningServiceName := testServiceNameFromInt(1)
+	runningServiceUuid := testServiceUuidFromInt(1)
+	runningServiceRegistration := service.NewServiceRegistration(runningServiceName, runningServiceUuid, enclaveName, testIpFromInt(1), string(runningServiceName))
+	goneServiceName := testServiceNameFromInt(2)
+	goneServiceUuid := testServiceUuidFromInt(2)
+	goneServiceRegistration := service.NewServiceRegistration(goneServiceName, goneServiceUuid, enclaveName, testIpFromInt(2), string(goneServiceName))
+	unknownServiceName := testServiceNameFromInt(3)
+	unknownServiceUuid := testServiceUuidFromInt(3)
+	unknownServiceRegistration := service.NewServiceRegistration(unknownServiceName, unknownServiceUuid, enclaveName, testIpFromInt(3), string(unknownServiceName))
+
+	for _, serviceRegistration := range []*service.ServiceRegistration{runningServiceRegistration, goneServiceRegistration} {
+		serviceName := serviceRegistration.GetName()
+		network.registeredServiceInfo[serviceName] = serviceRegistration
+		require.Nil(t, network.serviceRegistrationsBucket.AddServiceRegistration(serviceRegistration))
+		require.Nil(t, network.networkingSidecarsBucket.AddNetworkingSidecar(serviceName, serviceRegistration.GetUUID()))
+		require.Nil(t, network.addServiceToTopology(serviceName, partition_topology.DefaultPartitionId))
+	}
+
+	backend.EXPECT().GetUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			runningServiceUuid: service.NewService(runningServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, nil, map[string]*port_spec.PortSpec{}),
+			unknownServiceUuid: service.NewService(unknownServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, nil, map[string]*port_spec.PortSpec{}),
+		},
+		nil,
+	)
+
+	// the running sidecar of the running service is re-used
+	backend.EXPECT().GetNetworkingSidecars(
+		ctx,
+		mock.MatchedBy(func(filters *lib_networking_sidecar.NetworkingSidecarFilters) bool {
+			return len(filters.UserServiceUUIDs) == 1 && filters.UserServiceUUIDs[runningServiceUuid]
+		}),
+	).Times(1).Return(
+		map[service.ServiceUUID]*lib_networking_sidecar.NetworkingSidecar{
+			runningServiceUuid: lib_networking_sidecar.NewNetworkingSidecar(runningServiceUuid, enclaveName, container_status.ContainerStatus_Running),
+		},
+		nil,
+	)
+
+	// traffic control is reset, initialized and then updated
+	backend.EXPECT().RunNetworkingSidecarExecCommands(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(commands map[service.ServiceUUID][]string) bool {
+			_, foundService := commands[runningServiceUuid]
+			return len(commands) == 1 && foundService
+		})).Times(3).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			runningServiceUuid: exec_result.NewExecResult(0, ""),
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	require.Nil(t, network.ReconcileWithBackend(ctx))
+
+	require.Len(t, network.registeredServiceInfo, 1)
+	require.Contains(t, network.registeredServiceInfo, runningServiceName)
+	require.Len(t, network.networkingSidecars, 1)
+	require.Contains(t, network.networkingSidecars, runningServiceName)
+
+	persistedRegistrations, err := network.serviceRegistrationsBucket.GetAllServiceRegistrations()
+	require.Nil(t, err)
+	require.Len(t, persistedRegistrations, 1)
+	require.Contains(t, persistedRegistrations, runningServiceName)
+	persistedSidecars, err := network.networkingSidecarsBucket.GetAllNetworkingSidecars()
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]service.ServiceUUID{runningServiceName: runningServiceUuid}, persistedSidecars)
+
+	servicePartitions, err := network.topology.GetServicePartitions()
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]service_network_types.PartitionID{runningServiceName: partition_topology.DefaultPartitionId}, servicePartitions)
+}
+
+func TestSetDefaultConnection(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection("test-partition"))
+	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
+	network.networkingSidecars["test-service"] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	err = network.SetDefaultConnection(ctx, newDefaultConnection)
+	require.Nil(t, err)
+	require.Equal(t, network.topology.GetDefaultConnection(), newDefaultConnection)
+}
+
+func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection("test-partition"))
+	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
+	// not add the sidecar such that it won't be able to update the networking rules
+
+	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	err = network.SetDefaultConnection(ctx, newDefaultConnection)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
+	// check connection was rolled back to ConnectionAllowed in the topology
+	require.Equal(t, network.topology.GetDefaultConnection(), partition_topology.ConnectionAllowed)
+}
+
+func TestSetConnection(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay)
+	err = network.SetConnection(ctx, partition1, partition2, connectionOverride)
+	require.Nil(t, err)
+
+	// check that connection override was successfully set to connectionOverride
+	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.Equal(t, connectionOverride, currentConnectionOverride)
+}
+
+func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
 		testServiceNameFromInt(service2Index),
 		testServiceUuidFromInt(service2Index),
 		enclaveName,
@@ -1212,6 +2151,7 @@ func TestUnsetConnection(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1244,21 +2184,358 @@ func TestUnsetConnection(t *testing.T) {
 	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
 	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
 
-	network.registeredServiceInfo[service1.GetName()] = service1
-	network.registeredServiceInfo[service2.GetName()] = service2
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	err = network.UnsetConnection(ctx, partition1, partition2)
+	require.Nil(t, err)
+	// test connection was successfully unset back to default
+	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.Equal(t, partition_topology.ConnectionAllowed, currentConnectionOverride)
+}
+
+func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.SetConnection(partition1, partition2, connectionOverride))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	// do not add any sidecar such that updating network traffic will throw an exception
+
+	err = network.UnsetConnection(ctx, partition1, partition2)
+	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
+
+	// check that connection was rolled back to the previous override
+	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.Equal(t, connectionOverride, currentConnectionOverride)
+}
+
+func TestSetDirectionalConnection(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	// partition 2 gets created on the fly
+	err = network.SetDirectionalConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked)
+	require.Nil(t, err)
+
+	isDirectionalConnection, currentConnection, err := network.GetDirectionalConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.True(t, isDirectionalConnection)
+	require.Equal(t, partition_topology.ConnectionBlocked, currentConnection)
+
+	isDirectionalConnection, currentConnection, err = network.GetDirectionalConnection(partition2, partition1)
+	require.Nil(t, err)
+	require.False(t, isDirectionalConnection)
+	require.Equal(t, partition_topology.ConnectionAllowed, currentConnection)
+
+	err = network.UnsetDirectionalConnection(ctx, partition1, partition2)
+	require.Nil(t, err)
+
+	isDirectionalConnection, currentConnection, err = network.GetDirectionalConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.False(t, isDirectionalConnection)
+	require.Equal(t, partition_topology.ConnectionAllowed, currentConnection)
+}
+
+func TestSetServiceConnection(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	// the two services share a partition
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition_topology.DefaultPartitionId))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition_topology.DefaultPartitionId))
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+	sidecar1 := networking_sidecar.NewMockNetworkingSidecarWrapper()
+	network.networkingSidecars[service1.GetName()] = sidecar1
+	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	err = network.SetServiceConnection(ctx, service1.GetName(), service2.GetName(), partition_topology.ConnectionBlocked)
+	require.Nil(t, err)
+
+	isServiceConnection, currentConnection, err := network.GetServiceConnection(service2.GetName(), service1.GetName())
+	require.Nil(t, err)
+	require.True(t, isServiceConnection)
+	require.Equal(t, partition_topology.ConnectionBlocked, currentConnection)
+	recordedUpdates := sidecar1.GetRecordedUpdatedPacketConnectionConfig()
+	require.Len(t, recordedUpdates, 1)
+	require.Equal(t, partition_topology.ConnectionBlocked, *recordedUpdates[0][service2.GetPrivateIP().String()])
+
+	err = network.UnsetServiceConnection(ctx, service1.GetName(), service2.GetName())
+	require.Nil(t, err)
+
+	isServiceConnection, currentConnection, err = network.GetServiceConnection(service1.GetName(), service2.GetName())
+	require.Nil(t, err)
+	require.False(t, isServiceConnection)
+	require.Equal(t, partition_topology.ConnectionAllowed, currentConnection)
+
+	err = network.SetServiceConnection(ctx, service1.GetName(), "unknown-service", partition_topology.ConnectionBlocked)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "No service found with name 'unknown-service'")
+}
+
+func TestCaptureServiceTraffic(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	// only service1 has a sidecar
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	err = network.CaptureServiceTraffic(ctx, string(service1.GetUUID()), time.Second, "", io.Discard)
+	require.Nil(t, err)
+
+	err = network.CaptureServiceTraffic(ctx, string(service2.GetName()), time.Second, "", io.Discard)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "has no networking sidecar")
+
+	err = network.CaptureServiceTraffic(ctx, "unknown-service", time.Second, "", io.Discard)
+	require.NotNil(t, err)
+
+	network.isPartitioningEnabled = false
+	err = network.CaptureServiceTraffic(ctx, string(service1.GetName()), time.Second, "", io.Discard)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "network partitioning is enabled")
+}
+
+func TestGetTrafficStatistics(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
 
-	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
-	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	serviceRegistrations := []*service.ServiceRegistration{}
+	sidecars := []*networking_sidecar.MockNetworkingSidecarWrapper{}
+	for serviceIndex := 1; serviceIndex <= 2; serviceIndex++ {
+		serviceRegistration := service.NewServiceRegistration(
+			testServiceNameFromInt(serviceIndex),
+			testServiceUuidFromInt(serviceIndex),
+			enclaveName,
+			testIpFromInt(serviceIndex),
+			testServiceHostnameFromInt(serviceIndex))
+		sidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
+		network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+		network.networkingSidecars[serviceRegistration.GetName()] = sidecar
+		serviceRegistrations = append(serviceRegistrations, serviceRegistration)
+		sidecars = append(sidecars, sidecar)
+	}
+	service1Name := serviceRegistrations[0].GetName()
+	service2Name := serviceRegistrations[1].GetName()
+	service1To2Statistics := &networking_sidecar.TrafficStatistics{
+		SentBytes:      0,
+		SentPackets:    0,
+		DroppedPackets: 12,
+	}
+	sidecars[0].SetTrafficStatistics(map[string]*networking_sidecar.TrafficStatistics{
+		serviceRegistrations[1].GetPrivateIP().String(): service1To2Statistics,
+		// not a service IP, ignored
+		"10.10.10.10": service1To2Statistics,
+	})
 
-	err = network.UnsetConnection(ctx, partition1, partition2)
+	allTrafficStatistics, err := network.GetTrafficStatistics(ctx, "")
 	require.Nil(t, err)
-	// test connection was successfully unset back to default
-	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
+	expectedAllTrafficStatistics := map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics{
+		service1Name: {service2Name: service1To2Statistics},
+		service2Name: {},
+	}
+	require.Equal(t, expectedAllTrafficStatistics, allTrafficStatistics)
+
+	service2TrafficStatistics, err := network.GetTrafficStatistics(ctx, string(service2Name))
 	require.Nil(t, err)
-	require.Equal(t, partition_topology.ConnectionAllowed, currentConnectionOverride)
+	require.Equal(t, map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics{service2Name: {}}, service2TrafficStatistics)
+
+	network.isPartitioningEnabled = false
+	_, err = network.GetTrafficStatistics(ctx, "")
+	require.NotNil(t, err)
 }
 
-func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
+func TestSetConnection_FailureRestoresDirectionalConnections(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
@@ -1276,6 +2553,7 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1293,33 +2571,27 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 		enclaveName,
 		testIpFromInt(service1Index),
 		testServiceHostnameFromInt(service1Index))
-	service2Index := 2
-	service2 := service.NewServiceRegistration(
-		testServiceNameFromInt(service2Index),
-		testServiceUuidFromInt(service2Index),
-		enclaveName,
-		testIpFromInt(service2Index),
-		testServiceHostnameFromInt(service2Index))
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
-	require.Nil(t, network.topology.SetConnection(partition1, partition2, connectionOverride))
 	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
-	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
-
 	network.registeredServiceInfo[service1.GetName()] = service1
-	network.registeredServiceInfo[service2.GetName()] = service2
+	require.Nil(t, network.topology.SetDirectionalConnection(partition2, partition1, partition_topology.ConnectionBlocked))
 
 	// do not add any sidecar such that updating network traffic will throw an exception
 
-	err = network.UnsetConnection(ctx, partition1, partition2)
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	err = network.SetConnection(ctx, partition1, partition2, connectionOverride)
 	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
 
-	// check that connection was rolled back to the previous override
-	_, currentConnectionOverride, err := network.topology.GetPartitionConnection(partition1, partition2)
+	isDirectionalConnection, currentConnection, err := network.topology.GetDirectionalPartitionConnection(partition2, partition1)
 	require.Nil(t, err)
-	require.Equal(t, connectionOverride, currentConnectionOverride)
+	require.True(t, isDirectionalConnection)
+	require.Equal(t, partition_topology.ConnectionBlocked, currentConnection)
+
+	isDefaultConnection, _, err := network.topology.GetPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.True(t, isDefaultConnection)
 }
 
 func TestUpdateTrafficControl(t *testing.T) {
@@ -1425,3 +2697,289 @@ func testServiceUuidFromInt(i int) service.ServiceUUID {
 		i,
 	))
 }
+
+func TestScheduleConnectionChanges(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		noTrafficBlockingWithoutSidecars,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	blockedConnection := partition_topology.ConnectionBlocked
+	changes := []*connection_change_scheduler.ConnectionChange{
+		connection_change_scheduler.NewConnectionChange(0, partition1, partition2, &blockedConnection, true),
+		connection_change_scheduler.NewConnectionChange(time.Millisecond, partition1, partition2, nil, false),
+	}
+	require.Nil(t, network.ScheduleConnectionChanges("scenario", changes))
+
+	eventsChan, err := network.WatchConnectionChanges("scenario")
+	require.Nil(t, err)
+	eventTypes := []connection_change_scheduler.ConnectionChangeEventType{}
+	for event := range eventsChan {
+		eventTypes = append(eventTypes, event.GetEventType())
+	}
+	expectedEventTypes := []connection_change_scheduler.ConnectionChangeEventType{
+		connection_change_scheduler.StepApplied,
+		connection_change_scheduler.StepApplied,
+		connection_change_scheduler.Completed,
+	}
+	require.Equal(t, expectedEventTypes, eventTypes)
+
+	isDefaultConnection, currentConnection, err := network.GetConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.False(t, isDefaultConnection)
+	require.Equal(t, partition_topology.ConnectionBlocked, currentConnection)
+}
+
+func TestSetConnection_BlocksTrafficWithoutSidecars(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		!partitioningEnabled,
+		true,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	// No networking sidecar gets involved, the traffic between the two services is blocked by the backend instead
+	service1Ip := service1.GetPrivateIP().String()
+	service2Ip := service2.GetPrivateIP().String()
+	backend.EXPECT().UpdateEnclaveBlockedTraffic(ctx, enclaveName, map[string]map[string]bool{
+		service1Ip: {service2Ip: true},
+		service2Ip: {service1Ip: true},
+	}).Times(1).Return(nil)
+	require.Nil(t, network.SetConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked))
+
+	// Setting the same connection again doesn't change the blocked traffic, so the backend isn't called again
+	require.Nil(t, network.SetConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked))
+
+	// Impairing the traffic without blocking it requires the networking sidecars
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay)
+	require.NotNil(t, network.SetConnection(ctx, partition1, partition2, connectionOverride))
+
+	backend.EXPECT().UpdateEnclaveBlockedTraffic(ctx, enclaveName, map[string]map[string]bool{}).Times(1).Return(nil)
+	require.Nil(t, network.UnsetConnection(ctx, partition1, partition2))
+	require.Empty(t, network.networkingSidecars)
+}
+
+func TestSetConnection_BlocksIpv6TrafficWithoutSidecars(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		!partitioningEnabled,
+		true,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewDualStackServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		net.ParseIP("fd00::1"),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewDualStackServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		net.ParseIP("fd00::2"),
+		testServiceHostnameFromInt(service2Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	// The traffic is blocked for both IP families
+	service1Ip := service1.GetPrivateIP().String()
+	service2Ip := service2.GetPrivateIP().String()
+	backend.EXPECT().UpdateEnclaveBlockedTraffic(ctx, enclaveName, map[string]map[string]bool{
+		service1Ip: {service2Ip: true},
+		service2Ip: {service1Ip: true},
+		"fd00::1":  {"fd00::2": true},
+		"fd00::2":  {"fd00::1": true},
+	}).Times(1).Return(nil)
+	require.Nil(t, network.SetConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked))
+}
+
+func TestSetConnection_UpdatesHostsFilesOfServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		!partitioningEnabled,
+		true,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition2))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+	network.serviceConfigs[service1.GetName()] = services.NewServiceConfigBuilder(testContainerImageName).WithHostnames([]string{"alias-1"}).Build()
+	network.serviceConfigs[service2.GetName()] = services.NewServiceConfigBuilder(testContainerImageName).WithHostnames([]string{"alias-2"}).Build()
+
+	service1HostsFileEntry := fmt.Sprintf("%s alias-1 %s", service1.GetPrivateIP(), hostsFileEntryMarker)
+	service2HostsFileEntry := fmt.Sprintf("%s alias-2 %s", service2.GetPrivateIP(), hostsFileEntryMarker)
+	successfulExecResults := map[service.ServiceUUID]*exec_result.ExecResult{
+		service1.GetUUID(): exec_result.NewExecResult(0, ""),
+		service2.GetUUID(): exec_result.NewExecResult(0, ""),
+	}
+
+	// Once the services are blocked from each other, each one only resolves its own hostname
+	backend.EXPECT().UpdateEnclaveBlockedTraffic(ctx, enclaveName, mock.Anything).Times(2).Return(nil)
+	backend.EXPECT().RunUserServiceExecCommands(ctx, enclaveName, map[service.ServiceUUID][]string{
+		service1.GetUUID(): generateUpdateHostsFileCmd([]string{service1HostsFileEntry}),
+		service2.GetUUID(): generateUpdateHostsFileCmd([]string{service2HostsFileEntry}),
+	}).Times(1).Return(successfulExecResults, map[service.ServiceUUID]error{}, nil)
+	require.Nil(t, network.SetConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked))
+
+	// Setting the same connection again doesn't change the hosts files, so they aren't written again
+	require.Nil(t, network.SetConnection(ctx, partition1, partition2, partition_topology.ConnectionBlocked))
+
+	// Once the connection is unset, the services resolve each other's hostname again
+	backend.EXPECT().RunUserServiceExecCommands(ctx, enclaveName, map[service.ServiceUUID][]string{
+		service1.GetUUID(): generateUpdateHostsFileCmd([]string{service1HostsFileEntry, service2HostsFileEntry}),
+		service2.GetUUID(): generateUpdateHostsFileCmd([]string{service1HostsFileEntry, service2HostsFileEntry}),
+	}).Times(1).Return(successfulExecResults, map[service.ServiceUUID]error{}, nil)
+	require.Nil(t, network.UnsetConnection(ctx, partition1, partition2))
+}
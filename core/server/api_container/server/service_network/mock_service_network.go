@@ -3,6 +3,10 @@
 package service_network
 
 import (
+	io "io"
+
+	connection_change_scheduler "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+
 	context "context"
 	http "net/http"
 
@@ -12,11 +16,15 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	networking_sidecar "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
+
 	partition_topology "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
 	service_network_types "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+
+	time "time"
 )
 
 // MockServiceNetwork is an autogenerated mock type for the ServiceNetwork type
@@ -32,6 +40,188 @@ func (_m *MockServiceNetwork) EXPECT() *MockServiceNetwork_Expecter {
 	return &MockServiceNetwork_Expecter{mock: &_m.Mock}
 }
 
+// AreRenderedTemplatesUpToDate provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
+func (_m *MockServiceNetwork) AreRenderedTemplatesUpToDate(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (bool, error) {
+	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (bool, error)); ok {
+		return rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) bool); ok {
+		r0 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) error); ok {
+		r1 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_AreRenderedTemplatesUpToDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AreRenderedTemplatesUpToDate'
+type MockServiceNetwork_AreRenderedTemplatesUpToDate_Call struct {
+	*mock.Call
+}
+
+// AreRenderedTemplatesUpToDate is a helper method to define mock.On call
+//   - templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) AreRenderedTemplatesUpToDate(templatesAndDataByDestinationRelFilepath interface{}, artifactName interface{}) *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call {
+	return &MockServiceNetwork_AreRenderedTemplatesUpToDate_Call{Call: _e.mock.On("AreRenderedTemplatesUpToDate", templatesAndDataByDestinationRelFilepath, artifactName)}
+}
+
+func (_c *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call) Run(run func(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string)) *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call) Return(_a0 bool, _a1 error) *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call) RunAndReturn(run func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (bool, error)) *MockServiceNetwork_AreRenderedTemplatesUpToDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanBlockTrafficWithoutSidecars provides a mock function with given fields:
+func (_m *MockServiceNetwork) CanBlockTrafficWithoutSidecars() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanBlockTrafficWithoutSidecars'
+type MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call struct {
+	*mock.Call
+}
+
+// CanBlockTrafficWithoutSidecars is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) CanBlockTrafficWithoutSidecars() *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call {
+	return &MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call{Call: _e.mock.On("CanBlockTrafficWithoutSidecars")}
+}
+
+func (_c *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call) Run(run func()) *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call) Return(_a0 bool) *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call) RunAndReturn(run func() bool) *MockServiceNetwork_CanBlockTrafficWithoutSidecars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelConnectionChanges provides a mock function with given fields: name
+func (_m *MockServiceNetwork) CancelConnectionChanges(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CancelConnectionChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelConnectionChanges'
+type MockServiceNetwork_CancelConnectionChanges_Call struct {
+	*mock.Call
+}
+
+// CancelConnectionChanges is a helper method to define mock.On call
+//   - name string
+func (_e *MockServiceNetwork_Expecter) CancelConnectionChanges(name interface{}) *MockServiceNetwork_CancelConnectionChanges_Call {
+	return &MockServiceNetwork_CancelConnectionChanges_Call{Call: _e.mock.On("CancelConnectionChanges", name)}
+}
+
+func (_c *MockServiceNetwork_CancelConnectionChanges_Call) Run(run func(name string)) *MockServiceNetwork_CancelConnectionChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CancelConnectionChanges_Call) Return(_a0 error) *MockServiceNetwork_CancelConnectionChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CancelConnectionChanges_Call) RunAndReturn(run func(string) error) *MockServiceNetwork_CancelConnectionChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CaptureServiceTraffic provides a mock function with given fields: ctx, serviceIdentifier, duration, filter, output
+func (_m *MockServiceNetwork) CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error {
+	ret := _m.Called(ctx, serviceIdentifier, duration, filter, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration, string, io.Writer) error); ok {
+		r0 = rf(ctx, serviceIdentifier, duration, filter, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CaptureServiceTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureServiceTraffic'
+type MockServiceNetwork_CaptureServiceTraffic_Call struct {
+	*mock.Call
+}
+
+// CaptureServiceTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - duration time.Duration
+//   - filter string
+//   - output io.Writer
+func (_e *MockServiceNetwork_Expecter) CaptureServiceTraffic(ctx interface{}, serviceIdentifier interface{}, duration interface{}, filter interface{}, output interface{}) *MockServiceNetwork_CaptureServiceTraffic_Call {
+	return &MockServiceNetwork_CaptureServiceTraffic_Call{Call: _e.mock.On("CaptureServiceTraffic", ctx, serviceIdentifier, duration, filter, output)}
+}
+
+func (_c *MockServiceNetwork_CaptureServiceTraffic_Call) Run(run func(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer)) *MockServiceNetwork_CaptureServiceTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration), args[3].(string), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CaptureServiceTraffic_Call) Return(_a0 error) *MockServiceNetwork_CaptureServiceTraffic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CaptureServiceTraffic_Call) RunAndReturn(run func(context.Context, string, time.Duration, string, io.Writer) error) *MockServiceNetwork_CaptureServiceTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, artifactName
 func (_m *MockServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier, srcPath, artifactName)
@@ -87,6 +277,58 @@ func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// DoesFilesArtifactExist provides a mock function with given fields: artifactName
+func (_m *MockServiceNetwork) DoesFilesArtifactExist(artifactName string) (bool, error) {
+	ret := _m.Called(artifactName)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(artifactName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_DoesFilesArtifactExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoesFilesArtifactExist'
+type MockServiceNetwork_DoesFilesArtifactExist_Call struct {
+	*mock.Call
+}
+
+// DoesFilesArtifactExist is a helper method to define mock.On call
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) DoesFilesArtifactExist(artifactName interface{}) *MockServiceNetwork_DoesFilesArtifactExist_Call {
+	return &MockServiceNetwork_DoesFilesArtifactExist_Call{Call: _e.mock.On("DoesFilesArtifactExist", artifactName)}
+}
+
+func (_c *MockServiceNetwork_DoesFilesArtifactExist_Call) Run(run func(artifactName string)) *MockServiceNetwork_DoesFilesArtifactExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_DoesFilesArtifactExist_Call) Return(_a0 bool, _a1 error) *MockServiceNetwork_DoesFilesArtifactExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_DoesFilesArtifactExist_Call) RunAndReturn(run func(string) (bool, error)) *MockServiceNetwork_DoesFilesArtifactExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecCommand provides a mock function with given fields: ctx, serviceIdentifier, command
 func (_m *MockServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
 	ret := _m.Called(ctx, serviceIdentifier, command)
@@ -148,6 +390,167 @@ func (_c *MockServiceNetwork_ExecCommand_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetConnection provides a mock function with given fields: partition1, partition2
+func (_m *MockServiceNetwork) GetConnection(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	ret := _m.Called(partition1, partition2)
+
+	var r0 bool
+	var r1 partition_topology.PartitionConnection
+	var r2 error
+	if rf, ok := ret.Get(0).(func(service_network_types.PartitionID, service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)); ok {
+		return rf(partition1, partition2)
+	}
+	if rf, ok := ret.Get(0).(func(service_network_types.PartitionID, service_network_types.PartitionID) bool); ok {
+		r0 = rf(partition1, partition2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(service_network_types.PartitionID, service_network_types.PartitionID) partition_topology.PartitionConnection); ok {
+		r1 = rf(partition1, partition2)
+	} else {
+		r1 = ret.Get(1).(partition_topology.PartitionConnection)
+	}
+
+	if rf, ok := ret.Get(2).(func(service_network_types.PartitionID, service_network_types.PartitionID) error); ok {
+		r2 = rf(partition1, partition2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnection'
+type MockServiceNetwork_GetConnection_Call struct {
+	*mock.Call
+}
+
+// GetConnection is a helper method to define mock.On call
+//   - partition1 service_network_types.PartitionID
+//   - partition2 service_network_types.PartitionID
+func (_e *MockServiceNetwork_Expecter) GetConnection(partition1 interface{}, partition2 interface{}) *MockServiceNetwork_GetConnection_Call {
+	return &MockServiceNetwork_GetConnection_Call{Call: _e.mock.On("GetConnection", partition1, partition2)}
+}
+
+func (_c *MockServiceNetwork_GetConnection_Call) Run(run func(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID)) *MockServiceNetwork_GetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service_network_types.PartitionID), args[1].(service_network_types.PartitionID))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetConnection_Call) Return(_a0 bool, _a1 partition_topology.PartitionConnection, _a2 error) *MockServiceNetwork_GetConnection_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetConnection_Call) RunAndReturn(run func(service_network_types.PartitionID, service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)) *MockServiceNetwork_GetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultConnection provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetDefaultConnection() partition_topology.PartitionConnection {
+	ret := _m.Called()
+
+	var r0 partition_topology.PartitionConnection
+	if rf, ok := ret.Get(0).(func() partition_topology.PartitionConnection); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(partition_topology.PartitionConnection)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_GetDefaultConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultConnection'
+type MockServiceNetwork_GetDefaultConnection_Call struct {
+	*mock.Call
+}
+
+// GetDefaultConnection is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetDefaultConnection() *MockServiceNetwork_GetDefaultConnection_Call {
+	return &MockServiceNetwork_GetDefaultConnection_Call{Call: _e.mock.On("GetDefaultConnection")}
+}
+
+func (_c *MockServiceNetwork_GetDefaultConnection_Call) Run(run func()) *MockServiceNetwork_GetDefaultConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetDefaultConnection_Call) Return(_a0 partition_topology.PartitionConnection) *MockServiceNetwork_GetDefaultConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetDefaultConnection_Call) RunAndReturn(run func() partition_topology.PartitionConnection) *MockServiceNetwork_GetDefaultConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDirectionalConnection provides a mock function with given fields: from, to
+func (_m *MockServiceNetwork) GetDirectionalConnection(from service_network_types.PartitionID, to service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	ret := _m.Called(from, to)
+
+	var r0 bool
+	var r1 partition_topology.PartitionConnection
+	var r2 error
+	if rf, ok := ret.Get(0).(func(service_network_types.PartitionID, service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)); ok {
+		return rf(from, to)
+	}
+	if rf, ok := ret.Get(0).(func(service_network_types.PartitionID, service_network_types.PartitionID) bool); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(service_network_types.PartitionID, service_network_types.PartitionID) partition_topology.PartitionConnection); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Get(1).(partition_topology.PartitionConnection)
+	}
+
+	if rf, ok := ret.Get(2).(func(service_network_types.PartitionID, service_network_types.PartitionID) error); ok {
+		r2 = rf(from, to)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetDirectionalConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDirectionalConnection'
+type MockServiceNetwork_GetDirectionalConnection_Call struct {
+	*mock.Call
+}
+
+// GetDirectionalConnection is a helper method to define mock.On call
+//   - from service_network_types.PartitionID
+//   - to service_network_types.PartitionID
+func (_e *MockServiceNetwork_Expecter) GetDirectionalConnection(from interface{}, to interface{}) *MockServiceNetwork_GetDirectionalConnection_Call {
+	return &MockServiceNetwork_GetDirectionalConnection_Call{Call: _e.mock.On("GetDirectionalConnection", from, to)}
+}
+
+func (_c *MockServiceNetwork_GetDirectionalConnection_Call) Run(run func(from service_network_types.PartitionID, to service_network_types.PartitionID)) *MockServiceNetwork_GetDirectionalConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service_network_types.PartitionID), args[1].(service_network_types.PartitionID))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetDirectionalConnection_Call) Return(_a0 bool, _a1 partition_topology.PartitionConnection, _a2 error) *MockServiceNetwork_GetDirectionalConnection_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetDirectionalConnection_Call) RunAndReturn(run func(service_network_types.PartitionID, service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)) *MockServiceNetwork_GetDirectionalConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExistingAndHistoricalServiceIdentifiers provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
 	ret := _m.Called()
@@ -246,6 +649,176 @@ func (_c *MockServiceNetwork_GetService_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetServiceConfig provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServiceConfig(serviceName service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool) {
+	ret := _m.Called(serviceName)
+
+	var r0 *kurtosis_core_rpc_api_bindings.ServiceConfig
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) *kurtosis_core_rpc_api_bindings.ServiceConfig); ok {
+		r0 = rf(serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kurtosis_core_rpc_api_bindings.ServiceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceConfig'
+type MockServiceNetwork_GetServiceConfig_Call struct {
+	*mock.Call
+}
+
+// GetServiceConfig is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceConfig(serviceName interface{}) *MockServiceNetwork_GetServiceConfig_Call {
+	return &MockServiceNetwork_GetServiceConfig_Call{Call: _e.mock.On("GetServiceConfig", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) Return(_a0 *kurtosis_core_rpc_api_bindings.ServiceConfig, _a1 bool) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) RunAndReturn(run func(service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool)) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceConnection provides a mock function with given fields: service1, service2
+func (_m *MockServiceNetwork) GetServiceConnection(service1 service.ServiceName, service2 service.ServiceName) (bool, partition_topology.PartitionConnection, error) {
+	ret := _m.Called(service1, service2)
+
+	var r0 bool
+	var r1 partition_topology.PartitionConnection
+	var r2 error
+	if rf, ok := ret.Get(0).(func(service.ServiceName, service.ServiceName) (bool, partition_topology.PartitionConnection, error)); ok {
+		return rf(service1, service2)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName, service.ServiceName) bool); ok {
+		r0 = rf(service1, service2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName, service.ServiceName) partition_topology.PartitionConnection); ok {
+		r1 = rf(service1, service2)
+	} else {
+		r1 = ret.Get(1).(partition_topology.PartitionConnection)
+	}
+
+	if rf, ok := ret.Get(2).(func(service.ServiceName, service.ServiceName) error); ok {
+		r2 = rf(service1, service2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetServiceConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceConnection'
+type MockServiceNetwork_GetServiceConnection_Call struct {
+	*mock.Call
+}
+
+// GetServiceConnection is a helper method to define mock.On call
+//   - service1 service.ServiceName
+//   - service2 service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceConnection(service1 interface{}, service2 interface{}) *MockServiceNetwork_GetServiceConnection_Call {
+	return &MockServiceNetwork_GetServiceConnection_Call{Call: _e.mock.On("GetServiceConnection", service1, service2)}
+}
+
+func (_c *MockServiceNetwork_GetServiceConnection_Call) Run(run func(service1 service.ServiceName, service2 service.ServiceName)) *MockServiceNetwork_GetServiceConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName), args[1].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConnection_Call) Return(_a0 bool, _a1 partition_topology.PartitionConnection, _a2 error) *MockServiceNetwork_GetServiceConnection_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConnection_Call) RunAndReturn(run func(service.ServiceName, service.ServiceName) (bool, partition_topology.PartitionConnection, error)) *MockServiceNetwork_GetServiceConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceLogs provides a mock function with given fields: ctx, serviceIdentifier, numLogLines
+func (_m *MockServiceNetwork) GetServiceLogs(ctx context.Context, serviceIdentifier string, numLogLines int) ([]string, error) {
+	ret := _m.Called(ctx, serviceIdentifier, numLogLines)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, serviceIdentifier, numLogLines)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, serviceIdentifier, numLogLines)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, serviceIdentifier, numLogLines)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceLogs'
+type MockServiceNetwork_GetServiceLogs_Call struct {
+	*mock.Call
+}
+
+// GetServiceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - numLogLines int
+func (_e *MockServiceNetwork_Expecter) GetServiceLogs(ctx interface{}, serviceIdentifier interface{}, numLogLines interface{}) *MockServiceNetwork_GetServiceLogs_Call {
+	return &MockServiceNetwork_GetServiceLogs_Call{Call: _e.mock.On("GetServiceLogs", ctx, serviceIdentifier, numLogLines)}
+}
+
+func (_c *MockServiceNetwork_GetServiceLogs_Call) Run(run func(ctx context.Context, serviceIdentifier string, numLogLines int)) *MockServiceNetwork_GetServiceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceLogs_Call) Return(_a0 []string, _a1 error) *MockServiceNetwork_GetServiceLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceLogs_Call) RunAndReturn(run func(context.Context, string, int) ([]string, error)) *MockServiceNetwork_GetServiceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceNames provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
 	ret := _m.Called()
@@ -262,29 +835,88 @@ func (_m *MockServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
 	return r0
 }
 
-// MockServiceNetwork_GetServiceNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceNames'
-type MockServiceNetwork_GetServiceNames_Call struct {
+// MockServiceNetwork_GetServiceNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceNames'
+type MockServiceNetwork_GetServiceNames_Call struct {
+	*mock.Call
+}
+
+// GetServiceNames is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServiceNames() *MockServiceNetwork_GetServiceNames_Call {
+	return &MockServiceNetwork_GetServiceNames_Call{Call: _e.mock.On("GetServiceNames")}
+}
+
+func (_c *MockServiceNetwork_GetServiceNames_Call) Run(run func()) *MockServiceNetwork_GetServiceNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceNames_Call) Return(_a0 map[service.ServiceName]bool) *MockServiceNetwork_GetServiceNames_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceNames_Call) RunAndReturn(run func() map[service.ServiceName]bool) *MockServiceNetwork_GetServiceNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServicePartition provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServicePartition(serviceName service.ServiceName) (service_network_types.PartitionID, bool, error) {
+	ret := _m.Called(serviceName)
+
+	var r0 service_network_types.PartitionID
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (service_network_types.PartitionID, bool, error)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) service_network_types.PartitionID); ok {
+		r0 = rf(serviceName)
+	} else {
+		r0 = ret.Get(0).(service_network_types.PartitionID)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(service.ServiceName) error); ok {
+		r2 = rf(serviceName)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetServicePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServicePartition'
+type MockServiceNetwork_GetServicePartition_Call struct {
 	*mock.Call
 }
 
-// GetServiceNames is a helper method to define mock.On call
-func (_e *MockServiceNetwork_Expecter) GetServiceNames() *MockServiceNetwork_GetServiceNames_Call {
-	return &MockServiceNetwork_GetServiceNames_Call{Call: _e.mock.On("GetServiceNames")}
+// GetServicePartition is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServicePartition(serviceName interface{}) *MockServiceNetwork_GetServicePartition_Call {
+	return &MockServiceNetwork_GetServicePartition_Call{Call: _e.mock.On("GetServicePartition", serviceName)}
 }
 
-func (_c *MockServiceNetwork_GetServiceNames_Call) Run(run func()) *MockServiceNetwork_GetServiceNames_Call {
+func (_c *MockServiceNetwork_GetServicePartition_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServicePartition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(service.ServiceName))
 	})
 	return _c
 }
 
-func (_c *MockServiceNetwork_GetServiceNames_Call) Return(_a0 map[service.ServiceName]bool) *MockServiceNetwork_GetServiceNames_Call {
-	_c.Call.Return(_a0)
+func (_c *MockServiceNetwork_GetServicePartition_Call) Return(_a0 service_network_types.PartitionID, _a1 bool, _a2 error) *MockServiceNetwork_GetServicePartition_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockServiceNetwork_GetServiceNames_Call) RunAndReturn(run func() map[service.ServiceName]bool) *MockServiceNetwork_GetServiceNames_Call {
+func (_c *MockServiceNetwork_GetServicePartition_Call) RunAndReturn(run func(service.ServiceName) (service_network_types.PartitionID, bool, error)) *MockServiceNetwork_GetServicePartition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -343,6 +975,61 @@ func (_c *MockServiceNetwork_GetServiceRegistration_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetTrafficStatistics provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) GetTrafficStatistics(ctx context.Context, serviceIdentifier string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error) {
+	ret := _m.Called(ctx, serviceIdentifier)
+
+	var r0 map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error)); ok {
+		return rf(ctx, serviceIdentifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics); ok {
+		r0 = rf(ctx, serviceIdentifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetTrafficStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrafficStatistics'
+type MockServiceNetwork_GetTrafficStatistics_Call struct {
+	*mock.Call
+}
+
+// GetTrafficStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) GetTrafficStatistics(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_GetTrafficStatistics_Call {
+	return &MockServiceNetwork_GetTrafficStatistics_Call{Call: _e.mock.On("GetTrafficStatistics", ctx, serviceIdentifier)}
+}
+
+func (_c *MockServiceNetwork_GetTrafficStatistics_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_GetTrafficStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetTrafficStatistics_Call) Return(_a0 map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, _a1 error) *MockServiceNetwork_GetTrafficStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetTrafficStatistics_Call) RunAndReturn(run func(context.Context, string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error)) *MockServiceNetwork_GetTrafficStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueNameForFileArtifact provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
 	ret := _m.Called()
@@ -454,6 +1141,59 @@ func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// IsFilesArtifactUpToDate provides a mock function with given fields: compressedData, artifactName
+func (_m *MockServiceNetwork) IsFilesArtifactUpToDate(compressedData []byte, artifactName string) (bool, error) {
+	ret := _m.Called(compressedData, artifactName)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte, string) (bool, error)); ok {
+		return rf(compressedData, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, string) bool); ok {
+		r0 = rf(compressedData, artifactName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, string) error); ok {
+		r1 = rf(compressedData, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_IsFilesArtifactUpToDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFilesArtifactUpToDate'
+type MockServiceNetwork_IsFilesArtifactUpToDate_Call struct {
+	*mock.Call
+}
+
+// IsFilesArtifactUpToDate is a helper method to define mock.On call
+//   - compressedData []byte
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) IsFilesArtifactUpToDate(compressedData interface{}, artifactName interface{}) *MockServiceNetwork_IsFilesArtifactUpToDate_Call {
+	return &MockServiceNetwork_IsFilesArtifactUpToDate_Call{Call: _e.mock.On("IsFilesArtifactUpToDate", compressedData, artifactName)}
+}
+
+func (_c *MockServiceNetwork_IsFilesArtifactUpToDate_Call) Run(run func(compressedData []byte, artifactName string)) *MockServiceNetwork_IsFilesArtifactUpToDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_IsFilesArtifactUpToDate_Call) Return(_a0 bool, _a1 error) *MockServiceNetwork_IsFilesArtifactUpToDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_IsFilesArtifactUpToDate_Call) RunAndReturn(run func([]byte, string) (bool, error)) *MockServiceNetwork_IsFilesArtifactUpToDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsNetworkPartitioningEnabled provides a mock function with given fields:
 func (_m *MockServiceNetwork) IsNetworkPartitioningEnabled() bool {
 	ret := _m.Called()
@@ -538,6 +1278,112 @@ func (_c *MockServiceNetwork_PauseService_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// RemoveFilesArtifact provides a mock function with given fields: artifactName
+func (_m *MockServiceNetwork) RemoveFilesArtifact(artifactName string) error {
+	ret := _m.Called(artifactName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(artifactName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_RemoveFilesArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFilesArtifact'
+type MockServiceNetwork_RemoveFilesArtifact_Call struct {
+	*mock.Call
+}
+
+// RemoveFilesArtifact is a helper method to define mock.On call
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) RemoveFilesArtifact(artifactName interface{}) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	return &MockServiceNetwork_RemoveFilesArtifact_Call{Call: _e.mock.On("RemoveFilesArtifact", artifactName)}
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) Run(run func(artifactName string)) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) Return(_a0 error) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) RunAndReturn(run func(string) error) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecreateServices provides a mock function with given fields: ctx, serviceConfigs
+func (_m *MockServiceNetwork) RecreateServices(ctx context.Context, serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceConfigs)
+
+	var r0 map[service.ServiceName]*service.Service
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceConfigs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) map[service.ServiceName]*service.Service); ok {
+		r0 = rf(ctx, serviceConfigs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceConfigs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) error); ok {
+		r2 = rf(ctx, serviceConfigs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_RecreateServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecreateServices'
+type MockServiceNetwork_RecreateServices_Call struct {
+	*mock.Call
+}
+
+// RecreateServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+func (_e *MockServiceNetwork_Expecter) RecreateServices(ctx interface{}, serviceConfigs interface{}) *MockServiceNetwork_RecreateServices_Call {
+	return &MockServiceNetwork_RecreateServices_Call{Call: _e.mock.On("RecreateServices", ctx, serviceConfigs)}
+}
+
+func (_c *MockServiceNetwork_RecreateServices_Call) Run(run func(ctx context.Context, serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig)) *MockServiceNetwork_RecreateServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RecreateServices_Call) Return(_a0 map[service.ServiceName]*service.Service, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_RecreateServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RecreateServices_Call) RunAndReturn(run func(context.Context, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error)) *MockServiceNetwork_RecreateServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -574,40 +1420,140 @@ func (_e *MockServiceNetwork_Expecter) RemoveService(ctx interface{}, serviceIde
 	return &MockServiceNetwork_RemoveService_Call{Call: _e.mock.On("RemoveService", ctx, serviceIdentifier)}
 }
 
-func (_c *MockServiceNetwork_RemoveService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_RemoveService_Call {
+func (_c *MockServiceNetwork_RemoveService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_RemoveService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveService_Call) Return(_a0 service.ServiceUUID, _a1 error) *MockServiceNetwork_RemoveService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.Context, string) (service.ServiceUUID, error)) *MockServiceNetwork_RemoveService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
+func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
+
+	var r0 enclave_data_directory.FilesArtifactUUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	} else {
+		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) error); ok {
+		r1 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RenderTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderTemplates'
+type MockServiceNetwork_RenderTemplates_Call struct {
+	*mock.Call
+}
+
+// RenderTemplates is a helper method to define mock.On call
+//   - templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) RenderTemplates(templatesAndDataByDestinationRelFilepath interface{}, artifactName interface{}) *MockServiceNetwork_RenderTemplates_Call {
+	return &MockServiceNetwork_RenderTemplates_Call{Call: _e.mock.On("RenderTemplates", templatesAndDataByDestinationRelFilepath, artifactName)}
+}
+
+func (_c *MockServiceNetwork_RenderTemplates_Call) Run(run func(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string)) *MockServiceNetwork_RenderTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RenderTemplates_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_RenderTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_RenderTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Repartition provides a mock function with given fields: ctx, newPartitionServices, newPartitionConnections, newDefaultConnection
+func (_m *MockServiceNetwork) Repartition(ctx context.Context, newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool, newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, newDefaultConnection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service_network_types.PartitionID]map[service.ServiceName]bool, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_Repartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repartition'
+type MockServiceNetwork_Repartition_Call struct {
+	*mock.Call
+}
+
+// Repartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool
+//   - newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection
+//   - newDefaultConnection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) Repartition(ctx interface{}, newPartitionServices interface{}, newPartitionConnections interface{}, newDefaultConnection interface{}) *MockServiceNetwork_Repartition_Call {
+	return &MockServiceNetwork_Repartition_Call{Call: _e.mock.On("Repartition", ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)}
+}
+
+func (_c *MockServiceNetwork_Repartition_Call) Run(run func(ctx context.Context, newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool, newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, newDefaultConnection partition_topology.PartitionConnection)) *MockServiceNetwork_Repartition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(map[service_network_types.PartitionID]map[service.ServiceName]bool), args[2].(map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection), args[3].(partition_topology.PartitionConnection))
 	})
 	return _c
 }
 
-func (_c *MockServiceNetwork_RemoveService_Call) Return(_a0 service.ServiceUUID, _a1 error) *MockServiceNetwork_RemoveService_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockServiceNetwork_Repartition_Call) Return(_a0 error) *MockServiceNetwork_Repartition_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.Context, string) (service.ServiceUUID, error)) *MockServiceNetwork_RemoveService_Call {
+func (_c *MockServiceNetwork_Repartition_Call) RunAndReturn(run func(context.Context, map[service_network_types.PartitionID]map[service.ServiceName]bool, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error) *MockServiceNetwork_Repartition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
-func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
+// RestartService provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) RestartService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	ret := _m.Called(ctx, serviceIdentifier)
 
-	var r0 enclave_data_directory.FilesArtifactUUID
+	var r0 *service.Service
 	var r1 error
-	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
-		return rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*service.Service, error)); ok {
+		return rf(ctx, serviceIdentifier)
 	}
-	if rf, ok := ret.Get(0).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) enclave_data_directory.FilesArtifactUUID); ok {
-		r0 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *service.Service); ok {
+		r0 = rf(ctx, serviceIdentifier)
 	} else {
-		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.Service)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) error); ok {
-		r1 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -615,42 +1561,42 @@ func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFi
 	return r0, r1
 }
 
-// MockServiceNetwork_RenderTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderTemplates'
-type MockServiceNetwork_RenderTemplates_Call struct {
+// MockServiceNetwork_RestartService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartService'
+type MockServiceNetwork_RestartService_Call struct {
 	*mock.Call
 }
 
-// RenderTemplates is a helper method to define mock.On call
-//   - templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData
-//   - artifactName string
-func (_e *MockServiceNetwork_Expecter) RenderTemplates(templatesAndDataByDestinationRelFilepath interface{}, artifactName interface{}) *MockServiceNetwork_RenderTemplates_Call {
-	return &MockServiceNetwork_RenderTemplates_Call{Call: _e.mock.On("RenderTemplates", templatesAndDataByDestinationRelFilepath, artifactName)}
+// RestartService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) RestartService(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_RestartService_Call {
+	return &MockServiceNetwork_RestartService_Call{Call: _e.mock.On("RestartService", ctx, serviceIdentifier)}
 }
 
-func (_c *MockServiceNetwork_RenderTemplates_Call) Run(run func(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string)) *MockServiceNetwork_RenderTemplates_Call {
+func (_c *MockServiceNetwork_RestartService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_RestartService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData), args[1].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockServiceNetwork_RenderTemplates_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_RenderTemplates_Call {
+func (_c *MockServiceNetwork_RestartService_Call) Return(_a0 *service.Service, _a1 error) *MockServiceNetwork_RestartService_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_RenderTemplates_Call {
+func (_c *MockServiceNetwork_RestartService_Call) RunAndReturn(run func(context.Context, string) (*service.Service, error)) *MockServiceNetwork_RestartService_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Repartition provides a mock function with given fields: ctx, newPartitionServices, newPartitionConnections, newDefaultConnection
-func (_m *MockServiceNetwork) Repartition(ctx context.Context, newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool, newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, newDefaultConnection partition_topology.PartitionConnection) error {
-	ret := _m.Called(ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)
+// ScheduleConnectionChanges provides a mock function with given fields: name, changes
+func (_m *MockServiceNetwork) ScheduleConnectionChanges(name string, changes []*connection_change_scheduler.ConnectionChange) error {
+	ret := _m.Called(name, changes)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, map[service_network_types.PartitionID]map[service.ServiceName]bool, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error); ok {
-		r0 = rf(ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)
+	if rf, ok := ret.Get(0).(func(string, []*connection_change_scheduler.ConnectionChange) error); ok {
+		r0 = rf(name, changes)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -658,33 +1604,31 @@ func (_m *MockServiceNetwork) Repartition(ctx context.Context, newPartitionServi
 	return r0
 }
 
-// MockServiceNetwork_Repartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repartition'
-type MockServiceNetwork_Repartition_Call struct {
+// MockServiceNetwork_ScheduleConnectionChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleConnectionChanges'
+type MockServiceNetwork_ScheduleConnectionChanges_Call struct {
 	*mock.Call
 }
 
-// Repartition is a helper method to define mock.On call
-//   - ctx context.Context
-//   - newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool
-//   - newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection
-//   - newDefaultConnection partition_topology.PartitionConnection
-func (_e *MockServiceNetwork_Expecter) Repartition(ctx interface{}, newPartitionServices interface{}, newPartitionConnections interface{}, newDefaultConnection interface{}) *MockServiceNetwork_Repartition_Call {
-	return &MockServiceNetwork_Repartition_Call{Call: _e.mock.On("Repartition", ctx, newPartitionServices, newPartitionConnections, newDefaultConnection)}
+// ScheduleConnectionChanges is a helper method to define mock.On call
+//   - name string
+//   - changes []*connection_change_scheduler.ConnectionChange
+func (_e *MockServiceNetwork_Expecter) ScheduleConnectionChanges(name interface{}, changes interface{}) *MockServiceNetwork_ScheduleConnectionChanges_Call {
+	return &MockServiceNetwork_ScheduleConnectionChanges_Call{Call: _e.mock.On("ScheduleConnectionChanges", name, changes)}
 }
 
-func (_c *MockServiceNetwork_Repartition_Call) Run(run func(ctx context.Context, newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool, newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, newDefaultConnection partition_topology.PartitionConnection)) *MockServiceNetwork_Repartition_Call {
+func (_c *MockServiceNetwork_ScheduleConnectionChanges_Call) Run(run func(name string, changes []*connection_change_scheduler.ConnectionChange)) *MockServiceNetwork_ScheduleConnectionChanges_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(map[service_network_types.PartitionID]map[service.ServiceName]bool), args[2].(map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection), args[3].(partition_topology.PartitionConnection))
+		run(args[0].(string), args[1].([]*connection_change_scheduler.ConnectionChange))
 	})
 	return _c
 }
 
-func (_c *MockServiceNetwork_Repartition_Call) Return(_a0 error) *MockServiceNetwork_Repartition_Call {
+func (_c *MockServiceNetwork_ScheduleConnectionChanges_Call) Return(_a0 error) *MockServiceNetwork_ScheduleConnectionChanges_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockServiceNetwork_Repartition_Call) RunAndReturn(run func(context.Context, map[service_network_types.PartitionID]map[service.ServiceName]bool, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error) *MockServiceNetwork_Repartition_Call {
+func (_c *MockServiceNetwork_ScheduleConnectionChanges_Call) RunAndReturn(run func(string, []*connection_change_scheduler.ConnectionChange) error) *MockServiceNetwork_ScheduleConnectionChanges_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -777,6 +1721,96 @@ func (_c *MockServiceNetwork_SetDefaultConnection_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// SetDirectionalConnection provides a mock function with given fields: ctx, from, to, connection
+func (_m *MockServiceNetwork) SetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID, connection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, from, to, connection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service_network_types.PartitionID, service_network_types.PartitionID, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, from, to, connection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetDirectionalConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDirectionalConnection'
+type MockServiceNetwork_SetDirectionalConnection_Call struct {
+	*mock.Call
+}
+
+// SetDirectionalConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from service_network_types.PartitionID
+//   - to service_network_types.PartitionID
+//   - connection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) SetDirectionalConnection(ctx interface{}, from interface{}, to interface{}, connection interface{}) *MockServiceNetwork_SetDirectionalConnection_Call {
+	return &MockServiceNetwork_SetDirectionalConnection_Call{Call: _e.mock.On("SetDirectionalConnection", ctx, from, to, connection)}
+}
+
+func (_c *MockServiceNetwork_SetDirectionalConnection_Call) Run(run func(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID, connection partition_topology.PartitionConnection)) *MockServiceNetwork_SetDirectionalConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service_network_types.PartitionID), args[2].(service_network_types.PartitionID), args[3].(partition_topology.PartitionConnection))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetDirectionalConnection_Call) Return(_a0 error) *MockServiceNetwork_SetDirectionalConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetDirectionalConnection_Call) RunAndReturn(run func(context.Context, service_network_types.PartitionID, service_network_types.PartitionID, partition_topology.PartitionConnection) error) *MockServiceNetwork_SetDirectionalConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetServiceConnection provides a mock function with given fields: ctx, service1, service2, connection
+func (_m *MockServiceNetwork) SetServiceConnection(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName, connection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, service1, service2, connection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, service.ServiceName, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, service1, service2, connection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetServiceConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetServiceConnection'
+type MockServiceNetwork_SetServiceConnection_Call struct {
+	*mock.Call
+}
+
+// SetServiceConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - service1 service.ServiceName
+//   - service2 service.ServiceName
+//   - connection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) SetServiceConnection(ctx interface{}, service1 interface{}, service2 interface{}, connection interface{}) *MockServiceNetwork_SetServiceConnection_Call {
+	return &MockServiceNetwork_SetServiceConnection_Call{Call: _e.mock.On("SetServiceConnection", ctx, service1, service2, connection)}
+}
+
+func (_c *MockServiceNetwork_SetServiceConnection_Call) Run(run func(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName, connection partition_topology.PartitionConnection)) *MockServiceNetwork_SetServiceConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ServiceName), args[2].(service.ServiceName), args[3].(partition_topology.PartitionConnection))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServiceConnection_Call) Return(_a0 error) *MockServiceNetwork_SetServiceConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServiceConnection_Call) RunAndReturn(run func(context.Context, service.ServiceName, service.ServiceName, partition_topology.PartitionConnection) error) *MockServiceNetwork_SetServiceConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceName, serviceConfig
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) (*service.Service, error) {
 	ret := _m.Called(ctx, serviceName, serviceConfig)
@@ -898,6 +1932,64 @@ func (_c *MockServiceNetwork_StartServices_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// StoreServiceTrafficCapture provides a mock function with given fields: ctx, serviceIdentifier, duration, filter, artifactName
+func (_m *MockServiceNetwork) StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(ctx, serviceIdentifier, duration, filter, artifactName)
+
+	var r0 enclave_data_directory.FilesArtifactUUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(ctx, serviceIdentifier, duration, filter, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration, string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(ctx, serviceIdentifier, duration, filter, artifactName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration, string, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier, duration, filter, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_StoreServiceTrafficCapture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreServiceTrafficCapture'
+type MockServiceNetwork_StoreServiceTrafficCapture_Call struct {
+	*mock.Call
+}
+
+// StoreServiceTrafficCapture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - duration time.Duration
+//   - filter string
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) StoreServiceTrafficCapture(ctx interface{}, serviceIdentifier interface{}, duration interface{}, filter interface{}, artifactName interface{}) *MockServiceNetwork_StoreServiceTrafficCapture_Call {
+	return &MockServiceNetwork_StoreServiceTrafficCapture_Call{Call: _e.mock.On("StoreServiceTrafficCapture", ctx, serviceIdentifier, duration, filter, artifactName)}
+}
+
+func (_c *MockServiceNetwork_StoreServiceTrafficCapture_Call) Run(run func(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string)) *MockServiceNetwork_StoreServiceTrafficCapture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_StoreServiceTrafficCapture_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_StoreServiceTrafficCapture_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_StoreServiceTrafficCapture_Call) RunAndReturn(run func(context.Context, string, time.Duration, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_StoreServiceTrafficCapture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnpauseService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) UnpauseService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -985,6 +2077,94 @@ func (_c *MockServiceNetwork_UnsetConnection_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UnsetDirectionalConnection provides a mock function with given fields: ctx, from, to
+func (_m *MockServiceNetwork) UnsetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID) error {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service_network_types.PartitionID, service_network_types.PartitionID) error); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_UnsetDirectionalConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetDirectionalConnection'
+type MockServiceNetwork_UnsetDirectionalConnection_Call struct {
+	*mock.Call
+}
+
+// UnsetDirectionalConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from service_network_types.PartitionID
+//   - to service_network_types.PartitionID
+func (_e *MockServiceNetwork_Expecter) UnsetDirectionalConnection(ctx interface{}, from interface{}, to interface{}) *MockServiceNetwork_UnsetDirectionalConnection_Call {
+	return &MockServiceNetwork_UnsetDirectionalConnection_Call{Call: _e.mock.On("UnsetDirectionalConnection", ctx, from, to)}
+}
+
+func (_c *MockServiceNetwork_UnsetDirectionalConnection_Call) Run(run func(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID)) *MockServiceNetwork_UnsetDirectionalConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service_network_types.PartitionID), args[2].(service_network_types.PartitionID))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetDirectionalConnection_Call) Return(_a0 error) *MockServiceNetwork_UnsetDirectionalConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetDirectionalConnection_Call) RunAndReturn(run func(context.Context, service_network_types.PartitionID, service_network_types.PartitionID) error) *MockServiceNetwork_UnsetDirectionalConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsetServiceConnection provides a mock function with given fields: ctx, service1, service2
+func (_m *MockServiceNetwork) UnsetServiceConnection(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName) error {
+	ret := _m.Called(ctx, service1, service2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, service.ServiceName) error); ok {
+		r0 = rf(ctx, service1, service2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_UnsetServiceConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetServiceConnection'
+type MockServiceNetwork_UnsetServiceConnection_Call struct {
+	*mock.Call
+}
+
+// UnsetServiceConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - service1 service.ServiceName
+//   - service2 service.ServiceName
+func (_e *MockServiceNetwork_Expecter) UnsetServiceConnection(ctx interface{}, service1 interface{}, service2 interface{}) *MockServiceNetwork_UnsetServiceConnection_Call {
+	return &MockServiceNetwork_UnsetServiceConnection_Call{Call: _e.mock.On("UnsetServiceConnection", ctx, service1, service2)}
+}
+
+func (_c *MockServiceNetwork_UnsetServiceConnection_Call) Run(run func(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName)) *MockServiceNetwork_UnsetServiceConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ServiceName), args[2].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetServiceConnection_Call) Return(_a0 error) *MockServiceNetwork_UnsetServiceConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetServiceConnection_Call) RunAndReturn(run func(context.Context, service.ServiceName, service.ServiceName) error) *MockServiceNetwork_UnsetServiceConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateService provides a mock function with given fields: ctx, updateServiceConfigs
 func (_m *MockServiceNetwork) UpdateService(ctx context.Context, updateServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
 	ret := _m.Called(ctx, updateServiceConfigs)
@@ -1102,6 +2282,60 @@ func (_c *MockServiceNetwork_UploadFilesArtifact_Call) RunAndReturn(run func([]b
 	return _c
 }
 
+// WatchConnectionChanges provides a mock function with given fields: name
+func (_m *MockServiceNetwork) WatchConnectionChanges(name string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error) {
+	ret := _m.Called(name)
+
+	var r0 <-chan *connection_change_scheduler.ConnectionChangeEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) <-chan *connection_change_scheduler.ConnectionChangeEvent); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *connection_change_scheduler.ConnectionChangeEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_WatchConnectionChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchConnectionChanges'
+type MockServiceNetwork_WatchConnectionChanges_Call struct {
+	*mock.Call
+}
+
+// WatchConnectionChanges is a helper method to define mock.On call
+//   - name string
+func (_e *MockServiceNetwork_Expecter) WatchConnectionChanges(name interface{}) *MockServiceNetwork_WatchConnectionChanges_Call {
+	return &MockServiceNetwork_WatchConnectionChanges_Call{Call: _e.mock.On("WatchConnectionChanges", name)}
+}
+
+func (_c *MockServiceNetwork_WatchConnectionChanges_Call) Run(run func(name string)) *MockServiceNetwork_WatchConnectionChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_WatchConnectionChanges_Call) Return(_a0 <-chan *connection_change_scheduler.ConnectionChangeEvent, _a1 error) *MockServiceNetwork_WatchConnectionChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_WatchConnectionChanges_Call) RunAndReturn(run func(string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error)) *MockServiceNetwork_WatchConnectionChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockServiceNetwork interface {
 	mock.TestingT
 	Cleanup(func())
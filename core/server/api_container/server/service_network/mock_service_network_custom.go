@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"io"
 	"net"
 	"net/http"
+	"time"
 )
 
 const (
@@ -57,11 +61,42 @@ func (m *MockServiceNetworkCustom) UnsetConnection(ctx context.Context, partitio
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) SetDirectionalConnection(_ context.Context, _ service_network_types.PartitionID, _ service_network_types.PartitionID, _ partition_topology.PartitionConnection) error {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) UnsetDirectionalConnection(_ context.Context, _ service_network_types.PartitionID, _ service_network_types.PartitionID) error {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) SetServiceConnection(_ context.Context, _ service.ServiceName, _ service.ServiceName, _ partition_topology.PartitionConnection) error {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) UnsetServiceConnection(_ context.Context, _ service.ServiceName, _ service.ServiceName) error {
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) SetDefaultConnection(ctx context.Context, connection partition_topology.PartitionConnection) error {
 	//TODO implement me
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) ScheduleConnectionChanges(name string, changes []*connection_change_scheduler.ConnectionChange) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) CancelConnectionChanges(name string) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) WatchConnectionChanges(name string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) StartService(
 	ctx context.Context,
 	serviceName service.ServiceName,
@@ -92,11 +127,21 @@ func (m *MockServiceNetworkCustom) UpdateService(ctx context.Context, updateServ
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) RecreateServices(ctx context.Context, serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
 	//TODO implement me
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) RestartService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) PauseService(ctx context.Context, serviceIdentifier string) error {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -122,11 +167,31 @@ func (m *MockServiceNetworkCustom) GetService(ctx context.Context, serviceIdenti
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) GetServiceLogs(ctx context.Context, serviceIdentifier string, numLogLines int) ([]string, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	//TODO implement me
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetTrafficStatistics(ctx context.Context, serviceIdentifier string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) GetServiceNames() map[service.ServiceName]bool {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -137,6 +202,30 @@ func (m *MockServiceNetworkCustom) GetServiceRegistration(serviceName service.Se
 	return serviceRegistration, found
 }
 
+func (m *MockServiceNetworkCustom) GetServiceConfig(_ service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetServicePartition(_ service.ServiceName) (service_network_types.PartitionID, bool, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetConnection(_ service_network_types.PartitionID, _ service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetDirectionalConnection(_ service_network_types.PartitionID, _ service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetServiceConnection(_ service.ServiceName, _ service.ServiceName) (bool, partition_topology.PartitionConnection, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetDefaultConnection() partition_topology.PartitionConnection {
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) RenderTemplates(_ map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, _ string) (enclave_data_directory.FilesArtifactUUID, error) {
 	panic(unimplementedMsg)
 }
@@ -145,10 +234,30 @@ func (m *MockServiceNetworkCustom) UploadFilesArtifact(_ []byte, _ string) (encl
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) DoesFilesArtifactExist(_ string) (bool, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) IsFilesArtifactUpToDate(_ []byte, _ string) (bool, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) AreRenderedTemplatesUpToDate(_ map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, _ string) (bool, error) {
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) RemoveFilesArtifact(_ string) error {
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) IsNetworkPartitioningEnabled() bool {
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) CanBlockTrafficWithoutSidecars() bool {
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
 	panic(unimplementedMsg)
 }
@@ -9,15 +9,21 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"io"
 	"net"
+	"time"
 )
 
 type MockNetworkingSidecarWrapper struct {
 	updateFunctionCallsPartitionConnectionConfig []map[string]*partition_topology.PartitionConnection
+	trafficStatisticsPerIpAddress                map[string]*TrafficStatistics
 }
 
 func NewMockNetworkingSidecarWrapper() *MockNetworkingSidecarWrapper {
-	return &MockNetworkingSidecarWrapper{updateFunctionCallsPartitionConnectionConfig: []map[string]*partition_topology.PartitionConnection{}}
+	return &MockNetworkingSidecarWrapper{
+		updateFunctionCallsPartitionConnectionConfig: []map[string]*partition_topology.PartitionConnection{},
+		trafficStatisticsPerIpAddress:                map[string]*TrafficStatistics{},
+	}
 }
 
 func (sidecar *MockNetworkingSidecarWrapper) GetServiceUUID() service.ServiceUUID {
@@ -37,6 +43,18 @@ func (sidecar *MockNetworkingSidecarWrapper) UpdateTrafficControl(ctx context.Co
 	return nil
 }
 
+func (sidecar *MockNetworkingSidecarWrapper) CaptureTraffic(ctx context.Context, duration time.Duration, filter string, output io.Writer) error {
+	return nil
+}
+
+func (sidecar *MockNetworkingSidecarWrapper) GetTrafficStatistics(ctx context.Context) (map[string]*TrafficStatistics, error) {
+	return sidecar.trafficStatisticsPerIpAddress, nil
+}
+
+func (sidecar *MockNetworkingSidecarWrapper) SetTrafficStatistics(trafficStatisticsPerIpAddress map[string]*TrafficStatistics) {
+	sidecar.trafficStatisticsPerIpAddress = trafficStatisticsPerIpAddress
+}
+
 func (sidecar *MockNetworkingSidecarWrapper) GetRecordedUpdatedPacketConnectionConfig() []map[string]*partition_topology.PartitionConnection {
 	return sidecar.updateFunctionCallsPartitionConnectionConfig
 }
@@ -7,10 +7,12 @@ package networking_sidecar
 
 import (
 	"context"
+	"io"
 )
 
 type mockSidecarExecCmdExecutor struct {
 	commands     [][]string
+	output       string
 	isBlocked bool
 	unblockingChan chan interface{}
 }
@@ -18,6 +20,7 @@ type mockSidecarExecCmdExecutor struct {
 func newMockSidecarExecCmdExecutor() *mockSidecarExecCmdExecutor {
 	return &mockSidecarExecCmdExecutor{
 		commands:     [][]string{},
+		output:       "",
 		isBlocked: false,
 		unblockingChan: make(chan interface{}),
 	}
@@ -37,3 +40,18 @@ func (m *mockSidecarExecCmdExecutor) exec(ctx context.Context, unwrappedCmd []st
 	m.commands = append(m.commands, unwrappedCmd)
 	return nil
 }
+
+func (m *mockSidecarExecCmdExecutor) execWithOutput(ctx context.Context, unwrappedCmd []string) (string, error) {
+	if err := m.exec(ctx, unwrappedCmd); err != nil {
+		return "", err
+	}
+	return m.output, nil
+}
+
+func (m *mockSidecarExecCmdExecutor) execWithStreamedOutput(ctx context.Context, unwrappedCmd []string, output io.Writer) error {
+	if err := m.exec(ctx, unwrappedCmd); err != nil {
+		return err
+	}
+	_, err := io.WriteString(output, m.output)
+	return err
+}
@@ -8,6 +8,7 @@ package networking_sidecar
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -21,6 +22,7 @@ import (
 // ==========================================================================================
 type NetworkingSidecarManager interface {
 	Add(ctx context.Context, serviceId service.ServiceUUID) (NetworkingSidecarWrapper, error)
+	Load(ctx context.Context, serviceId service.ServiceUUID) (NetworkingSidecarWrapper, error)
 	Remove(ctx context.Context, sidecar NetworkingSidecarWrapper) error
 }
 
@@ -68,6 +70,56 @@ func (manager *StandardNetworkingSidecarManager) Add(
 	return networkingSidecarWrapper, nil
 }
 
+// Load returns the sidecar attached to the given service ID, re-using the running sidecar container if there's one.
+// Traffic control lives in the network namespace of the service and might still be configured by a previous sidecar,
+// so it is reset and the returned sidecar needs to be initialized as a newly added one
+func (manager *StandardNetworkingSidecarManager) Load(
+	ctx context.Context,
+	serviceUUID service.ServiceUUID,
+) (NetworkingSidecarWrapper, error) {
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		EnclaveUUIDs: map[enclave.EnclaveUUID]bool{
+			manager.enclaveUuid: true,
+		},
+		UserServiceUUIDs: map[service.ServiceUUID]bool{
+			serviceUUID: true,
+		},
+		Statuses: nil,
+	}
+	existingNetworkingSidecars, err := manager.kurtosisBackend.GetNetworkingSidecars(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting networking sidecars using filter '%+v'", filters)
+	}
+
+	networkingSidecar, found := existingNetworkingSidecars[serviceUUID]
+	if !found || networkingSidecar.GetStatus() != container_status.ContainerStatus_Running {
+		if found {
+			if _, _, err := manager.kurtosisBackend.DestroyNetworkingSidecars(ctx, filters); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred destroying the stopped networking sidecar for service with UUID '%v'", serviceUUID)
+			}
+		}
+		networkingSidecar, err = manager.kurtosisBackend.CreateNetworkingSidecar(ctx, manager.enclaveUuid, serviceUUID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating networking sidecar for service with UUID '%v' in enclave with ID '%v'", serviceUUID, manager.enclaveUuid)
+		}
+	}
+
+	execCmdExecutor := newStandardSidecarExecCmdExecutor(
+		manager.kurtosisBackend,
+		networkingSidecar.GetServiceUUID(),
+		networkingSidecar.GetEnclaveUUID())
+
+	networkingSidecarWrapper, err := NewStandardNetworkingSidecarWrapper(networkingSidecar, execCmdExecutor)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating networking sidecar wrapper for networking sidecar with service UUID '%v'", networkingSidecar.GetServiceUUID())
+	}
+	if err := networkingSidecarWrapper.resetTrafficControl(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resetting traffic control in networking sidecar with service UUID '%v'", networkingSidecar.GetServiceUUID())
+	}
+
+	return networkingSidecarWrapper, nil
+}
+
 func (manager *StandardNetworkingSidecarManager) Remove(
 	ctx context.Context,
 	networkingSidecarWrapper NetworkingSidecarWrapper) error {
@@ -13,36 +13,46 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
+	"math"
 	"net"
+	"strings"
 	"sync"
+	"time"
 )
 
 const (
-	tcCommand                   = "tc"
-	tcAddCommand                = "add"
-	tcReplaceCommand            = "replace"
-	tcDeleteCommand             = "del"
-	tcQdiscCommand              = "qdisc"
-	tcQdiscTypeHtb              = "htb"
-	tcQdiscTypeNetem            = "netem"
-	tcQdiscTypeNetemOptionLoss  = "loss"
-	tcQdiscTypeNetemOptionDelay = "delay"
-	tcClassCommand              = "class"
-	tcFilterCommand             = "filter"
-	tcFilterProtocolCommand     = "protocol"
-	tcFilterIPCommand           = "ip"
-	tcFilterPrioCommand         = "prio"
-	tcFilterFlowIDCommand       = "flowid"
-	tcFilterMatchCommand        = "match"
-	tcFilterBasicTypeCommand    = "basic"
-	tcFilterIPMatchTypeCommand  = "ip"
-	tcFilterIPDestCommand       = "dst"
-	tcU32FilterTypeCommand      = "u32"
-	tcDeviceCommand             = "dev"
-	tcHandleCommand             = "handle"
-	tcParentCommand             = "parent"
-	tcClassIDCommand            = "classid"
-	tcRateCommand               = "rate"
+	tcCommand                       = "tc"
+	tcAddCommand                    = "add"
+	tcReplaceCommand                = "replace"
+	tcDeleteCommand                 = "del"
+	tcQdiscCommand                  = "qdisc"
+	tcQdiscTypeHtb                  = "htb"
+	tcQdiscTypeNetem                = "netem"
+	tcQdiscTypeNetemOptionLoss      = "loss"
+	tcQdiscTypeNetemOptionDelay     = "delay"
+	tcQdiscTypeNetemOptionRate      = "rate"
+	tcQdiscTypeNetemOptionDuplicate = "duplicate"
+	tcQdiscTypeNetemOptionCorrupt   = "corrupt"
+	tcQdiscTypeNetemOptionReorder   = "reorder"
+	tcClassCommand                  = "class"
+	tcFilterCommand                 = "filter"
+	tcFilterProtocolCommand         = "protocol"
+	tcFilterIPCommand               = "ip"
+	tcFilterIPv6Command             = "ipv6"
+	tcFilterPrioCommand             = "prio"
+	tcFilterFlowIDCommand           = "flowid"
+	tcFilterMatchCommand            = "match"
+	tcFilterBasicTypeCommand        = "basic"
+	tcFilterIPMatchTypeCommand      = "ip"
+	tcFilterIPv6MatchTypeCommand    = "ip6"
+	tcFilterIPDestCommand           = "dst"
+	tcU32FilterTypeCommand          = "u32"
+	tcDeviceCommand                 = "dev"
+	tcHandleCommand                 = "handle"
+	tcParentCommand                 = "parent"
+	tcClassIDCommand                = "classid"
+	tcRateCommand                   = "rate"
 
 	rootQdiscName                 = "root"
 	defaultDockerNetworkInterface = "eth0"
@@ -62,8 +72,17 @@ const (
 	firstClassIdDecimalMinorNumber = 1
 
 	concatenateCommandsOperator = "&&"
+	orCommandsOperator          = "||"
+	noOpCommand                 = "true"
 
 	firstCommandIndex = 0
+
+	// The packets are written to stdout as they get captured, tcpdump's own messages going to stderr
+	// timeout exits with 124 when the capture lasted the whole duration, and tcpdump with 143 when it got terminated
+	captureTrafficCmdFormat = "timeout %d tcpdump -i %s -U -w - %s; " +
+		"exit_code=$?; " +
+		"if [ $exit_code -eq 124 ] || [ $exit_code -eq 143 ]; then exit 0; fi; " +
+		"exit $exit_code"
 )
 
 // ==========================================================================================
@@ -77,6 +96,8 @@ type NetworkingSidecarWrapper interface {
 	GetIPAddr() net.IP
 	InitializeTrafficControl(ctx context.Context) error
 	UpdateTrafficControl(ctx context.Context, partitionConnectionConfigPerIpAddress map[string]*partition_topology.PartitionConnection) error
+	CaptureTraffic(ctx context.Context, duration time.Duration, filter string, output io.Writer) error
+	GetTrafficStatistics(ctx context.Context) (map[string]*TrafficStatistics, error)
 }
 
 // ==========================================================================================
@@ -99,6 +120,9 @@ type StandardNetworkingSidecarWrapper struct {
 	//  which qdisc is in the background that we can flush and rebuild
 	//  when we're changing them
 	qdiscInUse qdiscID
+	// The tc objects shaping the traffic going to each destination IP in the qdisc in use. It's empty when no
+	//  connection impairs the traffic, as the traffic isn't classified by destination then
+	trafficClassPerIpAddress map[string]trafficClass
 
 	execCmdExecutor sidecarExecCmdExecutor
 }
@@ -115,11 +139,12 @@ func NewStandardNetworkingSidecarWrapper(
 	}
 
 	return &StandardNetworkingSidecarWrapper{
-		mutex:             &sync.Mutex{},
-		networkingSidecar: networkingSidecar,
-		sidecarIpAddr:     nil,
-		qdiscInUse:        undefinedQdiscId,
-		execCmdExecutor:   execCmdExecutor,
+		mutex:                    &sync.Mutex{},
+		networkingSidecar:        networkingSidecar,
+		sidecarIpAddr:            nil,
+		qdiscInUse:               undefinedQdiscId,
+		trafficClassPerIpAddress: map[string]trafficClass{},
+		execCmdExecutor:          execCmdExecutor,
 	}, nil
 }
 
@@ -151,6 +176,28 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) InitializeTrafficControl
 	return nil
 }
 
+// resetTrafficControl removes the whole qdisc configuration from the network interface, leaving traffic control
+// uninitialized. It doesn't fail if there was no configuration to remove
+func (sidecarWrapper *StandardNetworkingSidecarWrapper) resetTrafficControl(ctx context.Context) error {
+	sidecarWrapper.mutex.Lock()
+	defer sidecarWrapper.mutex.Unlock()
+
+	resetCmd := generateTcResetCmd()
+
+	cmdDescription := "tc reset"
+
+	if err := sidecarWrapper.executeCmdInSidecar(ctx, resetCmd, cmdDescription); err != nil {
+		return stacktrace.Propagate(err, "An error occurred executing cmd '%v' in networking sidecar with GUID '%v'", resetCmd, sidecarWrapper.GetServiceUUID())
+	}
+
+	sidecarWrapper.qdiscInUse = undefinedQdiscId
+	sidecarWrapper.trafficClassPerIpAddress = map[string]trafficClass{}
+
+	return nil
+}
+
+// UpdateTrafficControl shapes the traffic leaving the service, based on its destination IP. This makes each connection
+// apply to one direction only: the traffic going back to the service is shaped by the sidecar of the other service
 func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx context.Context, partitionConnectionConfigPerIpAddress map[string]*partition_topology.PartitionConnection) error {
 	sidecarWrapper.mutex.Lock()
 	defer sidecarWrapper.mutex.Unlock()
@@ -162,12 +209,10 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx
 	// if this variable is true, set delay as 0 ms and packet loss to 0%
 	shouldResetToDefaultNetworkSettings := false
 
-	// if at least one connection has either packet delay or packet loss set, run the tc update statement
-	// else re-initialize the q discs
+	// if at least one connection impairs the traffic in any way (packet loss, delay, bandwidth limit, etc.), run the
+	// tc update statement else re-initialize the q discs
 	for _, connectionConfig := range partitionConnectionConfigPerIpAddress {
-		packetLoss := connectionConfig.GetPacketLossPercentage()
-		packetDelay := connectionConfig.GetPacketDelay()
-		if packetLoss.IsSet() || packetDelay.IsSet() {
+		if connectionConfig.IsSet() {
 			shouldResetToDefaultNetworkSettings = true
 			break
 		}
@@ -187,7 +232,7 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx
 			return stacktrace.NewError("Unrecognized tc qdisc ID '%v' in use; this is a code bug", primaryQdisc)
 		}
 
-		updateTcCmd, err := generateTcUpdateCmd(backgroundQdisc, backgroundQdiscClass, partitionConnectionConfigPerIpAddress)
+		updateTcCmd, trafficClassPerIpAddress, err := generateTcUpdateCmd(backgroundQdisc, backgroundQdiscClass, partitionConnectionConfigPerIpAddress)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
@@ -202,6 +247,7 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx
 		}
 
 		sidecarWrapper.qdiscInUse = backgroundQdisc
+		sidecarWrapper.trafficClassPerIpAddress = trafficClassPerIpAddress
 	} else if !shouldResetToDefaultNetworkSettings {
 		//if shouldResetToDefaultNetworkSettings == false means the tc qdisc config has to be re-initialized (e.g.: when an unblocked partition with no delay is configured).
 		//This is going to be done deleting and recreating qdiscA and qdiscB
@@ -214,11 +260,67 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx
 		}
 
 		sidecarWrapper.qdiscInUse = initialKurtosisQdiscId
+		sidecarWrapper.trafficClassPerIpAddress = map[string]trafficClass{}
+	}
+
+	return nil
+}
+
+// CaptureTraffic runs tcpdump in the sidecar for the given duration, writing the captured packets in pcap format to
+// the output as they get captured. The filter is a BPF expression and can be empty to capture all the traffic
+// This doesn't lock the wrapper, so that traffic control can still be updated while capturing
+func (sidecarWrapper *StandardNetworkingSidecarWrapper) CaptureTraffic(ctx context.Context, duration time.Duration, filter string, output io.Writer) error {
+	if duration <= 0 {
+		return stacktrace.NewError("The capture duration must be positive, got '%v'", duration)
+	}
+
+	captureCmd := generateCaptureTrafficCmd(duration, filter)
+
+	logrus.Infof(
+		"Capturing traffic during '%v' with filter '%s' in networking sidecar with service GUID '%v'...",
+		duration,
+		filter,
+		sidecarWrapper.GetServiceUUID())
+
+	if err := sidecarWrapper.execCmdExecutor.execWithStreamedOutput(ctx, captureCmd, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred capturing traffic in networking sidecar with GUID '%v'", sidecarWrapper.GetServiceUUID())
 	}
 
+	logrus.Infof("Successfully captured traffic in networking sidecar with GUID '%v'", sidecarWrapper.GetServiceUUID())
+
 	return nil
 }
 
+// GetTrafficStatistics returns the counters of the traffic the service sent to each destination IP since the connections
+// were last updated, as traffic control gets rebuilt on each update. Destinations are only accounted for separately
+// when at least one connection impairs the traffic, otherwise the result is empty
+func (sidecarWrapper *StandardNetworkingSidecarWrapper) GetTrafficStatistics(ctx context.Context) (map[string]*TrafficStatistics, error) {
+	sidecarWrapper.mutex.Lock()
+	defer sidecarWrapper.mutex.Unlock()
+
+	if sidecarWrapper.qdiscInUse == undefinedQdiscId {
+		return nil, stacktrace.NewError("Cannot get traffic statistics because tc qdiscs haven't yet been initialized")
+	}
+	if len(sidecarWrapper.trafficClassPerIpAddress) == 0 {
+		return map[string]*TrafficStatistics{}, nil
+	}
+
+	showStatisticsCmd := generateTcShowStatisticsCmd()
+	output, err := sidecarWrapper.execCmdExecutor.execWithOutput(ctx, showStatisticsCmd)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred executing cmd '%v' in networking sidecar with GUID '%v'", showStatisticsCmd, sidecarWrapper.GetServiceUUID())
+	}
+	qdiscCounters, classCounters, err := parseTcStatistics(output)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the tc statistics of networking sidecar with GUID '%v'", sidecarWrapper.GetServiceUUID())
+	}
+	trafficStatisticsPerIpAddress, err := getTrafficStatisticsPerIpAddress(sidecarWrapper.trafficClassPerIpAddress, qdiscCounters, classCounters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the traffic statistics per destination of networking sidecar with GUID '%v'", sidecarWrapper.GetServiceUUID())
+	}
+	return trafficStatisticsPerIpAddress, nil
+}
+
 // ==========================================================================================
 //
 //	Private helper functions
@@ -270,7 +372,22 @@ func generateTcInitCmd() []string {
 	return resultCmd
 }
 
-func generateTcUpdateCmd(backgroundQdisc qdiscID, backgroundQdiscClass classID, partitionConnectionConfigPerIpAddress map[string]*partition_topology.PartitionConnection) ([]string, error) {
+func generateTcResetCmd() []string {
+	resultCmd := []string{
+		tcCommand,
+		tcQdiscCommand,
+		tcDeleteCommand,
+		tcDeviceCommand,
+		defaultDockerNetworkInterface,
+		rootQdiscName,
+		orCommandsOperator,
+		noOpCommand,
+	}
+
+	return resultCmd
+}
+
+func generateTcUpdateCmd(backgroundQdisc qdiscID, backgroundQdiscClass classID, partitionConnectionConfigPerIpAddress map[string]*partition_topology.PartitionConnection) ([]string, map[string]trafficClass, error) {
 	commandList := [][]string{
 		generateTcRemoveQdiscCmd(backgroundQdiscClass, backgroundQdisc),              //First remove all background Qdisc configuration in order to recreate it
 		generateTcAddQdiscCmd(backgroundQdiscClass, backgroundQdisc, tcQdiscTypeHtb), //Creating the background Qdisc again to fill it with new configuration
@@ -279,12 +396,13 @@ func generateTcUpdateCmd(backgroundQdisc qdiscID, backgroundQdiscClass classID,
 	parentQdisc := backgroundQdisc
 	classIdDecimalMinorNumber := firstClassIdDecimalMinorNumber
 	previousQdiscIdDecimalMajorNumber := lastUsedQdiscIdDecimalMajorNumber
+	trafficClassPerIpAddress := map[string]trafficClass{}
 	for ipAddress, connectionConfig := range partitionConnectionConfigPerIpAddress {
 		classId := newClassId(parentQdisc, classIdDecimalMinorNumber)
 		classIdDecimalMinorNumber++
 		qdiscId, decimalMajorNumber, err := getNextUnusedQdiscId(parentQdisc, previousQdiscIdDecimalMajorNumber)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating a new qdisc ID for parent qdisc ID %v and previous qdisc ID decimal major number %v", parentQdisc, previousQdiscIdDecimalMajorNumber)
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating a new qdisc ID for parent qdisc ID %v and previous qdisc ID decimal major number %v", parentQdisc, previousQdiscIdDecimalMajorNumber)
 		}
 		previousQdiscIdDecimalMajorNumber = decimalMajorNumber
 		//For each ip address that will be blocked, we create: a class that will be a child of QdiscA or QdiscB, a filter
@@ -292,13 +410,17 @@ func generateTcUpdateCmd(backgroundQdisc qdiscID, backgroundQdiscClass classID,
 		commandList = append(commandList, generateTcAddClassCmd(parentQdisc, classId))
 		commandList = append(commandList, generateTCAddFilterByIpCmd(parentQdisc, classId, ipAddress))
 		commandList = append(commandList, generateTCAddNetemQdiscWithPacketConnectionCmd(classId, qdiscId, connectionConfig))
+		trafficClassPerIpAddress[ipAddress] = trafficClass{
+			classId: classId,
+			qdiscId: qdiscId,
+		}
 	}
 
 	commandList = append(commandList, generateTCReplaceRootFilterCmd(backgroundQdiscClass)) //swapping the root filter pointer
 
 	resultCmd := mergeCommandListInOneLineCommand(commandList)
 
-	return resultCmd, nil
+	return resultCmd, trafficClassPerIpAddress, nil
 }
 
 func generateTcReInitQdiscAAndQdiscBCmd() []string {
@@ -374,10 +496,15 @@ func generateTcAddQdiscBCmd() []string {
 	return generateTcAddQdiscCmd(rootClassBClassID, qdiscBID, tcQdiscTypeHtb)
 }
 
-// This method generates the command for packet loss and packet delay
+// This method generates the command for packet loss, packet delay, bandwidth limit, packet duplication, packet corruption
+// and packet reordering
 func generateTCAddNetemQdiscWithPacketConnectionCmd(parentClassId classID, qdiscId qdiscID, connectionConfig *partition_topology.PartitionConnection) []string {
 	packetLoss := connectionConfig.GetPacketLossPercentage()
 	packetDelay := connectionConfig.GetPacketDelay()
+	bandwidthLimit := connectionConfig.GetBandwidthLimit()
+	packetDuplication := connectionConfig.GetPacketDuplication()
+	packetCorruption := connectionConfig.GetPacketCorruption()
+	packetReordering := connectionConfig.GetPacketReordering()
 
 	resultCmd := generateTcAddQdiscCmd(parentClassId, qdiscId, tcQdiscTypeNetem)
 	resultCmd = append(resultCmd, tcQdiscTypeNetemOptionLoss)
@@ -388,6 +515,27 @@ func generateTCAddNetemQdiscWithPacketConnectionCmd(parentClassId classID, qdisc
 		resultCmd = append(resultCmd, packetDelay.GetTcCommand())
 	}
 
+	if bandwidthLimit.IsSet() {
+		resultCmd = append(resultCmd, tcQdiscTypeNetemOptionRate)
+		resultCmd = append(resultCmd, bandwidthLimit.GetTcCommand())
+	}
+
+	if packetDuplication.IsSet() {
+		resultCmd = append(resultCmd, tcQdiscTypeNetemOptionDuplicate)
+		resultCmd = append(resultCmd, packetDuplication.GetTcCommand())
+	}
+
+	if packetCorruption.IsSet() {
+		resultCmd = append(resultCmd, tcQdiscTypeNetemOptionCorrupt)
+		resultCmd = append(resultCmd, packetCorruption.GetTcCommand())
+	}
+
+	// netem only reorders packets if they are delayed, which is validated when the connection is configured
+	if packetReordering.IsSet() {
+		resultCmd = append(resultCmd, tcQdiscTypeNetemOptionReorder)
+		resultCmd = append(resultCmd, packetReordering.GetTcCommand())
+	}
+
 	return resultCmd
 }
 
@@ -428,7 +576,15 @@ func generateTcAddClassCmd(parentQdiscId qdiscID, classId classID) []string {
 	return resultCmd
 }
 
+// generateTCAddFilterByIpCmd matches the traffic going to the given IPv4 or IPv6 address, as the services of IPv6 enclaves
+// have both
 func generateTCAddFilterByIpCmd(parentQdiscId qdiscID, classId classID, ipAddress string) []string {
+	filterProtocol := tcFilterIPCommand
+	filterMatchType := tcFilterIPMatchTypeCommand
+	if parsedIpAddress := net.ParseIP(ipAddress); parsedIpAddress != nil && parsedIpAddress.To4() == nil {
+		filterProtocol = tcFilterIPv6Command
+		filterMatchType = tcFilterIPv6MatchTypeCommand
+	}
 
 	resultCmd := []string{
 		tcCommand,
@@ -439,14 +595,14 @@ func generateTCAddFilterByIpCmd(parentQdiscId qdiscID, classId classID, ipAddres
 		tcParentCommand,
 		string(parentQdiscId),
 		tcFilterProtocolCommand,
-		tcFilterIPCommand,
+		filterProtocol,
 		tcFilterPrioCommand,
 		maxFilterPriority,
 		tcU32FilterTypeCommand,
 		tcFilterFlowIDCommand,
 		string(classId),
 		tcFilterMatchCommand,
-		tcFilterIPMatchTypeCommand,
+		filterMatchType,
 		tcFilterIPDestCommand,
 		ipAddress,
 	}
@@ -491,6 +647,22 @@ func generateTCReplaceRootFilterCmd(classId classID) []string {
 	return resultCmd
 }
 
+func generateCaptureTrafficCmd(duration time.Duration, filter string) []string {
+	durationInSeconds := int(math.Ceil(duration.Seconds()))
+	quotedFilter := ""
+	if filter != "" {
+		quotedFilter = shellQuote(filter)
+	}
+	return []string{
+		fmt.Sprintf(captureTrafficCmdFormat, durationInSeconds, defaultDockerNetworkInterface, quotedFilter),
+	}
+}
+
+// shellQuote wraps the value in single quotes so that the shell passes it as-is as a single argument
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
 func mergeCommandListInOneLineCommand(commandList [][]string) []string {
 	resultCmd := []string{}
 	for commandIndex, command := range commandList {
@@ -6,12 +6,14 @@
 package networking_sidecar
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net"
 	"strings"
 	"testing"
@@ -28,6 +30,8 @@ const (
 		" tc filter add dev eth0 parent 1: handle 1:0 basic flowid 1:1 && tc qdisc add dev eth0 parent 1:1 handle" +
 		" 2: htb && tc qdisc add dev eth0 parent 1:2 handle 3: htb"
 
+	expectedCommandsForExecutingResetTrafficControl = "tc qdisc del dev eth0 root || true"
+
 	expectedCommandsForExecutingBlockedPartitionInQdiscB = "tc qdisc del dev eth0 parent 1:2 handle 3: htb && tc qdisc " +
 		"add dev eth0 parent 1:2 handle 3: htb && tc class add dev eth0 parent 3: classid 3:1 htb rate 100% && tc " +
 		"filter add dev eth0 parent 3: protocol ip prio 1 u32 flowid 3:1 match ip dst 1.1.1.1 && tc qdisc add dev " +
@@ -204,6 +208,28 @@ func TestInitializeTrafficControl_AlreadyInitialized(t *testing.T) {
 	require.Nil(t, err, "Traffic control already initialized")
 }
 
+func TestResetTrafficControl(t *testing.T) {
+	//Initial state
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+	sidecar.qdiscInUse = qdiscBID
+
+	err := sidecar.resetTrafficControl(ctx)
+	require.NoError(t, err, "An error occurred resetting traffic control")
+	require.Empty(t, sidecar.qdiscInUse)
+	require.Equal(t, 1, len(execCmdExecutor.commands))
+	actualFirstExecutedMergedCmd := mergeCommandsInOneLine(execCmdExecutor.commands[0])
+	require.Equal(t, expectedCommandsForExecutingResetTrafficControl, actualFirstExecutedMergedCmd)
+
+	// traffic control can then be initialized from scratch
+	err = sidecar.InitializeTrafficControl(ctx)
+	require.NoError(t, err, "An error occurred initializing traffic control")
+	require.Equal(t, initialKurtosisQdiscId, sidecar.qdiscInUse)
+	require.Equal(t, 2, len(execCmdExecutor.commands))
+	actualSecondExecutedMergedCmd := mergeCommandsInOneLine(execCmdExecutor.commands[1])
+	require.Equal(t, expectedCommandsForExecutingInitTrafficControl, actualSecondExecutedMergedCmd)
+}
+
 func TestUpdateTrafficControl_CreateBlockedPartitionAndThenUnblockIt(t *testing.T) {
 	//Initial state
 	ctx := context.Background()
@@ -467,6 +493,57 @@ func TestUpdateTrafficControl_CreateWithSoftPartitionWithDelayDistributionAndThe
 	require.Equal(t, expectedCommandsForExecutingSoftPartitionWithDelayInQdiscA, actualSecondExecutedMergedCmd)
 }
 
+func TestUpdateTrafficControl_CreateUnblockedPartitionWithOnlyBandwidthLimit(t *testing.T) {
+	//Initial state
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+	require.Empty(t, sidecar.qdiscInUse)
+	sidecar.qdiscInUse = initialKurtosisQdiscId
+
+	connectionConfig := partition_topology.NewPartitionConnectionWithAllSettings(
+		partition_topology.ConnectionWithNoPacketLoss,
+		partition_topology.ConnectionWithNoPacketDelay,
+		partition_topology.NewBandwidthLimit(512),
+		partition_topology.ConnectionWithNoPacketDuplication,
+		partition_topology.ConnectionWithNoPacketCorruption,
+		partition_topology.ConnectionWithNoPacketReordering,
+	)
+	connectionConfigPerIpAddress := map[string]*partition_topology.PartitionConnection{
+		allUserServiceTestIPAddresses[0].String(): &connectionConfig,
+	}
+
+	//Execution
+	err := sidecar.UpdateTrafficControl(ctx, connectionConfigPerIpAddress)
+	require.NoError(t, err, "An error occurred updating qdisc configuration for partition with bandwidth limit")
+	require.Equal(t, qdiscBID, sidecar.qdiscInUse)
+	require.Equal(t, 1, len(execCmdExecutor.commands))
+	require.Contains(t, mergeCommandsInOneLine(execCmdExecutor.commands[0]), "netem loss 0% rate 512kbit")
+}
+
+func TestGenerateTCAddNetemQdiscWithPacketConnectionCmd_AllSettings(t *testing.T) {
+	connectionConfig := partition_topology.NewPartitionConnectionWithAllSettings(
+		partition_topology.NewPacketLoss(10),
+		partition_topology.NewNormalPacketDelayDistribution(100, 10, 25),
+		partition_topology.NewBandwidthLimit(1024),
+		partition_topology.NewPacketPercentage(1, 5),
+		partition_topology.NewPacketPercentage(2, 10),
+		partition_topology.NewPacketPercentage(3, 15),
+	)
+
+	actualCmd := strings.Join(generateTCAddNetemQdiscWithPacketConnectionCmd(rootClassAClassID, qdiscAID, &connectionConfig), " ")
+	expectedCmd := "tc qdisc add dev eth0 parent 1:1 handle 2: netem loss 10% delay 100ms 10ms 25% rate 1024kbit " +
+		"duplicate 1% 5% corrupt 2% 10% reorder 3% 15%"
+	require.Equal(t, expectedCmd, actualCmd)
+}
+
+func TestGenerateTCAddFilterByIpCmd_Ipv4AndIpv6(t *testing.T) {
+	actualIpv4Cmd := strings.Join(generateTCAddFilterByIpCmd(qdiscAID, "2:1", "172.16.0.3"), " ")
+	require.Equal(t, "tc filter add dev eth0 parent 2: protocol ip prio 1 u32 flowid 2:1 match ip dst 172.16.0.3", actualIpv4Cmd)
+
+	actualIpv6Cmd := strings.Join(generateTCAddFilterByIpCmd(qdiscAID, "2:1", "fd00::3"), " ")
+	require.Equal(t, "tc filter add dev eth0 parent 2: protocol ipv6 prio 1 u32 flowid 2:1 match ip6 dst fd00::3", actualIpv6Cmd)
+}
+
 func TestUpdateTrafficControl_UndefinedQdiscInUseError(t *testing.T) {
 	//Initial state
 	ctx := context.Background()
@@ -492,6 +569,64 @@ func TestUpdateTrafficControl_UnrecognizedPrimaryQdiscIdError(t *testing.T) {
 	require.Error(t, err, "Expected unrecognized primary qdisc id error")
 }
 
+func TestCaptureTraffic(t *testing.T) {
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+	expectedPcap := "pcap-content"
+	execCmdExecutor.output = expectedPcap
+
+	pcap := &bytes.Buffer{}
+	err := sidecar.CaptureTraffic(ctx, 1500*time.Millisecond, "tcp port 80 and host '10.0.0.1'", pcap)
+	require.NoError(t, err)
+	require.Equal(t, expectedPcap, pcap.String())
+	require.Len(t, execCmdExecutor.commands, 1)
+	captureCmd := mergeCommandsInOneLine(execCmdExecutor.commands[0])
+	require.Contains(t, captureCmd, `timeout 2 tcpdump -i eth0 -U -w - 'tcp port 80 and host '\''10.0.0.1'\'''; `)
+	// traffic control isn't touched
+	require.Empty(t, sidecar.qdiscInUse)
+}
+
+func TestCaptureTraffic_NonPositiveDurationError(t *testing.T) {
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+
+	err := sidecar.CaptureTraffic(ctx, 0, "", io.Discard)
+	require.Error(t, err)
+	require.Empty(t, execCmdExecutor.commands)
+}
+
+func TestGetTrafficStatistics(t *testing.T) {
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+	sidecar.qdiscInUse = qdiscAID
+
+	// no connection impairs the traffic, so it isn't classified by destination
+	trafficStatistics, err := sidecar.GetTrafficStatistics(ctx)
+	require.NoError(t, err)
+	require.Empty(t, trafficStatistics)
+	require.Empty(t, execCmdExecutor.commands)
+
+	blockedConnection := partition_topology.ConnectionBlocked
+	err = sidecar.UpdateTrafficControl(ctx, map[string]*partition_topology.PartitionConnection{"1.1.1.1": &blockedConnection})
+	require.NoError(t, err)
+	require.Equal(t, map[string]trafficClass{"1.1.1.1": {classId: "3:1", qdiscId: "5:"}}, sidecar.trafficClassPerIpAddress)
+
+	execCmdExecutor.output = testTcStatisticsOutput
+	trafficStatistics, err = sidecar.GetTrafficStatistics(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*TrafficStatistics{"1.1.1.1": {SentBytes: 0, SentPackets: 0, DroppedPackets: 13}}, trafficStatistics)
+	require.Len(t, execCmdExecutor.commands, 2)
+	require.Equal(t, "tc -s qdisc show dev eth0 && tc -s class show dev eth0", mergeCommandsInOneLine(execCmdExecutor.commands[1]))
+}
+
+func TestGetTrafficStatistics_UndefinedQdiscInUseError(t *testing.T) {
+	ctx := context.Background()
+	sidecar, _ := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+
+	_, err := sidecar.GetTrafficStatistics(ctx)
+	require.Error(t, err)
+}
+
 func TestGetNextUnusedQdiscId_GenereratQdiscAChildren(t *testing.T) {
 
 	parentQdiscID := qdiscAID
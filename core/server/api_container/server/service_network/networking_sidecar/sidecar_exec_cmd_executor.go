@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
+	"io"
 	"strings"
 )
 
@@ -26,6 +27,8 @@ const (
 // Extracted as an interface for testing
 type sidecarExecCmdExecutor interface {
 	exec(ctx context.Context, cmd []string) error
+	execWithOutput(ctx context.Context, cmd []string) (string, error)
+	execWithStreamedOutput(ctx context.Context, cmd []string, output io.Writer) error
 }
 
 // ==========================================================================================
@@ -49,7 +52,14 @@ func newStandardSidecarExecCmdExecutor(kurtosisBackend backend_interface.Kurtosi
 }
 
 func (executor standardSidecarExecCmdExecutor) exec(ctx context.Context, notShWrappedCmd []string) error {
+	if _, err := executor.execWithOutput(ctx, notShWrappedCmd); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
+	}
+	return nil
+}
 
+// execWithOutput runs the command in the networking sidecar and returns its output, stdout and stderr being mixed
+func (executor standardSidecarExecCmdExecutor) execWithOutput(ctx context.Context, notShWrappedCmd []string) (string, error) {
 	shWrappedCmd := shWrapCommand(notShWrappedCmd)
 	var (
 		networkingSidecarCommands = map[service.ServiceUUID][]string{
@@ -63,25 +73,45 @@ func (executor standardSidecarExecCmdExecutor) exec(ctx context.Context, notShWr
 		networkingSidecarCommands,
 	)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
+		return "", stacktrace.Propagate(err, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
 	}
 	if len(erroredNetworkingSidecars) > 0 {
 		sidecarError, sidecarErrorFound := erroredNetworkingSidecars[executor.serviceUUID]
 		if !sidecarErrorFound {
-			return stacktrace.NewError("Unable to find error for networking sidecar with UUID '%v'. This is a bug in kurtosis", executor.serviceUUID)
+			return "", stacktrace.NewError("Unable to find error for networking sidecar with UUID '%v'. This is a bug in kurtosis", executor.serviceUUID)
 		}
 
-		return stacktrace.Propagate(sidecarError, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
+		return "", stacktrace.Propagate(sidecarError, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
 	}
 	execResult, found := successfulNetworkingSidecarExecResults[executor.serviceUUID]
 	if !found {
-		return stacktrace.NewError("Expected to receive the execution result information after running commands from '%+v' for service with UUID '%v'; but none was found", successfulNetworkingSidecarExecResults, executor.serviceUUID)
+		return "", stacktrace.NewError("Expected to receive the execution result information after running commands from '%+v' for service with UUID '%v'; but none was found", successfulNetworkingSidecarExecResults, executor.serviceUUID)
 	}
 
 	if execResult.GetExitCode() != successExitCode {
-		return stacktrace.NewError("Executing commands '%+v' returned an failing exit code with output:\n%v", networkingSidecarCommands, execResult.GetOutput())
+		return "", stacktrace.NewError("Executing commands '%+v' returned an failing exit code with output:\n%v", networkingSidecarCommands, execResult.GetOutput())
 	}
 
+	return execResult.GetOutput(), nil
+}
+
+// execWithStreamedOutput runs the command in the networking sidecar, writing its stdout to the output as it comes. The
+// stderr of the command is only reported if it fails
+func (executor standardSidecarExecCmdExecutor) execWithStreamedOutput(ctx context.Context, notShWrappedCmd []string, output io.Writer) error {
+	shWrappedCmd := shWrapCommand(notShWrappedCmd)
+	exitCode, errorOutput, err := executor.kurtosisBackend.RunNetworkingSidecarExecCommandWithStreamedOutput(
+		ctx,
+		executor.enclaveUuid,
+		executor.serviceUUID,
+		shWrappedCmd,
+		output,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running exec command in networking sidecar with UUID '%v'", executor.serviceUUID)
+	}
+	if exitCode != successExitCode {
+		return stacktrace.NewError("Executing command '%+v' returned a failing exit code '%v' with error output:\n%v", shWrappedCmd, exitCode, errorOutput)
+	}
 	return nil
 }
 
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2023 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package networking_sidecar
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	tcStatisticsOption = "-s"
+	tcShowCommand      = "show"
+
+	tcStatsQdiscObjectType = "qdisc"
+	tcStatsClassObjectType = "class"
+	// e.g. 'class htb 3:1 parent 3: leaf 5: prio 0 rate 100Mbit ...', the ID comes after the object and its type
+	tcStatsObjectIdFieldIndex = 2
+
+	sentBytesSubmatchIndex      = 1
+	sentPacketsSubmatchIndex    = 2
+	droppedPacketsSubmatchIndex = 3
+	numSentLineSubmatches       = 4
+
+	decimalBase    = 10
+	uint64BitSize  = 64
+	firstLineIndex = 0
+)
+
+// e.g. ' Sent 1234 bytes 12 pkt (dropped 3, overlimits 0 requeues 0)'
+var tcStatsSentLineRegex = regexp.MustCompile(`^\s*Sent (\d+) bytes (\d+) pkt \(dropped (\d+),`)
+
+// TrafficStatistics are the counters of the traffic a service sent to one destination, as seen by the traffic control
+// of its networking sidecar
+type TrafficStatistics struct {
+	// The bytes and packets which left the service, i.e. that weren't dropped
+	SentBytes   uint64
+	SentPackets uint64
+
+	// The packets dropped by traffic control, e.g. because of the packet loss configured for the connection
+	DroppedPackets uint64
+}
+
+// trafficClass identifies the tc objects shaping the traffic going to one destination: an htb class with a netem
+// qdisc as its only child
+type trafficClass struct {
+	classId classID
+	qdiscId qdiscID
+}
+
+type tcObjectCounters struct {
+	sentBytes      uint64
+	sentPackets    uint64
+	droppedPackets uint64
+}
+
+func generateTcShowStatisticsCmd() []string {
+	commandList := [][]string{
+		{tcCommand, tcStatisticsOption, tcQdiscCommand, tcShowCommand, tcDeviceCommand, defaultDockerNetworkInterface},
+		{tcCommand, tcStatisticsOption, tcClassCommand, tcShowCommand, tcDeviceCommand, defaultDockerNetworkInterface},
+	}
+	return mergeCommandListInOneLineCommand(commandList)
+}
+
+// parseTcStatistics parses the output of 'tc -s qdisc show' and 'tc -s class show', returning the counters of each
+// qdisc and each class by ID
+func parseTcStatistics(tcOutput string) (map[qdiscID]*tcObjectCounters, map[classID]*tcObjectCounters, error) {
+	qdiscCounters := map[qdiscID]*tcObjectCounters{}
+	classCounters := map[classID]*tcObjectCounters{}
+
+	currentObjectType := ""
+	currentObjectId := ""
+	for _, line := range strings.Split(tcOutput, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > tcStatsObjectIdFieldIndex && (fields[firstLineIndex] == tcStatsQdiscObjectType || fields[firstLineIndex] == tcStatsClassObjectType) {
+			currentObjectType = fields[firstLineIndex]
+			currentObjectId = fields[tcStatsObjectIdFieldIndex]
+			continue
+		}
+		submatches := tcStatsSentLineRegex.FindStringSubmatch(line)
+		if len(submatches) != numSentLineSubmatches {
+			continue
+		}
+		if currentObjectId == "" {
+			return nil, nil, stacktrace.NewError("Found traffic counters line '%s' which doesn't belong to any qdisc or class", line)
+		}
+		counters, err := parseTcSentLineSubmatches(submatches)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the traffic counters of tc %s '%s'", currentObjectType, currentObjectId)
+		}
+		if currentObjectType == tcStatsQdiscObjectType {
+			qdiscCounters[qdiscID(currentObjectId)] = counters
+		} else {
+			classCounters[classID(currentObjectId)] = counters
+		}
+		currentObjectId = ""
+	}
+	return qdiscCounters, classCounters, nil
+}
+
+func parseTcSentLineSubmatches(submatches []string) (*tcObjectCounters, error) {
+	sentBytes, err := strconv.ParseUint(submatches[sentBytesSubmatchIndex], decimalBase, uint64BitSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing sent bytes '%s'", submatches[sentBytesSubmatchIndex])
+	}
+	sentPackets, err := strconv.ParseUint(submatches[sentPacketsSubmatchIndex], decimalBase, uint64BitSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing sent packets '%s'", submatches[sentPacketsSubmatchIndex])
+	}
+	droppedPackets, err := strconv.ParseUint(submatches[droppedPacketsSubmatchIndex], decimalBase, uint64BitSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing dropped packets '%s'", submatches[droppedPacketsSubmatchIndex])
+	}
+	return &tcObjectCounters{
+		sentBytes:      sentBytes,
+		sentPackets:    sentPackets,
+		droppedPackets: droppedPackets,
+	}, nil
+}
+
+// getTrafficStatisticsPerIpAddress combines the counters of the class and the netem qdisc of each destination. The
+// class counts what left the service, while the drops caused by the packet loss happen in the netem qdisc
+func getTrafficStatisticsPerIpAddress(
+	trafficClassPerIpAddress map[string]trafficClass,
+	qdiscCounters map[qdiscID]*tcObjectCounters,
+	classCounters map[classID]*tcObjectCounters,
+) (map[string]*TrafficStatistics, error) {
+	result := map[string]*TrafficStatistics{}
+	for ipAddress, destinationTrafficClass := range trafficClassPerIpAddress {
+		classCountersForIp, found := classCounters[destinationTrafficClass.classId]
+		if !found {
+			return nil, stacktrace.NewError("No traffic counters were found for tc class '%s' shaping the traffic going to '%s'", destinationTrafficClass.classId, ipAddress)
+		}
+		qdiscCountersForIp, found := qdiscCounters[destinationTrafficClass.qdiscId]
+		if !found {
+			return nil, stacktrace.NewError("No traffic counters were found for tc qdisc '%s' shaping the traffic going to '%s'", destinationTrafficClass.qdiscId, ipAddress)
+		}
+		result[ipAddress] = &TrafficStatistics{
+			SentBytes:      classCountersForIp.sentBytes,
+			SentPackets:    classCountersForIp.sentPackets,
+			DroppedPackets: classCountersForIp.droppedPackets + qdiscCountersForIp.droppedPackets,
+		}
+	}
+	return result, nil
+}
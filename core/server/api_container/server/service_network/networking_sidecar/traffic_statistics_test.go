@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2023 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package networking_sidecar
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testTcStatisticsOutput = `qdisc htb 1: root refcnt 2 r2q 10 default 0 direct_packets_stat 3 direct_qlen 1000
+ Sent 5130 bytes 45 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+qdisc htb 2: parent 1:1 r2q 10 default 0 direct_packets_stat 0 direct_qlen 1000
+ Sent 0 bytes 0 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+qdisc htb 3: parent 1:2 r2q 10 default 0 direct_packets_stat 0 direct_qlen 1000
+ Sent 4200 bytes 42 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+qdisc netem 5: parent 3:1 limit 1000 loss 100%
+ Sent 0 bytes 0 pkt (dropped 12, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+qdisc netem b: parent 3:a limit 1000 delay 100ms
+ Sent 4200 bytes 42 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+class htb 1:1 root leaf 2: prio 0 rate 10Gbit ceil 10Gbit burst 0b cburst 0b
+ Sent 0 bytes 0 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+ lended: 0 borrowed: 0 giants: 0
+ tokens: 14 ctokens: 14
+class htb 3:1 root leaf 5: prio 0 rate 10Gbit ceil 10Gbit burst 0b cburst 0b
+ Sent 0 bytes 0 pkt (dropped 1, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+ lended: 0 borrowed: 0 giants: 0
+ tokens: 14 ctokens: 14
+class htb 3:a root leaf b: prio 0 rate 10Gbit ceil 10Gbit burst 0b cburst 0b
+ Sent 4200 bytes 42 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+ lended: 42 borrowed: 0 giants: 0
+ tokens: 14 ctokens: 14
+`
+)
+
+func TestParseTcStatistics(t *testing.T) {
+	qdiscCounters, classCounters, err := parseTcStatistics(testTcStatisticsOutput)
+	require.NoError(t, err)
+	require.Len(t, qdiscCounters, 5)
+	require.Len(t, classCounters, 3)
+	require.Equal(t, &tcObjectCounters{sentBytes: 0, sentPackets: 0, droppedPackets: 12}, qdiscCounters["5:"])
+	require.Equal(t, &tcObjectCounters{sentBytes: 4200, sentPackets: 42, droppedPackets: 0}, classCounters["3:a"])
+}
+
+func TestGetTrafficStatisticsPerIpAddress(t *testing.T) {
+	qdiscCounters, classCounters, err := parseTcStatistics(testTcStatisticsOutput)
+	require.NoError(t, err)
+	trafficClassPerIpAddress := map[string]trafficClass{
+		"1.1.1.1": {classId: "3:1", qdiscId: "5:"},
+		"2.2.2.2": {classId: "3:a", qdiscId: "b:"},
+	}
+
+	trafficStatistics, err := getTrafficStatisticsPerIpAddress(trafficClassPerIpAddress, qdiscCounters, classCounters)
+	require.NoError(t, err)
+	expectedTrafficStatistics := map[string]*TrafficStatistics{
+		"1.1.1.1": {SentBytes: 0, SentPackets: 0, DroppedPackets: 13},
+		"2.2.2.2": {SentBytes: 4200, SentPackets: 42, DroppedPackets: 0},
+	}
+	require.Equal(t, expectedTrafficStatistics, trafficStatistics)
+}
+
+func TestGetTrafficStatisticsPerIpAddress_MissingClassError(t *testing.T) {
+	qdiscCounters, classCounters, err := parseTcStatistics(testTcStatisticsOutput)
+	require.NoError(t, err)
+	trafficClassPerIpAddress := map[string]trafficClass{
+		"1.1.1.1": {classId: "3:2", qdiscId: "7:"},
+	}
+
+	_, err = getTrafficStatisticsPerIpAddress(trafficClassPerIpAddress, qdiscCounters, classCounters)
+	require.Error(t, err)
+}
@@ -0,0 +1,223 @@
+package service_network
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	noOpServiceNetworkErrMsg = "The service network isn't backed by an enclave so it can't run operations against it"
+
+	noOpFilesArtifactNameFormat = "no-op-files-artifact-%d"
+)
+
+// NoOpServiceNetwork is a ServiceNetwork that isn't backed by an enclave. It has no services, no files artifacts and
+// the default partitioning settings, and every operation that would change the enclave or reach a service fails.
+// It's what Starlark code gets interpreted against when it must not touch the enclave, e.g. when interpreting tests.
+type NoOpServiceNetwork struct {
+	mutex *sync.Mutex
+
+	numGeneratedFilesArtifactNames int
+}
+
+func NewNoOpServiceNetwork() *NoOpServiceNetwork {
+	return &NoOpServiceNetwork{
+		mutex:                          &sync.Mutex{},
+		numGeneratedFilesArtifactNames: 0,
+	}
+}
+
+func (network *NoOpServiceNetwork) Repartition(ctx context.Context, newPartitionServices map[service_network_types.PartitionID]map[service.ServiceName]bool, newPartitionConnections map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, newDefaultConnection partition_topology.PartitionConnection) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) SetConnection(ctx context.Context, partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID, connection partition_topology.PartitionConnection) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UnsetConnection(ctx context.Context, partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) SetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID, connection partition_topology.PartitionConnection) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UnsetDirectionalConnection(ctx context.Context, from service_network_types.PartitionID, to service_network_types.PartitionID) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) SetServiceConnection(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName, connection partition_topology.PartitionConnection) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UnsetServiceConnection(ctx context.Context, service1 service.ServiceName, service2 service.ServiceName) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) SetDefaultConnection(ctx context.Context, connection partition_topology.PartitionConnection) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) ScheduleConnectionChanges(name string, changes []*connection_change_scheduler.ConnectionChange) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) CancelConnectionChanges(name string) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) WatchConnectionChanges(name string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) StartService(ctx context.Context, serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) (*service.Service, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) StartServices(ctx context.Context, serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, batchSize int) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	return nil, nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UpdateService(ctx context.Context, updateServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
+	return nil, nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) RecreateServices(ctx context.Context, serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	return nil, nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
+	return "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) RestartService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) PauseService(ctx context.Context, serviceIdentifier string) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UnpauseService(ctx context.Context, serviceIdentifier string) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
+	return 0, "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	return nil, stacktrace.NewError("No service with identifier '%v' exists in the service network, as it isn't backed by an enclave", serviceIdentifier)
+}
+
+func (network *NoOpServiceNetwork) GetServiceLogs(ctx context.Context, serviceIdentifier string, numLogLines int) ([]string, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) GetTrafficStatistics(ctx context.Context, serviceIdentifier string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error) {
+	return nil, stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
+	return map[service.ServiceName]bool{}
+}
+
+func (network *NoOpServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
+	return []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers{}
+}
+
+func (network *NoOpServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
+	return nil, false
+}
+
+func (network *NoOpServiceNetwork) GetServiceConfig(serviceName service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool) {
+	return nil, false
+}
+
+func (network *NoOpServiceNetwork) GetServicePartition(serviceName service.ServiceName) (service_network_types.PartitionID, bool, error) {
+	return "", false, nil
+}
+
+func (network *NoOpServiceNetwork) GetConnection(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	return true, partition_topology.ConnectionAllowed, nil
+}
+
+func (network *NoOpServiceNetwork) GetDirectionalConnection(from service_network_types.PartitionID, to service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error) {
+	return true, partition_topology.ConnectionAllowed, nil
+}
+
+func (network *NoOpServiceNetwork) GetServiceConnection(service1 service.ServiceName, service2 service.ServiceName) (bool, partition_topology.PartitionConnection, error) {
+	return true, partition_topology.ConnectionAllowed, nil
+}
+
+func (network *NoOpServiceNetwork) GetDefaultConnection() partition_topology.PartitionConnection {
+	return partition_topology.ConnectionAllowed
+}
+
+func (network *NoOpServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) UploadFilesArtifact(data []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return "", stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) DoesFilesArtifactExist(artifactName string) (bool, error) {
+	return false, nil
+}
+
+func (network *NoOpServiceNetwork) IsFilesArtifactUpToDate(compressedData []byte, artifactName string) (bool, error) {
+	return false, nil
+}
+
+func (network *NoOpServiceNetwork) AreRenderedTemplatesUpToDate(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (bool, error) {
+	return false, nil
+}
+
+func (network *NoOpServiceNetwork) RemoveFilesArtifact(artifactName string) error {
+	return stacktrace.NewError(noOpServiceNetworkErrMsg)
+}
+
+func (network *NoOpServiceNetwork) IsNetworkPartitioningEnabled() bool {
+	return false
+}
+
+func (network *NoOpServiceNetwork) CanBlockTrafficWithoutSidecars() bool {
+	return false
+}
+
+func (network *NoOpServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.numGeneratedFilesArtifactNames++
+	return fmt.Sprintf(noOpFilesArtifactNameFormat, network.numGeneratedFilesArtifactNames), nil
+}
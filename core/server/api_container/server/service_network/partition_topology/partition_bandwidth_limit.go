@@ -0,0 +1,31 @@
+package partition_topology
+
+import "fmt"
+
+const kiloBitSuffix = "kbit"
+
+var (
+	ConnectionWithNoBandwidthLimit = NewBandwidthLimit(0)
+)
+
+// BandwidthLimit - the max rate packets can be sent at, see the 'rate' option of https://man7.org/linux/man-pages/man8/tc-netem.8.html
+// Netem doesn't support a correlation for the rate
+type BandwidthLimit struct {
+	// 0 means no limit
+	kiloBitsPerSecond uint32
+}
+
+func NewBandwidthLimit(kiloBitsPerSecond uint32) BandwidthLimit {
+	return BandwidthLimit{
+		kiloBitsPerSecond: kiloBitsPerSecond,
+	}
+}
+
+// IsSet This method checks whether we need to limit the bandwidth, default value is 0
+func (bandwidthLimit *BandwidthLimit) IsSet() bool {
+	return bandwidthLimit.kiloBitsPerSecond > 0
+}
+
+func (bandwidthLimit *BandwidthLimit) GetTcCommand() string {
+	return fmt.Sprintf("%v%v", bandwidthLimit.kiloBitsPerSecond, kiloBitSuffix)
+}
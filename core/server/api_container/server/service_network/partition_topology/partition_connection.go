@@ -5,6 +5,10 @@ import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_acce
 type PartitionConnection struct {
 	packetLoss              PacketLoss
 	packetDelayDistribution PacketDelayDistribution
+	bandwidthLimit          BandwidthLimit
+	packetDuplication       PacketPercentage
+	packetCorruption        PacketPercentage
+	packetReordering        PacketPercentage
 }
 
 var (
@@ -12,10 +16,33 @@ var (
 	ConnectionBlocked = NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
 )
 
+// NewPartitionConnection creates a connection which only loses and delays packets
 func NewPartitionConnection(packetLoss PacketLoss, packetDelay PacketDelayDistribution) PartitionConnection {
+	return NewPartitionConnectionWithAllSettings(
+		packetLoss,
+		packetDelay,
+		ConnectionWithNoBandwidthLimit,
+		ConnectionWithNoPacketDuplication,
+		ConnectionWithNoPacketCorruption,
+		ConnectionWithNoPacketReordering,
+	)
+}
+
+func NewPartitionConnectionWithAllSettings(
+	packetLoss PacketLoss,
+	packetDelay PacketDelayDistribution,
+	bandwidthLimit BandwidthLimit,
+	packetDuplication PacketPercentage,
+	packetCorruption PacketPercentage,
+	packetReordering PacketPercentage,
+) PartitionConnection {
 	return PartitionConnection{
 		packetLoss:              packetLoss,
 		packetDelayDistribution: packetDelay,
+		bandwidthLimit:          bandwidthLimit,
+		packetDuplication:       packetDuplication,
+		packetCorruption:        packetCorruption,
+		packetReordering:        packetReordering,
 	}
 }
 
@@ -27,6 +54,48 @@ func (partitionConnection *PartitionConnection) GetPacketDelay() PacketDelayDist
 	return partitionConnection.packetDelayDistribution
 }
 
-func newPartitionConnectionFromDbType(currentPartitionConnectionDbType partition_connection_overrides.PartitionConnection) PartitionConnection {
-	return NewPartitionConnection(NewPacketLoss(currentPartitionConnectionDbType.PacketLoss), NewNormalPacketDelayDistribution(currentPartitionConnectionDbType.PacketDelayDistribution.AvgDelayMs, currentPartitionConnectionDbType.PacketDelayDistribution.Jitter, currentPartitionConnectionDbType.PacketDelayDistribution.Correlation))
+func (partitionConnection *PartitionConnection) GetBandwidthLimit() BandwidthLimit {
+	return partitionConnection.bandwidthLimit
+}
+
+func (partitionConnection *PartitionConnection) GetPacketDuplication() PacketPercentage {
+	return partitionConnection.packetDuplication
+}
+
+func (partitionConnection *PartitionConnection) GetPacketCorruption() PacketPercentage {
+	return partitionConnection.packetCorruption
+}
+
+func (partitionConnection *PartitionConnection) GetPacketReordering() PacketPercentage {
+	return partitionConnection.packetReordering
+}
+
+// IsSet returns true if the connection impairs the traffic in any way, i.e. if tc has to be configured for it
+func (partitionConnection *PartitionConnection) IsSet() bool {
+	return partitionConnection.packetLoss.IsSet() ||
+		partitionConnection.packetDelayDistribution.IsSet() ||
+		partitionConnection.bandwidthLimit.IsSet() ||
+		partitionConnection.packetDuplication.IsSet() ||
+		partitionConnection.packetCorruption.IsSet() ||
+		partitionConnection.packetReordering.IsSet()
+}
+
+// IsBlocking returns true if the connection drops all the packets, in which case its other settings don't matter
+func (partitionConnection *PartitionConnection) IsBlocking() bool {
+	return partitionConnection.packetLoss.packetLossPercentage >= ConnectionWithEntirePacketLoss.packetLossPercentage
+}
+
+func NewPartitionConnectionFromDbType(currentPartitionConnectionDbType partition_connection_overrides.PartitionConnection) PartitionConnection {
+	return NewPartitionConnectionWithAllSettings(
+		NewPacketLoss(currentPartitionConnectionDbType.PacketLoss),
+		NewNormalPacketDelayDistribution(currentPartitionConnectionDbType.PacketDelayDistribution.AvgDelayMs, currentPartitionConnectionDbType.PacketDelayDistribution.Jitter, currentPartitionConnectionDbType.PacketDelayDistribution.Correlation),
+		NewBandwidthLimit(currentPartitionConnectionDbType.BandwidthLimitKbit),
+		newPacketPercentageFromDbType(currentPartitionConnectionDbType.PacketDuplication),
+		newPacketPercentageFromDbType(currentPartitionConnectionDbType.PacketCorruption),
+		newPacketPercentageFromDbType(currentPartitionConnectionDbType.PacketReordering),
+	)
+}
+
+func newPacketPercentageFromDbType(packetPercentageDbType partition_connection_overrides.PacketPercentage) PacketPercentage {
+	return NewPacketPercentage(packetPercentageDbType.Percentage, packetPercentageDbType.Correlation)
 }
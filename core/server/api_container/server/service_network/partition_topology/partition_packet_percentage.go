@@ -0,0 +1,35 @@
+package partition_topology
+
+import "fmt"
+
+var (
+	ConnectionWithNoPacketDuplication = NewPacketPercentage(0, 0)
+	ConnectionWithNoPacketCorruption  = NewPacketPercentage(0, 0)
+	ConnectionWithNoPacketReordering  = NewPacketPercentage(0, 0)
+)
+
+// PacketPercentage - the percentage of packets a netem impairment applies to, used for packet duplication, corruption
+// and reordering. The correlation is how much the chance of impairing a packet depends on the previous packet having been
+// impaired, see https://man7.org/linux/man-pages/man8/tc-netem.8.html
+type PacketPercentage struct {
+	percentage  float32
+	correlation float32
+}
+
+func NewPacketPercentage(percentage float32, correlation float32) PacketPercentage {
+	return PacketPercentage{
+		percentage:  percentage,
+		correlation: correlation,
+	}
+}
+
+// IsSet This method checks whether we need to set the impairment, default value is 0
+func (packetPercentage *PacketPercentage) IsSet() bool {
+	return packetPercentage.percentage > 0
+}
+
+func (packetPercentage *PacketPercentage) GetTcCommand() string {
+	packetPercentageStr := fmt.Sprintf("%v%v", packetPercentage.percentage, percentageSuffix)
+	packetCorrelationStr := fmt.Sprintf("%v%v", packetPercentage.correlation, percentageSuffix)
+	return fmt.Sprintf("%v %v", packetPercentageStr, packetCorrelationStr)
+}
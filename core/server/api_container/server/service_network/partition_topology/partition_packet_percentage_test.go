@@ -0,0 +1,31 @@
+package partition_topology
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPacketPercentage_GetTcCommand(t *testing.T) {
+	packetPercentage := NewPacketPercentage(10, 0)
+	require.Equal(t, "10% 0%", packetPercentage.GetTcCommand())
+
+	packetPercentage = NewPacketPercentage(2.5, 25)
+	require.Equal(t, "2.5% 25%", packetPercentage.GetTcCommand())
+}
+
+func TestBandwidthLimit_GetTcCommand(t *testing.T) {
+	bandwidthLimit := NewBandwidthLimit(512)
+	require.Equal(t, "512kbit", bandwidthLimit.GetTcCommand())
+}
+
+func TestPartitionConnection_DbTypeRoundTrip(t *testing.T) {
+	connection := NewPartitionConnectionWithAllSettings(
+		NewPacketLoss(10),
+		NewNormalPacketDelayDistribution(100, 10, 5),
+		NewBandwidthLimit(1024),
+		NewPacketPercentage(1, 10),
+		NewPacketPercentage(2, 20),
+		NewPacketPercentage(3, 30),
+	)
+	require.Equal(t, connection, NewPartitionConnectionFromDbType(partitionConnectionDbTypeFromPartitionConnection(connection)))
+}
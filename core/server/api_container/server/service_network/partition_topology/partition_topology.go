@@ -34,6 +34,14 @@ type PartitionTopology struct {
 	// By default, connection between 2 partitions is set to defaultConnection. This map contains overrides
 	partitionConnectionOverrides *partition_connection_overrides.PartitionConnectionOverridesBucket
 
+	// Overrides applying only to the traffic going from one partition to another. For a given direction, they take
+	// precedence over partitionConnectionOverrides
+	directionalPartitionConnectionOverrides *partition_connection_overrides.DirectionalPartitionConnectionOverridesBucket
+
+	// Overrides applying to the traffic between two services, in both directions. They take precedence over all the
+	// connections set between partitions, and apply even if the two services are in the same partition
+	serviceConnectionOverrides *partition_connection_overrides.ServiceConnectionOverridesBucket
+
 	// A service can be a part of exactly one partition at a time
 	partitionServices *partition_services.PartitionServicesBucket
 }
@@ -54,12 +62,24 @@ func NewPartitionTopology(defaultPartition service_network_types.PartitionID, de
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the partition connection overrides bucket")
 	}
 
+	directionalPartitionConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateDirectionalPartitionConnectionOverrideBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the directional partition connection overrides bucket")
+	}
+
+	serviceConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateServiceConnectionOverrideBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the service connection overrides bucket")
+	}
+
 	return &PartitionTopology{
-		lock:                         &sync.RWMutex{},
-		servicePartitions:            servicePartitionsBucket,
-		partitionServices:            partitionServicesBucket,
-		partitionConnectionOverrides: partitionConnectionOverridesBucket,
-		defaultConnection:            defaultConnection,
+		lock:                                    &sync.RWMutex{},
+		servicePartitions:                       servicePartitionsBucket,
+		partitionServices:                       partitionServicesBucket,
+		partitionConnectionOverrides:            partitionConnectionOverridesBucket,
+		directionalPartitionConnectionOverrides: directionalPartitionConnectionOverridesBucket,
+		serviceConnectionOverrides:              serviceConnectionOverridesBucket,
+		defaultConnection:                       defaultConnection,
 	}, nil
 }
 
@@ -178,6 +198,13 @@ func (topology *PartitionTopology) Repartition(
 	if err = topology.partitionConnectionOverrides.ReplaceBucketContents(newPartitionConnectionOverridesCopy); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while repartitioning the partition connections bucket")
 	}
+	// all the connections get defined by the new overrides, as they apply in both directions
+	if err = topology.directionalPartitionConnectionOverrides.ReplaceBucketContents(map[partition_connection_overrides.DirectionalPartitionConnectionID]partition_connection_overrides.PartitionConnection{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while repartitioning the directional partition connections bucket")
+	}
+	if err = topology.serviceConnectionOverrides.ReplaceBucketContents(map[partition_connection_overrides.ServiceConnectionID]partition_connection_overrides.PartitionConnection{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while repartitioning the service connections bucket")
+	}
 	topology.defaultConnection = newDefaultConnection
 	return nil
 }
@@ -247,6 +274,17 @@ func (topology *PartitionTopology) RemovePartition(partitionId service_network_t
 			}
 		}
 	}
+	allDirectionalPartitionConnections, err := topology.directionalPartitionConnectionOverrides.GetAllPartitionConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all directional partition connection overrides")
+	}
+	for partitionConnectionId := range allDirectionalPartitionConnections {
+		if partitionConnectionId.From == partition.PartitionID(partitionId) || partitionConnectionId.To == partition.PartitionID(partitionId) {
+			if err = topology.directionalPartitionConnectionOverrides.RemovePartitionConnectionOverride(partitionConnectionId); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while removing directional partition connection with ID '%v'", partitionConnectionId)
+			}
+		}
+	}
 	return nil
 }
 
@@ -266,7 +304,8 @@ func (topology *PartitionTopology) GetDefaultConnection() PartitionConnection {
 	return topology.defaultConnection
 }
 
-// SetConnection overrides the connection between partition1 and partition2.
+// SetConnection overrides the connection between partition1 and partition2, in both directions. Directional overrides
+// previously set between the two partitions are dropped
 // It throws an error if either of the two partitions does not exist yet
 func (topology *PartitionTopology) SetConnection(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID, connection PartitionConnection) error {
 	topology.lock.Lock()
@@ -292,11 +331,38 @@ func (topology *PartitionTopology) SetConnection(partition1 service_network_type
 	if err = topology.partitionConnectionOverrides.AddPartitionConnectionOverride(partitionConnectionIdDbType, partitionConnectionDbType); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while adding partition with id '%v' to bucket", partitionConnectionIdDbType)
 	}
+	if err = topology.removeDirectionalConnectionOverridesUnlocked(partition1, partition2); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing the directional connection overrides between '%s' and '%s'", partition1, partition2)
+	}
+	return nil
+}
+
+// SetDirectionalConnection overrides the connection for the traffic going from partition 'from' to partition 'to'. The
+// traffic going the other way is left untouched
+// It throws an error if either of the two partitions does not exist yet
+func (topology *PartitionTopology) SetDirectionalConnection(from service_network_types.PartitionID, to service_network_types.PartitionID, connection PartitionConnection) error {
+	topology.lock.Lock()
+	defer topology.lock.Unlock()
+	for _, partitionId := range []service_network_types.PartitionID{from, to} {
+		exists, err := topology.partitionServices.DoesPartitionExist(partition.PartitionID(partitionId))
+		if err != nil {
+			return stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", partitionId)
+		}
+		if !exists {
+			return stacktrace.NewError("About to set a connection from '%s' to '%s' but '%s' does not exist", from, to, partitionId)
+		}
+	}
+
+	partitionConnectionIdDbType := directionalPartitionConnectionIdDbTypeFromPartitionIds(from, to)
+	partitionConnectionDbType := partitionConnectionDbTypeFromPartitionConnection(connection)
+	if err := topology.directionalPartitionConnectionOverrides.AddPartitionConnectionOverride(partitionConnectionIdDbType, partitionConnectionDbType); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding directional partition connection with id '%v' to bucket", partitionConnectionIdDbType)
+	}
 	return nil
 }
 
-// UnsetConnection unsets the connection override between partition1 and partition2. It will therefore fallback to
-// defaultConnection
+// UnsetConnection unsets the connection override between partition1 and partition2, along with the directional
+// overrides between them. It will therefore fallback to defaultConnection
 // It throws an error if either of the two partitions does not exist yet
 // It no-ops if there was no override for this partition connection yet
 func (topology *PartitionTopology) UnsetConnection(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) error {
@@ -321,7 +387,67 @@ func (topology *PartitionTopology) UnsetConnection(partition1 service_network_ty
 	if err = topology.partitionConnectionOverrides.RemovePartitionConnectionOverride(partitionConnectionIdDbType); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while removing partition connection with id '%v'", partitionConnectionIdDbType)
 	}
+	if err = topology.removeDirectionalConnectionOverridesUnlocked(partition1, partition2); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing the directional connection overrides between '%s' and '%s'", partition1, partition2)
+	}
+
+	return nil
+}
+
+// UnsetDirectionalConnection unsets the connection override for the traffic going from partition 'from' to partition
+// 'to'. This traffic will therefore fallback to the connection set between the two partitions, or to defaultConnection
+// It throws an error if either of the two partitions does not exist yet
+// It no-ops if there was no directional override for this partition connection yet
+func (topology *PartitionTopology) UnsetDirectionalConnection(from service_network_types.PartitionID, to service_network_types.PartitionID) error {
+	topology.lock.Lock()
+	defer topology.lock.Unlock()
+	for _, partitionId := range []service_network_types.PartitionID{from, to} {
+		exists, err := topology.partitionServices.DoesPartitionExist(partition.PartitionID(partitionId))
+		if err != nil {
+			return stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", partitionId)
+		}
+		if !exists {
+			return stacktrace.NewError("About to unset a connection from '%s' to '%s' but '%s' does not exist", from, to, partitionId)
+		}
+	}
+	partitionConnectionIdDbType := directionalPartitionConnectionIdDbTypeFromPartitionIds(from, to)
+	if err := topology.directionalPartitionConnectionOverrides.RemovePartitionConnectionOverride(partitionConnectionIdDbType); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing directional partition connection with id '%v'", partitionConnectionIdDbType)
+	}
+	return nil
+}
+
+// SetServiceConnection overrides the connection between service1 and service2, in both directions. This connection
+// takes precedence over the connection set between the partitions of the two services
+// It throws an error if either of the two services does not exist
+func (topology *PartitionTopology) SetServiceConnection(service1 service.ServiceName, service2 service.ServiceName, connection PartitionConnection) error {
+	topology.lock.Lock()
+	defer topology.lock.Unlock()
+	if err := topology.validateServiceConnectionUnlocked(service1, service2); err != nil {
+		return stacktrace.Propagate(err, "Cannot set a connection between services '%s' and '%s'", service1, service2)
+	}
+	serviceConnectionIdDbType := serviceConnectionIdDbTypeFromServiceNames(service1, service2)
+	partitionConnectionDbType := partitionConnectionDbTypeFromPartitionConnection(connection)
+	if err := topology.serviceConnectionOverrides.AddServiceConnectionOverride(serviceConnectionIdDbType, partitionConnectionDbType); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while adding service connection with id '%v' to bucket", serviceConnectionIdDbType)
+	}
+	return nil
+}
 
+// UnsetServiceConnection unsets the connection override between service1 and service2. The traffic between them will
+// therefore fallback to the connection set between their partitions
+// It throws an error if either of the two services does not exist
+// It no-ops if there was no override for this service connection yet
+func (topology *PartitionTopology) UnsetServiceConnection(service1 service.ServiceName, service2 service.ServiceName) error {
+	topology.lock.Lock()
+	defer topology.lock.Unlock()
+	if err := topology.validateServiceConnectionUnlocked(service1, service2); err != nil {
+		return stacktrace.Propagate(err, "Cannot unset the connection between services '%s' and '%s'", service1, service2)
+	}
+	serviceConnectionIdDbType := serviceConnectionIdDbTypeFromServiceNames(service1, service2)
+	if err := topology.serviceConnectionOverrides.RemoveServiceConnectionOverride(serviceConnectionIdDbType); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while removing service connection with id '%v'", serviceConnectionIdDbType)
+	}
 	return nil
 }
 
@@ -386,6 +512,18 @@ func (topology *PartitionTopology) RemoveService(serviceName service.ServiceName
 		return stacktrace.Propagate(err, "An error occurred while removing service '%v' from underlying service partition store", serviceName)
 	}
 
+	allServiceConnections, err := topology.serviceConnectionOverrides.GetAllServiceConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all service connection overrides")
+	}
+	for serviceConnectionId := range allServiceConnections {
+		if serviceConnectionId.LexicalFirst == serviceName || serviceConnectionId.LexicalSecond == serviceName {
+			if err = topology.serviceConnectionOverrides.RemoveServiceConnectionOverride(serviceConnectionId); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while removing service connection with ID '%v'", serviceConnectionId)
+			}
+		}
+	}
+
 	services, err := topology.partitionServices.GetServicesForPartition(partitionId)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting services for partition '%v'", partitionId)
@@ -449,10 +587,82 @@ func (topology *PartitionTopology) GetPartitionConnection(partition1 service_net
 		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting the partition connection with id '%v'", partitionConnectionIdDbType)
 	}
 
-	partitionConnection := newPartitionConnectionFromDbType(currentPartitionConnectionDbType)
+	partitionConnection := NewPartitionConnectionFromDbType(currentPartitionConnectionDbType)
 	return false, partitionConnection, nil
 }
 
+// GetDirectionalPartitionConnection returns a clone of the connection applying to the traffic going from partition
+// 'from' to partition 'to'
+// It also returns a boolean indicating whether this connection comes from a directional override or not. If not, the
+// connection is the one set between the two partitions, or the default connection
+// It throws an error if the one of the partition does not exist.
+func (topology *PartitionTopology) GetDirectionalPartitionConnection(from service_network_types.PartitionID, to service_network_types.PartitionID) (bool, PartitionConnection, error) {
+	topology.lock.RLock()
+	defer topology.lock.RUnlock()
+	isDirectionalOverride, connection, err := topology.getPartitionConnectionUnlocked(from, to)
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred getting the connection from '%s' to '%s'", from, to)
+	}
+	return isDirectionalOverride, connection, nil
+}
+
+// GetServiceConnection returns a clone of the connection applying to the traffic between service1 and service2
+// It also returns a boolean indicating whether this connection comes from a service override or not. If not, the
+// connection is the one set between the partitions of the two services, or ConnectionAllowed if they share a partition
+// It throws an error if the one of the services does not exist.
+func (topology *PartitionTopology) GetServiceConnection(service1 service.ServiceName, service2 service.ServiceName) (bool, PartitionConnection, error) {
+	topology.lock.RLock()
+	defer topology.lock.RUnlock()
+	if err := topology.validateServiceConnectionUnlocked(service1, service2); err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "Cannot get the connection between services '%s' and '%s'", service1, service2)
+	}
+
+	serviceConnectionIdDbType := serviceConnectionIdDbTypeFromServiceNames(service1, service2)
+	exists, err := topology.serviceConnectionOverrides.DoesServiceConnectionOverrideExist(serviceConnectionIdDbType)
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while verifying whether service connection override with id '%v' exists", serviceConnectionIdDbType)
+	}
+	if exists {
+		currentConnectionDbType, err := topology.serviceConnectionOverrides.GetServiceConnectionOverride(serviceConnectionIdDbType)
+		if err != nil {
+			return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting the service connection with id '%v'", serviceConnectionIdDbType)
+		}
+		return true, NewPartitionConnectionFromDbType(currentConnectionDbType), nil
+	}
+
+	partition1, err := topology.servicePartitions.GetPartitionForService(service1)
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while fetching the partition for service '%v'", service1)
+	}
+	partition2, err := topology.servicePartitions.GetPartitionForService(service2)
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while fetching the partition for service '%v'", service2)
+	}
+	if partition1 == partition2 {
+		return false, ConnectionAllowed, nil
+	}
+	_, connection, err := topology.getPartitionConnectionUnlocked(service_network_types.PartitionID(partition1), service_network_types.PartitionID(partition2))
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred getting the connection from '%s' to '%s'", partition1, partition2)
+	}
+	return false, connection, nil
+}
+
+// GetServiceConnectionOverrides returns all the connections set between two services
+func (topology *PartitionTopology) GetServiceConnectionOverrides() (map[service_network_types.ServiceConnectionID]PartitionConnection, error) {
+	topology.lock.RLock()
+	defer topology.lock.RUnlock()
+	allServiceConnections, err := topology.serviceConnectionOverrides.GetAllServiceConnectionOverrides()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service connection overrides")
+	}
+	result := map[service_network_types.ServiceConnectionID]PartitionConnection{}
+	for serviceConnectionId, connection := range allServiceConnections {
+		result[*service_network_types.NewServiceConnectionID(serviceConnectionId.LexicalFirst, serviceConnectionId.LexicalSecond)] = NewPartitionConnectionFromDbType(connection)
+	}
+	return result, nil
+}
+
 func (topology *PartitionTopology) GetServicePartitions() (map[service.ServiceName]service_network_types.PartitionID, error) {
 	topology.lock.RLock()
 	defer topology.lock.RUnlock()
@@ -470,6 +680,9 @@ func (topology *PartitionTopology) GetServicePartitions() (map[service.ServiceNa
 // GetServicePartitionConnectionConfigByServiceName this method returns a partition config map
 // containing information a structure similar to adjacency graph hashmap data structure between services
 // where nodes are services, and edges are partition connection object
+// Edges are directed: result[A][B] is the connection applying to the traffic going from A to B
+// Connections set between two services take precedence over the ones set between their partitions, and are the only
+// ones applying between services of the same partition
 func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceName() (map[service.ServiceName]map[service.ServiceName]*PartitionConnection, error) {
 	topology.lock.RLock()
 	defer topology.lock.RUnlock()
@@ -486,7 +699,7 @@ func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceN
 					// Two services in the same partition will never block each other
 					continue
 				}
-				connection, err := topology.getPartitionConnectionUnlocked(service_network_types.PartitionID(partitionId), service_network_types.PartitionID(otherPartitionId))
+				_, connection, err := topology.getPartitionConnectionUnlocked(service_network_types.PartitionID(partitionId), service_network_types.PartitionID(otherPartitionId))
 				if err != nil {
 					return nil, stacktrace.NewError("Couldn't get connection between partitions '%v' and '%v'", partitionId, otherPartitionId)
 				}
@@ -497,6 +710,20 @@ func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceN
 			result[serviceName] = partitionConnectionConfigBetweenServices
 		}
 	}
+
+	allServiceConnections, err := topology.serviceConnectionOverrides.GetAllServiceConnectionOverrides()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service connection overrides")
+	}
+	for serviceConnectionId, connectionDbType := range allServiceConnections {
+		connection := NewPartitionConnectionFromDbType(connectionDbType)
+		if connectionsFromFirst, found := result[serviceConnectionId.LexicalFirst]; found {
+			connectionsFromFirst[serviceConnectionId.LexicalSecond] = &connection
+		}
+		if connectionsFromSecond, found := result[serviceConnectionId.LexicalSecond]; found {
+			connectionsFromSecond[serviceConnectionId.LexicalFirst] = &connection
+		}
+	}
 	return result, nil
 }
 
@@ -505,43 +732,89 @@ func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceN
 //	Private Helper Methods
 //
 // ================================================================================================
+// getPartitionConnectionUnlocked returns the connection applying to the traffic going from partition 'from' to partition
+// 'to', along with a boolean set to true if it comes from a directional override
 func (topology *PartitionTopology) getPartitionConnectionUnlocked(
-	a service_network_types.PartitionID,
-	b service_network_types.PartitionID) (PartitionConnection, error) {
+	from service_network_types.PartitionID,
+	to service_network_types.PartitionID) (bool, PartitionConnection, error) {
 
-	exists, err := topology.partitionServices.DoesPartitionExist(partition.PartitionID(a))
+	exists, err := topology.partitionServices.DoesPartitionExist(partition.PartitionID(from))
 	if err != nil {
-		return ConnectionAllowed, stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", a)
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", from)
 	}
 	if !exists {
-		return ConnectionAllowed, stacktrace.NewError("Unrecognized partition '%v'", a)
+		return false, ConnectionAllowed, stacktrace.NewError("Unrecognized partition '%v'", from)
 	}
 
-	exists, err = topology.partitionServices.DoesPartitionExist(partition.PartitionID(b))
+	exists, err = topology.partitionServices.DoesPartitionExist(partition.PartitionID(to))
 	if err != nil {
-		return ConnectionAllowed, stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", b)
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "Attempted to check whether partition with ID '%v' exists but failed", to)
 	}
 	if !exists {
-		return ConnectionAllowed, stacktrace.NewError("Unrecognized partition '%v'", b)
+		return false, ConnectionAllowed, stacktrace.NewError("Unrecognized partition '%v'", to)
 	}
 
-	partitionConnectionIdDbType := partitionConnectionIdDbTypeFromPartitionIds(a, b)
+	directionalPartitionConnectionIdDbType := directionalPartitionConnectionIdDbTypeFromPartitionIds(from, to)
+	exists, err = topology.directionalPartitionConnectionOverrides.DoesPartitionConnectionOverrideExist(directionalPartitionConnectionIdDbType)
+	if err != nil {
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while verifying whether directional partition connection override with id '%v' exists", directionalPartitionConnectionIdDbType)
+	}
+	if exists {
+		currentPartitionConnectionDbType, err := topology.directionalPartitionConnectionOverrides.GetPartitionConnectionOverride(directionalPartitionConnectionIdDbType)
+		if err != nil {
+			return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting the directional partition connection with id '%v'", directionalPartitionConnectionIdDbType)
+		}
+		return true, NewPartitionConnectionFromDbType(currentPartitionConnectionDbType), nil
+	}
+
+	partitionConnectionIdDbType := partitionConnectionIdDbTypeFromPartitionIds(from, to)
 
 	exists, err = topology.partitionConnectionOverrides.DoesPartitionConnectionOverrideExist(partitionConnectionIdDbType)
 	if err != nil {
-		return ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while verifying whether partition connection override with id '%v' exists", partitionConnectionIdDbType)
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while verifying whether partition connection override with id '%v' exists", partitionConnectionIdDbType)
 	}
 	if !exists {
-		return topology.GetDefaultConnection(), nil
+		return false, topology.defaultConnection, nil
 	}
 
 	currentPartitionConnectionDbType, err := topology.partitionConnectionOverrides.GetPartitionConnectionOverride(partitionConnectionIdDbType)
 	if err != nil {
-		return ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting the partition connection with id '%v'", partitionConnectionIdDbType)
+		return false, ConnectionAllowed, stacktrace.Propagate(err, "An error occurred while getting the partition connection with id '%v'", partitionConnectionIdDbType)
 	}
-	partitionConnection := newPartitionConnectionFromDbType(currentPartitionConnectionDbType)
-	return partitionConnection, nil
+	partitionConnection := NewPartitionConnectionFromDbType(currentPartitionConnectionDbType)
+	return false, partitionConnection, nil
+
+}
 
+// removeDirectionalConnectionOverridesUnlocked drops the directional overrides in both directions between the two
+// partitions, if any
+func (topology *PartitionTopology) removeDirectionalConnectionOverridesUnlocked(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) error {
+	for _, directionalPartitionConnectionIdDbType := range []partition_connection_overrides.DirectionalPartitionConnectionID{
+		directionalPartitionConnectionIdDbTypeFromPartitionIds(partition1, partition2),
+		directionalPartitionConnectionIdDbTypeFromPartitionIds(partition2, partition1),
+	} {
+		if err := topology.directionalPartitionConnectionOverrides.RemovePartitionConnectionOverride(directionalPartitionConnectionIdDbType); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while removing directional partition connection with id '%v'", directionalPartitionConnectionIdDbType)
+		}
+	}
+	return nil
+}
+
+// validateServiceConnectionUnlocked checks that the two services exist and are different
+func (topology *PartitionTopology) validateServiceConnectionUnlocked(service1 service.ServiceName, service2 service.ServiceName) error {
+	if service1 == service2 {
+		return stacktrace.NewError("A connection can only be set between two different services, but got '%s' twice", service1)
+	}
+	for _, serviceName := range []service.ServiceName{service1, service2} {
+		exists, err := topology.servicePartitions.DoesServiceExist(serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "Attempted to check whether service '%v' exists but failed", serviceName)
+		}
+		if !exists {
+			return stacktrace.NewError("Service '%s' does not exist", serviceName)
+		}
+	}
+	return nil
 }
 
 func serviceIdSetToCommaStr(serviceSet map[service.ServiceName]bool) string {
@@ -575,6 +848,17 @@ func partitionConnectionDbTypeFromPartitionConnection(connection PartitionConnec
 			Jitter:      connection.packetDelayDistribution.jitter,
 			Correlation: connection.packetDelayDistribution.correlation,
 		},
+		BandwidthLimitKbit: connection.bandwidthLimit.kiloBitsPerSecond,
+		PacketDuplication:  packetPercentageDbTypeFromPacketPercentage(connection.packetDuplication),
+		PacketCorruption:   packetPercentageDbTypeFromPacketPercentage(connection.packetCorruption),
+		PacketReordering:   packetPercentageDbTypeFromPacketPercentage(connection.packetReordering),
+	}
+}
+
+func packetPercentageDbTypeFromPacketPercentage(packetPercentage PacketPercentage) partition_connection_overrides.PacketPercentage {
+	return partition_connection_overrides.PacketPercentage{
+		Percentage:  packetPercentage.percentage,
+		Correlation: packetPercentage.correlation,
 	}
 }
 
@@ -588,3 +872,18 @@ func partitionConnectionIdDbTypeFromPartitionConnectionId(connectionId service_n
 func partitionConnectionIdDbTypeFromPartitionIds(partitionId1, partitionId2 service_network_types.PartitionID) partition_connection_overrides.PartitionConnectionID {
 	return partitionConnectionIdDbTypeFromPartitionConnectionId(*service_network_types.NewPartitionConnectionID(partitionId1, partitionId2))
 }
+
+func directionalPartitionConnectionIdDbTypeFromPartitionIds(from, to service_network_types.PartitionID) partition_connection_overrides.DirectionalPartitionConnectionID {
+	return partition_connection_overrides.DirectionalPartitionConnectionID{
+		From: partition.PartitionID(from),
+		To:   partition.PartitionID(to),
+	}
+}
+
+func serviceConnectionIdDbTypeFromServiceNames(service1, service2 service.ServiceName) partition_connection_overrides.ServiceConnectionID {
+	serviceConnectionId := service_network_types.NewServiceConnectionID(service1, service2)
+	return partition_connection_overrides.ServiceConnectionID{
+		LexicalFirst:  serviceConnectionId.GetFirst(),
+		LexicalSecond: serviceConnectionId.GetSecond(),
+	}
+}
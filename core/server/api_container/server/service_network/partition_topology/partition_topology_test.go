@@ -655,6 +655,193 @@ func TestUnsetConnection_FailurePartitionNotFound(t *testing.T) {
 	require.Contains(t, err.Error(), "About to unset a connection between 'partition1' and 'unknownPartition' but 'unknownPartition' does not exist")
 }
 
+func TestSetDirectionalConnection(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+	repartition(
+		t,
+		topology,
+		serviceSetWithService1,
+		serviceSetWithService2,
+		serviceSetWithService3,
+		map[service_network_types.PartitionConnectionID]PartitionConnection{
+			*service_network_types.NewPartitionConnectionID(partition1, partition2): connectionWithSomeConstantLatency,
+		},
+		ConnectionAllowed)
+
+	err := topology.SetDirectionalConnection(partition1, partition2, ConnectionBlocked)
+	require.Nil(t, err)
+
+	servicePacketConnectionConfigurationsByServiceIDMap := getServicePacketConnectionConfigurationsByServiceIDMap(t, topology)
+	service1andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service1, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, ConnectionBlocked, *service1andOtherServicesPacketConnectionConfig[service2])
+	require.Equal(t, ConnectionAllowed, *service1andOtherServicesPacketConnectionConfig[service3])
+	service2andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service2, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, connectionWithSomeConstantLatency, *service2andOtherServicesPacketConnectionConfig[service1])
+
+	isDirectionalOverride, connection, err := topology.GetDirectionalPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.True(t, isDirectionalOverride)
+	require.Equal(t, ConnectionBlocked, connection)
+
+	isDirectionalOverride, connection, err = topology.GetDirectionalPartitionConnection(partition2, partition1)
+	require.Nil(t, err)
+	require.False(t, isDirectionalOverride)
+	require.Equal(t, connectionWithSomeConstantLatency, connection)
+}
+
+func TestSetDirectionalConnection_FailureUnknownPartition(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
+	defer closerFunc()
+
+	err := topology.SetDirectionalConnection(DefaultPartitionId, "unknownPartition", ConnectionBlocked)
+	require.Contains(t, err.Error(), "About to set a connection from 'default' to 'unknownPartition' but 'unknownPartition' does not exist")
+}
+
+func TestUnsetDirectionalConnection(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+	repartition(
+		t,
+		topology,
+		serviceSetWithService1,
+		serviceSetWithService2,
+		serviceSetWithService3,
+		map[service_network_types.PartitionConnectionID]PartitionConnection{
+			*service_network_types.NewPartitionConnectionID(partition1, partition2): connectionWithSomeConstantLatency,
+		},
+		ConnectionAllowed)
+
+	require.Nil(t, topology.SetDirectionalConnection(partition1, partition2, ConnectionBlocked))
+	require.Nil(t, topology.UnsetDirectionalConnection(partition1, partition2))
+
+	isDirectionalOverride, connection, err := topology.GetDirectionalPartitionConnection(partition1, partition2)
+	require.Nil(t, err)
+	require.False(t, isDirectionalOverride)
+	require.Equal(t, connectionWithSomeConstantLatency, connection)
+}
+
+func TestSetConnection_DropsDirectionalConnections(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+	repartition(
+		t,
+		topology,
+		serviceSetWithService1,
+		serviceSetWithService2,
+		serviceSetWithService3,
+		map[service_network_types.PartitionConnectionID]PartitionConnection{},
+		ConnectionAllowed)
+
+	require.Nil(t, topology.SetDirectionalConnection(partition1, partition2, ConnectionBlocked))
+	require.Nil(t, topology.SetDirectionalConnection(partition2, partition1, ConnectionBlocked))
+	require.Nil(t, topology.SetDirectionalConnection(partition1, partition3, ConnectionBlocked))
+	require.Nil(t, topology.SetConnection(partition2, partition1, connectionWithSomeConstantLatency))
+
+	expectedDirectionalConnectionOverrides := map[partition_connection_overrides.DirectionalPartitionConnectionID]partition_connection_overrides.PartitionConnection{
+		directionalPartitionConnectionIdDbTypeFromPartitionIds(partition1, partition3): partitionConnectionDbTypeFromPartitionConnection(ConnectionBlocked),
+	}
+	allDirectionalConnectionOverrides, err := topology.directionalPartitionConnectionOverrides.GetAllPartitionConnectionOverrides()
+	require.Nil(t, err)
+	require.Equal(t, expectedDirectionalConnectionOverrides, allDirectionalConnectionOverrides)
+
+	servicePacketConnectionConfigurationsByServiceIDMap := getServicePacketConnectionConfigurationsByServiceIDMap(t, topology)
+	service1andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service1, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, connectionWithSomeConstantLatency, *service1andOtherServicesPacketConnectionConfig[service2])
+	service2andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service2, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, connectionWithSomeConstantLatency, *service2andOtherServicesPacketConnectionConfig[service1])
+}
+
+func TestSetServiceConnection(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+	repartition(
+		t,
+		topology,
+		serviceSetWithService1And2,
+		emptyServiceSet,
+		serviceSetWithService3,
+		map[service_network_types.PartitionConnectionID]PartitionConnection{
+			*service_network_types.NewPartitionConnectionID(partition1, partition3): ConnectionBlocked,
+		},
+		ConnectionAllowed)
+
+	// service connections apply within a partition, and take precedence over the partition connections
+	require.Nil(t, topology.SetServiceConnection(service1, service2, connectionWithSomeConstantLatency))
+	require.Nil(t, topology.SetServiceConnection(service3, service1, connectionWithSomeConstantLatency))
+
+	servicePacketConnectionConfigurationsByServiceIDMap := getServicePacketConnectionConfigurationsByServiceIDMap(t, topology)
+	service1andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service1, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Len(t, service1andOtherServicesPacketConnectionConfig, 2)
+	require.Equal(t, connectionWithSomeConstantLatency, *service1andOtherServicesPacketConnectionConfig[service2])
+	require.Equal(t, connectionWithSomeConstantLatency, *service1andOtherServicesPacketConnectionConfig[service3])
+	service2andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service2, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, connectionWithSomeConstantLatency, *service2andOtherServicesPacketConnectionConfig[service1])
+	require.Equal(t, ConnectionBlocked, *service2andOtherServicesPacketConnectionConfig[service3])
+	service3andOtherServicesPacketConnectionConfig := getServicePacketConnectionConfigForService(t, service3, servicePacketConnectionConfigurationsByServiceIDMap)
+	require.Equal(t, connectionWithSomeConstantLatency, *service3andOtherServicesPacketConnectionConfig[service1])
+	require.Equal(t, ConnectionBlocked, *service3andOtherServicesPacketConnectionConfig[service2])
+
+	isServiceOverride, connection, err := topology.GetServiceConnection(service2, service1)
+	require.Nil(t, err)
+	require.True(t, isServiceOverride)
+	require.Equal(t, connectionWithSomeConstantLatency, connection)
+
+	isServiceOverride, connection, err = topology.GetServiceConnection(service2, service3)
+	require.Nil(t, err)
+	require.False(t, isServiceOverride)
+	require.Equal(t, ConnectionBlocked, connection)
+
+	allServiceConnections, err := topology.GetServiceConnectionOverrides()
+	require.Nil(t, err)
+	require.Len(t, allServiceConnections, 2)
+	require.Equal(t, connectionWithSomeConstantLatency, allServiceConnections[*service_network_types.NewServiceConnectionID(service1, service3)])
+}
+
+func TestSetServiceConnection_FailureUnknownService(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
+	defer closerFunc()
+
+	err := topology.SetServiceConnection(service1, "unknownService", ConnectionBlocked)
+	require.Contains(t, err.Error(), "Service 'unknownService' does not exist")
+
+	err = topology.SetServiceConnection(service1, service1, ConnectionBlocked)
+	require.Contains(t, err.Error(), "got 'service1' twice")
+}
+
+func TestUnsetServiceConnection(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
+	defer closerFunc()
+
+	require.Nil(t, topology.SetServiceConnection(service1, service2, connectionWithSomeConstantLatency))
+	require.Nil(t, topology.UnsetServiceConnection(service2, service1))
+
+	isServiceOverride, connection, err := topology.GetServiceConnection(service1, service2)
+	require.Nil(t, err)
+	require.False(t, isServiceOverride)
+	// the two services are in the default partition
+	require.Equal(t, ConnectionAllowed, connection)
+
+	servicePacketConnectionConfigurationsByServiceIDMap := getServicePacketConnectionConfigurationsByServiceIDMap(t, topology)
+	require.Empty(t, getServicePacketConnectionConfigForService(t, service1, servicePacketConnectionConfigurationsByServiceIDMap))
+}
+
+func TestRemoveService_DropsServiceConnections(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+
+	require.Nil(t, topology.SetServiceConnection(service1, service2, ConnectionBlocked))
+	require.Nil(t, topology.SetServiceConnection(service1, service3, ConnectionBlocked))
+	require.Nil(t, topology.RemoveService(service2))
+
+	allServiceConnections, err := topology.GetServiceConnectionOverrides()
+	require.Nil(t, err)
+	expectedServiceConnections := map[service_network_types.ServiceConnectionID]PartitionConnection{
+		*service_network_types.NewServiceConnectionID(service1, service3): ConnectionBlocked,
+	}
+	require.Equal(t, expectedServiceConnections, allServiceConnections)
+}
+
 func TestGetConnection(t *testing.T) {
 	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
 	defer closerFunc()
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2023 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package service_network
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// ServiceHostnameRegex A hostname is made of DNS labels separated by dots, e.g. 'db' or 'db.example.com'
+	ServiceHostnameRegex = `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
+
+	// The entries Kurtosis writes to the hosts file of a service all end with this marker, so that they can be
+	// replaced without touching the entries the container came with
+	hostsFileEntryMarker = "# kurtosis-hostname"
+	hostsFilepath        = "/etc/hosts"
+
+	shellCommand      = "sh"
+	shellScriptFlag   = "-c"
+	hostsFileEntrySep = " "
+)
+
+var serviceHostnameRegex = regexp.MustCompile(ServiceHostnameRegex)
+
+// validateServiceHostnames checks that the additional hostnames of the services about to be started are valid
+// hostnames, and that each one of them identifies a single service in the enclave. A hostname can't be the name of a
+// service, nor be shared with another service
+func validateServiceHostnames(
+	existingServiceNames map[service.ServiceName]bool,
+	existingServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+	newServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) map[service.ServiceName]error {
+	serviceNamePerHostname := map[string]service.ServiceName{}
+	for serviceName, serviceConfig := range existingServiceConfigs {
+		for _, hostname := range serviceConfig.GetHostnames() {
+			serviceNamePerHostname[hostname] = serviceName
+		}
+	}
+
+	failedServices := map[service.ServiceName]error{}
+	for serviceName, serviceConfig := range newServiceConfigs {
+		if otherServiceName, found := serviceNamePerHostname[string(serviceName)]; found {
+			failedServices[serviceName] = stacktrace.NewError("Service name '%s' is already used as a hostname by service '%s'", serviceName, otherServiceName)
+			continue
+		}
+		for _, hostname := range serviceConfig.GetHostnames() {
+			if !serviceHostnameRegex.MatchString(hostname) {
+				failedServices[serviceName] = stacktrace.NewError("Hostname '%s' of service '%s' is invalid; it should match '%s'", hostname, serviceName, ServiceHostnameRegex)
+				break
+			}
+			_, isExistingServiceName := existingServiceNames[service.ServiceName(hostname)]
+			_, isNewServiceName := newServiceConfigs[service.ServiceName(hostname)]
+			if isExistingServiceName || isNewServiceName {
+				failedServices[serviceName] = stacktrace.NewError("Hostname '%s' of service '%s' is already the name of a service in the enclave", hostname, serviceName)
+				break
+			}
+			if otherServiceName, found := serviceNamePerHostname[hostname]; found {
+				failedServices[serviceName] = stacktrace.NewError("Hostname '%s' of service '%s' is already used by service '%s'", hostname, serviceName, otherServiceName)
+				break
+			}
+			serviceNamePerHostname[hostname] = serviceName
+		}
+	}
+	return failedServices
+}
+
+// getHostsFileEntries returns the entries of the hosts file of a service, i.e. one entry for each service with
+// additional hostnames that the service can reach
+func getHostsFileEntries(
+	serviceName service.ServiceName,
+	otherServiceConnectionConfigs map[service.ServiceName]*partition_topology.PartitionConnection,
+	serviceRegistrations map[service.ServiceName]*service.ServiceRegistration,
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) []string {
+	var hostsFileEntries []string
+	for otherServiceName, otherServiceConfig := range serviceConfigs {
+		otherServiceHostnames := otherServiceConfig.GetHostnames()
+		if len(otherServiceHostnames) == emptyCollectionLength {
+			continue
+		}
+		otherServiceRegistration, found := serviceRegistrations[otherServiceName]
+		if !found {
+			continue
+		}
+		if connection, found := otherServiceConnectionConfigs[otherServiceName]; found && otherServiceName != serviceName && connection.IsBlocking() {
+			// the service can't reach the other service, so the hostnames of the latter shouldn't resolve from the former
+			continue
+		}
+		otherServiceIpAddresses := []net.IP{otherServiceRegistration.GetPrivateIP()}
+		if otherServiceRegistration.GetPrivateIPv6() != nil {
+			otherServiceIpAddresses = append(otherServiceIpAddresses, otherServiceRegistration.GetPrivateIPv6())
+		}
+		for _, otherServiceIpAddress := range otherServiceIpAddresses {
+			hostsFileEntryFields := []string{otherServiceIpAddress.String()}
+			hostsFileEntryFields = append(hostsFileEntryFields, otherServiceHostnames...)
+			hostsFileEntryFields = append(hostsFileEntryFields, hostsFileEntryMarker)
+			hostsFileEntries = append(hostsFileEntries, strings.Join(hostsFileEntryFields, hostsFileEntrySep))
+		}
+	}
+	sort.Strings(hostsFileEntries)
+	return hostsFileEntries
+}
+
+// generateUpdateHostsFileCmd returns the command replacing the entries Kurtosis previously wrote to the hosts file of a
+// service with the given ones. The file is written in place as the container runtime usually mounts it in the container
+func generateUpdateHostsFileCmd(hostsFileEntries []string) []string {
+	quotedHostsFileEntries := []string{`"$hosts"`}
+	for _, hostsFileEntry := range hostsFileEntries {
+		quotedHostsFileEntries = append(quotedHostsFileEntries, fmt.Sprintf("'%s'", hostsFileEntry))
+	}
+	script := fmt.Sprintf(
+		`hosts="$(grep -v ' %s$' %s)"; printf '%%s\n' %s > %s`,
+		hostsFileEntryMarker,
+		hostsFilepath,
+		strings.Join(quotedHostsFileEntries, " "),
+		hostsFilepath,
+	)
+	return []string{shellCommand, shellScriptFlag, script}
+}
+
+// getHostnamesInstallationFailures returns the errors of the given services whose hostnames, or the hostnames of the
+// services they can reach, couldn't be installed because some hosts files couldn't be written. A service fails if its
+// own hosts file couldn't be written, or if it has additional hostnames and the hosts file of another service couldn't
+func getHostnamesInstallationFailures(
+	serviceNames map[service.ServiceName]bool,
+	hostsFileUpdateFailures map[service.ServiceName]error,
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) map[service.ServiceName]error {
+	failedServices := map[service.ServiceName]error{}
+	for serviceName, hostsFileUpdateErr := range hostsFileUpdateFailures {
+		if _, found := serviceNames[serviceName]; !found {
+			continue
+		}
+		failedServices[serviceName] = stacktrace.Propagate(
+			hostsFileUpdateErr,
+			"The hostnames of the services it can reach couldn't be written to the hosts file of service '%s'; this requires its image to have a '%s' shell and its container user to be allowed to write '%s'",
+			serviceName,
+			shellCommand,
+			hostsFilepath,
+		)
+	}
+	for otherServiceName, hostsFileUpdateErr := range hostsFileUpdateFailures {
+		if _, found := serviceNames[otherServiceName]; found {
+			continue
+		}
+		isFailureOfAService := false
+		for serviceName := range serviceNames {
+			hostnames := serviceConfigs[serviceName].GetHostnames()
+			if len(hostnames) == emptyCollectionLength {
+				continue
+			}
+			isFailureOfAService = true
+			if _, found := failedServices[serviceName]; found {
+				continue
+			}
+			failedServices[serviceName] = stacktrace.Propagate(
+				hostsFileUpdateErr,
+				"Hostnames '%v' of service '%s' couldn't be written to the hosts file of service '%s'; this requires its image to have a '%s' shell and its container user to be allowed to write '%s'",
+				hostnames,
+				serviceName,
+				otherServiceName,
+				shellCommand,
+				hostsFilepath,
+			)
+		}
+		if !isFailureOfAService {
+			logrus.Warnf("An error occurred updating the hosts file of service '%s'. The additional hostnames of the "+
+				"services might not resolve as expected from it. Error was:\n%v", otherServiceName, hostsFileUpdateErr)
+		}
+	}
+	return failedServices
+}
+
+func areHostsFileEntriesEqual(hostsFileEntries []string, otherHostsFileEntries []string) bool {
+	if len(hostsFileEntries) != len(otherHostsFileEntries) {
+		return false
+	}
+	for idx, hostsFileEntry := range hostsFileEntries {
+		if hostsFileEntry != otherHostsFileEntries[idx] {
+			return false
+		}
+	}
+	return true
+}
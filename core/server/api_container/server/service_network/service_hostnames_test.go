@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package service_network
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+const (
+	hostnamesTestEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+
+	hostnamesTestImage = "kurtosistech/test-image"
+)
+
+func TestGetHostsFileEntries(t *testing.T) {
+	serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{
+		"api":      service.NewServiceRegistration("api", "api-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.3"), "api"),
+		"database": service.NewServiceRegistration("database", "database-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.4"), "database"),
+		"cache":    service.NewServiceRegistration("cache", "cache-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.5"), "cache"),
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"api":      services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"api.example.com"}).Build(),
+		"database": services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"db", "postgres"}).Build(),
+		"cache":    services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"redis"}).Build(),
+	}
+	blockedConnection := partition_topology.ConnectionBlocked
+	allowedConnection := partition_topology.ConnectionAllowed
+	otherServiceConnectionConfigs := map[service.ServiceName]*partition_topology.PartitionConnection{
+		"database": &allowedConnection,
+		"cache":    &blockedConnection,
+	}
+
+	hostsFileEntries := getHostsFileEntries("api", otherServiceConnectionConfigs, serviceRegistrations, serviceConfigs)
+	expectedHostsFileEntries := []string{
+		"172.16.0.3 api.example.com # kurtosis-hostname",
+		"172.16.0.4 db postgres # kurtosis-hostname",
+	}
+	require.Equal(t, expectedHostsFileEntries, hostsFileEntries)
+}
+
+func TestGetHostsFileEntries_DualStack(t *testing.T) {
+	serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{
+		"api":      service.NewDualStackServiceRegistration("api", "api-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.3"), net.ParseIP("fd00::3"), "api"),
+		"database": service.NewDualStackServiceRegistration("database", "database-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.4"), net.ParseIP("fd00::4"), "database"),
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"api":      services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+		"database": services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"db"}).Build(),
+	}
+
+	hostsFileEntries := getHostsFileEntries("api", map[service.ServiceName]*partition_topology.PartitionConnection{}, serviceRegistrations, serviceConfigs)
+	expectedHostsFileEntries := []string{
+		"172.16.0.4 db # kurtosis-hostname",
+		"fd00::4 db # kurtosis-hostname",
+	}
+	require.Equal(t, expectedHostsFileEntries, hostsFileEntries)
+}
+
+func TestGetHostsFileEntries_NoHostnames(t *testing.T) {
+	serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{
+		"api": service.NewServiceRegistration("api", "api-uuid", hostnamesTestEnclaveUuid, net.ParseIP("172.16.0.3"), "api"),
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"api": services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+	}
+
+	hostsFileEntries := getHostsFileEntries("api", map[service.ServiceName]*partition_topology.PartitionConnection{}, serviceRegistrations, serviceConfigs)
+	require.Empty(t, hostsFileEntries)
+	require.True(t, areHostsFileEntriesEqual(hostsFileEntries, nil))
+}
+
+func TestGenerateUpdateHostsFileCmd(t *testing.T) {
+	cmd := generateUpdateHostsFileCmd([]string{"172.16.0.4 db postgres # kurtosis-hostname"})
+	expectedScript := `hosts="$(grep -v ' # kurtosis-hostname$' /etc/hosts)"; ` +
+		`printf '%s\n' "$hosts" '172.16.0.4 db postgres # kurtosis-hostname' > /etc/hosts`
+	require.Equal(t, []string{"sh", "-c", expectedScript}, cmd)
+}
+
+func TestValidateServiceHostnames(t *testing.T) {
+	existingServiceNames := map[service.ServiceName]bool{
+		"database": true,
+	}
+	existingServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"database": services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"db"}).Build(),
+	}
+	newServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"valid":            services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"api.example.com"}).Build(),
+		"invalid":          services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"api; reboot"}).Build(),
+		"existing-name":    services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"database"}).Build(),
+		"new-name":         services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"valid"}).Build(),
+		"existing-alias":   services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"db"}).Build(),
+		"db":               services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+		"without-hostname": services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+	}
+
+	failedServices := validateServiceHostnames(existingServiceNames, existingServiceConfigs, newServiceConfigs)
+	require.Len(t, failedServices, 5)
+	for _, serviceName := range []service.ServiceName{"invalid", "existing-name", "new-name", "existing-alias", "db"} {
+		require.Contains(t, failedServices, serviceName)
+	}
+}
+
+func TestGetHostnamesInstallationFailures(t *testing.T) {
+	serviceNames := map[service.ServiceName]bool{
+		"shell-less":       true,
+		"api":              true,
+		"without-hostname": true,
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"shell-less":       services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"shell-less.example.com"}).Build(),
+		"api":              services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"api.example.com"}).Build(),
+		"without-hostname": services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+		"database":         services.NewServiceConfigBuilder(hostnamesTestImage).Build(),
+	}
+	hostsFileUpdateFailures := map[service.ServiceName]error{
+		"shell-less": stacktrace.NewError("exec: \"sh\": executable file not found in $PATH"),
+		"database":   stacktrace.NewError("/etc/hosts: Permission denied"),
+	}
+
+	failedServices := getHostnamesInstallationFailures(serviceNames, hostsFileUpdateFailures, serviceConfigs)
+	require.Len(t, failedServices, 2)
+	require.Contains(t, failedServices["shell-less"].Error(), "executable file not found")
+	require.Contains(t, failedServices["api"].Error(), "Hostnames '[api.example.com]' of service 'api' couldn't be written to the hosts file of service 'database'")
+}
+
+func TestGetHostnamesInstallationFailures_NoFailures(t *testing.T) {
+	serviceNames := map[service.ServiceName]bool{
+		"api": true,
+	}
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		"api": services.NewServiceConfigBuilder(hostnamesTestImage).WithHostnames([]string{"api.example.com"}).Build(),
+	}
+
+	failedServices := getHostnamesInstallationFailures(serviceNames, map[service.ServiceName]error{}, serviceConfigs)
+	require.Empty(t, failedServices)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"io"
 	"net/http"
+	"time"
 )
 
 type ServiceNetwork interface {
@@ -31,11 +35,43 @@ type ServiceNetwork interface {
 		partition2 service_network_types.PartitionID,
 	) error
 
+	SetDirectionalConnection(
+		ctx context.Context,
+		from service_network_types.PartitionID,
+		to service_network_types.PartitionID,
+		connection partition_topology.PartitionConnection,
+	) error
+
+	UnsetDirectionalConnection(
+		ctx context.Context,
+		from service_network_types.PartitionID,
+		to service_network_types.PartitionID,
+	) error
+
+	SetServiceConnection(
+		ctx context.Context,
+		service1 service.ServiceName,
+		service2 service.ServiceName,
+		connection partition_topology.PartitionConnection,
+	) error
+
+	UnsetServiceConnection(
+		ctx context.Context,
+		service1 service.ServiceName,
+		service2 service.ServiceName,
+	) error
+
 	SetDefaultConnection(
 		ctx context.Context,
 		connection partition_topology.PartitionConnection,
 	) error
 
+	ScheduleConnectionChanges(name string, changes []*connection_change_scheduler.ConnectionChange) error
+
+	CancelConnectionChanges(name string) error
+
+	WatchConnectionChanges(name string) (<-chan *connection_change_scheduler.ConnectionChangeEvent, error)
+
 	StartService(
 		ctx context.Context,
 		serviceName service.ServiceName,
@@ -64,8 +100,19 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	RecreateServices(
+		ctx context.Context,
+		serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+	) (
+		map[service.ServiceName]*service.Service,
+		map[service.ServiceName]error,
+		error,
+	)
+
 	RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error)
 
+	RestartService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
+
 	PauseService(ctx context.Context, serviceIdentifier string) error
 
 	UnpauseService(ctx context.Context, serviceIdentifier string) error
@@ -76,19 +123,51 @@ type ServiceNetwork interface {
 
 	GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
 
+	GetServiceLogs(ctx context.Context, serviceIdentifier string, numLogLines int) ([]string, error)
+
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	CaptureServiceTraffic(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, output io.Writer) error
+
+	StoreServiceTrafficCapture(ctx context.Context, serviceIdentifier string, duration time.Duration, filter string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+
+	GetTrafficStatistics(ctx context.Context, serviceIdentifier string) (map[service.ServiceName]map[service.ServiceName]*networking_sidecar.TrafficStatistics, error)
+
 	GetServiceNames() map[service.ServiceName]bool
 
 	GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers
 
 	GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool)
 
+	GetServiceConfig(serviceName service.ServiceName) (*kurtosis_core_rpc_api_bindings.ServiceConfig, bool)
+
+	GetServicePartition(serviceName service.ServiceName) (service_network_types.PartitionID, bool, error)
+
+	GetConnection(partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)
+
+	GetDirectionalConnection(from service_network_types.PartitionID, to service_network_types.PartitionID) (bool, partition_topology.PartitionConnection, error)
+
+	GetServiceConnection(service1 service.ServiceName, service2 service.ServiceName) (bool, partition_topology.PartitionConnection, error)
+
+	GetDefaultConnection() partition_topology.PartitionConnection
+
 	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	UploadFilesArtifact(data []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	DoesFilesArtifactExist(artifactName string) (bool, error)
+
+	IsFilesArtifactUpToDate(compressedData []byte, artifactName string) (bool, error)
+
+	AreRenderedTemplatesUpToDate(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (bool, error)
+
+	RemoveFilesArtifact(artifactName string) error
+
 	IsNetworkPartitioningEnabled() bool
 
+	// CanBlockTrafficWithoutSidecars returns true if the connections blocking all the traffic can be enforced even when
+	// network partitioning is disabled
+	CanBlockTrafficWithoutSidecars() bool
+
 	GetUniqueNameForFileArtifact() (string, error)
 }
@@ -0,0 +1,36 @@
+package service_network_types
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"strings"
+)
+
+/*
+Represents two services, where order is unimportant
+*/
+type ServiceConnectionID struct {
+	lexicalFirst  service.ServiceName
+	lexicalSecond service.ServiceName
+}
+
+// NOTE: It's very important that the constructor is used here!
+func NewServiceConnectionID(serviceA service.ServiceName, serviceB service.ServiceName) *ServiceConnectionID {
+	// Sorted upon creation, same as PartitionConnectionID, so that serviceConnectionID(A, B) == serviceConnectionID(B, A)
+	// as a map key
+	first, second := serviceA, serviceB
+	if strings.Compare(string(first), string(second)) > 0 {
+		first, second = second, first
+	}
+	return &ServiceConnectionID{
+		lexicalFirst:  first,
+		lexicalSecond: second,
+	}
+}
+
+func (id ServiceConnectionID) GetFirst() service.ServiceName {
+	return id.lexicalFirst
+}
+
+func (id ServiceConnectionID) GetSecond() service.ServiceName {
+	return id.lexicalSecond
+}
@@ -0,0 +1,23 @@
+package service_network_types
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+const (
+	service1 = "service1"
+	service2 = "service2"
+)
+
+func TestServiceConnectionIdCommutativeness(t *testing.T) {
+	forward := *NewServiceConnectionID(service1, service2)
+	reverse := *NewServiceConnectionID(service2, service1)
+
+	theMap := map[ServiceConnectionID]bool{
+		forward: true,
+	}
+
+	_, found := theMap[reverse]
+	assert.Assert(t, found, "Expected to find reverse mapping in the map due to commutativeness")
+}
@@ -0,0 +1,202 @@
+package snapshot_restorer
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/partition_topology_db/partition_connection_overrides"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/partition_topology_db/service_partitions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/service_configs"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	servicesStartupParallelism = 4
+
+	failedServicesErrorsSeparator = "\n"
+)
+
+// RestoreSnapshotIfPresent restores the enclave snapshot the engine copied to the enclave data directory, if any. The
+// files artifacts of the snapshot are stored again with the same UUIDs and names, and the services are started again
+// in their partitions, out of the images their filesystems were committed to when the snapshot was taken.
+// The snapshot is removed from the enclave data directory afterwards, so that it gets restored only once
+func RestoreSnapshotIfPresent(
+	ctx context.Context,
+	enclaveDataDirpath string,
+	serviceNetwork service_network.ServiceNetwork,
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
+) error {
+	snapshotDirpath := path.Join(enclaveDataDirpath, enclave_snapshot.SnapshotToRestoreDirname)
+	if _, err := os.Stat(snapshotDirpath); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking if an enclave snapshot to restore exists at '%v'", snapshotDirpath)
+	}
+	defer func() {
+		if err := os.RemoveAll(snapshotDirpath); err != nil {
+			logrus.Errorf("An error occurred removing the restored enclave snapshot at '%v'. It will be restored again "+
+				"if the API container restarts. Error was:\n%v", snapshotDirpath, err)
+		}
+	}()
+
+	serializedManifest, err := os.ReadFile(path.Join(snapshotDirpath, enclave_snapshot.ManifestFilename))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the manifest of the enclave snapshot")
+	}
+	manifest, err := enclave_snapshot.DeserializeManifest(serializedManifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred deserializing the manifest of the enclave snapshot")
+	}
+	logrus.Infof("Restoring the snapshot of enclave '%v'...", manifest.EnclaveName)
+
+	if err = filesArtifactStore.RestoreFilesFromStoreDirectory(path.Join(snapshotDirpath, enclave_snapshot.FilesArtifactStoreDirname)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the files artifacts of the enclave snapshot")
+	}
+
+	snapshotEnclaveDb, err := enclave_db.OpenEnclaveDatabaseFile(path.Join(snapshotDirpath, enclave_snapshot.EnclaveDbFilename))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the enclave database of the enclave snapshot")
+	}
+	defer snapshotEnclaveDb.Close()
+
+	serviceConfigs, err := getServiceConfigsToRestore(snapshotEnclaveDb, manifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the configs of the services of the enclave snapshot")
+	}
+	if len(serviceConfigs) > 0 {
+		_, failedServices, err := serviceNetwork.StartServices(ctx, serviceConfigs, servicesStartupParallelism)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting the services of the enclave snapshot")
+		}
+		if len(failedServices) > 0 {
+			failedServicesErrors := []string{}
+			for serviceName, serviceErr := range failedServices {
+				failedServicesErrors = append(failedServicesErrors, fmt.Sprintf("Service '%v' failed to start with error:\n%v", serviceName, serviceErr))
+			}
+			return stacktrace.NewError("Some services of the enclave snapshot failed to start:\n%v", strings.Join(failedServicesErrors, failedServicesErrorsSeparator))
+		}
+	}
+
+	if serviceNetwork.IsNetworkPartitioningEnabled() {
+		if err = restoreConnectionOverrides(ctx, snapshotEnclaveDb, serviceNetwork); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connections between the partitions of the enclave snapshot")
+		}
+	}
+	logrus.Infof("Snapshot of enclave '%v' restored: %d services and their files artifacts were restored", manifest.EnclaveName, len(serviceConfigs))
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// Returns the configs of the services of the snapshot, pointing to the images their filesystems were committed to and
+// to the partitions they were in
+func getServiceConfigsToRestore(
+	snapshotEnclaveDb *enclave_db.EnclaveDB,
+	manifest *enclave_snapshot.Manifest,
+) (map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, error) {
+	serviceConfigsBucket, err := service_configs.GetOrCreateServiceConfigsBucket(snapshotEnclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service configs bucket")
+	}
+	serializedServiceConfigs, err := serviceConfigsBucket.GetAllServiceConfigs()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service configs")
+	}
+	servicePartitionsBucket, err := service_partitions.GetOrCreateServicePartitionsBucket(snapshotEnclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service partitions bucket")
+	}
+	servicePartitions, err := servicePartitionsBucket.GetAllServicePartitions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the partitions of the services")
+	}
+
+	serviceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serializedServiceConfig := range serializedServiceConfigs {
+		serviceConfig := new(kurtosis_core_rpc_api_bindings.ServiceConfig)
+		if err = proto.Unmarshal(serializedServiceConfig, serviceConfig); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the config of service '%v'", serviceName)
+		}
+		if serviceImage, found := manifest.ServiceImages[string(serviceName)]; found {
+			serviceConfig.ContainerImageName = serviceImage
+		} else {
+			logrus.Warnf("No image was created out of the filesystem of service '%v' when the snapshot was taken. "+
+				"It is restored from its original image '%v'", serviceName, serviceConfig.GetContainerImageName())
+		}
+		if partitionId, found := servicePartitions[serviceName]; found {
+			partitionIdStr := string(partitionId)
+			serviceConfig.Subnetwork = &partitionIdStr
+		}
+		serviceConfigs[serviceName] = serviceConfig
+	}
+	return serviceConfigs, nil
+}
+
+func restoreConnectionOverrides(
+	ctx context.Context,
+	snapshotEnclaveDb *enclave_db.EnclaveDB,
+	serviceNetwork service_network.ServiceNetwork,
+) error {
+	connectionOverridesBucket, err := partition_connection_overrides.GetOrCreatePartitionConnectionOverrideBucket(snapshotEnclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the partition connection overrides bucket")
+	}
+	connectionOverrides, err := connectionOverridesBucket.GetAllPartitionConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the partition connection overrides")
+	}
+	for connectionId, connection := range connectionOverrides {
+		partition1 := service_network_types.PartitionID(connectionId.LexicalFirst)
+		partition2 := service_network_types.PartitionID(connectionId.LexicalSecond)
+		if err = serviceNetwork.SetConnection(ctx, partition1, partition2, partition_topology.NewPartitionConnectionFromDbType(connection)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connection between partitions '%v' and '%v'", partition1, partition2)
+		}
+	}
+
+	// restored after them, as setting a connection between two partitions drops the directional connections between them
+	directionalConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateDirectionalPartitionConnectionOverrideBucket(snapshotEnclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the directional partition connection overrides bucket")
+	}
+	directionalConnectionOverrides, err := directionalConnectionOverridesBucket.GetAllPartitionConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the directional partition connection overrides")
+	}
+	for connectionId, connection := range directionalConnectionOverrides {
+		from := service_network_types.PartitionID(connectionId.From)
+		to := service_network_types.PartitionID(connectionId.To)
+		if err = serviceNetwork.SetDirectionalConnection(ctx, from, to, partition_topology.NewPartitionConnectionFromDbType(connection)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connection from partition '%v' to partition '%v'", from, to)
+		}
+	}
+
+	serviceConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateServiceConnectionOverrideBucket(snapshotEnclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service connection overrides bucket")
+	}
+	serviceConnectionOverrides, err := serviceConnectionOverridesBucket.GetAllServiceConnectionOverrides()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service connection overrides")
+	}
+	for connectionId, connection := range serviceConnectionOverrides {
+		if err = serviceNetwork.SetServiceConnection(ctx, connectionId.LexicalFirst, connectionId.LexicalSecond, partition_topology.NewPartitionConnectionFromDbType(connection)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the connection between services '%v' and '%v'", connectionId.LexicalFirst, connectionId.LexicalSecond)
+		}
+	}
+	return nil
+}
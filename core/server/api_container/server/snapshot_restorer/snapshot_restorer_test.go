@@ -0,0 +1,178 @@
+package snapshot_restorer
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/partition"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/partition_topology_db/partition_connection_overrides"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/partition_topology_db/service_partitions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_network_db/service_configs"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	testEnclaveName = "test-enclave"
+
+	testServiceNameA      = service.ServiceName("test-service-a")
+	testServiceImageA     = "test-image-a"
+	testCommittedImageA   = "kurtosis-user-service-image/test-service-a-uuid:20230101000000"
+	testServiceNameB      = service.ServiceName("test-service-b")
+	testServiceImageB     = "test-image-b"
+	testServicePartitionA = partition.PartitionID("test-partition")
+
+	testPacketLossPercentage = float32(50)
+
+	isPartitioningEnabled = true
+)
+
+func TestRestoreSnapshotIfPresent_NoSnapshot(t *testing.T) {
+	enclaveDataDirpath := t.TempDir()
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	err := RestoreSnapshotIfPresent(context.Background(), enclaveDataDirpath, serviceNetwork, nil)
+	require.Nil(t, err)
+}
+
+func TestRestoreSnapshotIfPresent_RestoresServicesAndConnections(t *testing.T) {
+	ctx := context.Background()
+	enclaveDataDirpath := t.TempDir()
+	snapshotDirpath := path.Join(enclaveDataDirpath, enclave_snapshot.SnapshotToRestoreDirname)
+	writeTestSnapshot(t, snapshotDirpath)
+
+	filesArtifactStore, err := enclave_data_directory.NewEnclaveDataDirectory(enclaveDataDirpath).GetFilesArtifactStore()
+	require.Nil(t, err)
+
+	expectedServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		testServiceNameA: services.NewServiceConfigBuilder(testCommittedImageA).WithSubnetwork(string(testServicePartitionA)).Build(),
+		testServiceNameB: services.NewServiceConfigBuilder(testServiceImageB).Build(),
+	}
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().StartServices(
+		ctx,
+		mock.MatchedBy(func(serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			if len(serviceConfigs) != len(expectedServiceConfigs) {
+				return false
+			}
+			for serviceName, expectedServiceConfig := range expectedServiceConfigs {
+				if !proto.Equal(expectedServiceConfig, serviceConfigs[serviceName]) {
+					return false
+				}
+			}
+			return true
+		}),
+		servicesStartupParallelism,
+	).Return(map[service.ServiceName]*service.Service{}, map[service.ServiceName]error{}, nil)
+	serviceNetwork.EXPECT().IsNetworkPartitioningEnabled().Return(isPartitioningEnabled)
+	serviceNetwork.EXPECT().SetConnection(
+		ctx,
+		partition_topology.DefaultPartitionId,
+		service_network_types.PartitionID(testServicePartitionA),
+		partition_topology.NewPartitionConnection(partition_topology.NewPacketLoss(testPacketLossPercentage), partition_topology.NewNormalPacketDelayDistribution(0, 0, 0)),
+	).Return(nil)
+	serviceNetwork.EXPECT().SetDirectionalConnection(
+		ctx,
+		service_network_types.PartitionID(testServicePartitionA),
+		partition_topology.DefaultPartitionId,
+		partition_topology.NewPartitionConnection(partition_topology.NewPacketLoss(testPacketLossPercentage), partition_topology.NewNormalPacketDelayDistribution(0, 0, 0)),
+	).Return(nil)
+	serviceNetwork.EXPECT().SetServiceConnection(
+		ctx,
+		testServiceNameA,
+		testServiceNameB,
+		partition_topology.NewPartitionConnection(partition_topology.NewPacketLoss(testPacketLossPercentage), partition_topology.NewNormalPacketDelayDistribution(0, 0, 0)),
+	).Return(nil)
+
+	err = RestoreSnapshotIfPresent(ctx, enclaveDataDirpath, serviceNetwork, filesArtifactStore)
+	require.Nil(t, err)
+
+	_, err = os.Stat(snapshotDirpath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func writeTestSnapshot(t *testing.T, snapshotDirpath string) {
+	require.Nil(t, os.MkdirAll(path.Join(snapshotDirpath, enclave_snapshot.FilesArtifactStoreDirname), 0755))
+
+	manifest := enclave_snapshot.NewManifest("X.X.X", testEnclaveName, isPartitioningEnabled, map[string]string{
+		string(testServiceNameA): testCommittedImageA,
+	})
+	serializedManifest, err := manifest.Serialize()
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path.Join(snapshotDirpath, enclave_snapshot.ManifestFilename), serializedManifest, 0644))
+
+	enclaveDb, err := enclave_db.OpenEnclaveDatabaseFile(path.Join(snapshotDirpath, enclave_snapshot.EnclaveDbFilename))
+	require.Nil(t, err)
+	defer enclaveDb.Close()
+
+	serviceConfigsBucket, err := service_configs.GetOrCreateServiceConfigsBucket(enclaveDb)
+	require.Nil(t, err)
+	for serviceName, serviceConfig := range map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		testServiceNameA: services.NewServiceConfigBuilder(testServiceImageA).Build(),
+		testServiceNameB: services.NewServiceConfigBuilder(testServiceImageB).Build(),
+	} {
+		serializedServiceConfig, err := proto.Marshal(serviceConfig)
+		require.Nil(t, err)
+		require.Nil(t, serviceConfigsBucket.PutServiceConfig(serviceName, serializedServiceConfig))
+	}
+
+	servicePartitionsBucket, err := service_partitions.GetOrCreateServicePartitionsBucket(enclaveDb)
+	require.Nil(t, err)
+	require.Nil(t, servicePartitionsBucket.AddPartitionToService(testServiceNameA, testServicePartitionA))
+
+	connectionOverridesBucket, err := partition_connection_overrides.GetOrCreatePartitionConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+	connectionId := partition_connection_overrides.PartitionConnectionID{
+		LexicalFirst:  partition.PartitionID(partition_topology.DefaultPartitionId),
+		LexicalSecond: testServicePartitionA,
+	}
+	connection := partition_connection_overrides.PartitionConnection{
+		PacketLoss: testPacketLossPercentage,
+		PacketDelayDistribution: partition_connection_overrides.DelayDistribution{
+			AvgDelayMs:  0,
+			Jitter:      0,
+			Correlation: 0,
+		},
+		BandwidthLimitKbit: 0,
+		PacketDuplication: partition_connection_overrides.PacketPercentage{
+			Percentage:  0,
+			Correlation: 0,
+		},
+		PacketCorruption: partition_connection_overrides.PacketPercentage{
+			Percentage:  0,
+			Correlation: 0,
+		},
+		PacketReordering: partition_connection_overrides.PacketPercentage{
+			Percentage:  0,
+			Correlation: 0,
+		},
+	}
+	require.Nil(t, connectionOverridesBucket.AddPartitionConnectionOverride(connectionId, connection))
+
+	directionalConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateDirectionalPartitionConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+	directionalConnectionId := partition_connection_overrides.DirectionalPartitionConnectionID{
+		From: testServicePartitionA,
+		To:   partition.PartitionID(partition_topology.DefaultPartitionId),
+	}
+	require.Nil(t, directionalConnectionOverridesBucket.AddPartitionConnectionOverride(directionalConnectionId, connection))
+
+	serviceConnectionOverridesBucket, err := partition_connection_overrides.GetOrCreateServiceConnectionOverrideBucket(enclaveDb)
+	require.Nil(t, err)
+	serviceConnectionId := partition_connection_overrides.ServiceConnectionID{
+		LexicalFirst:  testServiceNameA,
+		LexicalSecond: testServiceNameB,
+	}
+	require.Nil(t, serviceConnectionOverridesBucket.AddServiceConnectionOverride(serviceConnectionId, connection))
+}
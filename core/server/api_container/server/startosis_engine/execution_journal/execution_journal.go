@@ -0,0 +1,78 @@
+package execution_journal
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/applied_instructions"
+	"github.com/kurtosis-tech/stacktrace"
+	"sync"
+)
+
+// ExecutionJournal keeps track of the instructions that were successfully applied to the enclave by the last
+// Starlark run of each package. Instructions are stored in their canonical form, along with the number of times they
+// were applied, such that a subsequent run of the same package can tell whether an instruction is already applied,
+// wherever it is located in the plan, and skip it.
+//
+// There is one API container per enclave, hence one journal per enclave.
+type ExecutionJournal struct {
+	mutex *sync.RWMutex
+
+	appliedInstructions map[string]map[string]int
+
+	// Where the journal is persisted so that it survives an API container restart. Nil if it is only kept in memory
+	appliedInstructionsBucket *applied_instructions.AppliedInstructionsBucket
+}
+
+func NewExecutionJournal() *ExecutionJournal {
+	return &ExecutionJournal{
+		mutex:                     &sync.RWMutex{},
+		appliedInstructions:       map[string]map[string]int{},
+		appliedInstructionsBucket: nil,
+	}
+}
+
+// NewPersistedExecutionJournal creates an ExecutionJournal backed by the enclave database. The instructions already
+// present in the database are loaded in the journal
+func NewPersistedExecutionJournal(enclaveDb *enclave_db.EnclaveDB) (*ExecutionJournal, error) {
+	appliedInstructionsBucket, err := applied_instructions.GetOrCreateAppliedInstructionsBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the applied instructions bucket")
+	}
+	appliedInstructions, err := appliedInstructionsBucket.GetAllAppliedInstructions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persisted applied instructions")
+	}
+	return &ExecutionJournal{
+		mutex:                     &sync.RWMutex{},
+		appliedInstructions:       appliedInstructions,
+		appliedInstructionsBucket: appliedInstructionsBucket,
+	}, nil
+}
+
+// GetAppliedInstructions returns the canonical forms of the instructions the last run of the package successfully
+// applied, along with the number of times each of them was applied
+func (journal *ExecutionJournal) GetAppliedInstructions(packageId string) map[string]int {
+	journal.mutex.RLock()
+	defer journal.mutex.RUnlock()
+	appliedInstructions := map[string]int{}
+	for canonicalInstruction, numberOfApplications := range journal.appliedInstructions[packageId] {
+		appliedInstructions[canonicalInstruction] = numberOfApplications
+	}
+	return appliedInstructions
+}
+
+// Replace overrides the instructions recorded for the package with the ones applied by the run that just completed.
+// The journal is left untouched if it can't be persisted
+func (journal *ExecutionJournal) Replace(packageId string, appliedInstructions map[string]int) error {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	if journal.appliedInstructionsBucket != nil {
+		if err := journal.appliedInstructionsBucket.ReplaceAppliedInstructions(packageId, appliedInstructions); err != nil {
+			return stacktrace.Propagate(err, "An error occurred persisting the instructions applied by package '%v'", packageId)
+		}
+	}
+	journal.appliedInstructions[packageId] = map[string]int{}
+	for canonicalInstruction, numberOfApplications := range appliedInstructions {
+		journal.appliedInstructions[packageId][canonicalInstruction] = numberOfApplications
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package execution_journal
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testPackageId = "github.com/package-author/package-repo"
+)
+
+func TestNewPersistedExecutionJournal_ReloadsAppliedInstructions(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+
+	executionJournal, err := NewPersistedExecutionJournal(enclaveDb)
+	require.Nil(t, err)
+	appliedInstructions := map[string]int{
+		"add_service(name=\"api\")": 1,
+		"print(msg=\"hello\")":      2,
+	}
+	require.Nil(t, executionJournal.Replace(testPackageId, appliedInstructions))
+
+	reloadedExecutionJournal, err := NewPersistedExecutionJournal(enclaveDb)
+	require.Nil(t, err)
+	require.Equal(t, appliedInstructions, reloadedExecutionJournal.GetAppliedInstructions(testPackageId))
+	require.Empty(t, reloadedExecutionJournal.GetAppliedInstructions("github.com/package-author/other-package-repo"))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -60,8 +61,9 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 				serviceNetwork:    serviceNetwork,
 				runtimeValueStore: runtimeValueStore,
 
-				serviceName:   "",  // populated at interpretation time
-				serviceConfig: nil, // populated at interpretation time
+				serviceName:    "",  // populated at interpretation time
+				serviceConfig:  nil, // populated at interpretation time
+				readyCondition: nil, // populated at interpretation time
 
 				resultUuid: "", // populated at interpretation time
 			}
@@ -80,6 +82,8 @@ type AddServiceCapabilities struct {
 
 	serviceName   service.ServiceName
 	serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig
+	// nil when the service is considered ready as soon as it's started
+	readyCondition *serviceReadyCondition
 
 	resultUuid string
 }
@@ -98,9 +102,14 @@ func (builtin *AddServiceCapabilities) Interpret(arguments *builtin_argument.Arg
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
+	readyCondition, interpretationErr := extractReadyCondition(serviceConfig)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
+	builtin.readyCondition = readyCondition
 	builtin.resultUuid, err = builtin.runtimeValueStore.CreateValue()
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
@@ -120,20 +129,74 @@ func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValu
 	return nil
 }
 
-func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+func (builtin *AddServiceCapabilities) Execute(ctx context.Context, arguments *builtin_argument.ArgumentValuesSet) (string, error) {
+	return builtin.ExecuteWithOptions(ctx, arguments, kurtosis_instruction.NewDefaultExecutionOptions())
+}
+
+// ExecuteWithOptions starts the service. When the package is applied to the enclave and the apply plan updates the
+// running service, the service is re-created in place instead, and rolled back to its previous config if it doesn't
+// become ready
+func (builtin *AddServiceCapabilities) ExecuteWithOptions(ctx context.Context, _ *builtin_argument.ArgumentValuesSet, options *kurtosis_instruction.ExecutionOptions) (string, error) {
 	replacedServiceName, replacedServiceConfig, err := replaceMagicStrings(builtin.runtimeValueStore, builtin.serviceName, builtin.serviceConfig)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred replace a magic string in '%s' instruction arguments for service '%s'. Execution cannot proceed", AddServiceBuiltinName, builtin.serviceName)
 	}
-	startedService, err := builtin.serviceNetwork.StartService(ctx, replacedServiceName, replacedServiceConfig)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "Unexpected error occurred starting service '%s'", replacedServiceName)
+	var startedService *service.Service
+	var previousServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+	if isServiceRecreatedByApplyPlan(options, replacedServiceName) {
+		serviceConfigsToRecreate := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+			replacedServiceName: replacedServiceConfig,
+		}
+		previousServiceConfigs, err = getServiceConfigsBeforeUpdate(builtin.serviceNetwork, serviceConfigsToRecreate)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting the config of service '%s' before updating it", replacedServiceName)
+		}
+		recreatedServices, failedServices, err := builtin.serviceNetwork.RecreateServices(ctx, serviceConfigsToRecreate)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "Unexpected error occurred re-creating service '%s' with its new config", replacedServiceName)
+		}
+		if failedServiceErr, found := failedServices[replacedServiceName]; found {
+			return "", stacktrace.Propagate(failedServiceErr, "An error occurred re-creating service '%s' with its new config", replacedServiceName)
+		}
+		startedService = recreatedServices[replacedServiceName]
+	} else {
+		startedService, err = builtin.serviceNetwork.StartService(ctx, replacedServiceName, replacedServiceConfig)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "Unexpected error occurred starting service '%s'", replacedServiceName)
+		}
+	}
+	if builtin.readyCondition != nil {
+		if err = waitForServiceReadiness(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, replacedServiceName, builtin.readyCondition); err != nil {
+			if previousServiceConfigs != nil {
+				rollbackServicesUpdatedByApplyPlan(builtin.serviceNetwork, previousServiceConfigs)
+				return "", stacktrace.Propagate(err, "An error occurred waiting for service '%s' to become ready after updating it. It was rolled back to its previous config", replacedServiceName)
+			}
+			removeServiceNotReady(ctx, builtin.serviceNetwork, replacedServiceName)
+			return "", stacktrace.Propagate(err, "An error occurred waiting for service '%s' to become ready", replacedServiceName)
+		}
+	}
+	if err = fillAddServiceReturnValueWithRuntimeValues(startedService.GetRegistration(), builtin.resultUuid, builtin.runtimeValueStore); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred filling the return value of service '%s'", replacedServiceName)
 	}
-	fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore)
 	instructionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", replacedServiceName, startedService.GetRegistration().GetUUID())
 	return instructionResult, nil
 }
 
+func (builtin *AddServiceCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	addServiceDependencies(dependencies, builtin.serviceName, builtin.serviceConfig, builtin.readyCondition, builtin.resultUuid)
+	return dependencies
+}
+
+func (builtin *AddServiceCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	change := planServiceApply(builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName, builtin.serviceConfig, builtin.resultUuid, forceUpdate)
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{change}, nil
+}
+
+func (builtin *AddServiceCapabilities) RestoreRuntimeValues(_ *builtin_argument.ArgumentValuesSet) bool {
+	return restoreServiceRuntimeValues(builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName, builtin.resultUuid)
+}
+
 func validateAndConvertConfig(rawConfig starlark.Value) (*kurtosis_core_rpc_api_bindings.ServiceConfig, *startosis_errors.InterpretationError) {
 	config, ok := rawConfig.(*service_config.ServiceConfig)
 	if !ok {
@@ -1,31 +1,250 @@
 package add_service
 
 import (
+	"context"
 	"fmt"
+	"github.com/cenkalti/backoff/v4"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"time"
 )
 
 const (
 	ipAddressRuntimeValue = "ip_address"
 	hostnameRuntimeValue  = "hostname"
+
+	// number of log lines of a service that didn't become ready to show in the error
+	numServiceLogLinesOnReadinessFailure = 50
 )
 
-func fillAddServiceReturnValueWithRuntimeValues(service *service.Service, resultUuid string, runtimeValueStore *runtime_value_store.RuntimeValueStore) {
-	runtimeValueStore.SetValue(resultUuid, map[string]starlark.Comparable{
-		ipAddressRuntimeValue: starlark.String(service.GetRegistration().GetPrivateIP().String()),
-		hostnameRuntimeValue:  starlark.String(service.GetRegistration().GetHostname()),
-	})
+// serviceReadyCondition holds the ready condition of a service, as extracted from its ServiceConfig
+type serviceReadyCondition struct {
+	recipe    recipe.Recipe
+	field     string
+	assertion string
+	target    starlark.Comparable
+	interval  time.Duration
+	timeout   time.Duration
+}
+
+// extractReadyCondition returns the ready condition set on the service config, or nil if the config has none
+func extractReadyCondition(serviceConfig *service_config.ServiceConfig) (*serviceReadyCondition, *startosis_errors.InterpretationError) {
+	readyCondition, interpretationErr := serviceConfig.GetReadyCondition()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if readyCondition == nil {
+		return nil, nil
+	}
+	readyConditionRecipe, interpretationErr := readyCondition.GetRecipe()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	field, interpretationErr := readyCondition.GetField()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	assertion, interpretationErr := readyCondition.GetAssertion()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	target, interpretationErr := readyCondition.GetTarget()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	interval, interpretationErr := readyCondition.GetInterval()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	timeout, interpretationErr := readyCondition.GetTimeout()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &serviceReadyCondition{
+		recipe:    readyConditionRecipe,
+		field:     field,
+		assertion: assertion,
+		target:    target,
+		interval:  interval,
+		timeout:   timeout,
+	}, nil
+}
+
+// waitForServiceReadiness blocks until the service satisfies its ready condition. If it never does, the error contains
+// the last lines of the logs of the service to help figuring out why
+func waitForServiceReadiness(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	readyCondition *serviceReadyCondition,
+) error {
+	_, _, err := wait.ExecuteServiceAssertionWithRecipe(
+		ctx,
+		serviceNetwork,
+		runtimeValueStore,
+		serviceName,
+		readyCondition.recipe,
+		readyCondition.field,
+		readyCondition.assertion,
+		readyCondition.target,
+		backoff.NewConstantBackOff(readyCondition.interval),
+		readyCondition.timeout,
+	)
+	if err == nil {
+		return nil
+	}
+	serviceLogLines, logsErr := serviceNetwork.GetServiceLogs(ctx, string(serviceName), numServiceLogLinesOnReadinessFailure)
+	if logsErr != nil {
+		logrus.Warnf("Service '%s' did not become ready and an error occurred getting its logs:\n%v", serviceName, logsErr)
+		return stacktrace.Propagate(err, "Service '%s' did not become ready. Its logs could not be retrieved", serviceName)
+	}
+	return stacktrace.Propagate(err, "Service '%s' did not become ready. Its last %d log lines were:\n%s", serviceName, len(serviceLogLines), strings.Join(serviceLogLines, "\n"))
+}
+
+// removeServiceNotReady removes a service that did not become ready, so that the failed instruction can be run again
+func removeServiceNotReady(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName) {
+	if _, err := serviceNetwork.RemoveService(ctx, string(serviceName)); err != nil {
+		logrus.Errorf("Service '%s' did not become ready and an error occurred removing it. It might have to be removed manually. Error was:\n%v", serviceName, err)
+	}
+}
+
+// getServiceConfigsBeforeUpdate returns the configs the running services have before the apply plan updates them, so
+// that they can be rolled back to it if the update fails
+func getServiceConfigsBeforeUpdate(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceConfigsToRecreate map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) (map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, error) {
+	previousServiceConfigs := make(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, len(serviceConfigsToRecreate))
+	for serviceName := range serviceConfigsToRecreate {
+		previousServiceConfig, found := serviceNetwork.GetServiceConfig(serviceName)
+		if !found {
+			return nil, stacktrace.NewError("No config was found for running service '%s', so it can't be updated as it couldn't be rolled back if the update failed", serviceName)
+		}
+		previousServiceConfigs[serviceName] = previousServiceConfig
+	}
+	return previousServiceConfigs, nil
+}
+
+// rollbackServicesUpdatedByApplyPlan re-creates the services the apply plan updated with the config they had before,
+// so that a failed apply leaves the services that were already running in the enclave as they were
+func rollbackServicesUpdatedByApplyPlan(
+	serviceNetwork service_network.ServiceNetwork,
+	previousServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) {
+	if len(previousServiceConfigs) == 0 {
+		return
+	}
+	// Use background context so the services get rolled back even if input context was cancelled
+	_, failedServices, err := serviceNetwork.RecreateServices(context.Background(), previousServiceConfigs)
+	if err != nil {
+		logrus.Errorf("An error occurred re-creating the services updated by the apply plan with their previous config. They "+
+			"will be left running their updated config. Error was:\n%v", err)
+		return
+	}
+	for serviceName, serviceErr := range failedServices {
+		logrus.Errorf("Service '%s' was updated by the apply plan and an error occurred re-creating it with its previous "+
+			"config. It might have to be fixed manually. Error was:\n%v", serviceName, serviceErr)
+	}
+}
+
+func fillAddServiceReturnValueWithRuntimeValues(serviceRegistration *service.ServiceRegistration, resultUuid string, runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
+	if err := runtimeValueStore.SetValue(resultUuid, getAddServiceReturnValueRuntimeValues(serviceRegistration)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the runtime values of service '%s'", serviceRegistration.GetName())
+	}
+	return nil
+}
+
+func getAddServiceReturnValueRuntimeValues(serviceRegistration *service.ServiceRegistration) map[string]starlark.Comparable {
+	return map[string]starlark.Comparable{
+		ipAddressRuntimeValue: starlark.String(serviceRegistration.GetPrivateIP().String()),
+		hostnameRuntimeValue:  starlark.String(serviceRegistration.GetHostname()),
+	}
+}
+
+// planServiceApply compares the service declared by the instruction with the one running in the enclave and returns
+// the change adding it would bring. When the running service is left unchanged, the return value of the instruction is
+// filled with its runtime values right away, as the instruction will not be executed. These values are transient as
+// they are set before the execution, which might not happen at all for a dry run
+func planServiceApply(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig,
+	resultUuid string,
+	forceUpdate bool,
+) *kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange {
+	isServiceUpToDate := false
+	replacedServiceName, replacedServiceConfig, err := replaceMagicStrings(runtimeValueStore, serviceName, serviceConfig)
+	if err != nil {
+		// the service depends on runtime values that are only known once the previous instructions are executed, so it
+		// can't be compared with the running one
+		logrus.Debugf("Service '%s' can't be compared with the one running in the enclave as it depends on runtime values that are not known yet. Error was:\n%v", serviceName, err)
+		replacedServiceName = serviceName
+	} else if runningServiceConfig, found := serviceNetwork.GetServiceConfig(replacedServiceName); found {
+		isServiceUpToDate = proto.Equal(runningServiceConfig, replacedServiceConfig)
+	}
+	runningServiceRegistration, doesServiceExist := serviceNetwork.GetServiceRegistration(replacedServiceName)
+	action := shared_helpers.GetApplyPlanAction(doesServiceExist, isServiceUpToDate, forceUpdate)
+	if action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED {
+		runtimeValueStore.SetTransientValue(resultUuid, getAddServiceReturnValueRuntimeValues(runningServiceRegistration))
+	}
+	return binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(replacedServiceName))
+}
+
+// restoreServiceRuntimeValues sets the runtime values of a service a previous run already added from the service
+// running in the enclave, as planServiceApply does for the services the apply plan keeps. It returns false if the
+// service isn't running
+func restoreServiceRuntimeValues(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	resultUuid string,
+) bool {
+	replacedServiceName, err := magic_string_helper.ReplaceRuntimeValueInString(string(serviceName), runtimeValueStore)
+	if err != nil {
+		logrus.Debugf("The runtime values of service '%s' can't be restored as its name depends on runtime values that are not known yet. Error was:\n%v", serviceName, err)
+		return false
+	}
+	runningServiceRegistration, found := serviceNetwork.GetServiceRegistration(service.ServiceName(replacedServiceName))
+	if !found {
+		return false
+	}
+	runtimeValueStore.SetTransientValue(resultUuid, getAddServiceReturnValueRuntimeValues(runningServiceRegistration))
+	return true
+}
+
+// isServiceRecreatedByApplyPlan returns true if the package is applied to the enclave and the apply plan updates the
+// running service, which is then re-created in place so that it keeps its UUID and IP address
+func isServiceRecreatedByApplyPlan(options *kurtosis_instruction.ExecutionOptions, serviceName service.ServiceName) bool {
+	action, found := options.GetApplyPlanAction(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(serviceName))
+	return found && action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+}
+
+// isServiceKeptByApplyPlan returns true if the package is applied to the enclave and the running service is up-to-date
+func isServiceKeptByApplyPlan(options *kurtosis_instruction.ExecutionOptions, serviceName service.ServiceName) bool {
+	action, found := options.GetApplyPlanAction(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(serviceName))
+	return found && action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED
 }
 
 func makeAddServiceInterpretationReturnValue(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig, resultUuid string) (*kurtosis_types.Service, *startosis_errors.InterpretationError) {
@@ -54,11 +273,11 @@ func makeAddServiceInterpretationReturnValue(serviceConfig *kurtosis_core_rpc_ap
 
 func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEnvironment, serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) *startosis_errors.ValidationError {
 	if partition_topology.ParsePartitionId(serviceConfig.Subnetwork) != partition_topology.DefaultPartitionId {
-		if !validatorEnvironment.IsNetworkPartitioningEnabled() {
+		if !validatorEnvironment.AreSubnetworksEnforced() {
 			return startosis_errors.NewValidationError("Service was about to be started inside subnetwork '%s' but the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark code with subnetwork enabled.", *serviceConfig.Subnetwork)
 		}
 	}
-	if validatorEnvironment.DoesServiceNameExist(serviceName) {
+	if validatorEnvironment.DoesServiceNameExist(serviceName) && !validatorEnvironment.RedeclareServiceName(serviceName) {
 		return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' already exists", AddServiceBuiltinName, serviceName)
 	}
 	for _, artifactName := range serviceConfig.FilesArtifactMountpoints {
@@ -71,6 +290,33 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 	return nil
 }
 
+func addServiceDependencies(dependencies *kurtosis_instruction.InstructionDependencies, serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig, readyCondition *serviceReadyCondition, resultUuid string) {
+	dependencies.WriteService(serviceName)
+	dependencies.WriteRuntimeValue(resultUuid)
+	dependencies.ReadRuntimeValuesInString(string(serviceName))
+	for _, hostname := range serviceConfig.GetHostnames() {
+		dependencies.WriteServiceHostname(hostname)
+	}
+	for _, artifactName := range serviceConfig.FilesArtifactMountpoints {
+		dependencies.ReadFilesArtifact(artifactName)
+	}
+	for _, entryPointArg := range serviceConfig.EntrypointArgs {
+		dependencies.ReadReferencesInString(entryPointArg)
+	}
+	for _, cmdArg := range serviceConfig.CmdArgs {
+		dependencies.ReadReferencesInString(cmdArg)
+	}
+	for _, envVarValue := range serviceConfig.EnvVars {
+		dependencies.ReadReferencesInString(envVarValue)
+	}
+	if readyCondition != nil {
+		dependencies.ReadReferencesInString(readyCondition.recipe.String())
+		if targetStr, ok := readyCondition.target.(starlark.String); ok {
+			dependencies.ReadReferencesInString(targetStr.GoString())
+		}
+	}
+}
+
 func replaceMagicStrings(
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	serviceName service.ServiceName,
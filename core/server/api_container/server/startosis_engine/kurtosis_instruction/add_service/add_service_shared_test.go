@@ -20,9 +20,9 @@ func TestAddServiceShared_EntryPointArgsRuntimeValueAreReplaced(t *testing.T) {
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
 	runtimeValueName := "value"
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
 		runtimeValueName: starlark.MakeInt(8765),
-	})
+	}))
 	runtimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, stringValueUuid, runtimeValueName)
 
 	serviceName := service.ServiceName("example-datastore-server-2")
@@ -43,9 +43,9 @@ func TestAddServiceShared_CmdArgsRuntimeValueAreReplaced(t *testing.T) {
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
 	runtimeValueName := "value"
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
 		runtimeValueName: starlark.MakeInt(999999),
-	})
+	}))
 	runtimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, stringValueUuid, runtimeValueName)
 
 	serviceName := service.ServiceName("example-datastore-server-2")
@@ -66,9 +66,9 @@ func TestAddServiceShared_EnvVarsWithRuntimeValueAreReplaced(t *testing.T) {
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
 	runtimeValueName := "value"
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
 		runtimeValueName: starlark.MakeInt(8765),
-	})
+	}))
 	runtimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, stringValueUuid, runtimeValueName)
 
 	serviceName := service.ServiceName("example-datastore-server-2")
@@ -92,9 +92,9 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
 	valueName := "value"
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
 		"value": starlark.String("database-1"),
-	})
+	}))
 	stringRuntimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, stringValueUuid, valueName)
 
 	serviceName := service.ServiceName(stringRuntimeValue)
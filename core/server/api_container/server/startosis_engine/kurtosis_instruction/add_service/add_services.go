@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -16,14 +17,15 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 )
 
 const (
 	AddServicesBuiltinName = "add_services"
 
-	ConfigsArgName   = "configs"
-	ParallelismParam = "PARALLELISM"
+	ConfigsArgName = "configs"
 )
 
 func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
@@ -39,7 +41,7 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
 						// we just try to convert the configs here to validate their shape, to avoid code duplication
 						// with Interpret
-						if _, err := validateAndConvertConfigs(value); err != nil {
+						if _, _, err := validateAndConvertConfigs(value); err != nil {
 							return err
 						}
 						return nil
@@ -53,7 +55,8 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 				serviceNetwork:    serviceNetwork,
 				runtimeValueStore: runtimeValueStore,
 
-				serviceConfigs: nil, // populated at interpretation time
+				serviceConfigs:  nil, // populated at interpretation time
+				readyConditions: nil, // populated at interpretation time
 
 				resultUuids: map[service.ServiceName]string{}, // populated at interpretation time
 			}
@@ -72,6 +75,8 @@ type AddServicesCapabilities struct {
 	runtimeValueStore *runtime_value_store.RuntimeValueStore
 
 	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+	// only contains the services that have a ready condition
+	readyConditions map[service.ServiceName]*serviceReadyCondition
 
 	resultUuids map[service.ServiceName]string
 }
@@ -81,11 +86,12 @@ func (builtin *AddServicesCapabilities) Interpret(arguments *builtin_argument.Ar
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
 	}
-	serviceConfigs, interpretationErr := validateAndConvertConfigs(ServiceConfigsDict)
+	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigs(ServiceConfigsDict)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	builtin.serviceConfigs = serviceConfigs
+	builtin.readyConditions = readyConditions
 
 	resultUuids, returnValue, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore)
 	if interpretationErr != nil {
@@ -104,21 +110,34 @@ func (builtin *AddServicesCapabilities) Validate(_ *builtin_argument.ArgumentVal
 	return nil
 }
 
-func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+func (builtin *AddServicesCapabilities) Execute(ctx context.Context, arguments *builtin_argument.ArgumentValuesSet) (string, error) {
+	return builtin.ExecuteWithOptions(ctx, arguments, kurtosis_instruction.NewDefaultExecutionOptions())
+}
+
+// ExecuteWithOptions starts the services, at most the parallelism of the options at a time. The executor reserves
+// this share of the parallelism of the run for the instruction, so that it doesn't add up with the instructions
+// running alongside. When the package is applied to the enclave, the running services the apply plan updates are
+// re-created in place, and the ones it keeps are left untouched. If the batch fails, the services it started are
+// removed and the ones it updated are rolled back to their previous config
+func (builtin *AddServicesCapabilities) ExecuteWithOptions(ctx context.Context, _ *builtin_argument.ArgumentValuesSet, options *kurtosis_instruction.ExecutionOptions) (string, error) {
 	renderedServiceConfigs := make(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, len(builtin.serviceConfigs))
-	parallelism, ok := ctx.Value(ParallelismParam).(int)
-	if !ok {
-		return "", stacktrace.NewError("An error occurred when getting parallelism level from execution context")
-	}
+	renderedServiceConfigsToRecreate := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
 	for serviceName, serviceConfig := range builtin.serviceConfigs {
 		renderedServiceName, renderedServiceConfig, err := replaceMagicStrings(builtin.runtimeValueStore, serviceName, serviceConfig)
 		if err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred replacing a magic string in '%s' instruction arguments for service: '%s'. Execution cannot proceed", AddServicesBuiltinName, serviceName)
 		}
+		if isServiceKeptByApplyPlan(options, renderedServiceName) {
+			continue
+		}
+		if isServiceRecreatedByApplyPlan(options, renderedServiceName) {
+			renderedServiceConfigsToRecreate[renderedServiceName] = renderedServiceConfig
+			continue
+		}
 		renderedServiceConfigs[renderedServiceName] = renderedServiceConfig
 	}
 
-	startedServices, failedServices, err := builtin.serviceNetwork.StartServices(ctx, renderedServiceConfigs, parallelism)
+	startedServices, failedServices, err := builtin.serviceNetwork.StartServices(ctx, renderedServiceConfigs, options.GetParallelism())
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Unexpected error occurred starting a batch of services")
 	}
@@ -131,47 +150,181 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 		return "", stacktrace.NewError("Some errors occurred starting the following services: '%v'. The entire batch was rolled back an no service was started. Errors were: \n%v", failedServiceNames, failedServices)
 	}
+	recreatedServices := map[service.ServiceName]*service.Service{}
+	previousServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	if len(renderedServiceConfigsToRecreate) > 0 {
+		previousServiceConfigs, err = getServiceConfigsBeforeUpdate(builtin.serviceNetwork, renderedServiceConfigsToRecreate)
+		if err == nil {
+			var failedServices map[service.ServiceName]error
+			recreatedServices, failedServices, err = builtin.serviceNetwork.RecreateServices(ctx, renderedServiceConfigsToRecreate)
+			if err == nil && len(failedServices) > 0 {
+				err = stacktrace.NewError("Some errors occurred re-creating the following services with their new config: '%v'. Errors were: \n%v", getSortedServiceNames(failedServices), failedServices)
+			}
+		}
+		if err != nil {
+			builtin.rollbackBatch(ctx, startedServices, recreatedServices, previousServiceConfigs)
+			return "", stacktrace.Propagate(err, "An error occurred re-creating the services updated by the apply plan. The services started by this instruction were removed, and the ones it updated were rolled back to their previous config")
+		}
+	}
+
+	// the services of the batch, both started and updated
+	batchServices := make(map[service.ServiceName]*service.Service, len(startedServices)+len(recreatedServices))
+	for serviceName, startedService := range startedServices {
+		batchServices[serviceName] = startedService
+	}
+	for serviceName, recreatedService := range recreatedServices {
+		batchServices[serviceName] = recreatedService
+	}
+
+	if err = builtin.waitForServicesReadiness(ctx, batchServices); err != nil {
+		builtin.rollbackBatch(ctx, startedServices, recreatedServices, previousServiceConfigs)
+		return "", stacktrace.Propagate(err, "Some services did not become ready. The entire batch was rolled back")
+	}
 
 	instructionResult := strings.Builder{}
-	instructionResult.WriteString(fmt.Sprintf("Successfully added the following '%d' services:", len(startedServices)))
-	for serviceName, serviceObj := range startedServices {
-		fillAddServiceReturnValueWithRuntimeValues(serviceObj, builtin.resultUuids[serviceName], builtin.runtimeValueStore)
+	instructionResult.WriteString(fmt.Sprintf("Successfully added the following '%d' services:", len(batchServices)))
+	for serviceName, serviceObj := range batchServices {
+		if err = fillAddServiceReturnValueWithRuntimeValues(serviceObj.GetRegistration(), builtin.resultUuids[serviceName], builtin.runtimeValueStore); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred filling the return value of service '%s'", serviceName)
+		}
 		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID()))
 
 	}
 	return instructionResult.String(), nil
 }
 
-func validateAndConvertConfigs(configs starlark.Value) (map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, *startosis_errors.InterpretationError) {
+func (builtin *AddServicesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	for serviceName, serviceConfig := range builtin.serviceConfigs {
+		addServiceDependencies(dependencies, serviceName, serviceConfig, builtin.readyConditions[serviceName], builtin.resultUuids[serviceName])
+	}
+	dependencies.SetNumberOfConcurrentOperations(len(builtin.serviceConfigs))
+	return dependencies
+}
+
+func (builtin *AddServicesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	var changes []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+	for serviceName, serviceConfig := range builtin.serviceConfigs {
+		change := planServiceApply(builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, serviceConfig, builtin.resultUuids[serviceName], forceUpdate)
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].GetResourceName() < changes[j].GetResourceName()
+	})
+	return changes, nil
+}
+
+func (builtin *AddServicesCapabilities) RestoreRuntimeValues(_ *builtin_argument.ArgumentValuesSet) bool {
+	for serviceName := range builtin.serviceConfigs {
+		if !restoreServiceRuntimeValues(builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, builtin.resultUuids[serviceName]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rollbackBatch removes the services the batch started, and re-creates the services it updated with their previous
+// config, so that the services that were running before the batch are left as they were
+func (builtin *AddServicesCapabilities) rollbackBatch(
+	ctx context.Context,
+	startedServices map[service.ServiceName]*service.Service,
+	recreatedServices map[service.ServiceName]*service.Service,
+	previousServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
+) {
+	for serviceName := range startedServices {
+		removeServiceNotReady(ctx, builtin.serviceNetwork, serviceName)
+	}
+	serviceConfigsToRollBackTo := make(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, len(recreatedServices))
+	for serviceName := range recreatedServices {
+		serviceConfigsToRollBackTo[serviceName] = previousServiceConfigs[serviceName]
+	}
+	rollbackServicesUpdatedByApplyPlan(builtin.serviceNetwork, serviceConfigsToRollBackTo)
+}
+
+// waitForServicesReadiness waits in parallel for all the started services that have a ready condition to satisfy it
+func (builtin *AddServicesCapabilities) waitForServicesReadiness(ctx context.Context, startedServices map[service.ServiceName]*service.Service) error {
+	readinessErrors := map[service.ServiceName]error{}
+	readinessErrorsMutex := sync.Mutex{}
+	waitGroup := sync.WaitGroup{}
+	for serviceName := range startedServices {
+		readyCondition, found := builtin.readyConditions[serviceName]
+		if !found {
+			continue
+		}
+		waitGroup.Add(1)
+		go func(serviceName service.ServiceName, readyCondition *serviceReadyCondition) {
+			defer waitGroup.Done()
+			if err := waitForServiceReadiness(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, readyCondition); err != nil {
+				readinessErrorsMutex.Lock()
+				defer readinessErrorsMutex.Unlock()
+				readinessErrors[serviceName] = err
+			}
+		}(serviceName, readyCondition)
+	}
+	waitGroup.Wait()
+
+	if len(readinessErrors) == 0 {
+		return nil
+	}
+	notReadyServiceNames := make([]string, 0, len(readinessErrors))
+	for serviceName := range readinessErrors {
+		notReadyServiceNames = append(notReadyServiceNames, string(serviceName))
+	}
+	sort.Strings(notReadyServiceNames)
+	errorMessages := make([]string, 0, len(readinessErrors))
+	for _, serviceName := range notReadyServiceNames {
+		errorMessages = append(errorMessages, readinessErrors[service.ServiceName(serviceName)].Error())
+	}
+	return stacktrace.NewError("The following services did not become ready: '%v'. Errors were:\n%v", strings.Join(notReadyServiceNames, "', '"), strings.Join(errorMessages, "\n"))
+}
+
+func getSortedServiceNames(failedServices map[service.ServiceName]error) []string {
+	serviceNames := make([]string, 0, len(failedServices))
+	for serviceName := range failedServices {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+	return serviceNames
+}
+
+func validateAndConvertConfigs(configs starlark.Value) (map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, map[service.ServiceName]*serviceReadyCondition, *startosis_errors.InterpretationError) {
 	configsDict, ok := configs.(*starlark.Dict)
 	if !ok {
-		return nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
+		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
 	}
 	if configsDict.Len() == 0 {
-		return nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
+		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	readyConditions := map[service.ServiceName]*serviceReadyCondition{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
-			return nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
+			return nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
 		}
 
 		dictValue, found, err := configsDict.Get(serviceName)
 		if err != nil || !found {
-			return nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
+			return nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
 		}
 		serviceConfig, isDictValueAServiceConfig := dictValue.(*service_config.ServiceConfig)
 		if !isDictValueAServiceConfig {
-			return nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
 		}
 		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType()
 		if interpretationErr != nil {
-			return nil, interpretationErr
+			return nil, nil, interpretationErr
+		}
+		readyCondition, interpretationErr := extractReadyCondition(serviceConfig)
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
 		}
 		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
+		if readyCondition != nil {
+			readyConditions[service.ServiceName(serviceNameStr.GoString())] = readyCondition
+		}
 	}
-	return convertedServiceConfigs, nil
+	return convertedServiceConfigs, readyConditions, nil
 }
 
 func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
@@ -3,6 +3,9 @@ package assert
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -142,6 +145,19 @@ func (builtin *AssertCapabilities) Execute(_ context.Context, _ *builtin_argumen
 	return instructionResult, nil
 }
 
+func (builtin *AssertCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.ReadReferencesInString(builtin.runtimeValue)
+	if targetStr, ok := builtin.target.(starlark.String); ok {
+		dependencies.ReadReferencesInString(targetStr.GoString())
+	}
+	return dependencies
+}
+
+func (builtin *AssertCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return shared_helpers.NewAlwaysExecutedApplyPlan(AssertBuiltinName), nil
+}
+
 // Assert verifies whether the currentValue matches the targetValue w.r.t. the assertion operator
 // TODO: This and ValidateAssertionToken below are used by both assert and wait. Refactor it to a better place
 func Assert(currentValue starlark.Comparable, assertion string, targetValue starlark.Comparable) error {
@@ -0,0 +1,92 @@
+package cancel_connection_changes
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	CancelConnectionChangesBuiltinName = "cancel_connection_changes"
+
+	NameArgName = "name"
+)
+
+func NewCancelConnectionChanges(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: CancelConnectionChangesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              NameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, NameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &CancelConnectionChangesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				name: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			NameArgName: true,
+		},
+	}
+}
+
+type CancelConnectionChangesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	name string
+}
+
+func (builtin *CancelConnectionChangesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	name, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, NameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", NameArgName)
+	}
+	builtin.name = name.GoString()
+	return starlark.None, nil
+}
+
+func (builtin *CancelConnectionChangesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.AreSubnetworksEnforced() {
+		return startosis_errors.NewValidationError("Cancelling connection changes cannot be performed because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled.")
+	}
+	return nil
+}
+
+func (builtin *CancelConnectionChangesCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.CancelConnectionChanges(builtin.name); err != nil {
+		return "", stacktrace.Propagate(err, "Failed cancelling connection changes '%s'", builtin.name)
+	}
+	return fmt.Sprintf("Cancelled connection changes '%s'", builtin.name), nil
+}
+
+func (builtin *CancelConnectionChangesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	// the scheduled changes update the traffic rules of every service in the enclave
+	return kurtosis_instruction.NewBarrierInstructionDependencies()
+}
+
+func (builtin *CancelConnectionChangesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return shared_helpers.NewAlwaysExecutedApplyPlan(CancelConnectionChangesBuiltinName), nil
+}
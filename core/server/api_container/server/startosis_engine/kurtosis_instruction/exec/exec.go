@@ -2,8 +2,10 @@ package exec
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -118,7 +120,22 @@ func (builtin *ExecCapabilities) Execute(ctx context.Context, _ *builtin_argumen
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Error executing exec recipe")
 	}
-	builtin.runtimeValueStore.SetValue(builtin.resultUuid, result)
+	if err = builtin.runtimeValueStore.SetValue(builtin.resultUuid, result); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the result of the exec")
+	}
 	instructionResult := builtin.execRecipe.ResultMapToString(result)
 	return instructionResult, err
 }
+
+func (builtin *ExecCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	// the command might modify the service, so it is considered written
+	dependencies.WriteService(builtin.serviceName)
+	dependencies.ReadReferencesInString(builtin.execRecipe.String())
+	dependencies.WriteRuntimeValue(builtin.resultUuid)
+	return dependencies
+}
+
+func (builtin *ExecCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return shared_helpers.NewAlwaysExecutedApplyPlan(ExecBuiltinName), nil
+}
@@ -0,0 +1,49 @@
+package kurtosis_instruction
+
+import "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+
+const (
+	// instructions run one operation at a time unless the executor provides them more parallelism
+	defaultParallelism = 1
+)
+
+// ExecutionOptions are the options of the run an instruction gets executed in, which the executor provides to the
+// instructions implementing ConfigurableKurtosisInstruction
+type ExecutionOptions struct {
+	// The number of operations the instruction can run at the same time, its share of the parallelism of the run
+	parallelism int
+
+	// The changes the apply plan of the run expects the instruction to bring to the enclave. Nil if the package isn't
+	// applied to the enclave
+	applyPlanChanges []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+}
+
+func NewExecutionOptions(parallelism int, applyPlanChanges []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange) *ExecutionOptions {
+	return &ExecutionOptions{
+		parallelism:      parallelism,
+		applyPlanChanges: applyPlanChanges,
+	}
+}
+
+// NewDefaultExecutionOptions returns the options of an instruction executed on its own, outside of any apply
+func NewDefaultExecutionOptions() *ExecutionOptions {
+	return NewExecutionOptions(defaultParallelism, nil)
+}
+
+func (options *ExecutionOptions) GetParallelism() int {
+	return options.parallelism
+}
+
+// GetApplyPlanAction returns the action the apply plan expects on the resource. It returns false if the package isn't
+// applied, or if the apply plan doesn't mention the resource
+func (options *ExecutionOptions) GetApplyPlanAction(
+	resourceType kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ResourceType,
+	resourceName string,
+) (kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_Action, bool) {
+	for _, change := range options.applyPlanChanges {
+		if change.GetResourceType() == resourceType && change.GetResourceName() == resourceName {
+			return change.GetAction(), true
+		}
+	}
+	return kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ADD, false
+}
@@ -0,0 +1,201 @@
+package kurtosis_instruction
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	serviceResourceFormat       = "service:%s"
+	hostnameResourceFormat      = "hostname:%s"
+	filesArtifactResourceFormat = "files_artifact:%s"
+	runtimeValueResourceFormat  = "runtime_value:%s"
+)
+
+// The characters service names and hostnames are made of. Anything else separates the words of a string that can be
+// a service name or a hostname
+var hostnameWordSeparatorRegex = regexp.MustCompile(`[^a-zA-Z0-9-]+`)
+
+// InstructionDependencies describes the enclave resources (services, files artifacts and runtime values) an
+// instruction reads and writes when it is executed. The executor uses it to know which instructions are independent
+// from each other and can therefore be executed concurrently.
+//
+// An instruction flagged as a barrier (for example an instruction changing the network topology) is considered to
+// depend on all the instructions before it, and all the instructions after it depend on it.
+//
+// The services an instruction reaches by name or hostname, e.g. through the command of an exec, aren't known for sure,
+// so every word of its strings that is the name or a hostname of a service written by another instruction is
+// considered a reference to this service. A word that happens to be a service name only costs some concurrency.
+type InstructionDependencies struct {
+	readResources    map[string]bool
+	writtenResources map[string]bool
+	isBarrier        bool
+
+	// The words of the strings of the instruction that can reference a service by its name or a hostname
+	referencedHostnames map[string]bool
+
+	// The number of operations the instruction can run concurrently, e.g. the number of services add_services starts
+	numberOfConcurrentOperations int
+}
+
+func NewInstructionDependencies() *InstructionDependencies {
+	return &InstructionDependencies{
+		readResources:                map[string]bool{},
+		writtenResources:             map[string]bool{},
+		isBarrier:                    false,
+		referencedHostnames:          map[string]bool{},
+		numberOfConcurrentOperations: 1,
+	}
+}
+
+// NewBarrierInstructionDependencies returns the dependencies of an instruction that needs to run in isolation
+func NewBarrierInstructionDependencies() *InstructionDependencies {
+	dependencies := NewInstructionDependencies()
+	dependencies.isBarrier = true
+	return dependencies
+}
+
+func (dependencies *InstructionDependencies) ReadService(serviceName service.ServiceName) {
+	dependencies.readResources[fmt.Sprintf(serviceResourceFormat, serviceName)] = true
+}
+
+// WriteService registers the service as written by the instruction. The service name being a hostname of the service,
+// the instructions referencing it in their strings depend on this instruction
+func (dependencies *InstructionDependencies) WriteService(serviceName service.ServiceName) {
+	dependencies.writtenResources[fmt.Sprintf(serviceResourceFormat, serviceName)] = true
+	dependencies.WriteServiceHostname(string(serviceName))
+}
+
+// WriteServiceHostname registers an additional hostname of a service written by the instruction
+func (dependencies *InstructionDependencies) WriteServiceHostname(hostname string) {
+	dependencies.writtenResources[fmt.Sprintf(hostnameResourceFormat, hostname)] = true
+}
+
+func (dependencies *InstructionDependencies) ReadFilesArtifact(artifactName string) {
+	dependencies.readResources[fmt.Sprintf(filesArtifactResourceFormat, artifactName)] = true
+}
+
+func (dependencies *InstructionDependencies) WriteFilesArtifact(artifactName string) {
+	dependencies.writtenResources[fmt.Sprintf(filesArtifactResourceFormat, artifactName)] = true
+}
+
+func (dependencies *InstructionDependencies) WriteRuntimeValue(runtimeValueUuid string) {
+	dependencies.writtenResources[fmt.Sprintf(runtimeValueResourceFormat, runtimeValueUuid)] = true
+}
+
+// ReadRuntimeValuesInString registers all the runtime values referenced through magic strings in the provided string
+// as read by the instruction
+func (dependencies *InstructionDependencies) ReadRuntimeValuesInString(stringWithRuntimeValues string) {
+	for _, runtimeValueUuid := range magic_string_helper.GetRuntimeValueUuidsReferencedInString(stringWithRuntimeValues) {
+		dependencies.readResources[fmt.Sprintf(runtimeValueResourceFormat, runtimeValueUuid)] = true
+	}
+}
+
+// ReadReferencesInString registers the runtime values referenced through magic strings in the provided string as read
+// by the instruction, as well as the services referenced by their name or a hostname
+func (dependencies *InstructionDependencies) ReadReferencesInString(stringWithReferences string) {
+	dependencies.ReadRuntimeValuesInString(stringWithReferences)
+	for _, word := range hostnameWordSeparatorRegex.Split(stringWithReferences, -1) {
+		if word != "" {
+			dependencies.referencedHostnames[word] = true
+		}
+	}
+}
+
+// SetNumberOfConcurrentOperations sets the number of operations the instruction can run concurrently, so that the
+// executor accounts for them in the parallelism of the run
+func (dependencies *InstructionDependencies) SetNumberOfConcurrentOperations(numberOfConcurrentOperations int) {
+	dependencies.numberOfConcurrentOperations = numberOfConcurrentOperations
+}
+
+func (dependencies *InstructionDependencies) GetNumberOfConcurrentOperations() int {
+	return dependencies.numberOfConcurrentOperations
+}
+
+// GetWrittenRuntimeValues returns the UUIDs of the runtime values created by the instruction, sorted
+func (dependencies *InstructionDependencies) GetWrittenRuntimeValues() []string {
+	runtimeValuePrefix := fmt.Sprintf(runtimeValueResourceFormat, "")
+	var runtimeValueUuids []string
+	for resource := range dependencies.writtenResources {
+		if strings.HasPrefix(resource, runtimeValuePrefix) {
+			runtimeValueUuids = append(runtimeValueUuids, strings.TrimPrefix(resource, runtimeValuePrefix))
+		}
+	}
+	sort.Strings(runtimeValueUuids)
+	return runtimeValueUuids
+}
+
+// ReadsRuntimeValuesWrittenBy returns true if the instruction holding those dependencies reads at least one runtime
+// value created by the instruction holding previousInstructionDependencies
+func (dependencies *InstructionDependencies) ReadsRuntimeValuesWrittenBy(previousInstructionDependencies *InstructionDependencies) bool {
+	for _, runtimeValueUuid := range previousInstructionDependencies.GetWrittenRuntimeValues() {
+		if dependencies.readResources[fmt.Sprintf(runtimeValueResourceFormat, runtimeValueUuid)] {
+			return true
+		}
+	}
+	return false
+}
+
+func (dependencies *InstructionDependencies) IsBarrier() bool {
+	return dependencies.isBarrier
+}
+
+// DependsOn returns true if the instruction holding those dependencies needs to wait for the instruction holding
+// previousInstructionDependencies to complete before it can be executed. previousInstructionDependencies must belong
+// to an instruction located before this one in the plan.
+//
+// An instruction depends on a previous one if it reads a resource the previous one writes (read after write), if it
+// writes a resource the previous one reads (write after read) or if both write the same resource (write after write).
+// Referencing a service by its name or a hostname counts as reading it.
+func (dependencies *InstructionDependencies) DependsOn(previousInstructionDependencies *InstructionDependencies) bool {
+	if dependencies.isBarrier || previousInstructionDependencies.isBarrier {
+		return true
+	}
+	if dependencies.referencesHostnamesWrittenBy(previousInstructionDependencies) || previousInstructionDependencies.referencesHostnamesWrittenBy(dependencies) {
+		return true
+	}
+	for resource := range previousInstructionDependencies.writtenResources {
+		if dependencies.readResources[resource] || dependencies.writtenResources[resource] {
+			return true
+		}
+	}
+	for resource := range previousInstructionDependencies.readResources {
+		if dependencies.writtenResources[resource] {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAffectedByChangesOf returns true if the resources the instruction holding those dependencies manages need to be
+// re-created when the instruction holding previousInstructionDependencies changed the enclave. previousInstructionDependencies
+// must belong to an instruction located before this one in the plan.
+//
+// An instruction is affected if it reads or writes a resource the previous one writes. Changes to the network topology
+// being tracked with barriers, two barrier instructions are also considered to affect each other.
+func (dependencies *InstructionDependencies) IsAffectedByChangesOf(previousInstructionDependencies *InstructionDependencies) bool {
+	if dependencies.isBarrier && previousInstructionDependencies.isBarrier {
+		return true
+	}
+	for resource := range previousInstructionDependencies.writtenResources {
+		if dependencies.readResources[resource] || dependencies.writtenResources[resource] {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesHostnamesWrittenBy returns true if the strings of the instruction holding those dependencies reference the
+// name or a hostname of a service written by the instruction holding otherInstructionDependencies
+func (dependencies *InstructionDependencies) referencesHostnamesWrittenBy(otherInstructionDependencies *InstructionDependencies) bool {
+	for referencedHostname := range dependencies.referencedHostnames {
+		if otherInstructionDependencies.writtenResources[fmt.Sprintf(hostnameResourceFormat, referencedHostname)] {
+			return true
+		}
+	}
+	return false
+}
@@ -31,4 +31,27 @@ type KurtosisInstruction interface {
 	// ValidateAndUpdateEnvironment validates if the instruction can be applied to an environment, and mutates that
 	// environment to reflect how Kurtosis would look like after this instruction is successfully executed.
 	ValidateAndUpdateEnvironment(environment *startosis_validator.ValidatorEnvironment) error
+
+	// GetDependencies returns the enclave resources this instruction reads and writes when executed. It is used to
+	// run independent instructions concurrently
+	GetDependencies() *InstructionDependencies
+
+	// PlanApply compares what the instruction declares with the current state of the enclave and returns the changes
+	// executing it would bring. If forceUpdate is true, the resources managed by the instruction are re-created even if
+	// they are identical, because a resource they depend on changed. The instruction keeps track of the outcome such
+	// that validating and executing it only applies those changes
+	PlanApply(forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error)
+
+	// RestoreRuntimeValues sets the runtime values the instruction creates from the resources a previous run already
+	// applied to the enclave, such that the instruction can be skipped when resuming this run. It returns false if the
+	// instruction can't restore them, in which case it needs to be executed again for its runtime values to exist
+	RestoreRuntimeValues() bool
+}
+
+// ConfigurableKurtosisInstruction is implemented by the instructions which execution depends on the options of the run
+// they're executed in, for example because they run several operations concurrently (see
+// InstructionDependencies.GetNumberOfConcurrentOperations) or because they manage several resources of the enclave
+// when a package is applied to it
+type ConfigurableKurtosisInstruction interface {
+	ExecuteWithOptions(ctx context.Context, options *ExecutionOptions) (*string, error)
 }
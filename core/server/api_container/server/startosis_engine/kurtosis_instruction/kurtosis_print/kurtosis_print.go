@@ -2,7 +2,10 @@ package kurtosis_print
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -86,3 +89,13 @@ func (builtin *PrintCapabilities) Execute(_ context.Context, _ *builtin_argument
 	}
 	return maybeSerializedArgsWithRuntimeValue, nil
 }
+
+func (builtin *PrintCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.ReadReferencesInString(builtin.msg.String())
+	return dependencies
+}
+
+func (builtin *PrintCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return shared_helpers.NewAlwaysExecutedApplyPlan(PrintBuiltinName), nil
+}
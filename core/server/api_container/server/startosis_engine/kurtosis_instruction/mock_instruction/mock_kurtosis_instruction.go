@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	kurtosis_instruction "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	kurtosis_starlark_framework "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 
 	mock "github.com/stretchr/testify/mock"
@@ -123,6 +124,49 @@ func (_c *MockKurtosisInstruction_GetCanonicalInstruction_Call) RunAndReturn(run
 	return _c
 }
 
+// GetDependencies provides a mock function with given fields:
+func (_m *MockKurtosisInstruction) GetDependencies() *kurtosis_instruction.InstructionDependencies {
+	ret := _m.Called()
+
+	var r0 *kurtosis_instruction.InstructionDependencies
+	if rf, ok := ret.Get(0).(func() *kurtosis_instruction.InstructionDependencies); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kurtosis_instruction.InstructionDependencies)
+		}
+	}
+
+	return r0
+}
+
+// MockKurtosisInstruction_GetDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencies'
+type MockKurtosisInstruction_GetDependencies_Call struct {
+	*mock.Call
+}
+
+// GetDependencies is a helper method to define mock.On call
+func (_e *MockKurtosisInstruction_Expecter) GetDependencies() *MockKurtosisInstruction_GetDependencies_Call {
+	return &MockKurtosisInstruction_GetDependencies_Call{Call: _e.mock.On("GetDependencies")}
+}
+
+func (_c *MockKurtosisInstruction_GetDependencies_Call) Run(run func()) *MockKurtosisInstruction_GetDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_GetDependencies_Call) Return(_a0 *kurtosis_instruction.InstructionDependencies) *MockKurtosisInstruction_GetDependencies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_GetDependencies_Call) RunAndReturn(run func() *kurtosis_instruction.InstructionDependencies) *MockKurtosisInstruction_GetDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPositionInOriginalScript provides a mock function with given fields:
 func (_m *MockKurtosisInstruction) GetPositionInOriginalScript() *kurtosis_starlark_framework.KurtosisBuiltinPosition {
 	ret := _m.Called()
@@ -166,6 +210,101 @@ func (_c *MockKurtosisInstruction_GetPositionInOriginalScript_Call) RunAndReturn
 	return _c
 }
 
+// PlanApply provides a mock function with given fields: forceUpdate
+func (_m *MockKurtosisInstruction) PlanApply(forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	ret := _m.Called(forceUpdate)
+
+	var r0 []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error)); ok {
+		return rf(forceUpdate)
+	}
+	if rf, ok := ret.Get(0).(func(bool) []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange); ok {
+		r0 = rf(forceUpdate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(forceUpdate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisInstruction_PlanApply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanApply'
+type MockKurtosisInstruction_PlanApply_Call struct {
+	*mock.Call
+}
+
+// PlanApply is a helper method to define mock.On call
+//   - forceUpdate bool
+func (_e *MockKurtosisInstruction_Expecter) PlanApply(forceUpdate interface{}) *MockKurtosisInstruction_PlanApply_Call {
+	return &MockKurtosisInstruction_PlanApply_Call{Call: _e.mock.On("PlanApply", forceUpdate)}
+}
+
+func (_c *MockKurtosisInstruction_PlanApply_Call) Run(run func(forceUpdate bool)) *MockKurtosisInstruction_PlanApply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_PlanApply_Call) Return(_a0 []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, _a1 error) *MockKurtosisInstruction_PlanApply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_PlanApply_Call) RunAndReturn(run func(bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error)) *MockKurtosisInstruction_PlanApply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRuntimeValues provides a mock function with given fields:
+func (_m *MockKurtosisInstruction) RestoreRuntimeValues() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockKurtosisInstruction_RestoreRuntimeValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRuntimeValues'
+type MockKurtosisInstruction_RestoreRuntimeValues_Call struct {
+	*mock.Call
+}
+
+// RestoreRuntimeValues is a helper method to define mock.On call
+func (_e *MockKurtosisInstruction_Expecter) RestoreRuntimeValues() *MockKurtosisInstruction_RestoreRuntimeValues_Call {
+	return &MockKurtosisInstruction_RestoreRuntimeValues_Call{Call: _e.mock.On("RestoreRuntimeValues")}
+}
+
+func (_c *MockKurtosisInstruction_RestoreRuntimeValues_Call) Run(run func()) *MockKurtosisInstruction_RestoreRuntimeValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_RestoreRuntimeValues_Call) Return(_a0 bool) *MockKurtosisInstruction_RestoreRuntimeValues_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_RestoreRuntimeValues_Call) RunAndReturn(run func() bool) *MockKurtosisInstruction_RestoreRuntimeValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // String provides a mock function with given fields:
 func (_m *MockKurtosisInstruction) String() string {
 	ret := _m.Called()
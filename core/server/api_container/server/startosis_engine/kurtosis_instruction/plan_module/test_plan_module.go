@@ -0,0 +1,216 @@
+package plan_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"sort"
+	"strconv"
+)
+
+const (
+	StubBuiltinName            = "stub"
+	GetInstructionsBuiltinName = "get_instructions"
+
+	stubInstructionArgName = "instruction"
+	stubResultArgName      = "result"
+	stubServiceNameArgName = "service_name"
+
+	// a stub without service name applies to the instructions of every service
+	anyServiceName = ""
+
+	serviceNameInstructionArgName = "service_name"
+
+	instructionNameAttr = "name"
+	instructionArgsAttr = "args"
+	instructionCodeAttr = "code"
+)
+
+// The instructions returning the result of a recipe, whose result can be stubbed
+var stubbableInstructionNames = map[string]bool{
+	exec.ExecBuiltinName:       true,
+	request.RequestBuiltinName: true,
+	wait.WaitBuiltinName:       true,
+}
+
+// TestPlanModule returns the plan that test functions get. On top of adding the instructions to the queue like the
+// plan of a run, it lets the test stub the result of the instructions running a recipe, and get the instructions
+// added so far to assert on them
+func TestPlanModule(
+	instructionsQueue *[]kurtosis_instruction.KurtosisInstruction,
+	kurtosisPlanInstructions []*kurtosis_plan_instruction.KurtosisPlanInstruction,
+) *starlarkstruct.Module {
+	plan := &testPlan{
+		instructionsQueue:                     instructionsQueue,
+		stubbedResultPerServicePerInstruction: map[string]map[string]*starlark.Dict{},
+	}
+
+	moduleBuiltins := starlark.StringDict{}
+	for _, planInstruction := range kurtosisPlanInstructions {
+		instructionName := planInstruction.GetName()
+		wrappedPlanInstruction := kurtosis_plan_instruction.NewKurtosisPlanInstructionWrapper(planInstruction, instructionsQueue).CreateBuiltin()
+		if stubbableInstructionNames[instructionName] {
+			wrappedPlanInstruction = plan.wrapWithStubbedResult(instructionName, wrappedPlanInstruction)
+		}
+		moduleBuiltins[instructionName] = starlark.NewBuiltin(instructionName, wrappedPlanInstruction)
+	}
+	moduleBuiltins[StubBuiltinName] = starlark.NewBuiltin(StubBuiltinName, plan.stub)
+	moduleBuiltins[GetInstructionsBuiltinName] = starlark.NewBuiltin(GetInstructionsBuiltinName, plan.getInstructions)
+
+	return &starlarkstruct.Module{
+		Name:    planModuleName,
+		Members: moduleBuiltins,
+	}
+}
+
+type testPlan struct {
+	instructionsQueue *[]kurtosis_instruction.KurtosisInstruction
+
+	stubbedResultPerServicePerInstruction map[string]map[string]*starlark.Dict
+}
+
+// stub makes the following calls of the instruction, for the given service or for every service, return the given
+// result rather than references to the values the instruction would return once executed
+func (plan *testPlan) stub(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var instructionName string
+	var result *starlark.Dict
+	serviceName := anyServiceName
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+		stubInstructionArgName, &instructionName,
+		stubResultArgName, &result,
+		stubServiceNameArgName+"?", &serviceName,
+	); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the arguments of '%s'", builtin.Name())
+	}
+	if !stubbableInstructionNames[instructionName] {
+		return nil, startosis_errors.NewInterpretationError("Instruction '%s' can't be stubbed; only the result of the instructions running a recipe can: %v", instructionName, getSortedStubbableInstructionNames())
+	}
+	if _, found := plan.stubbedResultPerServicePerInstruction[instructionName]; !found {
+		plan.stubbedResultPerServicePerInstruction[instructionName] = map[string]*starlark.Dict{}
+	}
+	plan.stubbedResultPerServicePerInstruction[instructionName][serviceName] = result
+	return starlark.None, nil
+}
+
+// getInstructions returns the instructions added to the plan so far, each as a struct with the name of the
+// instruction, its arguments serialized as Starlark code, and the Starlark code of the whole instruction
+func (plan *testPlan) getInstructions(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the arguments of '%s'", builtin.Name())
+	}
+	instructions := make([]starlark.Value, len(*plan.instructionsQueue))
+	for idx, instruction := range *plan.instructionsQueue {
+		canonicalInstruction := instruction.GetCanonicalInstruction()
+		instructionArgs := &starlark.Dict{}
+		for _, instructionArg := range getSetInstructionArgs(canonicalInstruction) {
+			if err := instructionArgs.SetKey(starlark.String(instructionArg.GetArgName()), starlark.String(instructionArg.GetSerializedArgValue())); err != nil {
+				return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred setting argument '%s' of instruction '%s'", instructionArg.GetArgName(), canonicalInstruction.GetInstructionName())
+			}
+		}
+		instructions[idx] = starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+			instructionNameAttr: starlark.String(canonicalInstruction.GetInstructionName()),
+			instructionArgsAttr: instructionArgs,
+			instructionCodeAttr: starlark.String(canonicalInstruction.GetExecutableInstruction()),
+		})
+	}
+	return starlark.NewList(instructions), nil
+}
+
+func (plan *testPlan) wrapWithStubbedResult(
+	instructionName string,
+	wrappedPlanInstruction func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error),
+) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		returnedFutureValue, err := wrappedPlanInstruction(thread, builtin, args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		// the instruction was just added to the queue
+		addedInstruction := (*plan.instructionsQueue)[len(*plan.instructionsQueue)-1]
+		stubbedResult, found := plan.getStubbedResult(instructionName, getServiceName(addedInstruction.GetCanonicalInstruction()))
+		if !found {
+			return returnedFutureValue, nil
+		}
+		futureResult, ok := returnedFutureValue.(*starlark.Dict)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Expected instruction '%s' to return a dictionary but it returned a '%s'", instructionName, returnedFutureValue.Type())
+		}
+		result, interpretationErr := mergeStubbedResult(instructionName, futureResult, stubbedResult)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		return result, nil
+	}
+}
+
+func (plan *testPlan) getStubbedResult(instructionName string, serviceName string) (*starlark.Dict, bool) {
+	stubbedResultPerService := plan.stubbedResultPerServicePerInstruction[instructionName]
+	if stubbedResult, found := stubbedResultPerService[serviceName]; found {
+		return stubbedResult, true
+	}
+	stubbedResult, found := stubbedResultPerService[anyServiceName]
+	return stubbedResult, found
+}
+
+// mergeStubbedResult returns the result of the instruction with the fields of the stubbed result replacing its
+// references to future values; the fields the stubbed result doesn't set keep their reference
+func mergeStubbedResult(instructionName string, futureResult *starlark.Dict, stubbedResult *starlark.Dict) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	result := &starlark.Dict{}
+	for _, item := range futureResult.Items() {
+		if err := result.SetKey(item[0], item[1]); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred copying field '%s' of the result of instruction '%s'", item[0], instructionName)
+		}
+	}
+	for _, item := range stubbedResult.Items() {
+		if _, found, _ := futureResult.Get(item[0]); !found {
+			return nil, startosis_errors.NewInterpretationError("The stubbed result of instruction '%s' has field %s but its result only has fields %s", instructionName, item[0], futureResult.Keys())
+		}
+		if err := result.SetKey(item[0], item[1]); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred setting field '%s' of the stubbed result of instruction '%s'", item[0], instructionName)
+		}
+	}
+	result.Freeze()
+	return result, nil
+}
+
+// getServiceName returns the service the instruction was called for, which is empty for instructions that took it from
+// the recipe
+func getServiceName(canonicalInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction) string {
+	for _, instructionArg := range getSetInstructionArgs(canonicalInstruction) {
+		if instructionArg.GetArgName() != serviceNameInstructionArgName {
+			continue
+		}
+		serviceName, err := strconv.Unquote(instructionArg.GetSerializedArgValue())
+		if err != nil {
+			return instructionArg.GetSerializedArgValue()
+		}
+		return serviceName
+	}
+	return anyServiceName
+}
+
+// getSetInstructionArgs returns the arguments the instruction was called with, skipping the optional ones it wasn't
+func getSetInstructionArgs(canonicalInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction) []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg {
+	setInstructionArgs := []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{}
+	for _, instructionArg := range canonicalInstruction.GetArguments() {
+		if instructionArg != nil {
+			setInstructionArgs = append(setInstructionArgs, instructionArg)
+		}
+	}
+	return setInstructionArgs
+}
+
+func getSortedStubbableInstructionNames() []string {
+	instructionNames := []string{}
+	for instructionName := range stubbableInstructionNames {
+		instructionNames = append(instructionNames, instructionName)
+	}
+	sort.Strings(instructionNames)
+	return instructionNames
+}
@@ -3,8 +3,12 @@ package remove_connection
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -19,7 +23,11 @@ import (
 const (
 	RemoveConnectionBuiltinName = "remove_connection"
 
-	SubnetworksArgName = "subnetworks"
+	SubnetworksArgName   = "subnetworks"
+	ServicesArgName      = "services"
+	BidirectionalArgName = "bidirectional"
+
+	defaultIsBidirectional = true
 )
 
 func NewRemoveConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
@@ -34,6 +42,18 @@ func NewRemoveConnection(serviceNetwork service_network.ServiceNetwork) *kurtosi
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
 					Validator:         validateSubnetworks,
 				},
+				{
+					Name:              ServicesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
+					Validator:         validateServices,
+				},
+				{
+					Name:              BidirectionalArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -41,13 +61,18 @@ func NewRemoveConnection(serviceNetwork service_network.ServiceNetwork) *kurtosi
 			return &RemoveConnectionCapabilities{
 				serviceNetwork: serviceNetwork,
 
-				subnetwork1: "", // populated at interpretation time
-				subnetwork2: "", // populated at interpretation time
+				subnetwork1:      "",  // populated at interpretation time
+				subnetwork2:      "",  // populated at interpretation time
+				optionalService1: nil, // populated at interpretation time
+				optionalService2: nil, // populated at interpretation time
+				isBidirectional:  defaultIsBidirectional,
 			}
 		},
 
 		DefaultDisplayArguments: map[string]bool{
-			SubnetworksArgName: true,
+			SubnetworksArgName:   true,
+			ServicesArgName:      true,
+			BidirectionalArgName: true,
 		},
 	}
 }
@@ -57,9 +82,45 @@ type RemoveConnectionCapabilities struct {
 
 	subnetwork1 service_network_types.PartitionID
 	subnetwork2 service_network_types.PartitionID
+
+	// set instead of the subnetworks when the connection to remove is the one set between two services
+	optionalService1 *service.ServiceName
+	optionalService2 *service.ServiceName
+
+	// when false, only the connection applying to the traffic going from subnetwork1 to subnetwork2 is removed
+	isBidirectional bool
 }
 
 func (builtin *RemoveConnectionCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	if arguments.IsSet(BidirectionalArgName) {
+		isBidirectional, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, BidirectionalArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BidirectionalArgName)
+		}
+		builtin.isBidirectional = bool(isBidirectional)
+	}
+
+	if arguments.IsSet(SubnetworksArgName) == arguments.IsSet(ServicesArgName) {
+		return nil, startosis_errors.NewInterpretationError("Exactly one of '%s' and '%s' arguments has to be set", SubnetworksArgName, ServicesArgName)
+	}
+
+	if arguments.IsSet(ServicesArgName) {
+		if !builtin.isBidirectional {
+			return nil, startosis_errors.NewInterpretationError("A connection between two services applies in both directions, '%s' cannot be set to False along with the '%s' argument", BidirectionalArgName, ServicesArgName)
+		}
+		services, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, ServicesArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServicesArgName)
+		}
+		service1, service2, interpretationErr := shared_helpers.ParseServices(ServicesArgName, services)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.optionalService1 = &service1
+		builtin.optionalService2 = &service2
+		return starlark.None, nil
+	}
+
 	subnetworks, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, SubnetworksArgName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SubnetworksArgName)
@@ -74,13 +135,34 @@ func (builtin *RemoveConnectionCapabilities) Interpret(arguments *builtin_argume
 }
 
 func (builtin *RemoveConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
-	if !validatorEnvironment.IsNetworkPartitioningEnabled() {
+	if !validatorEnvironment.AreSubnetworksEnforced() {
 		return startosis_errors.NewValidationError("Removing connection between two subnetworks cannot be performed because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled.")
 	}
+	if builtin.optionalService1 != nil {
+		for _, serviceName := range []service.ServiceName{*builtin.optionalService1, *builtin.optionalService2} {
+			if !validatorEnvironment.DoesServiceNameExist(serviceName) {
+				return startosis_errors.NewValidationError("There was an error validating '%s' as service name '%s' does not exist", RemoveConnectionBuiltinName, serviceName)
+			}
+		}
+	}
 	return nil
 }
 
 func (builtin *RemoveConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if builtin.optionalService1 != nil {
+		service1 := *builtin.optionalService1
+		service2 := *builtin.optionalService2
+		if err := builtin.serviceNetwork.UnsetServiceConnection(ctx, service1, service2); err != nil {
+			return "", stacktrace.Propagate(err, "Failed removing connection between service '%s' and service '%s'", service1, service2)
+		}
+		return fmt.Sprintf("Removed connection override between services '%s' and '%s'", service1, service2), nil
+	}
+	if !builtin.isBidirectional {
+		if err := builtin.serviceNetwork.UnsetDirectionalConnection(ctx, builtin.subnetwork1, builtin.subnetwork2); err != nil {
+			return "", stacktrace.Propagate(err, "Failed removing connection from subnetwork '%s' to subnetwork '%s'", builtin.subnetwork1, builtin.subnetwork2)
+		}
+		return fmt.Sprintf("Removed subnetwork connection override from '%s' to '%s'", builtin.subnetwork1, builtin.subnetwork2), nil
+	}
 	if err := builtin.serviceNetwork.UnsetConnection(ctx, builtin.subnetwork1, builtin.subnetwork2); err != nil {
 		return "", stacktrace.Propagate(err, "Failed setting connection between subnetwork '%s' and subnetwork '%s'", builtin.subnetwork1, builtin.subnetwork2)
 	}
@@ -88,6 +170,56 @@ func (builtin *RemoveConnectionCapabilities) Execute(ctx context.Context, _ *bui
 	return instructionResult, nil
 }
 
+func (builtin *RemoveConnectionCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	// updating the topology changes the traffic rules of every service in the enclave
+	return kurtosis_instruction.NewBarrierInstructionDependencies()
+}
+
+func (builtin *RemoveConnectionCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	var resourceName string
+	var isConnectionSet bool
+	if builtin.optionalService1 != nil {
+		service1 := *builtin.optionalService1
+		service2 := *builtin.optionalService2
+		isServiceConnection, _, err := builtin.serviceNetwork.GetServiceConnection(service1, service2)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection between service '%s' and service '%s'", service1, service2)
+		}
+		resourceName = shared_helpers.GetServiceConnectionApplyPlanResourceName(service1, service2)
+		isConnectionSet = isServiceConnection
+	} else if builtin.isBidirectional {
+		isDefaultConnection, _, err := builtin.serviceNetwork.GetConnection(builtin.subnetwork1, builtin.subnetwork2)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection between subnetwork '%s' and subnetwork '%s'", builtin.subnetwork1, builtin.subnetwork2)
+		}
+		resourceName = shared_helpers.GetSubnetworkConnectionApplyPlanResourceName(builtin.subnetwork1, builtin.subnetwork2)
+		isConnectionSet = !isDefaultConnection
+		// removing the connection also removes the connections previously set in one direction only
+		for _, direction := range [][]service_network_types.PartitionID{{builtin.subnetwork1, builtin.subnetwork2}, {builtin.subnetwork2, builtin.subnetwork1}} {
+			isDirectionalConnection, _, err := builtin.serviceNetwork.GetDirectionalConnection(direction[0], direction[1])
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the current connection from subnetwork '%s' to subnetwork '%s'", direction[0], direction[1])
+			}
+			isConnectionSet = isConnectionSet || isDirectionalConnection
+		}
+	} else {
+		isDirectionalConnection, _, err := builtin.serviceNetwork.GetDirectionalConnection(builtin.subnetwork1, builtin.subnetwork2)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection from subnetwork '%s' to subnetwork '%s'", builtin.subnetwork1, builtin.subnetwork2)
+		}
+		resourceName = shared_helpers.GetDirectionalSubnetworkConnectionApplyPlanResourceName(builtin.subnetwork1, builtin.subnetwork2)
+		isConnectionSet = isDirectionalConnection
+	}
+	// when forced, the connection is set by a previous instruction of the package, so it needs to be removed again
+	action := kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED
+	if isConnectionSet || forceUpdate {
+		action = kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE
+	}
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SUBNETWORK_CONNECTION, resourceName),
+	}, nil
+}
+
 func validateSubnetworks(value starlark.Value) *startosis_errors.InterpretationError {
 	subnetworks, ok := value.(starlark.Tuple)
 	if !ok {
@@ -99,3 +231,15 @@ func validateSubnetworks(value starlark.Value) *startosis_errors.InterpretationE
 	}
 	return nil
 }
+
+func validateServices(value starlark.Value) *startosis_errors.InterpretationError {
+	services, ok := value.(starlark.Tuple)
+	if !ok {
+		return startosis_errors.NewInterpretationError("'%s' argument should be a 'starlark.Tuple', got '%s'", ServicesArgName, reflect.TypeOf(value))
+	}
+	_, _, interpretationErr := shared_helpers.ParseServices(ServicesArgName, services)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	return nil
+}
@@ -3,8 +3,11 @@ package remove_service
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -84,3 +87,20 @@ func (builtin *RemoveServiceCapabilities) Execute(ctx context.Context, _ *builti
 	instructionResult := fmt.Sprintf("Service '%s' with service UUID '%s' removed", builtin.serviceName, serviceUUID)
 	return instructionResult, nil
 }
+
+func (builtin *RemoveServiceCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.WriteService(builtin.serviceName)
+	return dependencies
+}
+
+func (builtin *RemoveServiceCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	// when forced, the service is created by a previous instruction of the package, so it needs to be removed again
+	action := kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED
+	if _, found := builtin.serviceNetwork.GetServiceRegistration(builtin.serviceName); found || forceUpdate {
+		action = kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE
+	}
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(builtin.serviceName)),
+	}, nil
+}
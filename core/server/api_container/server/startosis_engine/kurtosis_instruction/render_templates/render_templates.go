@@ -7,6 +7,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -16,6 +18,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"go.starlark.net/starlarkjson"
 	"go.starlark.net/starlarkstruct"
@@ -63,6 +66,8 @@ func NewRenderTemplatesInstruction(serviceNetwork service_network.ServiceNetwork
 
 				artifactName:                      "",  // will be populated at interpretation time
 				templatesAndDataByDestRelFilepath: nil, // will be populated at interpretation time
+
+				shouldReplaceFilesArtifact: false, // will be populated when planning an apply
 			}
 		},
 
@@ -79,6 +84,9 @@ type RenderTemplatesCapabilities struct {
 	templatesAndDataByDestRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData
 
 	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	// set when the package is applied to an enclave where a files artifact with different content has the same name
+	shouldReplaceFilesArtifact bool
 }
 
 func (builtin *RenderTemplatesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -109,6 +117,9 @@ func (builtin *RenderTemplatesCapabilities) Interpret(arguments *builtin_argumen
 }
 
 func (builtin *RenderTemplatesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if builtin.shouldReplaceFilesArtifact {
+		validatorEnvironment.RemoveArtifactName(builtin.artifactName)
+	}
 	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) {
 		return startosis_errors.NewValidationError("There was an error validating '%v' as artifact name '%v' already exists", RenderTemplatesBuiltinName, builtin.artifactName)
 	}
@@ -117,16 +128,17 @@ func (builtin *RenderTemplatesCapabilities) Validate(_ *builtin_argument.Argumen
 }
 
 func (builtin *RenderTemplatesCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
-	for relFilePath := range builtin.templatesAndDataByDestRelFilepath {
-		templateStr := builtin.templatesAndDataByDestRelFilepath[relFilePath].Template
-		dataAsJson := builtin.templatesAndDataByDestRelFilepath[relFilePath].DataAsJson
-		dataAsJsonWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(dataAsJson, builtin.runtimeValueStore)
-		if err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred while replacing IP address with place holder in the render_template instruction for target '%v'", relFilePath)
-		}
-		builtin.templatesAndDataByDestRelFilepath[relFilePath] = binding_constructors.NewTemplateAndData(templateStr, dataAsJsonWithRuntimeValueReplaced)
+	templatesAndDataByDestRelFilepath, err := replaceRuntimeValuesInTemplatesData(builtin.templatesAndDataByDestRelFilepath, builtin.runtimeValueStore)
+	if err != nil {
+		return "", err
 	}
+	builtin.templatesAndDataByDestRelFilepath = templatesAndDataByDestRelFilepath
 
+	if builtin.shouldReplaceFilesArtifact {
+		if err = builtin.serviceNetwork.RemoveFilesArtifact(builtin.artifactName); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred removing files artifact '%s' to replace it with the newly rendered templates", builtin.artifactName)
+		}
+	}
 	artifactUUID, err := builtin.serviceNetwork.RenderTemplates(builtin.templatesAndDataByDestRelFilepath, builtin.artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Failed to render templates '%v'", builtin.templatesAndDataByDestRelFilepath)
@@ -135,6 +147,56 @@ func (builtin *RenderTemplatesCapabilities) Execute(_ context.Context, _ *builti
 	return instructionResult, nil
 }
 
+func (builtin *RenderTemplatesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	for _, templateAndData := range builtin.templatesAndDataByDestRelFilepath {
+		dependencies.ReadReferencesInString(templateAndData.DataAsJson)
+	}
+	dependencies.WriteFilesArtifact(builtin.artifactName)
+	return dependencies
+}
+
+func (builtin *RenderTemplatesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	doesFilesArtifactExist, err := builtin.serviceNetwork.DoesFilesArtifactExist(builtin.artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking whether files artifact '%s' exists", builtin.artifactName)
+	}
+	isFilesArtifactUpToDate := false
+	templatesAndDataByDestRelFilepath, err := replaceRuntimeValuesInTemplatesData(builtin.templatesAndDataByDestRelFilepath, builtin.runtimeValueStore)
+	if err != nil {
+		// the templates data depends on runtime values that are only known once the previous instructions are
+		// executed, so the templates can't be compared with the files artifact
+		logrus.Debugf("Templates of files artifact '%s' can't be compared with the files artifact as they depend on runtime values that are not known yet. Error was:\n%v", builtin.artifactName, err)
+	} else if doesFilesArtifactExist {
+		isFilesArtifactUpToDate, err = builtin.serviceNetwork.AreRenderedTemplatesUpToDate(templatesAndDataByDestRelFilepath, builtin.artifactName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred comparing the rendered templates with files artifact '%s'", builtin.artifactName)
+		}
+	}
+	action := shared_helpers.GetApplyPlanAction(doesFilesArtifactExist, isFilesArtifactUpToDate, forceUpdate)
+	builtin.shouldReplaceFilesArtifact = action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_FILES_ARTIFACT, builtin.artifactName),
+	}, nil
+}
+
+// replaceRuntimeValuesInTemplatesData returns a copy of the templates where the runtime values referenced in the
+// templates data are replaced with their actual values
+func replaceRuntimeValuesInTemplatesData(
+	templatesAndDataByDestRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+) (map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, error) {
+	replacedTemplatesAndDataByDestRelFilepath := make(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, len(templatesAndDataByDestRelFilepath))
+	for relFilePath, templateAndData := range templatesAndDataByDestRelFilepath {
+		dataAsJsonWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(templateAndData.DataAsJson, runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while replacing IP address with place holder in the render_template instruction for target '%v'", relFilePath)
+		}
+		replacedTemplatesAndDataByDestRelFilepath[relFilePath] = binding_constructors.NewTemplateAndData(templateAndData.Template, dataAsJsonWithRuntimeValueReplaced)
+	}
+	return replacedTemplatesAndDataByDestRelFilepath, nil
+}
+
 func parseTemplatesAndData(templatesAndData *starlark.Dict) (map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, *startosis_errors.InterpretationError) {
 	templateAndDataByDestRelFilepath := make(map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData)
 	for _, relPathInFilesArtifactKey := range templatesAndData.Keys() {
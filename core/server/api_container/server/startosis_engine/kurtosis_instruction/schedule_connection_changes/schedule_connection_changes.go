@@ -0,0 +1,155 @@
+package schedule_connection_changes
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_change"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"reflect"
+)
+
+const (
+	ScheduleConnectionChangesBuiltinName = "schedule_connection_changes"
+
+	NameArgName    = "name"
+	ChangesArgName = "changes"
+)
+
+func NewScheduleConnectionChanges(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ScheduleConnectionChangesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              NameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, NameArgName)
+					},
+				},
+				{
+					Name:              ChangesArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						// we just try to convert the changes here to validate their shape, to avoid code duplication
+						// with Interpret
+						if _, err := validateAndConvertChanges(value); err != nil {
+							return err
+						}
+						return nil
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &ScheduleConnectionChangesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				name:    "",  // populated at interpretation time
+				changes: nil, // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			NameArgName:    true,
+			ChangesArgName: true,
+		},
+	}
+}
+
+type ScheduleConnectionChangesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	name string
+
+	changes []*connection_change_scheduler.ConnectionChange
+}
+
+func (builtin *ScheduleConnectionChangesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	name, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, NameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", NameArgName)
+	}
+	builtin.name = name.GoString()
+
+	changesStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, ChangesArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ChangesArgName)
+	}
+	changes, interpretationErr := validateAndConvertChanges(changesStarlark)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.changes = changes
+	return starlark.None, nil
+}
+
+func (builtin *ScheduleConnectionChangesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.AreSubnetworksEnforced() {
+		return startosis_errors.NewValidationError("Scheduling connection changes cannot be performed because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled.")
+	}
+	if !validatorEnvironment.IsNetworkPartitioningEnabled() {
+		for _, change := range builtin.changes {
+			connection := change.GetConnection()
+			if connection != nil && connection.IsSet() && !connection.IsBlocking() {
+				return startosis_errors.NewValidationError("Only connections allowing or blocking all the traffic can be scheduled because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled to schedule packet loss, delay or bandwidth limits.")
+			}
+		}
+	}
+	return nil
+}
+
+func (builtin *ScheduleConnectionChangesCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.ScheduleConnectionChanges(builtin.name, builtin.changes); err != nil {
+		return "", stacktrace.Propagate(err, "Failed scheduling connection changes '%s'", builtin.name)
+	}
+	return fmt.Sprintf("Scheduled %d connection changes under the name '%s'", len(builtin.changes), builtin.name), nil
+}
+
+func (builtin *ScheduleConnectionChangesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	// the scheduled changes update the traffic rules of every service in the enclave
+	return kurtosis_instruction.NewBarrierInstructionDependencies()
+}
+
+func (builtin *ScheduleConnectionChangesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	// the changes are applied over time, so there is no state to compare against
+	return shared_helpers.NewAlwaysExecutedApplyPlan(ScheduleConnectionChangesBuiltinName), nil
+}
+
+func validateAndConvertChanges(rawChanges starlark.Value) ([]*connection_change_scheduler.ConnectionChange, *startosis_errors.InterpretationError) {
+	changesList, ok := rawChanges.(*starlark.List)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("The '%s' argument is not a list (was '%s').", ChangesArgName, reflect.TypeOf(rawChanges))
+	}
+	changes := []*connection_change_scheduler.ConnectionChange{}
+	for idx := 0; idx < changesList.Len(); idx++ {
+		starlarkChange, ok := changesList.Index(idx).(*connection_change.ConnectionChange)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Element #%d of the '%s' argument is not a %s (was '%s').", idx, ChangesArgName, connection_change.ConnectionChangeTypeName, reflect.TypeOf(changesList.Index(idx)))
+		}
+		change, interpretationErr := starlarkChange.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if idx > 0 && change.GetOffset() < changes[idx-1].GetOffset() {
+			return nil, startosis_errors.NewInterpretationError("The elements of the '%s' argument have to be ordered by offset, but element #%d has a lower offset than element #%d", ChangesArgName, idx, idx-1)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
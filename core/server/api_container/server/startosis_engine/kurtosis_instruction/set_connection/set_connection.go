@@ -3,9 +3,13 @@ package set_connection
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -22,7 +26,11 @@ const (
 	SetConnectionBuiltinName = "set_connection"
 
 	SubnetworksArgName      = "subnetworks"
+	ServicesArgName         = "services"
 	ConnectionConfigArgName = "config"
+	BidirectionalArgName    = "bidirectional"
+
+	defaultIsBidirectional = true
 )
 
 func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
@@ -37,6 +45,12 @@ func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_p
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
 					Validator:         validateSubnetworks,
 				},
+				{
+					Name:              ServicesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
+					Validator:         validateServices,
+				},
 				{
 					Name:              ConnectionConfigArgName,
 					IsOptional:        false,
@@ -50,6 +64,12 @@ func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_p
 						return nil
 					},
 				},
+				{
+					Name:              BidirectionalArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -59,13 +79,18 @@ func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_p
 
 				optionalSubnetwork1: nil, // populated at interpretation time
 				optionalSubnetwork2: nil, // populated at interpretation time
+				optionalService1:    nil, // populated at interpretation time
+				optionalService2:    nil, // populated at interpretation time
 				connectionConfig:    nil, // populated at interpretation time
+				isBidirectional:     defaultIsBidirectional,
 			}
 		},
 
 		DefaultDisplayArguments: map[string]bool{
 			SubnetworksArgName:      true,
+			ServicesArgName:         true,
 			ConnectionConfigArgName: true,
+			BidirectionalArgName:    true,
 		},
 	}
 }
@@ -78,7 +103,15 @@ type SetConnectionCapabilities struct {
 	optionalSubnetwork1 *service_network_types.PartitionID
 	optionalSubnetwork2 *service_network_types.PartitionID
 
+	// set instead of the subnetworks when the connection is set between two services. It takes precedence over the
+	// connection set between their subnetworks
+	optionalService1 *service.ServiceName
+	optionalService2 *service.ServiceName
+
 	connectionConfig *partition_topology.PartitionConnection
+
+	// when false, the connection only applies to the traffic going from optionalSubnetwork1 to optionalSubnetwork2
+	isBidirectional bool
 }
 
 func (builtin *SetConnectionCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -92,7 +125,38 @@ func (builtin *SetConnectionCapabilities) Interpret(arguments *builtin_argument.
 	}
 	builtin.connectionConfig = connectionConfig
 
+	if arguments.IsSet(BidirectionalArgName) {
+		isBidirectional, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, BidirectionalArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BidirectionalArgName)
+		}
+		builtin.isBidirectional = bool(isBidirectional)
+	}
+
+	if arguments.IsSet(ServicesArgName) {
+		if arguments.IsSet(SubnetworksArgName) {
+			return nil, startosis_errors.NewInterpretationError("Only one of '%s' and '%s' arguments can be set", SubnetworksArgName, ServicesArgName)
+		}
+		if !builtin.isBidirectional {
+			return nil, startosis_errors.NewInterpretationError("A connection between two services applies in both directions, '%s' cannot be set to False along with the '%s' argument", BidirectionalArgName, ServicesArgName)
+		}
+		services, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, ServicesArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServicesArgName)
+		}
+		service1, service2, interpretationErr := shared_helpers.ParseServices(ServicesArgName, services)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.optionalService1 = &service1
+		builtin.optionalService2 = &service2
+		return starlark.None, nil
+	}
+
 	if !arguments.IsSet(SubnetworksArgName) {
+		if !builtin.isBidirectional {
+			return nil, startosis_errors.NewInterpretationError("The default connection applies in both directions, '%s' can only be set to False along with the '%s' argument", BidirectionalArgName, SubnetworksArgName)
+		}
 		return starlark.None, nil
 	}
 	subnetworks, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, SubnetworksArgName)
@@ -109,20 +173,44 @@ func (builtin *SetConnectionCapabilities) Interpret(arguments *builtin_argument.
 }
 
 func (builtin *SetConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
-	if !validatorEnvironment.IsNetworkPartitioningEnabled() {
+	if !validatorEnvironment.AreSubnetworksEnforced() {
 		return startosis_errors.NewValidationError("Setting connection between two subnetworks cannot be performed because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled.")
 	}
+	if !validatorEnvironment.IsNetworkPartitioningEnabled() && builtin.connectionConfig.IsSet() && !builtin.connectionConfig.IsBlocking() {
+		return startosis_errors.NewValidationError("Only connections allowing or blocking all the traffic can be set because the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled to set packet loss, delay or bandwidth limits.")
+	}
+	if builtin.optionalService1 != nil {
+		for _, serviceName := range []service.ServiceName{*builtin.optionalService1, *builtin.optionalService2} {
+			if !validatorEnvironment.DoesServiceNameExist(serviceName) {
+				return startosis_errors.NewValidationError("There was an error validating '%s' as service name '%s' does not exist", SetConnectionBuiltinName, serviceName)
+			}
+		}
+	}
 	return nil
 }
 
 func (builtin *SetConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
 	var instructionResult string
-	if builtin.optionalSubnetwork1 == nil {
+	if builtin.optionalService1 != nil {
+		service1 := *builtin.optionalService1
+		service2 := *builtin.optionalService2
+		if err := builtin.serviceNetwork.SetServiceConnection(ctx, service1, service2, *builtin.connectionConfig); err != nil {
+			return "", stacktrace.Propagate(err, "Failed setting connection between service '%s' and service '%s' with connection config %+v", service1, service2, builtin.connectionConfig)
+		}
+		instructionResult = fmt.Sprintf("Configured connection between services '%s' and '%s'", service1, service2)
+	} else if builtin.optionalSubnetwork1 == nil {
 		// if optionalSubnetwork1 is nil, optionalSubnetwork2 is nil as well and the default connection is being set
 		if err := builtin.serviceNetwork.SetDefaultConnection(ctx, *builtin.connectionConfig); err != nil {
 			return "", stacktrace.Propagate(err, "Failed setting default connection to %+v", builtin.connectionConfig)
 		}
 		instructionResult = "Configured default subnetwork connection"
+	} else if !builtin.isBidirectional {
+		from := *builtin.optionalSubnetwork1
+		to := *builtin.optionalSubnetwork2
+		if err := builtin.serviceNetwork.SetDirectionalConnection(ctx, from, to, *builtin.connectionConfig); err != nil {
+			return "", stacktrace.Propagate(err, "Failed setting connection from subnetwork '%s' to subnetwork '%s' with connection config %+v", from, to, builtin.connectionConfig)
+		}
+		instructionResult = fmt.Sprintf("Configured subnetwork connection from '%s' to '%s'", from, to)
 	} else {
 		subnetwork1 := *builtin.optionalSubnetwork1
 		subnetwork2 := *builtin.optionalSubnetwork2
@@ -134,6 +222,61 @@ func (builtin *SetConnectionCapabilities) Execute(ctx context.Context, _ *builti
 	return instructionResult, nil
 }
 
+func (builtin *SetConnectionCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	// updating the topology changes the traffic rules of every service in the enclave
+	return kurtosis_instruction.NewBarrierInstructionDependencies()
+}
+
+func (builtin *SetConnectionCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	var resourceName string
+	var isConnectionUpToDate bool
+	if builtin.optionalService1 != nil {
+		service1 := *builtin.optionalService1
+		service2 := *builtin.optionalService2
+		isServiceConnection, currentConnection, err := builtin.serviceNetwork.GetServiceConnection(service1, service2)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection between service '%s' and service '%s'", service1, service2)
+		}
+		resourceName = shared_helpers.GetServiceConnectionApplyPlanResourceName(service1, service2)
+		isConnectionUpToDate = isServiceConnection && currentConnection == *builtin.connectionConfig
+	} else if builtin.optionalSubnetwork1 == nil {
+		resourceName = shared_helpers.DefaultConnectionApplyPlanResourceName
+		isConnectionUpToDate = builtin.serviceNetwork.GetDefaultConnection() == *builtin.connectionConfig
+	} else if !builtin.isBidirectional {
+		from := *builtin.optionalSubnetwork1
+		to := *builtin.optionalSubnetwork2
+		isDirectionalConnection, currentConnection, err := builtin.serviceNetwork.GetDirectionalConnection(from, to)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection from subnetwork '%s' to subnetwork '%s'", from, to)
+		}
+		resourceName = shared_helpers.GetDirectionalSubnetworkConnectionApplyPlanResourceName(from, to)
+		isConnectionUpToDate = isDirectionalConnection && currentConnection == *builtin.connectionConfig
+	} else {
+		subnetwork1 := *builtin.optionalSubnetwork1
+		subnetwork2 := *builtin.optionalSubnetwork2
+		isDefaultConnection, currentConnection, err := builtin.serviceNetwork.GetConnection(subnetwork1, subnetwork2)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current connection between subnetwork '%s' and subnetwork '%s'", subnetwork1, subnetwork2)
+		}
+		resourceName = shared_helpers.GetSubnetworkConnectionApplyPlanResourceName(subnetwork1, subnetwork2)
+		isConnectionUpToDate = !isDefaultConnection && currentConnection == *builtin.connectionConfig
+		// setting the connection also drops the connections previously set in one direction only
+		for _, direction := range [][]service_network_types.PartitionID{{subnetwork1, subnetwork2}, {subnetwork2, subnetwork1}} {
+			isDirectionalConnection, _, err := builtin.serviceNetwork.GetDirectionalConnection(direction[0], direction[1])
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the current connection from subnetwork '%s' to subnetwork '%s'", direction[0], direction[1])
+			}
+			isConnectionUpToDate = isConnectionUpToDate && !isDirectionalConnection
+		}
+	}
+	// a connection always exists between two subnetworks or services, the default one being used when none is set
+	doesConnectionExist := true
+	action := shared_helpers.GetApplyPlanAction(doesConnectionExist, isConnectionUpToDate, forceUpdate)
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SUBNETWORK_CONNECTION, resourceName),
+	}, nil
+}
+
 func validateSubnetworks(value starlark.Value) *startosis_errors.InterpretationError {
 	subnetworks, ok := value.(starlark.Tuple)
 	if !ok {
@@ -146,6 +289,18 @@ func validateSubnetworks(value starlark.Value) *startosis_errors.InterpretationE
 	return nil
 }
 
+func validateServices(value starlark.Value) *startosis_errors.InterpretationError {
+	services, ok := value.(starlark.Tuple)
+	if !ok {
+		return startosis_errors.NewInterpretationError("'%s' argument should be a 'starlark.Tuple', got '%s'", ServicesArgName, reflect.TypeOf(value))
+	}
+	_, _, interpretationErr := shared_helpers.ParseServices(ServicesArgName, services)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	return nil
+}
+
 func validateAndConvertConfig(rawConfig starlark.Value) (*partition_topology.PartitionConnection, *startosis_errors.InterpretationError) {
 	starlarkConnectionConfig, ok := rawConfig.(*connection_config.ConnectionConfig)
 	if !ok {
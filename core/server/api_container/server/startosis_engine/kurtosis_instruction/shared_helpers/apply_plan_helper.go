@@ -0,0 +1,61 @@
+package shared_helpers
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+)
+
+const (
+	DefaultConnectionApplyPlanResourceName = "default"
+
+	subnetworkConnectionApplyPlanResourceNameFormat            = "%s <-> %s"
+	directionalSubnetworkConnectionApplyPlanResourceNameFormat = "%s -> %s"
+	serviceConnectionApplyPlanResourceNameFormat               = "service %s <-> service %s"
+)
+
+// GetApplyPlanAction returns what needs to be done to an enclave resource declared by an instruction when a package is
+// applied to the enclave. A resource that exists and is up-to-date is left as is, unless forceUpdate is set
+func GetApplyPlanAction(doesResourceExist bool, isResourceUpToDate bool, forceUpdate bool) kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_Action {
+	if !doesResourceExist {
+		return kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ADD
+	}
+	if isResourceUpToDate && !forceUpdate {
+		return kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED
+	}
+	return kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+}
+
+// NewAlwaysExecutedApplyPlan returns the apply plan of an instruction that does not manage any enclave resource, like
+// exec or wait. Those instructions are executed every time a package is applied
+func NewAlwaysExecutedApplyPlan(instructionName string) []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange {
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(
+			kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_EXECUTE,
+			kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_INSTRUCTION,
+			instructionName,
+		),
+	}
+}
+
+// GetSubnetworkConnectionApplyPlanResourceName returns the name of the connection between the two subnetworks as shown
+// in apply plans. The order of the subnetworks does not matter
+func GetSubnetworkConnectionApplyPlanResourceName(subnetwork1 service_network_types.PartitionID, subnetwork2 service_network_types.PartitionID) string {
+	connectionId := service_network_types.NewPartitionConnectionID(subnetwork1, subnetwork2)
+	return fmt.Sprintf(subnetworkConnectionApplyPlanResourceNameFormat, connectionId.GetFirst(), connectionId.GetSecond())
+}
+
+// GetDirectionalSubnetworkConnectionApplyPlanResourceName returns the name of the connection applying to the traffic
+// going from one subnetwork to the other as shown in apply plans
+func GetDirectionalSubnetworkConnectionApplyPlanResourceName(from service_network_types.PartitionID, to service_network_types.PartitionID) string {
+	return fmt.Sprintf(directionalSubnetworkConnectionApplyPlanResourceNameFormat, from, to)
+}
+
+// GetServiceConnectionApplyPlanResourceName returns the name of the connection between the two services as shown in
+// apply plans. The order of the services does not matter
+func GetServiceConnectionApplyPlanResourceName(service1 service.ServiceName, service2 service.ServiceName) string {
+	connectionId := service_network_types.NewServiceConnectionID(service1, service2)
+	return fmt.Sprintf(serviceConnectionApplyPlanResourceNameFormat, connectionId.GetFirst(), connectionId.GetSecond())
+}
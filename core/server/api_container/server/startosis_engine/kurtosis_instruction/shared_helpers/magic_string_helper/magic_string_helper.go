@@ -64,6 +64,21 @@ func GetOrReplaceRuntimeValueFromString(originalString string, runtimeValueStore
 	}
 }
 
+// GetRuntimeValueUuidsReferencedInString returns the UUIDs of all the runtime values referenced in the string, without
+// resolving them. It can be called before the runtime values are set
+func GetRuntimeValueUuidsReferencedInString(originalString string) []string {
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	if runtimeValueMatchIndex == subExpNotFound {
+		// should never happen, the subgroup is part of the constant regexp
+		return nil
+	}
+	var runtimeValueUuids []string
+	for _, match := range compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches) {
+		runtimeValueUuids = append(runtimeValueUuids, match[runtimeValueMatchIndex])
+	}
+	return runtimeValueUuids
+}
+
 func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
 	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
 	if runtimeValueMatchIndex == subExpNotFound {
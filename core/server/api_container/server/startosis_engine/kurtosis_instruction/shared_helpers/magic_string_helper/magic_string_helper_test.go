@@ -20,10 +20,10 @@ func TestGetOrReplaceRuntimeValueFromString_BasicFetch(t *testing.T) {
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue}))
 	intValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue}))
 	fetchedStringValue, err := GetOrReplaceRuntimeValueFromString(fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField), runtimeValueStore)
 	require.Nil(t, err)
 	require.Equal(t, fetchedStringValue, testStringRuntimeValue)
@@ -36,10 +36,10 @@ func TestGetOrReplaceRuntimeValueFromString_Interpolated(t *testing.T) {
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue}))
 	intValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue}))
 	stringRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField)
 	intRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, intValueUuid, testRuntimeValueField)
 	interpolatedString := fmt.Sprintf("%v is not %v", stringRuntimeValue, intRuntimeValue)
@@ -52,10 +52,10 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue}))
 	intValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
-	runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue})
+	require.Nil(t, runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue}))
 	stringRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField)
 	intRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, intValueUuid, testRuntimeValueField)
 	interpolatedString := fmt.Sprintf("%v is not %v", stringRuntimeValue, intRuntimeValue)
@@ -63,3 +63,17 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
+
+func TestGetRuntimeValueUuidsReferencedInString(t *testing.T) {
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	stringValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	intValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	stringRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField)
+	intRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, intValueUuid, testRuntimeValueField)
+	interpolatedString := fmt.Sprintf("%v is not %v", stringRuntimeValue, intRuntimeValue)
+	// runtime values do not need to be set to be referenced
+	require.Equal(t, []string{stringValueUuid, intValueUuid}, GetRuntimeValueUuidsReferencedInString(interpolatedString))
+	require.Empty(t, GetRuntimeValueUuidsReferencedInString("no runtime value here"))
+}
@@ -0,0 +1,28 @@
+package shared_helpers
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+func ParseServices(serviceArgName string, servicesTuple starlark.Tuple) (service.ServiceName, service.ServiceName, *startosis_errors.InterpretationError) {
+	servicesStr, interpretationErr := kurtosis_types.SafeCastToStringSlice(servicesTuple, serviceArgName)
+	if interpretationErr != nil {
+		return "", "", interpretationErr
+	}
+
+	errorMsgTemplate := "Services tuple should contain exactly 2 service names. %d %s provided"
+	if len(servicesStr) < 2 {
+		return "", "", startosis_errors.NewInterpretationError(errorMsgTemplate, len(servicesStr), "was")
+	} else if len(servicesStr) > 2 {
+		return "", "", startosis_errors.NewInterpretationError(errorMsgTemplate, len(servicesStr), "were")
+	}
+	if servicesStr[0] == servicesStr[1] {
+		return "", "", startosis_errors.NewInterpretationError("Services tuple should contain two different service names, got '%s' twice", servicesStr[0])
+	}
+	service1 := service.ServiceName(servicesStr[0])
+	service2 := service.ServiceName(servicesStr[1])
+	return service1, service2, nil
+}
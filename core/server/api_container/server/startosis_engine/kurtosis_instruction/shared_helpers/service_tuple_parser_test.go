@@ -0,0 +1,44 @@
+package shared_helpers
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	servicesArgName = "services"
+)
+
+func TestParseServices_ValidArg(t *testing.T) {
+	expectedService1 := "service_1"
+	expectedService2 := "service_2"
+	services := starlark.Tuple([]starlark.Value{
+		starlark.String(expectedService1),
+		starlark.String(expectedService2),
+	})
+	service1, service2, err := ParseServices(servicesArgName, services)
+	require.Nil(t, err)
+	require.Equal(t, service.ServiceName(expectedService1), service1)
+	require.Equal(t, service.ServiceName(expectedService2), service2)
+}
+
+func TestParseServices_TooFewServices(t *testing.T) {
+	services := starlark.Tuple([]starlark.Value{
+		starlark.String("service_1"),
+	})
+	service1, service2, err := ParseServices(servicesArgName, services)
+	require.Contains(t, err.Error(), "Services tuple should contain exactly 2 service names. 1 was provided")
+	require.Empty(t, service1)
+	require.Empty(t, service2)
+}
+
+func TestParseServices_SameServiceTwice(t *testing.T) {
+	services := starlark.Tuple([]starlark.Value{
+		starlark.String("service_1"),
+		starlark.String("service_1"),
+	})
+	_, _, err := ParseServices(servicesArgName, services)
+	require.Contains(t, err.Error(), "got 'service_1' twice")
+}
@@ -3,8 +3,12 @@ package store_service_files
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -56,6 +60,8 @@ func NewStoreServiceFiles(serviceNetwork service_network.ServiceNetwork) *kurtos
 				serviceName:  "", // populated at interpretation time
 				src:          "", // populated at interpretation time
 				artifactName: "", // populated at interpretation time
+
+				shouldReplaceFilesArtifact: false, // populated when planning an apply
 			}
 		},
 
@@ -73,6 +79,10 @@ type StoreServiceFilesCapabilities struct {
 	serviceName  kurtosis_backend_service.ServiceName
 	src          string
 	artifactName string
+
+	// set when the package is applied to an enclave where the files artifact already exists but needs to be copied
+	// again from the service
+	shouldReplaceFilesArtifact bool
 }
 
 func (builtin *StoreServiceFilesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -106,6 +116,9 @@ func (builtin *StoreServiceFilesCapabilities) Interpret(arguments *builtin_argum
 }
 
 func (builtin *StoreServiceFilesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if builtin.shouldReplaceFilesArtifact {
+		validatorEnvironment.RemoveArtifactName(builtin.artifactName)
+	}
 	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
 		return startosis_errors.NewValidationError("There was an error validating '%v' with service name '%v' that does not exist", StoreServiceFilesBuiltinName, builtin.serviceName)
 	}
@@ -117,6 +130,11 @@ func (builtin *StoreServiceFilesCapabilities) Validate(_ *builtin_argument.Argum
 }
 
 func (builtin *StoreServiceFilesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if builtin.shouldReplaceFilesArtifact {
+		if err := builtin.serviceNetwork.RemoveFilesArtifact(builtin.artifactName); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred removing files artifact '%s' to copy it again from service '%v'", builtin.artifactName, builtin.serviceName)
+		}
+	}
 	artifactUuid, err := builtin.serviceNetwork.CopyFilesFromService(ctx, string(builtin.serviceName), builtin.src, builtin.artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Failed to copy file '%v' from service '%v", builtin.src, builtin.serviceName)
@@ -124,3 +142,25 @@ func (builtin *StoreServiceFilesCapabilities) Execute(ctx context.Context, _ *bu
 	instructionResult := fmt.Sprintf("Files with artifact name '%s' uploaded with artifact UUID '%s'", builtin.artifactName, artifactUuid)
 	return instructionResult, nil
 }
+
+func (builtin *StoreServiceFilesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.ReadService(builtin.serviceName)
+	dependencies.WriteFilesArtifact(builtin.artifactName)
+	return dependencies
+}
+
+func (builtin *StoreServiceFilesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	doesFilesArtifactExist, err := builtin.serviceNetwork.DoesFilesArtifactExist(builtin.artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking whether files artifact '%s' exists", builtin.artifactName)
+	}
+	// the content of the service files can't be known before execution, so the files artifact is considered up-to-date
+	// unless the service it is copied from changes
+	isFilesArtifactUpToDate := true
+	action := shared_helpers.GetApplyPlanAction(doesFilesArtifactExist, isFilesArtifactUpToDate, forceUpdate)
+	builtin.shouldReplaceFilesArtifact = action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_FILES_ARTIFACT, builtin.artifactName),
+	}, nil
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/update_service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
+	"google.golang.org/protobuf/proto"
 	"reflect"
 )
 
@@ -25,7 +30,7 @@ const (
 	UpdateServiceConfigArgName = "config"
 )
 
-func NewUpdateService(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+func NewUpdateService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: UpdateServiceBuiltinName,
@@ -57,7 +62,8 @@ func NewUpdateService(serviceNetwork service_network.ServiceNetwork) *kurtosis_p
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
 			return &UpdateServiceCapabilities{
-				serviceNetwork: serviceNetwork,
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
 
 				serviceName:         "",  // populated at interpretation time
 				updateServiceConfig: nil, // populated at interpretation time
@@ -72,7 +78,8 @@ func NewUpdateService(serviceNetwork service_network.ServiceNetwork) *kurtosis_p
 }
 
 type UpdateServiceCapabilities struct {
-	serviceNetwork service_network.ServiceNetwork
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
 
 	serviceName         service.ServiceName
 	updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig
@@ -100,13 +107,21 @@ func (builtin *UpdateServiceCapabilities) Interpret(arguments *builtin_argument.
 
 func (builtin *UpdateServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
 	if partition_topology.ParsePartitionId(builtin.updateServiceConfig.Subnetwork) != partition_topology.DefaultPartitionId {
-		if !validatorEnvironment.IsNetworkPartitioningEnabled() {
+		if !validatorEnvironment.AreSubnetworksEnforced() {
 			return startosis_errors.NewValidationError("Service was about to be moved to subnetwork '%s' but the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark script with subnetwork enabled.", *builtin.updateServiceConfig.Subnetwork)
 		}
 	}
 	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
 		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' does not exist", UpdateServiceBuiltinName, builtin.serviceName)
 	}
+	for _, artifactName := range builtin.updateServiceConfig.FilesArtifactMountpoints {
+		if !validatorEnvironment.DoesArtifactNameExist(artifactName) {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' does not exist", UpdateServiceBuiltinName, artifactName)
+		}
+	}
+	if builtin.updateServiceConfig.ContainerImageName != nil {
+		validatorEnvironment.AppendRequiredContainerImage(*builtin.updateServiceConfig.ContainerImageName)
+	}
 	return nil
 }
 
@@ -116,8 +131,13 @@ func (builtin *UpdateServiceCapabilities) Execute(ctx context.Context, _ *builti
 		return "", stacktrace.Propagate(err, "Updating service '%s' failed as it could not be retrieved from the enclave", builtin.serviceName)
 	}
 
+	replacedUpdateServiceConfig, err := replaceMagicStrings(builtin.runtimeValueStore, builtin.updateServiceConfig)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred replacing a magic string in '%s' instruction arguments for service '%s'. Execution cannot proceed", UpdateServiceBuiltinName, builtin.serviceName)
+	}
+
 	updateServiceConfigMap := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
-		builtin.serviceName: builtin.updateServiceConfig,
+		builtin.serviceName: replacedUpdateServiceConfig,
 	}
 
 	serviceSuccessful, serviceFailed, err := builtin.serviceNetwork.UpdateService(ctx, updateServiceConfigMap)
@@ -135,6 +155,48 @@ func (builtin *UpdateServiceCapabilities) Execute(ctx context.Context, _ *builti
 	return instructionResult, nil
 }
 
+func (builtin *UpdateServiceCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	// moving a service to another subnetwork changes the traffic rules of every service in the enclave
+	dependencies := kurtosis_instruction.NewBarrierInstructionDependencies()
+	dependencies.WriteService(builtin.serviceName)
+	for _, artifactName := range builtin.updateServiceConfig.FilesArtifactMountpoints {
+		dependencies.ReadFilesArtifact(artifactName)
+	}
+	for _, entryPointArg := range builtin.updateServiceConfig.EntrypointArgs {
+		dependencies.ReadReferencesInString(entryPointArg)
+	}
+	for _, cmdArg := range builtin.updateServiceConfig.CmdArgs {
+		dependencies.ReadReferencesInString(cmdArg)
+	}
+	for _, envVarValue := range builtin.updateServiceConfig.EnvVars {
+		dependencies.ReadReferencesInString(envVarValue)
+	}
+	return dependencies
+}
+
+func (builtin *UpdateServiceCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	currentPartition, doesServiceExist, err := builtin.serviceNetwork.GetServicePartition(builtin.serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current subnetwork of service '%s'", builtin.serviceName)
+	}
+	// when the service does not exist yet, it is created by a previous instruction of the package and needs to be updated
+	// once created
+	isServiceUpToDate := builtin.updateServiceConfig.Subnetwork == nil ||
+		(doesServiceExist && currentPartition == partition_topology.ParsePartitionId(builtin.updateServiceConfig.Subnetwork))
+	if runningServiceConfig, found := builtin.serviceNetwork.GetServiceConfig(builtin.serviceName); found {
+		isServiceUpToDate = isServiceUpToDate && isServiceConfigUpToDate(runningServiceConfig, builtin.updateServiceConfig)
+	} else if !doesServiceExist {
+		isServiceUpToDate = isServiceUpToDate && isSubnetworkOnlyUpdate(builtin.updateServiceConfig)
+	}
+	action := kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED
+	if !isServiceUpToDate || forceUpdate {
+		action = kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+	}
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(builtin.serviceName)),
+	}, nil
+}
+
 func validateAndConvertConfig(rawConfig starlark.Value) (*kurtosis_core_rpc_api_bindings.UpdateServiceConfig, *startosis_errors.InterpretationError) {
 	config, ok := rawConfig.(*update_service_config.UpdateServiceConfig)
 	if !ok {
@@ -146,3 +208,103 @@ func validateAndConvertConfig(rawConfig starlark.Value) (*kurtosis_core_rpc_api_
 	}
 	return apiUpdateServiceConfig, nil
 }
+
+// isServiceConfigUpToDate returns true if the service config already has all the attributes set in the update
+func isServiceConfigUpToDate(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig, updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig) bool {
+	if updateServiceConfig.ContainerImageName != nil && *updateServiceConfig.ContainerImageName != serviceConfig.ContainerImageName {
+		return false
+	}
+	if len(updateServiceConfig.PrivatePorts) > 0 {
+		if len(updateServiceConfig.PrivatePorts) != len(serviceConfig.PrivatePorts) {
+			return false
+		}
+		for portId, port := range updateServiceConfig.PrivatePorts {
+			if !proto.Equal(port, serviceConfig.PrivatePorts[portId]) {
+				return false
+			}
+		}
+	}
+	if len(updateServiceConfig.EntrypointArgs) > 0 && !reflect.DeepEqual(updateServiceConfig.EntrypointArgs, serviceConfig.EntrypointArgs) {
+		return false
+	}
+	if len(updateServiceConfig.CmdArgs) > 0 && !reflect.DeepEqual(updateServiceConfig.CmdArgs, serviceConfig.CmdArgs) {
+		return false
+	}
+	if len(updateServiceConfig.EnvVars) > 0 && !reflect.DeepEqual(updateServiceConfig.EnvVars, serviceConfig.EnvVars) {
+		return false
+	}
+	if len(updateServiceConfig.FilesArtifactMountpoints) > 0 && !reflect.DeepEqual(updateServiceConfig.FilesArtifactMountpoints, serviceConfig.FilesArtifactMountpoints) {
+		return false
+	}
+	if updateServiceConfig.CpuAllocationMillicpus != nil && *updateServiceConfig.CpuAllocationMillicpus != serviceConfig.CpuAllocationMillicpus {
+		return false
+	}
+	if updateServiceConfig.MemoryAllocationMegabytes != nil && *updateServiceConfig.MemoryAllocationMegabytes != serviceConfig.MemoryAllocationMegabytes {
+		return false
+	}
+	return true
+}
+
+func isSubnetworkOnlyUpdate(updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig) bool {
+	return updateServiceConfig.ContainerImageName == nil &&
+		len(updateServiceConfig.PrivatePorts) == 0 &&
+		len(updateServiceConfig.EntrypointArgs) == 0 &&
+		len(updateServiceConfig.CmdArgs) == 0 &&
+		len(updateServiceConfig.EnvVars) == 0 &&
+		len(updateServiceConfig.FilesArtifactMountpoints) == 0 &&
+		updateServiceConfig.CpuAllocationMillicpus == nil &&
+		updateServiceConfig.MemoryAllocationMegabytes == nil
+}
+
+// replaceMagicStrings returns a copy of the update with the runtime values in the entrypoint, cmd and environment
+// variables replaced
+func replaceMagicStrings(
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig,
+) (*kurtosis_core_rpc_api_bindings.UpdateServiceConfig, error) {
+	replacedUpdateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:                updateServiceConfig.Subnetwork,
+		ContainerImageName:        updateServiceConfig.ContainerImageName,
+		PrivatePorts:              updateServiceConfig.PrivatePorts,
+		EntrypointArgs:            nil,
+		CmdArgs:                   nil,
+		EnvVars:                   nil,
+		FilesArtifactMountpoints:  updateServiceConfig.FilesArtifactMountpoints,
+		CpuAllocationMillicpus:    updateServiceConfig.CpuAllocationMillicpus,
+		MemoryAllocationMegabytes: updateServiceConfig.MemoryAllocationMegabytes,
+	}
+
+	if updateServiceConfig.EntrypointArgs != nil {
+		replacedUpdateServiceConfig.EntrypointArgs = make([]string, len(updateServiceConfig.EntrypointArgs))
+		for index, entryPointArg := range updateServiceConfig.EntrypointArgs {
+			entryPointArgWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(entryPointArg, runtimeValueStore)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in entry point args for '%v'", entryPointArg)
+			}
+			replacedUpdateServiceConfig.EntrypointArgs[index] = entryPointArgWithRuntimeValueReplaced
+		}
+	}
+
+	if updateServiceConfig.CmdArgs != nil {
+		replacedUpdateServiceConfig.CmdArgs = make([]string, len(updateServiceConfig.CmdArgs))
+		for index, cmdArg := range updateServiceConfig.CmdArgs {
+			cmdArgWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(cmdArg, runtimeValueStore)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in command args for '%v'", cmdArg)
+			}
+			replacedUpdateServiceConfig.CmdArgs[index] = cmdArgWithRuntimeValueReplaced
+		}
+	}
+
+	if updateServiceConfig.EnvVars != nil {
+		replacedUpdateServiceConfig.EnvVars = make(map[string]string, len(updateServiceConfig.EnvVars))
+		for envVarName, envVarValue := range updateServiceConfig.EnvVars {
+			envVarValueWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(envVarValue, runtimeValueStore)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in env var '%s': '%s'", envVarName, envVarValue)
+			}
+			replacedUpdateServiceConfig.EnvVars[envVarName] = envVarValueWithRuntimeValueReplaced
+		}
+	}
+	return replacedUpdateServiceConfig, nil
+}
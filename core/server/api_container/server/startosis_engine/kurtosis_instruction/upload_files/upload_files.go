@@ -3,8 +3,12 @@ package upload_files
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -54,6 +58,8 @@ func NewUploadFiles(serviceNetwork service_network.ServiceNetwork, packageConten
 				src:          "", // populated at interpretation time
 				artifactName: "", // populated at interpretation time
 				pathOnDisk:   "", // populated at interpretation time
+
+				shouldReplaceFilesArtifact: false, // populated when planning an apply
 			}
 		},
 
@@ -71,6 +77,9 @@ type UploadFilesCapabilities struct {
 	src          string
 	artifactName string
 	pathOnDisk   string
+
+	// set when the package is applied to an enclave where a files artifact with different content has the same name
+	shouldReplaceFilesArtifact bool
 }
 
 func (builtin *UploadFilesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -104,6 +113,9 @@ func (builtin *UploadFilesCapabilities) Interpret(arguments *builtin_argument.Ar
 }
 
 func (builtin *UploadFilesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if builtin.shouldReplaceFilesArtifact {
+		validatorEnvironment.RemoveArtifactName(builtin.artifactName)
+	}
 	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) {
 		return startosis_errors.NewValidationError("There was an error validating '%v' as artifact name '%v' already exists", UploadFilesBuiltinName, builtin.artifactName)
 	}
@@ -116,6 +128,11 @@ func (builtin *UploadFilesCapabilities) Execute(_ context.Context, _ *builtin_ar
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while compressing the files '%v'", builtin.pathOnDisk)
 	}
+	if builtin.shouldReplaceFilesArtifact {
+		if err = builtin.serviceNetwork.RemoveFilesArtifact(builtin.artifactName); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred removing files artifact '%s' to replace it with its new content", builtin.artifactName)
+		}
+	}
 	filesArtifactUuid, err := builtin.serviceNetwork.UploadFilesArtifact(compressedData, builtin.artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while uploading the compressed contents\n'%v'", compressedData)
@@ -123,3 +140,32 @@ func (builtin *UploadFilesCapabilities) Execute(_ context.Context, _ *builtin_ar
 	instructionResult := fmt.Sprintf("Files with artifact name '%s' uploaded with artifact UUID '%s'", builtin.artifactName, filesArtifactUuid)
 	return instructionResult, nil
 }
+
+func (builtin *UploadFilesCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.WriteFilesArtifact(builtin.artifactName)
+	return dependencies
+}
+
+func (builtin *UploadFilesCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	doesFilesArtifactExist, err := builtin.serviceNetwork.DoesFilesArtifactExist(builtin.artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking whether files artifact '%s' exists", builtin.artifactName)
+	}
+	isFilesArtifactUpToDate := false
+	if doesFilesArtifactExist {
+		compressedData, err := shared_utils.CompressPath(builtin.pathOnDisk, ensureCompressedFileIsLesserThanGRPCLimit)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while compressing the files '%v'", builtin.pathOnDisk)
+		}
+		isFilesArtifactUpToDate, err = builtin.serviceNetwork.IsFilesArtifactUpToDate(compressedData, builtin.artifactName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred comparing the files '%v' with files artifact '%s'", builtin.pathOnDisk, builtin.artifactName)
+		}
+	}
+	action := shared_helpers.GetApplyPlanAction(doesFilesArtifactExist, isFilesArtifactUpToDate, forceUpdate)
+	builtin.shouldReplaceFilesArtifact = action == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_FILES_ARTIFACT, builtin.artifactName),
+	}, nil
+}
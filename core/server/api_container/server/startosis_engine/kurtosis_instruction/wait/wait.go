@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/cenkalti/backoff/v4"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/assert"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
@@ -236,6 +238,59 @@ func (builtin *WaitCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet,
 }
 
 func (builtin *WaitCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	startTime := time.Now()
+	lastResult, tries, err := ExecuteServiceAssertionWithRecipe(
+		ctx,
+		builtin.serviceNetwork,
+		builtin.runtimeValueStore,
+		builtin.serviceName,
+		builtin.recipe,
+		builtin.valueField,
+		builtin.assertion,
+		builtin.target,
+		builtin.backoff,
+		builtin.timeout,
+	)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred running '%v' on service '%v'", WaitBuiltinName, builtin.serviceName)
+	}
+	if err = builtin.runtimeValueStore.SetValue(builtin.resultUuid, lastResult); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the result of the wait")
+	}
+	instructionResult := fmt.Sprintf("Wait took %d tries (%v in total). Assertion passed with following:\n%s", tries, time.Since(startTime), builtin.recipe.ResultMapToString(lastResult))
+	return instructionResult, nil
+}
+
+func (builtin *WaitCapabilities) GetDependencies(_ *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.ReadService(builtin.serviceName)
+	dependencies.ReadReferencesInString(builtin.recipe.String())
+	if targetStr, ok := builtin.target.(starlark.String); ok {
+		dependencies.ReadReferencesInString(targetStr.GoString())
+	}
+	dependencies.WriteRuntimeValue(builtin.resultUuid)
+	return dependencies
+}
+
+func (builtin *WaitCapabilities) PlanApply(_ *builtin_argument.ArgumentValuesSet, _ bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return shared_helpers.NewAlwaysExecutedApplyPlan(WaitBuiltinName), nil
+}
+
+// ExecuteServiceAssertionWithRecipe runs the recipe against the service until the value of the field satisfies the
+// assertion, waiting according to the backoff in between tries. It gives up once the timeout is reached.
+// It returns the last result of the recipe, along with the number of tries it took
+func ExecuteServiceAssertionWithRecipe(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	genericRecipe recipe.Recipe,
+	valueField string,
+	assertion string,
+	target starlark.Comparable,
+	waitBackoff backoff.BackOff,
+	timeout time.Duration,
+) (map[string]starlark.Comparable, int, error) {
 	var requestErr error
 	var assertErr error
 	tries := 0
@@ -244,22 +299,21 @@ func (builtin *WaitCapabilities) Execute(ctx context.Context, _ *builtin_argumen
 	startTime := time.Now()
 	for {
 		tries += 1
-		backoffDuration := builtin.backoff.NextBackOff()
-		if backoffDuration == backoff.Stop || time.Since(startTime) > builtin.timeout {
+		backoffDuration := waitBackoff.NextBackOff()
+		if backoffDuration == backoff.Stop || time.Since(startTime) > timeout {
 			timedOut = true
 			break
 		}
-		lastResult, requestErr = builtin.recipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
+		lastResult, requestErr = genericRecipe.Execute(ctx, serviceNetwork, runtimeValueStore, serviceName)
 		if requestErr != nil {
 			time.Sleep(backoffDuration)
 			continue
 		}
-		builtin.runtimeValueStore.SetValue(builtin.resultUuid, lastResult)
-		value, found := lastResult[builtin.valueField]
+		value, found := lastResult[valueField]
 		if !found {
-			return "", stacktrace.NewError("Error extracting value from key '%v'", builtin.valueField)
+			return nil, tries, stacktrace.NewError("Error extracting value from key '%v'", valueField)
 		}
-		assertErr = assert.Assert(value, builtin.assertion, builtin.target)
+		assertErr = assert.Assert(value, assertion, target)
 		if assertErr != nil {
 			time.Sleep(backoffDuration)
 			continue
@@ -267,14 +321,10 @@ func (builtin *WaitCapabilities) Execute(ctx context.Context, _ *builtin_argumen
 		break
 	}
 	if timedOut {
-		return "", stacktrace.NewError("Wait timed-out waiting for the assertion to become valid. Waited for '%v'. Last assertion error was: \n%v", time.Since(startTime), assertErr)
-	}
-	if requestErr != nil {
-		return "", stacktrace.Propagate(requestErr, "Error executing HTTP recipe on '%v'", WaitBuiltinName)
-	}
-	if assertErr != nil {
-		return "", stacktrace.Propagate(assertErr, "Error asserting HTTP recipe on '%v'", WaitBuiltinName)
+		if requestErr != nil {
+			return nil, tries, stacktrace.Propagate(requestErr, "Wait timed-out waiting for the assertion to become valid. Waited for '%v'. Last recipe execution failed", time.Since(startTime))
+		}
+		return nil, tries, stacktrace.NewError("Wait timed-out waiting for the assertion to become valid. Waited for '%v'. Last assertion error was: \n%v", time.Since(startTime), assertErr)
 	}
-	instructionResult := fmt.Sprintf("Wait took %d tries (%v in total). Assertion passed with following:\n%s", tries, time.Since(startTime), builtin.recipe.ResultMapToString(lastResult))
-	return instructionResult, nil
+	return lastResult, tries, nil
 }
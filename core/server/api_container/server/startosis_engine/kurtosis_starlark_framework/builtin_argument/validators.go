@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 )
 
 func NonEmptyString(value starlark.Value, argNameForLogging string) *startosis_errors.InterpretationError {
@@ -49,6 +50,17 @@ func FloatInRange(value starlark.Value, argNameForLogging string, min float64, m
 	return nil
 }
 
+func Duration(value starlark.Value, argNameForLogging string) *startosis_errors.InterpretationError {
+	valueStr, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a starlark.String but was '%s'", argNameForLogging, reflect.TypeOf(value))
+	}
+	if _, err := time.ParseDuration(valueStr.GoString()); err != nil {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a duration string (e.g. '1s', '2m30s'), but it was '%s'", argNameForLogging, valueStr.GoString())
+	}
+	return nil
+}
+
 func StringValues(value starlark.Value, argNameForLogging string, acceptableValues []string) *startosis_errors.InterpretationError {
 	valueStr, ok := value.(starlark.String)
 	if !ok {
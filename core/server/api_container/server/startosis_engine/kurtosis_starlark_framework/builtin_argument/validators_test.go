@@ -27,6 +27,19 @@ func TestNonEmptyString_Valid(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestDuration_Valid(t *testing.T) {
+	value := starlark.String("2m30s")
+	err := Duration(value, "timeout")
+	require.Nil(t, err)
+}
+
+func TestDuration_Invalid(t *testing.T) {
+	value := starlark.String("30")
+	err := Duration(value, "timeout")
+	require.NotNil(t, err)
+	require.Equal(t, "Value for 'timeout' was expected to be a duration string (e.g. '1s', '2m30s'), but it was '30'", err.Error())
+}
+
 func TestStringValues_Valid(t *testing.T) {
 	value := starlark.String("TCP")
 	err := StringValues(value, "port_protocol", []string{"TCP", "UDP"})
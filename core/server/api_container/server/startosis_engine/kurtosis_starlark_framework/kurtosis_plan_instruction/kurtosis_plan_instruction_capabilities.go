@@ -2,6 +2,8 @@ package kurtosis_plan_instruction
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -14,4 +16,21 @@ type KurtosisPlanInstructionCapabilities interface {
 	Validate(arguments *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError
 
 	Execute(ctx context.Context, arguments *builtin_argument.ArgumentValuesSet) (string, error)
+
+	GetDependencies(arguments *builtin_argument.ArgumentValuesSet) *kurtosis_instruction.InstructionDependencies
+
+	PlanApply(arguments *builtin_argument.ArgumentValuesSet, forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error)
+}
+
+// ConfigurableKurtosisPlanInstructionCapabilities can be implemented by the instructions which execution depends on
+// the options of the run they're executed in. Execute is used otherwise
+type ConfigurableKurtosisPlanInstructionCapabilities interface {
+	ExecuteWithOptions(ctx context.Context, arguments *builtin_argument.ArgumentValuesSet, options *kurtosis_instruction.ExecutionOptions) (string, error)
+}
+
+// RestorableKurtosisPlanInstructionCapabilities can be implemented by the instructions which can set the runtime values
+// they create from the resources a previous run already applied to the enclave (see
+// kurtosis_instruction.KurtosisInstruction.RestoreRuntimeValues). The other instructions can't restore them
+type RestorableKurtosisPlanInstructionCapabilities interface {
+	RestoreRuntimeValues(arguments *builtin_argument.ArgumentValuesSet) bool
 }
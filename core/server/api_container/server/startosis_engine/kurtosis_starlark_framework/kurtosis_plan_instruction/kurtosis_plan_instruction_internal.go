@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -75,6 +76,34 @@ func (builtin *kurtosisPlanInstructionInternal) Execute(ctx context.Context) (*s
 	return &result, nil
 }
 
+func (builtin *kurtosisPlanInstructionInternal) ExecuteWithOptions(ctx context.Context, options *kurtosis_instruction.ExecutionOptions) (*string, error) {
+	configurableCapabilities, ok := builtin.capabilities.(ConfigurableKurtosisPlanInstructionCapabilities)
+	if !ok {
+		return builtin.Execute(ctx)
+	}
+	result, err := configurableCapabilities.ExecuteWithOptions(ctx, builtin.GetArguments(), options)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (builtin *kurtosisPlanInstructionInternal) GetDependencies() *kurtosis_instruction.InstructionDependencies {
+	return builtin.capabilities.GetDependencies(builtin.GetArguments())
+}
+
+func (builtin *kurtosisPlanInstructionInternal) PlanApply(forceUpdate bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, error) {
+	return builtin.capabilities.PlanApply(builtin.GetArguments(), forceUpdate)
+}
+
+func (builtin *kurtosisPlanInstructionInternal) RestoreRuntimeValues() bool {
+	restorableCapabilities, ok := builtin.capabilities.(RestorableKurtosisPlanInstructionCapabilities)
+	if !ok {
+		return false
+	}
+	return restorableCapabilities.RestoreRuntimeValues(builtin.GetArguments())
+}
+
 func (builtin *kurtosisPlanInstructionInternal) interpret() (starlark.Value, *startosis_errors.InterpretationError) {
 	result, interpretationErr := builtin.capabilities.Interpret(builtin.GetArguments())
 	if interpretationErr != nil {
@@ -0,0 +1,137 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+const (
+	previousContainerImageName = "kurtosistech/example-datastore-server:previous"
+)
+
+func TestAddService_ApplyRollsBackUpdatedServiceThatIsNotReady(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	previousServiceConfig := services.NewServiceConfigBuilder(previousContainerImageName).Build()
+	serviceNetwork.EXPECT().GetServiceConfig(TestServiceName).Return(previousServiceConfig, true)
+	expectServiceRecreatedWithImage(serviceNetwork, TestServiceName, TestServiceUuid, TestContainerImageName)
+	expectServiceNeverReady(serviceNetwork, TestServiceName)
+	// the service gets rolled back to its previous config rather than removed
+	expectServiceRecreatedWithImage(serviceNetwork, TestServiceName, TestServiceUuid, previousContainerImageName)
+
+	instruction := add_service.NewAddService(serviceNetwork, runtime_value_store.NewRuntimeValueStore())
+	starlarkCode := fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, TestServiceName, add_service.ServiceConfigArgName, getServiceConfigWithFailingReadyConditionStr())
+	instructionToExecute, ok := interpretKurtosisPlanInstruction(t, instruction, starlarkCode).(kurtosis_instruction.ConfigurableKurtosisInstruction)
+	require.True(t, ok, "instruction should be configurable")
+
+	_, err := instructionToExecute.ExecuteWithOptions(context.Background(), newApplyExecutionOptions(TestServiceName))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "It was rolled back to its previous config")
+}
+
+func TestAddServices_ApplyRemovesAddedServicesAndRollsBackUpdatedServicesWhenNotReady(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().StartServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			_, found := configs[TestServiceName]
+			return len(configs) == 1 && found
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			TestServiceName: newTestService(TestServiceName, TestServiceUuid),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	previousServiceConfig := services.NewServiceConfigBuilder(previousContainerImageName).Build()
+	serviceNetwork.EXPECT().GetServiceConfig(TestServiceName2).Return(previousServiceConfig, true)
+	expectServiceRecreatedWithImage(serviceNetwork, TestServiceName2, TestServiceUuid2, TestContainerImageName)
+	expectServiceNeverReady(serviceNetwork, TestServiceName2)
+	// only the service the batch added gets removed, the one it updated gets rolled back to its previous config
+	serviceNetwork.EXPECT().RemoveService(mock.Anything, string(TestServiceName)).Times(1).Return(TestServiceUuid, nil)
+	expectServiceRecreatedWithImage(serviceNetwork, TestServiceName2, TestServiceUuid2, previousContainerImageName)
+
+	instruction := add_service.NewAddServices(serviceNetwork, runtime_value_store.NewRuntimeValueStore())
+	serviceConfig1 := fmt.Sprintf("%s(%s=%q)", service_config.ServiceConfigTypeName, service_config.ImageAttr, TestContainerImageName)
+	starlarkCode := fmt.Sprintf(`%s(%s={%q: %s, %q: %s})`, add_service.AddServicesBuiltinName, add_service.ConfigsArgName, TestServiceName, serviceConfig1, TestServiceName2, getServiceConfigWithFailingReadyConditionStr())
+	instructionToExecute, ok := interpretKurtosisPlanInstruction(t, instruction, starlarkCode).(kurtosis_instruction.ConfigurableKurtosisInstruction)
+	require.True(t, ok, "instruction should be configurable")
+
+	_, err := instructionToExecute.ExecuteWithOptions(context.Background(), newApplyExecutionOptions(TestServiceName2))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Some services did not become ready. The entire batch was rolled back")
+}
+
+// newApplyExecutionOptions returns the options of an apply which plan updates the given running service and adds the others
+func newApplyExecutionOptions(updatedServiceName service.ServiceName) *kurtosis_instruction.ExecutionOptions {
+	return kurtosis_instruction.NewExecutionOptions(1, []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(updatedServiceName)),
+	})
+}
+
+func getServiceConfigWithFailingReadyConditionStr() string {
+	recipeStr := fmt.Sprintf(`%s(port_id=%q, endpoint=%q, extract={"key": ".value"})`, recipe.GetHttpRecipeTypeName, readyConditionRecipePortId, readyConditionRecipePath)
+	readyConditionStr := fmt.Sprintf("%s(%s=%s, %s=%q, %s=%q, %s=%d, %s=%q, %s=%q)",
+		service_config.ReadyConditionTypeName,
+		service_config.RecipeAttr, recipeStr,
+		service_config.FieldAttr, readyConditionField,
+		service_config.AssertionAttr, readyConditionAssertion,
+		service_config.TargetAttr, readyConditionTarget,
+		service_config.IntervalAttr, "10ms",
+		service_config.TimeoutAttr, "50ms")
+	return fmt.Sprintf("%s(%s=%q, %s=%s)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.ReadyConditionsAttr, readyConditionStr)
+}
+
+func expectServiceRecreatedWithImage(serviceNetwork *service_network.MockServiceNetwork, serviceName service.ServiceName, serviceUuid service.ServiceUUID, containerImageName string) {
+	serviceNetwork.EXPECT().RecreateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			serviceConfig, found := configs[serviceName]
+			return len(configs) == 1 && found && serviceConfig.GetContainerImageName() == containerImageName
+		}),
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			serviceName: newTestService(serviceName, serviceUuid),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+}
+
+func expectServiceNeverReady(serviceNetwork *service_network.MockServiceNetwork, serviceName service.ServiceName) {
+	serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(serviceName),
+		readyConditionRecipePortId,
+		http.MethodGet,
+		"",
+		readyConditionRecipePath,
+		"",
+	).RunAndReturn(func(context.Context, string, string, string, string, string, string) (*http.Response, error) {
+		return newHttpResponse(http.StatusServiceUnavailable, ""), nil
+	})
+	serviceNetwork.EXPECT().GetServiceLogs(mock.Anything, string(serviceName), mock.Anything).Return([]string{}, nil)
+}
+
+func newTestService(serviceName service.ServiceName, serviceUuid service.ServiceUUID) *service.Service {
+	return service.NewService(service.NewServiceRegistration(serviceName, serviceUuid, TestEnclaveUuid, nil, string(serviceName)), container_status.ContainerStatus_Running, nil, nil, nil)
+}
@@ -0,0 +1,119 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type addServiceWithReadyConditionTestCase struct {
+	*testing.T
+}
+
+func newAddServiceWithReadyConditionTestCase(t *testing.T) *addServiceWithReadyConditionTestCase {
+	return &addServiceWithReadyConditionTestCase{
+		T: t,
+	}
+}
+
+func (t *addServiceWithReadyConditionTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", add_service.AddServiceBuiltinName, "with_ready_condition")
+}
+
+func (t *addServiceWithReadyConditionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		TestServiceName,
+		mock.Anything,
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil),
+		nil,
+	)
+
+	// the service is only ready the second time it's queried
+	serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		readyConditionRecipePortId,
+		http.MethodGet,
+		"",
+		readyConditionRecipePath,
+		"",
+	).Return(newHttpResponse(http.StatusServiceUnavailable, ""), nil).Once()
+	serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		readyConditionRecipePortId,
+		http.MethodGet,
+		"",
+		readyConditionRecipePath,
+		"",
+	).Return(newHttpResponse(http.StatusOK, `{"value": "pong"}`), nil).Once()
+
+	return add_service.NewAddService(serviceNetwork, runtimeValueStore)
+}
+
+func (t *addServiceWithReadyConditionTestCase) GetStarlarkCode() string {
+	recipeStr := fmt.Sprintf(`%s(port_id=%q, endpoint=%q, extract={"key": ".value"})`, recipe.GetHttpRecipeTypeName, readyConditionRecipePortId, readyConditionRecipePath)
+	readyConditionStr := fmt.Sprintf("%s(%s=%s, %s=%q, %s=%q, %s=%d, %s=%q)",
+		service_config.ReadyConditionTypeName,
+		service_config.RecipeAttr, recipeStr,
+		service_config.FieldAttr, readyConditionField,
+		service_config.AssertionAttr, readyConditionAssertion,
+		service_config.TargetAttr, readyConditionTarget,
+		service_config.IntervalAttr, "10ms")
+	serviceConfigStr := fmt.Sprintf("%s(%s=%q, %s=%s)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.ReadyConditionsAttr, readyConditionStr)
+	return fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, TestServiceName, add_service.ServiceConfigArgName, serviceConfigStr)
+}
+
+func (t *addServiceWithReadyConditionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addServiceWithReadyConditionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	serviceObj, ok := interpretationResult.(*kurtosis_types.Service)
+	require.True(t, ok, "interpretation result should be a service")
+	require.NotNil(t, serviceObj)
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", TestServiceName, TestServiceUuid)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
+
+func newHttpResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:           http.StatusText(statusCode),
+		StatusCode:       statusCode,
+		Proto:            "HTTP/1.0",
+		ProtoMajor:       1,
+		ProtoMinor:       0,
+		Header:           nil,
+		Body:             io.NopCloser(strings.NewReader(body)),
+		ContentLength:    -1,
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          nil,
+		TLS:              nil,
+	}
+}
@@ -36,9 +36,9 @@ func (t assertTestCase) GetId() string {
 
 func (t assertTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	runtimeValueStore.SetValue(t.runtimeValueUuid, map[string]starlark.Comparable{
+	require.Nil(t, runtimeValueStore.SetValue(t.runtimeValueUuid, map[string]starlark.Comparable{
 		"value": starlark.String(runtimeValueValue),
-	})
+	}))
 	return assert.NewAssert(runtimeValueStore)
 }
 
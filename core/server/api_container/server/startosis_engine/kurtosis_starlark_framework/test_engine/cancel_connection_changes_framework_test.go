@@ -0,0 +1,52 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/cancel_connection_changes"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type cancelConnectionChangesTestCase struct {
+	*testing.T
+}
+
+func newCancelConnectionChangesTestCase(t *testing.T) *cancelConnectionChangesTestCase {
+	return &cancelConnectionChangesTestCase{
+		T: t,
+	}
+}
+
+func (t *cancelConnectionChangesTestCase) GetId() string {
+	return cancel_connection_changes.CancelConnectionChangesBuiltinName
+}
+
+func (t *cancelConnectionChangesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().CancelConnectionChanges(
+		testConnectionChangesName,
+	).Times(1).Return(
+		nil,
+	)
+
+	return cancel_connection_changes.NewCancelConnectionChanges(serviceNetwork)
+}
+
+func (t *cancelConnectionChangesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)", cancel_connection_changes.CancelConnectionChangesBuiltinName, cancel_connection_changes.NameArgName, testConnectionChangesName)
+}
+
+func (t *cancelConnectionChangesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *cancelConnectionChangesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Cancelled connection changes '%s'", testConnectionChangesName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
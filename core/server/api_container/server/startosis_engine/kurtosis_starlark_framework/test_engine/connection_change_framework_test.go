@@ -0,0 +1,52 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_change"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+type connectionChangeTestCase struct {
+	*testing.T
+}
+
+func newConnectionChangeTestCase(t *testing.T) *connectionChangeTestCase {
+	return &connectionChangeTestCase{
+		T: t,
+	}
+}
+
+func (t *connectionChangeTestCase) GetId() string {
+	return connection_change.ConnectionChangeTypeName
+}
+
+func (t *connectionChangeTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return connection_change.NewConnectionChangeType()
+}
+
+func (t *connectionChangeTestCase) GetStarlarkCode() string {
+	subnetworks := fmt.Sprintf(`(%q, %q)`, TestSubnetwork, TestSubnetwork2)
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=False)",
+		connection_change.ConnectionChangeTypeName,
+		connection_change.OffsetAttr, "1m30s",
+		connection_change.SubnetworksAttr, subnetworks,
+		connection_change.ConfigAttr, "ConnectionConfig(packet_loss_percentage=100.0)",
+		connection_change.BidirectionalAttr)
+}
+
+func (t *connectionChangeTestCase) Assert(typeValue starlark.Value) {
+	connectionChangeStarlark, ok := typeValue.(*connection_change.ConnectionChange)
+	require.True(t, ok)
+	connectionChange, err := connectionChangeStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	blockedConnection := partition_topology.ConnectionBlocked
+	expectedConnectionChange := connection_change_scheduler.NewConnectionChange(90*time.Second, TestSubnetwork, TestSubnetwork2, &blockedConnection, false)
+	require.Equal(t, expectedConnectionChange, connectionChange)
+}
@@ -0,0 +1,62 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/packet_delay_distribution"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type connectionConfigWithNetworkImpairmentsTestCase struct {
+	*testing.T
+}
+
+func newConnectionConfigWithNetworkImpairmentsTestCase(t *testing.T) *connectionConfigWithNetworkImpairmentsTestCase {
+	return &connectionConfigWithNetworkImpairmentsTestCase{
+		T: t,
+	}
+}
+
+func (t *connectionConfigWithNetworkImpairmentsTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", connection_config.ConnectionConfigTypeName, "WithNetworkImpairments")
+}
+
+func (t *connectionConfigWithNetworkImpairmentsTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return connection_config.NewConnectionConfigType()
+}
+
+func (t *connectionConfigWithNetworkImpairmentsTestCase) GetStarlarkCode() string {
+	packetDelayArg := fmt.Sprintf("%s(%s=%d)", packet_delay_distribution.UniformPacketDelayDistributionTypeName, packet_delay_distribution.DelayAttr, 100)
+	return fmt.Sprintf("%s(%s=%s, %s=%d, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s)",
+		connection_config.ConnectionConfigTypeName,
+		connection_config.PacketDelayDistributionAttr, packetDelayArg,
+		connection_config.BandwidthLimitKbitAttr, 1024,
+		connection_config.PacketDuplicationPercentageAttr, "1.0",
+		connection_config.PacketDuplicationCorrelationAttr, "10.0",
+		connection_config.PacketCorruptionPercentageAttr, "2.0",
+		connection_config.PacketCorruptionCorrelationAttr, "20.0",
+		connection_config.PacketReorderingPercentageAttr, "3.0",
+		connection_config.PacketReorderingCorrelationAttr, "30.0",
+	)
+}
+
+func (t *connectionConfigWithNetworkImpairmentsTestCase) Assert(typeValue starlark.Value) {
+	connectionConfigStarlark, ok := typeValue.(*connection_config.ConnectionConfig)
+	require.True(t, ok)
+	connectionConfig, err := connectionConfigStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	expectedConnectionConfig := partition_topology.NewPartitionConnectionWithAllSettings(
+		partition_topology.ConnectionWithNoPacketLoss,
+		partition_topology.NewUniformPacketDelayDistribution(100),
+		partition_topology.NewBandwidthLimit(1024),
+		partition_topology.NewPacketPercentage(1, 10),
+		partition_topology.NewPacketPercentage(2, 20),
+		partition_topology.NewPacketPercentage(3, 30),
+	)
+	require.Equal(t, expectedConnectionConfig, *connectionConfig)
+}
@@ -0,0 +1,82 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	readyConditionRecipePortId = "http-port"
+	readyConditionRecipePath   = "/ping"
+	readyConditionField        = "code"
+	readyConditionAssertion    = "=="
+	readyConditionTarget       = 200
+	readyConditionInterval     = "500ms"
+	readyConditionTimeout      = "30s"
+)
+
+type readyConditionTestCase struct {
+	*testing.T
+}
+
+func newReadyConditionTestCase(t *testing.T) *readyConditionTestCase {
+	return &readyConditionTestCase{
+		T: t,
+	}
+}
+
+func (t *readyConditionTestCase) GetId() string {
+	return service_config.ReadyConditionTypeName
+}
+
+func (t *readyConditionTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return service_config.NewReadyConditionType()
+}
+
+func (t *readyConditionTestCase) GetStarlarkCode() string {
+	recipeStr := fmt.Sprintf(`%s(port_id=%q, endpoint=%q, extract={"key": ".value"})`, recipe.GetHttpRecipeTypeName, readyConditionRecipePortId, readyConditionRecipePath)
+	return fmt.Sprintf("%s(%s=%s, %s=%q, %s=%q, %s=%d, %s=%q, %s=%q)",
+		service_config.ReadyConditionTypeName,
+		service_config.RecipeAttr, recipeStr,
+		service_config.FieldAttr, readyConditionField,
+		service_config.AssertionAttr, readyConditionAssertion,
+		service_config.TargetAttr, readyConditionTarget,
+		service_config.IntervalAttr, readyConditionInterval,
+		service_config.TimeoutAttr, readyConditionTimeout)
+}
+
+func (t *readyConditionTestCase) Assert(typeValue starlark.Value) {
+	readyCondition, ok := typeValue.(*service_config.ReadyCondition)
+	require.True(t, ok)
+
+	readyConditionRecipe, err := readyCondition.GetRecipe()
+	require.Nil(t, err)
+	_, ok = readyConditionRecipe.(*recipe.HttpRequestRecipe)
+	require.True(t, ok)
+
+	field, err := readyCondition.GetField()
+	require.Nil(t, err)
+	require.Equal(t, readyConditionField, field)
+
+	assertion, err := readyCondition.GetAssertion()
+	require.Nil(t, err)
+	require.Equal(t, readyConditionAssertion, assertion)
+
+	target, err := readyCondition.GetTarget()
+	require.Nil(t, err)
+	require.Equal(t, starlark.MakeInt(readyConditionTarget), target)
+
+	interval, err := readyCondition.GetInterval()
+	require.Nil(t, err)
+	require.Equal(t, 500*time.Millisecond, interval)
+
+	timeout, err := readyCondition.GetTimeout()
+	require.Nil(t, err)
+	require.Equal(t, 30*time.Second, timeout)
+}
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type removeConnectionBetweenServicesTestCase struct {
+	*testing.T
+}
+
+func newRemoveConnectionBetweenServicesTestCase(t *testing.T) *removeConnectionBetweenServicesTestCase {
+	return &removeConnectionBetweenServicesTestCase{
+		T: t,
+	}
+}
+
+func (t *removeConnectionBetweenServicesTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", remove_connection.RemoveConnectionBuiltinName, "BetweenServices")
+}
+
+func (t *removeConnectionBetweenServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().UnsetServiceConnection(
+		mock.Anything,
+		TestServiceName,
+		TestServiceName2,
+	).Times(1).Return(nil)
+	return remove_connection.NewRemoveConnection(serviceNetwork)
+}
+
+func (t *removeConnectionBetweenServicesTestCase) GetStarlarkCode() string {
+	services := fmt.Sprintf("(%q, %q)", TestServiceName, TestServiceName2)
+	return fmt.Sprintf("%s(%s=%s)", remove_connection.RemoveConnectionBuiltinName, remove_connection.ServicesArgName, services)
+}
+
+func (t *removeConnectionBetweenServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *removeConnectionBetweenServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Removed connection override between services '%s' and '%s'", TestServiceName, TestServiceName2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type removeConnectionDirectionalTestCase struct {
+	*testing.T
+}
+
+func newRemoveConnectionDirectionalTestCase(t *testing.T) *removeConnectionDirectionalTestCase {
+	return &removeConnectionDirectionalTestCase{
+		T: t,
+	}
+}
+
+func (t *removeConnectionDirectionalTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", remove_connection.RemoveConnectionBuiltinName, "FromOneSubnetworkToAnother")
+}
+
+func (t *removeConnectionDirectionalTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().UnsetDirectionalConnection(
+		mock.Anything,
+		TestSubnetwork,
+		TestSubnetwork2,
+	).Times(1).Return(nil)
+	return remove_connection.NewRemoveConnection(serviceNetwork)
+}
+
+func (t *removeConnectionDirectionalTestCase) GetStarlarkCode() string {
+	subnetworks := fmt.Sprintf("(%q, %q)", TestSubnetwork, TestSubnetwork2)
+	return fmt.Sprintf("%s(%s=%s, %s=False)", remove_connection.RemoveConnectionBuiltinName, remove_connection.SubnetworksArgName, subnetworks, remove_connection.BidirectionalArgName)
+}
+
+func (t *removeConnectionDirectionalTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *removeConnectionDirectionalTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Removed subnetwork connection override from '%s' to '%s'", TestSubnetwork, TestSubnetwork2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,67 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/schedule_connection_changes"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	testConnectionChangesName = "test-scenario"
+)
+
+type scheduleConnectionChangesTestCase struct {
+	*testing.T
+}
+
+func newScheduleConnectionChangesTestCase(t *testing.T) *scheduleConnectionChangesTestCase {
+	return &scheduleConnectionChangesTestCase{
+		T: t,
+	}
+}
+
+func (t *scheduleConnectionChangesTestCase) GetId() string {
+	return schedule_connection_changes.ScheduleConnectionChangesBuiltinName
+}
+
+func (t *scheduleConnectionChangesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	blockedConnection := partition_topology.ConnectionBlocked
+	expectedChanges := []*connection_change_scheduler.ConnectionChange{
+		connection_change_scheduler.NewConnectionChange(10*time.Second, TestSubnetwork, TestSubnetwork2, &blockedConnection, true),
+		connection_change_scheduler.NewConnectionChange(time.Minute, TestSubnetwork, TestSubnetwork2, nil, true),
+	}
+	serviceNetwork.EXPECT().ScheduleConnectionChanges(
+		testConnectionChangesName,
+		expectedChanges,
+	).Times(1).Return(
+		nil,
+	)
+
+	return schedule_connection_changes.NewScheduleConnectionChanges(serviceNetwork)
+}
+
+func (t *scheduleConnectionChangesTestCase) GetStarlarkCode() string {
+	subnetworks := fmt.Sprintf(`(%q, %q)`, TestSubnetwork, TestSubnetwork2)
+	changes := fmt.Sprintf(`[ConnectionChange(offset="10s", subnetworks=%s, config=ConnectionConfig(packet_loss_percentage=100.0)), ConnectionChange(offset="1m", subnetworks=%s)]`, subnetworks, subnetworks)
+	return fmt.Sprintf("%s(%s=%q, %s=%s)", schedule_connection_changes.ScheduleConnectionChangesBuiltinName, schedule_connection_changes.NameArgName, testConnectionChangesName, schedule_connection_changes.ChangesArgName, changes)
+}
+
+func (t *scheduleConnectionChangesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *scheduleConnectionChangesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Scheduled 2 connection changes under the name '%s'", testConnectionChangesName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
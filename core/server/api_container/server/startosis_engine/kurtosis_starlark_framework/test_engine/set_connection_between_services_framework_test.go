@@ -0,0 +1,59 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type setConnectionBetweenServicesTestCase struct {
+	*testing.T
+}
+
+func newSetConnectionBetweenServicesTestCase(t *testing.T) *setConnectionBetweenServicesTestCase {
+	return &setConnectionBetweenServicesTestCase{
+		T: t,
+	}
+}
+
+func (t *setConnectionBetweenServicesTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", set_connection.SetConnectionBuiltinName, "BetweenServices")
+}
+
+func (t *setConnectionBetweenServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().SetServiceConnection(
+		mock.Anything,
+		TestServiceName,
+		TestServiceName2,
+		partition_topology.ConnectionBlocked,
+	).Times(1).Return(
+		nil,
+	)
+
+	return set_connection.NewSetConnection(serviceNetwork)
+}
+
+func (t *setConnectionBetweenServicesTestCase) GetStarlarkCode() string {
+	connectionConfig := "ConnectionConfig(packet_loss_percentage=100.0)"
+	services := fmt.Sprintf(`(%q, %q)`, TestServiceName, TestServiceName2)
+	return fmt.Sprintf("%s(%s=%s, %s=%s)", set_connection.SetConnectionBuiltinName, set_connection.ServicesArgName, services, set_connection.ConnectionConfigArgName, connectionConfig)
+}
+
+func (t *setConnectionBetweenServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setConnectionBetweenServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Configured connection between services '%s' and '%s'", TestServiceName, TestServiceName2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,59 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type setConnectionDirectionalTestCase struct {
+	*testing.T
+}
+
+func newSetConnectionDirectionalTestCase(t *testing.T) *setConnectionDirectionalTestCase {
+	return &setConnectionDirectionalTestCase{
+		T: t,
+	}
+}
+
+func (t *setConnectionDirectionalTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", set_connection.SetConnectionBuiltinName, "FromOneSubnetworkToAnother")
+}
+
+func (t *setConnectionDirectionalTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().SetDirectionalConnection(
+		mock.Anything,
+		TestSubnetwork,
+		TestSubnetwork2,
+		partition_topology.ConnectionBlocked,
+	).Times(1).Return(
+		nil,
+	)
+
+	return set_connection.NewSetConnection(serviceNetwork)
+}
+
+func (t *setConnectionDirectionalTestCase) GetStarlarkCode() string {
+	connectionConfig := "ConnectionConfig(packet_loss_percentage=100.0)"
+	subnetworks := fmt.Sprintf(`(%q, %q)`, TestSubnetwork, TestSubnetwork2)
+	return fmt.Sprintf("%s(%s=%s, %s=%s, %s=False)", set_connection.SetConnectionBuiltinName, set_connection.SubnetworksArgName, subnetworks, set_connection.ConnectionConfigArgName, connectionConfig, set_connection.BidirectionalArgName)
+}
+
+func (t *setConnectionDirectionalTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setConnectionDirectionalTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Configured subnetwork connection from '%s' to '%s'", TestSubnetwork, TestSubnetwork2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
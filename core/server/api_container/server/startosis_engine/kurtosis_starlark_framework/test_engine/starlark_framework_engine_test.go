@@ -22,21 +22,29 @@ const (
 
 func TestAllRegisteredBuiltins(t *testing.T) {
 	testKurtosisPlanInstruction(t, newAddServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newAddServiceWithReadyConditionTestCase(t))
 	testKurtosisPlanInstruction(t, newAddServicesTestCase(t))
 	testKurtosisPlanInstruction(t, newAssertTestCase(t))
+	testKurtosisPlanInstruction(t, newCancelConnectionChangesTestCase(t))
 	testKurtosisPlanInstruction(t, newExecTestCase1(t))
 	testKurtosisPlanInstruction(t, newExecTestCase2(t))
 	testKurtosisPlanInstruction(t, newExecTestCase3(t))
 	testKurtosisPlanInstruction(t, newSetConnectionTestCase(t))
+	testKurtosisPlanInstruction(t, newSetConnectionBetweenServicesTestCase(t))
 	testKurtosisPlanInstruction(t, newSetConnectionDefaultTestCase(t))
+	testKurtosisPlanInstruction(t, newSetConnectionDirectionalTestCase(t))
 	testKurtosisPlanInstruction(t, newPrintTestCase(t))
 	testKurtosisPlanInstruction(t, newRemoveConnectionTestCase(t))
+	testKurtosisPlanInstruction(t, newRemoveConnectionBetweenServicesTestCase(t))
+	testKurtosisPlanInstruction(t, newRemoveConnectionDirectionalTestCase(t))
 	testKurtosisPlanInstruction(t, newRemoveServiceTestCase(t))
 	testKurtosisPlanInstruction(t, newRenderSingleTemplateTestCase(t))
 	testKurtosisPlanInstruction(t, newRenderMultipleTemplatesTestCase(t))
 	testKurtosisPlanInstruction(t, newRequestTestCase1(t))
 	testKurtosisPlanInstruction(t, newRequestTestCase2(t))
 	testKurtosisPlanInstruction(t, newRequestTestCase3(t))
+	testKurtosisPlanInstruction(t, newRestartServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newScheduleConnectionChangesTestCase(t))
 	testKurtosisPlanInstruction(t, newStoreServiceFilesTestCase(t))
 	testKurtosisPlanInstruction(t, newStoreServiceFilesWithoutNameTestCase(t))
 	testKurtosisPlanInstruction(t, newUpdateServiceTestCase(t))
@@ -49,17 +57,21 @@ func TestAllRegisteredBuiltins(t *testing.T) {
 	testKurtosisHelper(t, newReadFileTestCase(t))
 	testKurtosisHelper(t, newImportModuleTestCase(t))
 
+	testKurtosisTypeConstructor(t, newConnectionChangeTestCase(t))
 	testKurtosisTypeConstructor(t, newConnectionConfigFullTestCase(t))
 	testKurtosisTypeConstructor(t, newConnectionConfigWithPacketDelayTestCase(t))
 	testKurtosisTypeConstructor(t, newConnectionConfigWithPacketLossTestCase(t))
+	testKurtosisTypeConstructor(t, newConnectionConfigWithNetworkImpairmentsTestCase(t))
 	testKurtosisTypeConstructor(t, newNormalPacketDelayDistributionFullTestCase(t))
 	testKurtosisTypeConstructor(t, newNormalPacketDelayDistributionMinimalTestCase(t))
 	testKurtosisTypeConstructor(t, newPortSpecFullTestCase(t))
 	testKurtosisTypeConstructor(t, newPortSpecMinimalTestCase(t))
+	testKurtosisTypeConstructor(t, newReadyConditionTestCase(t))
 	testKurtosisTypeConstructor(t, newServiceConfigMinimalTestCase(t))
 	testKurtosisTypeConstructor(t, newServiceConfigFullTestCase(t))
 	testKurtosisTypeConstructor(t, newUniformPacketDelayDistributionTestCase(t))
 	testKurtosisTypeConstructor(t, newUpdateServiceConfigTestCase(t))
+	testKurtosisTypeConstructor(t, newUpdateServiceConfigFullTestCase(t))
 }
 
 func testKurtosisPlanInstruction(t *testing.T, builtin KurtosisPlanInstructionBaseTest) {
@@ -82,7 +94,7 @@ func testKurtosisPlanInstruction(t *testing.T, builtin KurtosisPlanInstructionBa
 	instructionToExecute := instructionQueue[0]
 
 	// execute the instruction and run custom builtin assertions
-	executionResult, err := instructionToExecute.Execute(context.WithValue(context.Background(), "PARALLELISM", 1))
+	executionResult, err := instructionToExecute.Execute(context.Background())
 	require.Nil(t, err, "Builtin execution threw an error: \n%v", err)
 	builtin.Assert(interpretationResult, executionResult)
 
@@ -97,6 +109,22 @@ func testKurtosisPlanInstruction(t *testing.T, builtin KurtosisPlanInstructionBa
 	require.Equal(t, starlarkCodeForAssertion, serializedInstruction)
 }
 
+// interpretKurtosisPlanInstruction interprets the Starlark code calling the instruction and returns the instruction
+// it added to the plan, for tests that need to execute it another way than testKurtosisPlanInstruction does
+func interpretKurtosisPlanInstruction(t *testing.T, instruction *kurtosis_plan_instruction.KurtosisPlanInstruction, starlarkCode string) kurtosis_instruction.KurtosisInstruction {
+	var instructionQueue []kurtosis_instruction.KurtosisInstruction
+	thread := newStarlarkThread("framework-testing-engine")
+
+	predeclared := getBasePredeclaredDict(t)
+	instructionWrapper := kurtosis_plan_instruction.NewKurtosisPlanInstructionWrapper(instruction, &instructionQueue)
+	predeclared[instructionWrapper.GetName()] = starlark.NewBuiltin(instructionWrapper.GetName(), instructionWrapper.CreateBuiltin())
+
+	_, err := starlark.ExecFile(thread, startosis_constants.PackageIdPlaceholderForStandaloneScript, codeToExecute(starlarkCode), predeclared)
+	require.Nil(t, err, "Error interpreting Starlark code for instruction '%s'", instruction.GetName())
+	require.Len(t, instructionQueue, 1)
+	return instructionQueue[0]
+}
+
 func testKurtosisHelper(t *testing.T, builtin KurtosisHelperBaseTest) {
 	testId := builtin.GetId()
 	thread := newStarlarkThread("framework-testing-engine")
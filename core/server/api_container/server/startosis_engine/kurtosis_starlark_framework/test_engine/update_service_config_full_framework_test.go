@@ -0,0 +1,77 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/update_service_config"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type updateServiceConfigFullTestCase struct {
+	*testing.T
+}
+
+func newUpdateServiceConfigFullTestCase(t *testing.T) *updateServiceConfigFullTestCase {
+	return &updateServiceConfigFullTestCase{
+		T: t,
+	}
+}
+
+func (t *updateServiceConfigFullTestCase) GetId() string {
+	return update_service_config.UpdateServiceConfigTypeName
+}
+
+func (t *updateServiceConfigFullTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return update_service_config.NewUpdateServiceConfigType()
+}
+
+func (t *updateServiceConfigFullTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%d, %s=%d)",
+		update_service_config.UpdateServiceConfigTypeName,
+		update_service_config.SubnetworkAttr, TestSubnetwork,
+		update_service_config.ImageAttr, TestContainerImageName,
+		update_service_config.PortsAttr, fmt.Sprintf("{%q: PortSpec(number=%d, transport_protocol=%q, application_protocol=%q)}", TestPrivatePortId, TestPrivatePortNumber, TestPrivatePortProtocolStr, TestPrivateApplicationProtocol),
+		update_service_config.FilesAttr, fmt.Sprintf("{%q: %q, %q: %q}", TestFilesArtifactPath1, TestFilesArtifactName1, TestFilesArtifactPath2, TestFilesArtifactName2),
+		update_service_config.EntrypointAttr, fmt.Sprintf("[%q, %q]", TestEntryPointSlice[0], TestEntryPointSlice[1]),
+		update_service_config.CmdAttr, fmt.Sprintf("[%q, %q, %q]", TestCmdSlice[0], TestCmdSlice[1], TestCmdSlice[2]),
+		update_service_config.EnvVarsAttr, fmt.Sprintf("{%q: %q, %q: %q}", TestEnvVarName1, TestEnvVarValue1, TestEnvVarName2, TestEnvVarValue2),
+		update_service_config.CpuAllocationAttr, TestCpuAllocation,
+		update_service_config.MemoryAllocationAttr, TestMemoryAllocation,
+	)
+}
+
+func (t *updateServiceConfigFullTestCase) Assert(typeValue starlark.Value) {
+	updateServiceConfigStarlark, ok := typeValue.(*update_service_config.UpdateServiceConfig)
+	require.True(t, ok)
+	updateServiceConfig, err := updateServiceConfigStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	subnetwork := string(TestSubnetwork)
+	containerImageName := TestContainerImageName
+	cpuAllocation := TestCpuAllocation
+	memoryAllocation := TestMemoryAllocation
+	expectedUpdateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:         &subnetwork,
+		ContainerImageName: &containerImageName,
+		PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			TestPrivatePortId: binding_constructors.NewPort(TestPrivatePortNumber, TestPrivatePortProtocol, TestPrivateApplicationProtocol),
+		},
+		EntrypointArgs: TestEntryPointSlice,
+		CmdArgs:        TestCmdSlice,
+		EnvVars: map[string]string{
+			TestEnvVarName1: TestEnvVarValue1,
+			TestEnvVarName2: TestEnvVarValue2,
+		},
+		FilesArtifactMountpoints: map[string]string{
+			TestFilesArtifactPath1: TestFilesArtifactName1,
+			TestFilesArtifactPath2: TestFilesArtifactName2,
+		},
+		CpuAllocationMillicpus:    &cpuAllocation,
+		MemoryAllocationMegabytes: &memoryAllocation,
+	}
+	require.Equal(t, expectedUpdateServiceConfig, updateServiceConfig)
+}
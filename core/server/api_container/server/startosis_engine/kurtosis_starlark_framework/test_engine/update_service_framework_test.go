@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/update_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
@@ -53,7 +54,7 @@ func (t *updateServiceTestCase) GetInstruction() *kurtosis_plan_instruction.Kurt
 		nil,
 	)
 
-	return update_service.NewUpdateService(serviceNetwork)
+	return update_service.NewUpdateService(serviceNetwork, runtime_value_store.NewRuntimeValueStore())
 }
 
 func (t *updateServiceTestCase) GetStarlarkCode() string {
@@ -0,0 +1,136 @@
+package connection_change
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/connection_change_scheduler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	ConnectionChangeTypeName = "ConnectionChange"
+
+	OffsetAttr        = "offset"
+	SubnetworksAttr   = "subnetworks"
+	ConfigAttr        = "config"
+	BidirectionalAttr = "bidirectional"
+
+	defaultIsBidirectional = true
+)
+
+func NewConnectionChangeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ConnectionChangeTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              OffsetAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, OffsetAttr)
+					},
+				},
+				{
+					Name:              SubnetworksAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
+					Validator:         nil,
+				},
+				{
+					Name:              ConfigAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*connection_config.ConnectionConfig],
+					Validator:         nil,
+				},
+				{
+					Name:              BidirectionalAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (kurtosis_type_constructor.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ConnectionChangeTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &ConnectionChange{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ConnectionChange is a step of the timeline passed to schedule_connection_changes. When no config is provided, the
+// step removes the connection override between the two subnetworks
+type ConnectionChange struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (connectionChange *ConnectionChange) ToKurtosisType() (*connection_change_scheduler.ConnectionChange, *startosis_errors.InterpretationError) {
+	offsetStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		connectionChange.KurtosisValueTypeDefault, OffsetAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required argument '%s' could not be found on '%s'", OffsetAttr, ConnectionChangeTypeName)
+	}
+	offset, err := time.ParseDuration(offsetStarlark.GoString())
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Argument '%s' on '%s' is not a valid duration", OffsetAttr, ConnectionChangeTypeName)
+	}
+	if offset < 0 {
+		return nil, startosis_errors.NewInterpretationError("Argument '%s' on '%s' cannot be negative, got '%s'", OffsetAttr, ConnectionChangeTypeName, offsetStarlark.GoString())
+	}
+
+	subnetworksStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Tuple](
+		connectionChange.KurtosisValueTypeDefault, SubnetworksAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required argument '%s' could not be found on '%s'", SubnetworksAttr, ConnectionChangeTypeName)
+	}
+	subnetwork1, subnetwork2, interpretationErr := shared_helpers.ParseSubnetworks(SubnetworksAttr, subnetworksStarlark)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	connectionConfigStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*connection_config.ConnectionConfig](
+		connectionChange.KurtosisValueTypeDefault, ConfigAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	var connection *partition_topology.PartitionConnection
+	if found {
+		connection, interpretationErr = connectionConfigStarlark.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	isBidirectional := defaultIsBidirectional
+	isBidirectionalStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		connectionChange.KurtosisValueTypeDefault, BidirectionalAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		isBidirectional = bool(isBidirectionalStarlark)
+	}
+
+	return connection_change_scheduler.NewConnectionChange(offset, subnetwork1, subnetwork2, connection, isBidirectional), nil
+}
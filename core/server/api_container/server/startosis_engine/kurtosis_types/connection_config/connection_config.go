@@ -8,13 +8,21 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/packet_delay_distribution"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
+	"math"
 )
 
 const (
 	ConnectionConfigTypeName = "ConnectionConfig"
 
-	PacketLossPercentageAttr    = "packet_loss_percentage"
-	PacketDelayDistributionAttr = "packet_delay_distribution"
+	PacketLossPercentageAttr         = "packet_loss_percentage"
+	PacketDelayDistributionAttr      = "packet_delay_distribution"
+	BandwidthLimitKbitAttr           = "bandwidth_limit_kbit"
+	PacketDuplicationPercentageAttr  = "packet_duplication_percentage"
+	PacketDuplicationCorrelationAttr = "packet_duplication_correlation"
+	PacketCorruptionPercentageAttr   = "packet_corruption_percentage"
+	PacketCorruptionCorrelationAttr  = "packet_corruption_correlation"
+	PacketReorderingPercentageAttr   = "packet_reordering_percentage"
+	PacketReorderingCorrelationAttr  = "packet_reordering_correlation"
 )
 
 func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -37,6 +45,20 @@ func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructo
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[packet_delay_distribution.PacketDelayDistribution],
 					Validator:         nil,
 				},
+				{
+					Name:              BandwidthLimitKbitAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, BandwidthLimitKbitAttr, 0, math.MaxUint32)
+					},
+				},
+				newPercentageArgument(PacketDuplicationPercentageAttr),
+				newPercentageArgument(PacketDuplicationCorrelationAttr),
+				newPercentageArgument(PacketCorruptionPercentageAttr),
+				newPercentageArgument(PacketCorruptionCorrelationAttr),
+				newPercentageArgument(PacketReorderingPercentageAttr),
+				newPercentageArgument(PacketReorderingCorrelationAttr),
 			},
 		},
 
@@ -62,6 +84,13 @@ func CreateConnectionConfig(packetLossPercentage starlark.Float) (*ConnectionCon
 	args := []starlark.Value{
 		packetLossPercentage,
 		nil, // no delay distribution as we don't need it
+		nil, // no bandwidth limit
+		nil, // no packet duplication
+		nil,
+		nil, // no packet corruption
+		nil,
+		nil, // no packet reordering
+		nil,
 	}
 	argumentDefinitions := NewConnectionConfigType().KurtosisBaseBuiltin.Arguments
 	argumentValuesSet := builtin_argument.NewArgumentValuesSet(argumentDefinitions, args)
@@ -98,9 +127,70 @@ func (connectionConfig *ConnectionConfig) ToKurtosisType() (*partition_topology.
 	} else {
 		packetDelayDistribution = partition_topology.NewUniformPacketDelayDistribution(0)
 	}
-	partitionConnection := partition_topology.NewPartitionConnection(
+
+	bandwidthLimitStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		connectionConfig.KurtosisValueTypeDefault, BandwidthLimitKbitAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	var bandwidthLimitKbit uint64
+	if found {
+		var ok bool
+		if bandwidthLimitKbit, ok = bandwidthLimitStarlark.Uint64(); !ok {
+			return nil, startosis_errors.NewInterpretationError("Argument '%s' on '%s' was out of bounds", BandwidthLimitKbitAttr, ConnectionConfigTypeName)
+		}
+	}
+
+	packetDuplication, interpretationErr := connectionConfig.extractPacketPercentage(PacketDuplicationPercentageAttr, PacketDuplicationCorrelationAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	packetCorruption, interpretationErr := connectionConfig.extractPacketPercentage(PacketCorruptionPercentageAttr, PacketCorruptionCorrelationAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	packetReordering, interpretationErr := connectionConfig.extractPacketPercentage(PacketReorderingPercentageAttr, PacketReorderingCorrelationAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	// netem can only reorder packets which are delayed, the other packets being sent immediately
+	if packetReordering.IsSet() && !packetDelayDistribution.IsSet() {
+		return nil, startosis_errors.NewInterpretationError("'%s' requires '%s' to be set on '%s', as only delayed packets can be reordered",
+			PacketReorderingPercentageAttr, PacketDelayDistributionAttr, ConnectionConfigTypeName)
+	}
+
+	partitionConnection := partition_topology.NewPartitionConnectionWithAllSettings(
 		partition_topology.NewPacketLoss(packetLossPct),
 		packetDelayDistribution,
+		partition_topology.NewBandwidthLimit(uint32(bandwidthLimitKbit)),
+		packetDuplication,
+		packetCorruption,
+		packetReordering,
 	)
 	return &partitionConnection, nil
 }
+
+func (connectionConfig *ConnectionConfig) extractPacketPercentage(percentageAttr string, correlationAttr string) (partition_topology.PacketPercentage, *startosis_errors.InterpretationError) {
+	percentageStarlark, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Float](
+		connectionConfig.KurtosisValueTypeDefault, percentageAttr)
+	if interpretationErr != nil {
+		return partition_topology.PacketPercentage{}, interpretationErr
+	}
+	correlationStarlark, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Float](
+		connectionConfig.KurtosisValueTypeDefault, correlationAttr)
+	if interpretationErr != nil {
+		return partition_topology.PacketPercentage{}, interpretationErr
+	}
+	return partition_topology.NewPacketPercentage(float32(percentageStarlark), float32(correlationStarlark)), nil
+}
+
+func newPercentageArgument(attrName string) *builtin_argument.BuiltinArgument {
+	return &builtin_argument.BuiltinArgument{
+		Name:              attrName,
+		IsOptional:        true,
+		ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Float],
+		Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+			return builtin_argument.FloatInRange(value, attrName, 0, 100)
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package connection_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+func TestToKurtosisType_ReorderingWithoutDelayFails(t *testing.T) {
+	args := []starlark.Value{
+		nil,
+		nil, // no delay distribution
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		starlark.Float(10), // packet reordering percentage
+		nil,
+	}
+	argumentValuesSet := builtin_argument.NewArgumentValuesSet(NewConnectionConfigType().KurtosisBaseBuiltin.Arguments, args)
+	kurtosisDefaultValue, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ConnectionConfigTypeName, argumentValuesSet)
+	require.Nil(t, interpretationErr)
+	connectionConfig := &ConnectionConfig{
+		KurtosisValueTypeDefault: kurtosisDefaultValue,
+	}
+
+	_, interpretationErr = connectionConfig.ToKurtosisType()
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), PacketReorderingPercentageAttr)
+}
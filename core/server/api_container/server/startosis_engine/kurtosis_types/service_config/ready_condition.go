@@ -0,0 +1,190 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/assert"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	ReadyConditionTypeName = "ReadyCondition"
+
+	RecipeAttr    = "recipe"
+	FieldAttr     = "field"
+	AssertionAttr = "assertion"
+	TargetAttr    = "target_value"
+	IntervalAttr  = "interval"
+	TimeoutAttr   = "timeout"
+
+	defaultReadyConditionInterval = 1 * time.Second
+	defaultReadyConditionTimeout  = 15 * time.Minute
+)
+
+func NewReadyConditionType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ReadyConditionTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              RecipeAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateRecipe,
+				},
+				{
+					Name:              FieldAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, FieldAttr)
+					},
+				},
+				{
+					Name:              AssertionAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         assert.ValidateAssertionToken,
+				},
+				{
+					Name:              TargetAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+				{
+					Name:              IntervalAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, IntervalAttr)
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateReadyCondition,
+	}
+}
+
+func instantiateReadyCondition(arguments *builtin_argument.ArgumentValuesSet) (kurtosis_type_constructor.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ReadyConditionTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	readyCondition := &ReadyCondition{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}
+
+	assertion, interpretationErr := readyCondition.GetAssertion()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	target, interpretationErr := readyCondition.GetTarget()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if _, ok := target.(starlark.Iterable); (assertion == assert.InCollectionAssertionToken || assertion == assert.NotInCollectionAssertionToken) && !ok {
+		return nil, startosis_errors.NewInterpretationError("'%v' assertion requires an iterable for '%s', got '%v'", assertion, TargetAttr, target.Type())
+	}
+	return readyCondition, nil
+}
+
+// ReadyCondition is a starlark.Value that represents the condition a service has to satisfy to be considered ready.
+// The recipe is run against the service until the assertion on the value of the field passes, or the timeout is reached
+type ReadyCondition struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (readyCondition *ReadyCondition) GetRecipe() (recipe.Recipe, *startosis_errors.InterpretationError) {
+	httpRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*recipe.HttpRequestRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
+	if interpretationErr == nil && found {
+		return httpRecipe, nil
+	}
+	execRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*recipe.ExecRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", RecipeAttr, ReadyConditionTypeName)
+	}
+	return execRecipe, nil
+}
+
+func (readyCondition *ReadyCondition) GetField() (string, *startosis_errors.InterpretationError) {
+	field, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, FieldAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", FieldAttr, ReadyConditionTypeName)
+	}
+	return field.GoString(), nil
+}
+
+func (readyCondition *ReadyCondition) GetAssertion() (string, *startosis_errors.InterpretationError) {
+	assertion, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, AssertionAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", AssertionAttr, ReadyConditionTypeName)
+	}
+	return assertion.GoString(), nil
+}
+
+func (readyCondition *ReadyCondition) GetTarget() (starlark.Comparable, *startosis_errors.InterpretationError) {
+	target, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Comparable](readyCondition.KurtosisValueTypeDefault, TargetAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", TargetAttr, ReadyConditionTypeName)
+	}
+	return target, nil
+}
+
+func (readyCondition *ReadyCondition) GetInterval() (time.Duration, *startosis_errors.InterpretationError) {
+	return readyCondition.getDurationAttr(IntervalAttr, defaultReadyConditionInterval)
+}
+
+func (readyCondition *ReadyCondition) GetTimeout() (time.Duration, *startosis_errors.InterpretationError) {
+	return readyCondition.getDurationAttr(TimeoutAttr, defaultReadyConditionTimeout)
+}
+
+func (readyCondition *ReadyCondition) getDurationAttr(attrName string, defaultValue time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
+	durationStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(durationStarlark.GoString())
+	if err != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing attribute '%s' with value '%s' as a duration", attrName, durationStarlark.GoString())
+	}
+	return duration, nil
+}
+
+func validateRecipe(value starlark.Value) *startosis_errors.InterpretationError {
+	switch value.(type) {
+	case *recipe.HttpRequestRecipe, *recipe.ExecRecipe:
+		return nil
+	default:
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a '%s', '%s' or '%s', but it was '%s'", RecipeAttr, recipe.GetHttpRecipeTypeName, recipe.PostHttpRecipeTypeName, recipe.ExecRecipeName, value.Type())
+	}
+}
@@ -3,6 +3,7 @@ package service_config
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
@@ -25,8 +26,10 @@ const (
 	EnvVarsAttr                     = "env_vars"
 	PrivateIpAddressPlaceholderAttr = "private_ip_address_placeholder"
 	SubnetworkAttr                  = "subnetwork"
+	HostnamesAttr                   = "hostnames"
 	CpuAllocationAttr               = "cpu_allocation"
 	MemoryAllocationAttr            = "memory_allocation"
+	ReadyConditionsAttr             = "ready_conditions"
 )
 
 func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -95,6 +98,12 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.NonEmptyString(value, SubnetworkAttr)
 					},
 				},
+				{
+					Name:              HostnamesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         validateHostnames,
+				},
 				{
 					Name:              CpuAllocationAttr,
 					IsOptional:        true,
@@ -111,6 +120,12 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.Uint64InRange(value, MemoryAllocationAttr, 6, math.MaxUint64)
 					},
 				},
+				{
+					Name:              ReadyConditionsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*ReadyCondition],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -152,7 +167,7 @@ func (config *ServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.S
 	if found && portsStarlark.Len() > 0 {
 		ports := make(map[string]*kurtosis_core_rpc_api_bindings.Port, portsStarlark.Len())
 		for _, portItem := range portsStarlark.Items() {
-			portKey, portValue, interpretationError := convertPortMapEntry(PortsAttr, portItem[0], portItem[1], portsStarlark)
+			portKey, portValue, interpretationError := ConvertPortMapEntry(PortsAttr, portItem[0], portItem[1], portsStarlark)
 			if interpretationError != nil {
 				return nil, interpretationError
 			}
@@ -168,7 +183,7 @@ func (config *ServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.S
 	if found && publicPortsStarlark.Len() > 0 {
 		publicPorts := make(map[string]*kurtosis_core_rpc_api_bindings.Port, publicPortsStarlark.Len())
 		for _, portItem := range publicPortsStarlark.Items() {
-			portKey, portValue, interpretationError := convertPortMapEntry(PublicPortsAttr, portItem[0], portItem[1], publicPortsStarlark)
+			portKey, portValue, interpretationError := ConvertPortMapEntry(PublicPortsAttr, portItem[0], portItem[1], publicPortsStarlark)
 			if interpretationError != nil {
 				return nil, interpretationError
 			}
@@ -241,6 +256,18 @@ func (config *ServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.S
 		builder.WithSubnetwork(subnetworkStarlark.GoString())
 	}
 
+	hostnamesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, HostnamesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && hostnamesStarlark.Len() > 0 {
+		hostnames, interpretationErr := kurtosis_types.SafeCastToStringSlice(hostnamesStarlark, HostnamesAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builder.WithHostnames(hostnames)
+	}
+
 	cpuAllocationStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, CpuAllocationAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
@@ -268,7 +295,34 @@ func (config *ServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.S
 	return builder.Build(), nil
 }
 
-func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *kurtosis_core_rpc_api_bindings.Port, *startosis_errors.InterpretationError) {
+func validateHostnames(value starlark.Value) *startosis_errors.InterpretationError {
+	hostnames, interpretationErr := kurtosis_types.SafeCastToStringSlice(value, HostnamesAttr)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	for _, hostname := range hostnames {
+		if interpretationErr = builtin_argument.StringRegexp(starlark.String(hostname), HostnamesAttr, service_network.ServiceHostnameRegex); interpretationErr != nil {
+			return interpretationErr
+		}
+	}
+	return nil
+}
+
+// GetReadyCondition returns the condition the service has to satisfy to be considered ready, or nil if it has none.
+// It is not part of the API service config as it is checked by the instructions starting the service
+func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_errors.InterpretationError) {
+	readyCondition, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*ReadyCondition](config.KurtosisValueTypeDefault, ReadyConditionsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+	return readyCondition, nil
+}
+
+// ConvertPortMapEntry converts an entry of a Starlark dictionary of ports to its port ID & API port
+func ConvertPortMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *kurtosis_core_rpc_api_bindings.Port, *startosis_errors.InterpretationError) {
 	keyStr, ok := key.(starlark.String)
 	if !ok {
 		return "", nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, dictForLogging)
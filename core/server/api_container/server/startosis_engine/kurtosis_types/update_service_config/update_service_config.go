@@ -2,17 +2,28 @@ package update_service_config
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
+	"math"
 )
 
 const (
 	UpdateServiceConfigTypeName = "UpdateServiceConfig"
-	SubnetworkAttr              = "subnetwork"
+
+	SubnetworkAttr       = "subnetwork"
+	ImageAttr            = "image"
+	PortsAttr            = "ports"
+	FilesAttr            = "files"
+	EntrypointAttr       = "entrypoint"
+	CmdAttr              = "cmd"
+	EnvVarsAttr          = "env_vars"
+	CpuAllocationAttr    = "cpu_allocation"
+	MemoryAllocationAttr = "memory_allocation"
 )
 
 func NewUpdateServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -23,12 +34,66 @@ func NewUpdateServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstru
 			Arguments: []*builtin_argument.BuiltinArgument{
 				{
 					Name:              SubnetworkAttr,
-					IsOptional:        false,
+					IsOptional:        true,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
 						return builtin_argument.NonEmptyString(value, SubnetworkAttr)
 					},
 				},
+				{
+					Name:              ImageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageAttr)
+					},
+				},
+				{
+					Name:              PortsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              FilesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              EntrypointAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              CmdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              EnvVarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              CpuAllocationAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, CpuAllocationAttr, 0, math.MaxUint64)
+					},
+				},
+				{
+					Name:              MemoryAllocationAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MemoryAllocationAttr, 6, math.MaxUint64)
+					},
+				},
 			},
 		},
 
@@ -46,19 +111,130 @@ func instantiate(arguments *builtin_argument.ArgumentValuesSet) (kurtosis_type_c
 	}, nil
 }
 
+// UpdateServiceConfig is a starlark.Value that represents the changes to apply to a service in the update_service
+// instruction. Only the attributes that are set get updated
 type UpdateServiceConfig struct {
 	*kurtosis_type_constructor.KurtosisValueTypeDefault
 }
 
 func (config *UpdateServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.UpdateServiceConfig, *startosis_errors.InterpretationError) {
-	subnetworkStarlarkValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
-		config.KurtosisValueTypeDefault, SubnetworkAttr)
+	updateServiceConfig := &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:                nil,
+		ContainerImageName:        nil,
+		PrivatePorts:              nil,
+		EntrypointArgs:            nil,
+		CmdArgs:                   nil,
+		EnvVars:                   nil,
+		FilesArtifactMountpoints:  nil,
+		CpuAllocationMillicpus:    nil,
+		MemoryAllocationMegabytes: nil,
+	}
+
+	subnetworkStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, SubnetworkAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	if !found {
-		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
-			SubnetworkAttr, UpdateServiceConfigTypeName)
+	if found {
+		subnetwork := subnetworkStarlark.GoString()
+		updateServiceConfig.Subnetwork = &subnetwork
 	}
-	return binding_constructors.NewUpdateServiceConfig(subnetworkStarlarkValue.GoString()), nil
+
+	imageStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, ImageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		image := imageStarlark.GoString()
+		updateServiceConfig.ContainerImageName = &image
+	}
+
+	portsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, PortsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && portsStarlark.Len() > 0 {
+		ports := make(map[string]*kurtosis_core_rpc_api_bindings.Port, portsStarlark.Len())
+		for _, portItem := range portsStarlark.Items() {
+			portKey, portValue, interpretationError := service_config.ConvertPortMapEntry(PortsAttr, portItem[0], portItem[1], portsStarlark)
+			if interpretationError != nil {
+				return nil, interpretationError
+			}
+			ports[portKey] = portValue
+		}
+		updateServiceConfig.PrivatePorts = ports
+	}
+
+	filesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, FilesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && filesStarlark.Len() > 0 {
+		filesArtifactMountDirpaths, interpretationErr := kurtosis_types.SafeCastToMapStringString(filesStarlark, FilesAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		updateServiceConfig.FilesArtifactMountpoints = filesArtifactMountDirpaths
+	}
+
+	entrypointStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, EntrypointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && entrypointStarlark.Len() > 0 {
+		entryPointArgs, interpretationErr := kurtosis_types.SafeCastToStringSlice(entrypointStarlark, EntrypointAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		updateServiceConfig.EntrypointArgs = entryPointArgs
+	}
+
+	cmdStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, CmdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && cmdStarlark.Len() > 0 {
+		cmdArgs, interpretationErr := kurtosis_types.SafeCastToStringSlice(cmdStarlark, CmdAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		updateServiceConfig.CmdArgs = cmdArgs
+	}
+
+	envVarsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, EnvVarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && envVarsStarlark.Len() > 0 {
+		envVars, interpretationErr := kurtosis_types.SafeCastToMapStringString(envVarsStarlark, EnvVarsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		updateServiceConfig.EnvVars = envVars
+	}
+
+	cpuAllocationStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, CpuAllocationAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		cpuAllocation, ok := cpuAllocationStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", CpuAllocationAttr, cpuAllocationStarlark)
+		}
+		updateServiceConfig.CpuAllocationMillicpus = &cpuAllocation
+	}
+
+	memoryAllocationStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, MemoryAllocationAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		memoryAllocation, ok := memoryAllocationStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", MemoryAllocationAttr, memoryAllocationStarlark)
+		}
+		updateServiceConfig.MemoryAllocationMegabytes = &memoryAllocation
+	}
+
+	return updateServiceConfig, nil
 }
@@ -41,6 +41,10 @@ const (
 	GetHttpRecipeTypeName  = "GetHttpRequestRecipe"
 
 	HttpRecipeTypeName = "HttpRequestRecipe"
+
+	// Keys of the result of the recipe, for the Go code running recipes
+	StatusCodeResultKey = statusCodeKey
+	BodyResultKey       = bodyKey
 )
 
 type HttpRequestRecipe struct {
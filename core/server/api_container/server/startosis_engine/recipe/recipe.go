@@ -10,6 +10,7 @@ import (
 )
 
 type Recipe interface {
+	String() string
 	Execute(
 		ctx context.Context,
 		serviceNetwork service_network.ServiceNetwork,
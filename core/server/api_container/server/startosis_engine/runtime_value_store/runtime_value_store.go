@@ -1,35 +1,148 @@
 package runtime_value_store
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/runtime_values"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
+	"sync"
+)
+
+const (
+	runtimeValueDeserializationThreadName = "runtime-value-deserialization"
+	runtimeValueDeserializationFilename   = "runtime-value"
 )
 
 type RuntimeValueStore struct {
+	// Instructions can be executed concurrently, hence the lock
+	mutex *sync.RWMutex
+
 	recipeResultMap map[string]map[string]starlark.Comparable
+
+	// The values created during the interpretation of a run, or set before its execution, which are not persisted and
+	// are only kept until the runs in progress finish
+	transientValueUuids map[string]bool
+
+	// The number of runs in progress. The transient values are removed once it drops to zero, as they might be used by
+	// any of these runs
+	numRunsInProgress int
+
+	// Where the values are persisted so that they survive an API container restart. Nil if values are only kept in memory
+	runtimeValuesBucket *runtime_values.RuntimeValuesBucket
 }
 
 func NewRuntimeValueStore() *RuntimeValueStore {
 	return &RuntimeValueStore{
-		recipeResultMap: make(map[string]map[string]starlark.Comparable),
+		mutex:               &sync.RWMutex{},
+		recipeResultMap:     make(map[string]map[string]starlark.Comparable),
+		transientValueUuids: make(map[string]bool),
+		numRunsInProgress:   0,
+		runtimeValuesBucket: nil,
+	}
+}
+
+// NewPersistedRuntimeValueStore creates a RuntimeValueStore backed by the enclave database. The values already present
+// in the database are loaded in the store
+func NewPersistedRuntimeValueStore(enclaveDb *enclave_db.EnclaveDB) (*RuntimeValueStore, error) {
+	runtimeValuesBucket, err := runtime_values.GetOrCreateRuntimeValuesBucket(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the runtime values bucket")
+	}
+	persistedRuntimeValues, err := runtimeValuesBucket.GetAllRuntimeValues()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persisted runtime values")
+	}
+	recipeResultMap := make(map[string]map[string]starlark.Comparable, len(persistedRuntimeValues))
+	for uuid, serializedFields := range persistedRuntimeValues {
+		// older API containers persisted the values as soon as they were created, so a value never set is persisted
+		// without any field. It can't be used by any run anymore
+		if len(serializedFields) == 0 {
+			if err = runtimeValuesBucket.RemoveRuntimeValue(uuid); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred removing persisted runtime value '%v' which was never set", uuid)
+			}
+			continue
+		}
+		value, err := deserializeValue(serializedFields)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing persisted runtime value '%v'", uuid)
+		}
+		recipeResultMap[uuid] = value
+	}
+	return &RuntimeValueStore{
+		mutex:               &sync.RWMutex{},
+		recipeResultMap:     recipeResultMap,
+		transientValueUuids: make(map[string]bool),
+		numRunsInProgress:   0,
+		runtimeValuesBucket: runtimeValuesBucket,
+	}, nil
+}
+
+// StartRun registers a run in progress, such that the transient values it creates are kept until it finishes. It must
+// be called before the run is interpreted, and followed by FinishRun
+func (re *RuntimeValueStore) StartRun() {
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
+	re.numRunsInProgress++
+}
+
+// FinishRun unregisters a run in progress. Once no run is in progress anymore, the transient values are removed: the
+// values created by dry runs, by failed interpretations, by instructions which didn't get executed or that were set
+// before the execution of a run
+func (re *RuntimeValueStore) FinishRun() {
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
+	re.numRunsInProgress--
+	if re.numRunsInProgress > 0 {
+		return
+	}
+	for uuid := range re.transientValueUuids {
+		delete(re.recipeResultMap, uuid)
 	}
+	re.transientValueUuids = make(map[string]bool)
 }
 
+// CreateValue creates a value which is set later on. It is only kept in memory until it is set by SetValue
 func (re *RuntimeValueStore) CreateValue() (string, error) {
 	uuid, err := uuid_generator.GenerateUUIDString()
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while generating uuid for runtime value")
 	}
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
 	re.recipeResultMap[uuid] = nil
+	re.transientValueUuids[uuid] = true
 	return uuid, nil
 }
 
-func (re *RuntimeValueStore) SetValue(uuid string, value map[string]starlark.Comparable) {
+// SetValue sets a value during the execution of an instruction. The value is persisted, and the instruction fails if
+// it can't be, as it wouldn't survive an API container restart
+func (re *RuntimeValueStore) SetValue(uuid string, value map[string]starlark.Comparable) error {
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
+	if re.runtimeValuesBucket != nil {
+		if err := re.runtimeValuesBucket.SetRuntimeValue(uuid, serializeValue(value)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred persisting runtime value '%v'", uuid)
+		}
+	}
+	re.recipeResultMap[uuid] = value
+	delete(re.transientValueUuids, uuid)
+	return nil
+}
+
+// SetTransientValue sets a value before the execution of a run, for instance when the instruction producing it is
+// skipped because its result is already known. The value is not persisted and is removed once the runs in progress
+// finish
+func (re *RuntimeValueStore) SetTransientValue(uuid string, value map[string]starlark.Comparable) {
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
 	re.recipeResultMap[uuid] = value
+	re.transientValueUuids[uuid] = true
 }
 
 func (re *RuntimeValueStore) GetValue(uuid string) (map[string]starlark.Comparable, error) {
+	re.mutex.RLock()
+	defer re.mutex.RUnlock()
 	value, found := re.recipeResultMap[uuid]
 	if !found {
 		return nil, stacktrace.NewError("Runtime UUID '%v' was not found", uuid)
@@ -39,3 +152,35 @@ func (re *RuntimeValueStore) GetValue(uuid string) (map[string]starlark.Comparab
 	}
 	return value, nil
 }
+
+// Fields are serialized as their Starlark representation, which can be evaluated back to the same value
+func serializeValue(value map[string]starlark.Comparable) map[string]string {
+	serializedFields := make(map[string]string, len(value))
+	for fieldName, fieldValue := range value {
+		serializedFields[fieldName] = fieldValue.String()
+	}
+	return serializedFields
+}
+
+func deserializeValue(serializedFields map[string]string) (map[string]starlark.Comparable, error) {
+	thread := &starlark.Thread{
+		Name:       runtimeValueDeserializationThreadName,
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	value := make(map[string]starlark.Comparable, len(serializedFields))
+	for fieldName, serializedFieldValue := range serializedFields {
+		fieldValue, err := starlark.Eval(thread, runtimeValueDeserializationFilename, serializedFieldValue, nil)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred evaluating the value '%v' of field '%v'", serializedFieldValue, fieldName)
+		}
+		comparableFieldValue, ok := fieldValue.(starlark.Comparable)
+		if !ok {
+			return nil, stacktrace.NewError("The value '%v' of field '%v' is not comparable", serializedFieldValue, fieldName)
+		}
+		value[fieldName] = comparableFieldValue
+	}
+	return value, nil
+}
@@ -0,0 +1,101 @@
+package runtime_value_store
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/runtime_values"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testRuntimeValueUuid = "runtime-value"
+)
+
+func TestNewPersistedRuntimeValueStore_ReloadsValues(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+
+	runtimeValueStore, err := NewPersistedRuntimeValueStore(enclaveDb)
+	require.Nil(t, err)
+	setValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	unsetValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	value := map[string]starlark.Comparable{
+		"code":      starlark.MakeInt(200),
+		"body":      starlark.String("{\"message\": \"hello\"}"),
+		"ratio":     starlark.Float(0.5),
+		"extracted": starlark.NewList([]starlark.Value{starlark.String("a"), starlark.MakeInt(1)}),
+	}
+	require.Nil(t, runtimeValueStore.SetValue(setValueUuid, value))
+
+	reloadedRuntimeValueStore, err := NewPersistedRuntimeValueStore(enclaveDb)
+	require.Nil(t, err)
+	reloadedValue, err := reloadedRuntimeValueStore.GetValue(setValueUuid)
+	require.Nil(t, err)
+	require.Len(t, reloadedValue, len(value))
+	for fieldName, fieldValue := range value {
+		isEqual, err := starlark.Equal(fieldValue, reloadedValue[fieldName])
+		require.Nil(t, err)
+		require.True(t, isEqual, "Field '%v' was '%v' before reloading and '%v' after", fieldName, fieldValue, reloadedValue[fieldName])
+	}
+
+	// only the values set during an execution are persisted
+	_, err = reloadedRuntimeValueStore.GetValue(unsetValueUuid)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "was not found")
+}
+
+func TestNewPersistedRuntimeValueStore_RemovesValuesNeverSet(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+
+	// older API containers persisted the values without any field as soon as they were created
+	runtimeValuesBucket, err := runtime_values.GetOrCreateRuntimeValuesBucket(enclaveDb)
+	require.Nil(t, err)
+	require.Nil(t, runtimeValuesBucket.SetRuntimeValue(testRuntimeValueUuid, map[string]string{}))
+
+	runtimeValueStore, err := NewPersistedRuntimeValueStore(enclaveDb)
+	require.Nil(t, err)
+	_, err = runtimeValueStore.GetValue(testRuntimeValueUuid)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "was not found")
+	persistedRuntimeValues, err := runtimeValuesBucket.GetAllRuntimeValues()
+	require.Nil(t, err)
+	require.Empty(t, persistedRuntimeValues)
+}
+
+func TestFinishRun_RemovesTransientValuesOnceNoRunIsInProgress(t *testing.T) {
+	runtimeValueStore := NewRuntimeValueStore()
+	value := map[string]starlark.Comparable{
+		"code": starlark.MakeInt(200),
+	}
+
+	runtimeValueStore.StartRun()
+	setValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	require.Nil(t, runtimeValueStore.SetValue(setValueUuid, value))
+	unsetValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	transientValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	runtimeValueStore.SetTransientValue(transientValueUuid, value)
+
+	// a concurrent run finishing doesn't remove the values of the run still in progress
+	runtimeValueStore.StartRun()
+	runtimeValueStore.FinishRun()
+	_, err = runtimeValueStore.GetValue(transientValueUuid)
+	require.Nil(t, err)
+
+	runtimeValueStore.FinishRun()
+	_, err = runtimeValueStore.GetValue(setValueUuid)
+	require.Nil(t, err)
+	for _, removedValueUuid := range []string{unsetValueUuid, transientValueUuid} {
+		_, err = runtimeValueStore.GetValue(removedValueUuid)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "was not found")
+	}
+}
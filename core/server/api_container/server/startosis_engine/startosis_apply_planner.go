@@ -0,0 +1,140 @@
+package startosis_engine
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+)
+
+// StartosisApplyPlanner compares the instructions of a package with the current state of the enclave, such that only
+// the instructions bringing a change to the enclave are executed when the package is applied to it
+type StartosisApplyPlanner struct {
+	serviceNetwork service_network.ServiceNetwork
+}
+
+func NewStartosisApplyPlanner(serviceNetwork service_network.ServiceNetwork) *StartosisApplyPlanner {
+	return &StartosisApplyPlanner{
+		serviceNetwork: serviceNetwork,
+	}
+}
+
+// Plan returns the changes applying the instructions would bring to the enclave, the indices of the instructions that
+// can be skipped as the enclave is already up-to-date with them, and the services running in the enclave that are not
+// declared by the instructions anymore and need to be removed. Those services are only returned if
+// removeUndeclaredServices is true.
+//
+// Instructions are planned in order. When an instruction changes the enclave, all the following instructions that are
+// affected by the resources it changes are forced to re-create their own resources, even if those look up-to-date.
+func (planner *StartosisApplyPlanner) Plan(instructions []kurtosis_instruction.KurtosisInstruction, removeUndeclaredServices bool) ([]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, map[int]bool, map[service.ServiceName]bool, error) {
+	var changes []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+	instructionsToSkip := map[int]bool{}
+	declaredServices := map[service.ServiceName]bool{}
+	var changingInstructionsDependencies []*kurtosis_instruction.InstructionDependencies
+	for index, instruction := range instructions {
+		instructionDependencies := instruction.GetDependencies()
+		forceUpdate := false
+		for _, changingInstructionDependencies := range changingInstructionsDependencies {
+			if instructionDependencies.IsAffectedByChangesOf(changingInstructionDependencies) {
+				forceUpdate = true
+				break
+			}
+		}
+
+		instructionChanges, err := instruction.PlanApply(forceUpdate)
+		if err != nil {
+			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred planning the changes of instruction (number %d) at %v:\n%v", index+1, instruction.GetPositionInOriginalScript().String(), instruction.String())
+		}
+
+		isInstructionChangingEnclave := false
+		isInstructionExecuted := false
+		instructionNumber := uint32(index + 1)
+		for _, change := range instructionChanges {
+			change.InstructionNumber = &instructionNumber
+			switch change.GetAction() {
+			case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_ADD,
+				kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE,
+				kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE:
+				isInstructionChangingEnclave = true
+				isInstructionExecuted = true
+			case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_EXECUTE:
+				isInstructionExecuted = true
+			case kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED:
+				// nothing to do
+			}
+			if change.GetResourceType() == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE {
+				declaredServices[service.ServiceName(change.GetResourceName())] = true
+			}
+		}
+		changes = append(changes, instructionChanges...)
+		if isInstructionChangingEnclave {
+			changingInstructionsDependencies = append(changingInstructionsDependencies, instructionDependencies)
+		}
+		if !isInstructionExecuted {
+			instructionsToSkip[index] = true
+		}
+	}
+
+	undeclaredServices := map[service.ServiceName]bool{}
+	if !removeUndeclaredServices {
+		return changes, instructionsToSkip, undeclaredServices, nil
+	}
+	var undeclaredServiceNames []string
+	for serviceName := range planner.serviceNetwork.GetServiceNames() {
+		if declaredServices[serviceName] {
+			continue
+		}
+		undeclaredServices[serviceName] = true
+		undeclaredServiceNames = append(undeclaredServiceNames, string(serviceName))
+	}
+	sort.Strings(undeclaredServiceNames)
+	for _, serviceName := range undeclaredServiceNames {
+		changes = append(changes, binding_constructors.NewStarlarkApplyPlanChange(kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, serviceName))
+	}
+	return changes, instructionsToSkip, undeclaredServices, nil
+}
+
+// RemoveUndeclaredServices removes the services returned by Plan that are not declared by the package anymore
+func (planner *StartosisApplyPlanner) RemoveUndeclaredServices(ctx context.Context, undeclaredServices map[service.ServiceName]bool) error {
+	for serviceName := range undeclaredServices {
+		if _, err := planner.serviceNetwork.RemoveService(ctx, string(serviceName)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing service '%s' which is not declared by the package anymore", serviceName)
+		}
+	}
+	return nil
+}
+
+// getInstructionsApplyPlanChanges groups the changes of an apply plan by the index of the instruction bringing them.
+// The removals of the undeclared services aren't brought by any instruction, they are left out
+func getInstructionsApplyPlanChanges(changes []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange) map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange {
+	instructionsChanges := map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{}
+	for _, change := range changes {
+		if change.InstructionNumber == nil {
+			continue
+		}
+		instructionIndex := int(change.GetInstructionNumber()) - 1
+		instructionsChanges[instructionIndex] = append(instructionsChanges[instructionIndex], change)
+	}
+	return instructionsChanges
+}
+
+// getServicesRedeclaredByApplyPlan returns the services running in the enclave that the instructions of the apply plan
+// declare again, either to update them or to keep them unchanged
+func getServicesRedeclaredByApplyPlan(instructionsChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange) map[service.ServiceName]bool {
+	redeclaredServices := map[service.ServiceName]bool{}
+	for _, changes := range instructionsChanges {
+		for _, change := range changes {
+			if change.GetResourceType() != kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE {
+				continue
+			}
+			if change.GetAction() == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE || change.GetAction() == kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED {
+				redeclaredServices[service.ServiceName(change.GetResourceName())] = true
+			}
+		}
+	}
+	return redeclaredServices
+}
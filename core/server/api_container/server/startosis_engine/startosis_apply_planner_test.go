@@ -0,0 +1,159 @@
+package startosis_engine
+
+import (
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	doRemoveUndeclaredServices    = true
+	doNotRemoveUndeclaredServices = false
+
+	doForceUpdate    = true
+	doNotForceUpdate = false
+
+	serviceNameForTest1 = service.ServiceName("service1")
+	serviceNameForTest2 = service.ServiceName("service2")
+	serviceNameForTest3 = service.ServiceName("service3")
+)
+
+func TestPlan_NothingChanged(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	planner := NewStartosisApplyPlanner(serviceNetwork)
+
+	instruction1 := createMockAddServiceInstructionForApply(t, serviceNameForTest1, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED)
+	instruction2 := createMockAddServiceInstructionForApply(t, serviceNameForTest2, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	changes, instructionsToSkip, servicesToRemove, err := planner.Plan(instructions, doNotRemoveUndeclaredServices)
+	require.Nil(t, err)
+	require.Equal(t, map[int]bool{0: true, 1: true}, instructionsToSkip)
+	require.Empty(t, servicesToRemove)
+	require.Len(t, changes, 2)
+	require.Equal(t, uint32(1), changes[0].GetInstructionNumber())
+	require.Equal(t, uint32(2), changes[1].GetInstructionNumber())
+}
+
+func TestPlan_ChangeForcesUpdateOfAffectedInstructionsOnly(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	planner := NewStartosisApplyPlanner(serviceNetwork)
+
+	instruction1 := createMockAddServiceInstructionForApply(t, serviceNameForTest1, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE)
+
+	// reads service1, which is updated by instruction1, so it has to be re-applied
+	readingDependencies := kurtosis_instruction.NewInstructionDependencies()
+	readingDependencies.ReadService(serviceNameForTest1)
+	readingDependencies.WriteService(serviceNameForTest2)
+	instruction2 := createMockInstructionWithDependencies(t, "add_service", readingDependencies)
+	instruction2.EXPECT().PlanApply(doForceUpdate).Return(newServiceApplyPlanChanges(serviceNameForTest2, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE), nil)
+
+	// independent of service1, it remains untouched
+	instruction3 := createMockAddServiceInstructionForApply(t, serviceNameForTest3, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED)
+
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+		instruction3,
+	}
+
+	changes, instructionsToSkip, servicesToRemove, err := planner.Plan(instructions, doNotRemoveUndeclaredServices)
+	require.Nil(t, err)
+	require.Equal(t, map[int]bool{2: true}, instructionsToSkip)
+	require.Empty(t, servicesToRemove)
+	require.Len(t, changes, 3)
+}
+
+func TestPlan_RemoveUndeclaredServices(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetServiceNames().Return(map[service.ServiceName]bool{
+		serviceNameForTest1: true,
+		serviceNameForTest2: true,
+		serviceNameForTest3: true,
+	})
+	planner := NewStartosisApplyPlanner(serviceNetwork)
+
+	instruction1 := createMockAddServiceInstructionForApply(t, serviceNameForTest2, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+	}
+
+	changes, instructionsToSkip, servicesToRemove, err := planner.Plan(instructions, doRemoveUndeclaredServices)
+	require.Nil(t, err)
+	require.Equal(t, map[int]bool{0: true}, instructionsToSkip)
+	require.Equal(t, map[service.ServiceName]bool{serviceNameForTest1: true, serviceNameForTest3: true}, servicesToRemove)
+	require.Len(t, changes, 3)
+	for _, removal := range changes[1:] {
+		require.Equal(t, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_REMOVE, removal.GetAction())
+		require.Nil(t, removal.InstructionNumber)
+	}
+	require.Equal(t, string(serviceNameForTest1), changes[1].GetResourceName())
+	require.Equal(t, string(serviceNameForTest3), changes[2].GetResourceName())
+}
+
+func TestPlan_FailureIsPropagated(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	planner := NewStartosisApplyPlanner(serviceNetwork)
+
+	instruction1 := createMockInstructionWithDependencies(t, "add_service", kurtosis_instruction.NewInstructionDependencies())
+	instruction1.EXPECT().PlanApply(doNotForceUpdate).Return(nil, errors.New("expected error for test"))
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+	}
+
+	_, _, _, err := planner.Plan(instructions, doNotRemoveUndeclaredServices)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "expected error for test")
+}
+
+func TestPlan_ChangesAreGroupedByInstruction(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetServiceNames().Return(map[service.ServiceName]bool{
+		serviceNameForTest1: true,
+		serviceNameForTest2: true,
+		serviceNameForTest3: true,
+	})
+	planner := NewStartosisApplyPlanner(serviceNetwork)
+
+	instruction1 := createMockAddServiceInstructionForApply(t, serviceNameForTest1, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UPDATE)
+	instruction2 := createMockAddServiceInstructionForApply(t, serviceNameForTest2, doNotForceUpdate, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_UNCHANGED)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	changes, _, servicesToRemove, err := planner.Plan(instructions, doRemoveUndeclaredServices)
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]bool{serviceNameForTest3: true}, servicesToRemove)
+
+	instructionsChanges := getInstructionsApplyPlanChanges(changes)
+	require.Len(t, instructionsChanges, 2)
+	require.Equal(t, changes[0:1], instructionsChanges[0])
+	require.Equal(t, changes[1:2], instructionsChanges[1])
+
+	// the removed service is not declared again, it can't be redeclared by the package
+	require.Equal(t, map[service.ServiceName]bool{serviceNameForTest1: true, serviceNameForTest2: true}, getServicesRedeclaredByApplyPlan(instructionsChanges))
+}
+
+func createMockAddServiceInstructionForApply(t *testing.T, serviceName service.ServiceName, forceUpdate bool, action kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_Action) *mock_instruction.MockKurtosisInstruction {
+	dependencies := kurtosis_instruction.NewInstructionDependencies()
+	dependencies.WriteService(serviceName)
+	instruction := createMockInstructionWithDependencies(t, "add_service", dependencies)
+	instruction.EXPECT().PlanApply(forceUpdate).Return(newServiceApplyPlanChanges(serviceName, action), nil)
+	return instruction
+}
+
+func newServiceApplyPlanChanges(serviceName service.ServiceName, action kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_Action) []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange {
+	return []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange{
+		binding_constructors.NewStarlarkApplyPlanChange(action, kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange_SERVICE, string(serviceName)),
+	}
+}
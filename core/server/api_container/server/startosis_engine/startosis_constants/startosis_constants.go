@@ -2,10 +2,16 @@ package startosis_constants
 
 const (
 	MainFileName       = "main.star"
+	TestFileSuffix     = "_test.star"
 	KurtosisYamlName   = "kurtosis.yml"
+	KurtosisLockName   = "kurtosis-lock.yml"
 	GithubDomainPrefix = "github.com"
 	EmptyInputArgs     = "{}" // empty JSON
 
+	VendorDirname = "vendor"
+	// the manifest of the vendor directory has the format of the lockfile
+	KurtosisVendorManifestName = "kurtosis-vendor.yml"
+
 	NoOutputObject = ""
 
 	PackageIdPlaceholderForStandaloneScript = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
@@ -2,73 +2,120 @@ package startosis_engine
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_journal"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strings"
 	"sync"
 )
 
 const (
-	progressMsg      = "Execution in progress"
-	ParallelismParam = "PARALLELISM"
+	progressMsg = "Execution in progress"
+
+	minParallelism = 1
+
+	skippedInstructionOutput = "Skipped as this instruction was already applied by a previous run"
+
+	// runtime values UUIDs are generated randomly at interpretation time. In the journal, they are replaced by a
+	// reference to the instruction creating them, identified by its own canonical form and its number of occurrences,
+	// such that the canonical form of an instruction is stable across runs and doesn't depend on its position
+	runtimeValueReferenceInJournalFormat = "<runtime value of instruction %s>"
+	runtimeValueProducerInJournalFormat  = "%s#%d"
+	runtimeValueProducerHashLength       = 16
+)
+
+var (
+	noApplyPlanChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
 )
 
 type StartosisExecutor struct {
 	mutex *sync.Mutex
+
+	executionJournal *execution_journal.ExecutionJournal
 }
 
 type ExecutionError struct {
 	Error string
 }
 
-func NewStartosisExecutor() *StartosisExecutor {
+type instructionExecutionResult struct {
+	instructionIndex  int
+	instructionOutput *string
+	err               error
+}
+
+// instructionsPlan wraps the list of instructions being executed to compute their canonical form at most once
+type instructionsPlan struct {
+	instructions []kurtosis_instruction.KurtosisInstruction
+
+	canonicalInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
+
+	// The changes the apply plan expects from each instruction, indexed by instruction. Nil if the package isn't applied
+	applyPlanChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+}
+
+func NewStartosisExecutor(executionJournal *execution_journal.ExecutionJournal) *StartosisExecutor {
 	return &StartosisExecutor{
-		mutex: &sync.Mutex{},
+		mutex:            &sync.Mutex{},
+		executionJournal: executionJournal,
 	}
 }
 
 // Execute executes the list of Kurtosis instructions _asynchronously_ against the Kurtosis backend
 // Consumers of this method should read the response lines channel and return as soon as one it is closed
 //
+// Instructions that do not depend on each other (see kurtosis_instruction.InstructionDependencies) are executed
+// concurrently, with at most `parallelism` operations running at the same time. An instruction running several
+// operations concurrently, like add_services, takes as many of them as it has operations, up to `parallelism`.
+// Regardless of the order in which
+// instructions complete, response lines are always sent in the order of the instructions in the plan.
+//
 // The channel of KurtosisExecutionResponseLine can contain three kinds of line:
 // - A regular KurtosisInstruction that was successfully executed
 // - A KurtosisExecutionError if the execution failed
 // - A ProgressInfo to update the current "state" of the execution
-func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, instructions []kurtosis_instruction.KurtosisInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+//
+// When the package is applied to the enclave, applyPlanChanges holds the changes the apply plan expects from each
+// instruction (see StartosisApplyPlanner), which the instructions managing several resources use to tell which of
+// them to change.
+//
+// Each run records the instructions it successfully applied to the enclave in the execution journal of the package.
+// The instructions in instructionsToSkip (see GetInstructionsAlreadyApplied) are not executed, and are recorded as
+// applied.
+func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, packageId string, instructionsToSkip map[int]bool, applyPlanChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange, instructions []kurtosis_instruction.KurtosisInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	ctxWithParallelism := context.WithValue(ctx, ParallelismParam, parallelism)
 	go func() {
 		defer func() {
 			executor.mutex.Unlock()
 			close(starlarkRunResponseLineStream)
 		}()
 
-		totalNumberOfInstructions := uint32(len(instructions))
-		for index, instruction := range instructions {
-			instructionNumber := uint32(index + 1)
-			progress := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
-				progressMsg, instructionNumber, totalNumberOfInstructions)
-			starlarkRunResponseLineStream <- progress
-
-			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction())
-			starlarkRunResponseLineStream <- canonicalInstruction
-
-			if !dryRun {
-				instructionOutput, err := instruction.Execute(ctxWithParallelism)
-				if err != nil {
-
-					propagatedError := stacktrace.Propagate(err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
-					serializedError := binding_constructors.NewStarlarkExecutionError(propagatedError.Error())
-					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
-					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
-					return
-				}
-				if instructionOutput != nil {
-					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(*instructionOutput)
-				}
+		plan := newInstructionsPlan(instructions, applyPlanChanges)
+		if dryRun {
+			for index := range instructions {
+				sendInstructionProgressAndCanonicalForm(starlarkRunResponseLineStream, plan, index)
 			}
+		} else if failure, err := executor.executeAndRecordInstructions(ctx, parallelism, packageId, instructionsToSkip, plan, starlarkRunResponseLineStream); err != nil {
+			propagatedError := stacktrace.Propagate(err, "An error occurred recording the instructions applied to the enclave")
+			serializedError := binding_constructors.NewStarlarkExecutionError(propagatedError.Error())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		} else if failure != nil {
+			instructionNumber := uint32(failure.instructionIndex + 1)
+			instruction := instructions[failure.instructionIndex]
+			propagatedError := stacktrace.Propagate(failure.err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+			serializedError := binding_constructors.NewStarlarkExecutionError(propagatedError.Error())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
 		}
 
 		// TODO(gb): we should run magic string replacement on the output
@@ -76,3 +123,316 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 	}()
 	return starlarkRunResponseLineStream
 }
+
+// GetInstructionsAlreadyApplied returns the set of instructions that can be skipped when resuming from the previous
+// run of the package: the instructions already applied by the previous run and identical in this run, wherever they
+// are located in the plan. An instruction is re-executed
+// if it changed, if it was not applied (because the previous run failed before it for example), if it depends on an
+// instruction that is re-executed, or if an instruction being re-executed reads the runtime values it creates and it
+// can't restore them from what it already applied to the enclave (see KurtosisInstruction.RestoreRuntimeValues).
+func (executor *StartosisExecutor) GetInstructionsAlreadyApplied(packageId string, instructions []kurtosis_instruction.KurtosisInstruction) map[int]bool {
+	return getInstructionsToSkip(newInstructionsPlan(instructions, noApplyPlanChanges), executor.executionJournal.GetAppliedInstructions(packageId))
+}
+
+// executeAndRecordInstructions executes the plan, skipping the instructions provided, and replaces the content of the
+// execution journal of the package with the instructions applied to the enclave once it is done, whether the
+// execution failed or not. It returns an error if the journal couldn't be recorded
+func (executor *StartosisExecutor) executeAndRecordInstructions(ctx context.Context, parallelism int, packageId string, skippedInstructions map[int]bool, plan *instructionsPlan, starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (*instructionExecutionResult, error) {
+	executedInstructions, failure := executeInstructions(ctx, parallelism, plan, skippedInstructions, starlarkRunResponseLineStream)
+
+	instructionsInJournal := getCanonicalInstructionsForJournal(plan)
+	appliedInstructions := map[string]int{}
+	for instructionIndex := range skippedInstructions {
+		appliedInstructions[instructionsInJournal(instructionIndex)] += 1
+	}
+	for _, instructionIndex := range executedInstructions {
+		appliedInstructions[instructionsInJournal(instructionIndex)] += 1
+	}
+	if err := executor.executionJournal.Replace(packageId, appliedInstructions); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred recording the instructions applied by package '%v' in the execution journal", packageId)
+	}
+	return failure, nil
+}
+
+// getInstructionsToSkip returns the set of instructions that were already applied by the previous run, are identical
+// in this run, and do not need to be re-executed because of another instruction being re-executed. An instruction
+// appearing several times in the plan is considered applied as many times as the previous run applied it.
+// The skipped instructions which runtime values are read by an instruction being re-executed restore them
+func getInstructionsToSkip(plan *instructionsPlan, appliedInstructions map[string]int) map[int]bool {
+	instructionsDependencies := getInstructionsDependencies(plan.instructions)
+	instructionsInJournal := getCanonicalInstructionsForJournal(plan)
+
+	isReExecuted := make([]bool, len(plan.instructions))
+	hasRestoredRuntimeValues := make([]bool, len(plan.instructions))
+	numberOfOccurrences := map[string]int{}
+	for index := range plan.instructions {
+		instructionInJournal := instructionsInJournal(index)
+		numberOfOccurrences[instructionInJournal] += 1
+		isReExecuted[index] = numberOfOccurrences[instructionInJournal] > appliedInstructions[instructionInJournal]
+	}
+
+	// re-executing an instruction can trigger the re-execution of other instructions in both directions, iterate
+	// until nothing changes
+	for hasChanged := true; hasChanged; {
+		hasChanged = false
+		for index, instructionDependencies := range instructionsDependencies {
+			for previousIndex := 0; previousIndex < index; previousIndex++ {
+				if !isReExecuted[previousIndex] {
+					continue
+				}
+				// an instruction depending on an instruction being re-executed needs to be re-executed
+				if !isReExecuted[index] && instructionDependencies.DependsOn(instructionsDependencies[previousIndex]) {
+					isReExecuted[index] = true
+					hasChanged = true
+				}
+			}
+			if !isReExecuted[index] {
+				continue
+			}
+			// the runtime values read by an instruction being re-executed do not exist yet. They are restored from what
+			// the skipped instruction creating them already applied to the enclave, as executing it again would
+			// conflict with it, or created by executing it again if it can't restore them
+			for previousIndex := 0; previousIndex < index; previousIndex++ {
+				if isReExecuted[previousIndex] || hasRestoredRuntimeValues[previousIndex] || !instructionDependencies.ReadsRuntimeValuesWrittenBy(instructionsDependencies[previousIndex]) {
+					continue
+				}
+				if plan.instructions[previousIndex].RestoreRuntimeValues() {
+					hasRestoredRuntimeValues[previousIndex] = true
+					continue
+				}
+				isReExecuted[previousIndex] = true
+				hasChanged = true
+			}
+		}
+	}
+
+	skippedInstructions := map[int]bool{}
+	for index := range plan.instructions {
+		if !isReExecuted[index] {
+			skippedInstructions[index] = true
+		}
+	}
+	return skippedInstructions
+}
+
+// getCanonicalInstructionsForJournal returns a function computing the canonical form of an instruction as stored in
+// the execution journal, i.e. without its position in the script and with the runtime value UUIDs replaced by a
+// reference to the instruction creating them. As an instruction is always located after the ones creating the
+// runtime values it reads, the forms are computed in plan order, up to the instruction requested
+func getCanonicalInstructionsForJournal(plan *instructionsPlan) func(int) string {
+	instructionsDependencies := getInstructionsDependencies(plan.instructions)
+	var instructionsInJournal []string
+	runtimeValueReferences := map[string]string{}
+	numberOfOccurrences := map[string]int{}
+	return func(instructionIndex int) string {
+		for index := len(instructionsInJournal); index <= instructionIndex; index++ {
+			canonicalInstruction := plan.getCanonicalInstruction(index).GetExecutableInstruction()
+			for runtimeValueUuid, runtimeValueReference := range runtimeValueReferences {
+				canonicalInstruction = strings.ReplaceAll(canonicalInstruction, runtimeValueUuid, runtimeValueReference)
+			}
+			instructionsInJournal = append(instructionsInJournal, canonicalInstruction)
+
+			numberOfOccurrences[canonicalInstruction] += 1
+			producerHash := sha256.Sum256([]byte(fmt.Sprintf(runtimeValueProducerInJournalFormat, canonicalInstruction, numberOfOccurrences[canonicalInstruction])))
+			producerReference := hex.EncodeToString(producerHash[:])[:runtimeValueProducerHashLength]
+			for _, runtimeValueUuid := range instructionsDependencies[index].GetWrittenRuntimeValues() {
+				runtimeValueReferences[runtimeValueUuid] = fmt.Sprintf(runtimeValueReferenceInJournalFormat, producerReference)
+			}
+		}
+		return instructionsInJournal[instructionIndex]
+	}
+}
+
+// executeInstructions runs the instructions following their dependency graph and streams the progress, the canonical
+// form and the output of each instruction in plan order.
+//
+// When an instruction fails, no instruction located after it in the plan is started anymore, but the ones located
+// before it are run to completion, such that the enclave ends up in the same state as a sequential execution
+// failing at this instruction (plus potentially some independent instructions that were already running).
+//
+// Skipped instructions are not executed and are considered successfully completed right away.
+// It returns the indices of the instructions that were successfully executed, along with the result of the first
+// failing instruction in plan order, or nil if all instructions succeeded.
+func executeInstructions(ctx context.Context, parallelism int, plan *instructionsPlan, skippedInstructions map[int]bool, starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) ([]int, *instructionExecutionResult) {
+	instructions := plan.instructions
+	numberOfInstructions := len(instructions)
+	if numberOfInstructions == 0 {
+		return nil, nil
+	}
+	if parallelism < minParallelism {
+		parallelism = minParallelism
+	}
+
+	instructionsDependencies := getInstructionsDependencies(instructions)
+	dependentInstructions, numberOfPendingDependencies := buildInstructionsDependencyGraph(instructionsDependencies)
+	instructionsParallelism := make([]int, numberOfInstructions)
+	for index, instructionDependencies := range instructionsDependencies {
+		instructionsParallelism[index] = getInstructionParallelism(instructionDependencies, parallelism)
+	}
+
+	// buffered such that running instructions never block on writing their result
+	executionResults := make(chan *instructionExecutionResult, numberOfInstructions)
+	completedInstructions := map[int]*instructionExecutionResult{}
+	isInstructionStarted := make([]bool, numberOfInstructions)
+	numberOfRunningInstructions := 0
+	numberOfRunningOperations := 0
+	firstFailedInstructionIndex := numberOfInstructions
+	nextInstructionToReport := 0
+	var executedInstructions []int
+
+	for index := range instructions {
+		if !skippedInstructions[index] {
+			continue
+		}
+		output := skippedInstructionOutput
+		isInstructionStarted[index] = true
+		completedInstructions[index] = &instructionExecutionResult{
+			instructionIndex:  index,
+			instructionOutput: &output,
+			err:               nil,
+		}
+		for _, dependentInstructionIndex := range dependentInstructions[index] {
+			numberOfPendingDependencies[dependentInstructionIndex] -= 1
+		}
+	}
+
+	sendInstructionProgressAndCanonicalForm(starlarkRunResponseLineStream, plan, nextInstructionToReport)
+	for {
+		// report all the instructions that can be reported, in order
+		for nextInstructionToReport < numberOfInstructions {
+			completedInstruction, found := completedInstructions[nextInstructionToReport]
+			if !found || completedInstruction.err != nil {
+				break
+			}
+			if completedInstruction.instructionOutput != nil {
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(*completedInstruction.instructionOutput)
+			}
+			nextInstructionToReport += 1
+			if nextInstructionToReport < numberOfInstructions {
+				sendInstructionProgressAndCanonicalForm(starlarkRunResponseLineStream, plan, nextInstructionToReport)
+			}
+		}
+
+		// start as many instructions as possible, prioritizing the ones appearing first in the plan. Instructions
+		// after one that doesn't fit in the remaining parallelism wait for it, so that it can't be starved
+		for index := 0; index < firstFailedInstructionIndex && numberOfRunningOperations < parallelism; index++ {
+			if isInstructionStarted[index] || numberOfPendingDependencies[index] > 0 {
+				continue
+			}
+			if numberOfRunningOperations+instructionsParallelism[index] > parallelism {
+				break
+			}
+			isInstructionStarted[index] = true
+			numberOfRunningInstructions += 1
+			numberOfRunningOperations += instructionsParallelism[index]
+			options := kurtosis_instruction.NewExecutionOptions(instructionsParallelism[index], plan.applyPlanChanges[index])
+			go executeInstruction(ctx, index, instructions[index], options, executionResults)
+		}
+		if numberOfRunningInstructions == 0 {
+			break
+		}
+
+		executionResult := <-executionResults
+		numberOfRunningInstructions -= 1
+		numberOfRunningOperations -= instructionsParallelism[executionResult.instructionIndex]
+		completedInstructions[executionResult.instructionIndex] = executionResult
+		if executionResult.err != nil {
+			if executionResult.instructionIndex < firstFailedInstructionIndex {
+				firstFailedInstructionIndex = executionResult.instructionIndex
+			}
+			continue
+		}
+		executedInstructions = append(executedInstructions, executionResult.instructionIndex)
+		if executionResult.instructionIndex > firstFailedInstructionIndex {
+			logrus.Warnf("Instruction number %d was executed successfully, but an instruction located before it in the plan failed", executionResult.instructionIndex+1)
+			continue
+		}
+		for _, dependentInstructionIndex := range dependentInstructions[executionResult.instructionIndex] {
+			numberOfPendingDependencies[dependentInstructionIndex] -= 1
+		}
+	}
+
+	if firstFailedInstructionIndex < numberOfInstructions {
+		// all the instructions before the failed one have been reported at this point
+		return executedInstructions, completedInstructions[firstFailedInstructionIndex]
+	}
+	return executedInstructions, nil
+}
+
+// buildInstructionsDependencyGraph returns, for each instruction, the list of instructions depending on it, as well
+// as the number of instructions each instruction depends on
+func buildInstructionsDependencyGraph(instructionsDependencies []*kurtosis_instruction.InstructionDependencies) ([][]int, []int) {
+	dependentInstructions := make([][]int, len(instructionsDependencies))
+	numberOfDependencies := make([]int, len(instructionsDependencies))
+	for index, instructionDependencies := range instructionsDependencies {
+		for previousIndex := 0; previousIndex < index; previousIndex++ {
+			if instructionDependencies.DependsOn(instructionsDependencies[previousIndex]) {
+				dependentInstructions[previousIndex] = append(dependentInstructions[previousIndex], index)
+				numberOfDependencies[index] += 1
+			}
+		}
+	}
+	return dependentInstructions, numberOfDependencies
+}
+
+// getInstructionParallelism returns the share of the parallelism of the run an instruction takes: as many operations
+// as it runs concurrently, but at least one and at most the parallelism of the run
+func getInstructionParallelism(instructionDependencies *kurtosis_instruction.InstructionDependencies, parallelism int) int {
+	instructionParallelism := instructionDependencies.GetNumberOfConcurrentOperations()
+	if instructionParallelism < minParallelism {
+		return minParallelism
+	}
+	if instructionParallelism > parallelism {
+		return parallelism
+	}
+	return instructionParallelism
+}
+
+func getInstructionsDependencies(instructions []kurtosis_instruction.KurtosisInstruction) []*kurtosis_instruction.InstructionDependencies {
+	instructionsDependencies := make([]*kurtosis_instruction.InstructionDependencies, len(instructions))
+	for index, instruction := range instructions {
+		instructionsDependencies[index] = instruction.GetDependencies()
+	}
+	return instructionsDependencies
+}
+
+func executeInstruction(ctx context.Context, instructionIndex int, instruction kurtosis_instruction.KurtosisInstruction, options *kurtosis_instruction.ExecutionOptions, executionResults chan<- *instructionExecutionResult) {
+	var instructionOutput *string
+	var err error
+	if configurableInstruction, ok := instruction.(kurtosis_instruction.ConfigurableKurtosisInstruction); ok {
+		instructionOutput, err = configurableInstruction.ExecuteWithOptions(ctx, options)
+	} else {
+		instructionOutput, err = instruction.Execute(ctx)
+	}
+	executionResults <- &instructionExecutionResult{
+		instructionIndex:  instructionIndex,
+		instructionOutput: instructionOutput,
+		err:               err,
+	}
+}
+
+func sendInstructionProgressAndCanonicalForm(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, plan *instructionsPlan, instructionIndex int) {
+	instructionNumber := uint32(instructionIndex + 1)
+	totalNumberOfInstructions := uint32(len(plan.instructions))
+	progress := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
+		progressMsg, instructionNumber, totalNumberOfInstructions)
+	starlarkRunResponseLineStream <- progress
+
+	canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(plan.getCanonicalInstruction(instructionIndex))
+	starlarkRunResponseLineStream <- canonicalInstruction
+}
+
+func newInstructionsPlan(instructions []kurtosis_instruction.KurtosisInstruction, applyPlanChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange) *instructionsPlan {
+	return &instructionsPlan{
+		instructions:          instructions,
+		canonicalInstructions: make([]*kurtosis_core_rpc_api_bindings.StarlarkInstruction, len(instructions)),
+		applyPlanChanges:      applyPlanChanges,
+	}
+}
+
+func (plan *instructionsPlan) getCanonicalInstruction(instructionIndex int) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+	if plan.canonicalInstructions[instructionIndex] == nil {
+		plan.canonicalInstructions[instructionIndex] = plan.instructions[instructionIndex].GetCanonicalInstruction()
+	}
+	return plan.canonicalInstructions[instructionIndex]
+}
@@ -3,15 +3,27 @@ package startosis_engine
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_journal"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"net"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const (
@@ -23,6 +35,14 @@ const (
 
 	noScriptOutputObject = ""
 	noParallelism        = 1
+	someParallelism      = 4
+
+	doResume    = true
+	doNotResume = false
+
+	otherTestPackageId = "github.com/package-author/other-package-repo"
+
+	blockingInstructionTimeout = 5 * time.Second
 )
 
 var (
@@ -32,7 +52,7 @@ var (
 
 func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
@@ -57,7 +77,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 }
 
 func TestExecuteKurtosisInstructions_ExecuteForReal_FailureHalfWay(t *testing.T) {
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", throwOnExecute)
@@ -94,7 +114,7 @@ instruction2()
 }
 
 func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
@@ -119,7 +139,369 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	require.Equal(t, serializedInstruction, expectedSerializedInstructions)
 }
 
+func TestExecuteKurtosisInstructions_IndependentInstructionsRunConcurrently(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	// each instruction blocks until the other one has started, which can only succeed if they run concurrently
+	startedInstructions := &sync.WaitGroup{}
+	startedInstructions.Add(2)
+	instruction1 := createBlockingMockInstruction(t, "instruction1", startedInstructions, kurtosis_instruction.NewInstructionDependencies())
+	instruction2 := createBlockingMockInstruction(t, "instruction2", startedInstructions, kurtosis_instruction.NewInstructionDependencies())
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	scriptOutput, serializedInstruction, err := executeSynchronouslyWithParallelism(t, executor, someParallelism, instructions)
+	require.Nil(t, err)
+	instruction1.AssertNumberOfCalls(t, "Execute", 1)
+	instruction2.AssertNumberOfCalls(t, "Execute", 1)
+
+	// results are streamed in plan order regardless of the order of completion
+	require.Equal(t, "instruction1instruction2", scriptOutput)
+	expectedSerializedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction1", "instruction1()", noInstructionArgsForTesting),
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction2", "instruction2()", noInstructionArgsForTesting),
+	}
+	require.Equal(t, expectedSerializedInstructions, serializedInstruction)
+}
+
+func TestExecuteKurtosisInstructions_DependentInstructionsRunSequentially(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	writeDependencies := kurtosis_instruction.NewInstructionDependencies()
+	writeDependencies.WriteService("service")
+	readDependencies := kurtosis_instruction.NewInstructionDependencies()
+	readDependencies.ReadService("service")
+
+	var executionOrder []string
+	executionOrderMutex := &sync.Mutex{}
+	instruction1 := createRecordingMockInstruction(t, "instruction1", writeDependencies, &executionOrder, executionOrderMutex, 100*time.Millisecond)
+	instruction2 := createRecordingMockInstruction(t, "instruction2", readDependencies, &executionOrder, executionOrderMutex, 0)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	_, _, err := executeSynchronouslyWithParallelism(t, executor, someParallelism, instructions)
+	require.Nil(t, err)
+	require.Equal(t, []string{"instruction1", "instruction2"}, executionOrder)
+}
+
+func TestExecuteKurtosisInstructions_ConcurrentOperationsTakeTheirShareOfParallelism(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	// the first instruction runs as many operations as the parallelism allows, so the second one has to wait for it
+	concurrentDependencies := kurtosis_instruction.NewInstructionDependencies()
+	concurrentDependencies.SetNumberOfConcurrentOperations(someParallelism + 1)
+
+	var executionOrder []string
+	executionOrderMutex := &sync.Mutex{}
+	instruction1 := createRecordingMockInstruction(t, "instruction1", concurrentDependencies, &executionOrder, executionOrderMutex, 100*time.Millisecond)
+	instruction2 := createRecordingMockInstruction(t, "instruction2", kurtosis_instruction.NewInstructionDependencies(), &executionOrder, executionOrderMutex, 0)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	_, _, err := executeSynchronouslyWithParallelism(t, executor, someParallelism, instructions)
+	require.Nil(t, err)
+	require.Equal(t, []string{"instruction1", "instruction2"}, executionOrder)
+}
+
+func TestExecuteKurtosisInstructions_FailureStopsDependentInstructions(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	writeDependencies := kurtosis_instruction.NewInstructionDependencies()
+	writeDependencies.WriteFilesArtifact("artifact")
+	readDependencies := kurtosis_instruction.NewInstructionDependencies()
+	readDependencies.ReadFilesArtifact("artifact")
+
+	instruction1 := createMockInstructionWithDependencies(t, "instruction1", writeDependencies)
+	instruction1.EXPECT().Execute(mock.Anything).Maybe().Return(nil, errors.New("expected error for test"))
+	instruction2 := createMockInstructionWithDependencies(t, "instruction2", readDependencies)
+	instruction2.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+	}
+
+	_, serializedInstruction, executionError := executeSynchronouslyWithParallelism(t, executor, someParallelism, instructions)
+	require.NotNil(t, executionError)
+	require.Contains(t, executionError.GetErrorMessage(), "An error occurred executing instruction (number 1)")
+	instruction1.AssertNumberOfCalls(t, "Execute", 1)
+	instruction2.AssertNumberOfCalls(t, "Execute", 0)
+
+	expectedSerializedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction1", "instruction1()", noInstructionArgsForTesting),
+	}
+	require.Equal(t, expectedSerializedInstructions, serializedInstruction)
+}
+
+func TestExecuteKurtosisInstructions_Resume_SkipsAppliedInstructionsAndResumesAtFailedOne(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	firstRunInstructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstruction(t, "instruction1", executeSuccessfully),
+		createMockInstruction(t, "instruction2", throwOnExecute),
+		createMockInstruction(t, "instruction3", executeSuccessfully),
+	}
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, firstRunInstructions)
+	require.NotNil(t, executionError)
+
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
+	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
+	instruction3 := createMockInstruction(t, "instruction3", executeSuccessfully)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+		instruction2,
+		instruction3,
+	}
+	scriptOutput, serializedInstruction, executionError := executeSynchronouslyWithResume(t, executor, instructions)
+	require.Nil(t, executionError)
+	instruction1.AssertNumberOfCalls(t, "Execute", 0)
+	instruction2.AssertNumberOfCalls(t, "Execute", 1)
+	instruction3.AssertNumberOfCalls(t, "Execute", 1)
+
+	// skipped instructions are still streamed
+	require.Equal(t, skippedInstructionOutput, scriptOutput)
+	expectedSerializedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction1", "instruction1()", noInstructionArgsForTesting),
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction2", "instruction2()", noInstructionArgsForTesting),
+		binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "instruction3", "instruction3()", noInstructionArgsForTesting),
+	}
+	require.Equal(t, expectedSerializedInstructions, serializedInstruction)
+}
+
+func TestExecuteKurtosisInstructions_Resume_ReExecutesChangedAndDependentInstructions(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	serviceWrite := kurtosis_instruction.NewInstructionDependencies()
+	serviceWrite.WriteService("service")
+	serviceRead := kurtosis_instruction.NewInstructionDependencies()
+	serviceRead.ReadService("service")
+
+	firstRunInstructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstructionWithDependencies(t, "add_service", serviceWrite),
+		createMockInstructionWithDependencies(t, "exec", serviceRead),
+		createMockInstructionWithDependencies(t, "upload_files", kurtosis_instruction.NewInstructionDependencies()),
+	}
+	for _, instruction := range firstRunInstructions {
+		instruction.(*mock_instruction.MockKurtosisInstruction).EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	}
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, firstRunInstructions)
+	require.Nil(t, executionError)
+
+	changedInstruction := createMockInstructionWithDependencies(t, "add_service_changed", serviceWrite)
+	changedInstruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	dependentInstruction := createMockInstructionWithDependencies(t, "exec", serviceRead)
+	dependentInstruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	independentInstruction := createMockInstructionWithDependencies(t, "upload_files", kurtosis_instruction.NewInstructionDependencies())
+	independentInstruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		changedInstruction,
+		dependentInstruction,
+		independentInstruction,
+	}
+	_, _, executionError = executeSynchronouslyWithResume(t, executor, instructions)
+	require.Nil(t, executionError)
+	changedInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	dependentInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	independentInstruction.AssertNumberOfCalls(t, "Execute", 0)
+}
+
+func TestExecuteKurtosisInstructions_Resume_ReExecutesRuntimeValueProducersWhichCantRestoreThem(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	firstRunProducerDependencies := kurtosis_instruction.NewInstructionDependencies()
+	firstRunProducerDependencies.WriteRuntimeValue("first-run-uuid")
+	firstRunConsumerDependencies := kurtosis_instruction.NewInstructionDependencies()
+	firstRunConsumerDependencies.ReadRuntimeValuesInString(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, "first-run-uuid", "code"))
+	firstRunInstructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstructionWithDependencies(t, "request", firstRunProducerDependencies),
+		createMockInstructionWithDependencies(t, "consumer", firstRunConsumerDependencies),
+	}
+	for _, instruction := range firstRunInstructions {
+		instruction.(*mock_instruction.MockKurtosisInstruction).EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	}
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, firstRunInstructions)
+	require.Nil(t, executionError)
+
+	// the producer is identical, but the changed consumer needs the runtime value to be created again in this run
+	producerDependencies := kurtosis_instruction.NewInstructionDependencies()
+	producerDependencies.WriteRuntimeValue("second-run-uuid")
+	consumerDependencies := kurtosis_instruction.NewInstructionDependencies()
+	consumerDependencies.ReadRuntimeValuesInString(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, "second-run-uuid", "code"))
+	producer := createMockInstructionWithDependencies(t, "request", producerDependencies)
+	producer.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	consumer := createMockInstructionWithDependencies(t, "consumer_changed", consumerDependencies)
+	consumer.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		producer,
+		consumer,
+	}
+	_, _, executionError = executeSynchronouslyWithResume(t, executor, instructions)
+	require.Nil(t, executionError)
+	producer.AssertNumberOfCalls(t, "Execute", 1)
+	consumer.AssertNumberOfCalls(t, "Execute", 1)
+}
+
+func TestExecuteKurtosisInstructions_Resume_RestoresRuntimeValuesOfSkippedAddService(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceARegistration := service.NewServiceRegistration("service-a", "service-a-uuid", "enclave-uuid", net.ParseIP("172.16.0.10"), "service-a")
+	serviceBRegistration := service.NewServiceRegistration("service-b", "service-b-uuid", "enclave-uuid", net.ParseIP("172.16.0.11"), "service-b")
+	script := `
+def run(plan):
+	service_a = plan.add_service(service_name = "service-a", config = ServiceConfig(image = "` + testContainerImageName + `"))
+	plan.add_service(service_name = "service-b", config = ServiceConfig(image = "` + testContainerImageName + `", env_vars = {"SERVICE_A_IP": service_a.ip_address}))
+`
+
+	// service b fails to start in the first run
+	serviceNetwork.EXPECT().StartService(mock.Anything, service.ServiceName("service-a"), mock.Anything).Times(1).Return(
+		service.NewService(serviceARegistration, container_status.ContainerStatus_Running, nil, nil, nil), nil)
+	serviceNetwork.EXPECT().StartService(mock.Anything, service.ServiceName("service-b"), mock.Anything).Times(1).Return(
+		nil, errors.New("expected error for test"))
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, interpretScript(t, serviceNetwork, script))
+	require.NotNil(t, executionError)
+
+	// resuming doesn't start service a again, which would fail as it already exists, it reads the IP address of the
+	// running service a for service b instead
+	serviceNetwork.EXPECT().StartService(mock.Anything, service.ServiceName("service-a"), mock.Anything).Maybe().Return(
+		nil, errors.New("service 'service-a' already exists"))
+	serviceNetwork.EXPECT().GetServiceRegistration(service.ServiceName("service-a")).Times(1).Return(serviceARegistration, true)
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		service.ServiceName("service-b"),
+		mock.MatchedBy(func(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			return serviceConfig.GetEnvVars()["SERVICE_A_IP"] == serviceARegistration.GetPrivateIP().String()
+		}),
+	).Times(1).Return(service.NewService(serviceBRegistration, container_status.ContainerStatus_Running, nil, nil, nil), nil)
+	_, _, executionError = executeSynchronouslyWithResume(t, executor, interpretScript(t, serviceNetwork, script))
+	require.Nil(t, executionError)
+}
+
+func TestExecuteKurtosisInstructions_Resume_SkipsAppliedInstructionsMovedInThePlan(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	firstRunInstructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstruction(t, "instruction1", executeSuccessfully),
+		createMockInstruction(t, "instruction2", executeSuccessfully),
+	}
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, firstRunInstructions)
+	require.Nil(t, executionError)
+
+	newInstruction := createMockInstruction(t, "new_instruction", executeSuccessfully)
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
+	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
+	duplicatedInstruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		newInstruction,
+		instruction1,
+		instruction2,
+		duplicatedInstruction2,
+	}
+	_, _, executionError = executeSynchronouslyWithResume(t, executor, instructions)
+	require.Nil(t, executionError)
+	newInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	instruction1.AssertNumberOfCalls(t, "Execute", 0)
+	instruction2.AssertNumberOfCalls(t, "Execute", 0)
+	// the first run applied the instruction only once
+	duplicatedInstruction2.AssertNumberOfCalls(t, "Execute", 1)
+}
+
+func TestExecuteKurtosisInstructions_Resume_IgnoresInstructionsAppliedByOtherPackages(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	firstRunInstructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstruction(t, "instruction1", executeSuccessfully),
+	}
+	_, _, executionError := executeSynchronouslyWithOptions(t, executor, executeForReal, noParallelism, doNotResume, otherTestPackageId, firstRunInstructions)
+	require.Nil(t, executionError)
+
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+	}
+	_, _, executionError = executeSynchronouslyWithResume(t, executor, instructions)
+	require.Nil(t, executionError)
+	instruction1.AssertNumberOfCalls(t, "Execute", 1)
+}
+
+func TestExecuteKurtosisInstructions_NoResume_ExecutesAppliedInstructions(t *testing.T) {
+	executor := NewStartosisExecutor(execution_journal.NewExecutionJournal())
+
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, []kurtosis_instruction.KurtosisInstruction{
+		createMockInstruction(t, "instruction1", executeSuccessfully),
+	})
+	require.Nil(t, executionError)
+
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
+	_, _, executionError = executeSynchronously(t, executor, executeForReal, []kurtosis_instruction.KurtosisInstruction{
+		instruction1,
+	})
+	require.Nil(t, executionError)
+	instruction1.AssertNumberOfCalls(t, "Execute", 1)
+}
+
+func TestBuildInstructionsDependencyGraph(t *testing.T) {
+	serviceWrite := kurtosis_instruction.NewInstructionDependencies()
+	serviceWrite.WriteService("service")
+	otherServiceWrite := kurtosis_instruction.NewInstructionDependencies()
+	otherServiceWrite.WriteService("other-service")
+	serviceRead := kurtosis_instruction.NewInstructionDependencies()
+	serviceRead.ReadService("service")
+
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstructionWithDependencies(t, "instruction1", serviceWrite),
+		createMockInstructionWithDependencies(t, "instruction2", otherServiceWrite),
+		createMockInstructionWithDependencies(t, "instruction3", serviceRead),
+		createMockInstructionWithDependencies(t, "instruction4", kurtosis_instruction.NewBarrierInstructionDependencies()),
+		createMockInstructionWithDependencies(t, "instruction5", serviceRead),
+	}
+
+	dependentInstructions, numberOfDependencies := buildInstructionsDependencyGraph(getInstructionsDependencies(instructions))
+	require.Equal(t, [][]int{{2, 3, 4}, {3}, {3}, {4}, nil}, dependentInstructions)
+	require.Equal(t, []int{0, 0, 1, 3, 2}, numberOfDependencies)
+}
+
+func TestBuildInstructionsDependencyGraph_LiteralServiceReferences(t *testing.T) {
+	serviceWrite := kurtosis_instruction.NewInstructionDependencies()
+	serviceWrite.WriteService("db")
+	serviceWrite.WriteServiceHostname("postgres")
+	serviceNameReference := kurtosis_instruction.NewInstructionDependencies()
+	serviceNameReference.ReadReferencesInString("psql -h db.local -c 'SELECT 1'")
+	hostnameReference := kurtosis_instruction.NewInstructionDependencies()
+	hostnameReference.ReadReferencesInString("http://postgres:5432")
+	noReference := kurtosis_instruction.NewInstructionDependencies()
+	noReference.ReadReferencesInString("psql -h db-replica")
+
+	instructions := []kurtosis_instruction.KurtosisInstruction{
+		createMockInstructionWithDependencies(t, "instruction1", serviceWrite),
+		createMockInstructionWithDependencies(t, "instruction2", serviceNameReference),
+		createMockInstructionWithDependencies(t, "instruction3", hostnameReference),
+		createMockInstructionWithDependencies(t, "instruction4", noReference),
+	}
+
+	dependentInstructions, numberOfDependencies := buildInstructionsDependencyGraph(getInstructionsDependencies(instructions))
+	require.Equal(t, [][]int{{1, 2}, nil, nil, nil}, dependentInstructions)
+	require.Equal(t, []int{0, 1, 1, 0}, numberOfDependencies)
+}
+
 func createMockInstruction(t *testing.T, instructionName string, executeSuccessfully bool) *mock_instruction.MockKurtosisInstruction {
+	instruction := createMockInstructionWithDependencies(t, instructionName, kurtosis_instruction.NewInstructionDependencies())
+
+	if executeSuccessfully {
+		instruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
+	} else {
+		instruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, errors.New("expected error for test"))
+	}
+
+	return instruction
+}
+
+// createMockInstructionWithDependencies creates a mock instruction which does not expect any call to Execute
+func createMockInstructionWithDependencies(t *testing.T, instructionName string, dependencies *kurtosis_instruction.InstructionDependencies) *mock_instruction.MockKurtosisInstruction {
 	instruction := mock_instruction.NewMockKurtosisInstruction(t)
 
 	stringifiedInstruction := instructionName + "()"
@@ -128,24 +510,82 @@ func createMockInstruction(t *testing.T, instructionName string, executeSuccessf
 	instruction.EXPECT().GetCanonicalInstruction().Maybe().Return(canonicalInstruction)
 	instruction.EXPECT().GetPositionInOriginalScript().Maybe().Return(dummyPosition)
 	instruction.EXPECT().String().Maybe().Return(stringifiedInstruction)
+	instruction.EXPECT().GetDependencies().Maybe().Return(dependencies)
+	instruction.EXPECT().RestoreRuntimeValues().Maybe().Return(false)
+	return instruction
+}
 
-	if executeSuccessfully {
-		instruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
-	} else {
-		instruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, errors.New("expected error for test"))
-	}
+func createBlockingMockInstruction(t *testing.T, instructionName string, startedInstructions *sync.WaitGroup, dependencies *kurtosis_instruction.InstructionDependencies) *mock_instruction.MockKurtosisInstruction {
+	instruction := createMockInstructionWithDependencies(t, instructionName, dependencies)
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		startedInstructions.Done()
+		allInstructionsStarted := make(chan bool)
+		go func() {
+			startedInstructions.Wait()
+			close(allInstructionsStarted)
+		}()
+		select {
+		case <-allInstructionsStarted:
+			output := instructionName
+			return &output, nil
+		case <-time.After(blockingInstructionTimeout):
+			return nil, errors.New("timed out waiting for the other instructions to start")
+		}
+	}).Maybe()
+	return instruction
+}
 
+func createRecordingMockInstruction(t *testing.T, instructionName string, dependencies *kurtosis_instruction.InstructionDependencies, executionOrder *[]string, executionOrderMutex *sync.Mutex, executionDuration time.Duration) *mock_instruction.MockKurtosisInstruction {
+	instruction := createMockInstructionWithDependencies(t, instructionName, dependencies)
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		time.Sleep(executionDuration)
+		executionOrderMutex.Lock()
+		defer executionOrderMutex.Unlock()
+		*executionOrder = append(*executionOrder, instructionName)
+		return nil, nil
+	}).Maybe()
 	return instruction
 }
 
+func interpretScript(t *testing.T, serviceNetwork service_network.ServiceNetwork, script string) []kurtosis_instruction.KurtosisInstruction {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	interpreter := NewStartosisInterpreter(serviceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore())
+	_, instructions, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.Nil(t, interpretationError)
+	return instructions
+}
+
 func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool, instructions []kurtosis_instruction.KurtosisInstruction) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
+	return executeSynchronouslyWithOptions(t, executor, dryRun, noParallelism, doNotResume, testPackageId, instructions)
+}
+
+func executeSynchronouslyWithParallelism(t *testing.T, executor *StartosisExecutor, parallelism int, instructions []kurtosis_instruction.KurtosisInstruction) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
+	return executeSynchronouslyWithOptions(t, executor, executeForReal, parallelism, doNotResume, testPackageId, instructions)
+}
+
+func executeSynchronouslyWithResume(t *testing.T, executor *StartosisExecutor, instructions []kurtosis_instruction.KurtosisInstruction) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
+	return executeSynchronouslyWithOptions(t, executor, executeForReal, noParallelism, doResume, testPackageId, instructions)
+}
+
+func executeSynchronouslyWithOptions(t *testing.T, executor *StartosisExecutor, dryRun bool, parallelism int, resume bool, packageId string, instructions []kurtosis_instruction.KurtosisInstruction) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
 	scriptOutput := strings.Builder{}
 	var serializedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
 
-	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, instructions, noScriptOutputObject)
+	instructionsToSkip := map[int]bool{}
+	if resume {
+		instructionsToSkip = executor.GetInstructionsAlreadyApplied(packageId, instructions)
+	}
+	executionResponseLines := executor.Execute(context.Background(), dryRun, parallelism, packageId, instructionsToSkip, noApplyPlanChanges, instructions, noScriptOutputObject)
+	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
 	for executionResponseLine := range executionResponseLines {
+		// keep consuming the stream until it is closed, such that the executor can be reused by the test
+		if executionError != nil {
+			continue
+		}
 		if executionResponseLine.GetError() != nil {
-			return scriptOutput.String(), serializedInstructions, executionResponseLine.GetError().GetExecutionError()
+			executionError = executionResponseLine.GetError().GetExecutionError()
+			continue
 		}
 		if executionResponseLine.GetInstruction() != nil {
 			executedKurtosisInstruction := executionResponseLine.GetInstruction()
@@ -157,5 +597,5 @@ func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool
 			}
 		}
 	}
-	return scriptOutput.String(), serializedInstructions, nil
+	return scriptOutput.String(), serializedInstructions, executionError
 }
@@ -57,7 +57,8 @@ type StartosisInterpreter struct {
 	serviceNetwork     service_network.ServiceNetwork
 	recipeExecutor     *runtime_value_store.RuntimeValueStore
 	moduleGlobalsCache map[string]*startosis_packages.ModuleCacheEntry
-	// TODO AUTH there will be a leak here in case people with different repo visibility access a module
+	// The module globals cache can't leak private modules: the API container clones them with the Git credentials of
+	// the engine for its whole lifetime, and the content provider re-clones the ones cloned with other credentials
 	moduleContentProvider startosis_packages.PackageContentProvider
 }
 
@@ -83,6 +84,15 @@ func (interpreter *StartosisInterpreter) Interpret(_ context.Context, packageId
 	defer interpreter.mutex.Unlock()
 	var instructionsQueue []kurtosis_instruction.KurtosisInstruction
 
+	haveDependenciesChanged, interpretationErr := interpreter.moduleContentProvider.LoadPackageDependencies(packageId)
+	if interpretationErr != nil {
+		return startosis_constants.NoOutputObject, nil, interpretationErr.ToAPIType()
+	}
+	if haveDependenciesChanged {
+		// the cached modules might have been imported from other commits than the ones now locked or vendored
+		interpreter.moduleGlobalsCache = make(map[string]*startosis_packages.ModuleCacheEntry)
+	}
+
 	globalVariables, interpretationErr := interpreter.interpretInternal(packageId, serializedStarlark, &instructionsQueue)
 	if interpretationErr != nil {
 		return startosis_constants.NoOutputObject, nil, interpretationErr.ToAPIType()
@@ -0,0 +1,96 @@
+package git_package_content_provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"strings"
+)
+
+const (
+	temporaryKnownHostsFilePattern = "known-hosts-*"
+	noSshPrivateKeyPassword        = ""
+
+	// the fingerprint of the credentials a repository got cloned with is stored in its .git directory
+	gitDirname                        = ".git"
+	gitCredentialsFingerprintFilename = "kurtosis-git-credentials-fingerprint"
+	gitCredentialsFingerprintPerms    = 0600
+	gitCredentialsFingerprintFieldSep = "\x00"
+)
+
+// GitCredentials are the credentials private repositories of a Git host get cloned with: either a token, sent over
+// HTTPS, or an SSH private key, like a deploy key, along with the known hosts its server keys get verified against
+type GitCredentials struct {
+	username string
+	token    string
+
+	sshPrivateKey string
+	sshKnownHosts string
+}
+
+func NewTokenGitCredentials(username string, token string) *GitCredentials {
+	return &GitCredentials{
+		username:      username,
+		token:         token,
+		sshPrivateKey: "",
+		sshKnownHosts: "",
+	}
+}
+
+func NewSshGitCredentials(username string, sshPrivateKey string, sshKnownHosts string) *GitCredentials {
+	return &GitCredentials{
+		username:      username,
+		token:         "",
+		sshPrivateKey: sshPrivateKey,
+		sshKnownHosts: sshKnownHosts,
+	}
+}
+
+// getAuthMethod returns the go-git authentication of the clones made with these credentials, writing the known hosts
+// to a temporary file of tmpDir for the time it takes to load them
+func (credentials *GitCredentials) getAuthMethod(tmpDir string) (transport.AuthMethod, error) {
+	if credentials.sshPrivateKey == "" {
+		return &http.BasicAuth{
+			Username: credentials.username,
+			Password: credentials.token,
+		}, nil
+	}
+
+	publicKeys, err := ssh.NewPublicKeys(credentials.username, []byte(credentials.sshPrivateKey), noSshPrivateKeyPassword)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the SSH private key; only private keys that aren't protected by a password are supported")
+	}
+	if credentials.sshKnownHosts == "" {
+		return nil, stacktrace.NewError("No SSH known hosts were provided along with the SSH private key, so the key of the Git server can't be verified")
+	}
+	knownHostsFile, err := os.CreateTemp(tmpDir, temporaryKnownHostsFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file to write the SSH known hosts to")
+	}
+	defer os.Remove(knownHostsFile.Name())
+	_, err = knownHostsFile.WriteString(credentials.sshKnownHosts)
+	closeErr := knownHostsFile.Close()
+	if err != nil || closeErr != nil {
+		return nil, stacktrace.NewError("An error occurred writing the SSH known hosts to temporary file '%v'", knownHostsFile.Name())
+	}
+	hostKeyCallback, err := ssh.NewKnownHostsCallback(knownHostsFile.Name())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the SSH known hosts")
+	}
+	publicKeys.HostKeyCallback = hostKeyCallback
+	return publicKeys, nil
+}
+
+// getFingerprint identifies the credentials without revealing them
+func (credentials *GitCredentials) getFingerprint() string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		credentials.username,
+		credentials.token,
+		credentials.sshPrivateKey,
+	}, gitCredentialsFingerprintFieldSep)))
+	return hex.EncodeToString(hash[:])
+}
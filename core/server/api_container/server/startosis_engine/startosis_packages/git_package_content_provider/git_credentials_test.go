@@ -0,0 +1,29 @@
+package git_package_content_provider
+
+import (
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestGetAuthMethod_Token(t *testing.T) {
+	credentials := NewTokenGitCredentials("git", "my-token")
+	auth, err := credentials.getAuthMethod(os.TempDir())
+	require.Nil(t, err)
+	require.Equal(t, &http.BasicAuth{Username: "git", Password: "my-token"}, auth)
+}
+
+func TestGetAuthMethod_InvalidSshPrivateKey(t *testing.T) {
+	credentials := NewSshGitCredentials("git", "not a private key", "gitlab.mycompany.com ssh-ed25519 AAAA")
+	_, err := credentials.getAuthMethod(os.TempDir())
+	require.NotNil(t, err)
+}
+
+func TestGetFingerprint(t *testing.T) {
+	credentials := NewTokenGitCredentials("git", "my-token")
+	require.Equal(t, credentials.getFingerprint(), NewTokenGitCredentials("git", "my-token").getFingerprint())
+	require.NotEqual(t, credentials.getFingerprint(), NewTokenGitCredentials("git", "other-token").getFingerprint())
+	require.NotEqual(t, credentials.getFingerprint(), NewTokenGitCredentials("other-user", "my-token").getFingerprint())
+	require.NotContains(t, credentials.getFingerprint(), "my-token")
+}
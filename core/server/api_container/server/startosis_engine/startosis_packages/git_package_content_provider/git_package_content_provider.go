@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
@@ -12,6 +13,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
 	"strings"
 )
 
@@ -32,7 +34,6 @@ const (
 	depthAssumingBranchTagsCommitsAreSpecified = 0
 	howImportWorksLink                         = "https://docs.kurtosis.com/explanations/how-do-kurtosis-imports-work"
 	filePathToKurtosisYamlNotFound             = ""
-	replaceCountPackageDirWithGithubConstant   = 1
 
 	packageDocLink        = "https://docs.kurtosis.com/reference/packages"
 	osPathSeparatorString = string(os.PathSeparator)
@@ -41,21 +42,53 @@ const (
 type GitPackageContentProvider struct {
 	packagesTmpDir string
 	packagesDir    string
+
+	// the hosts packages can be fetched from, mapped to the prefix of the URLs their repositories get cloned from
+	cloneUrlPrefixPerGitHost map[string]string
+
+	// the credentials private repositories get cloned with; repositories of hosts without credentials get cloned anonymously
+	credentialsPerGitHost map[string]*GitCredentials
+
+	// the commit each repository, e.g. 'github.com/author/repo', is locked to by the lockfile of the package being run
+	lockedCommitPerRepository map[string]string
+
+	// the repositories vendored by the package being run, in its vendor directory, which get resolved before cloning
+	vendoredDependencyPerRepository map[string]*yaml_parser.LockedDependency
+	packageVendorDir                string
 }
 
-func NewGitPackageContentProvider(moduleDir string, tmpDir string) *GitPackageContentProvider {
+// NewGitPackageContentProvider creates a provider fetching packages from GitHub and from the given custom Git hosts,
+// which map a host like 'gitlab.mycompany.com' to the prefix of the URLs its repositories get cloned from, like
+// 'https://gitlab.mycompany.com' or 'file:///srv/git-mirrors'. A custom entry for GitHub replaces the default one.
+// The repositories of the hosts in credentialsPerGitHost get cloned with their credentials, the others anonymously
+func NewGitPackageContentProvider(moduleDir string, tmpDir string, cloneUrlPrefixPerCustomGitHost map[string]string, credentialsPerGitHost map[string]*GitCredentials) *GitPackageContentProvider {
+	cloneUrlPrefixPerGitHost := map[string]string{
+		startosis_constants.GithubDomainPrefix: githubCloneUrlPrefix,
+	}
+	for gitHost, cloneUrlPrefix := range cloneUrlPrefixPerCustomGitHost {
+		cloneUrlPrefixPerGitHost[gitHost] = cloneUrlPrefix
+	}
 	return &GitPackageContentProvider{
-		packagesDir:    moduleDir,
-		packagesTmpDir: tmpDir,
+		packagesDir:                     moduleDir,
+		packagesTmpDir:                  tmpDir,
+		cloneUrlPrefixPerGitHost:        cloneUrlPrefixPerGitHost,
+		credentialsPerGitHost:           credentialsPerGitHost,
+		lockedCommitPerRepository:       map[string]string{},
+		vendoredDependencyPerRepository: map[string]*yaml_parser.LockedDependency{},
+		packageVendorDir:                "",
 	}
 }
 
 func (provider *GitPackageContentProvider) ClonePackage(packageId string) (string, *startosis_errors.InterpretationError) {
-	parsedURL, interpretationError := parseGitURL(packageId)
+	parsedURL, interpretationError := parseGitURL(packageId, provider.cloneUrlPrefixPerGitHost)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
 
+	if interpretationError = provider.removeCloneMadeWithOtherCredentials(parsedURL); interpretationError != nil {
+		return "", interpretationError
+	}
+
 	interpretationError = provider.atomicClone(parsedURL)
 	if interpretationError != nil {
 		return "", interpretationError
@@ -70,14 +103,14 @@ func (provider *GitPackageContentProvider) ClonePackage(packageId string) (strin
 			startosis_constants.KurtosisYamlName, packageId, startosis_constants.KurtosisYamlName, packageDocLink)
 	}
 
-	if interpretationError = validateKurtosisYaml(pathToKurtosisYaml, provider.packagesDir); interpretationError != nil {
+	if interpretationError = validateKurtosisYaml(pathToKurtosisYaml, provider.packagesDir, provider.cloneUrlPrefixPerGitHost); interpretationError != nil {
 		return "", interpretationError
 	}
 	return packageAbsolutePathOnDisk, nil
 }
 
 func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsidePackageUrl string) (string, *startosis_errors.InterpretationError) {
-	parsedURL, interpretationError := parseGitURL(fileInsidePackageUrl)
+	parsedURL, interpretationError := parseGitURL(fileInsidePackageUrl, provider.cloneUrlPrefixPerGitHost)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -87,6 +120,22 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 	pathToFileOnDisk := path.Join(provider.packagesDir, parsedURL.relativeFilePath)
 	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
 
+	// vendored packages get resolved without touching the network
+	pathToVendoredFileOnDisk, isVendored, interpretationError := provider.getVendoredFilePath(parsedURL, fileInsidePackageUrl)
+	if interpretationError != nil {
+		return "", interpretationError
+	}
+	if isVendored {
+		return pathToVendoredFileOnDisk, nil
+	}
+
+	if interpretationError = provider.removeCloneMadeWithOtherCredentials(parsedURL); interpretationError != nil {
+		return "", interpretationError
+	}
+	if interpretationError = provider.applyLockedCommit(parsedURL); interpretationError != nil {
+		return "", interpretationError
+	}
+
 	// Return the file path straight if it exists
 	if _, err := os.Stat(pathToFileOnDisk); err == nil {
 		return pathToFileOnDisk, nil
@@ -115,7 +164,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 		return "", startosis_errors.NewInterpretationError("%v is not found in the path of '%v'; files can only be accessed from Kurtosis packages. For more information, go to: %v", startosis_constants.KurtosisYamlName, fileInsidePackageUrl, howImportWorksLink)
 	}
 
-	if interpretationError = validateKurtosisYaml(maybeKurtosisYamlPath, provider.packagesDir); interpretationError != nil {
+	if interpretationError = validateKurtosisYaml(maybeKurtosisYamlPath, provider.packagesDir, provider.cloneUrlPrefixPerGitHost); interpretationError != nil {
 		return "", interpretationError
 	}
 
@@ -137,8 +186,49 @@ func (provider *GitPackageContentProvider) GetModuleContents(fileInsideModuleUrl
 	return string(contents), nil
 }
 
+func (provider *GitPackageContentProvider) LoadPackageDependencies(packageId string) (bool, *startosis_errors.InterpretationError) {
+	lockedCommitPerRepository := map[string]string{}
+	vendoredDependencyPerRepository := map[string]*yaml_parser.LockedDependency{}
+	packageVendorDir := ""
+	if packageId != startosis_constants.PackageIdPlaceholderForStandaloneScript {
+		parsedPackageId, interpretationError := parseGitURL(packageId, provider.cloneUrlPrefixPerGitHost)
+		if interpretationError != nil {
+			return false, interpretationError
+		}
+		packageAbsolutePathOnDisk := path.Join(provider.packagesDir, getPathToPackageRoot(parsedPackageId))
+
+		kurtosisLock, interpretationError := parseOptionalKurtosisLock(path.Join(packageAbsolutePathOnDisk, startosis_constants.KurtosisLockName), packageId)
+		if interpretationError != nil {
+			return false, interpretationError
+		}
+		lockedCommitPerRepository = kurtosisLock.GetLockedCommitPerRepository()
+
+		packageVendorDir = path.Join(packageAbsolutePathOnDisk, startosis_constants.VendorDirname)
+		vendorManifest, interpretationError := parseOptionalKurtosisLock(path.Join(packageVendorDir, startosis_constants.KurtosisVendorManifestName), packageId)
+		if interpretationError != nil {
+			return false, interpretationError
+		}
+		if vendorManifest != nil {
+			vendoredDependencyPerRepository = vendorManifest.Dependencies
+		}
+	}
+	for repositoryLocator, commit := range lockedCommitPerRepository {
+		if !plumbing.IsHash(commit) {
+			return false, startosis_errors.NewInterpretationError("The %v of package '%v' locks '%v' to '%v', which isn't a full commit hash; run 'kurtosis package lock' to fix it", startosis_constants.KurtosisLockName, packageId, repositoryLocator, commit)
+		}
+	}
+
+	haveDependenciesChanged := !reflect.DeepEqual(provider.lockedCommitPerRepository, lockedCommitPerRepository) ||
+		!reflect.DeepEqual(provider.vendoredDependencyPerRepository, vendoredDependencyPerRepository) ||
+		(len(vendoredDependencyPerRepository) > 0 && provider.packageVendorDir != packageVendorDir)
+	provider.lockedCommitPerRepository = lockedCommitPerRepository
+	provider.vendoredDependencyPerRepository = vendoredDependencyPerRepository
+	provider.packageVendorDir = packageVendorDir
+	return haveDependenciesChanged, nil
+}
+
 func (provider *GitPackageContentProvider) StorePackageContents(packageId string, moduleTar []byte, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
-	parsedPackageId, interpretationError := parseGitURL(packageId)
+	parsedPackageId, interpretationError := parseGitURL(packageId, provider.cloneUrlPrefixPerGitHost)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -195,9 +285,18 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 		depth = depthAssumingBranchTagsCommitsAreSpecified
 	}
 
+	var auth transport.AuthMethod
+	credentials, hasCredentials := provider.credentialsPerGitHost[parsedURL.gitHost]
+	if hasCredentials {
+		auth, err = credentials.getAuthMethod(provider.packagesTmpDir)
+		if err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Cloning the module '%s' failed. An error occurred getting the authentication from the credentials of Git host '%v'", parsedURL.gitURL, parsedURL.gitHost)
+		}
+	}
+
 	repo, err := git.PlainClone(gitClonePath, isNotBareClone, &git.CloneOptions{
 		URL:               parsedURL.gitURL,
-		Auth:              nil,
+		Auth:              auth,
 		RemoteName:        "",
 		ReferenceName:     "",
 		SingleBranch:      false,
@@ -210,8 +309,10 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 		CABundle:          nil,
 	})
 	if err != nil {
-		// TODO remove public repository from error after we support private repositories
-		return startosis_errors.WrapWithInterpretationError(err, "Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and is a public repository.", parsedURL.gitURL, gitClonePath, parsedURL.gitURL)
+		if hasCredentials {
+			return startosis_errors.WrapWithInterpretationError(err, "Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and that the credentials of Git host '%v' can read it.", parsedURL.gitURL, gitClonePath, parsedURL.gitURL, parsedURL.gitHost)
+		}
+		return startosis_errors.WrapWithInterpretationError(err, "Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and is a public repository, or add credentials for Git host '%v' to the 'git-hosts' of the Kurtosis config.", parsedURL.gitURL, gitClonePath, parsedURL.gitURL, parsedURL.gitHost)
 	}
 
 	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
@@ -250,15 +351,24 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 		}
 	}
 
+	// Record the credentials the repository got cloned with, so it doesn't get served when using other ones
+	if hasCredentials {
+		fingerprintFilepath := path.Join(gitClonePath, gitDirname, gitCredentialsFingerprintFilename)
+		if err = os.WriteFile(fingerprintFilepath, []byte(credentials.getFingerprint()), gitCredentialsFingerprintPerms); err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Cloning the package '%s' failed. An error occurred writing the fingerprint of the credentials it got cloned with to '%s'", parsedURL.gitURL, fingerprintFilepath)
+		}
+	}
+
 	// Then we move it into the target directory
-	packageAuthorPath := path.Join(provider.packagesDir, parsedURL.moduleAuthor)
 	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
+	// this is the directory of the author, which for hosts other than GitHub is itself in the directory of the host
+	packageAuthorPath := path.Dir(packagePath)
 	fileMode, err := os.Stat(packageAuthorPath)
 	if err == nil && !fileMode.IsDir() {
 		return startosis_errors.WrapWithInterpretationError(err, "Expected '%s' to be a directory but it is something else", packageAuthorPath)
 	}
 	if err != nil {
-		if err = os.Mkdir(packageAuthorPath, moduleDirPermission); err != nil {
+		if err = os.MkdirAll(packageAuthorPath, moduleDirPermission); err != nil {
 			return startosis_errors.WrapWithInterpretationError(err, "Cloning the package '%s' failed. An error occurred while creating the directory '%s'.", parsedURL.gitURL, packageAuthorPath)
 		}
 	}
@@ -268,6 +378,112 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 	return nil
 }
 
+// removeCloneMadeWithOtherCredentials removes the repository of the given URL if it got cloned with other credentials
+// than the ones of its Git host, so that the content of a private repository only gets served to the credentials that
+// could read it. Repositories cloned anonymously and packages uploaded by the user are kept
+func (provider *GitPackageContentProvider) removeCloneMadeWithOtherCredentials(parsedURL *ParsedGitURL) *startosis_errors.InterpretationError {
+	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
+	fingerprintFilepath := path.Join(packagePath, gitDirname, gitCredentialsFingerprintFilename)
+	clonedWithFingerprint, err := os.ReadFile(fingerprintFilepath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred reading the fingerprint of the credentials the package '%v' got cloned with from '%v'", parsedURL.gitURL, fingerprintFilepath)
+	}
+
+	credentials, hasCredentials := provider.credentialsPerGitHost[parsedURL.gitHost]
+	if hasCredentials && string(clonedWithFingerprint) == credentials.getFingerprint() {
+		return nil
+	}
+	logrus.Debugf("Removing package '%v' as it got cloned with other credentials than the current ones of Git host '%v'", parsedURL.gitURL, parsedURL.gitHost)
+	if err = os.RemoveAll(packagePath); err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred removing the package '%v' cloned with other credentials from '%v'", parsedURL.gitURL, packagePath)
+	}
+	return nil
+}
+
+// applyLockedCommit makes the given URL without version point to the commit its repository is locked to, removing the
+// clone of the repository if it's at another commit. Clones without Git metadata, like the uploaded packages, are kept
+func (provider *GitPackageContentProvider) applyLockedCommit(parsedURL *ParsedGitURL) *startosis_errors.InterpretationError {
+	lockedCommit, found := provider.lockedCommitPerRepository[parsedURL.getRepositoryLocator()]
+	if !found || parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
+		return nil
+	}
+	parsedURL.tagBranchOrCommit = lockedCommit
+
+	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
+	repo, err := git.PlainOpen(packagePath)
+	if err == git.ErrRepositoryNotExists {
+		return nil
+	}
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred opening the clone of package '%v' at '%v'", parsedURL.gitURL, packagePath)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the commit the clone of package '%v' at '%v' is at", parsedURL.gitURL, packagePath)
+	}
+	if head.Hash().String() == lockedCommit {
+		return nil
+	}
+	logrus.Debugf("Removing package '%v' as it's at commit '%v' rather than the locked commit '%v'", parsedURL.gitURL, head.Hash().String(), lockedCommit)
+	if err = os.RemoveAll(packagePath); err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred removing the package '%v' at another commit than the locked one from '%v'", parsedURL.gitURL, packagePath)
+	}
+	return nil
+}
+
+// getVendoredFilePath returns the path of the vendored copy of the file of the given URL, if its repository got vendored
+// by the package being run at the version of the URL. URLs without version resolve to the vendored copy
+func (provider *GitPackageContentProvider) getVendoredFilePath(parsedURL *ParsedGitURL, fileInsidePackageUrl string) (string, bool, *startosis_errors.InterpretationError) {
+	vendoredDependency, found := provider.vendoredDependencyPerRepository[parsedURL.getRepositoryLocator()]
+	if !found || vendoredDependency == nil {
+		return "", false, nil
+	}
+	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit && parsedURL.tagBranchOrCommit != vendoredDependency.Version && parsedURL.tagBranchOrCommit != vendoredDependency.Commit {
+		logrus.Debugf("Not using the vendored copy of '%v' at '%v' for '%v', which is at another version", parsedURL.getRepositoryLocator(), vendoredDependency.Version, fileInsidePackageUrl)
+		return "", false, nil
+	}
+
+	// the vendor directory is laid out like the packages directory, except that GitHub repositories are under their host
+	vendoredPackagesDir := provider.packageVendorDir
+	if parsedURL.gitHost == startosis_constants.GithubDomainPrefix {
+		vendoredPackagesDir = path.Join(vendoredPackagesDir, startosis_constants.GithubDomainPrefix)
+	}
+	pathToFileOnDisk := path.Join(vendoredPackagesDir, parsedURL.relativeFilePath)
+	if _, err := os.Stat(pathToFileOnDisk); err != nil {
+		return "", false, startosis_errors.WrapWithInterpretationError(err, "'%v' wasn't found in the vendored copy of '%v' at '%v'; run 'kurtosis package vendor' again to vendor it", fileInsidePackageUrl, parsedURL.getRepositoryLocator(), vendoredDependency.Version)
+	}
+
+	maybeKurtosisYamlPath, interpretationError := getKurtosisYamlPathForFileUrl(pathToFileOnDisk, vendoredPackagesDir)
+	if interpretationError != nil {
+		return "", false, startosis_errors.WrapWithInterpretationError(interpretationError, "Error occurred while verifying whether '%v' belongs to a vendored Kurtosis package.", fileInsidePackageUrl)
+	}
+	if maybeKurtosisYamlPath == filePathToKurtosisYamlNotFound {
+		return "", false, startosis_errors.NewInterpretationError("%v is not found in the path of the vendored '%v'; files can only be accessed from Kurtosis packages. For more information, go to: %v", startosis_constants.KurtosisYamlName, fileInsidePackageUrl, howImportWorksLink)
+	}
+	if interpretationError = validateKurtosisYaml(maybeKurtosisYamlPath, vendoredPackagesDir, provider.cloneUrlPrefixPerGitHost); interpretationError != nil {
+		return "", false, interpretationError
+	}
+	return pathToFileOnDisk, true, nil
+}
+
+// parseOptionalKurtosisLock parses the lockfile at the given path, returning nil if it doesn't exist
+func parseOptionalKurtosisLock(pathToKurtosisLock string, packageId string) (*yaml_parser.KurtosisLock, *startosis_errors.InterpretationError) {
+	if _, err := os.Stat(pathToKurtosisLock); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while checking whether package '%v' has a '%v'", packageId, pathToKurtosisLock)
+	}
+	kurtosisLock, err := yaml_parser.ParseKurtosisLock(pathToKurtosisLock)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Error occurred while parsing the '%v' of package '%v'", pathToKurtosisLock, packageId)
+	}
+	return kurtosisLock, nil
+}
+
 // methods checks whether the root of the package is same as repository root
 // or it is a sub-folder under it
 func getPathToPackageRoot(parsedPackagePath *ParsedGitURL) string {
@@ -315,14 +531,14 @@ func getReferenceName(repo *git.Repository, parsedURL *ParsedGitURL) (plumbing.R
 }
 
 // this method validates the contents of the kurtosis.yml found at path identified by the absPathToKurtosisYmlInThePackage
-func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir string) *startosis_errors.InterpretationError {
+func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir string, cloneUrlPrefixPerGitHost map[string]string) *startosis_errors.InterpretationError {
 	kurtosisYaml, errWhileParsing := yaml_parser.ParseKurtosisYaml(absPathToKurtosisYmlInThePackage)
 	if errWhileParsing != nil {
 		return startosis_errors.WrapWithInterpretationError(errWhileParsing, "Error occurred while parsing %v", absPathToKurtosisYmlInThePackage)
 	}
 
 	// this method validates whether the package name is also the locator - it should the location where kurtosis.yml exists
-	if err := validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml, absPathToKurtosisYmlInThePackage, packageDir); err != nil {
+	if err := validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml, absPathToKurtosisYmlInThePackage, packageDir, cloneUrlPrefixPerGitHost); err != nil {
 		return startosis_errors.WrapWithInterpretationError(err, "Error occurred while validating %v", absPathToKurtosisYmlInThePackage)
 	}
 
@@ -330,9 +546,9 @@ func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir st
 }
 
 // this method validates whether the package name found in kurtosis yml is same as the location where kurtosis.yml is found
-func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.KurtosisYaml, absPathToKurtosisYmlInThePackage string, packageDir string) *startosis_errors.InterpretationError {
+func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.KurtosisYaml, absPathToKurtosisYmlInThePackage string, packageDir string, cloneUrlPrefixPerGitHost map[string]string) *startosis_errors.InterpretationError {
 	// get package name from absolute path to package
-	packageNameFromAbsPackagePath := strings.Replace(absPathToKurtosisYmlInThePackage, packageDir, startosis_constants.GithubDomainPrefix, replaceCountPackageDirWithGithubConstant)
+	packageNameFromAbsPackagePath := getLocatorFromAbsPathOnDisk(absPathToKurtosisYmlInThePackage, packageDir, cloneUrlPrefixPerGitHost)
 	packageName := kurtosisYaml.GetPackageName()
 
 	if strings.HasSuffix(packageName, osPathSeparatorString) {
@@ -340,7 +556,7 @@ func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.Ku
 	}
 
 	// re-using parseGitURL with packageName found from kurtosis.yml as it already does some validations
-	_, err := parseGitURL(packageName)
+	_, err := parseGitURL(packageName, cloneUrlPrefixPerGitHost)
 	if err != nil {
 		return startosis_errors.WrapWithInterpretationError(err, "Error occurred while validating package name: %v which is found in kurtosis.yml at: '%v'", kurtosisYaml.GetPackageName(), packageNameFromAbsPackagePath)
 	}
@@ -354,6 +570,17 @@ func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.Ku
 	return nil
 }
 
+// getLocatorFromAbsPathOnDisk is the opposite of parseGitURL: it returns the locator of a file or directory stored in the
+// packages directory, e.g. 'github.com/author/repo/kurtosis.yml' or 'gitlab.mycompany.com/author/repo/kurtosis.yml'
+func getLocatorFromAbsPathOnDisk(absPathOnDisk string, packagesDir string, cloneUrlPrefixPerGitHost map[string]string) string {
+	relativePathOnDisk := strings.Trim(strings.Replace(absPathOnDisk, packagesDir, replacedWithEmptyString, onlyOneReplacement), osPathSeparatorString)
+	firstDirname, _, _ := strings.Cut(relativePathOnDisk, osPathSeparatorString)
+	if _, found := cloneUrlPrefixPerGitHost[firstDirname]; found && firstDirname != startosis_constants.GithubDomainPrefix {
+		return relativePathOnDisk
+	}
+	return path.Join(startosis_constants.GithubDomainPrefix, relativePathOnDisk)
+}
+
 /**
 While importing/reading a file we are currently cloning the repository, and trying to find whether kurtosis.yml exists in the path;
 this is being done as part of interpretation step of starlark.
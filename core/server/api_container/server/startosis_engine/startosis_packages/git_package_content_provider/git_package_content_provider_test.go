@@ -2,6 +2,8 @@ package git_package_content_provider
 
 import (
 	"fmt"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
@@ -10,6 +12,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 )
 
 const (
@@ -17,6 +20,10 @@ const (
 	packagesTmpDirRelPath = "tmp-startosis-packages"
 )
 
+var noCustomGitHosts = map[string]string{}
+
+var noGitCredentials = map[string]*GitCredentials{}
+
 func TestGitPackageProvider_SucceedsForValidPackage(t *testing.T) {
 	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
 	require.Nil(t, err)
@@ -25,7 +32,7 @@ func TestGitPackageProvider_SucceedsForValidPackage(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -41,7 +48,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithExplicitMasterSet(t *test
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@main"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -57,7 +64,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithBranch(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@test-branch"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -73,7 +80,7 @@ func TestGitPackageProvider_FailsForInvalidBranch(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@non-existent-branch"
 	_, err = provider.GetModuleContents(sampleStartosisModule)
@@ -88,7 +95,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithTag(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@0.1.1"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -104,7 +111,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommit(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@ec9062828e1a687a5db7dfa750f754f88119e4c0"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -120,7 +127,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommitOnABranch(t *testin
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@df88baf51caffbe7e8f66c0e54715f680f4482b2"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -136,7 +143,7 @@ func TestGitPackageProvider_SucceedsForNonStarlarkFile(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	sampleStarlarkPackage := "github.com/kurtosis-tech/eth2-package/static_files/prometheus-config/prometheus.yml.tmpl"
 	contents, err := provider.GetModuleContents(sampleStarlarkPackage)
@@ -152,7 +159,7 @@ func TestGitPackageProvider_FailsForNonExistentPackage(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(oackageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(oackageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 	nonExistentModulePath := "github.com/kurtosis-tech/non-existent-startosis-load/sample.star"
 
 	_, err = provider.GetModuleContents(nonExistentModulePath)
@@ -167,7 +174,7 @@ func TestGetAbsolutePathOnDisk_WorksForPureDirectories(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, noCustomGitHosts, noGitCredentials)
 
 	packagePath := "github.com/kurtosis-tech/datastore-army-package/src/helpers.star"
 	pathOnDisk, err := provider.GetOnDiskAbsoluteFilePath(packagePath)
@@ -176,15 +183,241 @@ func TestGetAbsolutePathOnDisk_WorksForPureDirectories(t *testing.T) {
 	require.Equal(t, path.Join(packageDir, "kurtosis-tech", "datastore-army-package", "src/helpers.star"), pathOnDisk)
 }
 
+func TestGitPackageProvider_SucceedsForPackageOnFileMirror(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+	packageTmpDir, err := os.MkdirTemp("", packagesTmpDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageTmpDir)
+	mirrorDir := createFileMirrorWithPackage(t)
+	defer os.RemoveAll(mirrorDir)
+
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, map[string]string{
+		"git.mycompany.com": "file://" + mirrorDir,
+	}, noGitCredentials)
+
+	contents, interpretationErr := provider.GetModuleContents("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr, "This test depends on the git binary being installed")
+	require.Equal(t, "def run(plan):\n    pass\n", contents)
+	require.FileExists(t, path.Join(packageDir, "git.mycompany.com", "author", "repo", "main.star"))
+}
+
+func TestGitPackageProvider_DoesNotServeClonesMadeWithOtherCredentials(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+	packageTmpDir, err := os.MkdirTemp("", packagesTmpDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageTmpDir)
+	mirrorDir := createFileMirrorWithPackage(t)
+	defer os.RemoveAll(mirrorDir)
+	cloneUrlPrefixPerCustomGitHost := map[string]string{
+		"git.mycompany.com": "file://" + mirrorDir,
+	}
+	fingerprintFilepath := path.Join(packageDir, "git.mycompany.com", "author", "repo", gitDirname, gitCredentialsFingerprintFilename)
+	firstCredentials := NewTokenGitCredentials("git", "first-token")
+	secondCredentials := NewTokenGitCredentials("git", "second-token")
+
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, cloneUrlPrefixPerCustomGitHost, map[string]*GitCredentials{
+		"git.mycompany.com": firstCredentials,
+	})
+	_, interpretationErr := provider.GetOnDiskAbsoluteFilePath("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr, "This test depends on the git binary being installed")
+	fingerprint, err := os.ReadFile(fingerprintFilepath)
+	require.Nil(t, err)
+	require.Equal(t, firstCredentials.getFingerprint(), string(fingerprint))
+
+	// the package gets cloned again with the other credentials rather than served from the existing clone
+	provider = NewGitPackageContentProvider(packageDir, packageTmpDir, cloneUrlPrefixPerCustomGitHost, map[string]*GitCredentials{
+		"git.mycompany.com": secondCredentials,
+	})
+	_, interpretationErr = provider.GetOnDiskAbsoluteFilePath("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr)
+	fingerprint, err = os.ReadFile(fingerprintFilepath)
+	require.Nil(t, err)
+	require.Equal(t, secondCredentials.getFingerprint(), string(fingerprint))
+
+	// and again anonymously when there are no credentials anymore
+	provider = NewGitPackageContentProvider(packageDir, packageTmpDir, cloneUrlPrefixPerCustomGitHost, noGitCredentials)
+	_, interpretationErr = provider.GetOnDiskAbsoluteFilePath("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr)
+	require.NoFileExists(t, fingerprintFilepath)
+}
+
+func TestGitPackageProvider_ResolvesImportsToLockedCommits(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+	packageTmpDir, err := os.MkdirTemp("", packagesTmpDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageTmpDir)
+	mirrorDir := createFileMirrorWithPackage(t)
+	defer os.RemoveAll(mirrorDir)
+
+	// the package of the mirror gets a second commit
+	mirrorRepoPath := path.Join(mirrorDir, "author", "repo.git")
+	mirrorRepo, err := git.PlainOpen(mirrorRepoPath)
+	require.Nil(t, err)
+	firstCommit, err := mirrorRepo.Head()
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path.Join(mirrorRepoPath, "main.star"), []byte("def run(plan):\n    return 2\n"), 0644))
+	workTree, err := mirrorRepo.Worktree()
+	require.Nil(t, err)
+	secondCommit, err := workTree.Commit("Second commit", &git.CommitOptions{
+		All:       true,
+		Author:    &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		Committer: nil,
+		Parents:   nil,
+		SignKey:   nil,
+	})
+	require.Nil(t, err)
+
+	// the package being run locks the one of the mirror to its first commit
+	rootPackagePath := path.Join(packageDir, "me", "root")
+	require.Nil(t, os.MkdirAll(rootPackagePath, 0755))
+	writeLockfile := func(commit string) {
+		lockfileContent := fmt.Sprintf("dependencies:\n  git.mycompany.com/author/repo:\n    version: main\n    commit: %v\n", commit)
+		require.Nil(t, os.WriteFile(path.Join(rootPackagePath, startosis_constants.KurtosisLockName), []byte(lockfileContent), 0644))
+	}
+	writeLockfile(firstCommit.Hash().String())
+
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, map[string]string{
+		"git.mycompany.com": "file://" + mirrorDir,
+	}, noGitCredentials)
+
+	haveLockedCommitsChanged, interpretationErr := provider.LoadPackageDependencies("github.com/me/root")
+	require.Nil(t, interpretationErr)
+	require.True(t, haveLockedCommitsChanged)
+	contents, interpretationErr := provider.GetModuleContents("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr, "This test depends on the git binary being installed")
+	require.Equal(t, "def run(plan):\n    pass\n", contents)
+
+	haveLockedCommitsChanged, interpretationErr = provider.LoadPackageDependencies("github.com/me/root")
+	require.Nil(t, interpretationErr)
+	require.False(t, haveLockedCommitsChanged)
+
+	// locking the second commit replaces the clone of the first one
+	writeLockfile(secondCommit.String())
+	haveLockedCommitsChanged, interpretationErr = provider.LoadPackageDependencies("github.com/me/root")
+	require.Nil(t, interpretationErr)
+	require.True(t, haveLockedCommitsChanged)
+	contents, interpretationErr = provider.GetModuleContents("git.mycompany.com/author/repo/main.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "def run(plan):\n    return 2\n", contents)
+
+	// standalone scripts unlock every repository
+	haveLockedCommitsChanged, interpretationErr = provider.LoadPackageDependencies(startosis_constants.PackageIdPlaceholderForStandaloneScript)
+	require.Nil(t, interpretationErr)
+	require.True(t, haveLockedCommitsChanged)
+}
+
+func TestGitPackageProvider_FailsOnLockfileWithoutFullCommitHash(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+
+	rootPackagePath := path.Join(packageDir, "me", "root")
+	require.Nil(t, os.MkdirAll(rootPackagePath, 0755))
+	lockfileContent := "dependencies:\n  github.com/author/repo:\n    version: main\n    commit: main\n"
+	require.Nil(t, os.WriteFile(path.Join(rootPackagePath, startosis_constants.KurtosisLockName), []byte(lockfileContent), 0644))
+
+	provider := NewGitPackageContentProvider(packageDir, packageDir, noCustomGitHosts, noGitCredentials)
+	_, interpretationErr := provider.LoadPackageDependencies("github.com/me/root")
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "which isn't a full commit hash")
+}
+
+func TestGitPackageProvider_ResolvesImportsToVendoredCopies(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+	packageTmpDir, err := os.MkdirTemp("", packagesTmpDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageTmpDir)
+
+	// the package being run vendors a GitHub repository and one of a custom Git host, which can't be cloned from
+	rootPackagePath := path.Join(packageDir, "me", "root")
+	vendorDirPath := path.Join(rootPackagePath, startosis_constants.VendorDirname)
+	vendoredFiles := map[string]string{
+		"github.com/author/dep/kurtosis.yml":             "name: github.com/author/dep\n",
+		"github.com/author/dep/lib.star":                 "def run(plan):\n    return 1\n",
+		"git.mycompany.com/author/repo/pkg/kurtosis.yml": "name: git.mycompany.com/author/repo/pkg\n",
+		"git.mycompany.com/author/repo/pkg/main.star":    "def run(plan):\n    return 2\n",
+		startosis_constants.KurtosisVendorManifestName:   "dependencies:\n  github.com/author/dep:\n    version: v1.0.0\n    commit: 3f2a9c1b7d5e4f6a8b0c2d4e6f8a0b2c4d6e8f0a\n  git.mycompany.com/author/repo:\n    version: main\n    commit: 4f2a9c1b7d5e4f6a8b0c2d4e6f8a0b2c4d6e8f0a\n",
+	}
+	for vendoredFileRelPath, vendoredFileContent := range vendoredFiles {
+		vendoredFilePath := path.Join(vendorDirPath, vendoredFileRelPath)
+		require.Nil(t, os.MkdirAll(path.Dir(vendoredFilePath), 0755))
+		require.Nil(t, os.WriteFile(vendoredFilePath, []byte(vendoredFileContent), 0644))
+	}
+
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, map[string]string{
+		"git.mycompany.com": "file:///non-existent-mirror",
+	}, noGitCredentials)
+
+	haveDependenciesChanged, interpretationErr := provider.LoadPackageDependencies("github.com/me/root")
+	require.Nil(t, interpretationErr)
+	require.True(t, haveDependenciesChanged)
+
+	contents, interpretationErr := provider.GetModuleContents("github.com/author/dep/lib.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "def run(plan):\n    return 1\n", contents)
+	contents, interpretationErr = provider.GetModuleContents("github.com/author/dep/lib.star@v1.0.0")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "def run(plan):\n    return 1\n", contents)
+	contents, interpretationErr = provider.GetModuleContents("git.mycompany.com/author/repo/pkg/main.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "def run(plan):\n    return 2\n", contents)
+
+	// files that weren't vendored, and other versions, aren't served from the vendor directory
+	_, interpretationErr = provider.GetModuleContents("github.com/author/dep/other.star")
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "wasn't found in the vendored copy")
+	_, interpretationErr = provider.GetModuleContents("git.mycompany.com/author/repo/pkg/main.star@v2.0.0")
+	require.NotNil(t, interpretationErr)
+
+	haveDependenciesChanged, interpretationErr = provider.LoadPackageDependencies(startosis_constants.PackageIdPlaceholderForStandaloneScript)
+	require.Nil(t, interpretationErr)
+	require.True(t, haveDependenciesChanged)
+	_, interpretationErr = provider.GetModuleContents("git.mycompany.com/author/repo/pkg/main.star")
+	require.NotNil(t, interpretationErr)
+}
+
+// createFileMirrorWithPackage creates a directory mirroring the repository of package 'git.mycompany.com/author/repo'
+func createFileMirrorWithPackage(t *testing.T) string {
+	mirrorDir, err := os.MkdirTemp("", "git-mirror")
+	require.Nil(t, err)
+
+	repoPath := path.Join(mirrorDir, "author", "repo.git")
+	repo, err := git.PlainInit(repoPath, false)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path.Join(repoPath, startosis_constants.KurtosisYamlName), []byte("name: git.mycompany.com/author/repo\n"), 0644))
+	require.Nil(t, os.WriteFile(path.Join(repoPath, "main.star"), []byte("def run(plan):\n    pass\n"), 0644))
+	workTree, err := repo.Worktree()
+	require.Nil(t, err)
+	_, err = workTree.Add(".")
+	require.Nil(t, err)
+	_, err = workTree.Commit("Initial commit", &git.CommitOptions{
+		All:       false,
+		Author:    &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		Committer: nil,
+		Parents:   nil,
+		SignKey:   nil,
+	})
+	require.Nil(t, err)
+	return mirrorDir
+}
+
 func Test_getPathToPackageRoot(t *testing.T) {
 	githubUrlWithKurtosisPackageInSubfolder := "github.com/sample/sample-package/folder/subpackage"
-	parsedGitUrl, err := parseGitURL(githubUrlWithKurtosisPackageInSubfolder)
+	parsedGitUrl, err := parseGitURL(githubUrlWithKurtosisPackageInSubfolder, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err, "Unexpected error occurred while parsing git url")
 	actual := getPathToPackageRoot(parsedGitUrl)
 	require.Equal(t, "sample/sample-package/folder/subpackage", actual)
 
 	githubUrlWithRootKurtosisPackage := "github.com/sample/sample-package"
-	parsedGitUrl, err = parseGitURL(githubUrlWithRootKurtosisPackage)
+	parsedGitUrl, err = parseGitURL(githubUrlWithRootKurtosisPackage, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err, "Unexpected error occurred while parsing git url")
 	actual = getPathToPackageRoot(parsedGitUrl)
 	require.Equal(t, "sample/sample-package", actual)
@@ -303,7 +536,8 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 			name: "failure - mismatch package name and path (incorrect package name)",
 			args: args{
 				kurtosisYaml: &yaml_parser.KurtosisYaml{
-					PackageName: "github.com/author/repo/packageIncorrect",
+					PackageName:  "github.com/author/repo/packageIncorrect",
+					Dependencies: nil,
 				},
 				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/package/kurtosis.yml",
 				packagesDir:                     "/root/folder",
@@ -314,7 +548,8 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 			name: "failure - mismatch package name and path (different location)",
 			args: args{
 				kurtosisYaml: &yaml_parser.KurtosisYaml{
-					PackageName: "github.com/author/repo",
+					PackageName:  "github.com/author/repo",
+					Dependencies: nil,
 				},
 				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/subfolder/kurtosis.yml",
 				packagesDir:                     "/root/folder",
@@ -325,7 +560,8 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 			name: "failure - contains a trailing '/'",
 			args: args{
 				kurtosisYaml: &yaml_parser.KurtosisYaml{
-					PackageName: "github.com/author/repo/subfolder/",
+					PackageName:  "github.com/author/repo/subfolder/",
+					Dependencies: nil,
 				},
 				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/subfolder/kurtosis.yml",
 				packagesDir:                     "/root/folder",
@@ -336,7 +572,8 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 			name: "success - kurtosis.yml found in repo folder",
 			args: args{
 				kurtosisYaml: &yaml_parser.KurtosisYaml{
-					PackageName: "github.com/author/repo",
+					PackageName:  "github.com/author/repo",
+					Dependencies: nil,
 				},
 				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/kurtosis.yml",
 				packagesDir:                     "/root/folder",
@@ -347,17 +584,42 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 			name: "success - kurtosis.yml found in sub folder folder",
 			args: args{
 				kurtosisYaml: &yaml_parser.KurtosisYaml{
-					PackageName: "github.com/author/repo/subfolder",
+					PackageName:  "github.com/author/repo/subfolder",
+					Dependencies: nil,
 				},
 				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/subfolder/kurtosis.yml",
 				packagesDir:                     "/root/folder",
 			},
 			want: nil,
 		},
+		{
+			name: "success - kurtosis.yml found in repo folder of a custom Git host",
+			args: args{
+				kurtosisYaml: &yaml_parser.KurtosisYaml{
+					PackageName:  "git.mycompany.com/author/repo",
+					Dependencies: nil,
+				},
+				absPathToPackageWithKurtosisYml: "/root/folder/git.mycompany.com/author/repo/kurtosis.yml",
+				packagesDir:                     "/root/folder",
+			},
+			want: nil,
+		},
+		{
+			name: "failure - package of a custom Git host stored as a GitHub package",
+			args: args{
+				kurtosisYaml: &yaml_parser.KurtosisYaml{
+					PackageName:  "git.mycompany.com/author/repo",
+					Dependencies: nil,
+				},
+				absPathToPackageWithKurtosisYml: "/root/folder/author/repo/kurtosis.yml",
+				packagesDir:                     "/root/folder",
+			},
+			want: startosis_errors.NewInterpretationError("The package name in %v must match the location it is in. Package name is '%v' and kurtosis.yml is found here: '%v'", startosis_constants.KurtosisYamlName, "git.mycompany.com/author/repo", "github.com/author/repo"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePackageNameMatchesKurtosisYamlLocation(tt.args.kurtosisYaml, tt.args.absPathToPackageWithKurtosisYml, tt.args.packagesDir)
+			err := validatePackageNameMatchesKurtosisYamlLocation(tt.args.kurtosisYaml, tt.args.absPathToPackageWithKurtosisYml, tt.args.packagesDir, testCloneUrlPrefixPerGitHost)
 			if tt.want == nil {
 				require.Nil(t, err)
 			} else {
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 )
 
@@ -18,10 +19,17 @@ const (
 
 	tagBranchOrCommitDelimiter = "@"
 	emptyTagBranchOrCommit     = ""
+
+	githubCloneUrlPrefix = httpsSchema + "://" + startosis_constants.GithubDomainPrefix
+	// packages from GitHub are stored at the root of the packages directory, the ones of the other hosts in a
+	// directory named after the host. GitHub user and org names can't contain dots so the two never collide
+	githubPackagesRelativeDirpath = ""
 )
 
 // ParsedGitURL an object representing a parsed moduleURL
 type ParsedGitURL struct {
+	// gitHost the host the module is fetched from, e.g. github.com
+	gitHost string
 	// moduleAuthor the git of the module (GitHub user or org)
 	moduleAuthor string
 	// moduleName the name of the module
@@ -39,8 +47,9 @@ type ParsedGitURL struct {
 	tagBranchOrCommit string
 }
 
-func newParsedGitURL(moduleAuthor, moduleName, gitURL, relativeRepoPath, relativeFilePath string, tagBranchOrCommit string) *ParsedGitURL {
+func newParsedGitURL(gitHost, moduleAuthor, moduleName, gitURL, relativeRepoPath, relativeFilePath string, tagBranchOrCommit string) *ParsedGitURL {
 	return &ParsedGitURL{
+		gitHost:           gitHost,
 		moduleAuthor:      moduleAuthor,
 		moduleName:        moduleName,
 		gitURL:            gitURL,
@@ -50,9 +59,16 @@ func newParsedGitURL(moduleAuthor, moduleName, gitURL, relativeRepoPath, relativ
 	}
 }
 
-// parseGitURL this takes a Git url (GitHub) for now and converts it into the struct ParsedGitURL
-// This can in the future be extended to GitLab or BitBucket or any other Git Host
-func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.InterpretationError) {
+// getRepositoryLocator returns the locator of the repository of the module, e.g. 'github.com/author/repo', which is
+// what lockfiles lock to a commit
+func (parsedURL *ParsedGitURL) getRepositoryLocator() string {
+	return path.Join(parsedURL.gitHost, parsedURL.moduleAuthor, parsedURL.moduleName)
+}
+
+// parseGitURL this takes a Git url and converts it into the struct ParsedGitURL
+// The host of the URL must be one of the keys of cloneUrlPrefixPerGitHost, which maps the allowed hosts to the prefix
+// of the URLs their repositories get cloned from (e.g. 'https://gitlab.mycompany.com' or 'file:///srv/git-mirrors')
+func parseGitURL(packageURL string, cloneUrlPrefixPerGitHost map[string]string) (*ParsedGitURL, *startosis_errors.InterpretationError) {
 	// we expect something like github.com/author/module/path.star
 	// we don't want schemas
 	parsedURL, err := url.Parse(packageURL)
@@ -69,8 +85,9 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Error parsing the URL with scheme for module '%v'", packageURLPrefixedWithHttps)
 	}
-	if parsedURL.Host != startosis_constants.GithubDomainPrefix {
-		return nil, startosis_errors.NewInterpretationError("Error parsing the URL of module. We only support modules on Github for now but got '%v'", packageURL)
+	cloneUrlPrefix, found := cloneUrlPrefixPerGitHost[parsedURL.Host]
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Error parsing the URL of module '%v'. Its host '%v' isn't one of the allowed Git hosts '%v'; hosts other than GitHub need to be added to the 'git-hosts' of the Kurtosis config", packageURL, parsedURL.Host, getSortedGitHosts(cloneUrlPrefixPerGitHost))
 	}
 
 	pathWithoutVersion, maybeTagBranchOrCommit := parseOutTagBranchOrCommit(parsedURL.Path)
@@ -83,15 +100,17 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 
 	moduleAuthor := splitURLPath[0]
 	moduleName := splitURLPath[1]
-	gitURL := fmt.Sprintf("%v://%v/%v/%v.git", httpsSchema, startosis_constants.GithubDomainPrefix, moduleAuthor, moduleName)
-	relativeModulePath := path.Join(moduleAuthor, moduleName)
+	gitURL := fmt.Sprintf("%v/%v/%v.git", strings.TrimSuffix(cloneUrlPrefix, urlPathSeparator), moduleAuthor, moduleName)
+	gitHostRelativeDirpath := getGitHostRelativeDirpath(parsedURL.Host)
+	relativeModulePath := path.Join(gitHostRelativeDirpath, moduleAuthor, moduleName)
 
 	relativeFilePath := ""
 	if len(splitURLPath) > minimumSubPathsForValidGitURL {
-		relativeFilePath = path.Join(splitURLPath...)
+		relativeFilePath = path.Join(gitHostRelativeDirpath, path.Join(splitURLPath...))
 	}
 
 	parsedGitURL := newParsedGitURL(
+		parsedURL.Host,
 		moduleAuthor,
 		moduleName,
 		gitURL,
@@ -103,6 +122,24 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 	return parsedGitURL, nil
 }
 
+// getGitHostRelativeDirpath returns the directory, relative to the packages directory, where the packages of the given
+// Git host are stored
+func getGitHostRelativeDirpath(gitHost string) string {
+	if gitHost == startosis_constants.GithubDomainPrefix {
+		return githubPackagesRelativeDirpath
+	}
+	return gitHost
+}
+
+func getSortedGitHosts(cloneUrlPrefixPerGitHost map[string]string) []string {
+	gitHosts := []string{}
+	for gitHost := range cloneUrlPrefixPerGitHost {
+		gitHosts = append(gitHosts, gitHost)
+	}
+	sort.Strings(gitHosts)
+	return gitHosts
+}
+
 // cleanPath removes empty "" from the string slice
 func cleanPathAndSplit(urlPath string) []string {
 	cleanPath := path.Clean(urlPath)
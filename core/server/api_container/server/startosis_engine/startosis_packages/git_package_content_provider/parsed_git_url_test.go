@@ -13,13 +13,20 @@ const (
 	githubSampleURL                                        = "github.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
 	githubSampleUrlWithTag                                 = githubSampleURL + "@5.33.2"
 	githubSampleUrlWithBranchContainingVersioningDelimiter = githubSampleURL + "@my@favorite-branch"
+	testCustomGitHost                                      = "git.mycompany.com"
 )
 
+var testCloneUrlPrefixPerGitHost = map[string]string{
+	"github.com":      "https://github.com",
+	testCustomGitHost: "file:///srv/git-mirrors/",
+}
+
 func TestParsedGitURL_SimpleParse(t *testing.T) {
-	parsedURL, err := parseGitURL(githubSampleURL)
+	parsedURL, err := parseGitURL(githubSampleURL, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 
 	expectedParsedURL := newParsedGitURL(
+		"github.com",
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -33,10 +40,10 @@ func TestParsedGitURL_SimpleParse(t *testing.T) {
 
 func TestParsedGitURL_FailsOnNonGithubURL(t *testing.T) {
 	nonGithubURL := "kurtosis-git.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
-	_, err := parseGitURL(nonGithubURL)
+	_, err := parseGitURL(nonGithubURL, testCloneUrlPrefixPerGitHost)
 	require.NotNil(t, err)
 
-	expectedErrorMsg := "We only support modules on Github for now"
+	expectedErrorMsg := "Its host 'kurtosis-git.com' isn't one of the allowed Git hosts '[git.mycompany.com github.com]'"
 
 	require.Contains(t, err.Error(), expectedErrorMsg)
 }
@@ -44,7 +51,7 @@ func TestParsedGitURL_FailsOnNonGithubURL(t *testing.T) {
 func TestParsedGitURL_FailsOnNonNonEmptySchema(t *testing.T) {
 	ftpSchema := "ftp"
 	nonGithubURL := ftpSchema + "://github.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
-	_, err := parseGitURL(nonGithubURL)
+	_, err := parseGitURL(nonGithubURL, testCloneUrlPrefixPerGitHost)
 	require.NotNil(t, err)
 
 	expectedErrorMsg := fmt.Sprintf("Expected schema to be empty got '%v'", ftpSchema)
@@ -54,19 +61,19 @@ func TestParsedGitURL_FailsOnNonNonEmptySchema(t *testing.T) {
 
 func TestParsedGitURL_IfNoFileThenRelativeFilePathIsEmpty(t *testing.T) {
 	pathWithoutFile := "github.com/" + testModuleAuthor + "/" + testModuleName
-	parsedURL, err := parseGitURL(pathWithoutFile)
+	parsedURL, err := parseGitURL(pathWithoutFile, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 	require.Equal(t, "", parsedURL.relativeFilePath)
 }
 
 func TestParsedGitURL_ParsingGetsRidOfAnyPathEscapes(t *testing.T) {
 	escapedURLWithoutStartosisFile := "github.com/../etc/passwd"
-	parsedURL, err := parseGitURL(escapedURLWithoutStartosisFile)
+	parsedURL, err := parseGitURL(escapedURLWithoutStartosisFile, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 	require.Equal(t, "", parsedURL.relativeFilePath)
 
 	escapedURLWithStartosisFile := "github.com/../../etc/passwd/startosis.star"
-	parsedURL, err = parseGitURL(escapedURLWithStartosisFile)
+	parsedURL, err = parseGitURL(escapedURLWithStartosisFile, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 	require.Equal(t, parsedURL.moduleAuthor, "etc")
 	require.Equal(t, parsedURL.moduleName, "passwd")
@@ -75,7 +82,7 @@ func TestParsedGitURL_ParsingGetsRidOfAnyPathEscapes(t *testing.T) {
 	require.Equal(t, parsedURL.relativeRepoPath, "etc/passwd")
 
 	escapedURLWithStartosisFile = "github.com/foo/../etc/passwd/startosis.star"
-	parsedURL, err = parseGitURL(escapedURLWithStartosisFile)
+	parsedURL, err = parseGitURL(escapedURLWithStartosisFile, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 	require.Equal(t, parsedURL.moduleAuthor, "etc")
 	require.Equal(t, parsedURL.moduleName, "passwd")
@@ -84,17 +91,18 @@ func TestParsedGitURL_ParsingGetsRidOfAnyPathEscapes(t *testing.T) {
 	require.Equal(t, parsedURL.relativeRepoPath, "etc/passwd")
 
 	escapedURLWithStartosisFile = "github.com/foo/../etc/../passwd"
-	_, err = parseGitURL(escapedURLWithStartosisFile)
+	_, err = parseGitURL(escapedURLWithStartosisFile, testCloneUrlPrefixPerGitHost)
 	require.NotNil(t, err)
 	expectedErrorMsg := fmt.Sprintf("Error parsing the URL of module: '%s'. The path should contain at least 2 subpaths got '[passwd]'", escapedURLWithStartosisFile)
 	require.Contains(t, err.Error(), expectedErrorMsg)
 }
 
 func TestParsedGitURL_WorksWithVersioningInformation(t *testing.T) {
-	parsedURL, err := parseGitURL(githubSampleUrlWithTag)
+	parsedURL, err := parseGitURL(githubSampleUrlWithTag, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 
 	expectedParsedURL := newParsedGitURL(
+		"github.com",
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -105,10 +113,11 @@ func TestParsedGitURL_WorksWithVersioningInformation(t *testing.T) {
 
 	require.Equal(t, expectedParsedURL, parsedURL)
 
-	parsedURL, err = parseGitURL(githubSampleUrlWithBranchContainingVersioningDelimiter)
+	parsedURL, err = parseGitURL(githubSampleUrlWithBranchContainingVersioningDelimiter, testCloneUrlPrefixPerGitHost)
 	require.Nil(t, err)
 
 	expectedParsedURL = newParsedGitURL(
+		"github.com",
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -119,3 +128,21 @@ func TestParsedGitURL_WorksWithVersioningInformation(t *testing.T) {
 
 	require.Equal(t, expectedParsedURL, parsedURL)
 }
+
+func TestParsedGitURL_CustomGitHost(t *testing.T) {
+	customGitHostURL := testCustomGitHost + "/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
+	parsedURL, err := parseGitURL(customGitHostURL, testCloneUrlPrefixPerGitHost)
+	require.Nil(t, err)
+
+	expectedParsedURL := newParsedGitURL(
+		testCustomGitHost,
+		testModuleAuthor,
+		testModuleName,
+		fmt.Sprintf("file:///srv/git-mirrors/%v/%v.git", testModuleAuthor, testModuleName),
+		fmt.Sprintf("%v/%v/%v", testCustomGitHost, testModuleAuthor, testModuleName),
+		fmt.Sprintf("%v/%v/%v/%v", testCustomGitHost, testModuleAuthor, testModuleName, testFileName),
+		emptyTagBranchOrCommit,
+	)
+
+	require.Equal(t, expectedParsedURL, parsedURL)
+}
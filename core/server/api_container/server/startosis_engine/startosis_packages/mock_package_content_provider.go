@@ -182,6 +182,60 @@ func (_c *MockPackageContentProvider_GetOnDiskAbsoluteFilePath_Call) RunAndRetur
 	return _c
 }
 
+// LoadPackageDependencies provides a mock function with given fields: packageId
+func (_m *MockPackageContentProvider) LoadPackageDependencies(packageId string) (bool, *startosis_errors.InterpretationError) {
+	ret := _m.Called(packageId)
+
+	var r0 bool
+	var r1 *startosis_errors.InterpretationError
+	if rf, ok := ret.Get(0).(func(string) (bool, *startosis_errors.InterpretationError)); ok {
+		return rf(packageId)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(packageId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *startosis_errors.InterpretationError); ok {
+		r1 = rf(packageId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*startosis_errors.InterpretationError)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockPackageContentProvider_LoadPackageDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPackageDependencies'
+type MockPackageContentProvider_LoadPackageDependencies_Call struct {
+	*mock.Call
+}
+
+// LoadPackageDependencies is a helper method to define mock.On call
+//   - packageId string
+func (_e *MockPackageContentProvider_Expecter) LoadPackageDependencies(packageId interface{}) *MockPackageContentProvider_LoadPackageDependencies_Call {
+	return &MockPackageContentProvider_LoadPackageDependencies_Call{Call: _e.mock.On("LoadPackageDependencies", packageId)}
+}
+
+func (_c *MockPackageContentProvider_LoadPackageDependencies_Call) Run(run func(packageId string)) *MockPackageContentProvider_LoadPackageDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_LoadPackageDependencies_Call) Return(_a0 bool, _a1 *startosis_errors.InterpretationError) *MockPackageContentProvider_LoadPackageDependencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_LoadPackageDependencies_Call) RunAndReturn(run func(string) (bool, *startosis_errors.InterpretationError)) *MockPackageContentProvider_LoadPackageDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorePackageContents provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockPackageContentProvider) StorePackageContents(_a0 string, _a1 []byte, _a2 bool) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(_a0, _a1, _a2)
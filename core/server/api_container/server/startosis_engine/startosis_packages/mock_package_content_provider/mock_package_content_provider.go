@@ -45,6 +45,10 @@ func (provider *MockPackageContentProvider) StorePackageContents(string, []byte,
 	panic(unimplementedMessage)
 }
 
+func (provider *MockPackageContentProvider) LoadPackageDependencies(_ string) (bool, *startosis_errors.InterpretationError) {
+	return false, nil
+}
+
 func (provider *MockPackageContentProvider) GetModuleContents(packageId string) (string, *startosis_errors.InterpretationError) {
 	absFilePath, found := provider.starlarkPackages[packageId]
 	if !found {
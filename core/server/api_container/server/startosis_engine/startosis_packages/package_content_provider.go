@@ -17,4 +17,10 @@ type PackageContentProvider interface {
 
 	// ClonePackage clones the package with the given id and returns the absolute path on disk
 	ClonePackage(packageId string) (string, *startosis_errors.InterpretationError)
+
+	// LoadPackageDependencies makes the imports without version of the repositories locked by the lockfile of the given
+	// package, which must already be on disk, resolve to their locked commit, and the imports of the repositories it
+	// vendored resolve to the vendored copies. A package without lockfile nor vendor directory, or a standalone script,
+	// unlocks every repository. Returns whether the locked or vendored commits changed
+	LoadPackageDependencies(packageId string) (bool, *startosis_errors.InterpretationError)
 }
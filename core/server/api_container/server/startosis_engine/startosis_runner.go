@@ -4,39 +4,62 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 )
 
 type StartosisRunner struct {
 	startosisInterpreter *StartosisInterpreter
 
+	startosisApplyPlanner *StartosisApplyPlanner
+
 	startosisValidator *StartosisValidator
 
 	startosisExecutor *StartosisExecutor
+
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
 }
 
 const (
 	defaultCurrentStepNumber  = 0
 	defaultTotalStepsNumber   = 0
 	startingInterpretationMsg = "Interpreting Starlark code - execution will begin shortly"
+	startingApplyPlanningMsg  = "Comparing instructions with the current state of the enclave"
 	startingValidationMsg     = "Starting validation"
 	startingExecutionMsg      = "Starting execution"
+
+	hiddenDirnamePrefix = "."
 )
 
-func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor) *StartosisRunner {
+func NewStartosisRunner(interpreter *StartosisInterpreter, applyPlanner *StartosisApplyPlanner, validator *StartosisValidator, executor *StartosisExecutor, runtimeValueStore *runtime_value_store.RuntimeValueStore) *StartosisRunner {
 	return &StartosisRunner{
-		startosisInterpreter: interpreter,
-		startosisValidator:   validator,
-		startosisExecutor:    executor,
+		startosisInterpreter:  interpreter,
+		startosisApplyPlanner: applyPlanner,
+		startosisValidator:    validator,
+		startosisExecutor:     executor,
+		runtimeValueStore:     runtimeValueStore,
 	}
 }
 
-func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism int, packageId string, serializedStartosis string, serializedParams string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism int, resume bool, apply bool, removeUndeclaredServices bool, packageId string, serializedStartosis string, serializedParams string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	// TODO(gb): add metric tracking maybe?
 	starlarkRunResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
+	// registered before the goroutine starts, such that a run finishing in the meantime can't remove the runtime values
+	// this run is about to create
+	runner.runtimeValueStore.StartRun()
 	go func() {
 		defer close(starlarkRunResponseLines)
+		defer runner.runtimeValueStore.FinishRun()
 
 		// Interpretation starts > send progress info (this line will be invisible as interpretation is super quick)
 		progressInfo := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
@@ -53,12 +76,38 @@ func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism
 		logrus.Debugf("Successfully interpreted Starlark script into a series of Kurtosis instructions: \n%v",
 			instructionsList)
 
+		instructionsToSkip := map[int]bool{}
+		servicesToRemove := map[service.ServiceName]bool{}
+		var applyPlanChanges map[int][]*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+		if apply {
+			// Apply planning starts > send progress info
+			progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
+				startingApplyPlanningMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
+			starlarkRunResponseLines <- progressInfo
+
+			var changes []*kurtosis_core_rpc_api_bindings.StarlarkApplyPlanChange
+			var err error
+			changes, instructionsToSkip, servicesToRemove, err = runner.startosisApplyPlanner.Plan(instructionsList, removeUndeclaredServices)
+			if err != nil {
+				planningError := startosis_errors.WrapWithValidationError(err, "An error occurred comparing the instructions with the current state of the enclave")
+				starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(planningError.ToAPIType())
+				starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+				return
+			}
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromApplyPlan(changes)
+			applyPlanChanges = getInstructionsApplyPlanChanges(changes)
+			logrus.Debugf("Successfully planned the changes to apply to the enclave: %d instruction(s) out of %d will be skipped and %d undeclared service(s) will be removed",
+				len(instructionsToSkip), len(instructionsList), len(servicesToRemove))
+		} else if resume {
+			instructionsToSkip = runner.startosisExecutor.GetInstructionsAlreadyApplied(packageId, instructionsList)
+		}
+
 		// Validation starts > send progress info
 		progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingValidationMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
 		starlarkRunResponseLines <- progressInfo
 
-		validationErrorsChan := runner.startosisValidator.Validate(ctx, instructionsList)
+		validationErrorsChan := runner.startosisValidator.Validate(ctx, instructionsList, instructionsToSkip, getServicesRedeclaredByApplyPlan(applyPlanChanges))
 		if isRunFinished := forwardKurtosisResponseLineChannelUntilSourceIsClosed(validationErrorsChan, starlarkRunResponseLines); isRunFinished {
 			return
 		}
@@ -69,7 +118,12 @@ func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
 		starlarkRunResponseLines <- progressInfo
 
-		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, instructionsList, serializedScriptOutput)
+		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, packageId, instructionsToSkip, applyPlanChanges, instructionsList, serializedScriptOutput)
+		if !dryRun && len(servicesToRemove) > 0 {
+			// the services not declared anymore are only removed once the package is successfully applied, such that
+			// a failed apply doesn't remove anything
+			executionResponseLinesChan = runner.removeUndeclaredServicesOnSuccess(ctx, executionResponseLinesChan, servicesToRemove)
+		}
 		if isRunFinished := forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, starlarkRunResponseLines); !isRunFinished {
 			logrus.Warnf("Execution finished but no 'RunFinishedEvent' was received through the stream. This is unexpected as every execution should be terminal.")
 		}
@@ -78,6 +132,64 @@ func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism
 	return starlarkRunResponseLines
 }
 
+// RunTests runs the tests of the package stored on disk at the given path, which are the test functions of its files
+// whose name ends with '_test.star'. The vendored packages and the hidden directories of the package are skipped
+func (runner *StartosisRunner) RunTests(ctx context.Context, packageId string, packageRootPathOnDisk string) ([]*kurtosis_core_rpc_api_bindings.StarlarkTestResult, error) {
+	testResults := []*kurtosis_core_rpc_api_bindings.StarlarkTestResult{}
+	vendorDirpath := path.Join(packageRootPathOnDisk, startosis_constants.VendorDirname)
+	err := filepath.WalkDir(packageRootPathOnDisk, func(filepathOnDisk string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred walking through '%v'", filepathOnDisk)
+		}
+		if entry.IsDir() {
+			if filepathOnDisk != packageRootPathOnDisk && (filepathOnDisk == vendorDirpath || strings.HasPrefix(entry.Name(), hiddenDirnamePrefix)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(entry.Name(), startosis_constants.TestFileSuffix) {
+			return nil
+		}
+		serializedTestFile, err := os.ReadFile(filepathOnDisk)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading test file '%v'", filepathOnDisk)
+		}
+		testFileId := path.Join(packageId, strings.TrimPrefix(filepathOnDisk, packageRootPathOnDisk))
+		logrus.Debugf("Running the tests of test file '%v'", testFileId)
+		testResults = append(testResults, runner.startosisInterpreter.InterpretTests(ctx, packageId, testFileId, string(serializedTestFile))...)
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of package '%v'", packageId)
+	}
+	return testResults, nil
+}
+
+// removeUndeclaredServicesOnSuccess forwards the lines of a successful execution, removing the undeclared services
+// before forwarding the event finishing the run. If they can't be removed, the run fails
+func (runner *StartosisRunner) removeUndeclaredServicesOnSuccess(ctx context.Context, executionResponseLines <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, servicesToRemove map[service.ServiceName]bool) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	forwardedResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go func() {
+		defer close(forwardedResponseLines)
+		for executionResponseLine := range executionResponseLines {
+			runFinishedEvent := executionResponseLine.GetRunFinishedEvent()
+			if runFinishedEvent == nil || !runFinishedEvent.GetIsRunSuccessful() {
+				forwardedResponseLines <- executionResponseLine
+				continue
+			}
+			if err := runner.startosisApplyPlanner.RemoveUndeclaredServices(ctx, servicesToRemove); err != nil {
+				propagatedError := stacktrace.Propagate(err, "The package was applied but an error occurred removing the services it doesn't declare anymore")
+				serializedError := binding_constructors.NewStarlarkExecutionError(propagatedError.Error())
+				forwardedResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
+				forwardedResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+				continue
+			}
+			forwardedResponseLines <- executionResponseLine
+		}
+	}()
+	return forwardedResponseLines
+}
+
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isStarlarkRunFinished := false
 	for executionResponseLine := range sourceChan {
@@ -0,0 +1,102 @@
+package startosis_engine
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/plan_module"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	starlarkGoTestThreadName = "Startosis test interpreter thread"
+
+	testFunctionNamePrefix = "test_"
+
+	maximumParamsAllowedForTestFunction = 1
+
+	// the result of a test file that couldn't be interpreted isn't the one of a test function
+	noTestFunctionName = ""
+)
+
+// InterpretTests interprets a Starlark test file of a package and calls each of its test functions, the functions
+// whose name starts with 'test_', in the order they are declared. Each of them gets a new test plan, which adds the
+// instructions to a queue the test can assert on rather than to the plan of the enclave, and lets the test stub the
+// results of the recipes. The instructions are interpreted against a no-op service network, so running the tests
+// doesn't touch the enclave
+func (interpreter *StartosisInterpreter) InterpretTests(_ context.Context, packageId string, testFileId string, serializedTestFile string) []*kurtosis_core_rpc_api_bindings.StarlarkTestResult {
+	interpreter.mutex.Lock()
+	defer interpreter.mutex.Unlock()
+	testFilepath := strings.TrimPrefix(testFileId, packageId+"/")
+
+	haveDependenciesChanged, interpretationErr := interpreter.moduleContentProvider.LoadPackageDependencies(packageId)
+	if interpretationErr != nil {
+		return []*kurtosis_core_rpc_api_bindings.StarlarkTestResult{binding_constructors.NewFailedStarlarkTestResult(testFilepath, noTestFunctionName, interpretationErr.ToAPIType())}
+	}
+	if haveDependenciesChanged {
+		interpreter.moduleGlobalsCache = make(map[string]*startosis_packages.ModuleCacheEntry)
+	}
+
+	// each test file gets its own module cache, such that the test files don't share the state of the modules they import
+	testInterpreter := NewStartosisInterpreter(service_network.NewNoOpServiceNetwork(), interpreter.moduleContentProvider, runtime_value_store.NewRuntimeValueStore())
+	var instructionsQueue []kurtosis_instruction.KurtosisInstruction
+	globalVariables, interpretationErr := testInterpreter.interpretInternal(testFileId, serializedTestFile, &instructionsQueue)
+	if interpretationErr != nil {
+		return []*kurtosis_core_rpc_api_bindings.StarlarkTestResult{binding_constructors.NewFailedStarlarkTestResult(testFilepath, noTestFunctionName, interpretationErr.ToAPIType())}
+	}
+
+	testResults := []*kurtosis_core_rpc_api_bindings.StarlarkTestResult{}
+	for _, testFunction := range getTestFunctions(globalVariables) {
+		interpretationErr = testInterpreter.callTestFunction(testFunction)
+		if interpretationErr != nil {
+			testResults = append(testResults, binding_constructors.NewFailedStarlarkTestResult(testFilepath, testFunction.Name(), interpretationErr.ToAPIType()))
+			continue
+		}
+		testResults = append(testResults, binding_constructors.NewSuccessfulStarlarkTestResult(testFilepath, testFunction.Name()))
+	}
+	return testResults
+}
+
+func (interpreter *StartosisInterpreter) callTestFunction(testFunction *starlark.Function) *startosis_errors.InterpretationError {
+	if testFunction.NumParams() > maximumParamsAllowedForTestFunction {
+		return startosis_errors.NewInterpretationError("Test function '%v' can have at most '%v' argument got '%v'", testFunction.Name(), maximumParamsAllowedForTestFunction, testFunction.NumParams())
+	}
+
+	var instructionsQueue []kurtosis_instruction.KurtosisInstruction
+	var argsTuple starlark.Tuple
+	if testFunction.NumParams() == maximumParamsAllowedForTestFunction {
+		if paramName, _ := testFunction.Param(planParamIndex); paramName != planParamName {
+			return startosis_errors.NewInterpretationError(unexpectedArgNameError, planParamIndex, planParamName, paramName)
+		}
+		kurtosisPlanInstructions := KurtosisPlanInstructions(interpreter.serviceNetwork, interpreter.recipeExecutor, interpreter.moduleContentProvider)
+		argsTuple = append(argsTuple, plan_module.TestPlanModule(&instructionsQueue, kurtosisPlanInstructions))
+	}
+
+	if _, err := starlark.Call(newStarlarkThread(starlarkGoTestThreadName), testFunction, argsTuple, noKwargs); err != nil {
+		return generateInterpretationError(err)
+	}
+	return nil
+}
+
+// getTestFunctions returns the test functions of the test file, sorted by their position in it
+func getTestFunctions(globalVariables starlark.StringDict) []*starlark.Function {
+	testFunctions := []*starlark.Function{}
+	for name, value := range globalVariables {
+		testFunction, ok := value.(*starlark.Function)
+		if !ok || !strings.HasPrefix(name, testFunctionNamePrefix) {
+			continue
+		}
+		testFunctions = append(testFunctions, testFunction)
+	}
+	sort.Slice(testFunctions, func(i, j int) bool {
+		return testFunctions[i].Position().Line < testFunctions[j].Position().Line
+	})
+	return testFunctions
+}
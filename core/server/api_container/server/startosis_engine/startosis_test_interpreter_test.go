@@ -0,0 +1,142 @@
+package startosis_engine
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	testPackageId    = "github.com/kurtosis-tech/test-package"
+	testTestFilepath = "tests/main_test.star"
+	testTestFileId   = testPackageId + "/" + testTestFilepath
+
+	testPackageMainFile = `
+def run(plan):
+	plan.add_service(service_name = "api", config = ServiceConfig(image = "api-image"))
+	response = plan.request(service_name = "api", recipe = GetHttpRequestRecipe(port_id = "http", endpoint = "/health"))
+	if response["code"] == 200:
+		plan.print("healthy")
+	else:
+		plan.print("unhealthy")
+`
+)
+
+func TestStartosisInterpreter_InterpretTestsRunsTestFunctionsInOrder(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	require.Nil(t, packageContentProvider.AddFileContent(testPackageId+"/main.star", testPackageMainFile))
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore())
+	testFile := `
+main = import_module("` + testPackageId + `/main.star")
+
+def test_adds_api_service(plan):
+	main.run(plan)
+	instructions = plan.get_instructions()
+	if len(instructions) != 3 or instructions[0].name != "add_service" or instructions[0].args["service_name"] != '"api"':
+		fail("Expected the api service to be added first")
+
+def test_prints_healthy_when_api_responds(plan):
+	plan.stub("request", {"code": 200}, service_name = "api")
+	main.run(plan)
+	if plan.get_instructions()[-1].args["msg"] != '"healthy"':
+		fail("Expected the package to print healthy")
+
+def test_prints_healthy_without_stub(plan):
+	main.run(plan)
+	if plan.get_instructions()[-1].args["msg"] != '"healthy"':
+		fail("Expected the package to print healthy")
+
+def test_without_plan():
+	pass
+
+def not_a_test(plan):
+	fail("Not a test")
+`
+
+	testResults := interpreter.InterpretTests(context.Background(), testPackageId, testTestFileId, testFile)
+	require.Len(t, testResults, 4)
+	expectedTestFunctionNames := []string{"test_adds_api_service", "test_prints_healthy_when_api_responds", "test_prints_healthy_without_stub", "test_without_plan"}
+	for idx, testResult := range testResults {
+		require.Equal(t, testTestFilepath, testResult.GetTestFilepath())
+		require.Equal(t, expectedTestFunctionNames[idx], testResult.GetTestFunctionName())
+	}
+	require.Nil(t, testResults[0].Failure)
+	require.Nil(t, testResults[1].Failure)
+	require.NotNil(t, testResults[2].Failure)
+	require.Contains(t, testResults[2].GetFailure().GetErrorMessage(), "Expected the package to print healthy")
+	require.Nil(t, testResults[3].Failure)
+}
+
+func TestStartosisInterpreter_InterpretTestsFailsOnStubbedResultWithUnknownField(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	require.Nil(t, packageContentProvider.AddFileContent(testPackageId+"/main.star", testPackageMainFile))
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore())
+	testFile := `
+main = import_module("` + testPackageId + `/main.star")
+
+def test_stubs_unknown_field(plan):
+	plan.stub("request", {"status": 200})
+	main.run(plan)
+
+def test_stubs_instruction_without_recipe(plan):
+	plan.stub("add_service", {})
+`
+
+	testResults := interpreter.InterpretTests(context.Background(), testPackageId, testTestFileId, testFile)
+	require.Len(t, testResults, 2)
+	require.NotNil(t, testResults[0].Failure)
+	require.Contains(t, testResults[0].GetFailure().GetErrorMessage(), "The stubbed result of instruction 'request' has field \"status\"")
+	require.NotNil(t, testResults[1].Failure)
+	require.Contains(t, testResults[1].GetFailure().GetErrorMessage(), "Instruction 'add_service' can't be stubbed")
+}
+
+func TestStartosisInterpreter_InterpretTestsFailsOnInvalidTestFile(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore())
+	testFile := `
+def test_with_syntax_error(plan):
+	plan.print("missing parenthesis"
+`
+
+	testResults := interpreter.InterpretTests(context.Background(), testPackageId, testTestFileId, testFile)
+	require.Len(t, testResults, 1)
+	require.Equal(t, testTestFilepath, testResults[0].GetTestFilepath())
+	require.Empty(t, testResults[0].GetTestFunctionName())
+	require.NotNil(t, testResults[0].Failure)
+}
+
+func TestStartosisRunner_RunTestsSkipsVendoredPackages(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	runner := NewStartosisRunner(interpreter, nil, nil, nil, runtimeValueStore)
+
+	packageRootPathOnDisk := t.TempDir()
+	fileContentPerFilepath := map[string]string{
+		"main_test.star":        "def test_main():\n\tpass\n",
+		"main.star":             "def test_not_in_test_file():\n\tpass\n",
+		"tests/other_test.star": "def test_other():\n\tpass\n",
+		"vendor/github.com/author/lib/lib_test.star": "def test_vendored():\n\tpass\n",
+		".hidden/hidden_test.star":                   "def test_hidden():\n\tpass\n",
+	}
+	for filepath, content := range fileContentPerFilepath {
+		require.NoError(t, os.MkdirAll(path.Dir(path.Join(packageRootPathOnDisk, filepath)), 0755))
+		require.NoError(t, os.WriteFile(path.Join(packageRootPathOnDisk, filepath), []byte(content), 0644))
+	}
+
+	testResults, err := runner.RunTests(context.Background(), testPackageId, packageRootPathOnDisk)
+	require.NoError(t, err)
+	require.Len(t, testResults, 2)
+	require.Equal(t, "main_test.star", testResults[0].GetTestFilepath())
+	require.Equal(t, "test_main", testResults[0].GetTestFunctionName())
+	require.Equal(t, "tests/other_test.star", testResults[1].GetTestFilepath())
+	require.Equal(t, "test_other", testResults[1].GetTestFunctionName())
+}
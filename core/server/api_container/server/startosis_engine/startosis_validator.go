@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -34,7 +35,11 @@ func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, s
 	}
 }
 
-func (validator *StartosisValidator) Validate(ctx context.Context, instructions []kurtosis_instruction.KurtosisInstruction) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+// Validate validates the instructions against the current state of the enclave. The instructions in
+// instructionsToSkip are already applied to the enclave, and their effect is already reflected in its current state,
+// so they are not validated. The services in redeclaredServices are running in the enclave and get declared again by
+// the package applied to it, which is only allowed once per service
+func (validator *StartosisValidator) Validate(ctx context.Context, instructions []kurtosis_instruction.KurtosisInstruction, instructionsToSkip map[int]bool, redeclaredServices map[service.ServiceName]bool) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	go func() {
 		defer close(starlarkRunResponseLineStream)
@@ -44,11 +49,15 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructions
 			validationInProgressMsg, defaultCurrentStepNumber, defaultTotalStepsNumber)
 		environment := startosis_validator.NewValidatorEnvironment(
 			validator.serviceNetwork.IsNetworkPartitioningEnabled(),
+			validator.serviceNetwork.CanBlockTrafficWithoutSidecars(),
 			validator.serviceNetwork.GetServiceNames(),
 			validator.fileArtifactStore.ListFiles())
+		for serviceName := range redeclaredServices {
+			environment.AllowServiceRedeclaration(serviceName)
+		}
 
 		isValidationFailure = isValidationFailure ||
-			validator.validateAnUpdateEnvironment(instructions, environment, starlarkRunResponseLineStream)
+			validator.validateAnUpdateEnvironment(instructions, instructionsToSkip, environment, starlarkRunResponseLineStream)
 		logrus.Debug("Finished validating environment. Validating and downloading container images.")
 
 		isValidationFailure = isValidationFailure ||
@@ -64,9 +73,12 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructions
 	return starlarkRunResponseLineStream
 }
 
-func (validator *StartosisValidator) validateAnUpdateEnvironment(instructions []kurtosis_instruction.KurtosisInstruction, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+func (validator *StartosisValidator) validateAnUpdateEnvironment(instructions []kurtosis_instruction.KurtosisInstruction, instructionsToSkip map[int]bool, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
-	for _, instruction := range instructions {
+	for index, instruction := range instructions {
+		if instructionsToSkip[index] {
+			continue
+		}
 		err := instruction.ValidateAndUpdateEnvironment(environment)
 		if err != nil {
 			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Error while validating instruction %v. The instruction can be found at %v", instruction.String(), instruction.GetPositionInOriginalScript().String())
@@ -6,18 +6,23 @@ import (
 
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
 type ValidatorEnvironment struct {
-	isNetworkPartitioningEnabled bool
-	requiredDockerImages         map[string]bool
-	serviceNames                 map[service.ServiceName]bool
-	artifactNames                map[string]bool
+	isNetworkPartitioningEnabled   bool
+	canBlockTrafficWithoutSidecars bool
+	requiredDockerImages           map[string]bool
+	serviceNames                   map[service.ServiceName]bool
+	artifactNames                  map[string]bool
+	// the running services the package applied to the enclave can declare once more
+	redeclarableServiceNames map[service.ServiceName]bool
 }
 
-func NewValidatorEnvironment(isNetworkPartitioningEnabled bool, serviceNames map[service.ServiceName]bool, artifactNames map[string]bool) *ValidatorEnvironment {
+func NewValidatorEnvironment(isNetworkPartitioningEnabled bool, canBlockTrafficWithoutSidecars bool, serviceNames map[service.ServiceName]bool, artifactNames map[string]bool) *ValidatorEnvironment {
 	return &ValidatorEnvironment{
-		isNetworkPartitioningEnabled: isNetworkPartitioningEnabled,
-		requiredDockerImages:         map[string]bool{},
-		serviceNames:                 serviceNames,
-		artifactNames:                artifactNames,
+		isNetworkPartitioningEnabled:   isNetworkPartitioningEnabled,
+		canBlockTrafficWithoutSidecars: canBlockTrafficWithoutSidecars,
+		requiredDockerImages:           map[string]bool{},
+		serviceNames:                   serviceNames,
+		artifactNames:                  artifactNames,
+		redeclarableServiceNames:       map[service.ServiceName]bool{},
 	}
 }
 
@@ -42,6 +47,21 @@ func (environment *ValidatorEnvironment) DoesServiceNameExist(serviceName servic
 	return ok
 }
 
+// AllowServiceRedeclaration allows the running service to be declared once more, by the package applied to the enclave
+func (environment *ValidatorEnvironment) AllowServiceRedeclaration(serviceName service.ServiceName) {
+	environment.redeclarableServiceNames[serviceName] = true
+}
+
+// RedeclareServiceName returns true if the existing service can be declared once more, in which case it can't be
+// anymore
+func (environment *ValidatorEnvironment) RedeclareServiceName(serviceName service.ServiceName) bool {
+	if !environment.redeclarableServiceNames[serviceName] {
+		return false
+	}
+	delete(environment.redeclarableServiceNames, serviceName)
+	return true
+}
+
 func (environment *ValidatorEnvironment) AddArtifactName(artifactName string) {
 	environment.artifactNames[artifactName] = true
 }
@@ -58,3 +78,10 @@ func (environment *ValidatorEnvironment) DoesArtifactNameExist(artifactName stri
 func (environment *ValidatorEnvironment) IsNetworkPartitioningEnabled() bool {
 	return environment.isNetworkPartitioningEnabled
 }
+
+// AreSubnetworksEnforced returns true if the connections between subnetworks take effect, either because network
+// partitioning is enabled or because the traffic between them can be blocked without the networking sidecars. In the
+// latter case, only connections allowing or blocking all the traffic can be set
+func (environment *ValidatorEnvironment) AreSubnetworksEnforced() bool {
+	return environment.isNetworkPartitioningEnabled || environment.canBlockTrafficWithoutSidecars
+}
@@ -34,8 +34,9 @@ type EnclaveDataDirectory struct {
 
 var (
 	// NOTE: This will be initialized exactly once (singleton pattern)
-	currentFilesArtifactStore *FilesArtifactStore
-	once                      sync.Once
+	currentFilesArtifactStore    *FilesArtifactStore
+	currentFilesArtifactStoreErr error
+	once                         sync.Once
 )
 
 func NewEnclaveDataDirectory(absMountDirpath string) *EnclaveDataDirectory {
@@ -52,13 +53,16 @@ func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, er
 	// NOTE: We use a 'once' to initialize the filesArtifactStore because it contains a mutex,
 	// and we don't ever want multiple filesArtifactStore instances in existence
 	once.Do(func() {
-		currentFilesArtifactStore = newFilesArtifactStore(absoluteDirpath, relativeDirpath)
+		currentFilesArtifactStore, currentFilesArtifactStoreErr = newFilesArtifactStore(absoluteDirpath, relativeDirpath)
 	})
+	if currentFilesArtifactStoreErr != nil {
+		return nil, stacktrace.Propagate(currentFilesArtifactStoreErr, "An error occurred creating the files artifact store at '%v'", absoluteDirpath)
+	}
 
 	return currentFilesArtifactStore, nil
 }
 
-func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_content_provider.GitPackageContentProvider, error) {
+func (dir EnclaveDataDirectory) GetGitPackageContentProvider(cloneUrlPrefixPerCustomGitHost map[string]string, gitCredentialsPerGitHost map[string]*git_package_content_provider.GitCredentials) (*git_package_content_provider.GitPackageContentProvider, error) {
 	packageStoreDirpath := path.Join(dir.absMountDirpath, startosisPackageStoreDirname)
 	if err := ensureDirpathExists(packageStoreDirpath); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark package store dirpath '%v' exists.", packageStoreDirpath)
@@ -69,5 +73,5 @@ func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_con
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark temporary package store dirpath '%v' exists.", tempPackageStoreDirpath)
 	}
 
-	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath), nil
+	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath, cloneUrlPrefixPerCustomGitHost, gitCredentialsPerGitHost), nil
 }
@@ -6,12 +6,15 @@
 package enclave_data_directory
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"os"
+	"path"
 	"strings"
 	"sync"
 )
@@ -22,6 +25,11 @@ const (
 	// TODO: this is something we can take a look in detail
 	// but we with random numbers as suffix, we should always be able to have some unique name available
 	maxFileArtifactNameRetriesDefault = 5
+
+	// The names of the files artifacts are persisted in this file of the store directory, so that they survive
+	// API container restarts and get captured by enclave snapshots
+	artifactNamesIndexFilename  = "artifact-names.json"
+	artifactNamesIndexFilePerms = 0644
 )
 
 type FilesArtifactStore struct {
@@ -33,15 +41,24 @@ type FilesArtifactStore struct {
 	generateNatureThemeName         func() string
 }
 
-func newFilesArtifactStore(absoluteDirpath string, dirpathRelativeToDataDirRoot string) *FilesArtifactStore {
+func newFilesArtifactStore(absoluteDirpath string, dirpathRelativeToDataDirRoot string) (*FilesArtifactStore, error) {
+	artifactNameToArtifactUuid, err := loadArtifactNamesIndex(absoluteDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the names of the files artifacts stored in '%v'", absoluteDirpath)
+	}
+	shortenedUuidToFullUuid := make(map[string][]FilesArtifactUUID)
+	for _, filesArtifactUuid := range artifactNameToArtifactUuid {
+		shortenedUuid := uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))
+		shortenedUuidToFullUuid[shortenedUuid] = append(shortenedUuidToFullUuid[shortenedUuid], filesArtifactUuid)
+	}
 	return &FilesArtifactStore{
 		fileCache:                       newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot),
 		mutex:                           &sync.RWMutex{},
-		artifactNameToArtifactUuid:      make(map[string]FilesArtifactUUID),
-		shortenedUuidToFullUuid:         make(map[string][]FilesArtifactUUID),
+		artifactNameToArtifactUuid:      artifactNameToArtifactUuid,
+		shortenedUuidToFullUuid:         shortenedUuidToFullUuid,
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
-	}
+	}, nil
 }
 
 // method needed for testing
@@ -75,9 +92,41 @@ func (store FilesArtifactStore) StoreFile(reader io.Reader, artifactName string)
 		return "", err
 	}
 	store.artifactNameToArtifactUuid[artifactName] = filesArtifactUuid
+	if err = store.persistArtifactNamesIndexUnlocked(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred persisting the name of files artifact '%v'", artifactName)
+	}
 	return filesArtifactUuid, nil
 }
 
+// RestoreFilesFromStoreDirectory stores the files artifacts found in the directory of another store, keeping their UUIDs
+// and names. This is used to restore the files artifacts of an enclave snapshot
+func (store FilesArtifactStore) RestoreFilesFromStoreDirectory(storeDirpath string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	artifactNameToArtifactUuid, err := loadArtifactNamesIndex(storeDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the names of the files artifacts stored in '%v'", storeDirpath)
+	}
+	for artifactName, filesArtifactUuid := range artifactNameToArtifactUuid {
+		if _, found := store.artifactNameToArtifactUuid[artifactName]; found {
+			return stacktrace.NewError("Files artifact name '%v' has already been used", artifactName)
+		}
+		filename := strings.Join(
+			[]string{string(filesArtifactUuid), artifactExtension},
+			".",
+		)
+		if err = store.restoreFileUnlocked(filesArtifactUuid, path.Join(storeDirpath, filename)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring files artifact '%v'", artifactName)
+		}
+		store.artifactNameToArtifactUuid[artifactName] = filesArtifactUuid
+	}
+	if err = store.persistArtifactNamesIndexUnlocked(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the names of the restored files artifacts")
+	}
+	return nil
+}
+
 // GetFile Get the file by uuid, then by shortened uuid and finally by name
 func (store FilesArtifactStore) GetFile(artifactIdentifier string) (*EnclaveDataDirFile, error) {
 	store.mutex.RLock()
@@ -189,13 +238,21 @@ func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(reader io.Reade
 		return "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
 	}
 
+	if err = store.storeFileToArtifactUuidUnlocked(filesArtifactUuid, reader); err != nil {
+		return "", err
+	}
+	return filesArtifactUuid, nil
+}
+
+// storeFileToArtifactUuidUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) storeFileToArtifactUuidUnlocked(filesArtifactUuid FilesArtifactUUID, reader io.Reader) error {
 	filename := strings.Join(
 		[]string{string(filesArtifactUuid), artifactExtension},
 		".",
 	)
-	_, err = store.fileCache.AddFile(filename, reader)
+	_, err := store.fileCache.AddFile(filename, reader)
 	if err != nil {
-		return "", stacktrace.Propagate(
+		return stacktrace.Propagate(
 			err,
 			"Could not store file '%s' to the file cache",
 			filename,
@@ -203,7 +260,20 @@ func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(reader io.Reade
 	}
 	shortenedUuidSlice := store.shortenedUuidToFullUuid[uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))]
 	store.shortenedUuidToFullUuid[uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))] = append(shortenedUuidSlice, filesArtifactUuid)
-	return filesArtifactUuid, nil
+	return nil
+}
+
+// restoreFileUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) restoreFileUnlocked(filesArtifactUuid FilesArtifactUUID, srcFilepath string) error {
+	srcFile, err := os.Open(srcFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening files artifact file '%v'", srcFilepath)
+	}
+	defer srcFile.Close()
+	if err = store.storeFileToArtifactUuidUnlocked(filesArtifactUuid, srcFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing files artifact file '%v'", srcFilepath)
+	}
+	return nil
 }
 
 // getFileUnlocked this is not thread safe, must be used from a thread safe context
@@ -239,6 +309,9 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 			delete(store.artifactNameToArtifactUuid, name)
 		}
 	}
+	if err := store.persistArtifactNamesIndexUnlocked(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the names of the files artifacts after removing '%v'", filesArtifactUuid)
+	}
 	shortenedUuid := uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))
 	artifactUuids, found := store.shortenedUuidToFullUuid[shortenedUuid]
 	if found {
@@ -259,3 +332,34 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 
 	return nil
 }
+
+// persistArtifactNamesIndexUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) persistArtifactNamesIndexUnlocked() error {
+	serializedArtifactNamesIndex, err := json.Marshal(store.artifactNameToArtifactUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the names of the files artifacts")
+	}
+	indexFilepath := path.Join(store.fileCache.absoluteDirpath, artifactNamesIndexFilename)
+	if err = os.WriteFile(indexFilepath, serializedArtifactNamesIndex, artifactNamesIndexFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the names of the files artifacts to '%v'", indexFilepath)
+	}
+	return nil
+}
+
+// loadArtifactNamesIndex returns the names of the files artifacts persisted in the given store directory, which is
+// empty if none were persisted yet
+func loadArtifactNamesIndex(storeDirpath string) (map[string]FilesArtifactUUID, error) {
+	artifactNameToArtifactUuid := map[string]FilesArtifactUUID{}
+	indexFilepath := path.Join(storeDirpath, artifactNamesIndexFilename)
+	serializedArtifactNamesIndex, err := os.ReadFile(indexFilepath)
+	if os.IsNotExist(err) {
+		return artifactNameToArtifactUuid, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the names of the files artifacts from '%v'", indexFilepath)
+	}
+	if err = json.Unmarshal(serializedArtifactNamesIndex, &artifactNameToArtifactUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the names of the files artifacts read from '%v'", indexFilepath)
+	}
+	return artifactNameToArtifactUuid, nil
+}
@@ -135,10 +135,46 @@ func TestFileStore_RemoveFileFailsForNonExistentId(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestFileStore_NamesSurviveReloadingTheStore(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testArtifactName := "test-artifact"
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), testArtifactName)
+	require.Nil(t, err)
+
+	reloadedFileStore, err := newFilesArtifactStore(fileStore.fileCache.absoluteDirpath, "")
+	require.Nil(t, err)
+	require.Equal(t, filesArtifactUuid, reloadedFileStore.artifactNameToArtifactUuid[testArtifactName])
+	_, err = reloadedFileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+}
+
+func TestFileStore_RestoreFilesFromStoreDirectory(t *testing.T) {
+	srcFileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	testArtifactName := "test-artifact"
+	filesArtifactUuid, err := srcFileStore.StoreFile(strings.NewReader(testContent), testArtifactName)
+	require.Nil(t, err)
+
+	fileStore := getTestFileStore(t)
+	err = fileStore.RestoreFilesFromStoreDirectory(srcFileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+
+	file, err := fileStore.GetFile(string(filesArtifactUuid))
+	require.Nil(t, err)
+	require.Equal(t, filepath.Join(fileStore.fileCache.absoluteDirpath, string(filesArtifactUuid)+"."+artifactExtension), file.absoluteFilepath)
+	fileContent, err := ioutil.ReadFile(file.absoluteFilepath)
+	require.Nil(t, err)
+	require.Equal(t, []byte(testContent), fileContent)
+	require.Equal(t, filesArtifactUuid, fileStore.artifactNameToArtifactUuid[testArtifactName])
+
+	err = fileStore.RestoreFilesFromStoreDirectory(srcFileStore.fileCache.absoluteDirpath)
+	require.NotNil(t, err)
+}
+
 func getTestFileStore(t *testing.T) *FilesArtifactStore {
 	absDirpath, err := ioutil.TempDir("", "")
 	require.Nil(t, err)
-	fileStore := newFilesArtifactStore(absDirpath, "")
+	fileStore, err := newFilesArtifactStore(absDirpath, "")
 	require.Nil(t, err)
 	return fileStore
 }
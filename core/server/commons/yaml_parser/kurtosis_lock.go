@@ -0,0 +1,57 @@
+package yaml_parser
+
+import (
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"io/ioutil"
+)
+
+// KurtosisLock is the lockfile 'kurtosis package lock' writes next to the kurtosis.yml of a package, which locks the
+// repositories of its dependencies, including the transitive ones, to a commit
+type KurtosisLock struct {
+	// The dependencies per repository locator, e.g. 'github.com/author/repo'
+	Dependencies map[string]*LockedDependency `yaml:"dependencies"`
+}
+
+type LockedDependency struct {
+	// The tag or branch the version constraints of the dependency resolved to, for humans reading the lockfile
+	Version string `yaml:"version"`
+
+	Commit string `yaml:"commit"`
+}
+
+// GetLockedCommitPerRepository returns the commit each repository is locked to
+func (lock *KurtosisLock) GetLockedCommitPerRepository() map[string]string {
+	result := map[string]string{}
+	if lock == nil {
+		return result
+	}
+	for repositoryLocator, lockedDependency := range lock.Dependencies {
+		if lockedDependency != nil {
+			result[repositoryLocator] = lockedDependency.Commit
+		}
+	}
+	return result
+}
+
+func parseKurtosisLockInternal(absPathToKurtosisLock string, read func(filename string) ([]byte, error)) (*KurtosisLock, error) {
+	kurtosisLockContent, err := read(absPathToKurtosisLock)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error occurred while reading the contents of '%v'", absPathToKurtosisLock)
+	}
+
+	var kurtosisLock KurtosisLock
+	if err = yaml.Unmarshal(kurtosisLockContent, &kurtosisLock); err != nil {
+		return nil, stacktrace.Propagate(err, "Error occurred while analyzing the contents of '%v'", absPathToKurtosisLock)
+	}
+	for repositoryLocator, lockedDependency := range kurtosisLock.Dependencies {
+		if lockedDependency == nil || lockedDependency.Commit == "" {
+			return nil, stacktrace.NewError("The dependency '%v' of '%v' isn't locked to any commit", repositoryLocator, absPathToKurtosisLock)
+		}
+	}
+	return &kurtosisLock, nil
+}
+
+func ParseKurtosisLock(absPathToKurtosisLock string) (*KurtosisLock, error) {
+	return parseKurtosisLockInternal(absPathToKurtosisLock, ioutil.ReadFile)
+}
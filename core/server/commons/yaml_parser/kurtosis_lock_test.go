@@ -0,0 +1,34 @@
+package yaml_parser
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	kurtosisLockPath        = "/root/kurtosis-lock.yml"
+	sampleCorrectLock       = []byte("dependencies:\n  github.com/test-author/test-dependency:\n    version: v1.2.0\n    commit: 3f2a9c1b7d5e4f6a8b0c2d4e6f8a0b2c4d6e8f0a\n")
+	sampleLockWithoutCommit = []byte("dependencies:\n  github.com/test-author/test-dependency:\n    version: v1.2.0\n")
+)
+
+func Test_parseKurtosisLockInternal_Success(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleCorrectLock, nil
+	}
+
+	actual, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"github.com/test-author/test-dependency": "3f2a9c1b7d5e4f6a8b0c2d4e6f8a0b2c4d6e8f0a",
+	}, actual.GetLockedCommitPerRepository())
+}
+
+func Test_parseKurtosisLockInternal_MissingCommit(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleLockWithoutCommit, nil
+	}
+
+	_, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.NotNil(t, err)
+	require.ErrorContains(t, err, "isn't locked to any commit")
+}
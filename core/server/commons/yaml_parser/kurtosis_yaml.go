@@ -10,6 +10,10 @@ var noPackageNameFound = ""
 
 type KurtosisYaml struct {
 	PackageName string `yaml:"name"`
+
+	// The version constraints per package locator, which 'kurtosis package lock' resolves to the commits of the
+	// kurtosis-lock.yml next to the kurtosis.yml
+	Dependencies map[string]string `yaml:"dependencies"`
 }
 
 func (parser *KurtosisYaml) GetPackageName() string {
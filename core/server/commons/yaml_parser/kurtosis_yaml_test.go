@@ -42,3 +42,13 @@ func Test_parseKurtosisYamlInternal_IncorrectYaml(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", actual.GetPackageName())
 }
+
+func Test_parseKurtosisYamlInternal_WithDependencies(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return []byte("name: github.com/test-author/test-repo\ndependencies:\n  github.com/test-author/test-dependency: ^1.2.0\n"), nil
+	}
+
+	actual, err := parseKurtosisYamlInternal(kurtosisYmlPath, mockRead)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"github.com/test-author/test-dependency": "^1.2.0"}, actual.Dependencies)
+}
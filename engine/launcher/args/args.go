@@ -29,6 +29,10 @@ type EngineServerArgs struct {
 	//User consent to send metrics
 	DidUserAcceptSendingMetrics bool `json:"didUserAcceptSendingMetrics"`
 
+	// The Git hosts other than GitHub that packages can be fetched from, mapped to the prefix of the URLs their
+	// repositories get cloned from. They're passed to the API container of every enclave
+	CloneUrlPrefixPerCustomGitHost map[string]string `json:"cloneUrlPrefixPerCustomGitHost"`
+
 	KurtosisBackendType KurtosisBackendType `json:"kurtosisBackendType"`
 
 	// Should be deserialized differently depending on value of KurtosisBackendType
@@ -59,6 +63,12 @@ func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
 			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", engineServerArgsMirror.KurtosisBackendConfig, engineServerArgsMirror.KurtosisBackendType.String())
 		}
 		engineServerArgsMirror.KurtosisBackendConfig = kubernetesConfig
+	case KurtosisBackendType_Podman:
+		var podmanConfig kurtosis_backend_config.PodmanBackendConfig
+		if err := json.Unmarshal(byteArray, &podmanConfig); err != nil {
+			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", engineServerArgsMirror.KurtosisBackendConfig, engineServerArgsMirror.KurtosisBackendType.String())
+		}
+		engineServerArgsMirror.KurtosisBackendConfig = podmanConfig
 	default:
 		return stacktrace.NewError("Unmarshalled an unrecognized Kurtosis backend type: '%v'", engineServerArgsMirror.KurtosisBackendType.String())
 	}
@@ -67,7 +77,8 @@ func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
 }
 
 // Even though the fields are public due to JSON de/serialization requirements, we still have this constructor so that
-//  we get compile errors if there are missing fields
+//
+//	we get compile errors if there are missing fields
 func NewEngineServerArgs(
 	grpcListenPortNum uint16,
 	grpcProxyListenPortNum uint16,
@@ -75,18 +86,20 @@ func NewEngineServerArgs(
 	imageVersionTag string,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	kurtosisBackendType KurtosisBackendType,
 	kurtosisBackendConfig interface{},
 ) (*EngineServerArgs, error) {
 	result := &EngineServerArgs{
-		GrpcListenPortNum:           grpcListenPortNum,
-		GrpcProxyListenPortNum:      grpcProxyListenPortNum,
-		LogLevelStr:                 logLevelStr,
-		ImageVersionTag:             imageVersionTag,
-		MetricsUserID:               metricsUserID,
-		DidUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		KurtosisBackendType:         kurtosisBackendType,
-		KurtosisBackendConfig:       kurtosisBackendConfig,
+		GrpcListenPortNum:              grpcListenPortNum,
+		GrpcProxyListenPortNum:         grpcProxyListenPortNum,
+		LogLevelStr:                    logLevelStr,
+		ImageVersionTag:                imageVersionTag,
+		MetricsUserID:                  metricsUserID,
+		DidUserAcceptSendingMetrics:    didUserAcceptSendingMetrics,
+		CloneUrlPrefixPerCustomGitHost: cloneUrlPrefixPerCustomGitHost,
+		KurtosisBackendType:            kurtosisBackendType,
+		KurtosisBackendConfig:          kurtosisBackendConfig,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
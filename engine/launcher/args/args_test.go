@@ -2,6 +2,7 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -9,6 +10,7 @@ import (
 const (
 	kubernetesArgsJson   = `{"grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
 	dockerArgsJson   = `{"grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
+	podmanArgsJson   = `{"grpcListenPortNum":9710,"grpcProxyListenPortNum":9711,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"podman","kurtosisBackendConfig":{"hostPodmanSocketFilepath":"/run/user/1000/podman/podman.sock"}}`
 )
 
 func TestArgsUnmarshalKubernetes(t *testing.T) {
@@ -23,4 +25,14 @@ func TestArgsUnmarshalDocker(t *testing.T) {
 	var args EngineServerArgs
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
+}
+
+func TestArgsUnmarshalPodman(t *testing.T) {
+	paramsJsonBytes := []byte(podmanArgsJson)
+	var args EngineServerArgs
+	err := json.Unmarshal(paramsJsonBytes, &args)
+	require.NoError(t, err)
+	podmanConfig, ok := args.KurtosisBackendConfig.(kurtosis_backend_config.PodmanBackendConfig)
+	require.True(t, ok)
+	require.Equal(t, "/run/user/1000/podman/podman.sock", podmanConfig.HostPodmanSocketFilepath)
 }
\ No newline at end of file
@@ -0,0 +1,11 @@
+/*
+ * Copyright (c) 2022 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package kurtosis_backend_config
+
+type PodmanBackendConfig struct {
+	// The filepath of the Podman socket on the host machine, which the engine & API container have bind-mounted
+	HostPodmanSocketFilepath string `json:"hostPodmanSocketFilepath"`
+}
@@ -36,6 +36,7 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	grpcProxyListenPortNum uint16, // Envoy proxy port that will forward grpc-web calls to the engine
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 ) (
 	resultPublicIpAddr net.IP,
@@ -51,6 +52,7 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		grpcProxyListenPortNum,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		cloneUrlPrefixPerCustomGitHost,
 		backendConfigSupplier,
 	)
 	if err != nil {
@@ -67,6 +69,7 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	grpcProxyListenPortNum uint16, // Envoy proxy port that will forward grpc-web calls to the engine
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 ) (
 	resultPublicIpAddr net.IP,
@@ -81,6 +84,7 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		imageVersionTag,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		cloneUrlPrefixPerCustomGitHost,
 		kurtosisBackendType,
 		kurtosisBackendConfig,
 	)
@@ -23,6 +23,9 @@ func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, enclaveSize
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:           backendConfigSupplier.storageClass,
+		EnclaveSizeInMegabytes: backendConfigSupplier.enclaveSizeInMegabytes,
+	}
 }
 
@@ -1,6 +1,9 @@
 package enclave_manager
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -11,10 +14,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_launcher_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -32,8 +40,16 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// Snapshots don't record whether IPv6 was enabled, and the restored services get new IP addresses anyway, so enclaves
+	// are restored IPv4-only
+	isIpv6DisabledInRestoredEnclaves = false
 )
 
+// Snapshots never hold the Git credentials of the enclave, so the packages of restored enclaves can only be cloned from
+// public repositories
+var noGitCredentialsInRestoredEnclaves = map[string]api_container_launcher_args.GitCredentials{}
+
 // TODO Move this to the KurtosisBackend to calculate!!
 // Completeness enforced via unit test
 var isContainerRunningDeterminer = map[types.ContainerStatus]bool{
@@ -55,6 +71,9 @@ type EnclaveManager struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 
+	// The Git hosts other than GitHub the API containers can fetch packages from
+	cloneUrlPrefixPerCustomGitHost map[string]string
+
 	// this is a stop gap solution, this would be stored and retrieved from the DB in the future
 	// we go with the GRPC type as it is just used by the engine server service
 	// this is an append only list
@@ -64,11 +83,13 @@ type EnclaveManager struct {
 func NewEnclaveManager(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	cloneUrlPrefixPerCustomGitHost map[string]string,
 ) *EnclaveManager {
 	return &EnclaveManager{
 		mutex:           &sync.Mutex{},
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		cloneUrlPrefixPerCustomGitHost:            cloneUrlPrefixPerCustomGitHost,
 		allExistingAndHistoricalIdentifiers:       []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{},
 	}
 }
@@ -84,6 +105,9 @@ func (manager *EnclaveManager) CreateEnclave(
 	//If blank, will use a random one
 	enclaveName string,
 	isPartitioningEnabled bool,
+	isIpv6Enabled bool,
+	// The credentials the API container of the enclave clones the private repositories of the Git hosts with
+	gitCredentialsPerGitHost map[string]api_container_launcher_args.GitCredentials,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
@@ -115,7 +139,7 @@ func (manager *EnclaveManager) CreateEnclave(
 
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
-	newEnclave, err := manager.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	newEnclave, err := manager.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, isPartitioningEnabled, isIpv6Enabled)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
@@ -143,6 +167,7 @@ func (manager *EnclaveManager) CreateEnclave(
 		apiContainerListenGrpcPortNumInsideNetwork,
 		apiContainerListenGrpcProxyPortNumInsideNetwork,
 		isPartitioningEnabled,
+		gitCredentialsPerGitHost,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
 	)
@@ -249,6 +274,200 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
 }
 
+// StartEnclave starts again the API container, the networking sidecars and the user services of a stopped enclave, so
+// that the enclave serves the state it had before being stopped
+func (manager *EnclaveManager) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	if err = manager.startEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveUuid)
+	}
+	if err = manager.restorePortForwardsWithoutMutex(ctx); err != nil {
+		logrus.Warnf("Enclave '%v' was started, but an error occurred restoring its port forwards:\n%v", enclaveUuid, err)
+	}
+	return nil
+}
+
+// SnapshotEnclave writes a snapshot of the given enclave to the output writer, as a gzipped TAR archive. The snapshot
+// contains the enclave database and the files artifacts of the API container, and the images the filesystems of the
+// user services get committed to, so that RestoreEnclave can recreate the enclave in the state it is in now
+// The API container is paused while the snapshot is taken, so that the enclave can't change in the meantime and the
+// enclave database isn't copied in the middle of a write. As the database is only modified by committing transactions,
+// the copy of a paused database is as consistent as the database of an API container that crashed
+func (manager *EnclaveManager) SnapshotEnclave(ctx context.Context, enclaveIdentifier string, output io.Writer) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("Enclave '%v' wasn't found by the backend", enclaveUuid)
+	}
+
+	if err = manager.kurtosisBackend.PauseAPIContainer(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pausing the API container of enclave '%v' to snapshot it", enclaveUuid)
+	}
+	defer func() {
+		// Separate context for unpausing the API container in case the input context is cancelled
+		if err := manager.kurtosisBackend.UnpauseAPIContainer(context.Background(), enclaveUuid); err != nil {
+			logrus.Errorf("An error occurred unpausing the API container of enclave '%v' after snapshotting it:\n%v", enclaveUuid, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually unpause the API container of enclave '%v'!!!!!!", enclaveUuid)
+		}
+	}()
+
+	serviceImages, err := manager.createUserServiceImages(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating images out of the filesystems of the services of enclave '%v'", enclaveUuid)
+	}
+	serializedManifest, err := enclave_snapshot.NewManifest(kurtosis_version.KurtosisVersion, enclaveObj.GetName(), enclaveObj.IsPartitioningEnabled(), serviceImages).Serialize()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the manifest of the snapshot of enclave '%v'", enclaveUuid)
+	}
+
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err = writeSnapshotFile(tarWriter, enclave_snapshot.ManifestFilename, int64(len(serializedManifest)), bytes.NewReader(serializedManifest)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifest to the snapshot of enclave '%v'", enclaveUuid)
+	}
+	for _, srcPathOnApiContainer := range []string{
+		enclave_snapshot.EnclaveDbFilepathOnApiContainer,
+		enclave_snapshot.FilesArtifactStoreDirpathOnApiContainer,
+	} {
+		if err = manager.copyApiContainerFilesToSnapshot(ctx, enclaveUuid, srcPathOnApiContainer, tarWriter); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying '%v' from the API container to the snapshot of enclave '%v'", srcPathOnApiContainer, enclaveUuid)
+		}
+	}
+	if len(serviceImages) > 0 {
+		if err = manager.saveServiceImagesToSnapshot(ctx, serviceImages, tarWriter); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving the images of the services to the snapshot of enclave '%v'", enclaveUuid)
+		}
+	}
+	if err = tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR archive of the snapshot of enclave '%v'", enclaveUuid)
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the gzip stream of the snapshot of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// RestoreEnclave creates a new enclave out of a snapshot written by SnapshotEnclave. The images of the services are
+// loaded, and the content of the snapshot is handed to the API container of the new enclave, which restores the files
+// artifacts and starts the services again when it boots. The new enclave has subnetwork capabilities if the
+// snapshotted one had them
+// It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), see CreateEnclave
+func (manager *EnclaveManager) RestoreEnclave(
+	ctx context.Context,
+	snapshot io.Reader,
+	// If blank, will use the default
+	apiContainerImageVersionTag string,
+	apiContainerLogLevel logrus.Level,
+	// If blank, will use the name of the snapshotted enclave
+	enclaveName string,
+	metricsUserID string,
+	didUserAcceptSendingMetrics bool,
+) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	extractedSnapshot, err := extractEnclaveSnapshot(snapshot)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extracting the enclave snapshot")
+	}
+	defer extractedSnapshot.remove()
+
+	if extractedSnapshot.serviceImagesFilepath != "" {
+		serviceImagesFile, err := os.Open(extractedSnapshot.serviceImagesFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred opening the images of the services of the enclave snapshot")
+		}
+		defer serviceImagesFile.Close()
+		if err = manager.kurtosisBackend.LoadImages(ctx, serviceImagesFile); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the images of the services of the enclave snapshot")
+		}
+	}
+
+	if enclaveName == "" {
+		enclaveName = extractedSnapshot.manifest.EnclaveName
+	}
+	enclaveInfo, err := manager.CreateEnclave(
+		ctx,
+		apiContainerImageVersionTag,
+		apiContainerLogLevel,
+		enclaveName,
+		extractedSnapshot.manifest.IsPartitioningEnabled,
+		isIpv6DisabledInRestoredEnclaves,
+		noGitCredentialsInRestoredEnclaves,
+		metricsUserID,
+		didUserAcceptSendingMetrics,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' to restore the snapshot into", enclaveName)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	shouldDestroyEnclave := true
+	defer func() {
+		if shouldDestroyEnclave {
+			// Separate context for tearing stuff down in case the input context is cancelled
+			_, destroyEnclaveErrs, err := manager.kurtosisBackend.DestroyEnclaves(context.Background(), getEnclaveByEnclaveIdFilter(enclaveUuid))
+			manualActionRequiredStrFmt := "ACTION REQUIRED: You'll need to manually destroy the enclave '%v'!!!!!!"
+			if err != nil {
+				logrus.Errorf("Expected to be able to call the backend and destroy enclave '%v', but an error occurred:\n%v", enclaveUuid, err)
+				logrus.Errorf(manualActionRequiredStrFmt, enclaveUuid)
+				return
+			}
+			for enclaveUuid, err := range destroyEnclaveErrs {
+				logrus.Errorf("Expected to be able to cleanup the enclave '%v', but an error was thrown:\n%v", enclaveUuid, err)
+				logrus.Errorf(manualActionRequiredStrFmt, enclaveUuid)
+			}
+		}
+	}()
+
+	snapshotToRestoreFile, err := os.Open(extractedSnapshot.snapshotToRestoreTarFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the content of the enclave snapshot to restore")
+	}
+	defer snapshotToRestoreFile.Close()
+	if err = manager.kurtosisBackend.CopyFilesToAPIContainer(ctx, enclaveUuid, enclave_snapshot.EnclaveDataDirpathOnApiContainer, snapshotToRestoreFile); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the content of the enclave snapshot to the API container of enclave '%v'", enclaveUuid)
+	}
+
+	// The API container restores the snapshot when it boots, so it gets restarted now that the snapshot is in place
+	if err = manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping enclave '%v' to restore the snapshot into it", enclaveUuid)
+	}
+	if err = manager.startEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v' to restore the snapshot into it", enclaveUuid)
+	}
+
+	// The ports the API container is reachable at on the host machine may have changed when it restarted
+	enclaveInfos, err := manager.getEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves after restoring the snapshot into enclave '%v'", enclaveUuid)
+	}
+	restoredEnclaveInfo, found := enclaveInfos[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found after restoring the snapshot into it", enclaveUuid)
+	}
+
+	shouldDestroyEnclave = false
+	return restoredEnclaveInfo, nil
+}
+
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
@@ -438,6 +657,35 @@ func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, encl
 	return nil
 }
 
+// Both StartEnclave and RestoreEnclave need to be able to start enclaves, but both have a mutex guard
+func (manager *EnclaveManager) startEnclaveWithoutMutex(ctx context.Context, enclaveId enclave.EnclaveUUID) error {
+	_, enclaveStartErrs, err := manager.kurtosisBackend.StartEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveId))
+	if err != nil {
+		return stacktrace.Propagate(err, "Attempted to start enclave '%v' but the backend threw an error", enclaveId)
+	}
+	// Handle any err thrown by the backend
+	enclaveStartErrStrings := []string{}
+	for enclaveId, err := range enclaveStartErrs {
+		if err != nil {
+			wrappedErr := stacktrace.Propagate(
+				err,
+				"An error occurred starting Enclave `%v'",
+				enclaveId,
+			)
+			enclaveStartErrStrings = append(enclaveStartErrStrings, wrappedErr.Error())
+		}
+	}
+	if len(enclaveStartErrStrings) > 0 {
+		return stacktrace.NewError(
+			"One or more errors occurred starting the enclave(s):\n%v",
+			strings.Join(
+				enclaveStartErrStrings,
+				"\n\n",
+			))
+	}
+	return nil
+}
+
 func (manager *EnclaveManager) cleanEnclaves(ctx context.Context, shouldCleanAll bool) ([]string, []error, error) {
 	enclaveStatusFilters := map[enclave.EnclaveStatus]bool{
 		enclave.EnclaveStatus_Stopped: true,
@@ -522,6 +770,7 @@ func (manager *EnclaveManager) launchApiContainer(
 	grpcListenPort uint16,
 	grpcProxyListenPort uint16,
 	isPartitioningEnabled bool,
+	gitCredentialsPerGitHost map[string]api_container_launcher_args.GitCredentials,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
 ) (
@@ -542,6 +791,8 @@ func (manager *EnclaveManager) launchApiContainer(
 			isPartitioningEnabled,
 			metricsUserID,
 			didUserAcceptSendingMetrics,
+			manager.cloneUrlPrefixPerCustomGitHost,
+			gitCredentialsPerGitHost,
 			manager.apiContainerKurtosisBackendConfigSupplier,
 		)
 		if err != nil {
@@ -558,6 +809,8 @@ func (manager *EnclaveManager) launchApiContainer(
 		isPartitioningEnabled,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		manager.cloneUrlPrefixPerCustomGitHost,
+		gitCredentialsPerGitHost,
 		manager.apiContainerKurtosisBackendConfigSupplier,
 	)
 	if err != nil {
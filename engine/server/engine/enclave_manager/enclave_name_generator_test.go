@@ -18,8 +18,8 @@ func TestGetRandomEnclaveIdWithRetriesSuccess(t *testing.T) {
 	retries := uint16(3)
 
 	currentEnclavePresent := map[enclave.EnclaveUUID]*enclave.Enclave{
-		"123": enclave.NewEnclave("123", nonUniqueName, enclave.EnclaveStatus_Empty, nil),
-		"456": enclave.NewEnclave("456", nameAlreadyExists1, enclave.EnclaveStatus_Empty, nil),
+		"123": enclave.NewEnclave("123", nonUniqueName, enclave.EnclaveStatus_Empty, nil, false),
+		"456": enclave.NewEnclave("456", nameAlreadyExists1, enclave.EnclaveStatus_Empty, nil, false),
 	}
 
 	timesCalled := 0
@@ -46,9 +46,9 @@ func TestGetRandomEnclaveIdWithRetriesUniqueNameNotFound(t *testing.T) {
 	retries := uint16(3)
 
 	currentEnclavePresent := map[enclave.EnclaveUUID]*enclave.Enclave{
-		"123": enclave.NewEnclave("123", nonUniqueName, enclave.EnclaveStatus_Empty, nil),
-		"456": enclave.NewEnclave("456", nameAlreadyExists1, enclave.EnclaveStatus_Empty, nil),
-		"789": enclave.NewEnclave("789", nameAlreadyExists2, enclave.EnclaveStatus_Empty, nil),
+		"123": enclave.NewEnclave("123", nonUniqueName, enclave.EnclaveStatus_Empty, nil, false),
+		"456": enclave.NewEnclave("456", nameAlreadyExists1, enclave.EnclaveStatus_Empty, nil, false),
+		"789": enclave.NewEnclave("789", nameAlreadyExists2, enclave.EnclaveStatus_Empty, nil, false),
 	}
 
 	timesCalled := 0
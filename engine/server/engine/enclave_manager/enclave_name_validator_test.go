@@ -19,9 +19,9 @@ const (
 
 var (
 	creationTime             = time.Now()
-	firstEnclaveForTest      = enclave.NewEnclave(firstEnclaveUuidForTest, firstEnclaveNameForTest, runningEnclaveStatus, &creationTime)
-	secondEnclaveForTest     = enclave.NewEnclave(secondEnclaveUuidForTest, secondEnclaveNameForTest, runningEnclaveStatus, &creationTime)
-	theirEnclaveForTest      = enclave.NewEnclave(theirEnclaveUuidForTest, theirEnclaveNameForTest, runningEnclaveStatus, &creationTime)
+	firstEnclaveForTest      = enclave.NewEnclave(firstEnclaveUuidForTest, firstEnclaveNameForTest, runningEnclaveStatus, &creationTime, false)
+	secondEnclaveForTest     = enclave.NewEnclave(secondEnclaveUuidForTest, secondEnclaveNameForTest, runningEnclaveStatus, &creationTime, false)
+	theirEnclaveForTest      = enclave.NewEnclave(theirEnclaveUuidForTest, theirEnclaveNameForTest, runningEnclaveStatus, &creationTime, false)
 	currentEnclaveIdsForTest = map[enclave.EnclaveUUID]*enclave.Enclave{
 		firstEnclaveUuidForTest:  firstEnclaveForTest,
 		secondEnclaveUuidForTest: secondEnclaveForTest,
@@ -0,0 +1,300 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sort"
+)
+
+const (
+	// A local port number of 0 means the private port number of the service is used
+	samePortAsPrivatePortNumber = uint32(0)
+
+	maxPortNumber = uint32(65535)
+
+	serviceNameNotFound = "Name Not Found"
+)
+
+// CreatePortForward forwards a local port of the machine running the engine to a private port of a service. The port
+// forward outlives the CLI invocation creating it, and is destroyed along with the enclave
+// It's a liiiitle weird that we return a PortForwardInfo object (which is a Protobuf object), see CreateEnclave
+func (manager *EnclaveManager) CreatePortForward(
+	ctx context.Context,
+	enclaveIdentifier string,
+	serviceIdentifier string,
+	portId string,
+	localPortNumber uint32,
+) (*kurtosis_engine_rpc_api_bindings.PortForwardInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if localPortNumber > maxPortNumber {
+		return nil, stacktrace.NewError("Local port '%v' is invalid; it must be lower than or equal to '%v'", localPortNumber, maxPortNumber)
+	}
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+	userService, err := manager.getUserServiceForIdentifierUnlocked(ctx, enclaveUuid, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+	privatePortSpec, found := userService.GetPrivatePorts()[portId]
+	if !found {
+		privatePortIds := []string{}
+		for privatePortId := range userService.GetPrivatePorts() {
+			privatePortIds = append(privatePortIds, privatePortId)
+		}
+		sort.Strings(privatePortIds)
+		return nil, stacktrace.NewError("Service '%v' doesn't have a port with ID '%v'; its ports are '%v'", serviceIdentifier, portId, privatePortIds)
+	}
+	if localPortNumber == samePortAsPrivatePortNumber {
+		localPortNumber = uint32(privatePortSpec.GetNumber())
+	}
+
+	serviceUuid := userService.GetRegistration().GetUUID()
+	portForward, err := manager.kurtosisBackend.CreatePortForward(ctx, enclaveUuid, serviceUuid, portId, uint16(localPortNumber))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred forwarding local port '%v' to port '%v' of service '%v'", localPortNumber, portId, serviceUuid)
+	}
+
+	enclaveNamesByUuid, err := manager.getEnclaveNamesByUuidUnlocked(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the names of the enclaves")
+	}
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{
+		serviceUuid: userService.GetRegistration().GetName(),
+	}
+	return newPortForwardInfo(portForward, enclaveNamesByUuid, serviceNamesByUuid), nil
+}
+
+// GetPortForwards returns the info of the existing port forwards, by local port number
+func (manager *EnclaveManager) GetPortForwards(ctx context.Context) (map[uint32]*kurtosis_engine_rpc_api_bindings.PortForwardInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	portForwards, err := manager.kurtosisBackend.GetPortForwards(ctx, getAllPortForwardsFilters())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the port forwards")
+	}
+
+	enclaveNamesByUuid, err := manager.getEnclaveNamesByUuidUnlocked(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the names of the enclaves")
+	}
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	enclavesWithPortForwards := map[enclave.EnclaveUUID]bool{}
+	for _, portForward := range portForwards {
+		enclavesWithPortForwards[portForward.GetEnclaveUUID()] = true
+	}
+	for enclaveUuid := range enclavesWithPortForwards {
+		userServices, err := manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, getAllUserServicesFilters())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the user services of enclave '%v'", enclaveUuid)
+		}
+		for serviceUuid, userService := range userServices {
+			serviceNamesByUuid[serviceUuid] = userService.GetRegistration().GetName()
+		}
+	}
+
+	result := map[uint32]*kurtosis_engine_rpc_api_bindings.PortForwardInfo{}
+	for localPortNumber, portForward := range portForwards {
+		result[uint32(localPortNumber)] = newPortForwardInfo(portForward, enclaveNamesByUuid, serviceNamesByUuid)
+	}
+	return result, nil
+}
+
+// DestroyPortForward stops forwarding the given local port
+func (manager *EnclaveManager) DestroyPortForward(ctx context.Context, localPortNumber uint32) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if localPortNumber > maxPortNumber {
+		return stacktrace.NewError("Local port '%v' is invalid; it must be lower than or equal to '%v'", localPortNumber, maxPortNumber)
+	}
+	filters := &port_forward.PortForwardFilters{
+		EnclaveUUIDs:     nil,
+		UserServiceUUIDs: nil,
+		LocalPortNumbers: map[uint16]bool{
+			uint16(localPortNumber): true,
+		},
+		Statuses: nil,
+	}
+	successfulLocalPortNumbers, erroredLocalPortNumbers, err := manager.kurtosisBackend.DestroyPortForwards(ctx, filters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the port forward of local port '%v'", localPortNumber)
+	}
+	if destroyErr, found := erroredLocalPortNumbers[uint16(localPortNumber)]; found {
+		return stacktrace.Propagate(destroyErr, "An error occurred destroying the port forward of local port '%v'", localPortNumber)
+	}
+	if _, found := successfulLocalPortNumbers[uint16(localPortNumber)]; !found {
+		return stacktrace.NewError("No port forward of local port '%v' exists", localPortNumber)
+	}
+	return nil
+}
+
+// RestorePortForwards brings back the port forwards which stopped along with the container engine or their enclave,
+// as long as the service they forward a port to is running
+func (manager *EnclaveManager) RestorePortForwards(ctx context.Context) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	return manager.restorePortForwardsWithoutMutex(ctx)
+}
+
+func (manager *EnclaveManager) restorePortForwardsWithoutMutex(ctx context.Context) error {
+	stoppedPortForwardsFilters := &port_forward.PortForwardFilters{
+		EnclaveUUIDs:     nil,
+		UserServiceUUIDs: nil,
+		LocalPortNumbers: nil,
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Stopped: true,
+		},
+	}
+	stoppedPortForwards, err := manager.kurtosisBackend.GetPortForwards(ctx, stoppedPortForwardsFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the stopped port forwards")
+	}
+
+	runningServicesFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: nil,
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	runningServicesByEnclave := map[enclave.EnclaveUUID]map[service.ServiceUUID]*service.Service{}
+	for localPortNumber, portForward := range stoppedPortForwards {
+		enclaveUuid := portForward.GetEnclaveUUID()
+		runningServices, found := runningServicesByEnclave[enclaveUuid]
+		if !found {
+			runningServices, err = manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, runningServicesFilters)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the running user services of enclave '%v'", enclaveUuid)
+			}
+			runningServicesByEnclave[enclaveUuid] = runningServices
+		}
+		if _, found := runningServices[portForward.GetServiceUUID()]; !found {
+			// The port forward is restored when the service is running again
+			continue
+		}
+
+		// The port forward container is recreated rather than restarted, as the IP address of the service may have changed
+		destroyFilters := &port_forward.PortForwardFilters{
+			EnclaveUUIDs:     nil,
+			UserServiceUUIDs: nil,
+			LocalPortNumbers: map[uint16]bool{
+				localPortNumber: true,
+			},
+			Statuses: nil,
+		}
+		if _, erroredLocalPortNumbers, err := manager.kurtosisBackend.DestroyPortForwards(ctx, destroyFilters); err != nil || len(erroredLocalPortNumbers) > 0 {
+			logrus.Warnf("Couldn't remove the stopped port forward of local port '%v', so it won't be restored", localPortNumber)
+			continue
+		}
+		if _, err = manager.kurtosisBackend.CreatePortForward(ctx, enclaveUuid, portForward.GetServiceUUID(), portForward.GetPortId(), localPortNumber); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the port forward of local port '%v' to port '%v' of service '%v'", localPortNumber, portForward.GetPortId(), portForward.GetServiceUUID())
+		}
+		logrus.Debugf("Restored the port forward of local port '%v' to port '%v' of service '%v'", localPortNumber, portForward.GetPortId(), portForward.GetServiceUUID())
+	}
+	return nil
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getUserServiceForIdentifierUnlocked(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceIdentifier string,
+) (*service.Service, error) {
+	userServices, err := manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, getAllUserServicesFilters())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user services of enclave '%v'", enclaveUuid)
+	}
+
+	if userService, found := userServices[service.ServiceUUID(serviceIdentifier)]; found {
+		return userService, nil
+	}
+
+	var shortenedUuidMatches []*service.Service
+	var nameMatches []*service.Service
+	for serviceUuid, userService := range userServices {
+		if uuid_generator.ShortenedUUIDString(string(serviceUuid)) == serviceIdentifier {
+			shortenedUuidMatches = append(shortenedUuidMatches, userService)
+		}
+		if string(userService.GetRegistration().GetName()) == serviceIdentifier {
+			nameMatches = append(nameMatches, userService)
+		}
+	}
+
+	if len(shortenedUuidMatches) == validNumberOfUuidMatches {
+		return shortenedUuidMatches[0], nil
+	} else if len(shortenedUuidMatches) > validNumberOfUuidMatches {
+		return nil, stacktrace.NewError("Found multiple services matching shortened uuid '%v'. Please use a uuid to be more specific", serviceIdentifier)
+	}
+	if len(nameMatches) == validNumberOfUuidMatches {
+		return nameMatches[0], nil
+	}
+	return nil, stacktrace.NewError("Couldn't find service for identifier '%v' in enclave '%v'", serviceIdentifier, enclaveUuid)
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getEnclaveNamesByUuidUnlocked(ctx context.Context) (map[enclave.EnclaveUUID]string, error) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	result := map[enclave.EnclaveUUID]string{}
+	for enclaveUuid, enclaveObj := range enclaves {
+		result[enclaveUuid] = enclaveObj.GetName()
+	}
+	return result, nil
+}
+
+func newPortForwardInfo(
+	portForward *port_forward.PortForward,
+	enclaveNamesByUuid map[enclave.EnclaveUUID]string,
+	serviceNamesByUuid map[service.ServiceUUID]service.ServiceName,
+) *kurtosis_engine_rpc_api_bindings.PortForwardInfo {
+	enclaveName, found := enclaveNamesByUuid[portForward.GetEnclaveUUID()]
+	if !found {
+		enclaveName = enclaveNameNotFound
+	}
+	serviceName, found := serviceNamesByUuid[portForward.GetServiceUUID()]
+	if !found {
+		serviceName = serviceNameNotFound
+	}
+	return &kurtosis_engine_rpc_api_bindings.PortForwardInfo{
+		EnclaveUuid:     string(portForward.GetEnclaveUUID()),
+		EnclaveName:     enclaveName,
+		ServiceUuid:     string(portForward.GetServiceUUID()),
+		ServiceName:     string(serviceName),
+		PortId:          portForward.GetPortId(),
+		LocalPortNumber: uint32(portForward.GetLocalPortNumber()),
+		IsRunning:       portForward.GetStatus() == container_status.ContainerStatus_Running,
+	}
+}
+
+func getAllPortForwardsFilters() *port_forward.PortForwardFilters {
+	return &port_forward.PortForwardFilters{
+		EnclaveUUIDs:     nil,
+		UserServiceUUIDs: nil,
+		LocalPortNumbers: nil,
+		Statuses:         nil,
+	}
+}
+
+func getAllUserServicesFilters() *service.ServiceFilters {
+	return &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+}
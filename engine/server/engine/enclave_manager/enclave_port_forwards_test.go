@@ -0,0 +1,63 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_forward"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+const (
+	portForwardTestEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+
+	runningServiceUuid = service.ServiceUUID("running-service-uuid")
+	stoppedServiceUuid = service.ServiceUUID("stopped-service-uuid")
+
+	portForwardTestPortId = "http"
+)
+
+func TestRestorePortForwards_RecreatesOnlyPortForwardsOfRunningServices(t *testing.T) {
+	ctx := context.Background()
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+
+	stoppedPortForwards := map[uint16]*port_forward.PortForward{
+		8080: port_forward.NewPortForward(portForwardTestEnclaveUuid, runningServiceUuid, portForwardTestPortId, 8080, container_status.ContainerStatus_Stopped),
+		9090: port_forward.NewPortForward(portForwardTestEnclaveUuid, stoppedServiceUuid, portForwardTestPortId, 9090, container_status.ContainerStatus_Stopped),
+	}
+	runningServiceRegistration := service.NewServiceRegistration("running-service", runningServiceUuid, portForwardTestEnclaveUuid, net.ParseIP("172.16.0.3"), "running-service")
+	runningServices := map[service.ServiceUUID]*service.Service{
+		runningServiceUuid: service.NewService(runningServiceRegistration, container_status.ContainerStatus_Running, nil, nil, nil),
+	}
+
+	kurtosisBackend.EXPECT().GetPortForwards(ctx, mock.Anything).Return(stoppedPortForwards, nil)
+	kurtosisBackend.EXPECT().GetUserServices(ctx, portForwardTestEnclaveUuid, mock.Anything).Return(runningServices, nil).Once()
+	kurtosisBackend.EXPECT().
+		DestroyPortForwards(ctx, mock.MatchedBy(func(filters *port_forward.PortForwardFilters) bool {
+			return len(filters.LocalPortNumbers) == 1 && filters.LocalPortNumbers[8080]
+		})).
+		Return(map[uint16]bool{8080: true}, map[uint16]error{}, nil).
+		Once()
+	kurtosisBackend.EXPECT().
+		CreatePortForward(ctx, portForwardTestEnclaveUuid, runningServiceUuid, portForwardTestPortId, uint16(8080)).
+		Return(port_forward.NewPortForward(portForwardTestEnclaveUuid, runningServiceUuid, portForwardTestPortId, 8080, container_status.ContainerStatus_Running), nil).
+		Once()
+
+	manager := NewEnclaveManager(kurtosisBackend, nil, map[string]string{})
+	require.NoError(t, manager.RestorePortForwards(ctx))
+}
+
+func TestNewPortForwardInfo_UnknownNames(t *testing.T) {
+	portForward := port_forward.NewPortForward(portForwardTestEnclaveUuid, runningServiceUuid, portForwardTestPortId, 8080, container_status.ContainerStatus_Running)
+
+	portForwardInfo := newPortForwardInfo(portForward, map[enclave.EnclaveUUID]string{}, map[service.ServiceUUID]service.ServiceName{})
+	require.Equal(t, enclaveNameNotFound, portForwardInfo.GetEnclaveName())
+	require.Equal(t, serviceNameNotFound, portForwardInfo.GetServiceName())
+	require.Equal(t, uint32(8080), portForwardInfo.GetLocalPortNumber())
+	require.True(t, portForwardInfo.GetIsRunning())
+}
@@ -0,0 +1,270 @@
+package enclave_manager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/enclave_snapshot"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	snapshotTempFilePattern = "kurtosis-enclave-snapshot-*"
+
+	snapshotFilePerms = 0644
+)
+
+// The content of an enclave snapshot, extracted to temporary files
+type extractedEnclaveSnapshot struct {
+	manifest *enclave_snapshot.Manifest
+
+	// TAR archive with the manifest, the enclave database and the files artifact store, all under the
+	// enclave_snapshot.SnapshotToRestoreDirname directory, ready to be copied to the enclave data dir of the API container
+	snapshotToRestoreTarFilepath string
+
+	// Empty if the snapshot doesn't contain any service image
+	serviceImagesFilepath string
+}
+
+func (snapshot *extractedEnclaveSnapshot) remove() {
+	for _, filepath := range []string{snapshot.snapshotToRestoreTarFilepath, snapshot.serviceImagesFilepath} {
+		if filepath == "" {
+			continue
+		}
+		if err := os.Remove(filepath); err != nil {
+			logrus.Warnf("An error occurred removing temporary enclave snapshot file '%v':\n%v", filepath, err)
+		}
+	}
+}
+
+// Commits the filesystem of every user service of the enclave to an image, returning the image names by service name
+func (manager *EnclaveManager) createUserServiceImages(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (map[string]string, error) {
+	allServicesFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	userServices, err := manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, allServicesFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user services of enclave '%v'", enclaveUuid)
+	}
+	serviceImagesByUuid, erroredServiceUuids, err := manager.kurtosisBackend.CreateUserServiceImages(ctx, enclaveUuid, allServicesFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating images out of the user services of enclave '%v'", enclaveUuid)
+	}
+	if len(erroredServiceUuids) > 0 {
+		serviceErrStrs := []string{}
+		for serviceUuid, serviceErr := range erroredServiceUuids {
+			serviceErrStrs = append(serviceErrStrs, fmt.Sprintf("An error occurred creating an image out of service '%v':\n%v", serviceUuid, serviceErr))
+		}
+		return nil, stacktrace.NewError("One or more errors occurred creating images out of the user services:\n%v", strings.Join(serviceErrStrs, "\n\n"))
+	}
+
+	serviceImages := map[string]string{}
+	for serviceUuid, imageName := range serviceImagesByUuid {
+		userService, found := userServices[serviceUuid]
+		if !found {
+			return nil, stacktrace.NewError("An image was created out of service '%v', but the service wasn't found in the enclave; this is a bug in Kurtosis", serviceUuid)
+		}
+		serviceImages[string(userService.GetRegistration().GetName())] = imageName
+	}
+	return serviceImages, nil
+}
+
+// Copies the given file or directory from the API container of the enclave to the snapshot, under its base name
+func (manager *EnclaveManager) copyApiContainerFilesToSnapshot(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	srcPathOnApiContainer string,
+	snapshotTarWriter *tar.Writer,
+) error {
+	pipeReader, pipeWriter := io.Pipe()
+	defer pipeReader.Close()
+	go func() {
+		pipeWriter.CloseWithError(manager.kurtosisBackend.CopyFilesFromAPIContainer(ctx, enclaveUuid, srcPathOnApiContainer, pipeWriter))
+	}()
+
+	tarReader := tar.NewReader(pipeReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the files copied from '%v' on the API container", srcPathOnApiContainer)
+		}
+		if err = snapshotTarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the header of '%v' to the snapshot", header.Name)
+		}
+		if _, err = io.Copy(snapshotTarWriter, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of '%v' to the snapshot", header.Name)
+		}
+	}
+}
+
+// The images get saved to a temporary file first, as the size of a TAR entry has to be known before writing its content
+func (manager *EnclaveManager) saveServiceImagesToSnapshot(ctx context.Context, serviceImages map[string]string, snapshotTarWriter *tar.Writer) error {
+	imageNames := []string{}
+	for _, imageName := range serviceImages {
+		imageNames = append(imageNames, imageName)
+	}
+	sort.Strings(imageNames)
+
+	serviceImagesFile, err := os.CreateTemp("", snapshotTempFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file to save the service images to")
+	}
+	defer func() {
+		serviceImagesFile.Close()
+		if err := os.Remove(serviceImagesFile.Name()); err != nil {
+			logrus.Warnf("An error occurred removing temporary service images file '%v':\n%v", serviceImagesFile.Name(), err)
+		}
+	}()
+	if err = manager.kurtosisBackend.SaveImages(ctx, imageNames, serviceImagesFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving images '%+v'", imageNames)
+	}
+	serviceImagesFileInfo, err := serviceImagesFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size of the saved service images")
+	}
+	if _, err = serviceImagesFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding the saved service images")
+	}
+	if err = writeSnapshotFile(snapshotTarWriter, enclave_snapshot.ServiceImagesFilename, serviceImagesFileInfo.Size(), serviceImagesFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the saved service images to the snapshot")
+	}
+	return nil
+}
+
+func writeSnapshotFile(snapshotTarWriter *tar.Writer, filename string, size int64, content io.Reader) error {
+	header := new(tar.Header)
+	header.Typeflag = tar.TypeReg
+	header.Name = filename
+	header.Mode = snapshotFilePerms
+	header.Size = size
+	if err := snapshotTarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of '%v'", filename)
+	}
+	if _, err := io.Copy(snapshotTarWriter, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of '%v'", filename)
+	}
+	return nil
+}
+
+// Splits the snapshot in the service images, which get loaded by the engine, and the rest, which gets restored by the
+// API container
+func extractEnclaveSnapshot(snapshot io.Reader) (*extractedEnclaveSnapshot, error) {
+	gzipReader, err := gzip.NewReader(snapshot)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave snapshot; is it a snapshot created by Kurtosis?")
+	}
+	defer gzipReader.Close()
+
+	snapshotToRestoreFile, err := os.CreateTemp("", snapshotTempFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file to extract the enclave snapshot to")
+	}
+	defer snapshotToRestoreFile.Close()
+	result := &extractedEnclaveSnapshot{
+		manifest:                     nil,
+		snapshotToRestoreTarFilepath: snapshotToRestoreFile.Name(),
+		serviceImagesFilepath:        "",
+	}
+	shouldRemoveExtractedSnapshot := true
+	defer func() {
+		if shouldRemoveExtractedSnapshot {
+			result.remove()
+		}
+	}()
+
+	snapshotTarReader := tar.NewReader(gzipReader)
+	snapshotToRestoreTarWriter := tar.NewWriter(snapshotToRestoreFile)
+	for {
+		header, err := snapshotTarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the enclave snapshot")
+		}
+		entryName := path.Clean(header.Name)
+
+		if entryName == enclave_snapshot.ServiceImagesFilename {
+			serviceImagesFilepath, err := extractServiceImages(snapshotTarReader)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred extracting the service images of the enclave snapshot")
+			}
+			result.serviceImagesFilepath = serviceImagesFilepath
+			continue
+		}
+
+		if entryName == enclave_snapshot.ManifestFilename {
+			serializedManifest, err := io.ReadAll(snapshotTarReader)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred reading the manifest of the enclave snapshot")
+			}
+			if result.manifest, err = enclave_snapshot.DeserializeManifest(serializedManifest); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred deserializing the manifest of the enclave snapshot")
+			}
+			header.Size = int64(len(serializedManifest))
+			if err = writeSnapshotEntryToRestore(snapshotToRestoreTarWriter, header, bytes.NewReader(serializedManifest)); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred extracting the manifest of the enclave snapshot")
+			}
+			continue
+		}
+
+		if entryName != enclave_snapshot.EnclaveDbFilename &&
+			entryName != enclave_snapshot.FilesArtifactStoreDirname &&
+			!strings.HasPrefix(entryName, enclave_snapshot.FilesArtifactStoreDirname+"/") {
+			return nil, stacktrace.NewError("The enclave snapshot contains unexpected entry '%v'; is it a snapshot created by Kurtosis?", header.Name)
+		}
+		if err = writeSnapshotEntryToRestore(snapshotToRestoreTarWriter, header, snapshotTarReader); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred extracting '%v' from the enclave snapshot", header.Name)
+		}
+	}
+	if result.manifest == nil {
+		return nil, stacktrace.NewError("The enclave snapshot doesn't contain a manifest; is it a snapshot created by Kurtosis?")
+	}
+	if err = snapshotToRestoreTarWriter.Close(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred closing the extracted content of the enclave snapshot")
+	}
+
+	shouldRemoveExtractedSnapshot = false
+	return result, nil
+}
+
+func writeSnapshotEntryToRestore(snapshotToRestoreTarWriter *tar.Writer, header *tar.Header, content io.Reader) error {
+	header.Name = path.Join(enclave_snapshot.SnapshotToRestoreDirname, path.Clean(header.Name))
+	if err := snapshotToRestoreTarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of '%v'", header.Name)
+	}
+	if _, err := io.Copy(snapshotToRestoreTarWriter, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of '%v'", header.Name)
+	}
+	return nil
+}
+
+func extractServiceImages(content io.Reader) (string, error) {
+	serviceImagesFile, err := os.CreateTemp("", snapshotTempFilePattern)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating a temporary file to extract the service images to")
+	}
+	defer serviceImagesFile.Close()
+	if _, err = io.Copy(serviceImagesFile, content); err != nil {
+		if removeErr := os.Remove(serviceImagesFile.Name()); removeErr != nil {
+			logrus.Warnf("An error occurred removing temporary service images file '%v':\n%v", serviceImagesFile.Name(), removeErr)
+		}
+		return "", stacktrace.Propagate(err, "An error occurred extracting the service images")
+	}
+	return serviceImagesFile.Name(), nil
+}
@@ -0,0 +1,96 @@
+package enclave_manager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/enclave_snapshot"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	testEnclaveName      = "test-enclave"
+	testServiceName      = "test-service"
+	testServiceImage     = "kurtosis-user-service-image/test-service-uuid:20230101000000"
+	testEnclaveDbContent = "enclave-db"
+	testArtifactFilename = enclave_snapshot.FilesArtifactStoreDirname + "/test-artifact.tgz"
+	testArtifactContent  = "artifact"
+	testServiceImagesTar = "service-images"
+
+	isPartitioningEnabled = true
+)
+
+func TestExtractEnclaveSnapshot(t *testing.T) {
+	manifest := enclave_snapshot.NewManifest("X.X.X", testEnclaveName, isPartitioningEnabled, map[string]string{testServiceName: testServiceImage})
+	serializedManifest, err := manifest.Serialize()
+	require.NoError(t, err)
+	snapshot := createTestSnapshot(t, map[string]string{
+		enclave_snapshot.ManifestFilename:      string(serializedManifest),
+		enclave_snapshot.EnclaveDbFilename:     testEnclaveDbContent,
+		testArtifactFilename:                   testArtifactContent,
+		enclave_snapshot.ServiceImagesFilename: testServiceImagesTar,
+	})
+
+	extractedSnapshot, err := extractEnclaveSnapshot(snapshot)
+	require.NoError(t, err)
+	defer extractedSnapshot.remove()
+
+	require.Equal(t, manifest, extractedSnapshot.manifest)
+
+	serviceImages, err := os.ReadFile(extractedSnapshot.serviceImagesFilepath)
+	require.NoError(t, err)
+	require.Equal(t, testServiceImagesTar, string(serviceImages))
+
+	snapshotToRestoreFile, err := os.Open(extractedSnapshot.snapshotToRestoreTarFilepath)
+	require.NoError(t, err)
+	defer snapshotToRestoreFile.Close()
+	snapshotToRestore := map[string]string{}
+	tarReader := tar.NewReader(snapshotToRestoreFile)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		snapshotToRestore[header.Name] = string(content)
+	}
+	require.Equal(t, map[string]string{
+		path.Join(enclave_snapshot.SnapshotToRestoreDirname, enclave_snapshot.ManifestFilename):  string(serializedManifest),
+		path.Join(enclave_snapshot.SnapshotToRestoreDirname, enclave_snapshot.EnclaveDbFilename): testEnclaveDbContent,
+		path.Join(enclave_snapshot.SnapshotToRestoreDirname, testArtifactFilename):               testArtifactContent,
+	}, snapshotToRestore)
+}
+
+func TestExtractEnclaveSnapshot_UnexpectedEntryIsRejected(t *testing.T) {
+	snapshot := createTestSnapshot(t, map[string]string{
+		enclave_snapshot.FilesArtifactStoreDirname + "/../../etc/passwd": "",
+	})
+	_, err := extractEnclaveSnapshot(snapshot)
+	require.Error(t, err)
+}
+
+func TestExtractEnclaveSnapshot_MissingManifestIsRejected(t *testing.T) {
+	snapshot := createTestSnapshot(t, map[string]string{
+		enclave_snapshot.EnclaveDbFilename: testEnclaveDbContent,
+	})
+	_, err := extractEnclaveSnapshot(snapshot)
+	require.Error(t, err)
+}
+
+func createTestSnapshot(t *testing.T, files map[string]string) io.Reader {
+	snapshot := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(snapshot)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for filename, content := range files {
+		require.NoError(t, writeSnapshotFile(tarWriter, filename, int64(len(content)), bytes.NewReader([]byte(content))))
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return snapshot
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	kubernetes_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/backend_creator"
+	podman_backend_creator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/podman/podman_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
@@ -107,11 +109,15 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
 
-	enclaveManager, err := getEnclaveManager(kurtosisBackend, serverArgs.KurtosisBackendType)
+	enclaveManager, err := getEnclaveManager(kurtosisBackend, serverArgs.KurtosisBackendType, backendConfig, serverArgs.CloneUrlPrefixPerCustomGitHost)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
 
+	if err = enclaveManager.RestorePortForwards(ctx); err != nil {
+		logrus.Warnf("An error occurred restoring the port forwards; they can be recreated with 'kurtosis port forward':\n%v", err)
+	}
+
 	logsDatabaseClient := kurtosis_backend.NewKurtosisBackendLogsDatabaseClient(kurtosisBackend)
 
 	engineServerService := server.NewEngineServerService(serverArgs.ImageVersionTag, enclaveManager, serverArgs.MetricsUserID, serverArgs.DidUserAcceptSendingMetrics, logsDatabaseClient)
@@ -134,18 +140,25 @@ func runMain() error {
 	return nil
 }
 
-func getEnclaveManager(kurtosisBackend backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType) (*enclave_manager.EnclaveManager, error) {
+func getEnclaveManager(kurtosisBackend backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, cloneUrlPrefixPerCustomGitHost map[string]string) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
 	case args.KurtosisBackendType_Docker:
 		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewDockerKurtosisBackendConfigSupplier()
 	case args.KurtosisBackendType_Kubernetes:
 		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier()
+	case args.KurtosisBackendType_Podman:
+		podmanBackendConfig, ok := (backendConfig).(kurtosis_backend_config.PodmanBackendConfig)
+		if !ok {
+			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Podman.String())
+		}
+		// The API container bind-mounts the same host socket as the engine
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewPodmanKurtosisBackendConfigSupplier(podmanBackendConfig.HostPodmanSocketFilepath)
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
 
-	enclaveManager := enclave_manager.NewEnclaveManager(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveManager := enclave_manager.NewEnclaveManager(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, cloneUrlPrefixPerCustomGitHost)
 
 	return enclaveManager, nil
 }
@@ -160,27 +173,27 @@ func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBa
 			return nil, stacktrace.Propagate(err, "An error occurred getting local Docker Kurtosis backend")
 		}
 	case args.KurtosisBackendType_Kubernetes:
-		// Use this with more properties
-		_, ok := (backendConfig).(kurtosis_backend_config.KubernetesBackendConfig)
+		kubernetesBackendConfig, ok := (backendConfig).(kurtosis_backend_config.KubernetesBackendConfig)
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		pluginPath := backend_interface.GetPluginPathForEngine(backend_interface.KubernetesPluginName)
-		plugin, err := backend_interface.OpenBackendPlugin(pluginPath)
+		kurtosisBackend, err = kubernetes_backend_creator.GetEngineServerBackend(ctx, kubernetesBackendConfig.StorageClass, kubernetesBackendConfig.EnclaveSizeInMegabytes)
 		if err != nil {
 			return nil, stacktrace.Propagate(
 				err,
-				"An error occurred loading a Kurtosis Kubernetes backend plugin on path '%s'",
-				pluginPath,
+				"An error occurred getting Kubernetes Kurtosis backend with storage class '%v' and enclave size (in MB) '%v'",
+				kubernetesBackendConfig.StorageClass,
+				kubernetesBackendConfig.EnclaveSizeInMegabytes,
 			)
 		}
-		kurtosisBackend, err = plugin.GetEngineServerBackend(ctx)
+	case args.KurtosisBackendType_Podman:
+		podmanBackendConfig, ok := (backendConfig).(kurtosis_backend_config.PodmanBackendConfig)
+		if !ok {
+			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Podman.String())
+		}
+		kurtosisBackend, err = podman_backend_creator.GetInContainerPodmanKurtosisBackend(ctx, podmanBackendConfig.HostPodmanSocketFilepath, apiContainerModeArgsForKurtosisBackend)
 		if err != nil {
-			return nil, stacktrace.Propagate(
-				err,
-				"An error occurred casting a Kurtosis Kubernetes backend loaded from plugin on path '%s'",
-				pluginPath,
-			)
+			return nil, stacktrace.Propagate(err, "An error occurred getting Podman Kurtosis backend using host socket '%v'", podmanBackendConfig.HostPodmanSocketFilepath)
 		}
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
@@ -1,16 +1,24 @@
 package server
 
 import (
+	"bufio"
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	api_container_launcher_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+)
+
+const (
+	// Enclave snapshots are streamed in chunks below the default max gRPC message size
+	snapshotChunkSizeBytes = 3 * 1024 * 1024
 )
 
 type EngineServerService struct {
@@ -59,12 +67,24 @@ func (service *EngineServerService) CreateEnclave(ctx context.Context, args *kur
 		return nil, stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", args.ApiContainerLogLevel)
 	}
 
+	gitCredentialsPerGitHost := map[string]api_container_launcher_args.GitCredentials{}
+	for gitHost, gitCredentials := range args.GitCredentialsPerGitHost {
+		gitCredentialsPerGitHost[gitHost] = api_container_launcher_args.GitCredentials{
+			Username:      gitCredentials.GetUsername(),
+			Token:         gitCredentials.GetToken(),
+			SshPrivateKey: gitCredentials.GetSshPrivateKey(),
+			SshKnownHosts: gitCredentials.GetSshKnownHosts(),
+		}
+	}
+
 	enclaveInfo, err := service.enclaveManager.CreateEnclave(
 		ctx,
 		args.ApiContainerVersionTag,
 		apiContainerLogLevel,
 		args.EnclaveName,
 		args.IsPartitioningEnabled,
+		args.IsIpv6Enabled,
+		gitCredentialsPerGitHost,
 		service.metricsUserID,
 		service.didUserAcceptSendingMetrics,
 	)
@@ -104,6 +124,88 @@ func (service *EngineServerService) StopEnclave(ctx context.Context, args *kurto
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineServerService) StartEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.StartEnclaveArgs) (*emptypb.Empty, error) {
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	if err := service.enclaveManager.StartEnclave(ctx, enclaveIdentifier); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineServerService) SnapshotEnclave(args *kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs, stream kurtosis_engine_rpc_api_bindings.EngineService_SnapshotEnclaveServer) error {
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	chunkWriter := bufio.NewWriterSize(&snapshotChunkStreamWriter{stream: stream}, snapshotChunkSizeBytes)
+	if err := service.enclaveManager.SnapshotEnclave(stream.Context(), enclaveIdentifier, chunkWriter); err != nil {
+		return stacktrace.Propagate(err, "An error occurred taking a snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	if err := chunkWriter.Flush(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the last chunk of the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+// The enclave settings are read from the first message of the stream; the following ones only carry snapshot chunks
+func (service *EngineServerService) RestoreEnclave(stream kurtosis_engine_rpc_api_bindings.EngineService_RestoreEnclaveServer) error {
+	args, err := stream.Recv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the arguments to restore the enclave snapshot with")
+	}
+	apiContainerLogLevel, err := logrus.ParseLevel(args.ApiContainerLogLevel)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", args.ApiContainerLogLevel)
+	}
+
+	snapshotReader, snapshotWriter := io.Pipe()
+	receivingDone := make(chan struct{})
+	go func() {
+		defer close(receivingDone)
+		chunk := args.GetSnapshotChunk()
+		for {
+			if _, err := snapshotWriter.Write(chunk); err != nil {
+				// The snapshot reader was closed, so the remaining chunks aren't needed
+				return
+			}
+			nextArgs, err := stream.Recv()
+			if err == io.EOF {
+				snapshotWriter.Close()
+				return
+			}
+			if err != nil {
+				snapshotWriter.CloseWithError(err)
+				return
+			}
+			chunk = nextArgs.GetSnapshotChunk()
+		}
+	}()
+
+	enclaveInfo, err := service.enclaveManager.RestoreEnclave(
+		stream.Context(),
+		snapshotReader,
+		args.ApiContainerVersionTag,
+		apiContainerLogLevel,
+		args.EnclaveName,
+		service.metricsUserID,
+		service.didUserAcceptSendingMetrics,
+	)
+	// The stream mustn't be read anymore once this method returns
+	snapshotReader.Close()
+	<-receivingDone
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot")
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse{
+		EnclaveInfo: enclaveInfo,
+	}
+	if err = stream.SendAndClose(response); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the restored enclave info")
+	}
+	return nil
+}
+
 func (service *EngineServerService) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	enclaveIdentifier := args.EnclaveIdentifier
 
@@ -213,6 +315,39 @@ func (service *EngineServerService) GetServiceLogs(
 
 }
 
+func (service *EngineServerService) CreatePortForward(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CreatePortForwardArgs) (*kurtosis_engine_rpc_api_bindings.CreatePortForwardResponse, error) {
+	portForwardInfo, err := service.enclaveManager.CreatePortForward(
+		ctx,
+		args.EnclaveIdentifier,
+		args.ServiceIdentifier,
+		args.PortId,
+		args.LocalPortNumber,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v' in enclave '%v'", args.PortId, args.ServiceIdentifier, args.EnclaveIdentifier)
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.CreatePortForwardResponse{PortForwardInfo: portForwardInfo}
+	return response, nil
+}
+
+func (service *EngineServerService) GetPortForwards(ctx context.Context, _ *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetPortForwardsResponse, error) {
+	infoForPortForwards, err := service.enclaveManager.GetPortForwards(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for port forwards")
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetPortForwardsResponse{PortForwardInfo: infoForPortForwards}
+	return response, nil
+}
+
+func (service *EngineServerService) DestroyPortForward(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyPortForwardArgs) (*emptypb.Empty, error) {
+	if err := service.enclaveManager.DestroyPortForward(ctx, args.LocalPortNumber); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred destroying the port forward of local port '%v'", args.LocalPortNumber)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -338,3 +473,24 @@ func newConjunctiveLogLineFiltersFromGRPCLogLineFilters(
 
 	return conjunctiveLogLineFilters, nil
 }
+
+// Sends everything written to it as enclave snapshot chunks
+type snapshotChunkStreamWriter struct {
+	stream kurtosis_engine_rpc_api_bindings.EngineService_SnapshotEnclaveServer
+}
+
+func (writer *snapshotChunkStreamWriter) Write(content []byte) (int, error) {
+	for sentBytes := 0; sentBytes < len(content); sentBytes += snapshotChunkSizeBytes {
+		chunkEnd := sentBytes + snapshotChunkSizeBytes
+		if chunkEnd > len(content) {
+			chunkEnd = len(content)
+		}
+		chunk := &kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk{
+			Content: content[sentBytes:chunkEnd],
+		}
+		if err := writer.stream.Send(chunk); err != nil {
+			return sentBytes, stacktrace.Propagate(err, "An error occurred sending an enclave snapshot chunk")
+		}
+	}
+	return len(content), nil
+}
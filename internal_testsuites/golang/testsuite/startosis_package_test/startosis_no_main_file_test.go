@@ -3,6 +3,7 @@ package startosis_package_test
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"os"
@@ -38,7 +39,7 @@ func TestStartosisPackage_NoMainFile(t *testing.T) {
 
 	expectedErrorContents := `An error occurred while verifying that 'main.star' exists in the package 'github.com/sample/sample-kurtosis-package' at '/kurtosis-data/startosis-packages/sample/sample-kurtosis-package/main.star'
 	Caused by: stat /kurtosis-data/startosis-packages/sample/sample-kurtosis-package/main.star: no such file or directory`
-	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, enclaves.NewStarlarkRunOptionsBuilder().WithDryRun(defaultDryRun).WithParallelism(defaultParallelism).Build())
 	require.Nil(t, err, "Unexpected error executing package")
 	require.NotNil(t, runResult.InterpretationError)
 	require.Equal(t, runResult.InterpretationError.GetErrorMessage(), expectedErrorContents)
@@ -3,6 +3,7 @@ package startosis_package_test
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"os"
@@ -33,7 +34,7 @@ func TestStartosisPackage_ValidPackageNoInput(t *testing.T) {
 
 	logrus.Infof("Starlark package path: \n%v", packageDirpath)
 
-	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, enclaves.NewStarlarkRunOptionsBuilder().WithDryRun(defaultDryRun).WithParallelism(defaultParallelism).Build())
 	require.Nil(t, err, "Unexpected error executing Starlark package")
 
 	require.Nil(t, runResult.InterpretationError)
@@ -65,7 +66,7 @@ func TestStartosisPackage_ValidPackageNoInput_PassingParamsAlsoWorks(t *testing.
 	logrus.Infof("Starlark package path: \n%v", packageDirpath)
 
 	params := `{"greetings": "bonjour!"}`
-	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, params, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, params, enclaves.NewStarlarkRunOptionsBuilder().WithDryRun(defaultDryRun).WithParallelism(defaultParallelism).Build())
 	require.Nil(t, err, "Unexpected error executing Starlark package")
 
 	require.Nil(t, runResult.InterpretationError)
@@ -3,6 +3,7 @@ package startosis_subpackage_test
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"os"
@@ -25,7 +26,7 @@ func TestStarlarkValidLocalSubPackage(t *testing.T) {
 		err = destroyEnclaveFunc()
 		require.Nil(t, err, "Unexpected Error Occurred")
 	}()
-	
+
 	// ------------------------------------- TEST RUN ----------------------------------------------
 	logrus.Debugf("Executing Starlark Package")
 
@@ -33,7 +34,7 @@ func TestStarlarkValidLocalSubPackage(t *testing.T) {
 	require.Nil(t, err)
 	packageDirpath := path.Join(currentWorkingDirectory, relPathToKurtosisSubpackage)
 
-	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, emptyRunParams, enclaves.NewStarlarkRunOptionsBuilder().WithDryRun(defaultDryRun).WithParallelism(defaultParallelism).Build())
 	require.NoError(t, err, "Unexpected error executing starlark package")
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error")